@@ -945,24 +945,19 @@ const mapOffset = (token: Token, offset: number): Token => {
 }
 
 /**
- * Returns true if a `contains.file(...)` predicate is valid. This predicate is currently valid when one of
- * `path:` or `content:` is specified, or both. Any additional filters or tokens besides whitespace
- * makes this body invalid.
+ * Returns true if a `contains.file(...)` predicate is valid. This predicate is currently valid when it
+ * consists of (possibly negated) `path:` and `content:` filters combined with keywords and
+ * parentheses. Any other filters or tokens besides whitespace makes this body invalid.
  */
-const validContainsFileBody = (tokens: Token[]): boolean => {
-    const fileIndex = tokens.findIndex(token => token.type === 'filter' && token.field.value === 'path')
-    if (fileIndex !== -1) {
-        tokens.splice(fileIndex, 1)
-    }
-    const contentIndex = tokens.findIndex(token => token.type === 'filter' && token.field.value === 'content')
-    if (contentIndex !== -1) {
-        tokens.splice(contentIndex, 1)
-    }
-    if (tokens.filter(value => value.type !== 'whitespace').length > 0) {
-        return false
-    }
-    return true
-}
+const validContainsFileBody = (tokens: Token[]): boolean =>
+    tokens.every(
+        token =>
+            token.type === 'whitespace' ||
+            token.type === 'openingParen' ||
+            token.type === 'closingParen' ||
+            token.type === 'keyword' ||
+            (token.type === 'filter' && (token.field.value === 'path' || token.field.value === 'content'))
+    )
 
 /**
  * Attempts to decorate `contains.file(path:foo content:bar)` syntax. Fails if
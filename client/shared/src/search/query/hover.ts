@@ -200,7 +200,7 @@ const toPredicateHover = (token: MetaPredicate): string => {
     switch (token.value.name) {
         case 'contains.file':
         case 'has.file': {
-            return '**Built-in predicate**. Search only inside repositories that satisfy the specified `path:` and `content:` filters. `path:` and `content:` filters should be regular expressions.'
+            return '**Built-in predicate**. Search only inside repositories that satisfy the specified `path:` and `content:` filters. `path:` and `content:` filters should be regular expressions, and can be negated with `-` and combined with `or`.'
         }
         case 'contains.path':
         case 'has.path': {
@@ -26,6 +26,8 @@ export const PREDICATES: PredicateDefinition[] = [
     { field: 'file', name: 'contains.content' },
     { field: 'file', name: 'has.content' },
     { field: 'file', name: 'has.owner' },
+    { field: 'file', name: 'has.commit.after' },
+    { field: 'file', name: 'has.size' },
     { field: 'rev', name: 'at.time' },
 ]
 
@@ -186,6 +188,18 @@ export const predicateCompletion = (field: FilterType): Completion[] => {
                 asSnippet: true,
                 description: 'Search only inside files that have a contributor that matches a pattern',
             },
+            {
+                label: 'has.commit.after(...)',
+                insertText: 'has.commit.after(${1:1 month ago})',
+                asSnippet: true,
+                description: 'Search only inside files that have been committed to since then',
+            },
+            {
+                label: 'has.size(...)',
+                insertText: 'has.size(${1:>500})',
+                asSnippet: true,
+                description: 'Search only inside files whose number of lines matches a comparison, like >500',
+            },
         ]
     }
     if (field === 'rev') {
@@ -176,6 +176,8 @@ export type OwnerMatch = PersonMatch | TeamMatch
 export interface BaseOwnerMatch {
     handle?: string
     email?: string
+    /** The names of the CODEOWNERS sections the owner was found in. */
+    sections?: string[]
 }
 
 export interface PersonMatch extends BaseOwnerMatch {
@@ -49,6 +49,11 @@ export const V2SearchAggregationModeTypes: { [key in SearchAggregationMode]: num
     PATH: 3,
     REPO: 4,
     REPO_METADATA: 5,
+    DIRECTORY: 6,
+    OWNER: 7,
+    LANGUAGE: 8,
+    SYMBOL_KIND: 9,
+    COMMIT_MONTH: 10,
 }
 
 export const SearchAggregationResult: FC<SearchAggregationResultProps> = props => {
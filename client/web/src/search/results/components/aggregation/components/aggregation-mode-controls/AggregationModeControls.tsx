@@ -128,6 +128,87 @@ export const AggregationModeControls: FC<AggregationModeControlsProps> = props =
                     </Button>
                 </Tooltip>
             </div>
+
+            <div onMouseEnter={() => handleModeEnter(SearchAggregationMode.DIRECTORY)} onMouseLeave={handleMouseLeave}>
+                <Tooltip content={availabilityGroups[SearchAggregationMode.DIRECTORY]?.reasonUnavailable}>
+                    <Button
+                        variant="secondary"
+                        size={size}
+                        outline={mode !== SearchAggregationMode.DIRECTORY}
+                        disabled={!isModeAvailable(SearchAggregationMode.DIRECTORY)}
+                        data-testid="directory-aggregation-mode"
+                        onClick={() => onModeChange(SearchAggregationMode.DIRECTORY)}
+                    >
+                        Directory
+                    </Button>
+                </Tooltip>
+            </div>
+
+            <div onMouseEnter={() => handleModeEnter(SearchAggregationMode.OWNER)} onMouseLeave={handleMouseLeave}>
+                <Tooltip content={availabilityGroups[SearchAggregationMode.OWNER]?.reasonUnavailable}>
+                    <Button
+                        variant="secondary"
+                        size={size}
+                        outline={mode !== SearchAggregationMode.OWNER}
+                        disabled={!isModeAvailable(SearchAggregationMode.OWNER)}
+                        data-testid="owner-aggregation-mode"
+                        onClick={() => onModeChange(SearchAggregationMode.OWNER)}
+                    >
+                        Owner
+                    </Button>
+                </Tooltip>
+            </div>
+
+            <div onMouseEnter={() => handleModeEnter(SearchAggregationMode.LANGUAGE)} onMouseLeave={handleMouseLeave}>
+                <Tooltip content={availabilityGroups[SearchAggregationMode.LANGUAGE]?.reasonUnavailable}>
+                    <Button
+                        variant="secondary"
+                        size={size}
+                        outline={mode !== SearchAggregationMode.LANGUAGE}
+                        disabled={!isModeAvailable(SearchAggregationMode.LANGUAGE)}
+                        data-testid="language-aggregation-mode"
+                        onClick={() => onModeChange(SearchAggregationMode.LANGUAGE)}
+                    >
+                        Language
+                    </Button>
+                </Tooltip>
+            </div>
+
+            <div
+                onMouseEnter={() => handleModeEnter(SearchAggregationMode.SYMBOL_KIND)}
+                onMouseLeave={handleMouseLeave}
+            >
+                <Tooltip content={availabilityGroups[SearchAggregationMode.SYMBOL_KIND]?.reasonUnavailable}>
+                    <Button
+                        variant="secondary"
+                        size={size}
+                        outline={mode !== SearchAggregationMode.SYMBOL_KIND}
+                        disabled={!isModeAvailable(SearchAggregationMode.SYMBOL_KIND)}
+                        data-testid="symbolKind-aggregation-mode"
+                        onClick={() => onModeChange(SearchAggregationMode.SYMBOL_KIND)}
+                    >
+                        Symbol kind
+                    </Button>
+                </Tooltip>
+            </div>
+
+            <div
+                onMouseEnter={() => handleModeEnter(SearchAggregationMode.COMMIT_MONTH)}
+                onMouseLeave={handleMouseLeave}
+            >
+                <Tooltip content={availabilityGroups[SearchAggregationMode.COMMIT_MONTH]?.reasonUnavailable}>
+                    <Button
+                        variant="secondary"
+                        size={size}
+                        outline={mode !== SearchAggregationMode.COMMIT_MONTH}
+                        disabled={!isModeAvailable(SearchAggregationMode.COMMIT_MONTH)}
+                        data-testid="commitMonth-aggregation-mode"
+                        onClick={() => onModeChange(SearchAggregationMode.COMMIT_MONTH)}
+                    >
+                        Commit month
+                    </Button>
+                </Tooltip>
+            </div>
             {enableRepositoryMetadata && (
                 <div
                     onMouseEnter={() => handleModeEnter(SearchAggregationMode.REPO_METADATA)}
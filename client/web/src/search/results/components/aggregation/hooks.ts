@@ -19,7 +19,18 @@ import { GroupResultsPing } from './pings'
 import { V2SearchAggregationModeTypes } from './SearchAggregationResult'
 import { AggregationUIMode } from './types'
 
-type SerializedAggregationMode = 'repo' | 'path' | 'author' | 'group' | 'repo-metadata' | ''
+type SerializedAggregationMode =
+    | 'repo'
+    | 'path'
+    | 'author'
+    | 'group'
+    | 'repo-metadata'
+    | 'directory'
+    | 'owner'
+    | 'language'
+    | 'symbol-kind'
+    | 'commit-month'
+    | ''
 
 const aggregationModeSerializer = (mode: SearchAggregationMode | null): SerializedAggregationMode => {
     switch (mode) {
@@ -38,6 +49,21 @@ const aggregationModeSerializer = (mode: SearchAggregationMode | null): Serializ
         case SearchAggregationMode.REPO_METADATA: {
             return 'repo-metadata'
         }
+        case SearchAggregationMode.DIRECTORY: {
+            return 'directory'
+        }
+        case SearchAggregationMode.OWNER: {
+            return 'owner'
+        }
+        case SearchAggregationMode.LANGUAGE: {
+            return 'language'
+        }
+        case SearchAggregationMode.SYMBOL_KIND: {
+            return 'symbol-kind'
+        }
+        case SearchAggregationMode.COMMIT_MONTH: {
+            return 'commit-month'
+        }
         default: {
             return ''
         }
@@ -63,6 +89,21 @@ const aggregationModeDeserializer = (
         case 'repo-metadata': {
             return SearchAggregationMode.REPO_METADATA
         }
+        case 'directory': {
+            return SearchAggregationMode.DIRECTORY
+        }
+        case 'owner': {
+            return SearchAggregationMode.OWNER
+        }
+        case 'language': {
+            return SearchAggregationMode.LANGUAGE
+        }
+        case 'symbol-kind': {
+            return SearchAggregationMode.SYMBOL_KIND
+        }
+        case 'commit-month': {
+            return SearchAggregationMode.COMMIT_MONTH
+        }
 
         default: {
             return null
@@ -0,0 +1,164 @@
+// Package builtin implements the transformations of builtin batch spec steps.
+// They are applied to the workspace in-process instead of running a container.
+package builtin
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Apply applies the transformation of step to the files in dir and returns the
+// slash-separated paths of the files it changed, relative to dir.
+func Apply(ctx context.Context, dir string, step *batcheslib.BuiltinStep) ([]string, error) {
+	switch {
+	case step.RegexReplace != nil:
+		return regexReplace(ctx, dir, step.RegexReplace)
+	case step.StructuralReplace != nil:
+		return structuralReplace(ctx, dir, step.StructuralReplace)
+	case step.AddFile != nil:
+		return addFile(dir, step.AddFile)
+	case step.DeleteFile != nil:
+		return deleteFiles(ctx, dir, step.DeleteFile)
+	case step.JSONEdit != nil:
+		return jsonEdit(dir, step.JSONEdit)
+	case step.YAMLEdit != nil:
+		return yamlEdit(dir, step.YAMLEdit)
+	default:
+		return nil, errors.New("builtin step has no transformation")
+	}
+}
+
+// matchFiles returns the slash-separated paths of the regular files in dir
+// that match any of the glob patterns, or all of them if there are no
+// patterns. The .git directory is never matched.
+func matchFiles(ctx context.Context, dir string, patterns []string) ([]string, error) {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, errors.Wrapf(err, "invalid path pattern %q", pattern)
+		}
+	}
+
+	var matches []string
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		// Symbolic links are skipped, so that a repository can't point a
+		// transformation at files outside of the workspace.
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if len(patterns) == 0 {
+			matches = append(matches, rel)
+			return nil
+		}
+		for _, pattern := range patterns {
+			if matchGlob(pattern, rel) {
+				matches = append(matches, rel)
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "finding files")
+	}
+	return matches, nil
+}
+
+// matchGlob reports whether the slash-separated name matches pattern. In
+// addition to the syntax of path.Match, a ** segment matches any number of
+// path segments.
+func matchGlob(pattern, name string) bool {
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		// The pattern has been validated by matchFiles.
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0
+}
+
+// localPath returns the path of the slash-separated name in dir. It returns
+// an error if name points outside of dir or passes through a symbolic link,
+// so that a repository can't redirect writes outside of the workspace.
+func localPath(dir, name string) (string, error) {
+	name = filepath.FromSlash(name)
+	if !filepath.IsLocal(name) {
+		return "", errors.Newf("path %q is not within the workspace", filepath.ToSlash(name))
+	}
+
+	p := dir
+	for _, part := range strings.Split(filepath.Clean(name), string(filepath.Separator)) {
+		p = filepath.Join(p, part)
+		info, err := os.Lstat(p)
+		if errors.Is(err, fs.ErrNotExist) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		if info.Mode()&fs.ModeSymlink != 0 {
+			return "", errors.Newf("path %q is a symbolic link", filepath.ToSlash(name))
+		}
+	}
+	return filepath.Join(dir, name), nil
+}
+
+// writeFile writes content to the file at p, creating its parent directories
+// if necessary. It returns false if the file already has the content.
+func writeFile(p string, content []byte) (bool, error) {
+	existing, err := os.ReadFile(p)
+	if err == nil && string(existing) == string(content) {
+		return false, nil
+	}
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return false, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(p), os.ModePerm); err != nil {
+		return false, errors.Wrap(err, "creating parent directories")
+	}
+	if err := os.WriteFile(p, content, 0o644); err != nil {
+		return false, err
+	}
+	return true, nil
+}
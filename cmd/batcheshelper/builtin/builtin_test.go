@@ -0,0 +1,219 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+)
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name          string
+		files         map[string]string
+		step          batcheslib.BuiltinStep
+		expectedFiles map[string]string
+		expectedPaths []string
+		expectedErr   string
+	}{
+		{
+			name: "Regex replace",
+			files: map[string]string{
+				"main.go":     "data, err := ioutil.ReadFile(path)\n",
+				"sub/util.go": "ioutil.ReadFile(a)\nioutil.ReadFile(b)\n",
+				"README.md":   "ioutil.ReadFile\n",
+			},
+			step: batcheslib.BuiltinStep{RegexReplace: &batcheslib.BuiltinRegexReplace{
+				Paths:       []string{"**/*.go"},
+				Pattern:     `ioutil\.ReadFile\((\w+)\)`,
+				Replacement: "os.ReadFile($1)",
+			}},
+			expectedFiles: map[string]string{
+				"main.go":     "data, err := os.ReadFile(path)\n",
+				"sub/util.go": "os.ReadFile(a)\nos.ReadFile(b)\n",
+				"README.md":   "ioutil.ReadFile\n",
+			},
+			expectedPaths: []string{"main.go", "sub/util.go"},
+		},
+		{
+			name: "Regex replace skips binary files",
+			files: map[string]string{
+				"image.png": "foo\x00bar",
+			},
+			step: batcheslib.BuiltinStep{RegexReplace: &batcheslib.BuiltinRegexReplace{
+				Pattern:     "foo",
+				Replacement: "baz",
+			}},
+			expectedFiles: map[string]string{
+				"image.png": "foo\x00bar",
+			},
+		},
+		{
+			name: "Invalid regex",
+			step: batcheslib.BuiltinStep{RegexReplace: &batcheslib.BuiltinRegexReplace{
+				Pattern: "(",
+			}},
+			expectedErr: "compiling pattern: error parsing regexp: missing closing ): `(`",
+		},
+		{
+			name: "Structural replace",
+			files: map[string]string{
+				"main.go": "err := fmt.Sprintf(\"%s: %v\", f(a, b), err)\n",
+			},
+			step: batcheslib.BuiltinStep{StructuralReplace: &batcheslib.BuiltinStructuralReplace{
+				Pattern:     "fmt.Sprintf(:[format], :[args])",
+				Replacement: "fmt.Errorf(:[format], :[args])",
+			}},
+			expectedFiles: map[string]string{
+				"main.go": "err := fmt.Errorf(\"%s: %v\", f(a, b), err)\n",
+			},
+			expectedPaths: []string{"main.go"},
+		},
+		{
+			name: "Add file",
+			step: batcheslib.BuiltinStep{AddFile: &batcheslib.BuiltinAddFile{
+				Path:    ".github/CODEOWNERS",
+				Content: "* @sourcegraph/batchers\n",
+			}},
+			expectedFiles: map[string]string{
+				".github/CODEOWNERS": "* @sourcegraph/batchers\n",
+			},
+			expectedPaths: []string{".github/CODEOWNERS"},
+		},
+		{
+			name: "Add file with same content",
+			files: map[string]string{
+				"README.md": "Hello",
+			},
+			step: batcheslib.BuiltinStep{AddFile: &batcheslib.BuiltinAddFile{
+				Path:    "README.md",
+				Content: "Hello",
+			}},
+			expectedFiles: map[string]string{
+				"README.md": "Hello",
+			},
+		},
+		{
+			name: "Add file outside of workspace",
+			step: batcheslib.BuiltinStep{AddFile: &batcheslib.BuiltinAddFile{
+				Path:    "../outside",
+				Content: "Hello",
+			}},
+			expectedErr: `path "../outside" is not within the workspace`,
+		},
+		{
+			name: "Delete files",
+			files: map[string]string{
+				".travis.yml":    "language: go",
+				"a/b/main.orig":  "",
+				"a/b/main.go":    "",
+				"c/README.orig":  "",
+				"c/README.md":    "",
+				"d/.travis.yml/": "",
+			},
+			step: batcheslib.BuiltinStep{DeleteFile: &batcheslib.BuiltinDeleteFile{
+				Paths: []string{".travis.yml", "**/*.orig"},
+			}},
+			expectedFiles: map[string]string{
+				"a/b/main.go": "",
+				"c/README.md": "",
+			},
+			expectedPaths: []string{".travis.yml", "a/b/main.orig", "c/README.orig"},
+		},
+		{
+			name: "No transformation",
+			step: batcheslib.BuiltinStep{},
+
+			expectedErr: "builtin step has no transformation",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, test.files)
+
+			paths, err := Apply(context.Background(), dir, &test.step)
+			if test.expectedErr != "" {
+				require.Error(t, err)
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedPaths, paths)
+
+			for name, content := range test.expectedFiles {
+				b, err := os.ReadFile(filepath.Join(dir, name))
+				require.NoError(t, err)
+				assert.Equal(t, content, string(b), name)
+			}
+		})
+	}
+}
+
+func TestApply_Symlinks(t *testing.T) {
+	outside := t.TempDir()
+	writeFiles(t, outside, map[string]string{"secret.go": "ioutil.ReadFile(x)"})
+
+	dir := t.TempDir()
+	require.NoError(t, os.Symlink(filepath.Join(outside, "secret.go"), filepath.Join(dir, "link.go")))
+	require.NoError(t, os.Symlink(outside, filepath.Join(dir, "linkdir")))
+
+	// Files behind symbolic links are not matched.
+	paths, err := Apply(context.Background(), dir, &batcheslib.BuiltinStep{RegexReplace: &batcheslib.BuiltinRegexReplace{
+		Pattern:     "ioutil",
+		Replacement: "os",
+	}})
+	require.NoError(t, err)
+	assert.Empty(t, paths)
+
+	// Files can't be written through symbolic links.
+	_, err = Apply(context.Background(), dir, &batcheslib.BuiltinStep{AddFile: &batcheslib.BuiltinAddFile{
+		Path:    "linkdir/secret.go",
+		Content: "overwritten",
+	}})
+	assert.EqualError(t, err, `path "linkdir/secret.go" is a symbolic link`)
+
+	b, err := os.ReadFile(filepath.Join(outside, "secret.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "ioutil.ReadFile(x)", string(b))
+}
+
+func TestMatchGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{pattern: "*.go", name: "main.go", want: true},
+		{pattern: "*.go", name: "cmd/main.go", want: false},
+		{pattern: "**/*.go", name: "main.go", want: true},
+		{pattern: "**/*.go", name: "cmd/app/main.go", want: true},
+		{pattern: "cmd/**", name: "cmd/app/main.go", want: true},
+		{pattern: "cmd/**/main.go", name: "cmd/main.go", want: true},
+		{pattern: "cmd/**/main.go", name: "internal/main.go", want: false},
+		{pattern: "README.md", name: "docs/README.md", want: false},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, matchGlob(test.pattern, test.name), "pattern=%q name=%q", test.pattern, test.name)
+	}
+}
+
+// writeFiles creates the files in dir. Names ending in a slash are created as
+// directories.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		if name[len(name)-1] == '/' {
+			require.NoError(t, os.MkdirAll(p, os.ModePerm))
+			continue
+		}
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), os.ModePerm))
+		require.NoError(t, os.WriteFile(p, []byte(content), os.ModePerm))
+	}
+}
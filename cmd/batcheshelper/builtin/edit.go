@@ -0,0 +1,409 @@
+package builtin
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// indentPattern matches the indentation of the first indented line of a file.
+var indentPattern = regexp.MustCompile(`(?m)^([ \t]+)\S`)
+
+func jsonEdit(dir string, e *batcheslib.BuiltinPathEdit) ([]string, error) {
+	return editFile(dir, e, func(content []byte) ([]byte, error) {
+		var root any = jsonObject{}
+		if len(bytes.TrimSpace(content)) > 0 {
+			dec := json.NewDecoder(bytes.NewReader(content))
+			dec.UseNumber()
+			var err error
+			if root, err = decodeJSON(dec); err != nil {
+				return nil, errors.Wrap(err, "parsing JSON")
+			}
+		}
+
+		keys := strings.Split(e.Key, ".")
+		var err error
+		if e.Delete {
+			root, err = deleteJSON(root, keys)
+		} else {
+			root, err = setJSON(root, keys, e.Value)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		indent := "  "
+		if m := indentPattern.FindSubmatch(content); m != nil {
+			indent = string(m[1])
+		}
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", indent)
+		if err := enc.Encode(root); err != nil {
+			return nil, err
+		}
+		return keepTrailingNewline(content, buf.Bytes()), nil
+	})
+}
+
+func yamlEdit(dir string, e *batcheslib.BuiltinPathEdit) ([]string, error) {
+	return editFile(dir, e, func(content []byte) ([]byte, error) {
+		// Only the first document of a multi-document file is edited, but all
+		// of them are written back.
+		var docs []*yaml.Node
+		dec := yaml.NewDecoder(bytes.NewReader(content))
+		for {
+			var doc yaml.Node
+			if err := dec.Decode(&doc); err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, errors.Wrap(err, "parsing YAML")
+			}
+			docs = append(docs, &doc)
+		}
+		if len(docs) == 0 {
+			docs = append(docs, &yaml.Node{Kind: yaml.DocumentNode})
+		}
+		if len(docs[0].Content) == 0 {
+			docs[0].Content = []*yaml.Node{{Kind: yaml.MappingNode}}
+		}
+
+		keys := strings.Split(e.Key, ".")
+		if e.Delete {
+			if err := deleteYAML(docs[0].Content[0], keys); err != nil {
+				return nil, err
+			}
+		} else {
+			var value yaml.Node
+			if err := value.Encode(e.Value); err != nil {
+				return nil, errors.Wrap(err, "encoding value")
+			}
+			if err := setYAML(docs[0].Content[0], keys, &value); err != nil {
+				return nil, err
+			}
+		}
+
+		indent := 2
+		if m := indentPattern.FindSubmatch(content); m != nil && len(m[1]) > indent {
+			indent = len(m[1])
+		}
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(indent)
+		for _, doc := range docs {
+			if err := enc.Encode(doc); err != nil {
+				return nil, err
+			}
+		}
+		if err := enc.Close(); err != nil {
+			return nil, err
+		}
+		return keepTrailingNewline(content, buf.Bytes()), nil
+	})
+}
+
+// editFile calls edit with the content of the file of e, which is empty if it
+// doesn't exist, and writes back what it returns.
+func editFile(dir string, e *batcheslib.BuiltinPathEdit, edit func(content []byte) ([]byte, error)) ([]string, error) {
+	if e.Key == "" {
+		return nil, errors.New("key must not be empty")
+	}
+
+	p, err := localPath(dir, e.Path)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(p)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	edited, err := edit(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "editing %s", e.Path)
+	}
+	changed, err := writeFile(p, edited)
+	if err != nil {
+		return nil, errors.Wrapf(err, "writing %s", e.Path)
+	}
+	if !changed {
+		return nil, nil
+	}
+	return []string{filepath.ToSlash(filepath.Clean(e.Path))}, nil
+}
+
+// keepTrailingNewline removes the trailing newline of edited if the original,
+// non-empty content doesn't end with one.
+func keepTrailingNewline(original, edited []byte) []byte {
+	if len(original) > 0 && !bytes.HasSuffix(original, []byte("\n")) {
+		return bytes.TrimSuffix(edited, []byte("\n"))
+	}
+	return edited
+}
+
+// jsonObject is a JSON object that keeps the order of its members, so that
+// editing a file only changes the edited value.
+type jsonObject []jsonMember
+
+type jsonMember struct {
+	key   string
+	value any
+}
+
+func (o jsonObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	buf.WriteByte('{')
+	for i, m := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := enc.Encode(m.key); err != nil {
+			return nil, err
+		}
+		buf.WriteByte(':')
+		if err := enc.Encode(m.value); err != nil {
+			return nil, err
+		}
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// decodeJSON decodes the next value of dec, using jsonObject for objects.
+func decodeJSON(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		obj := jsonObject{}
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeJSON(dec)
+			if err != nil {
+				return nil, err
+			}
+			obj = append(obj, jsonMember{key: key.(string), value: value})
+		}
+		// Consume the closing delimiter.
+		_, err = dec.Token()
+		return obj, err
+
+	case json.Delim('['):
+		arr := []any{}
+		for dec.More() {
+			value, err := decodeJSON(dec)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, value)
+		}
+		_, err = dec.Token()
+		return arr, err
+
+	default:
+		return tok, nil
+	}
+}
+
+// setJSON sets the value at keys in node and returns the updated node.
+// Missing objects along keys are created.
+func setJSON(node any, keys []string, value any) (any, error) {
+	if len(keys) == 0 {
+		return value, nil
+	}
+
+	switch n := node.(type) {
+	case nil:
+		return setJSON(jsonObject{}, keys, value)
+
+	case jsonObject:
+		for i := range n {
+			if n[i].key == keys[0] {
+				v, err := setJSON(n[i].value, keys[1:], value)
+				if err != nil {
+					return nil, err
+				}
+				n[i].value = v
+				return n, nil
+			}
+		}
+		v, err := setJSON(nil, keys[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		return append(n, jsonMember{key: keys[0], value: v}), nil
+
+	case []any:
+		idx, err := arrayIndex(keys[0], len(n))
+		if err != nil {
+			return nil, err
+		}
+		v, err := setJSON(n[idx], keys[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		n[idx] = v
+		return n, nil
+
+	default:
+		return nil, errors.Newf("cannot set key %q of a value that is not an object or array", keys[0])
+	}
+}
+
+// deleteJSON deletes the value at keys in node and returns the updated node.
+// Deleting a missing key is a no-op.
+func deleteJSON(node any, keys []string) (any, error) {
+	switch n := node.(type) {
+	case jsonObject:
+		for i := range n {
+			if n[i].key != keys[0] {
+				continue
+			}
+			if len(keys) == 1 {
+				return append(n[:i], n[i+1:]...), nil
+			}
+			v, err := deleteJSON(n[i].value, keys[1:])
+			if err != nil {
+				return nil, err
+			}
+			n[i].value = v
+			return n, nil
+		}
+		return n, nil
+
+	case []any:
+		idx, err := arrayIndex(keys[0], len(n))
+		if err != nil {
+			return nil, err
+		}
+		if len(keys) == 1 {
+			return append(n[:idx], n[idx+1:]...), nil
+		}
+		v, err := deleteJSON(n[idx], keys[1:])
+		if err != nil {
+			return nil, err
+		}
+		n[idx] = v
+		return n, nil
+
+	default:
+		return node, nil
+	}
+}
+
+// setYAML sets the value at keys in node. Missing mappings along keys are
+// created.
+func setYAML(node *yaml.Node, keys []string, value *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!null" {
+		// An empty value, e.g. "key:", becomes a mapping.
+		*node = yaml.Node{Kind: yaml.MappingNode, LineComment: node.LineComment}
+	}
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value != keys[0] {
+				continue
+			}
+			if len(keys) == 1 {
+				setYAMLValue(node.Content[i+1], value)
+				return nil
+			}
+			return setYAML(node.Content[i+1], keys[1:], value)
+		}
+
+		key := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: keys[0]}
+		child := value
+		if len(keys) > 1 {
+			child = &yaml.Node{Kind: yaml.MappingNode}
+			if err := setYAML(child, keys[1:], value); err != nil {
+				return err
+			}
+		}
+		node.Content = append(node.Content, key, child)
+		return nil
+
+	case yaml.SequenceNode:
+		idx, err := arrayIndex(keys[0], len(node.Content))
+		if err != nil {
+			return err
+		}
+		if len(keys) == 1 {
+			setYAMLValue(node.Content[idx], value)
+			return nil
+		}
+		return setYAML(node.Content[idx], keys[1:], value)
+
+	default:
+		return errors.Newf("cannot set key %q of a value that is not a mapping or sequence", keys[0])
+	}
+}
+
+// setYAMLValue replaces node with value, keeping the comments of node.
+func setYAMLValue(node, value *yaml.Node) {
+	head, line, foot := node.HeadComment, node.LineComment, node.FootComment
+	*node = *value
+	node.HeadComment, node.LineComment, node.FootComment = head, line, foot
+}
+
+// deleteYAML deletes the value at keys in node. Deleting a missing key is a
+// no-op.
+func deleteYAML(node *yaml.Node, keys []string) error {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value != keys[0] {
+				continue
+			}
+			if len(keys) == 1 {
+				node.Content = append(node.Content[:i], node.Content[i+2:]...)
+				return nil
+			}
+			return deleteYAML(node.Content[i+1], keys[1:])
+		}
+		return nil
+
+	case yaml.SequenceNode:
+		idx, err := arrayIndex(keys[0], len(node.Content))
+		if err != nil {
+			return err
+		}
+		if len(keys) == 1 {
+			node.Content = append(node.Content[:idx], node.Content[idx+1:]...)
+			return nil
+		}
+		return deleteYAML(node.Content[idx], keys[1:])
+
+	default:
+		return nil
+	}
+}
+
+func arrayIndex(key string, length int) (int, error) {
+	idx, err := strconv.Atoi(key)
+	if err != nil || idx < 0 || idx >= length {
+		return 0, errors.Newf("invalid index %q for an array of length %d", key, length)
+	}
+	return idx, nil
+}
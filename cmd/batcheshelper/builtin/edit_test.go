@@ -0,0 +1,242 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+)
+
+func TestJSONEdit(t *testing.T) {
+	const packageJSON = `{
+    "name": "app",
+    "scripts": {
+        "test": "jest",
+        "url": "https://example.com/?a=1&b=2"
+    },
+    "files": ["dist", "lib"],
+    "version": 1.0
+}
+`
+
+	tests := []struct {
+		name        string
+		content     string
+		edit        batcheslib.BuiltinPathEdit
+		expected    string
+		expectedErr string
+	}{
+		{
+			name:    "Set existing key",
+			content: packageJSON,
+			edit:    batcheslib.BuiltinPathEdit{Key: "scripts.test", Value: "vitest"},
+			expected: `{
+    "name": "app",
+    "scripts": {
+        "test": "vitest",
+        "url": "https://example.com/?a=1&b=2"
+    },
+    "files": [
+        "dist",
+        "lib"
+    ],
+    "version": 1.0
+}
+`,
+		},
+		{
+			name:    "Set new nested key",
+			content: `{"name": "app"}`,
+			edit:    batcheslib.BuiltinPathEdit{Key: "engines.node", Value: ">=18"},
+			expected: `{
+  "name": "app",
+  "engines": {
+    "node": ">=18"
+  }
+}`,
+		},
+		{
+			name:    "Set array element",
+			content: packageJSON,
+			edit:    batcheslib.BuiltinPathEdit{Key: "files.1", Value: map[string]any{"path": "lib"}},
+			expected: `{
+    "name": "app",
+    "scripts": {
+        "test": "jest",
+        "url": "https://example.com/?a=1&b=2"
+    },
+    "files": [
+        "dist",
+        {
+            "path": "lib"
+        }
+    ],
+    "version": 1.0
+}
+`,
+		},
+		{
+			name:    "Delete key",
+			content: packageJSON,
+			edit:    batcheslib.BuiltinPathEdit{Key: "scripts", Delete: true},
+			expected: `{
+    "name": "app",
+    "files": [
+        "dist",
+        "lib"
+    ],
+    "version": 1.0
+}
+`,
+		},
+		{
+			name: "New file",
+			edit: batcheslib.BuiltinPathEdit{Key: "compilerOptions.strict", Value: true},
+			expected: `{
+  "compilerOptions": {
+    "strict": true
+  }
+}
+`,
+		},
+		{
+			name:        "Set key of a string",
+			content:     packageJSON,
+			edit:        batcheslib.BuiltinPathEdit{Key: "name.first", Value: "app"},
+			expectedErr: `editing file.json: cannot set key "first" of a value that is not an object or array`,
+		},
+		{
+			name:        "Index out of range",
+			content:     packageJSON,
+			edit:        batcheslib.BuiltinPathEdit{Key: "files.2", Value: "src"},
+			expectedErr: `editing file.json: invalid index "2" for an array of length 2`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if test.content != "" {
+				writeFiles(t, dir, map[string]string{"file.json": test.content})
+			}
+
+			test.edit.Path = "file.json"
+			paths, err := Apply(context.Background(), dir, &batcheslib.BuiltinStep{JSONEdit: &test.edit})
+			if test.expectedErr != "" {
+				require.Error(t, err)
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []string{"file.json"}, paths)
+
+			b, err := os.ReadFile(filepath.Join(dir, "file.json"))
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, string(b))
+		})
+	}
+}
+
+func TestYAMLEdit(t *testing.T) {
+	const workflow = `name: CI
+on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest # The runner.
+    steps:
+      - uses: actions/checkout@v3
+      - run: make test
+`
+
+	tests := []struct {
+		name     string
+		content  string
+		edit     batcheslib.BuiltinPathEdit
+		expected string
+	}{
+		{
+			name:    "Set existing key",
+			content: workflow,
+			edit:    batcheslib.BuiltinPathEdit{Key: "jobs.build.runs-on", Value: "ubuntu-22.04"},
+			expected: `name: CI
+on: push
+jobs:
+  build:
+    runs-on: ubuntu-22.04 # The runner.
+    steps:
+      - uses: actions/checkout@v3
+      - run: make test
+`,
+		},
+		{
+			name:    "Set sequence element",
+			content: workflow,
+			edit:    batcheslib.BuiltinPathEdit{Key: "jobs.build.steps.0.uses", Value: "actions/checkout@v4"},
+			expected: `name: CI
+on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest # The runner.
+    steps:
+      - uses: actions/checkout@v4
+      - run: make test
+`,
+		},
+		{
+			name:    "Set new nested key",
+			content: workflow,
+			edit:    batcheslib.BuiltinPathEdit{Key: "jobs.build.env.CGO_ENABLED", Value: "0"},
+			expected: `name: CI
+on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest # The runner.
+    steps:
+      - uses: actions/checkout@v3
+      - run: make test
+    env:
+      CGO_ENABLED: "0"
+`,
+		},
+		{
+			name:    "Delete key",
+			content: workflow,
+			edit:    batcheslib.BuiltinPathEdit{Key: "jobs.build.steps.1", Delete: true},
+			expected: `name: CI
+on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest # The runner.
+    steps:
+      - uses: actions/checkout@v3
+`,
+		},
+		{
+			name: "New file",
+			edit: batcheslib.BuiltinPathEdit{Key: "version", Value: 2},
+			expected: `version: 2
+`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if test.content != "" {
+				writeFiles(t, dir, map[string]string{"file.yml": test.content})
+			}
+
+			test.edit.Path = "file.yml"
+			paths, err := Apply(context.Background(), dir, &batcheslib.BuiltinStep{YAMLEdit: &test.edit})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"file.yml"}, paths)
+
+			b, err := os.ReadFile(filepath.Join(dir, "file.yml"))
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, string(b))
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func addFile(dir string, f *batcheslib.BuiltinAddFile) ([]string, error) {
+	p, err := localPath(dir, f.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := writeFile(p, []byte(f.Content))
+	if err != nil {
+		return nil, errors.Wrapf(err, "writing %s", f.Path)
+	}
+	if !changed {
+		return nil, nil
+	}
+	return []string{filepath.ToSlash(filepath.Clean(f.Path))}, nil
+}
+
+func deleteFiles(ctx context.Context, dir string, f *batcheslib.BuiltinDeleteFile) ([]string, error) {
+	// Without paths, matchFiles would match every file.
+	if len(f.Paths) == 0 {
+		return nil, errors.New("deleteFile requires at least one path")
+	}
+
+	names, err := matchFiles(ctx, dir, f.Paths)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range names {
+		if err := os.Remove(filepath.Join(dir, filepath.FromSlash(name))); err != nil {
+			return nil, errors.Wrapf(err, "deleting %s", name)
+		}
+	}
+	return names, nil
+}
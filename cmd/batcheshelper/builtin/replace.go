@@ -0,0 +1,85 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/sourcegraph/sourcegraph/internal/comby"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func regexReplace(ctx context.Context, dir string, r *batcheslib.BuiltinRegexReplace) ([]string, error) {
+	re, err := regexp.Compile(r.Pattern)
+	if err != nil {
+		return nil, errors.Wrap(err, "compiling pattern")
+	}
+
+	return replaceInFiles(ctx, dir, r.Paths, func(_ string, content []byte) ([]byte, error) {
+		return re.ReplaceAll(content, []byte(r.Replacement)), nil
+	})
+}
+
+func structuralReplace(ctx context.Context, dir string, r *batcheslib.BuiltinStructuralReplace) ([]string, error) {
+	// Matchers are created per language, which is the extension of the file
+	// unless the step sets one.
+	matchers := map[string]*comby.Matcher{}
+	return replaceInFiles(ctx, dir, r.Paths, func(name string, content []byte) ([]byte, error) {
+		language := r.Matcher
+		if language == "" {
+			language = filepath.Ext(name)
+		}
+		m, ok := matchers[language]
+		if !ok {
+			var err error
+			if m, err = comby.NewMatcher(r.Pattern, "", language); err != nil {
+				return nil, errors.Wrap(err, "parsing pattern")
+			}
+			matchers[language] = m
+		}
+
+		rewritten, _ := m.Rewrite(content, r.Replacement)
+		return rewritten, nil
+	})
+}
+
+// binarySniffLen is the number of leading bytes that are checked for NUL bytes
+// to detect binary files, like git does.
+const binarySniffLen = 8000
+
+// replaceInFiles calls replace with the content of each text file matching
+// paths and writes back what it returns.
+func replaceInFiles(ctx context.Context, dir string, paths []string, replace func(name string, content []byte) ([]byte, error)) ([]string, error) {
+	names, err := matchFiles(ctx, dir, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []string
+	for _, name := range names {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		content, err := os.ReadFile(p)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.IndexByte(content[:min(len(content), binarySniffLen)], 0) >= 0 {
+			continue
+		}
+
+		replaced, err := replace(name, content)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(content, replaced) {
+			continue
+		}
+		if err := os.WriteFile(p, replaced, 0o644); err != nil {
+			return nil, errors.Wrapf(err, "writing %s", name)
+		}
+		changed = append(changed, name)
+	}
+	return changed, nil
+}
@@ -2,19 +2,15 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
-	"path/filepath"
 	"strconv"
 
 	"github.com/sourcegraph/sourcegraph/cmd/batcheshelper/log"
 	"github.com/sourcegraph/sourcegraph/cmd/batcheshelper/run"
 	"github.com/sourcegraph/sourcegraph/cmd/batcheshelper/util"
 	"github.com/sourcegraph/sourcegraph/internal/sanitycheck"
-	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
-	"github.com/sourcegraph/sourcegraph/lib/batches/execution"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -56,12 +52,12 @@ func doMain() error {
 		return err
 	}
 
-	executionInput, err := parseInput(*inputPath)
+	executionInput, err := util.ParseInput(*inputPath)
 	if err != nil {
 		return err
 	}
 
-	previousResult, err := parsePreviousStepResult(*previousPath, arguments.step)
+	previousResult, err := util.ParsePreviousStepResult(*previousPath, arguments.step)
 	if err != nil {
 		return err
 	}
@@ -76,20 +72,22 @@ func doMain() error {
 	ctx := context.Background()
 	switch arguments.mode {
 	case "pre":
-		return run.Pre(ctx, logger, arguments.step, executionInput, previousResult, wd, *workspaceFilesPath)
+		return run.Pre(ctx, logger, arguments.step, executionInput, previousResult, wd, *workspaceFilesPath, os.Environ())
+	case "builtin":
+		return run.Builtin(ctx, os.Stdout, arguments.step, executionInput, previousResult, wd)
 	case "post":
 		addSafe, err := getAddSafe()
 		if err != nil {
 			return err
 		}
-		return run.Post(ctx, logger, &util.RealCmdRunner{}, arguments.step, executionInput, previousResult, wd, *workspaceFilesPath, addSafe)
+		return run.Post(ctx, logger, &util.RealCmdRunner{}, arguments.step, executionInput, previousResult, wd, *workspaceFilesPath, addSafe, os.Environ())
 	default:
 		return errors.Newf("invalid mode %q", arguments.mode)
 	}
 }
 
 func usage() {
-	fmt.Fprintf(os.Stderr, "Usage: %s <pre|post> <step index> [OPTIONS]\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "Usage: %s <pre|builtin|post> <step index> [OPTIONS]\n", os.Args[0])
 	fmt.Fprintf(os.Stderr, "OPTIONS:\n")
 	flag.PrintDefaults()
 }
@@ -102,7 +100,7 @@ func parseArgs(arguments []string) (args, error) {
 	}
 
 	mode := arguments[0]
-	if mode != "pre" && mode != "post" {
+	if mode != "pre" && mode != "builtin" && mode != "post" {
 		return args{}, errors.Newf("invalid mode %q", mode)
 	}
 
@@ -119,49 +117,6 @@ type args struct {
 	step int
 }
 
-func parseInput(inputPath string) (batcheslib.WorkspacesExecutionInput, error) {
-	var executionInput batcheslib.WorkspacesExecutionInput
-
-	input, err := os.ReadFile(inputPath)
-	if err != nil {
-		return executionInput, errors.Wrapf(err, "failed to read execution input file %q", inputPath)
-	}
-
-	if err = json.Unmarshal(input, &executionInput); err != nil {
-		return executionInput, errors.Wrap(err, "failed to unmarshal execution input")
-	}
-	return executionInput, nil
-}
-
-func parsePreviousStepResult(path string, step int) (execution.AfterStepResult, error) {
-	if step > 0 {
-		// Read the previous step's result file.
-		return getPreviouslyExecutedStep(path, step-1)
-	}
-	return execution.AfterStepResult{}, nil
-}
-
-func getPreviouslyExecutedStep(path string, previousStep int) (execution.AfterStepResult, error) {
-	for i := previousStep; i >= 0; i-- {
-		var previousResult execution.AfterStepResult
-		stepResultPath := filepath.Join(path, util.StepJSONFile(i))
-		stepJSON, err := os.ReadFile(stepResultPath)
-		if err != nil {
-			if os.IsNotExist(err) {
-				continue
-			}
-			return previousResult, errors.Wrap(err, "failed to read step result file")
-		}
-		if err = json.Unmarshal(stepJSON, &previousResult); err != nil {
-			return previousResult, errors.Wrap(err, "failed to unmarshal step result file")
-		}
-		if !previousResult.Skipped {
-			return previousResult, nil
-		}
-	}
-	return execution.AfterStepResult{}, nil
-}
-
 func getAddSafe() (bool, error) {
 	addSafeString := os.Getenv("EXECUTOR_ADD_SAFE")
 	// Default to true for backwards compatibility.
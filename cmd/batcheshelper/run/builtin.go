@@ -0,0 +1,122 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/sourcegraph/sourcegraph/cmd/batcheshelper/builtin"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/batches/execution"
+	"github.com/sourcegraph/sourcegraph/lib/batches/template"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Builtin applies the transformation of a builtin Batch Change step to the
+// workspace. It takes the place of running the step in a container, so like
+// the container it writes the stdout and stderr files that Post reads. The
+// stdout of the step lists the changed files.
+func Builtin(
+	ctx context.Context,
+	stdout io.Writer,
+	stepIdx int,
+	executionInput batcheslib.WorkspacesExecutionInput,
+	previousResult execution.AfterStepResult,
+	workingDirectory string,
+) error {
+	step := executionInput.Steps[stepIdx]
+	if step.Builtin == nil {
+		return errors.Newf("step %d is not a builtin step", stepIdx+1)
+	}
+
+	stepContext, err := getStepContext(executionInput, previousResult)
+	if err != nil {
+		return err
+	}
+	builtinStep, err := renderBuiltinStep(step.Builtin, &stepContext)
+	if err != nil {
+		return err
+	}
+
+	var stepStdout, stepStderr bytes.Buffer
+	dir := filepath.Join(workingDirectory, gitDir, filepath.FromSlash(executionInput.Path))
+	changed, applyErr := builtin.Apply(ctx, dir, builtinStep)
+	if applyErr != nil {
+		applyErr = errors.Wrapf(applyErr, "failed to apply %s", builtinStep.Name())
+		fmt.Fprintln(&stepStderr, applyErr)
+	}
+	for _, path := range changed {
+		fmt.Fprintln(&stepStdout, path)
+	}
+
+	if err = os.WriteFile(filepath.Join(workingDirectory, fmt.Sprintf("stdout%d.log", stepIdx)), stepStdout.Bytes(), os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed to write stdout file")
+	}
+	if err = os.WriteFile(filepath.Join(workingDirectory, fmt.Sprintf("stderr%d.log", stepIdx)), stepStderr.Bytes(), os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed to write stderr file")
+	}
+	if _, err = stdout.Write(stepStdout.Bytes()); err != nil {
+		return err
+	}
+
+	return applyErr
+}
+
+// renderBuiltinStep returns a copy of the step with the templates in its
+// replacements, contents and string values rendered.
+func renderBuiltinStep(step *batcheslib.BuiltinStep, stepContext *template.StepContext) (*batcheslib.BuiltinStep, error) {
+	render := func(tmpl string) (string, error) {
+		var out bytes.Buffer
+		if err := template.RenderStepTemplate("step-builtin", tmpl, &out, stepContext); err != nil {
+			return "", errors.Wrapf(err, "failed to render step.builtin.%s", step.Name())
+		}
+		return out.String(), nil
+	}
+
+	rendered := *step
+	var err error
+	switch {
+	case step.RegexReplace != nil:
+		r := *step.RegexReplace
+		if r.Replacement, err = render(r.Replacement); err != nil {
+			return nil, err
+		}
+		rendered.RegexReplace = &r
+	case step.StructuralReplace != nil:
+		r := *step.StructuralReplace
+		if r.Replacement, err = render(r.Replacement); err != nil {
+			return nil, err
+		}
+		rendered.StructuralReplace = &r
+	case step.AddFile != nil:
+		f := *step.AddFile
+		if f.Content, err = render(f.Content); err != nil {
+			return nil, err
+		}
+		rendered.AddFile = &f
+	case step.JSONEdit != nil:
+		if rendered.JSONEdit, err = renderPathEdit(step.JSONEdit, render); err != nil {
+			return nil, err
+		}
+	case step.YAMLEdit != nil:
+		if rendered.YAMLEdit, err = renderPathEdit(step.YAMLEdit, render); err != nil {
+			return nil, err
+		}
+	}
+	return &rendered, nil
+}
+
+func renderPathEdit(e *batcheslib.BuiltinPathEdit, render func(string) (string, error)) (*batcheslib.BuiltinPathEdit, error) {
+	rendered := *e
+	if value, ok := e.Value.(string); ok {
+		v, err := render(value)
+		if err != nil {
+			return nil, err
+		}
+		rendered.Value = v
+	}
+	return &rendered, nil
+}
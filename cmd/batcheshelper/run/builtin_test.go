@@ -0,0 +1,128 @@
+package run_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/batcheshelper/run"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/batches/execution"
+)
+
+func TestBuiltin(t *testing.T) {
+	tests := []struct {
+		name           string
+		step           int
+		executionInput batcheslib.WorkspacesExecutionInput
+		expectedErr    string
+		expectedStdout string
+		expectedStderr string
+		expectedFiles  map[string]string
+	}{
+		{
+			name: "Success",
+			executionInput: batcheslib.WorkspacesExecutionInput{
+				Repository: batcheslib.WorkspaceRepo{Name: "github.com/sourcegraph/sourcegraph"},
+				Steps: []batcheslib.Step{
+					{Builtin: &batcheslib.BuiltinStep{RegexReplace: &batcheslib.BuiltinRegexReplace{
+						Paths:       []string{"*.md"},
+						Pattern:     "REPO",
+						Replacement: "${{ repository.name }}",
+					}}},
+				},
+			},
+			expectedStdout: "README.md\n",
+			expectedFiles: map[string]string{
+				"repository/README.md":        "# github.com/sourcegraph/sourcegraph",
+				"repository/sub/README.md":    "# REPO",
+				"repository/sub/CODEOWNERS":   "REPO",
+				"repository/sub/docs/home.md": "REPO",
+			},
+		},
+		{
+			name: "Workspace path",
+			executionInput: batcheslib.WorkspacesExecutionInput{
+				Path: "sub",
+				Steps: []batcheslib.Step{
+					{Builtin: &batcheslib.BuiltinStep{DeleteFile: &batcheslib.BuiltinDeleteFile{
+						Paths: []string{"**/*.md"},
+					}}},
+				},
+			},
+			expectedStdout: "README.md\ndocs/home.md\n",
+			expectedFiles: map[string]string{
+				"repository/README.md":      "# REPO",
+				"repository/sub/CODEOWNERS": "REPO",
+			},
+		},
+		{
+			name: "Transformation fails",
+			executionInput: batcheslib.WorkspacesExecutionInput{
+				Steps: []batcheslib.Step{
+					{Builtin: &batcheslib.BuiltinStep{RegexReplace: &batcheslib.BuiltinRegexReplace{
+						Pattern: "(",
+					}}},
+				},
+			},
+			expectedErr:    "failed to apply regexReplace: compiling pattern: error parsing regexp: missing closing ): `(`",
+			expectedStderr: "failed to apply regexReplace: compiling pattern: error parsing regexp: missing closing ): `(`\n",
+		},
+		{
+			name: "Not a builtin step",
+			executionInput: batcheslib.WorkspacesExecutionInput{
+				Steps: []batcheslib.Step{
+					{Run: "echo hello", Container: "alpine:3"},
+				},
+			},
+			expectedErr: "step 1 is not a builtin step",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range map[string]string{
+				"README.md":        "# REPO",
+				"sub/README.md":    "# REPO",
+				"sub/CODEOWNERS":   "REPO",
+				"sub/docs/home.md": "REPO",
+			} {
+				p := filepath.Join(dir, "repository", name)
+				require.NoError(t, os.MkdirAll(filepath.Dir(p), os.ModePerm))
+				require.NoError(t, os.WriteFile(p, []byte(content), os.ModePerm))
+			}
+
+			var stdout bytes.Buffer
+			err := run.Builtin(context.Background(), &stdout, test.step, test.executionInput, execution.AfterStepResult{}, dir)
+			if test.expectedErr != "" {
+				require.Error(t, err)
+				assert.EqualError(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			if test.expectedErr != "" && test.expectedStderr == "" {
+				// The step failed before the transformation was applied.
+				return
+			}
+
+			assert.Equal(t, test.expectedStdout, stdout.String())
+			b, err := os.ReadFile(filepath.Join(dir, "stdout0.log"))
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedStdout, string(b))
+			b, err = os.ReadFile(filepath.Join(dir, "stderr0.log"))
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedStderr, string(b))
+
+			for name, content := range test.expectedFiles {
+				b, err := os.ReadFile(filepath.Join(dir, name))
+				require.NoError(t, err)
+				assert.Equal(t, content, string(b), name)
+			}
+		})
+	}
+}
@@ -21,7 +21,8 @@ const (
 	gitDir = "repository"
 )
 
-// Post processes the workspace after the Batch Change step.
+// Post processes the workspace after the Batch Change step. The environ is the
+// environment the step ran in, in the form of os.Environ.
 func Post(
 	ctx context.Context,
 	logger *log.Logger,
@@ -32,6 +33,7 @@ func Post(
 	workingDirectory string,
 	workspaceFilesPath string,
 	addSafe bool,
+	environ []string,
 ) error {
 	if addSafe {
 		// Sometimes the files belong to different users. Mark the repository directory as safe.
@@ -135,7 +137,7 @@ func Post(
 			FileMatches: executionInput.SearchResultPaths,
 		},
 		executionInput.Path,
-		os.Environ(),
+		environ,
 		executionInput.OnlyFetchWorkspace,
 		executionInput.Steps,
 		stepIdx,
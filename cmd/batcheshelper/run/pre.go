@@ -22,7 +22,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
-// Pre prepares the workspace for the Batch Change step.
+// Pre prepares the workspace for the Batch Change step. The environ is the
+// environment the step runs in, in the form of os.Environ.
 func Pre(
 	ctx context.Context,
 	logger *log.Logger,
@@ -31,10 +32,11 @@ func Pre(
 	previousResult execution.AfterStepResult,
 	workingDirectory string,
 	workspaceFilesPath string,
+	environ []string,
 ) error {
 	// Resolve step.Env given the current environment.
 	step := executionInput.Steps[stepIdx]
-	stepEnv, err := step.Env.Resolve(os.Environ())
+	stepEnv, err := step.Env.Resolve(environ)
 	if err != nil {
 		return errors.Wrap(err, "failed to resolve step env")
 	}
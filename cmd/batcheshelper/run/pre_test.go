@@ -235,7 +235,7 @@ func TestPre(t *testing.T) {
 				test.setupFunc(t, dir, test.executionInput)
 			}
 
-			err := run.Pre(context.Background(), logger, test.step, test.executionInput, test.previousResult, dir, dir)
+			err := run.Pre(context.Background(), logger, test.step, test.executionInput, test.previousResult, dir, dir, os.Environ())
 
 			if test.expectedErr != nil {
 				require.Error(t, err)
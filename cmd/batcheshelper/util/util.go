@@ -8,6 +8,8 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/executor/types"
 	executorutil "github.com/sourcegraph/sourcegraph/internal/executor/util"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/batches/execution"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -34,3 +36,49 @@ func WriteSkipFile(workingDirectory string, nextStep int) error {
 	}
 	return nil
 }
+
+// ParseInput reads the execution input of the workspace from the file at inputPath.
+func ParseInput(inputPath string) (batcheslib.WorkspacesExecutionInput, error) {
+	var executionInput batcheslib.WorkspacesExecutionInput
+
+	input, err := os.ReadFile(inputPath)
+	if err != nil {
+		return executionInput, errors.Wrapf(err, "failed to read execution input file %q", inputPath)
+	}
+
+	if err = json.Unmarshal(input, &executionInput); err != nil {
+		return executionInput, errors.Wrap(err, "failed to unmarshal execution input")
+	}
+	return executionInput, nil
+}
+
+// ParsePreviousStepResult reads the result of the last step before the given step that was not skipped from the
+// step result files in path.
+func ParsePreviousStepResult(path string, step int) (execution.AfterStepResult, error) {
+	if step > 0 {
+		// Read the previous step's result file.
+		return getPreviouslyExecutedStep(path, step-1)
+	}
+	return execution.AfterStepResult{}, nil
+}
+
+func getPreviouslyExecutedStep(path string, previousStep int) (execution.AfterStepResult, error) {
+	for i := previousStep; i >= 0; i-- {
+		var previousResult execution.AfterStepResult
+		stepResultPath := filepath.Join(path, StepJSONFile(i))
+		stepJSON, err := os.ReadFile(stepResultPath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return previousResult, errors.Wrap(err, "failed to read step result file")
+		}
+		if err = json.Unmarshal(stepJSON, &previousResult); err != nil {
+			return previousResult, errors.Wrap(err, "failed to unmarshal step result file")
+		}
+		if !previousResult.Skipped {
+			return previousResult, nil
+		}
+	}
+	return execution.AfterStepResult{}, nil
+}
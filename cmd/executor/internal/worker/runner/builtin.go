@@ -0,0 +1,94 @@
+package runner
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/cmd/batcheshelper/log"
+	"github.com/sourcegraph/sourcegraph/cmd/batcheshelper/run"
+	batcheshelperutil "github.com/sourcegraph/sourcegraph/cmd/batcheshelper/util"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/cmdlogger"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// runBuiltin runs the batcheshelper mode of a builtin batch spec step in the
+// executor process, against the workspace in dir. The log entry looks like the
+// one of the helper container, so the step results are picked up the same way.
+func runBuiltin(ctx context.Context, logger cmdlogger.Logger, dir string, spec Spec) error {
+	commandSpec := spec.CommandSpecs[0]
+	logEntry := logger.LogEntry(commandSpec.Key, []string{"batcheshelper", spec.Builtin.Mode, strconv.Itoa(spec.Builtin.Step)})
+	defer logEntry.Close()
+
+	var stdout bytes.Buffer
+	err := runBuiltinMode(ctx, &stdout, dir, spec.Builtin.Mode, spec.Builtin.Step, commandSpec.Env)
+	if writeErr := writeLogLines(logEntry, "stdout", &stdout); writeErr != nil {
+		err = errors.Append(err, writeErr)
+	}
+	if err != nil {
+		if writeErr := writeLogLines(logEntry, "stderr", bytes.NewBufferString(err.Error())); writeErr != nil {
+			err = errors.Append(err, writeErr)
+		}
+		logEntry.Finalize(1)
+		return errors.Wrapf(err, "builtin step %s failed", commandSpec.Key)
+	}
+	logEntry.Finalize(0)
+
+	return nil
+}
+
+func runBuiltinMode(ctx context.Context, stdout io.Writer, dir string, mode string, step int, environ []string) error {
+	executionInput, err := batcheshelperutil.ParseInput(filepath.Join(dir, "input.json"))
+	if err != nil {
+		return err
+	}
+	previousResult, err := batcheshelperutil.ParsePreviousStepResult(dir, step)
+	if err != nil {
+		return err
+	}
+
+	logger := &log.Logger{Writer: stdout}
+	workspaceFilesPath := filepath.Join(dir, "workspace-files")
+
+	switch mode {
+	case "pre":
+		return run.Pre(ctx, logger, step, executionInput, previousResult, dir, workspaceFilesPath, environ)
+	case "builtin":
+		return run.Builtin(ctx, stdout, step, executionInput, previousResult, dir)
+	case "post":
+		// The workspace belongs to the executor, so there is no need to mark
+		// the repository as safe.
+		return run.Post(ctx, logger, workspaceCmdRunner{dir: dir}, step, executionInput, previousResult, dir, workspaceFilesPath, false, environ)
+	default:
+		return errors.Newf("invalid mode %q", mode)
+	}
+}
+
+// workspaceCmdRunner runs git in the given directory of the workspace, where
+// batcheshelper runs it relative to its working directory.
+type workspaceCmdRunner struct {
+	dir string
+}
+
+var _ batcheshelperutil.CmdRunner = workspaceCmdRunner{}
+
+func (r workspaceCmdRunner) Git(ctx context.Context, dir string, args ...string) ([]byte, error) {
+	return (&batcheshelperutil.RealCmdRunner{}).Git(ctx, filepath.Join(r.dir, dir), args...)
+}
+
+// writeLogLines writes the lines of r to w in the format of the output of
+// commands.
+func writeLogLines(w io.Writer, prefix string, r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 4*1024), 100*1024*1024)
+	for scanner.Scan() {
+		if _, err := fmt.Fprintf(w, "%s: %s\n", prefix, scanner.Text()); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,131 @@
+package runner_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/command"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/runner"
+	"github.com/sourcegraph/sourcegraph/internal/executor/types"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/batches/execution"
+)
+
+func TestRunner_RunBuiltin(t *testing.T) {
+	dir := t.TempDir()
+	repoDir := filepath.Join(dir, "repository")
+	require.NoError(t, os.MkdirAll(repoDir, os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "main.go"), []byte("ioutil.ReadFile(path)\n"), os.ModePerm))
+	for _, args := range [][]string{
+		{"init"},
+		{"add", "--all"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-m", "initial"},
+	} {
+		out, err := exec.Command("git", append([]string{"-C", repoDir}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	input, err := json.Marshal(batcheslib.WorkspacesExecutionInput{
+		Steps: []batcheslib.Step{
+			{Builtin: &batcheslib.BuiltinStep{RegexReplace: &batcheslib.BuiltinRegexReplace{
+				Paths:       []string{"*.go"},
+				Pattern:     `ioutil\.`,
+				Replacement: "os.",
+			}}},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "input.json"), input, os.ModePerm))
+
+	cmd := runner.NewMockCommand()
+	logger := runner.NewMockLogger()
+	var logs strings.Builder
+	var exitCodes []int
+	logEntry := runner.NewMockLogEntry()
+	logEntry.WriteFunc.SetDefaultHook(logs.Write)
+	logEntry.FinalizeFunc.SetDefaultHook(func(exitCode int) {
+		exitCodes = append(exitCodes, exitCode)
+	})
+	logger.LogEntryFunc.SetDefaultReturn(logEntry)
+
+	shellRunner := runner.NewShellRunner(cmd, logger, dir, command.DockerOptions{})
+	for _, s := range []struct {
+		key  string
+		mode string
+	}{
+		{key: "step.docker.step.0.pre", mode: "pre"},
+		{key: "step.docker.step.0.run", mode: "builtin"},
+		{key: "step.docker.step.0.post", mode: "post"},
+	} {
+		err = shellRunner.Run(context.Background(), runner.Spec{
+			CommandSpecs: []command.Spec{{Key: s.key, Dir: "."}},
+			Image:        "sourcegraph/batcheshelper:insiders",
+			Builtin:      &types.BuiltinStep{Mode: s.mode, Step: 0},
+		})
+		require.NoError(t, err)
+	}
+
+	// The steps never ran in a container.
+	assert.Empty(t, cmd.RunFunc.History())
+	assert.Equal(t, []int{0, 0, 0}, exitCodes)
+	require.Len(t, logger.LogEntryFunc.History(), 3)
+	assert.Equal(t, "step.docker.step.0.run", logger.LogEntryFunc.History()[1].Arg0)
+	assert.Equal(t, []string{"batcheshelper", "builtin", "0"}, logger.LogEntryFunc.History()[1].Arg1)
+	assert.Contains(t, logs.String(), "stdout: main.go\n")
+
+	b, err := os.ReadFile(filepath.Join(repoDir, "main.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "os.ReadFile(path)\n", string(b))
+
+	// The step result is the same as the one of a container step.
+	b, err = os.ReadFile(filepath.Join(dir, "step0.json"))
+	require.NoError(t, err)
+	var result execution.AfterStepResult
+	require.NoError(t, json.Unmarshal(b, &result))
+	assert.Equal(t, 0, result.StepIndex)
+	assert.Equal(t, "main.go\n", result.Stdout)
+	assert.Contains(t, string(result.Diff), "+os.ReadFile(path)")
+}
+
+func TestRunner_RunBuiltin_Error(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "repository"), os.ModePerm))
+
+	input, err := json.Marshal(batcheslib.WorkspacesExecutionInput{
+		Steps: []batcheslib.Step{
+			{Builtin: &batcheslib.BuiltinStep{RegexReplace: &batcheslib.BuiltinRegexReplace{
+				Pattern: "(",
+			}}},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "input.json"), input, os.ModePerm))
+
+	cmd := runner.NewMockCommand()
+	logger := runner.NewMockLogger()
+	var logs strings.Builder
+	logEntry := runner.NewMockLogEntry()
+	logEntry.WriteFunc.SetDefaultHook(logs.Write)
+	logger.LogEntryFunc.SetDefaultReturn(logEntry)
+
+	dockerRunner := runner.NewDockerRunner(cmd, logger, dir, command.DockerOptions{}, types.DockerAuthConfig{})
+	err = dockerRunner.Run(context.Background(), runner.Spec{
+		CommandSpecs: []command.Spec{{Key: "step.docker.step.0.run", Dir: "."}},
+		Builtin:      &types.BuiltinStep{Mode: "builtin", Step: 0},
+	})
+	require.Error(t, err)
+	assert.EqualError(t, err, "builtin step step.docker.step.0.run failed: failed to apply regexReplace: compiling pattern: error parsing regexp: missing closing ): `(`")
+
+	assert.Empty(t, cmd.RunFunc.History())
+	require.Len(t, logEntry.FinalizeFunc.History(), 1)
+	assert.Equal(t, 1, logEntry.FinalizeFunc.History()[0].Arg0)
+	assert.Equal(t, "stderr: failed to apply regexReplace: compiling pattern: error parsing regexp: missing closing ): `(`\n", logs.String())
+}
@@ -95,6 +95,10 @@ func (r *dockerRunner) Teardown(ctx context.Context) error {
 }
 
 func (r *dockerRunner) Run(ctx context.Context, spec Spec) error {
+	if spec.Builtin != nil {
+		return runBuiltin(ctx, r.commandLogger, r.dir, spec)
+	}
+
 	dockerSpec := command.NewDockerSpec(r.dir, spec.Image, spec.ScriptPath, spec.CommandSpecs[0], r.options)
 	return r.cmd.Run(ctx, r.commandLogger, dockerSpec)
 }
@@ -36,6 +36,9 @@ type Spec struct {
 	CommandSpecs []command.Spec
 	Image        string
 	ScriptPath   string
+	// Builtin is set for the steps of builtin batch spec steps. Runners that
+	// execute on the host run these natively instead of in the image.
+	Builtin *types.BuiltinStep
 }
 
 // Options are the options that can be passed to the runner.
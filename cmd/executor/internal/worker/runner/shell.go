@@ -62,6 +62,10 @@ func (r *shellRunner) Teardown(ctx context.Context) error {
 }
 
 func (r *shellRunner) Run(ctx context.Context, spec Spec) error {
+	if spec.Builtin != nil {
+		return runBuiltin(ctx, r.commandLogger, r.dir, spec)
+	}
+
 	shellSpec := command.NewShellSpec(r.dir, spec.Image, spec.ScriptPath, spec.CommandSpecs[0], r.options)
 	return r.cmd.Run(ctx, r.commandLogger, shellSpec)
 }
@@ -63,6 +63,7 @@ func (r *shellRuntime) NewRunnerSpecs(ws workspace.Workspace, job types.Job) ([]
 			},
 			Image:      step.Image,
 			ScriptPath: ws.ScriptFilenames()[i],
+			Builtin:    step.Builtin,
 		}
 	}
 
@@ -53,6 +53,14 @@ type DeleteBatchChangeArgs struct {
 	BatchChange graphql.ID
 }
 
+type PauseBatchChangeRolloutArgs struct {
+	BatchChange graphql.ID
+}
+
+type ResumeBatchChangeRolloutArgs struct {
+	BatchChange graphql.ID
+}
+
 type SyncChangesetArgs struct {
 	Changeset graphql.ID
 }
@@ -232,6 +240,10 @@ type PublishChangesetsArgs struct {
 	Draft bool
 }
 
+type RebaseChangesetsArgs struct {
+	BulkOperationBaseArgs
+}
+
 type ResolveWorkspacesForBatchSpecArgs struct {
 	BatchSpec string
 }
@@ -270,6 +282,8 @@ type BatchChangesResolver interface {
 	CloseBatchChange(ctx context.Context, args *CloseBatchChangeArgs) (BatchChangeResolver, error)
 	MoveBatchChange(ctx context.Context, args *MoveBatchChangeArgs) (BatchChangeResolver, error)
 	DeleteBatchChange(ctx context.Context, args *DeleteBatchChangeArgs) (*EmptyResponse, error)
+	PauseBatchChangeRollout(ctx context.Context, args *PauseBatchChangeRolloutArgs) (BatchChangeResolver, error)
+	ResumeBatchChangeRollout(ctx context.Context, args *ResumeBatchChangeRolloutArgs) (BatchChangeResolver, error)
 	CreateBatchChangesCredential(ctx context.Context, args *CreateBatchChangesCredentialArgs) (BatchChangesCredentialResolver, error)
 	DeleteBatchChangesCredential(ctx context.Context, args *DeleteBatchChangesCredentialArgs) (*EmptyResponse, error)
 
@@ -283,6 +297,7 @@ type BatchChangesResolver interface {
 	MergeChangesets(ctx context.Context, args *MergeChangesetsArgs) (BulkOperationResolver, error)
 	CloseChangesets(ctx context.Context, args *CloseChangesetsArgs) (BulkOperationResolver, error)
 	PublishChangesets(ctx context.Context, args *PublishChangesetsArgs) (BulkOperationResolver, error)
+	RebaseChangesets(ctx context.Context, args *RebaseChangesetsArgs) (BulkOperationResolver, error)
 
 	// Queries
 	BatchChange(ctx context.Context, args *BatchChangeArgs) (BatchChangeResolver, error)
@@ -320,6 +335,7 @@ type BulkOperationResolver interface {
 	State() string
 	Progress() float64
 	Errors(ctx context.Context) ([]ChangesetJobErrorResolver, error)
+	RebaseConflicts(ctx context.Context) ([]ChangesetJobErrorResolver, error)
 	Initiator(ctx context.Context) (*UserResolver, error)
 	ChangesetCount() int32
 	CreatedAt() gqlutil.DateTime
@@ -675,6 +691,23 @@ type BatchChangeResolver interface {
 	CurrentSpec(ctx context.Context) (BatchSpecResolver, error)
 	BulkOperations(ctx context.Context, args *ListBatchChangeBulkOperationArgs) (BulkOperationConnectionResolver, error)
 	BatchSpecs(ctx context.Context, args *ListBatchSpecArgs) (BatchSpecConnectionResolver, error)
+	Rollout(ctx context.Context) (BatchChangeRolloutResolver, error)
+}
+
+type BatchChangeRolloutResolver interface {
+	CurrentWave() int32
+	WaveCount() int32
+	Paused() bool
+	GateMessage() *string
+	Waves(ctx context.Context) ([]BatchChangeRolloutWaveResolver, error)
+}
+
+type BatchChangeRolloutWaveResolver interface {
+	Number() int32
+	State() string
+	ChangesetCount() int32
+	PublishedCount() int32
+	MergedCount() int32
 }
 
 type BatchChangesConnectionResolver interface {
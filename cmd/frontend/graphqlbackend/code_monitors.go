@@ -31,6 +31,8 @@ type CodeMonitorsResolver interface {
 	TriggerTestEmailAction(ctx context.Context, args *TriggerTestEmailActionArgs) (*EmptyResponse, error)
 	TriggerTestWebhookAction(ctx context.Context, args *TriggerTestWebhookActionArgs) (*EmptyResponse, error)
 	TriggerTestSlackWebhookAction(ctx context.Context, args *TriggerTestSlackWebhookActionArgs) (*EmptyResponse, error)
+	TriggerTestTeamsWebhookAction(ctx context.Context, args *TriggerTestTeamsWebhookActionArgs) (*EmptyResponse, error)
+	TriggerTestTemplatedWebhookAction(ctx context.Context, args *TriggerTestTemplatedWebhookActionArgs) (*EmptyResponse, error)
 
 	NodeResolvers() map[string]NodeByIDFunc
 }
@@ -50,6 +52,12 @@ type MonitorResolver interface {
 	Enabled() bool
 	Trigger(ctx context.Context) (MonitorTrigger, error)
 	Actions(ctx context.Context, args *ListActionArgs) (MonitorActionConnectionResolver, error)
+	NotificationLimit() MonitorNotificationLimitResolver
+}
+
+type MonitorNotificationLimitResolver interface {
+	Count() int32
+	Window() string
 }
 
 type MonitorTrigger interface {
@@ -59,6 +67,7 @@ type MonitorTrigger interface {
 type MonitorQueryResolver interface {
 	ID() graphql.ID
 	Query() string
+	Kind() string
 	Events(ctx context.Context, args *ListEventsArgs) (MonitorTriggerEventConnectionResolver, error)
 }
 
@@ -76,6 +85,17 @@ type MonitorTriggerEventResolver interface {
 	Actions(ctx context.Context, args *ListActionArgs) (MonitorActionConnectionResolver, error)
 	ResultCount() int32
 	Query() *string
+	ContentChanges() MonitorContentChangesResolver
+}
+
+type MonitorContentChangesResolver interface {
+	Added() []MonitorContentMatchResolver
+	Removed() []MonitorContentMatchResolver
+}
+
+type MonitorContentMatchResolver interface {
+	Repository() string
+	Path() string
 }
 
 type MonitorActionConnectionResolver interface {
@@ -89,6 +109,8 @@ type MonitorAction interface {
 	ToMonitorEmail() (MonitorEmailResolver, bool)
 	ToMonitorWebhook() (MonitorWebhookResolver, bool)
 	ToMonitorSlackWebhook() (MonitorSlackWebhookResolver, bool)
+	ToMonitorTeamsWebhook() (MonitorTeamsWebhookResolver, bool)
+	ToMonitorTemplatedWebhook() (MonitorTemplatedWebhookResolver, bool)
 }
 
 type MonitorEmailResolver interface {
@@ -97,6 +119,7 @@ type MonitorEmailResolver interface {
 	IncludeResults() bool
 	Priority() string
 	Header() string
+	Delivery() string
 	Recipients(ctx context.Context, args *ListRecipientsArgs) (MonitorActionEmailRecipientsConnectionResolver, error)
 	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
 }
@@ -106,6 +129,7 @@ type MonitorWebhookResolver interface {
 	Enabled() bool
 	IncludeResults() bool
 	URL() string
+	Delivery() string
 	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
 }
 
@@ -114,9 +138,35 @@ type MonitorSlackWebhookResolver interface {
 	Enabled() bool
 	IncludeResults() bool
 	URL() string
+	Delivery() string
+	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
+}
+
+type MonitorTeamsWebhookResolver interface {
+	ID() graphql.ID
+	Enabled() bool
+	IncludeResults() bool
+	URL() string
+	Delivery() string
+	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
+}
+
+type MonitorTemplatedWebhookResolver interface {
+	ID() graphql.ID
+	Enabled() bool
+	IncludeResults() bool
+	URL() string
+	PayloadTemplate() string
+	Headers(ctx context.Context) ([]MonitorWebhookHeaderResolver, error)
+	Delivery() string
 	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
 }
 
+type MonitorWebhookHeaderResolver interface {
+	Name() string
+	Value() string
+}
+
 type MonitorEmailRecipient interface {
 	ToUser() (*UserResolver, bool)
 }
@@ -168,17 +218,21 @@ type CreateCodeMonitorArgs struct {
 
 type CreateTriggerArgs struct {
 	Query string
+	Kind  *string
 }
 
 type CreateActionArgs struct {
-	Email        *CreateActionEmailArgs
-	Webhook      *CreateActionWebhookArgs
-	SlackWebhook *CreateActionSlackWebhookArgs
+	Email            *CreateActionEmailArgs
+	Webhook          *CreateActionWebhookArgs
+	SlackWebhook     *CreateActionSlackWebhookArgs
+	TeamsWebhook     *CreateActionTeamsWebhookArgs
+	TemplatedWebhook *CreateActionTemplatedWebhookArgs
 }
 
 type CreateActionEmailArgs struct {
 	Enabled        bool
 	IncludeResults bool
+	Delivery       string
 	Priority       string
 	Recipients     []graphql.ID
 	Header         string
@@ -188,12 +242,35 @@ type CreateActionWebhookArgs struct {
 	Enabled        bool
 	IncludeResults bool
 	URL            string
+	Delivery       string
 }
 
 type CreateActionSlackWebhookArgs struct {
 	Enabled        bool
 	IncludeResults bool
 	URL            string
+	Delivery       string
+}
+
+type CreateActionTeamsWebhookArgs struct {
+	Enabled        bool
+	IncludeResults bool
+	URL            string
+	Delivery       string
+}
+
+type CreateActionTemplatedWebhookArgs struct {
+	Enabled         bool
+	IncludeResults  bool
+	URL             string
+	PayloadTemplate string
+	Headers         *[]*WebhookHeaderArgs
+	Delivery        string
+}
+
+type WebhookHeaderArgs struct {
+	Name  string
+	Value string
 }
 
 type ToggleCodeMonitorArgs struct {
@@ -227,10 +304,28 @@ type TriggerTestSlackWebhookActionArgs struct {
 	SlackWebhook *CreateActionSlackWebhookArgs
 }
 
+type TriggerTestTeamsWebhookActionArgs struct {
+	Namespace    graphql.ID
+	Description  string
+	TeamsWebhook *CreateActionTeamsWebhookArgs
+}
+
+type TriggerTestTemplatedWebhookActionArgs struct {
+	Namespace        graphql.ID
+	Description      string
+	TemplatedWebhook *CreateActionTemplatedWebhookArgs
+}
+
 type CreateMonitorArgs struct {
-	Namespace   graphql.ID
-	Description string
-	Enabled     bool
+	Namespace         graphql.ID
+	Description       string
+	Enabled           bool
+	NotificationLimit *MonitorNotificationLimitArgs
+}
+
+type MonitorNotificationLimitArgs struct {
+	Count  int32
+	Window string
 }
 
 type EditActionEmailArgs struct {
@@ -248,10 +343,22 @@ type EditActionSlackWebhookArgs struct {
 	Update *CreateActionSlackWebhookArgs
 }
 
+type EditActionTeamsWebhookArgs struct {
+	Id     *graphql.ID
+	Update *CreateActionTeamsWebhookArgs
+}
+
+type EditActionTemplatedWebhookArgs struct {
+	Id     *graphql.ID
+	Update *CreateActionTemplatedWebhookArgs
+}
+
 type EditActionArgs struct {
-	Email        *EditActionEmailArgs
-	Webhook      *EditActionWebhookArgs
-	SlackWebhook *EditActionSlackWebhookArgs
+	Email            *EditActionEmailArgs
+	Webhook          *EditActionWebhookArgs
+	SlackWebhook     *EditActionSlackWebhookArgs
+	TeamsWebhook     *EditActionTeamsWebhookArgs
+	TemplatedWebhook *EditActionTemplatedWebhookArgs
 }
 
 type EditTriggerArgs struct {
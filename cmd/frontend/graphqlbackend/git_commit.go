@@ -471,3 +471,7 @@ func (r *GitCommitResolver) canonicalRepoRevURL() *url.URL {
 func (r *GitCommitResolver) Ownership(ctx context.Context, args ListOwnershipArgs) (OwnershipConnectionResolver, error) {
 	return EnterpriseResolvers.ownResolver.GitCommitOwnership(ctx, r, args)
 }
+
+func (r *GitCommitResolver) CodeownersLint(ctx context.Context) (CodeownersLintReportResolver, error) {
+	return EnterpriseResolvers.ownResolver.GitCommitCodeownersLint(ctx, r)
+}
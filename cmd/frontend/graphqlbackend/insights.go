@@ -25,12 +25,15 @@ type InsightsResolver interface {
 	ValidateScopedInsightQuery(ctx context.Context, args ValidateScopedInsightQueryArgs) (ScopedInsightQueryPayloadResolver, error)
 	PreviewRepositoriesFromQuery(ctx context.Context, args PreviewRepositoriesFromQueryArgs) (RepositoryPreviewPayloadResolver, error)
 
+	ExportInsightsDashboard(ctx context.Context, args *ExportInsightsDashboardArgs) (string, error)
+
 	// Mutations
 	CreateInsightsDashboard(ctx context.Context, args *CreateInsightsDashboardArgs) (InsightsDashboardPayloadResolver, error)
 	UpdateInsightsDashboard(ctx context.Context, args *UpdateInsightsDashboardArgs) (InsightsDashboardPayloadResolver, error)
 	DeleteInsightsDashboard(ctx context.Context, args *DeleteInsightsDashboardArgs) (*EmptyResponse, error)
 	RemoveInsightViewFromDashboard(ctx context.Context, args *RemoveInsightViewFromDashboardArgs) (InsightsDashboardPayloadResolver, error)
 	AddInsightViewToDashboard(ctx context.Context, args *AddInsightViewToDashboardArgs) (InsightsDashboardPayloadResolver, error)
+	ImportInsightsDashboard(ctx context.Context, args *ImportInsightsDashboardArgs) (InsightsDashboardPayloadResolver, error)
 
 	CreateLineChartSearchInsight(ctx context.Context, args *CreateLineChartSearchInsightArgs) (InsightViewPayloadResolver, error)
 	UpdateLineChartSearchInsight(ctx context.Context, args *UpdateLineChartSearchInsightArgs) (InsightViewPayloadResolver, error)
@@ -49,6 +52,11 @@ type InsightsResolver interface {
 	RetryInsightSeriesBackfill(ctx context.Context, args *BackfillArgs) (*BackfillQueueItemResolver, error)
 	MoveInsightSeriesBackfillToFrontOfQueue(ctx context.Context, args *BackfillArgs) (*BackfillQueueItemResolver, error)
 	MoveInsightSeriesBackfillToBackOfQueue(ctx context.Context, args *BackfillArgs) (*BackfillQueueItemResolver, error)
+
+	// Alerts
+	InsightSeriesAlerts(ctx context.Context, args *InsightSeriesAlertsArgs) ([]InsightSeriesAlertResolver, error)
+	CreateInsightSeriesAlert(ctx context.Context, args *CreateInsightSeriesAlertArgs) (InsightSeriesAlertResolver, error)
+	DeleteInsightSeriesAlert(ctx context.Context, args *DeleteInsightSeriesAlertArgs) (*EmptyResponse, error)
 }
 
 type SearchInsightLivePreviewArgs struct {
@@ -184,6 +192,22 @@ type InsightsPermissionGrants struct {
 	Global        *bool
 }
 
+type ExportInsightsDashboardArgs struct {
+	Id            graphql.ID
+	IncludePoints bool
+}
+
+type ImportInsightsDashboardArgs struct {
+	Input ImportInsightsDashboardInput
+}
+
+type ImportInsightsDashboardInput struct {
+	Dashboard     string
+	Title         *string
+	Grants        InsightsPermissionGrants
+	IncludePoints bool
+}
+
 type DeleteInsightsDashboardArgs struct {
 	Id graphql.ID
 }
@@ -240,6 +264,7 @@ type SearchInsightDataSeriesDefinitionResolver interface {
 	GeneratedFromCaptureGroups() (bool, error)
 	IsCalculated() (bool, error)
 	GroupBy() (*string, error)
+	SymbolReferences() (*string, error)
 }
 
 type InsightPresentation interface {
@@ -301,6 +326,39 @@ type UpdateInsightSeriesInput struct {
 	Enabled  *bool
 }
 
+type InsightSeriesAlertsArgs struct {
+	InsightViewId graphql.ID
+}
+
+type CreateInsightSeriesAlertArgs struct {
+	Input CreateInsightSeriesAlertInput
+}
+
+type CreateInsightSeriesAlertInput struct {
+	InsightViewId graphql.ID
+	SeriesId      string
+	Kind          string
+	Threshold     float64
+	Action        string
+	Url           *string
+}
+
+type DeleteInsightSeriesAlertArgs struct {
+	Id graphql.ID
+}
+
+type InsightSeriesAlertResolver interface {
+	ID() graphql.ID
+	SeriesId() string
+	Kind() string
+	Threshold() float64
+	Action() string
+	Url() *string
+	LastEvaluatedAt() *gqlutil.DateTime
+	LastFiredAt() *gqlutil.DateTime
+	CreatedAt() gqlutil.DateTime
+}
+
 type InsightSeriesMetadataResolver interface {
 	SeriesId(ctx context.Context) (string, error)
 	Query(ctx context.Context) (string, error)
@@ -430,6 +488,7 @@ type LineChartSearchInsightDataSeriesInput struct {
 	Options                    LineChartDataSeriesOptionsInput
 	GeneratedFromCaptureGroups *bool
 	GroupBy                    *string
+	SymbolReferences           *string
 }
 
 type LineChartDataSeriesOptionsInput struct {
@@ -68,6 +68,10 @@ type OwnResolver interface {
 	AssignTeam(context.Context, *AssignOwnerOrTeamArgs) (*EmptyResponse, error)
 	RemoveAssignedTeam(context.Context, *AssignOwnerOrTeamArgs) (*EmptyResponse, error)
 
+	// Codeowners lint.
+	GitCommitCodeownersLint(ctx context.Context, commit *GitCommitResolver) (CodeownersLintReportResolver, error)
+	CodeownersLintReports(context.Context, *CodeownersLintReportsArgs) (CodeownersLintReportConnectionResolver, error)
+
 	// Config.
 	OwnSignalConfigurations(ctx context.Context) ([]SignalConfigurationResolver, error)
 	UpdateOwnSignalConfigurations(ctx context.Context, configurationsArgs UpdateSignalConfigurationsArgs) ([]SignalConfigurationResolver, error)
@@ -122,6 +126,7 @@ type CodeownersFileEntryResolver interface {
 	Description() (string, error)
 	CodeownersFile(context.Context) (FileResolver, error)
 	RuleLineMatch(context.Context) (int32, error)
+	Section() *string
 }
 
 type RecentContributorOwnershipSignalResolver interface {
@@ -194,6 +199,43 @@ type CodeownersIngestedFileConnectionResolver interface {
 	PageInfo(ctx context.Context) (*gqlutil.PageInfo, error)
 }
 
+type CodeownersLintReportsArgs struct {
+	First       *int32
+	After       *string
+	MaxCoverage *float64
+}
+
+type CodeownersLintReportResolver interface {
+	Repository(context.Context) (*RepositoryResolver, error)
+	OID() GitObjectID
+	HasCodeownersFile() bool
+	TotalFiles() int32
+	OwnedFiles() int32
+	Coverage() float64
+	DeadRules() []CodeownersLintRuleResolver
+	UnknownOwners() []CodeownersLintOwnerResolver
+	UnownedPaths() []string
+	UpdatedAt() gqlutil.DateTime
+}
+
+type CodeownersLintRuleResolver interface {
+	Pattern() string
+	LineNumber() int32
+	Section() *string
+}
+
+type CodeownersLintOwnerResolver interface {
+	Handle() *string
+	Email() *string
+	LineNumbers() []int32
+}
+
+type CodeownersLintReportConnectionResolver interface {
+	Nodes(ctx context.Context) ([]CodeownersLintReportResolver, error)
+	TotalCount(ctx context.Context) (int32, error)
+	PageInfo(ctx context.Context) (*gqlutil.PageInfo, error)
+}
+
 type SignalConfigurationResolver interface {
 	Name() string
 	Description() string
@@ -13,10 +13,15 @@ type SearchJobsResolver interface {
 	CreateSearchJob(ctx context.Context, args *CreateSearchJobArgs) (SearchJobResolver, error)
 	CancelSearchJob(ctx context.Context, args *CancelSearchJobArgs) (*EmptyResponse, error)
 	DeleteSearchJob(ctx context.Context, args *DeleteSearchJobArgs) (*EmptyResponse, error)
+	RerunSearchJobIncrementally(ctx context.Context, args *RerunSearchJobIncrementallyArgs) (SearchJobResolver, error)
+	CreateSearchJobSchedule(ctx context.Context, args *CreateSearchJobScheduleArgs) (SearchJobScheduleResolver, error)
+	UpdateSearchJobSchedule(ctx context.Context, args *UpdateSearchJobScheduleArgs) (SearchJobScheduleResolver, error)
+	DeleteSearchJobSchedule(ctx context.Context, args *DeleteSearchJobScheduleArgs) (*EmptyResponse, error)
 
 	// Queries
 	SearchJobs(ctx context.Context, args *SearchJobsArgs) (*gqlutil.ConnectionResolver[SearchJobResolver], error)
 	ValidateSearchJob(ctx context.Context, args *CreateSearchJobArgs) (*EmptyResponse, error)
+	SearchJobSchedules(ctx context.Context, args *SearchJobSchedulesArgs) ([]SearchJobScheduleResolver, error)
 
 	NodeResolvers() map[string]NodeByIDFunc
 }
@@ -46,6 +51,60 @@ type SearchJobResolver interface {
 	URL(ctx context.Context) (*string, error)
 	LogURL(ctx context.Context) (*string, error)
 	RepoStats(ctx context.Context) (SearchJobStatsResolver, error)
+	PreviousJob(ctx context.Context) (SearchJobResolver, error)
+	IncrementalSummary(ctx context.Context) (SearchJobIncrementalSummaryResolver, error)
+	Schedule(ctx context.Context) (SearchJobScheduleResolver, error)
+	Aggregations(ctx context.Context, args *SearchJobAggregationsArgs) (SearchJobAggregationResolver, error)
+}
+
+type SearchJobAggregationsArgs struct {
+	Mode           string
+	DirectoryDepth int32
+	Limit          int32
+}
+
+type SearchJobAggregationResolver interface {
+	Mode() string
+	Groups() []SearchJobAggregationGroupResolver
+	OtherGroupCount() int32
+	OtherResultCount() int32
+	Complete() bool
+	URL() (*string, error)
+}
+
+type SearchJobAggregationGroupResolver interface {
+	Label() string
+	Count() int32
+}
+
+type SearchJobScheduleResolver interface {
+	ID() graphql.ID
+	Query() string
+	Schedule() string
+	Namespace(ctx context.Context) (*NamespaceResolver, error)
+	Creator(ctx context.Context) (*UserResolver, error)
+	RetainRuns() int32
+	RetainDays() int32
+	Incremental() bool
+	Notify() bool
+	Enabled() bool
+	NextRunAt() *gqlutil.DateTime
+	LastRunAt() *gqlutil.DateTime
+	CreatedAt() gqlutil.DateTime
+	UpdatedAt() gqlutil.DateTime
+	Runs(ctx context.Context, args *SearchJobScheduleRunsArgs) ([]SearchJobResolver, error)
+}
+
+type SearchJobScheduleRunsArgs struct {
+	First int32
+}
+
+type SearchJobIncrementalSummaryResolver interface {
+	RevisionsReused() int32
+	RevisionsSearched() int32
+	RevisionsRemoved() int32
+	MatchesAdded() int32
+	MatchesRemoved() int32
 }
 
 type SearchJobStatsResolver interface {
@@ -73,6 +132,39 @@ type DeleteSearchJobArgs struct {
 	ID graphql.ID
 }
 
+type RerunSearchJobIncrementallyArgs struct {
+	ID graphql.ID
+}
+
+type CreateSearchJobScheduleArgs struct {
+	Namespace   graphql.ID
+	Query       string
+	Schedule    string
+	RetainRuns  int32
+	RetainDays  int32
+	Incremental bool
+	Notify      bool
+}
+
+type UpdateSearchJobScheduleArgs struct {
+	ID          graphql.ID
+	Query       *string
+	Schedule    *string
+	RetainRuns  *int32
+	RetainDays  *int32
+	Incremental *bool
+	Notify      *bool
+	Enabled     *bool
+}
+
+type DeleteSearchJobScheduleArgs struct {
+	ID graphql.ID
+}
+
+type SearchJobSchedulesArgs struct {
+	Namespace *graphql.ID
+}
+
 type RetrySearchJobArgs struct {
 	ID graphql.ID
 }
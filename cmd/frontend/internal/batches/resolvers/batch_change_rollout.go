@@ -0,0 +1,90 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+)
+
+var _ graphqlbackend.BatchChangeRolloutResolver = &batchChangeRolloutResolver{}
+
+type batchChangeRolloutResolver struct {
+	store   *store.Store
+	rollout *btypes.BatchChangeRollout
+}
+
+func (r *batchChangeRolloutResolver) CurrentWave() int32 {
+	return r.rollout.CurrentWave + 1
+}
+
+func (r *batchChangeRolloutResolver) WaveCount() int32 {
+	return r.rollout.WaveCount
+}
+
+func (r *batchChangeRolloutResolver) Paused() bool {
+	return r.rollout.Paused
+}
+
+func (r *batchChangeRolloutResolver) GateMessage() *string {
+	return r.rollout.GateMessage
+}
+
+func (r *batchChangeRolloutResolver) Waves(ctx context.Context) ([]graphqlbackend.BatchChangeRolloutWaveResolver, error) {
+	stats, err := r.store.GetRolloutWaveStats(ctx, r.rollout.BatchChangeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Waves without changesets don't have stats, but are still returned.
+	byWave := make(map[int32]btypes.RolloutWaveStats, len(stats))
+	for _, s := range stats {
+		byWave[s.Wave] = s
+	}
+
+	waves := make([]graphqlbackend.BatchChangeRolloutWaveResolver, 0, r.rollout.WaveCount)
+	for i := int32(0); i < r.rollout.WaveCount; i++ {
+		waves = append(waves, &batchChangeRolloutWaveResolver{
+			stats:       byWave[i],
+			wave:        i,
+			currentWave: r.rollout.CurrentWave,
+		})
+	}
+	return waves, nil
+}
+
+var _ graphqlbackend.BatchChangeRolloutWaveResolver = &batchChangeRolloutWaveResolver{}
+
+type batchChangeRolloutWaveResolver struct {
+	stats       btypes.RolloutWaveStats
+	wave        int32
+	currentWave int32
+}
+
+func (r *batchChangeRolloutWaveResolver) Number() int32 {
+	return r.wave + 1
+}
+
+func (r *batchChangeRolloutWaveResolver) State() string {
+	switch {
+	case r.wave < r.currentWave:
+		return "COMPLETED"
+	case r.wave == r.currentWave:
+		return "ACTIVE"
+	default:
+		return "PENDING"
+	}
+}
+
+func (r *batchChangeRolloutWaveResolver) ChangesetCount() int32 {
+	return r.stats.Total
+}
+
+func (r *batchChangeRolloutWaveResolver) PublishedCount() int32 {
+	return r.stats.Published
+}
+
+func (r *batchChangeRolloutWaveResolver) MergedCount() int32 {
+	return r.stats.Merged
+}
@@ -95,6 +95,90 @@ func (r *bulkOperationResolver) Errors(ctx context.Context) ([]graphqlbackend.Ch
 	return res, nil
 }
 
+func (r *bulkOperationResolver) RebaseConflicts(ctx context.Context) ([]graphqlbackend.ChangesetJobErrorResolver, error) {
+	if r.bulkOperation.Type != btypes.ChangesetJobTypeRebase {
+		return []graphqlbackend.ChangesetJobErrorResolver{}, nil
+	}
+
+	rebases, err := r.store.ListChangesetRebases(ctx, store.ListChangesetRebasesOpts{BulkGroup: r.bulkOperation.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	changesetIDs := make([]int64, 0, len(rebases))
+	for _, rb := range rebases {
+		changesetIDs = append(changesetIDs, rb.ChangesetID)
+	}
+
+	changesetsByID := map[int64]*btypes.Changeset{}
+	reposByID := map[api.RepoID]*types.Repo{}
+	if len(changesetIDs) > 0 {
+		// Load all changesets and repos at once, to avoid N+1 queries.
+		changesets, _, err := r.store.ListChangesets(ctx, store.ListChangesetsOpts{IDs: changesetIDs})
+		if err != nil {
+			return nil, err
+		}
+		for _, ch := range changesets {
+			changesetsByID[ch.ID] = ch
+		}
+		// 🚨 SECURITY: database.Repos.GetReposSetByIDs uses the authzFilter under the hood and
+		// filters out repositories that the user doesn't have access to.
+		reposByID, err = r.store.Repos().GetReposSetByIDs(ctx, changesets.RepoIDs()...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := make([]graphqlbackend.ChangesetJobErrorResolver, 0)
+	for _, rb := range rebases {
+		ch, ok := changesetsByID[rb.ChangesetID]
+		if !ok {
+			continue
+		}
+
+		msg, conflicting := rebaseConflictMessage(rb, ch)
+		if !conflicting {
+			continue
+		}
+
+		repo, accessible := reposByID[ch.RepoID]
+		resolver := &changesetJobErrorResolver{store: r.store, gitserverClient: r.gitserverClient, logger: r.logger, changeset: ch, repo: repo}
+		if accessible {
+			resolver.error = msg
+		}
+		res = append(res, resolver)
+	}
+	return res, nil
+}
+
+// rebaseConflictMessage returns whether the changeset is still conflicting
+// with its base branch after the given rebase and, if so, why.
+func rebaseConflictMessage(rb *btypes.ChangesetRebase, ch *btypes.Changeset) (string, bool) {
+	switch rb.State {
+	case btypes.ChangesetRebaseStateFailed:
+		if rb.FailureMessage != nil {
+			return *rb.FailureMessage, true
+		}
+		return "re-executing the changeset failed", true
+
+	case btypes.ChangesetRebaseStatePushed:
+		// Until the rebased commit has been pushed and the changeset has been
+		// synced again, the code host still reports the state of the old
+		// commit.
+		if ch.ReconcilerState != btypes.ReconcilerStateCompleted || !ch.ExternalUpdatedAt.After(rb.UpdatedAt) {
+			return "", false
+		}
+		fallthrough
+
+	case btypes.ChangesetRebaseStateUpToDate:
+		if ch.HasConflicts() {
+			return "the code host reports conflicts with the base branch", true
+		}
+	}
+
+	return "", false
+}
+
 func (r *bulkOperationResolver) Initiator(ctx context.Context) (*graphqlbackend.UserResolver, error) {
 	return graphqlbackend.UserByIDInt32(ctx, r.store.DatabaseDB(), r.bulkOperation.UserID)
 }
@@ -130,6 +214,8 @@ func changesetJobTypeToBulkOperationType(t btypes.ChangesetJobType) (string, err
 		return "CLOSE", nil
 	case btypes.ChangesetJobTypePublish:
 		return "PUBLISH", nil
+	case btypes.ChangesetJobTypeRebase:
+		return "REBASE", nil
 	default:
 		return "", errors.Errorf("invalid job type %q", t)
 	}
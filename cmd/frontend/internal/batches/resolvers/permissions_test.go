@@ -903,6 +903,18 @@ query($includeLocallyExecutedSpecs: Boolean) {
 					return fmt.Sprintf(`mutation { deleteBatchChange(batchChange: %q) { alwaysNil } } `, batchChangeID)
 				},
 			},
+			{
+				name: "pauseBatchChangeRollout",
+				mutationFunc: func(userID, batchChangeID, changesetID, batchSpecID string) string {
+					return fmt.Sprintf(`mutation { pauseBatchChangeRollout(batchChange: %q) { id } }`, batchChangeID)
+				},
+			},
+			{
+				name: "resumeBatchChangeRollout",
+				mutationFunc: func(userID, batchChangeID, changesetID, batchSpecID string) string {
+					return fmt.Sprintf(`mutation { resumeBatchChangeRollout(batchChange: %q) { id } }`, batchChangeID)
+				},
+			},
 			{
 				name: "syncChangeset",
 				mutationFunc: func(userID, batchChangeID, changesetID, batchSpecID string) string {
@@ -951,6 +963,12 @@ query($includeLocallyExecutedSpecs: Boolean) {
 					return fmt.Sprintf(`mutation { closeChangesets(batchChange: %q, changesets: [%q]) { id } }`, batchChangeID, changesetID)
 				},
 			},
+			{
+				name: "rebaseChangesets",
+				mutationFunc: func(userID, batchChangeID, changesetID, batchSpecID string) string {
+					return fmt.Sprintf(`mutation { rebaseChangesets(batchChange: %q, changesets: [%q]) { id } }`, batchChangeID, changesetID)
+				},
+			},
 			{
 				name: "createEmptyBatchChange",
 				mutationFunc: func(userID, batchChangeID, changesetID, batchSpecID string) string {
@@ -757,6 +757,54 @@ func (r *Resolver) DeleteBatchChange(ctx context.Context, args *graphqlbackend.D
 	return &graphqlbackend.EmptyResponse{}, err
 }
 
+func (r *Resolver) PauseBatchChangeRollout(ctx context.Context, args *graphqlbackend.PauseBatchChangeRolloutArgs) (_ graphqlbackend.BatchChangeResolver, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.PauseBatchChangeRollout", attribute.String("batchChange", string(args.BatchChange)))
+	defer tr.EndWithErr(&err)
+
+	return r.setBatchChangeRolloutPaused(ctx, args.BatchChange, true)
+}
+
+func (r *Resolver) ResumeBatchChangeRollout(ctx context.Context, args *graphqlbackend.ResumeBatchChangeRolloutArgs) (_ graphqlbackend.BatchChangeResolver, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.ResumeBatchChangeRollout", attribute.String("batchChange", string(args.BatchChange)))
+	defer tr.EndWithErr(&err)
+
+	return r.setBatchChangeRolloutPaused(ctx, args.BatchChange, false)
+}
+
+func (r *Resolver) setBatchChangeRolloutPaused(ctx context.Context, id graphql.ID, paused bool) (graphqlbackend.BatchChangeResolver, error) {
+	if err := enterprise.BatchChangesEnabledForUser(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	if err := rbac.CheckCurrentUserHasPermission(ctx, r.store.DatabaseDB(), rbac.BatchChangesWritePermission); err != nil {
+		return nil, err
+	}
+
+	batchChangeID, err := unmarshalBatchChangeID(id)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshaling batch change id")
+	}
+
+	if batchChangeID == 0 {
+		return nil, ErrIDIsZero{}
+	}
+
+	svc := service.New(r.store)
+	var batchChange *btypes.BatchChange
+	// 🚨 SECURITY: PauseBatchChangeRollout and ResumeBatchChangeRollout check
+	// whether current user is authorized.
+	if paused {
+		batchChange, err = svc.PauseBatchChangeRollout(ctx, batchChangeID)
+	} else {
+		batchChange, err = svc.ResumeBatchChangeRollout(ctx, batchChangeID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &batchChangeResolver{store: r.store, gitserverClient: r.gitserverClient, batchChange: batchChange, logger: r.logger}, nil
+}
+
 func (r *Resolver) BatchChanges(ctx context.Context, args *graphqlbackend.ListBatchChangesArgs) (graphqlbackend.BatchChangesConnectionResolver, error) {
 	if err := enterprise.BatchChangesEnabledForUser(ctx, r.store.DatabaseDB()); err != nil {
 		return nil, err
@@ -1446,6 +1494,46 @@ func (r *Resolver) PublishChangesets(ctx context.Context, args *graphqlbackend.P
 	return r.bulkOperationByIDString(ctx, bulkGroupID)
 }
 
+func (r *Resolver) RebaseChangesets(ctx context.Context, args *graphqlbackend.RebaseChangesetsArgs) (_ graphqlbackend.BulkOperationResolver, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.RebaseChangesets",
+		attribute.String("batchChange", string(args.BatchChange)),
+		attribute.Int("changesets.len", len(args.Changesets)))
+	defer tr.EndWithErr(&err)
+	if err := enterprise.BatchChangesEnabledForUser(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	if err := rbac.CheckCurrentUserHasPermission(ctx, r.store.DatabaseDB(), rbac.BatchChangesWritePermission); err != nil {
+		return nil, err
+	}
+
+	batchChangeID, changesetIDs, err := unmarshalBulkOperationBaseArgs(args.BulkOperationBaseArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: CreateChangesetJobs checks whether current user is authorized.
+	svc := service.New(r.store)
+	published := btypes.ChangesetPublicationStatePublished
+	bulkGroupID, err := svc.CreateChangesetJobs(
+		ctx,
+		batchChangeID,
+		changesetIDs,
+		btypes.ChangesetJobTypeRebase,
+		&btypes.ChangesetJobRebasePayload{},
+		store.ListChangesetsOpts{
+			PublicationState: &published,
+			ReconcilerStates: []btypes.ReconcilerState{btypes.ReconcilerStateCompleted},
+			ExternalStates:   []btypes.ChangesetExternalState{btypes.ChangesetExternalStateOpen, btypes.ChangesetExternalStateDraft},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.bulkOperationByIDString(ctx, bulkGroupID)
+}
+
 func (r *Resolver) BatchSpecs(ctx context.Context, args *graphqlbackend.ListBatchSpecArgs) (_ graphqlbackend.BatchSpecConnectionResolver, err error) {
 	tr, ctx := trace.New(ctx, "Resolver.BatchSpecs",
 		attribute.Int("first", int(args.First)),
@@ -92,6 +92,8 @@ func TestNullIDResilience(t *testing.T) {
 	mutations := []string{
 		fmt.Sprintf(`mutation { closeBatchChange(batchChange: %q) { id } }`, bgql.MarshalBatchChangeID(0)),
 		fmt.Sprintf(`mutation { deleteBatchChange(batchChange: %q) { alwaysNil } }`, bgql.MarshalBatchChangeID(0)),
+		fmt.Sprintf(`mutation { pauseBatchChangeRollout(batchChange: %q) { id } }`, bgql.MarshalBatchChangeID(0)),
+		fmt.Sprintf(`mutation { resumeBatchChangeRollout(batchChange: %q) { id } }`, bgql.MarshalBatchChangeID(0)),
 		fmt.Sprintf(`mutation { syncChangeset(changeset: %q) { alwaysNil } }`, bgql.MarshalChangesetID(0)),
 		fmt.Sprintf(`mutation { reenqueueChangeset(changeset: %q) { id } }`, bgql.MarshalChangesetID(0)),
 		fmt.Sprintf(`mutation { applyBatchChange(batchSpec: %q) { id } }`, marshalBatchSpecRandID("")),
@@ -110,6 +112,8 @@ func TestNullIDResilience(t *testing.T) {
 		fmt.Sprintf(`mutation { closeChangesets(batchChange: %q, changesets: [%q]) { id } }`, bgql.MarshalBatchChangeID(1), bgql.MarshalChangesetID(0)),
 		fmt.Sprintf(`mutation { publishChangesets(batchChange: %q, changesets: []) { id } }`, bgql.MarshalBatchChangeID(0)),
 		fmt.Sprintf(`mutation { publishChangesets(batchChange: %q, changesets: [%q]) { id } }`, bgql.MarshalBatchChangeID(1), bgql.MarshalChangesetID(0)),
+		fmt.Sprintf(`mutation { rebaseChangesets(batchChange: %q, changesets: []) { id } }`, bgql.MarshalBatchChangeID(0)),
+		fmt.Sprintf(`mutation { rebaseChangesets(batchChange: %q, changesets: [%q]) { id } }`, bgql.MarshalBatchChangeID(1), bgql.MarshalChangesetID(0)),
 		fmt.Sprintf(`mutation { executeBatchSpec(batchSpec: %q) { id } }`, marshalBatchSpecRandID("")),
 		fmt.Sprintf(`mutation { cancelBatchSpecExecution(batchSpec: %q) { id } }`, marshalBatchSpecRandID("")),
 		fmt.Sprintf(`mutation { replaceBatchSpecInput(previousSpec: %q, batchSpec: "name: testing") { id } }`, marshalBatchSpecRandID("")),
@@ -2688,6 +2692,104 @@ mutation($batchChange: ID!, $changesets: [ID!]!, $draft: Boolean!) {
 }
 `
 
+func TestRebaseChangesets(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	ctx := context.Background()
+	db := database.NewDB(logger, dbtest.NewDB(t))
+	bstore := store.New(db, observation.TestContextTB(t), nil)
+
+	userID := bt.CreateTestUser(t, db, true).ID
+	// We give this user the `BATCH_CHANGES#WRITE` permission so they're authorized
+	// to create Batch Changes.
+	assignBatchChangesWritePermissionToUser(ctx, t, db, userID)
+
+	unauthorizedUser := bt.CreateTestUser(t, db, false)
+
+	batchSpec := bt.CreateBatchSpec(t, ctx, bstore, "test-rebase", userID, 0)
+	batchChange := bt.CreateBatchChange(t, ctx, bstore, "test-rebase", userID, batchSpec.ID)
+	repo, _ := bt.CreateTestRepo(t, ctx, db)
+	changeset := bt.CreateChangeset(t, ctx, bstore, bt.TestChangesetOpts{
+		Repo:             repo.ID,
+		BatchChange:      batchChange.ID,
+		PublicationState: btypes.ChangesetPublicationStatePublished,
+		ReconcilerState:  btypes.ReconcilerStateCompleted,
+		ExternalState:    btypes.ChangesetExternalStateOpen,
+	})
+	mergedChangeset := bt.CreateChangeset(t, ctx, bstore, bt.TestChangesetOpts{
+		Repo:             repo.ID,
+		BatchChange:      batchChange.ID,
+		PublicationState: btypes.ChangesetPublicationStatePublished,
+		ReconcilerState:  btypes.ReconcilerStateCompleted,
+		ExternalState:    btypes.ChangesetExternalStateMerged,
+	})
+
+	r := &Resolver{store: bstore}
+	s, err := newSchema(db, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	generateInput := func() map[string]any {
+		return map[string]any{
+			"batchChange": bgql.MarshalBatchChangeID(batchChange.ID),
+			"changesets":  []string{string(bgql.MarshalChangesetID(changeset.ID))},
+		}
+	}
+
+	var response struct {
+		RebaseChangesets apitest.BulkOperation
+	}
+	actorCtx := actor.WithActor(ctx, actor.FromUser(userID))
+
+	t.Run("unauthorized access", func(t *testing.T) {
+		unauthorizedCtx := actor.WithActor(ctx, actor.FromUser(unauthorizedUser.ID))
+		input := generateInput()
+		errs := apitest.Exec(unauthorizedCtx, t, s, input, &response, mutationRebaseChangesets)
+		if errs == nil {
+			t.Fatal("expected error")
+		}
+		firstErr := errs[0]
+		if !strings.Contains(firstErr.Error(), fmt.Sprintf("user is missing permission %s", rbac.BatchChangesWritePermission)) {
+			t.Fatalf("expected unauthorized error, got %+v", err)
+		}
+	})
+
+	t.Run("merged changeset fails", func(t *testing.T) {
+		input := generateInput()
+		input["changesets"] = []string{string(bgql.MarshalChangesetID(mergedChangeset.ID))}
+		errs := apitest.Exec(actorCtx, t, s, input, &response, mutationRebaseChangesets)
+
+		if len(errs) != 1 {
+			t.Fatalf("expected single errors, but got none")
+		}
+		if have, want := errs[0].Message, "some changesets could not be found"; have != want {
+			t.Fatalf("wrong error. want=%q, have=%q", want, have)
+		}
+	})
+
+	t.Run("runs successfully", func(t *testing.T) {
+		input := generateInput()
+		apitest.MustExec(actorCtx, t, s, input, &response, mutationRebaseChangesets)
+
+		if response.RebaseChangesets.ID == "" {
+			t.Fatalf("expected bulk operation to be created, but was not")
+		}
+		if response.RebaseChangesets.Type != "REBASE" {
+			t.Fatalf("unexpected bulk operation type %q", response.RebaseChangesets.Type)
+		}
+	})
+}
+
+const mutationRebaseChangesets = `
+mutation($batchChange: ID!, $changesets: [ID!]!) {
+    rebaseChangesets(batchChange: $batchChange, changesets: $changesets) { id type }
+}
+`
+
 func TestCheckBatchChangesCredential(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -2,6 +2,7 @@ package resolvers
 
 import (
 	"context"
+	"net/http"
 	"net/url"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/codemonitors"
 	"github.com/sourcegraph/sourcegraph/internal/codemonitors/background"
@@ -18,6 +20,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/dotcom"
 	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/sourcegraph/sourcegraph/lib/pointers"
 )
@@ -140,30 +143,47 @@ func (r *Resolver) CreateCodeMonitor(ctx context.Context, args *graphqlbackend.C
 		return nil, err
 	}
 
-	// Snapshot the state of the searched repos when the monitor is created so that
-	// we can distinguish new repos. We run the snapshot outside the transaction because
-	// search requires that the DB handle is not a transaction.
-	resolvedRevisions, err := codemonitors.Snapshot(ctx, r.logger, r.db, args.Trigger.Query)
+	notificationLimit, notificationLimitWindow, err := monitorNotificationLimit(args.Monitor.NotificationLimit)
 	if err != nil {
 		return nil, err
 	}
 
+	kind := triggerKind(args.Trigger.Kind, database.CommitQueryTrigger)
+	var resolvedRevisions map[api.RepoID][]string
+	if kind == database.ContentQueryTrigger {
+		// Content triggers record the files they match on their first run instead
+		// of snapshotting revisions.
+		if err := codemonitors.ValidateContentQuery(ctx, r.logger, r.db, args.Trigger.Query); err != nil {
+			return nil, err
+		}
+	} else {
+		// Snapshot the state of the searched repos when the monitor is created so that
+		// we can distinguish new repos. We run the snapshot outside the transaction because
+		// search requires that the DB handle is not a transaction.
+		resolvedRevisions, err = codemonitors.Snapshot(ctx, r.logger, r.db, args.Trigger.Query)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Start transaction.
 	var newMonitor *database.Monitor
 	err = r.withTransact(ctx, func(tx *Resolver) error {
 		// Create monitor.
 		m, err := tx.db.CodeMonitors().CreateMonitor(ctx, database.MonitorArgs{
-			Description:     args.Monitor.Description,
-			Enabled:         args.Monitor.Enabled,
-			NamespaceUserID: namespace.User,
-			NamespaceOrgID:  namespace.Org,
+			Description:             args.Monitor.Description,
+			Enabled:                 args.Monitor.Enabled,
+			NamespaceUserID:         namespace.User,
+			NamespaceOrgID:          namespace.Org,
+			NotificationLimit:       notificationLimit,
+			NotificationLimitWindow: notificationLimitWindow,
 		})
 		if err != nil {
 			return err
 		}
 
 		// Create trigger.
-		_, err = tx.db.CodeMonitors().CreateQueryTrigger(ctx, m.ID, args.Trigger.Query)
+		_, err = tx.db.CodeMonitors().CreateQueryTrigger(ctx, m.ID, args.Trigger.Query, kind)
 		if err != nil {
 			return err
 		}
@@ -289,6 +309,7 @@ func (r *Resolver) createActions(ctx context.Context, monitorID int64, args []*g
 			e, err := r.db.CodeMonitors().CreateEmailAction(ctx, monitorID, &database.EmailActionArgs{
 				Enabled:        a.Email.Enabled,
 				IncludeResults: a.Email.IncludeResults,
+				Delivery:       database.ActionDelivery(a.Email.Delivery),
 				Priority:       a.Email.Priority,
 				Header:         a.Email.Header,
 			})
@@ -300,7 +321,7 @@ func (r *Resolver) createActions(ctx context.Context, monitorID int64, args []*g
 				return err
 			}
 		case a.Webhook != nil:
-			_, err := r.db.CodeMonitors().CreateWebhookAction(ctx, monitorID, a.Webhook.Enabled, a.Webhook.IncludeResults, a.Webhook.URL)
+			_, err := r.db.CodeMonitors().CreateWebhookAction(ctx, monitorID, a.Webhook.Enabled, a.Webhook.IncludeResults, a.Webhook.URL, database.ActionDelivery(a.Webhook.Delivery))
 			if err != nil {
 				return err
 			}
@@ -308,19 +329,36 @@ func (r *Resolver) createActions(ctx context.Context, monitorID int64, args []*g
 			if err := validateSlackURL(a.SlackWebhook.URL); err != nil {
 				return err
 			}
-			_, err := r.db.CodeMonitors().CreateSlackWebhookAction(ctx, monitorID, a.SlackWebhook.Enabled, a.SlackWebhook.IncludeResults, a.SlackWebhook.URL)
+			_, err := r.db.CodeMonitors().CreateSlackWebhookAction(ctx, monitorID, a.SlackWebhook.Enabled, a.SlackWebhook.IncludeResults, a.SlackWebhook.URL, database.ActionDelivery(a.SlackWebhook.Delivery))
+			if err != nil {
+				return err
+			}
+		case a.TeamsWebhook != nil:
+			if err := validateTeamsURL(a.TeamsWebhook.URL); err != nil {
+				return err
+			}
+			_, err := r.db.CodeMonitors().CreateTeamsWebhookAction(ctx, monitorID, a.TeamsWebhook.Enabled, a.TeamsWebhook.IncludeResults, a.TeamsWebhook.URL, database.ActionDelivery(a.TeamsWebhook.Delivery))
+			if err != nil {
+				return err
+			}
+		case a.TemplatedWebhook != nil:
+			templatedArgs, err := templatedWebhookActionArgs(a.TemplatedWebhook)
+			if err != nil {
+				return err
+			}
+			_, err = r.db.CodeMonitors().CreateTemplatedWebhookAction(ctx, monitorID, templatedArgs)
 			if err != nil {
 				return err
 			}
 		default:
-			return errors.New("exactly one of Email, Webhook, or SlackWebhook must be set")
+			return errors.New("exactly one of Email, Webhook, SlackWebhook, TeamsWebhook, or TemplatedWebhook must be set")
 		}
 	}
 	return nil
 }
 
 func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, ids []graphql.ID) error {
-	var email, webhook, slackWebhook []int64
+	var email, webhook, slackWebhook, teamsWebhook, templatedWebhook []int64
 	for _, id := range ids {
 		var intID int64
 		err := relay.UnmarshalSpec(id, &intID)
@@ -335,8 +373,12 @@ func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, ids []gra
 			webhook = append(webhook, intID)
 		case monitorActionSlackWebhookKind:
 			slackWebhook = append(slackWebhook, intID)
+		case monitorActionTeamsWebhookKind:
+			teamsWebhook = append(teamsWebhook, intID)
+		case monitorActionTemplatedWebhookKind:
+			templatedWebhook = append(templatedWebhook, intID)
 		default:
-			return errors.New("action IDs must be exactly one of email, webhook, or slack webhook")
+			return errors.New("action IDs must be exactly one of email, webhook, slack webhook, teams webhook, or templated webhook")
 		}
 	}
 
@@ -352,6 +394,14 @@ func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, ids []gra
 		return err
 	}
 
+	if err := r.db.CodeMonitors().DeleteTeamsWebhookActions(ctx, monitorID, teamsWebhook...); err != nil {
+		return err
+	}
+
+	if err := r.db.CodeMonitors().DeleteTemplatedWebhookActions(ctx, monitorID, templatedWebhook...); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -432,6 +482,41 @@ func (r *Resolver) TriggerTestSlackWebhookAction(ctx context.Context, args *grap
 	return &graphqlbackend.EmptyResponse{}, nil
 }
 
+func (r *Resolver) TriggerTestTeamsWebhookAction(ctx context.Context, args *graphqlbackend.TriggerTestTeamsWebhookActionArgs) (*graphqlbackend.EmptyResponse, error) {
+	err := r.isAllowedToCreate(ctx, args.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateTeamsURL(args.TeamsWebhook.URL); err != nil {
+		return nil, err
+	}
+
+	if err := background.SendTestTeamsWebhook(ctx, httpcli.ExternalDoer, args.Description, args.TeamsWebhook.URL); err != nil {
+		return nil, err
+	}
+
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
+func (r *Resolver) TriggerTestTemplatedWebhookAction(ctx context.Context, args *graphqlbackend.TriggerTestTemplatedWebhookActionArgs) (*graphqlbackend.EmptyResponse, error) {
+	err := r.isAllowedToCreate(ctx, args.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	templatedArgs, err := templatedWebhookActionArgs(args.TemplatedWebhook)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := background.SendTestTemplatedWebhook(ctx, httpcli.ExternalDoer, args.Description, templatedArgs); err != nil {
+		return nil, err
+	}
+
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
 func sendTestEmail(ctx context.Context, db database.DB, recipient graphql.ID, description string) error {
 	var (
 		userID int32
@@ -463,7 +548,15 @@ func (r *Resolver) actionIDsForMonitorIDInt64(ctx context.Context, monitorID int
 	if err != nil {
 		return nil, err
 	}
-	ids := make([]graphql.ID, 0, len(emailActions)+len(webhookActions)+len(slackWebhookActions))
+	teamsWebhookActions, err := r.db.CodeMonitors().ListTeamsWebhookActions(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	templatedWebhookActions, err := r.db.CodeMonitors().ListTemplatedWebhookActions(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]graphql.ID, 0, len(emailActions)+len(webhookActions)+len(slackWebhookActions)+len(teamsWebhookActions)+len(templatedWebhookActions))
 	for _, emailAction := range emailActions {
 		ids = append(ids, (&monitorEmail{EmailAction: emailAction}).ID())
 	}
@@ -473,6 +566,12 @@ func (r *Resolver) actionIDsForMonitorIDInt64(ctx context.Context, monitorID int
 	for _, slackWebhookAction := range slackWebhookActions {
 		ids = append(ids, (&monitorSlackWebhook{SlackWebhookAction: slackWebhookAction}).ID())
 	}
+	for _, teamsWebhookAction := range teamsWebhookActions {
+		ids = append(ids, (&monitorTeamsWebhook{TeamsWebhookAction: teamsWebhookAction}).ID())
+	}
+	for _, templatedWebhookAction := range templatedWebhookActions {
+		ids = append(ids, (&monitorTemplatedWebhook{TemplatedWebhookAction: templatedWebhookAction}).ID())
+	}
 	return ids, nil
 }
 
@@ -517,6 +616,26 @@ func splitActionIDs(args *graphqlbackend.UpdateCodeMonitorArgs, actionIDs []grap
 			}
 			toUpdateActions = append(toUpdateActions, a)
 			delete(aMap, *a.SlackWebhook.Id)
+		case a.TeamsWebhook != nil:
+			if a.TeamsWebhook.Id == nil {
+				toCreate = append(toCreate, &graphqlbackend.CreateActionArgs{TeamsWebhook: a.TeamsWebhook.Update})
+				continue
+			}
+			if _, ok := aMap[*a.TeamsWebhook.Id]; !ok {
+				return nil, nil, errors.Errorf("unknown ID=%s for action", *a.TeamsWebhook.Id)
+			}
+			toUpdateActions = append(toUpdateActions, a)
+			delete(aMap, *a.TeamsWebhook.Id)
+		case a.TemplatedWebhook != nil:
+			if a.TemplatedWebhook.Id == nil {
+				toCreate = append(toCreate, &graphqlbackend.CreateActionArgs{TemplatedWebhook: a.TemplatedWebhook.Update})
+				continue
+			}
+			if _, ok := aMap[*a.TemplatedWebhook.Id]; !ok {
+				return nil, nil, errors.Errorf("unknown ID=%s for action", *a.TemplatedWebhook.Id)
+			}
+			toUpdateActions = append(toUpdateActions, a)
+			delete(aMap, *a.TemplatedWebhook.Id)
 		}
 	}
 
@@ -541,11 +660,18 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, rawDB database.DB, arg
 		return nil, err
 	}
 
+	notificationLimit, notificationLimitWindow, err := monitorNotificationLimit(args.Monitor.Update.NotificationLimit)
+	if err != nil {
+		return nil, err
+	}
+
 	mo, err := r.db.CodeMonitors().UpdateMonitor(ctx, monitorID, database.MonitorArgs{
-		Description:     args.Monitor.Update.Description,
-		Enabled:         args.Monitor.Update.Enabled,
-		NamespaceUserID: namespace.User,
-		NamespaceOrgID:  namespace.Org,
+		Description:             args.Monitor.Update.Description,
+		Enabled:                 args.Monitor.Update.Enabled,
+		NamespaceUserID:         namespace.User,
+		NamespaceOrgID:          namespace.Org,
+		NotificationLimit:       notificationLimit,
+		NotificationLimitWindow: notificationLimitWindow,
 	})
 	if err != nil {
 		return nil, err
@@ -561,9 +687,23 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, rawDB database.DB, arg
 		return nil, err
 	}
 
+	kind := triggerKind(args.Trigger.Update.Kind, currentTrigger.Kind)
+	triggerChanged := currentTrigger.QueryString != args.Trigger.Update.Query || currentTrigger.Kind != kind
+
+	if triggerChanged && kind == database.ContentQueryTrigger {
+		if err := codemonitors.ValidateContentQuery(ctx, r.logger, rawDB, args.Trigger.Update.Query); err != nil {
+			return nil, err
+		}
+		// Forget the files matched by the previous query, so that the next run
+		// records a new baseline instead of firing for every difference.
+		if err := r.db.CodeMonitors().DeleteLastContentMatches(ctx, monitorID); err != nil {
+			return nil, err
+		}
+	}
+
 	// When the query is changed, take a new snapshot of the commits that currently
 	// exist so we know where to start.
-	if currentTrigger.QueryString != args.Trigger.Update.Query {
+	if triggerChanged && kind == database.CommitQueryTrigger {
 		// Snapshot the state of the searched repos when the monitor is created so that
 		// we can distinguish new repos.
 		// NOTE: we use rawDB here because Snapshot requires that the db conn is not a transaction.
@@ -580,7 +720,7 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, rawDB database.DB, arg
 	}
 
 	// Update trigger.
-	err = r.db.CodeMonitors().UpdateQueryTrigger(ctx, triggerID, args.Trigger.Update.Query)
+	err = r.db.CodeMonitors().UpdateQueryTrigger(ctx, triggerID, args.Trigger.Update.Query, kind)
 	if err != nil {
 		return nil, err
 	}
@@ -603,8 +743,15 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, rawDB database.DB, arg
 				return nil, err
 			}
 			err = r.updateSlackWebhookAction(ctx, *action.SlackWebhook)
+		case action.TeamsWebhook != nil:
+			if err := validateTeamsURL(action.TeamsWebhook.Update.URL); err != nil {
+				return nil, err
+			}
+			err = r.updateTeamsWebhookAction(ctx, *action.TeamsWebhook)
+		case action.TemplatedWebhook != nil:
+			err = r.updateTemplatedWebhookAction(ctx, *action.TemplatedWebhook)
 		default:
-			err = errors.New("action must be one of email, webhook, or slack webhook")
+			err = errors.New("action must be one of email, webhook, slack webhook, teams webhook, or templated webhook")
 		}
 		if err != nil {
 			return nil, err
@@ -629,6 +776,7 @@ func (r *Resolver) updateEmailAction(ctx context.Context, args graphqlbackend.Ed
 	e, err := r.db.CodeMonitors().UpdateEmailAction(ctx, emailID, &database.EmailActionArgs{
 		Enabled:        args.Update.Enabled,
 		IncludeResults: args.Update.IncludeResults,
+		Delivery:       database.ActionDelivery(args.Update.Delivery),
 		Priority:       args.Update.Priority,
 		Header:         args.Update.Header,
 	})
@@ -645,7 +793,7 @@ func (r *Resolver) updateWebhookAction(ctx context.Context, args graphqlbackend.
 		return err
 	}
 
-	_, err = r.db.CodeMonitors().UpdateWebhookAction(ctx, id, args.Update.Enabled, args.Update.IncludeResults, args.Update.URL)
+	_, err = r.db.CodeMonitors().UpdateWebhookAction(ctx, id, args.Update.Enabled, args.Update.IncludeResults, args.Update.URL, database.ActionDelivery(args.Update.Delivery))
 	return err
 }
 
@@ -656,10 +804,112 @@ func (r *Resolver) updateSlackWebhookAction(ctx context.Context, args graphqlbac
 		return err
 	}
 
-	_, err = r.db.CodeMonitors().UpdateSlackWebhookAction(ctx, id, args.Update.Enabled, args.Update.IncludeResults, args.Update.URL)
+	_, err = r.db.CodeMonitors().UpdateSlackWebhookAction(ctx, id, args.Update.Enabled, args.Update.IncludeResults, args.Update.URL, database.ActionDelivery(args.Update.Delivery))
+	return err
+}
+
+func (r *Resolver) updateTeamsWebhookAction(ctx context.Context, args graphqlbackend.EditActionTeamsWebhookArgs) error {
+	var id int64
+	err := relay.UnmarshalSpec(*args.Id, &id)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.CodeMonitors().UpdateTeamsWebhookAction(ctx, id, args.Update.Enabled, args.Update.IncludeResults, args.Update.URL, database.ActionDelivery(args.Update.Delivery))
+	return err
+}
+
+func (r *Resolver) updateTemplatedWebhookAction(ctx context.Context, args graphqlbackend.EditActionTemplatedWebhookArgs) error {
+	var id int64
+	err := relay.UnmarshalSpec(*args.Id, &id)
+	if err != nil {
+		return err
+	}
+
+	templatedArgs, err := templatedWebhookActionArgs(args.Update)
+	if err != nil {
+		return err
+	}
+
+	stored, err := r.db.CodeMonitors().GetTemplatedWebhookAction(ctx, id)
+	if err != nil {
+		return err
+	}
+	storedHeaders, err := stored.Headers.Decrypt(ctx)
+	if err != nil {
+		return err
+	}
+	if err := unredactWebhookHeaders(templatedArgs.Headers, storedHeaders); err != nil {
+		return err
+	}
+
+	_, err = r.db.CodeMonitors().UpdateTemplatedWebhookAction(ctx, id, templatedArgs)
 	return err
 }
 
+// unredactWebhookHeaders replaces redacted header values with the stored value
+// of the header with the same name. Header values are redacted when they are
+// read, so clients pass the redacted values back to keep them unchanged.
+func unredactWebhookHeaders(headers, stored []database.WebhookHeader) error {
+	values := make(map[string]string, len(stored))
+	for _, h := range stored {
+		values[http.CanonicalHeaderKey(h.Name)] = h.Value
+	}
+
+	for i, h := range headers {
+		if h.Value != types.RedactedSecret {
+			continue
+		}
+		value, ok := values[http.CanonicalHeaderKey(h.Name)]
+		if !ok {
+			return errors.Errorf("webhook header %q has a redacted value, but no value is stored for it", h.Name)
+		}
+		headers[i].Value = value
+	}
+	return nil
+}
+
+// templatedWebhookActionArgs validates the input of a templated webhook action
+// and converts it to the arguments stored in the database.
+func templatedWebhookActionArgs(args *graphqlbackend.CreateActionTemplatedWebhookArgs) (*database.TemplatedWebhookActionArgs, error) {
+	if err := validateWebhookURL(args.URL); err != nil {
+		return nil, err
+	}
+
+	var headers []database.WebhookHeader
+	if args.Headers != nil {
+		headers = make([]database.WebhookHeader, 0, len(*args.Headers))
+		for _, h := range *args.Headers {
+			headers = append(headers, database.WebhookHeader{Name: h.Name, Value: h.Value})
+		}
+	}
+
+	if err := background.ValidateTemplatedWebhook(args.PayloadTemplate, headers); err != nil {
+		return nil, err
+	}
+
+	return &database.TemplatedWebhookActionArgs{
+		Enabled:         args.Enabled,
+		IncludeResults:  args.IncludeResults,
+		URL:             args.URL,
+		PayloadTemplate: args.PayloadTemplate,
+		Headers:         headers,
+		Delivery:        database.ActionDelivery(args.Delivery),
+	}, nil
+}
+
+// monitorNotificationLimit returns the notification limit of a monitor stored
+// in the database for the given input.
+func monitorNotificationLimit(args *graphqlbackend.MonitorNotificationLimitArgs) (*int32, database.NotificationLimitWindow, error) {
+	if args == nil {
+		return nil, database.NotificationLimitHour, nil
+	}
+	if args.Count < 1 {
+		return nil, "", errors.New("the notification limit of a code monitor must be at least 1")
+	}
+	return &args.Count, database.NotificationLimitWindow(args.Window), nil
+}
+
 func (r *Resolver) withTransact(ctx context.Context, f func(*Resolver) error) error {
 	return r.db.WithTransact(ctx, func(tx database.DB) error {
 		return f(&Resolver{
@@ -746,6 +996,8 @@ const (
 	monitorActionEmailKind             = "CodeMonitorActionEmail"
 	monitorActionWebhookKind           = "CodeMonitorActionWebhook"
 	monitorActionSlackWebhookKind      = "CodeMonitorActionSlackWebhook"
+	monitorActionTeamsWebhookKind      = "CodeMonitorActionTeamsWebhook"
+	monitorActionTemplatedWebhookKind  = "CodeMonitorActionTemplatedWebhook"
 	monitorActionEmailEventKind        = "CodeMonitorActionEmailEvent"
 	monitorActionWebhookEventKind      = "CodeMonitorActionWebhookEvent"
 	monitorActionSlackWebhookEventKind = "CodeMonitorActionSlackWebhookEvent"
@@ -770,6 +1022,15 @@ func unmarshalEmailID(id graphql.ID) (int64, error) {
 	return i, err
 }
 
+// triggerKind returns the requested kind of a trigger, or fallback if none was
+// requested.
+func triggerKind(kind *string, fallback database.QueryTriggerKind) database.QueryTriggerKind {
+	if kind == nil {
+		return fallback
+	}
+	return database.QueryTriggerKind(*kind)
+}
+
 func unmarshalAfter(after *string) (*int, error) {
 	if after == nil {
 		return nil, nil
@@ -823,6 +1084,26 @@ func (m *monitor) Actions(ctx context.Context, args *graphqlbackend.ListActionAr
 	return m.actionConnectionResolverWithTriggerID(ctx, nil, m.Monitor.ID, args)
 }
 
+func (m *monitor) NotificationLimit() graphqlbackend.MonitorNotificationLimitResolver {
+	if m.Monitor.NotificationLimit == nil {
+		return nil
+	}
+	return &monitorNotificationLimitResolver{count: *m.Monitor.NotificationLimit, window: m.Monitor.NotificationLimitWindow}
+}
+
+type monitorNotificationLimitResolver struct {
+	count  int32
+	window database.NotificationLimitWindow
+}
+
+func (l *monitorNotificationLimitResolver) Count() int32 {
+	return l.count
+}
+
+func (l *monitorNotificationLimitResolver) Window() string {
+	return string(l.window)
+}
+
 func (r *Resolver) actionConnectionResolverWithTriggerID(ctx context.Context, triggerEventID *int32, monitorID int64, args *graphqlbackend.ListActionArgs) (graphqlbackend.MonitorActionConnectionResolver, error) {
 	opts := database.ListActionsOpts{MonitorID: &monitorID}
 
@@ -841,7 +1122,17 @@ func (r *Resolver) actionConnectionResolverWithTriggerID(ctx context.Context, tr
 		return nil, err
 	}
 
-	actions := make([]graphqlbackend.MonitorAction, 0, len(es)+len(ws)+len(sws))
+	tws, err := r.db.CodeMonitors().ListTeamsWebhookActions(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	pws, err := r.db.CodeMonitors().ListTemplatedWebhookActions(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make([]graphqlbackend.MonitorAction, 0, len(es)+len(ws)+len(sws)+len(tws)+len(pws))
 	for _, e := range es {
 		actions = append(actions, &action{
 			email: &monitorEmail{
@@ -869,6 +1160,24 @@ func (r *Resolver) actionConnectionResolverWithTriggerID(ctx context.Context, tr
 			},
 		})
 	}
+	for _, tw := range tws {
+		actions = append(actions, &action{
+			teamsWebhook: &monitorTeamsWebhook{
+				Resolver:           r,
+				TeamsWebhookAction: tw,
+				triggerEventID:     triggerEventID,
+			},
+		})
+	}
+	for _, pw := range pws {
+		actions = append(actions, &action{
+			templatedWebhook: &monitorTemplatedWebhook{
+				Resolver:               r,
+				TemplatedWebhookAction: pw,
+				triggerEventID:         triggerEventID,
+			},
+		})
+	}
 
 	totalCount := len(actions)
 	if args.After != nil {
@@ -910,6 +1219,10 @@ func (q *monitorQuery) Query() string {
 	return q.QueryString
 }
 
+func (q *monitorQuery) Kind() string {
+	return string(q.QueryTrigger.Kind)
+}
+
 func (q *monitorQuery) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorTriggerEventConnectionResolver, error) {
 	after, err := unmarshalAfter(args.After)
 	if err != nil {
@@ -993,13 +1306,50 @@ func (m *monitorTriggerEvent) Query() *string {
 }
 
 func (m *monitorTriggerEvent) ResultCount() int32 {
-	count := 0
+	count := m.TriggerJob.ContentChanges.Count()
 	for _, cm := range m.TriggerJob.SearchResults {
 		count += cm.ResultCount()
 	}
 	return int32(count)
 }
 
+func (m *monitorTriggerEvent) ContentChanges() graphqlbackend.MonitorContentChangesResolver {
+	if m.TriggerJob.ContentChanges == nil {
+		return nil
+	}
+	return &monitorContentChanges{m.TriggerJob.ContentChanges}
+}
+
+// MonitorContentChanges
+type monitorContentChanges struct {
+	*database.ContentChanges
+}
+
+func (c *monitorContentChanges) Added() []graphqlbackend.MonitorContentMatchResolver {
+	return toContentMatchResolvers(c.ContentChanges.Added)
+}
+
+func (c *monitorContentChanges) Removed() []graphqlbackend.MonitorContentMatchResolver {
+	return toContentMatchResolvers(c.ContentChanges.Removed)
+}
+
+func toContentMatchResolvers(matches []database.ContentMatch) []graphqlbackend.MonitorContentMatchResolver {
+	resolvers := make([]graphqlbackend.MonitorContentMatchResolver, 0, len(matches))
+	for _, m := range matches {
+		resolvers = append(resolvers, &monitorContentMatch{m})
+	}
+	return resolvers
+}
+
+// MonitorContentMatch
+type monitorContentMatch struct {
+	database.ContentMatch
+}
+
+func (m *monitorContentMatch) Repository() string { return m.RepoName }
+
+func (m *monitorContentMatch) Path() string { return m.ContentMatch.Path }
+
 func (m *monitorTriggerEvent) Message() *string {
 	// Print failure message first
 	var msg string
@@ -1051,9 +1401,11 @@ func (a *monitorActionConnection) PageInfo() *gqlutil.PageInfo {
 
 // Action <<UNION>>
 type action struct {
-	email        graphqlbackend.MonitorEmailResolver
-	webhook      graphqlbackend.MonitorWebhookResolver
-	slackWebhook graphqlbackend.MonitorSlackWebhookResolver
+	email            graphqlbackend.MonitorEmailResolver
+	webhook          graphqlbackend.MonitorWebhookResolver
+	slackWebhook     graphqlbackend.MonitorSlackWebhookResolver
+	teamsWebhook     graphqlbackend.MonitorTeamsWebhookResolver
+	templatedWebhook graphqlbackend.MonitorTemplatedWebhookResolver
 }
 
 func (a *action) ID() graphql.ID {
@@ -1064,6 +1416,10 @@ func (a *action) ID() graphql.ID {
 		return a.webhook.ID()
 	case a.slackWebhook != nil:
 		return a.slackWebhook.ID()
+	case a.teamsWebhook != nil:
+		return a.teamsWebhook.ID()
+	case a.templatedWebhook != nil:
+		return a.templatedWebhook.ID()
 	default:
 		panic("action must have a type")
 	}
@@ -1081,6 +1437,14 @@ func (a *action) ToMonitorSlackWebhook() (graphqlbackend.MonitorSlackWebhookReso
 	return a.slackWebhook, a.slackWebhook != nil
 }
 
+func (a *action) ToMonitorTeamsWebhook() (graphqlbackend.MonitorTeamsWebhookResolver, bool) {
+	return a.teamsWebhook, a.teamsWebhook != nil
+}
+
+func (a *action) ToMonitorTemplatedWebhook() (graphqlbackend.MonitorTemplatedWebhookResolver, bool) {
+	return a.templatedWebhook, a.templatedWebhook != nil
+}
+
 // Email
 type monitorEmail struct {
 	*Resolver
@@ -1146,6 +1510,10 @@ func (m *monitorEmail) Priority() string {
 	return m.EmailAction.Priority
 }
 
+func (m *monitorEmail) Delivery() string {
+	return string(m.EmailAction.Delivery)
+}
+
 func (m *monitorEmail) Header() string {
 	return m.EmailAction.Header
 }
@@ -1210,6 +1578,10 @@ func (m *monitorWebhook) URL() string {
 	return m.WebhookAction.URL
 }
 
+func (m *monitorWebhook) Delivery() string {
+	return string(m.WebhookAction.Delivery)
+}
+
 func (m *monitorWebhook) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
 	after, err := unmarshalAfter(args.After)
 	if err != nil {
@@ -1266,6 +1638,10 @@ func (m *monitorSlackWebhook) URL() string {
 	return m.SlackWebhookAction.URL
 }
 
+func (m *monitorSlackWebhook) Delivery() string {
+	return string(m.SlackWebhookAction.Delivery)
+}
+
 func (m *monitorSlackWebhook) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
 	after, err := unmarshalAfter(args.After)
 	if err != nil {
@@ -1296,6 +1672,157 @@ func (m *monitorSlackWebhook) Events(ctx context.Context, args *graphqlbackend.L
 	return &monitorActionEventConnection{events: events, totalCount: int32(totalCount)}, nil
 }
 
+type monitorTeamsWebhook struct {
+	*Resolver
+	*database.TeamsWebhookAction
+
+	// If triggerEventID == nil, all events of this action will be returned.
+	// Otherwise, only those events of this action which are related to the specified
+	// trigger event will be returned.
+	triggerEventID *int32
+}
+
+func (m *monitorTeamsWebhook) ID() graphql.ID {
+	return relay.MarshalID(monitorActionTeamsWebhookKind, m.TeamsWebhookAction.ID)
+}
+
+func (m *monitorTeamsWebhook) Enabled() bool {
+	return m.TeamsWebhookAction.Enabled
+}
+
+func (m *monitorTeamsWebhook) IncludeResults() bool {
+	return m.TeamsWebhookAction.IncludeResults
+}
+
+func (m *monitorTeamsWebhook) URL() string {
+	return m.TeamsWebhookAction.URL
+}
+
+func (m *monitorTeamsWebhook) Delivery() string {
+	return string(m.TeamsWebhookAction.Delivery)
+}
+
+func (m *monitorTeamsWebhook) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
+	after, err := unmarshalAfter(args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	ajs, err := m.db.CodeMonitors().ListActionJobs(ctx, database.ListActionJobsOpts{
+		TeamsWebhookID: pointers.Ptr(int(m.TeamsWebhookAction.ID)),
+		TriggerEventID: m.triggerEventID,
+		First:          pointers.Ptr(int(args.First)),
+		After:          after,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount, err := m.db.CodeMonitors().CountActionJobs(ctx, database.ListActionJobsOpts{
+		TeamsWebhookID: pointers.Ptr(int(m.TeamsWebhookAction.ID)),
+		TriggerEventID: m.triggerEventID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := make([]graphqlbackend.MonitorActionEventResolver, len(ajs))
+	for i, aj := range ajs {
+		events[i] = &monitorActionEvent{Resolver: m.Resolver, ActionJob: aj}
+	}
+	return &monitorActionEventConnection{events: events, totalCount: int32(totalCount)}, nil
+}
+
+type monitorTemplatedWebhook struct {
+	*Resolver
+	*database.TemplatedWebhookAction
+
+	// If triggerEventID == nil, all events of this action will be returned.
+	// Otherwise, only those events of this action which are related to the specified
+	// trigger event will be returned.
+	triggerEventID *int32
+}
+
+func (m *monitorTemplatedWebhook) ID() graphql.ID {
+	return relay.MarshalID(monitorActionTemplatedWebhookKind, m.TemplatedWebhookAction.ID)
+}
+
+func (m *monitorTemplatedWebhook) Enabled() bool {
+	return m.TemplatedWebhookAction.Enabled
+}
+
+func (m *monitorTemplatedWebhook) IncludeResults() bool {
+	return m.TemplatedWebhookAction.IncludeResults
+}
+
+func (m *monitorTemplatedWebhook) URL() string {
+	return m.TemplatedWebhookAction.URL
+}
+
+func (m *monitorTemplatedWebhook) PayloadTemplate() string {
+	return m.TemplatedWebhookAction.PayloadTemplate
+}
+
+func (m *monitorTemplatedWebhook) Headers(ctx context.Context) ([]graphqlbackend.MonitorWebhookHeaderResolver, error) {
+	stored, err := m.TemplatedWebhookAction.Headers.Decrypt(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := make([]graphqlbackend.MonitorWebhookHeaderResolver, 0, len(stored))
+	for _, h := range stored {
+		headers = append(headers, &monitorWebhookHeader{h})
+	}
+	return headers, nil
+}
+
+func (m *monitorTemplatedWebhook) Delivery() string {
+	return string(m.TemplatedWebhookAction.Delivery)
+}
+
+func (m *monitorTemplatedWebhook) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
+	after, err := unmarshalAfter(args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	ajs, err := m.db.CodeMonitors().ListActionJobs(ctx, database.ListActionJobsOpts{
+		TemplatedWebhookID: pointers.Ptr(int(m.TemplatedWebhookAction.ID)),
+		TriggerEventID:     m.triggerEventID,
+		First:              pointers.Ptr(int(args.First)),
+		After:              after,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount, err := m.db.CodeMonitors().CountActionJobs(ctx, database.ListActionJobsOpts{
+		TemplatedWebhookID: pointers.Ptr(int(m.TemplatedWebhookAction.ID)),
+		TriggerEventID:     m.triggerEventID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := make([]graphqlbackend.MonitorActionEventResolver, len(ajs))
+	for i, aj := range ajs {
+		events[i] = &monitorActionEvent{Resolver: m.Resolver, ActionJob: aj}
+	}
+	return &monitorActionEventConnection{events: events, totalCount: int32(totalCount)}, nil
+}
+
+type monitorWebhookHeader struct {
+	database.WebhookHeader
+}
+
+func (h *monitorWebhookHeader) Name() string {
+	return h.WebhookHeader.Name
+}
+
+// Value is always redacted, since header values are often credentials of the
+// webhook receiver.
+func (h *monitorWebhookHeader) Value() string {
+	return types.RedactedSecret
+}
+
 func intPtrToInt64Ptr(i *int) *int64 {
 	if i == nil {
 		return nil
@@ -1366,7 +1893,12 @@ func (m *monitorActionEvent) Status() (string, error) {
 }
 
 func (m *monitorActionEvent) Message() *string {
-	return m.FailureMessage
+	if m.FailureMessage != nil {
+		return m.FailureMessage
+	}
+	// Jobs which were merged into a digest or suppressed by the notification
+	// limit of their monitor record why.
+	return m.LogContents
 }
 
 func (m *monitorActionEvent) Timestamp() gqlutil.DateTime {
@@ -1388,3 +1920,27 @@ func validateSlackURL(urlString string) error {
 	}
 	return nil
 }
+
+// validateTeamsURL restricts Microsoft Teams webhooks to HTTPS. Unlike Slack,
+// incoming webhooks and workflows are served from several hosts.
+func validateTeamsURL(urlString string) error {
+	u, err := url.Parse(urlString)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return errors.New("teams webhook URL must begin with 'https://'")
+	}
+	return nil
+}
+
+func validateWebhookURL(urlString string) error {
+	u, err := url.Parse(urlString)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return errors.New("webhook URL must be an absolute http or https URL")
+	}
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/codemonitors/background"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/encryption"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/settings"
 	"github.com/sourcegraph/sourcegraph/internal/types"
@@ -1356,3 +1357,78 @@ func TestValidateSlackURL(t *testing.T) {
 		require.Error(t, validateSlackURL(url))
 	}
 }
+
+func TestValidateTeamsURL(t *testing.T) {
+	valid := []string{
+		"https://contoso.webhook.office.com/webhookb2/8d8d8/IncomingWebhook/8dd88d/838383",
+		"https://prod-00.westus.logic.azure.com:443/workflows/8d8d8/triggers/manual/paths/invoke",
+	}
+
+	for _, url := range valid {
+		require.NoError(t, validateTeamsURL(url))
+	}
+
+	invalid := []string{
+		"http://contoso.webhook.office.com/webhookb2",
+		"https://",
+		"contoso.webhook.office.com",
+	}
+
+	for _, url := range invalid {
+		require.Error(t, validateTeamsURL(url))
+	}
+}
+
+func TestTemplatedWebhookActionArgs(t *testing.T) {
+	args, err := templatedWebhookActionArgs(&graphqlbackend.CreateActionTemplatedWebhookArgs{
+		Enabled:         true,
+		URL:             "https://example.com/hook",
+		PayloadTemplate: `{"text": {{json .MonitorDescription}}}`,
+		Headers:         &[]*graphqlbackend.WebhookHeaderArgs{{Name: "Authorization", Value: "Bearer abc"}},
+		Delivery:        "IMMEDIATE",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []database.WebhookHeader{{Name: "Authorization", Value: "Bearer abc"}}, args.Headers)
+
+	_, err = templatedWebhookActionArgs(&graphqlbackend.CreateActionTemplatedWebhookArgs{
+		URL:             "ftp://example.com/hook",
+		PayloadTemplate: `{}`,
+	})
+	require.Error(t, err)
+
+	_, err = templatedWebhookActionArgs(&graphqlbackend.CreateActionTemplatedWebhookArgs{
+		URL:             "https://example.com/hook",
+		PayloadTemplate: `{{.NoSuchField}}`,
+	})
+	require.Error(t, err)
+}
+
+func TestUnredactWebhookHeaders(t *testing.T) {
+	stored := []database.WebhookHeader{{Name: "Authorization", Value: "Bearer abc"}}
+
+	headers := []database.WebhookHeader{
+		{Name: "authorization", Value: types.RedactedSecret},
+		{Name: "X-Team", Value: "search"},
+	}
+	require.NoError(t, unredactWebhookHeaders(headers, stored))
+	require.Equal(t, []database.WebhookHeader{
+		{Name: "authorization", Value: "Bearer abc"},
+		{Name: "X-Team", Value: "search"},
+	}, headers)
+
+	err := unredactWebhookHeaders([]database.WebhookHeader{{Name: "X-Api-Key", Value: types.RedactedSecret}}, stored)
+	require.Error(t, err)
+}
+
+func TestMonitorWebhookHeaderValueIsRedacted(t *testing.T) {
+	ctx := context.Background()
+	stored, err := encryption.NewUnencryptedJSON([]database.WebhookHeader{{Name: "Authorization", Value: "Bearer abc"}})
+	require.NoError(t, err)
+
+	w := &monitorTemplatedWebhook{TemplatedWebhookAction: &database.TemplatedWebhookAction{Headers: stored}}
+	headers, err := w.Headers(ctx)
+	require.NoError(t, err)
+	require.Len(t, headers, 1)
+	require.Equal(t, "Authorization", headers[0].Name())
+	require.Equal(t, types.RedactedSecret, headers[0].Value())
+}
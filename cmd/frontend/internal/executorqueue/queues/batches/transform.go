@@ -237,6 +237,35 @@ func transformRecord(ctx context.Context, logger log.Logger, s BatchesStore, job
 
 			step := batchSpec.Spec.Steps[i]
 
+			// Builtin steps don't need a container of their own. The executor
+			// runs the batcheshelper modes for them natively against the
+			// workspace, and falls back to the helper image on runtimes that
+			// can't.
+			if step.Builtin != nil {
+				for _, s := range []struct {
+					key  string
+					mode string
+				}{
+					{key: executorutil.FormatPreKey(i), mode: "pre"},
+					{key: executorutil.FormatRunKey(i), mode: "builtin"},
+					{key: executorutil.FormatPostKey(i), mode: "post"},
+				} {
+					dockerSteps = append(dockerSteps, apiclient.DockerStep{
+						Key:   s.key,
+						Image: helperImage,
+						Env:   secretEnvVars,
+						Dir:   ".",
+						Commands: []string{
+							shellquote.Join("batcheshelper", s.mode, strconv.Itoa(i)),
+						},
+						Builtin: &apiclient.BuiltinStep{Mode: s.mode, Step: i},
+					})
+				}
+
+				aj.DockerSteps = dockerSteps
+				continue
+			}
+
 			runDir := srcRepoDir
 			if workspace.Path != "" {
 				runDir = path.Join(runDir, workspace.Path)
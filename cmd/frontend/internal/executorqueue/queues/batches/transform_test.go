@@ -397,3 +397,126 @@ steps:
 		mockassert.CalledN(t, sal.CreateFunc, 5)
 	})
 }
+
+func TestTransformRecord_BuiltinStep(t *testing.T) {
+	db := dbmocks.NewMockDB()
+	repos := dbmocks.NewMockRepoStore()
+	repos.GetFunc.SetDefaultHook(func(ctx context.Context, id api.RepoID) (*types.Repo, error) {
+		return &types.Repo{ID: id, Name: "github.com/sourcegraph/sourcegraph"}, nil
+	})
+	db.ReposFunc.SetDefaultReturn(repos)
+	db.ExecutorSecretAccessLogsFunc.SetDefaultReturn(dbmocks.NewMockExecutorSecretAccessLogStore())
+
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+		ExternalURL:                    "https://test.io",
+		ExecutorsBatcheshelperImage:    "sourcegraph/batcheshelper",
+		ExecutorsBatcheshelperImageTag: "1.2.3",
+	}})
+	t.Cleanup(func() {
+		conf.Mock(nil)
+	})
+
+	spec := batcheslib.BatchSpec{}
+	err := yaml.Unmarshal([]byte(`
+steps:
+  - builtin:
+      regexReplace:
+        paths: ["**/*.go"]
+        pattern: ioutil\.ReadFile
+        replacement: os.ReadFile
+  - run: gofmt -w .
+    container: golang:1.21
+`), &spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	batchSpec := &btypes.BatchSpec{
+		RandID:          "abc",
+		UserID:          123,
+		NamespaceUserID: 123,
+		RawSpec:         "horse",
+		Spec:            &spec,
+	}
+
+	workspace := &btypes.BatchSpecWorkspace{
+		BatchSpecID:      batchSpec.ID,
+		ChangesetSpecIDs: []int64{},
+		RepoID:           5678,
+		Branch:           "refs/heads/base-branch",
+		Commit:           "d34db33f",
+	}
+
+	workspaceExecutionJob := &btypes.BatchSpecWorkspaceExecutionJob{
+		ID:                   42,
+		BatchSpecWorkspaceID: workspace.ID,
+		UserID:               123,
+		Version:              2,
+	}
+
+	store := NewMockBatchesStore()
+	store.GetBatchSpecFunc.SetDefaultReturn(batchSpec, nil)
+	store.GetBatchSpecWorkspaceFunc.SetDefaultReturn(workspace, nil)
+	store.DatabaseDBFunc.SetDefaultReturn(db)
+
+	job, err := transformRecord(context.Background(), logtest.Scoped(t), store, workspaceExecutionJob, "0.0.0-dev")
+	if err != nil {
+		t.Fatalf("unexpected error transforming record: %s", err)
+	}
+
+	// The builtin step is run by the helper image, the container step in its
+	// own container.
+	wantSteps := []apiclient.DockerStep{
+		{
+			Key:      "step.0.pre",
+			Image:    "sourcegraph/batcheshelper:1.2.3",
+			Env:      []string{},
+			Dir:      ".",
+			Commands: []string{"batcheshelper pre 0"},
+			Builtin:  &apiclient.BuiltinStep{Mode: "pre", Step: 0},
+		},
+		{
+			Key:      "step.0.run",
+			Image:    "sourcegraph/batcheshelper:1.2.3",
+			Env:      []string{},
+			Dir:      ".",
+			Commands: []string{"batcheshelper builtin 0"},
+			Builtin:  &apiclient.BuiltinStep{Mode: "builtin", Step: 0},
+		},
+		{
+			Key:      "step.0.post",
+			Image:    "sourcegraph/batcheshelper:1.2.3",
+			Env:      []string{},
+			Dir:      ".",
+			Commands: []string{"batcheshelper post 0"},
+			Builtin:  &apiclient.BuiltinStep{Mode: "post", Step: 0},
+		},
+		{
+			Key:      "step.1.pre",
+			Image:    "sourcegraph/batcheshelper:1.2.3",
+			Env:      []string{},
+			Dir:      ".",
+			Commands: []string{"batcheshelper pre 1"},
+		},
+		{
+			Key:   "step.1.run",
+			Image: "golang:1.21",
+			Dir:   "repository",
+			Commands: []string{
+				"{ set +x; } 2>/dev/null",
+				"{ set -eo pipefail; } 2>/dev/null",
+				`(exec "../step1.sh" | tee ../stdout1.log) 3>&1 1>&2 2>&3 | tee ../stderr1.log`,
+			},
+		},
+		{
+			Key:      "step.1.post",
+			Image:    "sourcegraph/batcheshelper:1.2.3",
+			Env:      []string{},
+			Dir:      ".",
+			Commands: []string{"batcheshelper post 1"},
+		},
+	}
+	if diff := cmp.Diff(wantSteps, job.DockerSteps); diff != "" {
+		t.Errorf("unexpected docker steps (-want +got):\n%s", diff)
+	}
+}
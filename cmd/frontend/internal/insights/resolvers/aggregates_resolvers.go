@@ -12,11 +12,13 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/insights/aggregation"
 	"github.com/sourcegraph/sourcegraph/internal/insights/query/querybuilder"
 	"github.com/sourcegraph/sourcegraph/internal/insights/query/streaming"
 	"github.com/sourcegraph/sourcegraph/internal/insights/types"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/own"
 	"github.com/sourcegraph/sourcegraph/internal/search/client"
 	"github.com/sourcegraph/sourcegraph/internal/search/limits"
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
@@ -36,6 +38,8 @@ const (
 const invalidQueryMsg = "Grouping is disabled because the search query is not valid."
 const fileUnsupportedFieldValueFmt = `Grouping by file is not available for searches with "%s:%s".`
 const authNotCommitDiffMsg = "Grouping by author is only available for diff and commit searches."
+const commitMonthNotCommitDiffMsg = "Grouping by commit month is only available for diff and commit searches."
+const symbolKindNotSymbolMsg = "Grouping by symbol kind is only available for symbol searches."
 const repoMetadataNotRepoSelectMsg = "Grouping by repo metadata is only available for repository searches."
 const cgInvalidQueryMsg = "Grouping by capture group is only available for regexp searches that contain a capturing group."
 const cgMultipleQueryPatternMsg = "Grouping by capture group does not support search patterns with the following: and, or, negation."
@@ -140,7 +144,11 @@ func (r *searchAggregateResolver) Aggregations(ctx context.Context, args graphql
 		cappedAggregator.Add(amr.Key.Group, int32(amr.Count))
 	}
 
-	countingFunc, err := aggregation.GetCountFuncForMode(r.searchQuery, r.patternType, aggregationMode)
+	groupOptions := aggregation.GroupOptions{
+		DirectoryDepth: int(args.DirectoryDepth),
+		Owners:         own.NewService(gitserver.NewClient("graphql.insights.aggregations"), r.postgresDB),
+	}
+	countingFunc, err := aggregation.GetCountFuncForMode(ctx, r.searchQuery, r.patternType, aggregationMode, groupOptions)
 	if err != nil {
 		r.getLogger().Debug("no aggregation counting function for mode", log.String("mode", string(aggregationMode)), log.Error(err))
 		return &searchAggregationResultResolver{
@@ -366,6 +374,11 @@ func getAggregateBy(mode types.SearchAggregationMode) canAggregateBy {
 		types.AUTHOR_AGGREGATION_MODE:        canAggregateByAuthor,
 		types.CAPTURE_GROUP_AGGREGATION_MODE: canAggregateByCaptureGroup,
 		types.REPO_METADATA_AGGREGATION_MODE: canAggregateByRepoMetadata,
+		types.DIRECTORY_AGGREGATION_MODE:     canAggregateByPath,
+		types.OWNER_AGGREGATION_MODE:         canAggregateByPath,
+		types.LANGUAGE_AGGREGATION_MODE:      canAggregateByPath,
+		types.SYMBOL_KIND_AGGREGATION_MODE:   canAggregateBySymbolKind,
+		types.COMMIT_MONTH_AGGREGATION_MODE:  canAggregateByCommitMonth,
 	}
 	canAggregateByFunc, ok := checkByMode[mode]
 	if !ok {
@@ -428,6 +441,32 @@ func canAggregateByAuthor(searchQuery, patternType string) (bool, *notAvailableR
 	return false, &notAvailableReason{reason: authNotCommitDiffMsg, reasonType: types.INVALID_AGGREGATION_MODE_FOR_QUERY}, nil
 }
 
+func canAggregateByCommitMonth(searchQuery, patternType string) (bool, *notAvailableReason, error) {
+	available, reason, err := canAggregateByAuthor(searchQuery, patternType)
+	if reason != nil && reason.reason == authNotCommitDiffMsg {
+		reason.reason = commitMonthNotCommitDiffMsg
+	}
+	return available, reason, err
+}
+
+func canAggregateBySymbolKind(searchQuery, patternType string) (bool, *notAvailableReason, error) {
+	plan, err := querybuilder.ParseQuery(searchQuery, patternType)
+	if err != nil {
+		return false, &notAvailableReason{reason: invalidQueryMsg, reasonType: types.INVALID_QUERY}, errors.Wrapf(err, "ParseQuery")
+	}
+	parameters := querybuilder.ParametersFromQueryPlan(plan)
+	// can only aggregate over type:symbol and select:symbol searches.
+	for _, parameter := range parameters {
+		if parameter.Field == query.FieldType && parameter.Value == "symbol" {
+			return true, nil, nil
+		}
+		if parameter.Field == query.FieldSelect && strings.HasPrefix(parameter.Value, "symbol") {
+			return true, nil, nil
+		}
+	}
+	return false, &notAvailableReason{reason: symbolKindNotSymbolMsg, reasonType: types.INVALID_AGGREGATION_MODE_FOR_QUERY}, nil
+}
+
 func canAggregateByCaptureGroup(searchQuery, patternType string) (bool, *notAvailableReason, error) {
 	plan, err := querybuilder.ParseQuery(searchQuery, patternType)
 	if err != nil {
@@ -602,6 +641,16 @@ func buildDrilldownQuery(mode types.SearchAggregationMode, originalQuery string,
 		modifierFunc = querybuilder.AddFileFilter
 	case types.AUTHOR_AGGREGATION_MODE:
 		modifierFunc = querybuilder.AddAuthorFilter
+	case types.DIRECTORY_AGGREGATION_MODE:
+		modifierFunc = querybuilder.AddDirectoryFilter
+	case types.OWNER_AGGREGATION_MODE:
+		modifierFunc = querybuilder.AddOwnerFilter
+	case types.LANGUAGE_AGGREGATION_MODE:
+		modifierFunc = querybuilder.AddLanguageFilter
+	case types.SYMBOL_KIND_AGGREGATION_MODE:
+		modifierFunc = querybuilder.AddSymbolKindFilter
+	case types.COMMIT_MONTH_AGGREGATION_MODE:
+		modifierFunc = querybuilder.AddCommitMonthFilter
 	case types.CAPTURE_GROUP_AGGREGATION_MODE:
 		searchType, err := client.SearchTypeFromString(patternType)
 		if err != nil {
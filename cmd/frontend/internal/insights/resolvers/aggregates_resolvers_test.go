@@ -189,6 +189,33 @@ func Test_canAggregateByAuthor(t *testing.T) {
 	suite.Test_canAggregateBy()
 }
 
+func Test_canAggregateBySymbolKind(t *testing.T) {
+	testCases := []canAggregateTestCase{
+		{
+			name:         "cannot aggregate for query without parameters",
+			query:        "func(t *testing.T)",
+			reason:       symbolKindNotSymbolMsg,
+			canAggregate: false,
+		},
+		{
+			name:         "can aggregate for query with type:symbol parameter",
+			query:        "type:symbol handler",
+			canAggregate: true,
+		},
+		{
+			name:         "can aggregate for query with select:symbol parameter",
+			query:        "handler select:symbol.function",
+			canAggregate: true,
+		},
+	}
+	suite := canAggregateBySuite{
+		canAggregateByFunc: canAggregateBySymbolKind,
+		testCases:          testCases,
+		t:                  t,
+	}
+	suite.Test_canAggregateBy()
+}
+
 func Test_canAggregateByCaptureGroup(t *testing.T) {
 	testCases := []canAggregateTestCase{
 		{
@@ -470,6 +497,41 @@ func Test_buildDrilldownQuery(t *testing.T) {
 			patternType: "standard",
 			mode:        types.CAPTURE_GROUP_AGGREGATION_MODE,
 		},
+		{
+			want:        autogold.Expect("file:^cmd/frontend/ findme"),
+			query:       "findme",
+			drilldown:   "cmd/frontend",
+			patternType: "standard",
+			mode:        types.DIRECTORY_AGGREGATION_MODE,
+		},
+		{
+			want:        autogold.Expect("file:has.owner(@alice) findme"),
+			query:       "findme",
+			drilldown:   "@alice",
+			patternType: "standard",
+			mode:        types.OWNER_AGGREGATION_MODE,
+		},
+		{
+			want:        autogold.Expect("lang:Go findme"),
+			query:       "findme",
+			drilldown:   "Go",
+			patternType: "standard",
+			mode:        types.LANGUAGE_AGGREGATION_MODE,
+		},
+		{
+			want:        autogold.Expect("type:symbol select:symbol.function findme"),
+			query:       "findme type:symbol",
+			drilldown:   "FUNCTION",
+			patternType: "standard",
+			mode:        types.SYMBOL_KIND_AGGREGATION_MODE,
+		},
+		{
+			want:        autogold.Expect(`type:commit after:"2024-03-01" before:"2024-04-01" findme`),
+			query:       "findme type:commit",
+			drilldown:   "2024-03",
+			patternType: "standard",
+			mode:        types.COMMIT_MONTH_AGGREGATION_MODE,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.query, func(t *testing.T) {
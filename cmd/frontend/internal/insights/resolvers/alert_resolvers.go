@@ -0,0 +1,206 @@
+package resolvers
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var _ graphqlbackend.InsightSeriesAlertResolver = &insightSeriesAlertResolver{}
+
+const insightSeriesAlertKind = "InsightSeriesAlert"
+
+func (r *Resolver) InsightSeriesAlerts(ctx context.Context, args *graphqlbackend.InsightSeriesAlertsArgs) ([]graphqlbackend.InsightSeriesAlertResolver, error) {
+	uid := actor.FromContext(ctx).UID
+	if uid == 0 {
+		return nil, auth.ErrNotAuthenticated
+	}
+	seriesIDs, err := r.viewSeriesIDs(ctx, args.InsightViewId)
+	if err != nil {
+		return nil, err
+	}
+
+	alertStore := store.NewAlertStore(r.insightsDB)
+	var resolvers []graphqlbackend.InsightSeriesAlertResolver
+	for _, seriesID := range seriesIDs {
+		alerts, err := alertStore.GetAlerts(ctx, store.AlertQueryArgs{SeriesID: seriesID, UserID: uid})
+		if err != nil {
+			return nil, errors.Wrap(err, "GetAlerts")
+		}
+		for _, alert := range alerts {
+			resolvers = append(resolvers, &insightSeriesAlertResolver{alert: alert})
+		}
+	}
+	return resolvers, nil
+}
+
+func (r *Resolver) CreateInsightSeriesAlert(ctx context.Context, args *graphqlbackend.CreateInsightSeriesAlertArgs) (graphqlbackend.InsightSeriesAlertResolver, error) {
+	uid := actor.FromContext(ctx).UID
+	if uid == 0 {
+		return nil, auth.ErrNotAuthenticated
+	}
+	seriesIDs, err := r.viewSeriesIDs(ctx, args.Input.InsightViewId)
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, seriesID := range seriesIDs {
+		if seriesID == args.Input.SeriesId {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, errors.Newf("series %q is not part of the insight view", args.Input.SeriesId)
+	}
+
+	alert := types.InsightSeriesAlert{
+		SeriesID:  args.Input.SeriesId,
+		Kind:      types.AlertKind(args.Input.Kind),
+		Threshold: args.Input.Threshold,
+		Action:    types.AlertActionType(args.Input.Action),
+		ActionURL: args.Input.Url,
+		UserID:    uid,
+	}
+	if err := validateAlert(alert); err != nil {
+		return nil, err
+	}
+
+	created, err := store.NewAlertStore(r.insightsDB).CreateAlert(ctx, alert)
+	if err != nil {
+		return nil, err
+	}
+	return &insightSeriesAlertResolver{alert: created}, nil
+}
+
+func (r *Resolver) DeleteInsightSeriesAlert(ctx context.Context, args *graphqlbackend.DeleteInsightSeriesAlertArgs) (*graphqlbackend.EmptyResponse, error) {
+	var id int
+	if err := relay.UnmarshalSpec(args.Id, &id); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling the alert id")
+	}
+
+	alertStore := store.NewAlertStore(r.insightsDB)
+	alerts, err := alertStore.GetAlerts(ctx, store.AlertQueryArgs{ID: id})
+	if err != nil {
+		return nil, errors.Wrap(err, "GetAlerts")
+	}
+	if len(alerts) != 1 {
+		return nil, errors.New("Alert not found.")
+	}
+
+	// 🚨 SECURITY: Only the creator of an alert and site admins can delete it.
+	if uid := actor.FromContext(ctx).UID; uid == 0 || alerts[0].UserID != uid {
+		if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.postgresDB); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := alertStore.DeleteAlert(ctx, id); err != nil {
+		return nil, errors.Wrap(err, "DeleteAlert")
+	}
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
+// viewSeriesIDs returns the series of an insight view, after validating that
+// the current user can access the view.
+func (r *Resolver) viewSeriesIDs(ctx context.Context, id graphql.ID) ([]string, error) {
+	var viewId string
+	if err := relay.UnmarshalSpec(id, &viewId); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling the insight view id")
+	}
+	permissionsValidator := PermissionsValidatorFromBase(&r.baseInsightResolver)
+	if err := permissionsValidator.validateUserAccessForView(ctx, viewId); err != nil {
+		return nil, err
+	}
+
+	insights, err := r.insightStore.GetMapped(ctx, store.InsightQueryArgs{WithoutAuthorization: true, UniqueID: viewId})
+	if err != nil {
+		return nil, errors.Wrap(err, "GetMapped")
+	}
+	if len(insights) != 1 {
+		return nil, errors.New("Insight not found.")
+	}
+	seriesIDs := make([]string, 0, len(insights[0].Series))
+	for _, series := range insights[0].Series {
+		seriesIDs = append(seriesIDs, series.SeriesID)
+	}
+	return seriesIDs, nil
+}
+
+func validateAlert(alert types.InsightSeriesAlert) error {
+	switch alert.Kind {
+	case types.AbsoluteThresholdAlert, types.RepoThresholdAlert:
+	case types.PercentChangeAlert:
+		if alert.Threshold <= 0 {
+			return errors.New("the threshold of a percent change alert must be positive")
+		}
+	default:
+		return errors.Newf("unknown alert kind %q", alert.Kind)
+	}
+
+	switch alert.Action {
+	case types.EmailAlertAction:
+		if alert.ActionURL != nil {
+			return errors.New("email alerts do not take a URL")
+		}
+		return nil
+	case types.SlackWebhookAlertAction, types.WebhookAlertAction:
+		if alert.ActionURL == nil {
+			return errors.Newf("%s alerts require a URL", alert.Action)
+		}
+		u, err := url.Parse(*alert.ActionURL)
+		if err != nil {
+			return err
+		}
+		if alert.Action == types.SlackWebhookAlertAction && (u.Host != "hooks.slack.com" || u.Scheme != "https") {
+			// Restrict slack webhooks to only canonical host and HTTPS, like code monitors.
+			return errors.New("slack webhook URL must begin with 'https://hooks.slack.com/")
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return errors.New("webhook URL must use HTTP or HTTPS")
+		}
+		return nil
+	default:
+		return errors.Newf("unknown alert action %q", alert.Action)
+	}
+}
+
+type insightSeriesAlertResolver struct {
+	alert types.InsightSeriesAlert
+}
+
+func (r *insightSeriesAlertResolver) ID() graphql.ID {
+	return relay.MarshalID(insightSeriesAlertKind, r.alert.ID)
+}
+
+func (r *insightSeriesAlertResolver) SeriesId() string { return r.alert.SeriesID }
+
+func (r *insightSeriesAlertResolver) Kind() string { return string(r.alert.Kind) }
+
+func (r *insightSeriesAlertResolver) Threshold() float64 { return r.alert.Threshold }
+
+func (r *insightSeriesAlertResolver) Action() string { return string(r.alert.Action) }
+
+func (r *insightSeriesAlertResolver) Url() *string { return r.alert.ActionURL }
+
+func (r *insightSeriesAlertResolver) LastEvaluatedAt() *gqlutil.DateTime {
+	return gqlutil.DateTimeOrNil(r.alert.LastEvaluatedAt)
+}
+
+func (r *insightSeriesAlertResolver) LastFiredAt() *gqlutil.DateTime {
+	return gqlutil.DateTimeOrNil(r.alert.LastFiredAt)
+}
+
+func (r *insightSeriesAlertResolver) CreatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.alert.CreatedAt}
+}
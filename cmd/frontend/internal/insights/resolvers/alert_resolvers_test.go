@@ -0,0 +1,66 @@
+package resolvers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+)
+
+func TestValidateAlert(t *testing.T) {
+	url := func(s string) *string { return &s }
+
+	for _, tc := range []struct {
+		name    string
+		alert   types.InsightSeriesAlert
+		wantErr bool
+	}{
+		{
+			name:  "email",
+			alert: types.InsightSeriesAlert{Kind: types.AbsoluteThresholdAlert, Threshold: 100, Action: types.EmailAlertAction},
+		},
+		{
+			name:    "email with URL",
+			alert:   types.InsightSeriesAlert{Kind: types.AbsoluteThresholdAlert, Threshold: 100, Action: types.EmailAlertAction, ActionURL: url("https://example.com")},
+			wantErr: true,
+		},
+		{
+			name:  "slack webhook",
+			alert: types.InsightSeriesAlert{Kind: types.RepoThresholdAlert, Threshold: 10, Action: types.SlackWebhookAlertAction, ActionURL: url("https://hooks.slack.com/services/abc")},
+		},
+		{
+			name:    "slack webhook on another host",
+			alert:   types.InsightSeriesAlert{Kind: types.RepoThresholdAlert, Threshold: 10, Action: types.SlackWebhookAlertAction, ActionURL: url("https://example.com/services/abc")},
+			wantErr: true,
+		},
+		{
+			name:  "webhook",
+			alert: types.InsightSeriesAlert{Kind: types.PercentChangeAlert, Threshold: 20, Action: types.WebhookAlertAction, ActionURL: url("https://example.com/hook")},
+		},
+		{
+			name:    "webhook without URL",
+			alert:   types.InsightSeriesAlert{Kind: types.PercentChangeAlert, Threshold: 20, Action: types.WebhookAlertAction},
+			wantErr: true,
+		},
+		{
+			name:    "non-positive percent change",
+			alert:   types.InsightSeriesAlert{Kind: types.PercentChangeAlert, Threshold: 0, Action: types.EmailAlertAction},
+			wantErr: true,
+		},
+		{
+			name:    "unknown kind",
+			alert:   types.InsightSeriesAlert{Kind: "OTHER", Action: types.EmailAlertAction},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateAlert(tc.alert)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,344 @@
+package resolvers
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/segmentio/ksuid"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/insights/portable"
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (r *Resolver) ExportInsightsDashboard(ctx context.Context, args *graphqlbackend.ExportInsightsDashboardArgs) (string, error) {
+	dashboardID, err := unmarshalDashboardID(args.Id)
+	if err != nil {
+		return "", err
+	}
+	if !dashboardID.isReal() {
+		return "", errors.New("only custom dashboards can be exported")
+	}
+	permissionsValidator := PermissionsValidatorFromBase(&r.baseInsightResolver)
+	if err := permissionsValidator.validateUserAccessForDashboard(ctx, int(dashboardID.Arg)); err != nil {
+		return "", err
+	}
+
+	dashboards, err := r.dashboardStore.GetDashboards(ctx, store.DashboardQueryArgs{IDs: []int{int(dashboardID.Arg)}, WithoutAuthorization: true})
+	if err != nil {
+		return "", errors.Wrap(err, "GetDashboards")
+	}
+	if len(dashboards) != 1 {
+		return "", errors.New("Dashboard not found.")
+	}
+
+	viewSeries, err := r.insightStore.GetAllOnDashboard(ctx, store.InsightsOnDashboardQueryArgs{DashboardID: dashboards[0].ID})
+	if err != nil {
+		return "", errors.Wrap(err, "GetAllOnDashboard")
+	}
+	views := r.insightStore.GroupByView(ctx, viewSeries)
+	sort.Slice(views, func(i, j int) bool {
+		return views[i].DashboardViewId < views[j].DashboardViewId
+	})
+
+	exported := portable.Dashboard{Title: dashboards[0].Title}
+	for _, view := range views {
+		insight := portable.NewInsight(view)
+		if args.IncludePoints {
+			for i, series := range view.Series {
+				recordingTimes, err := r.baseInsightResolver.timeSeriesStore.GetInsightSeriesRecordingTimes(ctx, series.InsightSeriesID, store.SeriesPointsOpts{})
+				if err != nil {
+					return "", errors.Wrap(err, "GetInsightSeriesRecordingTimes")
+				}
+				// 🚨 SECURITY: Only the points of repositories the user can see are exported.
+				points, err := r.baseInsightResolver.timeSeriesStore.RecordedRepoPoints(ctx, series.SeriesID)
+				if err != nil {
+					return "", errors.Wrap(err, "RecordedRepoPoints")
+				}
+				insight.Series[i].SetPoints(recordingTimes.RecordingTimes, points)
+			}
+		}
+		exported.Insights = append(exported.Insights, insight)
+	}
+
+	data, err := portable.Marshal(exported)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (r *Resolver) ImportInsightsDashboard(ctx context.Context, args *graphqlbackend.ImportInsightsDashboardArgs) (_ graphqlbackend.InsightsDashboardPayloadResolver, err error) {
+	imported, err := portable.Unmarshal([]byte(args.Input.Dashboard))
+	if err != nil {
+		return nil, err
+	}
+	title := imported.Title
+	if args.Input.Title != nil {
+		title = *args.Input.Title
+	}
+
+	dashboardGrants, err := parseDashboardGrants(args.Input.Grants)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse dashboard grants")
+	}
+	if len(dashboardGrants) == 0 {
+		return nil, errors.New("dashboard must be created with at least one grant")
+	}
+	userIds, orgIds, err := getUserPermissions(ctx, database.NewDBWith(r.logger, r.workerBaseStore).Orgs())
+	if err != nil {
+		return nil, errors.Wrap(err, "getUserPermissions")
+	}
+	if !hasPermissionForGrants(dashboardGrants, userIds, orgIds) {
+		return nil, errors.New("user does not have permission to create this dashboard")
+	}
+
+	// Validate every series before creating anything.
+	for _, insight := range imported.Insights {
+		for _, series := range insight.Series {
+			if types.PresentationType(insight.Presentation) == types.Line {
+				if err := isValidSeriesInput(importedSeriesInput(series)); err != nil {
+					return nil, err
+				}
+			}
+			if len(series.Repositories) > 0 {
+				if err := validateRepositoryList(ctx, series.Repositories, r.postgresDB.Repos()); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	insightTx, err := r.insightStore.Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = insightTx.Done(err) }()
+	dashboardTx := r.dashboardStore.With(insightTx)
+	seriesTx := r.baseInsightResolver.timeSeriesStore.With(insightTx)
+
+	dashboard, err := dashboardTx.CreateDashboard(ctx, store.CreateDashboardArgs{
+		Dashboard: types.Dashboard{Title: title, Save: true},
+		Grants:    dashboardGrants,
+		UserIDs:   userIds,
+		OrgIDs:    orgIds,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "CreateDashboard")
+	}
+
+	uid := actor.FromContext(ctx).UID
+	backfill := makeFillSeriesStrategy(insightTx, r.scheduler, r.insightEnqueuer)
+	for _, insight := range imported.Insights {
+		dashboardIds := []int{dashboard.ID}
+		lamDashboardId, err := createInsightLicenseCheck(ctx, insightTx, dashboardTx, dashboardIds)
+		if err != nil {
+			return nil, errors.Wrapf(err, "createInsightLicenseCheck")
+		}
+		if lamDashboardId != 0 {
+			dashboardIds = append(dashboardIds, lamDashboardId)
+		}
+
+		view, err := createImportedView(ctx, insightTx, insight, uid)
+		if err != nil {
+			return nil, err
+		}
+
+		switch types.PresentationType(insight.Presentation) {
+		case types.Pie:
+			if err := createImportedLanguageStatsSeries(ctx, insightTx, view, insight.Series[0]); err != nil {
+				return nil, err
+			}
+		default:
+			for _, series := range insight.Series {
+				fill := backfill
+				if args.Input.IncludePoints && len(series.Points) > 0 {
+					fill = importPointsFill(insightTx, seriesTx, r.postgresDB.Repos(), series)
+				}
+				if err := createAndAttachSeries(ctx, insightTx, fill, view, importedSeriesInput(series)); err != nil {
+					return nil, errors.Wrap(err, "createAndAttachSeries")
+				}
+			}
+		}
+
+		for _, dashboardId := range dashboardIds {
+			if err := dashboardTx.AddViewsToDashboard(ctx, dashboardId, []string{view.UniqueID}); err != nil {
+				return nil, errors.Wrap(err, "AddViewsToDashboard")
+			}
+		}
+	}
+
+	return &insightsDashboardPayloadResolver{dashboard: dashboard, baseInsightResolver: r.baseInsightResolver}, nil
+}
+
+// createImportedView creates the view of an imported insight, granted to the
+// importing user like any other newly created insight.
+func createImportedView(ctx context.Context, tx *store.InsightStore, insight portable.Insight, uid int32) (types.InsightView, error) {
+	view, err := tx.CreateView(ctx, types.InsightView{
+		Title:       insight.Title,
+		Description: insight.Description,
+		UniqueID:    ksuid.New().String(),
+		Filters: types.InsightViewFilters{
+			IncludeRepoRegex: insight.Filters.IncludeRepoRegex,
+			ExcludeRepoRegex: insight.Filters.ExcludeRepoRegex,
+			SearchContexts:   insight.Filters.SearchContexts,
+		},
+		OtherThreshold:   insight.OtherThreshold,
+		PresentationType: types.PresentationType(insight.Presentation),
+	}, []store.InsightViewGrant{store.UserGrant(int(uid))})
+	if err != nil {
+		return types.InsightView{}, errors.Wrap(err, "CreateView")
+	}
+
+	options := insight.SeriesOptions
+	if options.SortMode == nil && options.Limit == nil && options.NumSamples == nil {
+		return view, nil
+	}
+	// Series display options are only stored on update.
+	if options.SortMode != nil {
+		mode, direction := types.SeriesSortMode(*options.SortMode), types.SeriesSortDirection(*options.SortDirection)
+		view.SeriesSortMode, view.SeriesSortDirection = &mode, &direction
+	}
+	view.SeriesLimit = options.Limit
+	view.SeriesNumSamples = options.NumSamples
+	view, err = tx.UpdateView(ctx, view)
+	if err != nil {
+		return types.InsightView{}, errors.Wrap(err, "UpdateView")
+	}
+	return view, nil
+}
+
+// createImportedLanguageStatsSeries creates the series of an imported pie
+// chart, the same way as CreatePieChartSearchInsight.
+func createImportedLanguageStatsSeries(ctx context.Context, tx *store.InsightStore, view types.InsightView, series portable.Series) error {
+	created, err := tx.CreateSeries(ctx, types.InsightSeries{
+		SeriesID:           ksuid.New().String(),
+		Query:              series.Query,
+		CreatedAt:          time.Now(),
+		Repositories:       series.Repositories,
+		SampleIntervalUnit: string(types.Month),
+		JustInTime:         len(series.Repositories) > 0,
+		GenerationMethod:   types.LanguageStats,
+	})
+	if err != nil {
+		return errors.Wrap(err, "CreateSeries")
+	}
+	if err := tx.AttachSeriesToView(ctx, created, view, types.InsightViewSeriesMetadata{}); err != nil {
+		return errors.Wrap(err, "AttachSeriesToView")
+	}
+	return nil
+}
+
+// importedSeriesInput returns the input creating an imported series through
+// the same path as CreateLineChartSearchInsight.
+func importedSeriesInput(series portable.Series) graphqlbackend.LineChartSearchInsightDataSeriesInput {
+	input := graphqlbackend.LineChartSearchInsightDataSeriesInput{
+		Query: series.Query,
+		TimeScope: &graphqlbackend.TimeScopeInput{StepInterval: &graphqlbackend.TimeIntervalStepInput{
+			Unit:  series.IntervalUnit,
+			Value: int32(series.IntervalValue),
+		}},
+		RepositoryScope: &graphqlbackend.RepositoryScopeInput{
+			Repositories:       series.Repositories,
+			RepositoryCriteria: series.RepositoryCriteria,
+		},
+		Options: graphqlbackend.LineChartDataSeriesOptionsInput{
+			Label:     &series.Label,
+			LineColor: &series.Color,
+		},
+		GroupBy: series.GroupBy,
+	}
+	if series.GeneratedFromCaptureGroups {
+		input.GeneratedFromCaptureGroups = &series.GeneratedFromCaptureGroups
+	}
+	switch types.GenerationMethod(series.GenerationMethod) {
+	case types.PreciseSymbolReferences:
+		indexType := symbolReferencesPrecise
+		input.SymbolReferences = &indexType
+	case types.SyntacticSymbolReferences:
+		indexType := symbolReferencesSyntactic
+		input.SymbolReferences = &indexType
+	}
+	return input
+}
+
+// importPointsFill returns a fill strategy which records the exported points of
+// a series instead of backfilling it. Recordings continue as usual from the
+// next recording time of the series.
+func importPointsFill(insightTx *store.InsightStore, seriesTx *store.Store, repoStore database.RepoStore, exported portable.Series) fillSeriesStrategy {
+	return func(ctx context.Context, series types.InsightSeries) error {
+		names := make([]string, 0, len(exported.Points))
+		seen := map[string]struct{}{}
+		for _, p := range exported.Points {
+			if _, ok := seen[p.Repository]; !ok {
+				seen[p.Repository] = struct{}{}
+				names = append(names, p.Repository)
+			}
+		}
+		// 🚨 SECURITY: The repo store only returns repositories the user can see, so the
+		// points of any other repository are skipped.
+		repos, err := repoStore.List(ctx, database.ReposListOptions{Names: names})
+		if err != nil {
+			return errors.Wrap(err, "List")
+		}
+		repoIDs := make(map[string]api.RepoID, len(repos))
+		for _, repo := range repos {
+			repoIDs[string(repo.Name)] = repo.ID
+		}
+
+		points := make([]store.RecordSeriesPointArgs, 0, len(exported.Points))
+		for _, p := range exported.Points {
+			repoID, ok := repoIDs[p.Repository]
+			if !ok {
+				continue
+			}
+			repoName := p.Repository
+			points = append(points, store.RecordSeriesPointArgs{
+				SeriesID:    series.SeriesID,
+				Point:       store.SeriesPoint{SeriesID: series.SeriesID, Time: p.Time, Value: p.Value, Capture: p.Capture},
+				RepoName:    &repoName,
+				RepoID:      &repoID,
+				PersistMode: store.RecordMode,
+			})
+		}
+
+		recordingTimes := types.InsightSeriesRecordingTimes{InsightSeriesID: series.ID}
+		for _, t := range importedRecordingTimes(exported) {
+			recordingTimes.RecordingTimes = append(recordingTimes.RecordingTimes, types.RecordingTime{Timestamp: t})
+		}
+		if err := seriesTx.RecordSeriesPointsAndRecordingTimes(ctx, points, recordingTimes); err != nil {
+			return errors.Wrap(err, "RecordSeriesPointsAndRecordingTimes")
+		}
+
+		// The imported points take the place of a backfill.
+		if _, err := insightTx.StampBackfill(ctx, series); err != nil {
+			return errors.Wrap(err, "StampBackfill")
+		}
+		return nil
+	}
+}
+
+// importedRecordingTimes returns the recording times of an exported series,
+// falling back to the times of its points if they were not exported.
+func importedRecordingTimes(series portable.Series) []time.Time {
+	if len(series.RecordingTimes) > 0 {
+		return series.RecordingTimes
+	}
+	seen := map[time.Time]struct{}{}
+	var times []time.Time
+	for _, p := range series.Points {
+		t := p.Time.UTC()
+		if _, ok := seen[t]; !ok {
+			seen[t] = struct{}{}
+			times = append(times, t)
+		}
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	return times
+}
@@ -0,0 +1,46 @@
+package resolvers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/insights/portable"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+)
+
+func TestImportedSeriesInput(t *testing.T) {
+	series := portable.Series{
+		Label:            "refs",
+		Color:            "#fff",
+		Query:            "repo:^a$ MyFunc",
+		GenerationMethod: string(types.SyntacticSymbolReferences),
+		Repositories:     []string{"github.com/a/a"},
+		IntervalUnit:     string(types.Week),
+		IntervalValue:    2,
+	}
+	input := importedSeriesInput(series)
+	require.NoError(t, isValidSeriesInput(input))
+	require.Equal(t, "refs", *input.Options.Label)
+	require.Equal(t, "#fff", *input.Options.LineColor)
+	require.Equal(t, int32(2), input.TimeScope.StepInterval.Value)
+	require.Equal(t, []string{"github.com/a/a"}, input.RepositoryScope.Repositories)
+	require.Equal(t, symbolReferencesSyntactic, *input.SymbolReferences)
+	require.Nil(t, input.GeneratedFromCaptureGroups)
+}
+
+func TestImportedRecordingTimes(t *testing.T) {
+	t1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.AddDate(0, 1, 0)
+
+	recorded := portable.Series{RecordingTimes: []time.Time{t1, t2}}
+	require.Equal(t, []time.Time{t1, t2}, importedRecordingTimes(recorded))
+
+	fromPoints := portable.Series{Points: []portable.Point{
+		{Time: t2, Repository: "github.com/a/a"},
+		{Time: t1, Repository: "github.com/a/a"},
+		{Time: t2, Repository: "github.com/a/b"},
+	}}
+	require.Equal(t, []time.Time{t1, t2}, importedRecordingTimes(fromPoints))
+}
@@ -114,3 +114,23 @@ func (r *disabledResolver) MoveInsightSeriesBackfillToFrontOfQueue(ctx context.C
 func (r *disabledResolver) MoveInsightSeriesBackfillToBackOfQueue(ctx context.Context, args *graphqlbackend.BackfillArgs) (*graphqlbackend.BackfillQueueItemResolver, error) {
 	return nil, errors.New(r.reason)
 }
+
+func (r *disabledResolver) InsightSeriesAlerts(ctx context.Context, args *graphqlbackend.InsightSeriesAlertsArgs) ([]graphqlbackend.InsightSeriesAlertResolver, error) {
+	return nil, errors.New(r.reason)
+}
+
+func (r *disabledResolver) CreateInsightSeriesAlert(ctx context.Context, args *graphqlbackend.CreateInsightSeriesAlertArgs) (graphqlbackend.InsightSeriesAlertResolver, error) {
+	return nil, errors.New(r.reason)
+}
+
+func (r *disabledResolver) DeleteInsightSeriesAlert(ctx context.Context, args *graphqlbackend.DeleteInsightSeriesAlertArgs) (*graphqlbackend.EmptyResponse, error) {
+	return nil, errors.New(r.reason)
+}
+
+func (r *disabledResolver) ExportInsightsDashboard(ctx context.Context, args *graphqlbackend.ExportInsightsDashboardArgs) (string, error) {
+	return "", errors.New(r.reason)
+}
+
+func (r *disabledResolver) ImportInsightsDashboard(ctx context.Context, args *graphqlbackend.ImportInsightsDashboardArgs) (graphqlbackend.InsightsDashboardPayloadResolver, error) {
+	return nil, errors.New(r.reason)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/segmentio/ksuid"
 
 	"github.com/sourcegraph/log"
+	"github.com/sourcegraph/scip/bindings/go/scip"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
@@ -348,6 +349,31 @@ func (s *searchInsightDataSeriesDefinitionResolver) GroupBy() (*string, error) {
 	return s.series.GroupBy, nil
 }
 
+func (s *searchInsightDataSeriesDefinitionResolver) SymbolReferences() (*string, error) {
+	return symbolReferencesIndexType(s.series.GenerationMethod), nil
+}
+
+// Values of the SymbolReferencesIndexType GraphQL enum.
+const (
+	symbolReferencesPrecise   = "PRECISE"
+	symbolReferencesSyntactic = "SYNTACTIC"
+)
+
+// symbolReferencesIndexType returns the SymbolReferencesIndexType of series
+// generated from symbol references, and nil for other series.
+func symbolReferencesIndexType(method types.GenerationMethod) *string {
+	var indexType string
+	switch method {
+	case types.PreciseSymbolReferences:
+		indexType = symbolReferencesPrecise
+	case types.SyntacticSymbolReferences:
+		indexType = symbolReferencesSyntactic
+	default:
+		return nil
+	}
+	return &indexType
+}
+
 type insightIntervalTimeScopeResolver struct {
 	unit  string
 	value int32
@@ -773,6 +799,9 @@ func existingSeriesHasChanged(new graphqlbackend.LineChartSearchInsightDataSerie
 			return true
 		}
 	}
+	if emptyIfNil(new.SymbolReferences) != emptyIfNil(symbolReferencesIndexType(existing.GenerationMethod)) {
+		return true
+	}
 	return emptyIfNil(new.GroupBy) != emptyIfNil(existing.GroupBy)
 }
 
@@ -1316,7 +1345,14 @@ func createAndAttachSeries(ctx context.Context, tx *store.InsightStore, startSer
 	var err error
 	var dynamic bool
 	// Validate the query before creating anything; we don't want faulty insights running pointlessly.
-	if series.GroupBy != nil || series.GeneratedFromCaptureGroups != nil {
+	if series.SymbolReferences != nil {
+		if series.GroupBy != nil || isCaptureGroupSeries(series.GeneratedFromCaptureGroups) {
+			return errors.New("symbol references series cannot be grouped or generated from capture groups")
+		}
+		if _, err := scip.ParseSymbol(series.Query); err != nil {
+			return errors.Wrap(err, "symbol validation")
+		}
+	} else if series.GroupBy != nil || series.GeneratedFromCaptureGroups != nil {
 		if _, err := querybuilder.ParseComputeQuery(series.Query, gitserver.NewClient("graphql.insights.computequery")); err != nil {
 			return errors.Wrap(err, "query validation")
 		}
@@ -1343,7 +1379,8 @@ func createAndAttachSeries(ctx context.Context, tx *store.InsightStore, startSer
 	// Don't try to match on non-global series, since they are always replaced
 	// Also don't try to match on series that use repo criteria
 	// TODO: Reconsider matching on criteria based series. If so the edit case would need work to ensure other insights remain the same.
-	if len(series.RepositoryScope.Repositories) == 0 && series.RepositoryScope.RepositoryCriteria == nil {
+	// Symbol references series are not matched either, since their query is not a search query.
+	if len(series.RepositoryScope.Repositories) == 0 && series.RepositoryScope.RepositoryCriteria == nil && series.SymbolReferences == nil {
 		matchingSeries, foundSeries, err = tx.FindMatchingSeries(ctx, store.MatchSeriesArgs{
 			Query:                     series.Query,
 			StepIntervalUnit:          series.TimeScope.StepInterval.Unit,
@@ -1399,6 +1436,12 @@ func createAndAttachSeries(ctx context.Context, tx *store.InsightStore, startSer
 }
 
 func searchGenerationMethod(series graphqlbackend.LineChartSearchInsightDataSeriesInput) types.GenerationMethod {
+	if series.SymbolReferences != nil {
+		if *series.SymbolReferences == symbolReferencesSyntactic {
+			return types.SyntacticSymbolReferences
+		}
+		return types.PreciseSymbolReferences
+	}
 	if series.GeneratedFromCaptureGroups != nil && *series.GeneratedFromCaptureGroups {
 		if series.GroupBy != nil {
 			return types.MappingCompute
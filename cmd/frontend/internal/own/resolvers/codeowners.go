@@ -26,13 +26,18 @@ func (r *ownResolver) computeCodeowners(ctx context.Context, blob *graphqlbacken
 	if err != nil {
 		return nil, err
 	}
-	var rule *codeownerspb.Rule
+	// Every section of a CODEOWNERS file can contribute a matching rule.
+	var rules []*codeownerspb.Rule
 	if ruleset != nil {
-		rule = ruleset.Match(blob.Path())
+		rules = ruleset.MatchAll(blob.Path())
+	}
+	var hasOwners bool
+	for _, rule := range rules {
+		hasOwners = hasOwners || len(rule.GetOwner()) > 0
 	}
 	// Compute repo context if possible to allow better unification of references.
 	var repoContext *own.RepoContext
-	if len(rule.GetOwner()) > 0 {
+	if hasOwners {
 		spec, err := repo.ExternalRepo(ctx)
 		// Best effort resolution. We still want to serve the reason if external service cannot be resolved here.
 		if err == nil {
@@ -44,18 +49,20 @@ func (r *ownResolver) computeCodeowners(ctx context.Context, blob *graphqlbacken
 	}
 	// Return references
 	var rrs []reasonAndReference
-	for _, o := range rule.GetOwner() {
-		rrs = append(rrs, reasonAndReference{
-			reason: ownershipReason{
-				codeownersRule:   rule,
-				codeownersSource: ruleset.GetSource(),
-			},
-			reference: own.Reference{
-				RepoContext: repoContext,
-				Handle:      o.Handle,
-				Email:       o.Email,
-			},
-		})
+	for _, rule := range rules {
+		for _, o := range rule.GetOwner() {
+			rrs = append(rrs, reasonAndReference{
+				reason: ownershipReason{
+					codeownersRule:   rule,
+					codeownersSource: ruleset.GetSource(),
+				},
+				reference: own.Reference{
+					RepoContext: repoContext,
+					Handle:      o.Handle,
+					Email:       o.Email,
+				},
+			})
+		}
 	}
 	return rrs, nil
 }
@@ -64,6 +71,7 @@ type codeownersFileEntryResolver struct {
 	db              database.DB
 	source          codeowners.RulesetSource
 	matchLineNumber int32
+	section         string
 	repo            *graphqlbackend.RepositoryResolver
 	gitserverClient gitserver.Client
 }
@@ -103,3 +111,10 @@ func (r *codeownersFileEntryResolver) CodeownersFile(ctx context.Context) (graph
 func (r *codeownersFileEntryResolver) RuleLineMatch(_ context.Context) (int32, error) {
 	return r.matchLineNumber, nil
 }
+
+func (r *codeownersFileEntryResolver) Section() *string {
+	if r.section == "" {
+		return nil
+	}
+	return &r.section
+}
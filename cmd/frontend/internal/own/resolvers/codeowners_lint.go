@@ -0,0 +1,249 @@
+package resolvers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var (
+	_ graphqlbackend.CodeownersLintReportResolver           = &codeownersLintReportResolver{}
+	_ graphqlbackend.CodeownersLintReportConnectionResolver = &codeownersLintReportConnectionResolver{}
+)
+
+func (r *ownResolver) GitCommitCodeownersLint(ctx context.Context, commit *graphqlbackend.GitCommitResolver) (graphqlbackend.CodeownersLintReportResolver, error) {
+	if commit == nil {
+		return nil, errors.New("cannot resolve git commit")
+	}
+	repo := commit.Repository()
+	// 🚨 SECURITY: The report lists paths of the repository, which must not be
+	// revealed if some of them are hidden by sub-repo permissions.
+	isSubRepoPermsRepo, err := authz.SubRepoEnabledForRepoID(ctx, authz.DefaultSubRepoPermsChecker, repo.IDInt32())
+	if err != nil {
+		return nil, err
+	} else if isSubRepoPermsRepo {
+		return nil, errors.New("CODEOWNERS lint is not available for repositories with sub-repository permissions")
+	}
+	report, err := own.NewLintService(r.gitserver, r.db).LintCodeowners(ctx, repo.RepoName(), repo.IDInt32(), api.CommitID(commit.OID()))
+	if err != nil {
+		return nil, err
+	}
+	report.UpdatedAt = time.Now()
+	return &codeownersLintReportResolver{
+		db:           r.db,
+		gitserver:    r.gitserver,
+		report:       report,
+		repoResolver: repo,
+	}, nil
+}
+
+func (r *ownResolver) CodeownersLintReports(ctx context.Context, args *graphqlbackend.CodeownersLintReportsArgs) (graphqlbackend.CodeownersLintReportConnectionResolver, error) {
+	// 🚨 SECURITY: Reports cover all repositories, so only site admins can list them.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+	connectionResolver := &codeownersLintReportConnectionResolver{
+		db:        r.db,
+		gitserver: r.gitserver,
+		opts:      database.ListCodeownersLintReportsOpts{MaxCoverage: args.MaxCoverage},
+	}
+	if args.After != nil {
+		cursor, err := gqlutil.DecodeIntCursor(args.After)
+		if err != nil {
+			return nil, err
+		}
+		connectionResolver.offset = cursor
+	}
+	if args.First != nil {
+		connectionResolver.limit = int(*args.First)
+	}
+	return connectionResolver, nil
+}
+
+type codeownersLintReportResolver struct {
+	db        database.DB
+	gitserver gitserver.Client
+	report    *database.CodeownersLintReport
+
+	// repoResolver is loaded lazily for stored reports.
+	repoOnce     sync.Once
+	repoResolver *graphqlbackend.RepositoryResolver
+	repoErr      error
+}
+
+func (r *codeownersLintReportResolver) Repository(ctx context.Context) (*graphqlbackend.RepositoryResolver, error) {
+	r.repoOnce.Do(func() {
+		if r.repoResolver != nil {
+			return
+		}
+		repo, err := r.db.Repos().Get(ctx, r.report.RepoID)
+		if err != nil {
+			r.repoErr = err
+			return
+		}
+		r.repoResolver = graphqlbackend.NewRepositoryResolver(r.db, r.gitserver, repo)
+	})
+	return r.repoResolver, r.repoErr
+}
+
+func (r *codeownersLintReportResolver) OID() graphqlbackend.GitObjectID {
+	return graphqlbackend.GitObjectID(r.report.CommitID)
+}
+
+func (r *codeownersLintReportResolver) HasCodeownersFile() bool {
+	return r.report.HasCodeowners
+}
+
+func (r *codeownersLintReportResolver) TotalFiles() int32 {
+	return int32(r.report.TotalFiles)
+}
+
+func (r *codeownersLintReportResolver) OwnedFiles() int32 {
+	return int32(r.report.OwnedFiles)
+}
+
+func (r *codeownersLintReportResolver) Coverage() float64 {
+	return r.report.Coverage()
+}
+
+func (r *codeownersLintReportResolver) DeadRules() []graphqlbackend.CodeownersLintRuleResolver {
+	resolvers := make([]graphqlbackend.CodeownersLintRuleResolver, 0, len(r.report.DeadRules))
+	for _, rule := range r.report.DeadRules {
+		resolvers = append(resolvers, codeownersLintRuleResolver{rule: rule})
+	}
+	return resolvers
+}
+
+func (r *codeownersLintReportResolver) UnknownOwners() []graphqlbackend.CodeownersLintOwnerResolver {
+	resolvers := make([]graphqlbackend.CodeownersLintOwnerResolver, 0, len(r.report.UnknownOwners))
+	for _, owner := range r.report.UnknownOwners {
+		resolvers = append(resolvers, codeownersLintOwnerResolver{owner: owner})
+	}
+	return resolvers
+}
+
+func (r *codeownersLintReportResolver) UnownedPaths() []string {
+	if r.report.UnownedPaths == nil {
+		return []string{}
+	}
+	return r.report.UnownedPaths
+}
+
+func (r *codeownersLintReportResolver) UpdatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.report.UpdatedAt}
+}
+
+type codeownersLintRuleResolver struct {
+	rule database.CodeownersLintRule
+}
+
+func (r codeownersLintRuleResolver) Pattern() string {
+	return r.rule.Pattern
+}
+
+func (r codeownersLintRuleResolver) LineNumber() int32 {
+	return r.rule.LineNumber
+}
+
+func (r codeownersLintRuleResolver) Section() *string {
+	if r.rule.SectionName == "" {
+		return nil
+	}
+	return &r.rule.SectionName
+}
+
+type codeownersLintOwnerResolver struct {
+	owner database.CodeownersLintOwner
+}
+
+func (r codeownersLintOwnerResolver) Handle() *string {
+	if r.owner.Handle == "" {
+		return nil
+	}
+	return &r.owner.Handle
+}
+
+func (r codeownersLintOwnerResolver) Email() *string {
+	if r.owner.Email == "" {
+		return nil
+	}
+	return &r.owner.Email
+}
+
+func (r codeownersLintOwnerResolver) LineNumbers() []int32 {
+	return r.owner.LineNumbers
+}
+
+type codeownersLintReportConnectionResolver struct {
+	db        database.DB
+	gitserver gitserver.Client
+	opts      database.ListCodeownersLintReportsOpts
+
+	once     sync.Once
+	offset   int
+	limit    int
+	pageInfo *gqlutil.PageInfo
+	err      error
+
+	reports []*database.CodeownersLintReport
+}
+
+func (r *codeownersLintReportConnectionResolver) compute(ctx context.Context) {
+	r.once.Do(func() {
+		opts := r.opts
+		if r.limit != 0 {
+			// Fetch one more report to know whether there is a next page.
+			opts.LimitOffset = &database.LimitOffset{Limit: r.limit + 1, Offset: r.offset}
+		} else if r.offset != 0 {
+			opts.LimitOffset = &database.LimitOffset{Offset: r.offset}
+		}
+		reports, err := r.db.CodeownersLintReports().List(ctx, opts)
+		if err != nil {
+			r.err = err
+			return
+		}
+		if r.limit != 0 && len(reports) > r.limit {
+			reports = reports[:r.limit]
+			next := int32(r.offset + r.limit)
+			r.pageInfo = gqlutil.EncodeIntCursor(&next)
+		} else {
+			r.pageInfo = gqlutil.HasNextPage(false)
+		}
+		r.reports = reports
+	})
+}
+
+func (r *codeownersLintReportConnectionResolver) Nodes(ctx context.Context) ([]graphqlbackend.CodeownersLintReportResolver, error) {
+	r.compute(ctx)
+	if r.err != nil {
+		return nil, r.err
+	}
+	resolvers := make([]graphqlbackend.CodeownersLintReportResolver, 0, len(r.reports))
+	for _, report := range r.reports {
+		resolvers = append(resolvers, &codeownersLintReportResolver{
+			db:        r.db,
+			gitserver: r.gitserver,
+			report:    report,
+		})
+	}
+	return resolvers, nil
+}
+
+func (r *codeownersLintReportConnectionResolver) TotalCount(ctx context.Context) (int32, error) {
+	return r.db.CodeownersLintReports().Count(ctx, r.opts)
+}
+
+func (r *codeownersLintReportConnectionResolver) PageInfo(ctx context.Context) (*gqlutil.PageInfo, error) {
+	r.compute(ctx)
+	return r.pageInfo, r.err
+}
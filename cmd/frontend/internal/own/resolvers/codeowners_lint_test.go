@@ -0,0 +1,117 @@
+package resolvers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/graph-gophers/graphql-go/errors"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/database/fakedb"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestCodeownersLintReports(t *testing.T) {
+	fs := fakedb.New()
+	db := dbmocks.NewMockDB()
+	fs.Wire(db)
+	git := fakeGitserver{}
+	svc := own.NewService(git, db)
+
+	reports := []*database.CodeownersLintReport{
+		{
+			RepoID:        1,
+			CommitID:      "deadbeef",
+			HasCodeowners: true,
+			TotalFiles:    4,
+			OwnedFiles:    1,
+			DeadRules:     []database.CodeownersLintRule{{Pattern: "/old/**", LineNumber: 2, SectionName: "backend"}},
+			UnknownOwners: []database.CodeownersLintOwner{{Handle: "ghost", LineNumbers: []int32{1, 3}}},
+			UnownedPaths:  []string{"docs", "main.go"},
+		},
+		{RepoID: 2, CommitID: "cafebabe", TotalFiles: 2, OwnedFiles: 2},
+	}
+	store := dbmocks.NewMockCodeownersLintReportStore()
+	store.ListFunc.SetDefaultHook(func(_ context.Context, opts database.ListCodeownersLintReportsOpts) ([]*database.CodeownersLintReport, error) {
+		list := reports
+		if opts.LimitOffset != nil {
+			list = list[min(opts.Offset, len(list)):]
+			if opts.Limit != 0 {
+				list = list[:min(opts.Limit, len(list))]
+			}
+		}
+		return list, nil
+	})
+	store.CountFunc.SetDefaultReturn(int32(len(reports)), nil)
+	db.CodeownersLintReportsFunc.SetDefaultReturn(store)
+
+	schema, err := graphqlbackend.NewSchema(db, git, nil, []graphqlbackend.OptionalResolver{{OwnResolver: NewWithService(db, git, svc, logtest.NoOp(t))}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	query := `
+		query reports {
+		  codeownersLintReports(first: 1, maxCoverage: 50) {
+			totalCount
+			pageInfo { hasNextPage endCursor }
+			nodes {
+			  oid
+			  hasCodeownersFile
+			  totalFiles
+			  ownedFiles
+			  coverage
+			  deadRules { pattern lineNumber section }
+			  unknownOwners { handle email lineNumbers }
+			  unownedPaths
+			}
+		  }
+		}`
+
+	t.Run("site admin guarding is respected", func(t *testing.T) {
+		user := fs.AddUser(types.User{SiteAdmin: false})
+		graphqlbackend.RunTest(t, &graphqlbackend.Test{
+			Schema:         schema,
+			Context:        userCtx(user),
+			Query:          query,
+			ExpectedResult: `null`,
+			ExpectedErrors: []*errors.QueryError{
+				{Message: auth.ErrMustBeSiteAdmin.Error(), Path: []any{"codeownersLintReports"}},
+			},
+		})
+	})
+
+	t.Run("site admin lists reports", func(t *testing.T) {
+		admin := fs.AddUser(types.User{SiteAdmin: true})
+		graphqlbackend.RunTest(t, &graphqlbackend.Test{
+			Schema:  schema,
+			Context: userCtx(admin),
+			Query:   query,
+			ExpectedResult: `{
+			  "codeownersLintReports": {
+				"totalCount": 2,
+				"pageInfo": {"hasNextPage": true, "endCursor": "MQ=="},
+				"nodes": [{
+				  "oid": "deadbeef",
+				  "hasCodeownersFile": true,
+				  "totalFiles": 4,
+				  "ownedFiles": 1,
+				  "coverage": 25,
+				  "deadRules": [{"pattern": "/old/**", "lineNumber": 2, "section": "backend"}],
+				  "unknownOwners": [{"handle": "ghost", "email": null, "lineNumbers": [1, 3]}],
+				  "unownedPaths": ["docs", "main.go"]
+				}]
+			  }
+			}`,
+		})
+		opts := store.ListFunc.History()[0].Arg1
+		if opts.MaxCoverage == nil || *opts.MaxCoverage != 50 {
+			t.Errorf("expected max coverage 50, got %v", opts.MaxCoverage)
+		}
+	})
+}
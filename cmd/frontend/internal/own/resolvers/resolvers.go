@@ -541,6 +541,7 @@ func (r *ownershipResolver) Reasons(_ context.Context) ([]graphqlbackend.Ownersh
 					source:          reason.codeownersSource,
 					repo:            r.repo,
 					matchLineNumber: reason.codeownersRule.GetLineNumber(),
+					section:         reason.codeownersRule.GetSectionName(),
 				},
 			})
 
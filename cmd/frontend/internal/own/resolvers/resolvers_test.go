@@ -84,6 +84,10 @@ func (s fakeOwnService) AssignedTeams(context.Context, api.RepoID, api.CommitID)
 	return s.Teams, nil
 }
 
+func (s fakeOwnService) InferredOwnership(context.Context, api.RepoID) (own.InferredOwners, error) {
+	return nil, nil
+}
+
 // fakeGitServer is a limited gitserver.Client that returns a file for every Stat call.
 type fakeGitserver struct {
 	gitserver.Client
@@ -1301,6 +1305,18 @@ func Test_SignalConfigurations(t *testing.T) {
 			  "description": "Indexes ownership data to present in aggregated views like Admin > Analytics > Own and Repo > Ownership",
 			  "isEnabled": false,
 			  "excludedRepoPatterns": []
+			},
+			{
+			  "name": "inferred-ownership",
+			  "description": "Indexes owners of each directory inferred from repository history and blame, used by ownership search when files have no other owners.",
+			  "isEnabled": false,
+			  "excludedRepoPatterns": []
+			},
+			{
+			  "name": "codeowners-lint",
+			  "description": "Lints the CODEOWNERS file of each repository and reports its coverage, dead rules, unknown owners and unowned paths.",
+			  "isEnabled": false,
+			  "excludedRepoPatterns": []
 			}
 		  ]
 		}`,
@@ -1363,6 +1379,16 @@ func Test_SignalConfigurations(t *testing.T) {
 				Name:        "analytics",
 				Description: "Indexes ownership data to present in aggregated views like Admin > Analytics > Own and Repo > Ownership",
 			},
+			{
+				ID:          4,
+				Name:        "inferred-ownership",
+				Description: "Indexes owners of each directory inferred from repository history and blame, used by ownership search when files have no other owners.",
+			},
+			{
+				ID:          5,
+				Name:        "codeowners-lint",
+				Description: "Lints the CODEOWNERS file of each repository and reports its coverage, dead rules, unknown owners and unowned paths.",
+			},
 		}).Equal(t, configsFromDb)
 
 		readTest := baseReadTest
@@ -1395,6 +1421,18 @@ func Test_SignalConfigurations(t *testing.T) {
 			  "description": "Indexes ownership data to present in aggregated views like Admin > Analytics > Own and Repo > Ownership",
 			  "isEnabled": false,
 			  "excludedRepoPatterns": []
+			},
+			{
+			  "name": "inferred-ownership",
+			  "description": "Indexes owners of each directory inferred from repository history and blame, used by ownership search when files have no other owners.",
+			  "isEnabled": false,
+			  "excludedRepoPatterns": []
+			},
+			{
+			  "name": "codeowners-lint",
+			  "description": "Lints the CODEOWNERS file of each repository and reports its coverage, dead rules, unknown owners and unowned paths.",
+			  "isEnabled": false,
+			  "excludedRepoPatterns": []
 			}
 		  ]
 		}`
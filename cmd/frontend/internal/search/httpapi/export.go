@@ -1,17 +1,23 @@
 package httpapi
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
 	"fmt"
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/insights/aggregation"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/own"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/service"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -22,7 +28,10 @@ func filenamePrefix(jobID int64) string {
 	return fmt.Sprintf("search-jobs_%d_%s", jobID, time.Now().Format("2006-01-02_150405"))
 }
 
-func ServeSearchJobDownload(logger log.Logger, svc *service.Service) http.HandlerFunc {
+// ServeSearchJobDownload serves the results of a search job. If the
+// "aggregation" parameter is set, the number of results in each group of the
+// aggregation mode are served as CSV instead.
+func ServeSearchJobDownload(logger log.Logger, svc *service.Service, owners own.Service) http.HandlerFunc {
 	logger = logger.With(log.String("handler", "ServeSearchJobDownload"))
 
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -33,15 +42,62 @@ func ServeSearchJobDownload(logger log.Logger, svc *service.Service) http.Handle
 			return
 		}
 
-		writerTo, err := svc.GetSearchJobResultsWriterTo(r.Context(), int64(jobID))
+		if mode := r.URL.Query().Get("aggregation"); mode != "" {
+			serveAggregation(logger.With(log.Int64("jobID", jobID)), w, r, svc, owners, jobID, types.SearchAggregationMode(mode))
+			return
+		}
+
+		format, err := service.ParseResultFormat(r.URL.Query().Get("format"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		writerTo, err := svc.GetSearchJobResultsWriterTo(r.Context(), int64(jobID), format)
 		if err != nil {
 			httpError(w, err)
 			return
 		}
 
-		filename := filenamePrefix(jobID) + ".jsonl"
-		writeJSON(logger.With(log.Int64("jobID", jobID)), w, filename, writerTo)
+		filename := filenamePrefix(jobID) + "." + format.Extension()
+		writeResults(logger.With(log.Int64("jobID", jobID)), w, filename, format.ContentType(), writerTo)
+	}
+}
+
+func serveAggregation(logger log.Logger, w http.ResponseWriter, r *http.Request, svc *service.Service, owners own.Service, jobID int64, mode types.SearchAggregationMode) {
+	opts := aggregation.GroupOptions{Owners: owners}
+	if depth := r.URL.Query().Get("directoryDepth"); depth != "" {
+		var err error
+		if opts.DirectoryDepth, err = strconv.Atoi(depth); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
 	}
+
+	agg, err := aggregation.NewExhaustiveAggregator(mode, opts)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := svc.AggregateSearchJobResults(r.Context(), jobID, agg); err != nil {
+		httpError(w, err)
+		return
+	}
+
+	var buf bytes.Buffer
+	cw := csv.NewWriter(&buf)
+	_ = cw.Write([]string{"group", "count"})
+	for _, group := range agg.SortAggregate() {
+		_ = cw.Write([]string{group.Label, strconv.Itoa(int(group.Count))})
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		httpError(w, err)
+		return
+	}
+
+	filename := fmt.Sprintf("%s_%s.csv", filenamePrefix(jobID), strings.ToLower(string(mode)))
+	writeCSV(logger, w, filename, &buf)
 }
 
 func ServeSearchJobLogs(logger log.Logger, svc *service.Service) http.HandlerFunc {
@@ -113,8 +169,8 @@ func writeCSV(logger log.Logger, w http.ResponseWriter, filenameNoQuotes string,
 	}
 }
 
-func writeJSON(logger log.Logger, w http.ResponseWriter, filenameNoQuotes string, writerTo io.WriterTo) {
-	w.Header().Set("Content-Type", "application/jsonlines")
+func writeResults(logger log.Logger, w http.ResponseWriter, filenameNoQuotes, contentType string, writerTo io.WriterTo) {
+	w.Header().Set("Content-Type", contentType)
 	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filenameNoQuotes))
 	w.WriteHeader(200)
 	n, err := writerTo.WriteTo(w)
@@ -46,7 +46,7 @@ func TestServeSearchJobDownload(t *testing.T) {
 	svc := service.New(observationCtx, s, mockUploadStore, service.NewSearcherFake())
 
 	router := mux.NewRouter()
-	router.HandleFunc("/{id}.json", ServeSearchJobDownload(logger, svc))
+	router.HandleFunc("/{id}.json", ServeSearchJobDownload(logger, svc, nil))
 
 	// no job
 	{
@@ -12,6 +12,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/own"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/client"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive"
@@ -43,13 +44,14 @@ func Init(
 		return err
 	}
 
-	searchClient := client.New(logger, db, gitserver.NewClient("http.search"))
+	gitserverClient := gitserver.NewClient("http.search")
+	searchClient := client.New(logger, db, gitserverClient)
 	newSearcher := service.FromSearchClient(searchClient)
 
 	svc := service.New(observationCtx, store, uploadStore, newSearcher)
 
 	enterpriseServices.SearchJobsResolver = resolvers.New(logger, db, svc)
-	enterpriseServices.SearchJobsDataExportHandler = httpapi.ServeSearchJobDownload(logger, svc)
+	enterpriseServices.SearchJobsDataExportHandler = httpapi.ServeSearchJobDownload(logger, svc, own.NewService(gitserverClient, db))
 	enterpriseServices.SearchJobsLogsHandler = httpapi.ServeSearchJobLogs(logger, svc)
 
 	return nil
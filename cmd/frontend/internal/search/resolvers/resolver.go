@@ -17,6 +17,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/service"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store"
+	exhaustivetypes "github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -66,6 +67,125 @@ func (r *Resolver) DeleteSearchJob(ctx context.Context, args *graphqlbackend.Del
 	return &graphqlbackend.EmptyResponse{}, r.svc.DeleteSearchJob(ctx, jobID)
 }
 
+func (r *Resolver) RerunSearchJobIncrementally(ctx context.Context, args *graphqlbackend.RerunSearchJobIncrementallyArgs) (graphqlbackend.SearchJobResolver, error) {
+	jobID, err := UnmarshalSearchJobID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := r.svc.RerunSearchJobIncrementally(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSearchJobResolver(r.db, r.svc, job), nil
+}
+
+func (r *Resolver) CreateSearchJobSchedule(ctx context.Context, args *graphqlbackend.CreateSearchJobScheduleArgs) (graphqlbackend.SearchJobScheduleResolver, error) {
+	namespace, err := graphqlbackend.UnmarshalNamespaceToIDs(args.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := exhaustivetypes.ExhaustiveSearchSchedule{
+		Query:       args.Query,
+		Schedule:    args.Schedule,
+		RetainRuns:  args.RetainRuns,
+		RetainDays:  args.RetainDays,
+		Incremental: args.Incremental,
+		Notify:      args.Notify,
+		Enabled:     true,
+	}
+	if namespace.User != nil {
+		schedule.NamespaceUserID = *namespace.User
+	}
+	if namespace.Org != nil {
+		schedule.NamespaceOrgID = *namespace.Org
+	}
+
+	created, err := r.svc.CreateSearchJobSchedule(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+	return newSearchJobScheduleResolver(r.db, r.svc, created), nil
+}
+
+func (r *Resolver) UpdateSearchJobSchedule(ctx context.Context, args *graphqlbackend.UpdateSearchJobScheduleArgs) (graphqlbackend.SearchJobScheduleResolver, error) {
+	id, err := UnmarshalSearchJobScheduleID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := r.svc.GetSearchJobSchedule(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if args.Query != nil {
+		schedule.Query = *args.Query
+	}
+	if args.Schedule != nil {
+		schedule.Schedule = *args.Schedule
+	}
+	if args.RetainRuns != nil {
+		schedule.RetainRuns = *args.RetainRuns
+	}
+	if args.RetainDays != nil {
+		schedule.RetainDays = *args.RetainDays
+	}
+	if args.Incremental != nil {
+		schedule.Incremental = *args.Incremental
+	}
+	if args.Notify != nil {
+		schedule.Notify = *args.Notify
+	}
+	if args.Enabled != nil {
+		schedule.Enabled = *args.Enabled
+	}
+
+	updated, err := r.svc.UpdateSearchJobSchedule(ctx, *schedule)
+	if err != nil {
+		return nil, err
+	}
+	return newSearchJobScheduleResolver(r.db, r.svc, updated), nil
+}
+
+func (r *Resolver) DeleteSearchJobSchedule(ctx context.Context, args *graphqlbackend.DeleteSearchJobScheduleArgs) (*graphqlbackend.EmptyResponse, error) {
+	id, err := UnmarshalSearchJobScheduleID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphqlbackend.EmptyResponse{}, r.svc.DeleteSearchJobSchedule(ctx, id)
+}
+
+func (r *Resolver) SearchJobSchedules(ctx context.Context, args *graphqlbackend.SearchJobSchedulesArgs) ([]graphqlbackend.SearchJobScheduleResolver, error) {
+	var listArgs store.ListSchedulesArgs
+	if args.Namespace != nil {
+		namespace, err := graphqlbackend.UnmarshalNamespaceToIDs(*args.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		if namespace.User != nil {
+			listArgs.NamespaceUserID = *namespace.User
+		}
+		if namespace.Org != nil {
+			listArgs.NamespaceOrgID = *namespace.Org
+		}
+	}
+
+	schedules, err := r.svc.ListSearchJobSchedules(ctx, listArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]graphqlbackend.SearchJobScheduleResolver, 0, len(schedules))
+	for _, schedule := range schedules {
+		resolvers = append(resolvers, newSearchJobScheduleResolver(r.db, r.svc, schedule))
+	}
+	return resolvers, nil
+}
+
 func newSearchJobConnectionResolver(ctx context.Context, db database.DB, service *service.Service, args *graphqlbackend.SearchJobsArgs) (*gqlutil.ConnectionResolver[graphqlbackend.SearchJobResolver], error) {
 	var states []string
 	if args.States != nil {
@@ -228,6 +348,9 @@ func (r *Resolver) NodeResolvers() map[string]graphqlbackend.NodeByIDFunc {
 		searchJobIDKind: func(ctx context.Context, id graphql.ID) (graphqlbackend.Node, error) {
 			return r.searchJobByID(ctx, id)
 		},
+		searchJobScheduleIDKind: func(ctx context.Context, id graphql.ID) (graphqlbackend.Node, error) {
+			return r.searchJobScheduleByID(ctx, id)
+		},
 	}
 }
 
@@ -242,3 +365,15 @@ func (r *Resolver) searchJobByID(ctx context.Context, id graphql.ID) (graphqlbac
 	}
 	return newSearchJobResolver(r.db, r.svc, job), nil
 }
+
+func (r *Resolver) searchJobScheduleByID(ctx context.Context, id graphql.ID) (graphqlbackend.SearchJobScheduleResolver, error) {
+	scheduleID, err := UnmarshalSearchJobScheduleID(id)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := r.svc.GetSearchJobSchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	return newSearchJobScheduleResolver(r.db, r.svc, schedule), nil
+}
@@ -9,12 +9,15 @@ import (
 	"github.com/graph-gophers/graphql-go/relay"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/service"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/sourcegraph/sourcegraph/lib/pointers"
 )
 
@@ -98,3 +101,41 @@ func (r *searchJobResolver) RepoStats(ctx context.Context) (graphqlbackend.Searc
 	}
 	return &searchJobStatsResolver{repoRevStats}, nil
 }
+
+func (r *searchJobResolver) PreviousJob(ctx context.Context) (graphqlbackend.SearchJobResolver, error) {
+	if r.Job.PreviousJobID == 0 {
+		return nil, nil
+	}
+	job, err := r.svc.GetSearchJob(ctx, r.Job.PreviousJobID)
+	if err != nil {
+		return nil, err
+	}
+	return newSearchJobResolver(r.db, r.svc, job), nil
+}
+
+func (r *searchJobResolver) IncrementalSummary(ctx context.Context) (graphqlbackend.SearchJobIncrementalSummaryResolver, error) {
+	if r.Job.PreviousJobID == 0 {
+		return nil, nil
+	}
+	summary, err := r.svc.GetIncrementalSummary(ctx, r.Job.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &searchJobIncrementalSummaryResolver{summary}, nil
+}
+
+func (r *searchJobResolver) Schedule(ctx context.Context) (graphqlbackend.SearchJobScheduleResolver, error) {
+	if r.Job.ScheduleID == 0 {
+		return nil, nil
+	}
+	schedule, err := r.svc.GetSearchJobSchedule(ctx, r.Job.ScheduleID)
+	if err != nil {
+		// The schedule is owned by an org the current user may not be a
+		// member of anymore.
+		if errcode.IsUnauthorized(err) || errors.Is(err, auth.ErrNotAnOrgMember) || errors.Is(err, store.ErrNoResults) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return newSearchJobScheduleResolver(r.db, r.svc, schedule), nil
+}
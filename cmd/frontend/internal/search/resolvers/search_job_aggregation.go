@@ -0,0 +1,105 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/insights/aggregation"
+	insightstypes "github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func (r *searchJobResolver) Aggregations(ctx context.Context, args *graphqlbackend.SearchJobAggregationsArgs) (graphqlbackend.SearchJobAggregationResolver, error) {
+	mode := insightstypes.SearchAggregationMode(args.Mode)
+	agg, err := aggregation.NewExhaustiveAggregator(mode, aggregation.GroupOptions{
+		DirectoryDepth: int(args.DirectoryDepth),
+		Owners:         own.NewService(gitserver.NewClient("graphql.searchjobs.aggregations"), r.db),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.svc.AggregateSearchJobResults(ctx, r.Job.ID, agg); err != nil {
+		return nil, err
+	}
+
+	res := &searchJobAggregationResolver{
+		jobID:          r.Job.ID,
+		mode:           mode,
+		directoryDepth: args.DirectoryDepth,
+		complete:       r.Job.AggState.IsTerminal(),
+	}
+	for i, group := range agg.SortAggregate() {
+		if i < int(args.Limit) {
+			res.groups = append(res.groups, &searchJobAggregationGroupResolver{group})
+		} else {
+			res.otherGroupCount++
+			res.otherResultCount += group.Count
+		}
+	}
+	return res, nil
+}
+
+var _ graphqlbackend.SearchJobAggregationResolver = &searchJobAggregationResolver{}
+
+type searchJobAggregationResolver struct {
+	jobID            int64
+	mode             insightstypes.SearchAggregationMode
+	directoryDepth   int32
+	groups           []graphqlbackend.SearchJobAggregationGroupResolver
+	otherGroupCount  int32
+	otherResultCount int32
+	complete         bool
+}
+
+func (r *searchJobAggregationResolver) Mode() string {
+	return string(r.mode)
+}
+
+func (r *searchJobAggregationResolver) Groups() []graphqlbackend.SearchJobAggregationGroupResolver {
+	return r.groups
+}
+
+func (r *searchJobAggregationResolver) OtherGroupCount() int32 {
+	return r.otherGroupCount
+}
+
+func (r *searchJobAggregationResolver) OtherResultCount() int32 {
+	return r.otherResultCount
+}
+
+func (r *searchJobAggregationResolver) Complete() bool {
+	return r.complete
+}
+
+func (r *searchJobAggregationResolver) URL() (*string, error) {
+	exportPath, err := url.JoinPath(conf.Get().ExternalURL, fmt.Sprintf("/.api/search/export/%d.jsonl", r.jobID))
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{
+		"aggregation":    {string(r.mode)},
+		"directoryDepth": {strconv.Itoa(int(r.directoryDepth))},
+	}
+	return pointers.Ptr(exportPath + "?" + params.Encode()), nil
+}
+
+var _ graphqlbackend.SearchJobAggregationGroupResolver = &searchJobAggregationGroupResolver{}
+
+type searchJobAggregationGroupResolver struct {
+	*aggregation.Aggregate
+}
+
+func (r *searchJobAggregationGroupResolver) Label() string {
+	return r.Aggregate.Label
+}
+
+func (r *searchJobAggregationGroupResolver) Count() int32 {
+	return r.Aggregate.Count
+}
@@ -0,0 +1,135 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/service"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+)
+
+const searchJobScheduleIDKind = "SearchJobSchedule"
+
+func UnmarshalSearchJobScheduleID(id graphql.ID) (int64, error) {
+	var v int64
+	err := relay.UnmarshalSpec(id, &v)
+	return v, err
+}
+
+var _ graphqlbackend.SearchJobScheduleResolver = &searchJobScheduleResolver{}
+
+func newSearchJobScheduleResolver(db database.DB, svc *service.Service, schedule *types.ExhaustiveSearchSchedule) *searchJobScheduleResolver {
+	return &searchJobScheduleResolver{schedule: schedule, db: db, svc: svc}
+}
+
+// You should call newSearchJobScheduleResolver to construct an instance.
+type searchJobScheduleResolver struct {
+	schedule *types.ExhaustiveSearchSchedule
+	db       database.DB
+	svc      *service.Service
+}
+
+func (r *searchJobScheduleResolver) ID() graphql.ID {
+	return relay.MarshalID(searchJobScheduleIDKind, r.schedule.ID)
+}
+
+func (r *searchJobScheduleResolver) Query() string {
+	return r.schedule.Query
+}
+
+func (r *searchJobScheduleResolver) Schedule() string {
+	return r.schedule.Schedule
+}
+
+func (r *searchJobScheduleResolver) Namespace(ctx context.Context) (*graphqlbackend.NamespaceResolver, error) {
+	var id graphql.ID
+	switch {
+	case r.schedule.NamespaceUserID != 0:
+		id = graphqlbackend.MarshalUserID(r.schedule.NamespaceUserID)
+	case r.schedule.NamespaceOrgID != 0:
+		id = graphqlbackend.MarshalOrgID(r.schedule.NamespaceOrgID)
+	default:
+		return nil, nil
+	}
+
+	n, err := graphqlbackend.NamespaceByID(ctx, r.db, id)
+	if err != nil {
+		return nil, err
+	}
+	return &graphqlbackend.NamespaceResolver{Namespace: n}, nil
+}
+
+func (r *searchJobScheduleResolver) Creator(ctx context.Context) (*graphqlbackend.UserResolver, error) {
+	user, err := r.db.Users().GetByID(ctx, r.schedule.CreatorID)
+	if err != nil {
+		// We return nil for deleted users and expect the client to handle this case.
+		if errcode.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return graphqlbackend.NewUserResolver(ctx, r.db, user), nil
+}
+
+func (r *searchJobScheduleResolver) RetainRuns() int32 {
+	return r.schedule.RetainRuns
+}
+
+func (r *searchJobScheduleResolver) RetainDays() int32 {
+	return r.schedule.RetainDays
+}
+
+func (r *searchJobScheduleResolver) Incremental() bool {
+	return r.schedule.Incremental
+}
+
+func (r *searchJobScheduleResolver) Notify() bool {
+	return r.schedule.Notify
+}
+
+func (r *searchJobScheduleResolver) Enabled() bool {
+	return r.schedule.Enabled
+}
+
+func (r *searchJobScheduleResolver) NextRunAt() *gqlutil.DateTime {
+	return gqlutil.FromTime(r.schedule.NextRunAt)
+}
+
+func (r *searchJobScheduleResolver) LastRunAt() *gqlutil.DateTime {
+	return gqlutil.FromTime(r.schedule.LastRunAt)
+}
+
+func (r *searchJobScheduleResolver) CreatedAt() gqlutil.DateTime {
+	return *gqlutil.FromTime(r.schedule.CreatedAt)
+}
+
+func (r *searchJobScheduleResolver) UpdatedAt() gqlutil.DateTime {
+	return *gqlutil.FromTime(r.schedule.UpdatedAt)
+}
+
+func (r *searchJobScheduleResolver) Runs(ctx context.Context, args *graphqlbackend.SearchJobScheduleRunsArgs) ([]graphqlbackend.SearchJobResolver, error) {
+	first := int(args.First)
+	jobs, err := r.svc.ListSearchJobs(ctx, store.ListArgs{
+		PaginationArgs: &database.PaginationArgs{
+			First:   &first,
+			OrderBy: database.OrderBy{{Field: "created_at"}, {Field: "id"}},
+		},
+		ScheduleID: r.schedule.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]graphqlbackend.SearchJobResolver, 0, len(jobs))
+	for _, job := range jobs {
+		resolvers = append(resolvers, newSearchJobResolver(r.db, r.svc, job))
+	}
+	return resolvers, nil
+}
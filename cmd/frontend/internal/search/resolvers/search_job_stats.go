@@ -26,3 +26,29 @@ func (e *searchJobStatsResolver) Failed() int32 {
 func (e *searchJobStatsResolver) InProgress() int32 {
 	return e.RepoRevJobStats.InProgress
 }
+
+var _ graphqlbackend.SearchJobIncrementalSummaryResolver = &searchJobIncrementalSummaryResolver{}
+
+type searchJobIncrementalSummaryResolver struct {
+	*types.IncrementalSummary
+}
+
+func (e *searchJobIncrementalSummaryResolver) RevisionsReused() int32 {
+	return e.IncrementalSummary.RevisionsReused
+}
+
+func (e *searchJobIncrementalSummaryResolver) RevisionsSearched() int32 {
+	return e.IncrementalSummary.RevisionsSearched
+}
+
+func (e *searchJobIncrementalSummaryResolver) RevisionsRemoved() int32 {
+	return e.IncrementalSummary.RevisionsRemoved
+}
+
+func (e *searchJobIncrementalSummaryResolver) MatchesAdded() int32 {
+	return int32(e.IncrementalSummary.MatchesAdded)
+}
+
+func (e *searchJobIncrementalSummaryResolver) MatchesRemoved() int32 {
+	return int32(e.IncrementalSummary.MatchesRemoved)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/diskcache"
 	"github.com/sourcegraph/sourcegraph/internal/env"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/connection"
@@ -42,6 +43,12 @@ type JanitorConfig struct {
 	ShardID         string
 
 	DisableDeleteReposOnWrongShard bool
+
+	// BlameCache is the on-disk cache of blame results. If set, the janitor
+	// evicts the least recently used entries once it grows beyond
+	// BlameCacheMaxSizeBytes.
+	BlameCache             diskcache.Store
+	BlameCacheMaxSizeBytes int64
 }
 
 func NewJanitor(ctx context.Context, cfg JanitorConfig, db database.DB, fs gitserverfs.FS, gitBackendSource git.GitBackendSource, rcf *wrexec.RecordingCommandFactory, logger log.Logger) goroutine.BackgroundRoutine {
@@ -54,6 +61,10 @@ func NewJanitor(ctx context.Context, cfg JanitorConfig, db database.DB, fs gitse
 			// TODO: Should this return an error?
 			cleanupRepos(ctx, logger, db, fs, gitBackendSource, rcf, cfg.ShardID, gitserverAddrs, cfg.DisableDeleteReposOnWrongShard)
 
+			if cfg.BlameCache != nil {
+				evictBlameCache(logger, cfg.BlameCache, cfg.BlameCacheMaxSizeBytes)
+			}
+
 			return nil
 		}),
 		goroutine.WithName("gitserver.janitor"),
@@ -181,8 +192,28 @@ var (
 		Name: "src_gitserver_non_existing_repos_removed",
 		Help: "number of non existing repos removed during cleanup",
 	})
+	blameCacheSizeBytes = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "src_gitserver_blame_cache_size_bytes",
+		Help: "The size of the blame cache before the last eviction",
+	})
+	blameCacheEvictions = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "src_gitserver_blame_cache_evictions_total",
+		Help: "number of blame results evicted from the blame cache",
+	})
 )
 
+// evictBlameCache removes the least recently used blame results from cache
+// until it is smaller than maxSizeBytes.
+func evictBlameCache(logger log.Logger, cache diskcache.Store, maxSizeBytes int64) {
+	stats, err := cache.Evict(maxSizeBytes)
+	if err != nil {
+		logger.Error("failed to evict items from blame cache", log.Error(err))
+		return
+	}
+	blameCacheSizeBytes.Set(float64(stats.CacheSize))
+	blameCacheEvictions.Add(float64(stats.Evicted))
+}
+
 // cleanupRepos walks the repos directory and performs maintenance tasks:
 //
 // 1. Compute the amount of space used by the repo
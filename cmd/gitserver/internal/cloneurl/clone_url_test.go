@@ -16,6 +16,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/perforce"
@@ -306,6 +307,37 @@ func TestGerritCloneURL(t *testing.T) {
 	})
 }
 
+func TestGiteaCloneURL(t *testing.T) {
+	cfg := schema.GiteaConnection{
+		Url:   "https://gitea.example.com",
+		Token: "secret-token",
+	}
+
+	repo := &gitea.Repository{
+		FullName: "sourcegraph/src-cli",
+		CloneURL: "https://gitea.example.com/sourcegraph/src-cli.git",
+		SSHURL:   "git@gitea.example.com:sourcegraph/src-cli.git",
+	}
+
+	t.Run("HTTP", func(t *testing.T) {
+		got, err := giteaCloneURL(repo, &cfg)
+		require.NoError(t, err)
+		want := "https://secret-token@gitea.example.com/sourcegraph/src-cli.git"
+		if got != want {
+			t.Fatalf("wrong cloneURL, got: %q, want: %q", got, want)
+		}
+	})
+	t.Run("SSH", func(t *testing.T) {
+		cfg.GitURLType = "ssh"
+		got, err := giteaCloneURL(repo, &cfg)
+		require.NoError(t, err)
+		want := "git@gitea.example.com:sourcegraph/src-cli.git"
+		if got != want {
+			t.Fatalf("wrong cloneURL, got: %q, want: %q", got, want)
+		}
+	})
+}
+
 func TestPerforceCloneURL(t *testing.T) {
 	cfg := schema.PerforceConnection{
 		P4Port:   "ssl:111.222.333.444:1666",
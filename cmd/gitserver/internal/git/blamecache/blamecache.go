@@ -0,0 +1,340 @@
+// Package blamecache implements a git.GitBackend which persists blame results
+// on disk, and computes the blame of a commit from the cached blame of its
+// parent where possible.
+package blamecache
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sourcegraph/go-diff/diff"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/diskcache"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var blameCacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "src_gitserver_blame_cache_requests_total",
+	Help: "Blame requests served by the blame cache, by how the result was obtained.",
+}, []string{"result"})
+
+// cacheVersion is part of every cache key. It must be bumped whenever the
+// format of cache entries changes.
+const cacheVersion = "v1"
+
+// errNotCached is returned by the fetcher used to look up cache entries
+// without computing them.
+var errNotCached = errors.New("blame is not cached")
+
+// NewBackend returns a git.GitBackend for repo which serves Blame from cache,
+// and delegates everything else to backend.
+func NewBackend(backend git.GitBackend, repo api.RepoName, cache diskcache.Store) git.GitBackend {
+	return &cachingBackend{
+		GitBackend: backend,
+		repo:       repo,
+		cache:      cache,
+	}
+}
+
+type cachingBackend struct {
+	git.GitBackend
+
+	repo  api.RepoName
+	cache diskcache.Store
+}
+
+func (b *cachingBackend) Blame(ctx context.Context, commit api.CommitID, path string, opt git.BlameOptions) (git.BlameHunkReader, error) {
+	// Only absolute commits identify the same blame forever.
+	if !gitdomain.IsAbsoluteRevision(string(commit)) {
+		blameCacheRequests.WithLabelValues("uncacheable").Inc()
+		return b.GitBackend.Blame(ctx, commit, path, opt)
+	}
+
+	hunks, err := b.blame(ctx, commit, path, opt.IgnoreWhitespace)
+	if err != nil {
+		return nil, err
+	}
+	if opt.Range != nil {
+		hunks = clipHunks(hunks, opt.Range)
+	}
+	return &hunkSliceReader{hunks: hunks}, nil
+}
+
+func (b *cachingBackend) key(commit api.CommitID, path string, ignoreWhitespace bool) []string {
+	return []string{cacheVersion, string(b.repo), string(commit), path, strconv.FormatBool(ignoreWhitespace)}
+}
+
+// blame returns the blame hunks of path at commit, sorted by line, from the
+// cache. On a cache miss, the blame is computed and stored.
+func (b *cachingBackend) blame(ctx context.Context, commit api.CommitID, path string, ignoreWhitespace bool) ([]*gitdomain.Hunk, error) {
+	result := "hit"
+	// The fetcher keeps the error of the underlying backend, which the
+	// cache wraps, so that callers can still check for missing files and
+	// revisions.
+	var computeErr error
+	f, err := b.cache.OpenWithPath(ctx, b.key(commit, path, ignoreWhitespace), func(ctx context.Context, cachePath string) error {
+		hunks, incremental, err := b.compute(ctx, commit, path, ignoreWhitespace)
+		if err != nil {
+			computeErr = err
+			return err
+		}
+		result = "miss"
+		if incremental {
+			result = "incremental"
+		}
+		return writeHunks(cachePath, hunks)
+	})
+	if err != nil {
+		if computeErr != nil {
+			return nil, computeErr
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	blameCacheRequests.WithLabelValues(result).Inc()
+	return readHunks(f)
+}
+
+// cached returns the blame hunks of path at commit if they are in the cache.
+func (b *cachingBackend) cached(ctx context.Context, commit api.CommitID, path string) ([]*gitdomain.Hunk, bool, error) {
+	f, err := b.cache.OpenWithPath(ctx, b.key(commit, path, false), func(context.Context, string) error {
+		return errNotCached
+	})
+	if err != nil {
+		if errors.Is(err, errNotCached) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	defer f.Close()
+
+	hunks, err := readHunks(f)
+	if err != nil {
+		return nil, false, err
+	}
+	return hunks, true, nil
+}
+
+// compute computes the blame of path at commit, incrementally from the cached
+// blame of the parent commit if possible.
+func (b *cachingBackend) compute(ctx context.Context, commit api.CommitID, path string, ignoreWhitespace bool) (_ []*gitdomain.Hunk, incremental bool, _ error) {
+	// The diff used to update the blame of the parent does not ignore
+	// whitespace changes, so whitespace insensitive blames are always
+	// computed in full.
+	if !ignoreWhitespace {
+		hunks, ok, err := b.incremental(ctx, commit, path)
+		if err == nil && ok {
+			return hunks, true, nil
+		}
+		if ctx.Err() != nil {
+			return nil, false, ctx.Err()
+		}
+	}
+
+	r, err := b.GitBackend.Blame(ctx, commit, path, git.BlameOptions{IgnoreWhitespace: ignoreWhitespace})
+	if err != nil {
+		return nil, false, err
+	}
+	defer r.Close()
+
+	var hunks []*gitdomain.Hunk
+	for {
+		h, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		hunks = append(hunks, h)
+	}
+	// git blame --incremental emits hunks in the order they are attributed,
+	// not in the order of the file.
+	sort.Slice(hunks, func(i, j int) bool { return hunks[i].StartLine < hunks[j].StartLine })
+	return hunks, false, nil
+}
+
+// incremental computes the blame of path at commit from the cached blame of
+// its parent and the diff between the two. It returns false if commit is not
+// a regular commit with a single parent, the blame of the parent is not
+// cached, or path was added, renamed or is binary in commit.
+func (b *cachingBackend) incremental(ctx context.Context, commit api.CommitID, path string) ([]*gitdomain.Hunk, bool, error) {
+	c, err := b.GitBackend.GetCommit(ctx, commit, false)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(c.Parents) != 1 {
+		return nil, false, nil
+	}
+	parent := c.Parents[0]
+
+	parentHunks, ok, err := b.cached(ctx, parent, path)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	rc, err := b.GitBackend.RawDiff(ctx, string(parent), string(commit), git.GitDiffComparisonTypeOnlyInHead, git.RawDiffOpts{}, path)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rc.Close()
+	fileDiffs, err := diff.NewMultiFileDiffReader(rc).ReadAllFiles()
+	if err != nil {
+		return nil, false, err
+	}
+
+	switch len(fileDiffs) {
+	case 0:
+		// The file is unchanged, so is its blame.
+		return parentHunks, true, nil
+	case 1:
+		fd := fileDiffs[0]
+		if fd.OrigName != path || fd.NewName != path || len(fd.Hunks) == 0 {
+			return nil, false, nil
+		}
+		added := &gitdomain.Hunk{
+			CommitID:       commit,
+			PreviousCommit: &gitdomain.PreviousCommit{CommitID: parent, Filename: path},
+			Author: gitdomain.Signature{
+				Name:  c.Author.Name,
+				Email: c.Author.Email,
+				// git blame reports author times in seconds.
+				Date: c.Author.Date.UTC().Truncate(time.Second),
+			},
+			Message:  c.Message.Subject(),
+			Filename: path,
+		}
+		hunks, ok := applyDiff(parentHunks, fd.Hunks, added)
+		return hunks, ok, nil
+	default:
+		return nil, false, nil
+	}
+}
+
+// applyDiff returns the blame of a file after applying diffHunks, which must
+// have no context lines, to a file with the blame parentHunks. Added lines
+// are attributed to added. It returns false if the diff does not apply.
+func applyDiff(parentHunks []*gitdomain.Hunk, diffHunks []*diff.Hunk, added *gitdomain.Hunk) ([]*gitdomain.Hunk, bool) {
+	// lines holds, for every line of the file, the hunk it belongs to.
+	// Lines keep belonging to the same hunk value as long as they stay
+	// adjacent, so that hunks are only split where the diff splits them.
+	var parentLines []*gitdomain.Hunk
+	for _, h := range parentHunks {
+		if h.StartLine != uint32(len(parentLines)+1) || h.EndLine < h.StartLine {
+			return nil, false
+		}
+		for l := h.StartLine; l < h.EndLine; l++ {
+			parentLines = append(parentLines, h)
+		}
+	}
+
+	var lines []*gitdomain.Hunk
+	// next is the index of the next line of the parent to copy.
+	next := 0
+	for _, dh := range diffHunks {
+		// For pure insertions, OrigStartLine is the line after which lines
+		// are inserted.
+		start := int(dh.OrigStartLine)
+		if dh.OrigLines > 0 {
+			start--
+		}
+		if start < next || start+int(dh.OrigLines) > len(parentLines) {
+			return nil, false
+		}
+		lines = append(lines, parentLines[next:start]...)
+		if dh.NewLines > 0 {
+			// Every diff hunk gets its own hunk value, so that adjacent
+			// additions are not merged with unrelated ones.
+			h := *added
+			for range dh.NewLines {
+				lines = append(lines, &h)
+			}
+		}
+		next = start + int(dh.OrigLines)
+	}
+	lines = append(lines, parentLines[next:]...)
+
+	var hunks []*gitdomain.Hunk
+	for i := 0; i < len(lines); {
+		j := i + 1
+		for j < len(lines) && lines[j] == lines[i] {
+			j++
+		}
+		h := *lines[i]
+		if h.PreviousCommit != nil {
+			previous := *h.PreviousCommit
+			h.PreviousCommit = &previous
+		}
+		h.StartLine = uint32(i + 1)
+		h.EndLine = uint32(j + 1)
+		hunks = append(hunks, &h)
+		i = j
+	}
+	return hunks, true
+}
+
+// clipHunks returns the parts of hunks within the 1-indexed, inclusive line
+// range r, like git blame -L.
+func clipHunks(hunks []*gitdomain.Hunk, r *git.BlameRange) []*gitdomain.Hunk {
+	start, end := uint32(r.StartLine), uint32(r.EndLine)+1
+	var clipped []*gitdomain.Hunk
+	for _, h := range hunks {
+		if h.EndLine <= start || h.StartLine >= end {
+			continue
+		}
+		c := *h
+		c.StartLine = max(c.StartLine, start)
+		c.EndLine = min(c.EndLine, end)
+		clipped = append(clipped, &c)
+	}
+	return clipped
+}
+
+func writeHunks(path string, hunks []*gitdomain.Hunk) error {
+	f, err := os.OpenFile(path, os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(f).Encode(hunks); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func readHunks(r io.Reader) ([]*gitdomain.Hunk, error) {
+	var hunks []*gitdomain.Hunk
+	if err := json.NewDecoder(r).Decode(&hunks); err != nil {
+		return nil, errors.Wrap(err, "decoding cached blame")
+	}
+	return hunks, nil
+}
+
+// hunkSliceReader is a git.BlameHunkReader for hunks in memory.
+type hunkSliceReader struct {
+	hunks []*gitdomain.Hunk
+}
+
+func (r *hunkSliceReader) Read() (*gitdomain.Hunk, error) {
+	if len(r.hunks) == 0 {
+		return nil, io.EOF
+	}
+	h := r.hunks[0]
+	r.hunks = r.hunks[1:]
+	return h, nil
+}
+
+func (r *hunkSliceReader) Close() error {
+	return nil
+}
@@ -0,0 +1,191 @@
+package blamecache
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/go-diff/diff"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/diskcache"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+)
+
+const (
+	parentCommit = api.CommitID("1111111111111111111111111111111111111111")
+	childCommit  = api.CommitID("2222222222222222222222222222222222222222")
+)
+
+var authorDate = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+// childDiff changes the second line of the file and appends a fourth one.
+const childDiff = `diff --git file.go file.go
+index 1234567..89abcde 100644
+--- file.go
++++ file.go
+@@ -2 +2 @@
+-b
++B
+@@ -3,0 +4 @@
++d
+`
+
+func parentHunks() []*gitdomain.Hunk {
+	return []*gitdomain.Hunk{{
+		StartLine: 1,
+		EndLine:   4,
+		CommitID:  parentCommit,
+		Author:    gitdomain.Signature{Name: "a", Email: "a@example.com", Date: authorDate},
+		Message:   "parent",
+		Filename:  "file.go",
+	}}
+}
+
+func newMockBackend() *git.MockGitBackend {
+	b := git.NewMockGitBackend()
+	b.BlameFunc.SetDefaultHook(func(_ context.Context, commit api.CommitID, _ string, _ git.BlameOptions) (git.BlameHunkReader, error) {
+		if commit != parentCommit {
+			return nil, &gitdomain.RevisionNotFoundError{Repo: "repo", Spec: string(commit)}
+		}
+		return &hunkSliceReader{hunks: parentHunks()}, nil
+	})
+	b.GetCommitFunc.SetDefaultHook(func(_ context.Context, commit api.CommitID, _ bool) (*git.GitCommitWithFiles, error) {
+		if commit != childCommit {
+			// The parent is a root commit.
+			return &git.GitCommitWithFiles{Commit: &gitdomain.Commit{ID: commit}}, nil
+		}
+		return &git.GitCommitWithFiles{
+			Commit: &gitdomain.Commit{
+				ID:      childCommit,
+				Author:  gitdomain.Signature{Name: "b", Email: "b@example.com", Date: authorDate},
+				Message: "child\n\nbody",
+				Parents: []api.CommitID{parentCommit},
+			},
+		}, nil
+	})
+	b.RawDiffFunc.SetDefaultHook(func(context.Context, string, string, git.GitDiffComparisonType, git.RawDiffOpts, ...string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(childDiff)), nil
+	})
+	return b
+}
+
+func readAll(t *testing.T, r git.BlameHunkReader) []*gitdomain.Hunk {
+	t.Helper()
+	var hunks []*gitdomain.Hunk
+	for {
+		h, err := r.Read()
+		if err == io.EOF {
+			return hunks
+		}
+		require.NoError(t, err)
+		hunks = append(hunks, h)
+	}
+}
+
+func TestBlame(t *testing.T) {
+	ctx := context.Background()
+	inner := newMockBackend()
+	b := NewBackend(inner, "repo", diskcache.NewStore(t.TempDir(), "blame"))
+
+	r, err := b.Blame(ctx, parentCommit, "file.go", git.BlameOptions{})
+	require.NoError(t, err)
+	require.Equal(t, parentHunks(), readAll(t, r))
+	require.Len(t, inner.BlameFunc.History(), 1)
+
+	// A second request is served from the cache.
+	r, err = b.Blame(ctx, parentCommit, "file.go", git.BlameOptions{})
+	require.NoError(t, err)
+	require.Equal(t, parentHunks(), readAll(t, r))
+	require.Len(t, inner.BlameFunc.History(), 1)
+
+	// The blame of the child is computed from the cached blame of the
+	// parent, without running git blame.
+	r, err = b.Blame(ctx, childCommit, "file.go", git.BlameOptions{})
+	require.NoError(t, err)
+	require.Len(t, inner.BlameFunc.History(), 1)
+
+	child := &gitdomain.Hunk{
+		CommitID:       childCommit,
+		PreviousCommit: &gitdomain.PreviousCommit{CommitID: parentCommit, Filename: "file.go"},
+		Author:         gitdomain.Signature{Name: "b", Email: "b@example.com", Date: authorDate},
+		Message:        "child",
+		Filename:       "file.go",
+	}
+	want := []*gitdomain.Hunk{
+		withLines(parentHunks()[0], 1, 2),
+		withLines(child, 2, 3),
+		withLines(parentHunks()[0], 3, 4),
+		withLines(child, 4, 5),
+	}
+	if diff := cmp.Diff(want, readAll(t, r)); diff != "" {
+		t.Errorf("unexpected hunks (-want +got):\n%s", diff)
+	}
+
+	// Ranges are applied to cached blames too.
+	r, err = b.Blame(ctx, childCommit, "file.go", git.BlameOptions{Range: &git.BlameRange{StartLine: 2, EndLine: 3}})
+	require.NoError(t, err)
+	if diff := cmp.Diff(want[1:3], readAll(t, r)); diff != "" {
+		t.Errorf("unexpected hunks (-want +got):\n%s", diff)
+	}
+}
+
+func TestBlameUncachedParent(t *testing.T) {
+	inner := newMockBackend()
+	b := NewBackend(inner, "repo", diskcache.NewStore(t.TempDir(), "blame"))
+
+	// The parent's blame is not cached, so a full blame runs and its error
+	// is returned as is.
+	_, err := b.Blame(context.Background(), childCommit, "file.go", git.BlameOptions{})
+	require.True(t, gitdomain.IsRevisionNotFoundError(err), "unexpected error %v", err)
+	require.Len(t, inner.BlameFunc.History(), 1)
+	require.Empty(t, inner.RawDiffFunc.History())
+}
+
+func TestBlameRelativeRevision(t *testing.T) {
+	inner := newMockBackend()
+	inner.BlameFunc.SetDefaultHook(func(context.Context, api.CommitID, string, git.BlameOptions) (git.BlameHunkReader, error) {
+		return &hunkSliceReader{hunks: parentHunks()}, nil
+	})
+	b := NewBackend(inner, "repo", diskcache.NewStore(t.TempDir(), "blame"))
+
+	for range 2 {
+		r, err := b.Blame(context.Background(), "HEAD", "file.go", git.BlameOptions{})
+		require.NoError(t, err)
+		require.Equal(t, parentHunks(), readAll(t, r))
+	}
+	require.Len(t, inner.BlameFunc.History(), 2)
+}
+
+func TestApplyDiffDeletion(t *testing.T) {
+	added := &gitdomain.Hunk{CommitID: childCommit}
+	a := &gitdomain.Hunk{StartLine: 1, EndLine: 3, CommitID: "a"}
+	b := &gitdomain.Hunk{StartLine: 3, EndLine: 5, CommitID: "b"}
+
+	// Deleting the last line of a and the first line of b.
+	hunks, ok := applyDiff([]*gitdomain.Hunk{a, b}, parseHunks(t, "@@ -2,2 +1,0 @@\n-x\n-y\n"), added)
+	require.True(t, ok)
+	require.Equal(t, []*gitdomain.Hunk{withLines(a, 1, 2), withLines(b, 2, 3)}, hunks)
+
+	// Diffs which do not match the blame are rejected.
+	_, ok = applyDiff([]*gitdomain.Hunk{a, b}, parseHunks(t, "@@ -4,2 +3,0 @@\n-x\n-y\n"), added)
+	require.False(t, ok)
+}
+
+func parseHunks(t *testing.T, hunks string) []*diff.Hunk {
+	t.Helper()
+	fd, err := diff.ParseFileDiff([]byte("--- file.go\n+++ file.go\n" + hunks))
+	require.NoError(t, err)
+	return fd.Hunks
+}
+
+func withLines(h *gitdomain.Hunk, start, end uint32) *gitdomain.Hunk {
+	c := *h
+	c.StartLine, c.EndLine = start, end
+	return &c
+}
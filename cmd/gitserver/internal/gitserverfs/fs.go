@@ -31,6 +31,8 @@ type FS interface {
 	TempDir(prefix string) (string, error)
 	IgnorePath(string) bool
 	P4HomeDir() (string, error)
+	// BlameCacheDir returns the directory in which blame results are cached.
+	BlameCacheDir() (string, error)
 	RepoCloned(api.RepoName) (bool, error)
 	RemoveRepo(api.RepoName) error
 	ForEachRepo(func(api.RepoName, common.GitDir) (done bool)) error
@@ -95,6 +97,10 @@ func (r *realGitserverFS) P4HomeDir() (string, error) {
 	return makeP4HomeDir(r.reposDir)
 }
 
+func (r *realGitserverFS) BlameCacheDir() (string, error) {
+	return makeBlameCacheDir(r.reposDir)
+}
+
 func (r *realGitserverFS) RepoCloned(name api.RepoName) (bool, error) {
 	return repoCloned(r.RepoDir(name))
 }
@@ -201,6 +207,10 @@ const tempDirName = ".tmp"
 // and where it will store cache data.
 const p4HomeName = ".p4home"
 
+// blameCacheName is the name used for the directory in which blame results
+// are cached.
+const blameCacheName = ".blame-cache"
+
 func repoDirFromName(reposDir string, name api.RepoName) common.GitDir {
 	p := string(protocol.NormalizeRepo(name))
 	return common.GitDir(filepath.Join(reposDir, filepath.FromSlash(p), ".git"))
@@ -232,12 +242,13 @@ func tempDir(reposDir, prefix string) (name string, err error) {
 }
 
 func ignorePath(reposDir string, path string) bool {
-	// We ignore any path which starts with .tmp or .p4home in ReposDir
+	// We ignore any path which starts with .tmp, .p4home or .blame-cache in
+	// ReposDir
 	if filepath.Dir(path) != reposDir {
 		return false
 	}
 	base := filepath.Base(path)
-	return strings.HasPrefix(base, tempDirName) || strings.HasPrefix(base, p4HomeName)
+	return strings.HasPrefix(base, tempDirName) || strings.HasPrefix(base, p4HomeName) || strings.HasPrefix(base, blameCacheName)
 }
 
 // removeRepoDirectory atomically removes a directory from reposDir.
@@ -57,6 +57,7 @@ func TestIgnorePath(t *testing.T) {
 	}{
 		{path: filepath.Join(reposDir, tempDirName), shouldIgnore: true},
 		{path: filepath.Join(reposDir, p4HomeName), shouldIgnore: true},
+		{path: filepath.Join(reposDir, blameCacheName), shouldIgnore: true},
 		// Double check handling of trailing space
 		{path: filepath.Join(reposDir, p4HomeName+"   "), shouldIgnore: true},
 		{path: filepath.Join(reposDir, "sourcegraph/sourcegraph"), shouldIgnore: false},
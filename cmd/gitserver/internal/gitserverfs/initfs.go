@@ -49,6 +49,15 @@ func makeP4HomeDir(reposDir string) (string, error) {
 	return p4Home, nil
 }
 
+func makeBlameCacheDir(reposDir string) (string, error) {
+	dir := filepath.Join(reposDir, blameCacheName)
+	// Ensure the directory exists
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return "", errors.Wrapf(err, "ensuring blame cache dir exists: %q", dir)
+	}
+	return dir, nil
+}
+
 // setupAndClearTmp sets up the tempdir for reposDir as well as clearing it
 // out. It returns the temporary directory location.
 func setupAndClearTmp(logger log.Logger, reposDir string) (string, error) {
@@ -18,6 +18,9 @@ import (
 // github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs)
 // used for unit testing.
 type MockFS struct {
+	// BlameCacheDirFunc is an instance of a mock function object
+	// controlling the behavior of the method BlameCacheDir.
+	BlameCacheDirFunc *FSBlameCacheDirFunc
 	// CanonicalPathFunc is an instance of a mock function object
 	// controlling the behavior of the method CanonicalPath.
 	CanonicalPathFunc *FSCanonicalPathFunc
@@ -60,6 +63,11 @@ type MockFS struct {
 // values for all results, unless overwritten.
 func NewMockFS() *MockFS {
 	return &MockFS{
+		BlameCacheDirFunc: &FSBlameCacheDirFunc{
+			defaultHook: func() (r0 string, r1 error) {
+				return
+			},
+		},
 		CanonicalPathFunc: &FSCanonicalPathFunc{
 			defaultHook: func(common.GitDir) (r0 string) {
 				return
@@ -127,6 +135,11 @@ func NewMockFS() *MockFS {
 // on invocation, unless overwritten.
 func NewStrictMockFS() *MockFS {
 	return &MockFS{
+		BlameCacheDirFunc: &FSBlameCacheDirFunc{
+			defaultHook: func() (string, error) {
+				panic("unexpected invocation of MockFS.BlameCacheDir")
+			},
+		},
 		CanonicalPathFunc: &FSCanonicalPathFunc{
 			defaultHook: func(common.GitDir) string {
 				panic("unexpected invocation of MockFS.CanonicalPath")
@@ -194,6 +207,9 @@ func NewStrictMockFS() *MockFS {
 // delegate to the given implementation, unless overwritten.
 func NewMockFSFrom(i FS) *MockFS {
 	return &MockFS{
+		BlameCacheDirFunc: &FSBlameCacheDirFunc{
+			defaultHook: i.BlameCacheDir,
+		},
 		CanonicalPathFunc: &FSCanonicalPathFunc{
 			defaultHook: i.CanonicalPath,
 		},
@@ -233,6 +249,107 @@ func NewMockFSFrom(i FS) *MockFS {
 	}
 }
 
+// FSBlameCacheDirFunc describes the behavior when the BlameCacheDir method
+// of the parent MockFS instance is invoked.
+type FSBlameCacheDirFunc struct {
+	defaultHook func() (string, error)
+	hooks       []func() (string, error)
+	history     []FSBlameCacheDirFuncCall
+	mutex       sync.Mutex
+}
+
+// BlameCacheDir delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockFS) BlameCacheDir() (string, error) {
+	r0, r1 := m.BlameCacheDirFunc.nextHook()()
+	m.BlameCacheDirFunc.appendCall(FSBlameCacheDirFuncCall{r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the BlameCacheDir method
+// of the parent MockFS instance is invoked and the hook queue is empty.
+func (f *FSBlameCacheDirFunc) SetDefaultHook(hook func() (string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// BlameCacheDir method of the parent MockFS instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *FSBlameCacheDirFunc) PushHook(hook func() (string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *FSBlameCacheDirFunc) SetDefaultReturn(r0 string, r1 error) {
+	f.SetDefaultHook(func() (string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *FSBlameCacheDirFunc) PushReturn(r0 string, r1 error) {
+	f.PushHook(func() (string, error) {
+		return r0, r1
+	})
+}
+
+func (f *FSBlameCacheDirFunc) nextHook() func() (string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *FSBlameCacheDirFunc) appendCall(r0 FSBlameCacheDirFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of FSBlameCacheDirFuncCall objects describing
+// the invocations of this function.
+func (f *FSBlameCacheDirFunc) History() []FSBlameCacheDirFuncCall {
+	f.mutex.Lock()
+	history := make([]FSBlameCacheDirFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// FSBlameCacheDirFuncCall is an object that describes an invocation of
+// method BlameCacheDir on an instance of MockFS.
+type FSBlameCacheDirFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c FSBlameCacheDirFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c FSBlameCacheDirFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // FSCanonicalPathFunc describes the behavior when the CanonicalPath method
 // of the parent MockFS instance is invoked.
 type FSCanonicalPathFunc struct {
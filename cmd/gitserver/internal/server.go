@@ -33,6 +33,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/limiter"
 	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/internal/repos/webhooks"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/vcs"
 	"github.com/sourcegraph/sourcegraph/internal/wrexec"
@@ -92,6 +93,10 @@ type ServerOpts struct {
 	RecordingCommandFactory *wrexec.RecordingCommandFactory
 }
 
+// webhookSubscriptionsMaxAge is how long gitserver uses the outbound webhook
+// subscriptions for clone events before loading them again.
+const webhookSubscriptionsMaxAge = time.Minute
+
 func NewServer(opt *ServerOpts) *Server {
 	ctx, cancel := context.WithCancelCause(context.Background())
 
@@ -118,6 +123,7 @@ func NewServer(opt *ServerOpts) *Server {
 		getVCSSyncer:            opt.GetVCSSyncer,
 		hostname:                opt.Hostname,
 		db:                      opt.DB,
+		repoWebhooks:            webhooks.NewLongLivedEnqueuer(opt.Logger, opt.DB, webhookSubscriptionsMaxAge),
 		locker:                  opt.Locker,
 		rpsLimiter:              opt.RPSLimiter,
 		recordingCommandFactory: opt.RecordingCommandFactory,
@@ -162,6 +168,9 @@ type Server struct {
 	// db provides access to datastores.
 	db database.DB
 
+	// repoWebhooks enqueues outbound webhooks for clone events.
+	repoWebhooks *webhooks.Enqueuer
+
 	// locker is used to lock repositories while fetching to prevent concurrent work.
 	locker RepositoryLocker
 
@@ -346,10 +355,14 @@ func (s *Server) repoUpdateOrClone(ctx context.Context, repoName api.RepoName) e
 				if err := s.cloneRepo(ctx, repoName, lock); err != nil {
 					repoCloneFailedCounter.Inc()
 					logger.Error("error cloning repo", log.String("repo", string(repoName)), log.Error(err))
+					if s.isFirstCloneFailure(ctx, repoName) {
+						s.repoWebhooks.CloneFailed(ctx, repoName)
+					}
 					return errors.Wrapf(err, "failed to clone %s", repoName)
 				}
 				repoClonedCounter.Inc()
 				logger.Info("cloned repo", log.String("repo", string(repoName)))
+				s.repoWebhooks.CloneCompleted(ctx, repoName)
 			} else {
 				if err := s.doRepoUpdate(ctx, repoName, lock); err != nil {
 					// The repo update might have failed due to the repo being corrupt
@@ -372,6 +385,26 @@ func (s *Server) repoUpdateOrClone(ctx context.Context, repoName api.RepoName) e
 	}
 }
 
+// isFirstCloneFailure returns whether a failed clone of the given repository
+// is the first one since it was last fetched successfully. Failed clones are
+// retried by the update scheduler, and we only want to send a clone failed
+// webhook once, not for every retry. It must be called before the error of
+// the failed clone is recorded.
+func (s *Server) isFirstCloneFailure(ctx context.Context, repoName api.RepoName) bool {
+	if ctx.Err() != nil {
+		// The clone was interrupted because gitserver is shutting down, it
+		// will be retried.
+		return false
+	}
+
+	repo, err := s.db.GitserverRepos().GetByName(ctx, repoName)
+	if err != nil {
+		s.logger.Warn("failed to get last error of repo", log.String("repo", string(repoName)), log.Error(err))
+		return true
+	}
+	return repo.LastError == ""
+}
+
 func (s *Server) LogIfCorrupt(ctx context.Context, repo api.RepoName, err error) {
 	var corruptErr common.ErrRepoCorrupted
 	if errors.As(err, &corruptErr) {
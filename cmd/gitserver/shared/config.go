@@ -38,6 +38,10 @@ type Config struct {
 	JanitorInterval                       time.Duration
 	JanitorDisableDeleteReposOnWrongShard bool
 
+	// BlameCacheSizeMB is the maximum size of the on-disk blame cache. Blame
+	// caching is disabled if it is 0.
+	BlameCacheSizeMB int
+
 	ExhaustiveRequestLoggingEnabled bool
 }
 
@@ -77,5 +81,10 @@ func (c *Config) Load() {
 	c.JanitorInterval = c.GetInterval("SRC_REPOS_JANITOR_INTERVAL", "1m", "Interval between cleanup runs")
 	c.JanitorDisableDeleteReposOnWrongShard = c.GetBool("SRC_REPOS_JANITOR_DISABLE_DELETE_REPOS_ON_WRONG_SHARD", "false", "Disable deleting repos on wrong shard")
 
+	c.BlameCacheSizeMB = c.GetInt("SRC_GITSERVER_BLAME_CACHE_SIZE_MB", "1024", "Maximum size of the on-disk cache of blame results in megabytes. Set to 0 to disable blame caching.")
+	if c.BlameCacheSizeMB < 0 {
+		c.AddError(errors.New("SRC_GITSERVER_BLAME_CACHE_SIZE_MB must not be negative"))
+	}
+
 	c.ExhaustiveRequestLoggingEnabled = c.GetBool("SRC_GITSERVER_EXHAUSTIVE_LOGGING_ENABLED", "false", "Enable exhaustive request logging in gitserver")
 }
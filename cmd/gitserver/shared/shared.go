@@ -30,6 +30,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/cloneurl"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git/blamecache"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git/gitcli"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/vcssyncer"
@@ -42,6 +43,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	connections "github.com/sourcegraph/sourcegraph/internal/database/connections/live"
+	"github.com/sourcegraph/sourcegraph/internal/diskcache"
 	"github.com/sourcegraph/sourcegraph/internal/encryption/keyring"
 	"github.com/sourcegraph/sourcegraph/internal/env"
 	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
@@ -92,8 +94,20 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 	recordingCommandFactory := wrexec.NewRecordingCommandFactory(nil, 0)
 	locker := server.NewRepositoryLocker()
 	hostname := config.ExternalAddress
+	var blameCache diskcache.Store
+	if config.BlameCacheSizeMB > 0 {
+		blameCacheDir, err := fs.BlameCacheDir()
+		if err != nil {
+			return errors.Wrap(err, "creating blame cache dir")
+		}
+		blameCache = diskcache.NewStore(blameCacheDir, "blame", diskcache.WithobservationCtx(observationCtx))
+	}
 	backendSource := func(dir common.GitDir, repoName api.RepoName) git.GitBackend {
-		return git.NewObservableBackend(gitcli.NewBackend(logger, recordingCommandFactory, dir, repoName))
+		backend := gitcli.NewBackend(logger, recordingCommandFactory, dir, repoName)
+		if blameCache != nil {
+			backend = blamecache.NewBackend(backend, repoName, blameCache)
+		}
+		return git.NewObservableBackend(backend)
 	}
 	gitserver := makeServer(
 		observationCtx,
@@ -145,6 +159,8 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 				ShardID:                        hostname,
 				JanitorInterval:                config.JanitorInterval,
 				DisableDeleteReposOnWrongShard: config.JanitorDisableDeleteReposOnWrongShard,
+				BlameCache:                     blameCache,
+				BlameCacheMaxSizeBytes:         int64(config.BlameCacheSizeMB) * 1024 * 1024,
 			},
 			db,
 			fs,
@@ -40,12 +40,28 @@ func toFileMatch(zipReader *zip.Reader, combyMatch *comby.FileMatch, contextLine
 		return protocol.FileMatch{}, err
 	}
 
-	// Convert comby matches to ranges
-	ranges := make([]protocol.Range, 0, len(combyMatch.Matches))
-	for _, r := range combyMatch.Matches {
+	ranges, err := combyMatchesToRanges(combyMatch.Matches, len(fileBuf))
+	if err != nil {
+		return protocol.FileMatch{}, err
+	}
+
+	chunks := chunkRanges(ranges, contextLines*2)
+	chunkMatches := chunksToMatches(fileBuf, chunks, contextLines)
+	return protocol.FileMatch{
+		Path:         combyMatch.URI,
+		ChunkMatches: chunkMatches,
+		LimitHit:     false,
+	}, nil
+}
+
+// combyMatchesToRanges converts comby matches in a file of length fileLen to
+// ranges.
+func combyMatchesToRanges(matches []comby.Match, fileLen int) ([]protocol.Range, error) {
+	ranges := make([]protocol.Range, 0, len(matches))
+	for _, r := range matches {
 		// trust, but verify
-		if r.Range.Start.Offset > len(fileBuf) || r.Range.End.Offset > len(fileBuf) {
-			return protocol.FileMatch{}, errors.New("comby match range does not fit in file")
+		if r.Range.Start.Offset > fileLen || r.Range.End.Offset > fileLen {
+			return nil, errors.New("comby match range does not fit in file")
 		}
 
 		ranges = append(ranges, protocol.Range{
@@ -62,14 +78,7 @@ func toFileMatch(zipReader *zip.Reader, combyMatch *comby.FileMatch, contextLine
 			},
 		})
 	}
-
-	chunks := chunkRanges(ranges, contextLines*2)
-	chunkMatches := chunksToMatches(fileBuf, chunks, contextLines)
-	return protocol.FileMatch{
-		Path:         combyMatch.URI,
-		ChunkMatches: chunkMatches,
-		LimitHit:     false,
-	}, nil
+	return ranges, nil
 }
 
 func combyChunkMatchesToFileMatch(combyMatch *comby.FileMatchWithChunks) protocol.FileMatch {
@@ -337,6 +346,25 @@ func structuralSearch(
 
 	matcher := toMatcher(languages, extensionHint)
 
+	nativeMatcher, err := comby.NewMatcher(pattern, rule, matcher)
+	switch {
+	case err == nil:
+		tr.AddEvent("using native matcher")
+		switch input := inputType.(type) {
+		case comby.Tar:
+			return runNativeAgainstTar(ctx, nativeMatcher, input, sender)
+		case comby.ZipPath:
+			return runNativeAgainstZip(ctx, nativeMatcher, input, paths, numWorkers, contextLines, sender)
+		}
+		return errors.New("comby input must be either -tar or -zip for structural search")
+	case errors.Is(err, comby.ErrUnsupported) && comby.Exists():
+		// Fall back to the comby binary for the parts of its syntax that
+		// the native matcher does not implement.
+		tr.AddEvent("falling back to comby binary", attribute.String("reason", err.Error()))
+	default:
+		return err
+	}
+
 	var filePatterns []string
 	if v, ok := paths.(subset); ok {
 		filePatterns = v
@@ -363,6 +391,133 @@ func structuralSearch(
 	return errors.New("comby input must be either -tar or -zip for structural search")
 }
 
+// matchesFilePatterns reports whether the file at path is selected by paths.
+// Like the -f flag of comby, a subset selects the files whose path ends with
+// one of its patterns.
+func matchesFilePatterns(paths filePatterns, path string) bool {
+	patterns, ok := paths.(subset)
+	if !ok || len(patterns) == 0 {
+		return true
+	}
+	for _, p := range patterns {
+		if strings.HasSuffix(path, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// nativeFileMatch matches content with the native matcher. It returns false
+// if the file does not match.
+func nativeFileMatch(m *comby.Matcher, path string, content []byte, contextLines int32) (protocol.FileMatch, bool, error) {
+	matches := m.Matches(content)
+	if len(matches) == 0 {
+		return protocol.FileMatch{}, false, nil
+	}
+
+	ranges, err := combyMatchesToRanges(matches, len(content))
+	if err != nil {
+		return protocol.FileMatch{}, false, err
+	}
+
+	chunks := chunkRanges(ranges, contextLines*2)
+	return protocol.FileMatch{
+		Path:         path,
+		ChunkMatches: chunksToMatches(content, chunks, contextLines),
+		LimitHit:     false,
+	}, true, nil
+}
+
+// runNativeAgainstTar matches the files streamed on tarInput with the native
+// matcher. It returns the same chunks as runCombyAgainstTar: comby does not
+// add context lines to the chunks and does not include the line terminator of
+// the last line, so neither do we.
+func runNativeAgainstTar(
+	ctx context.Context,
+	m *comby.Matcher,
+	tarInput comby.Tar,
+	sender matchSender,
+) error {
+	for tb := range tarInput.TarInputEventC {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if sender.Remaining() <= 0 {
+			return nil
+		}
+
+		fm, ok, err := nativeFileMatch(m, tb.Header.Name, tb.Content, 0)
+		if err != nil {
+			return err
+		}
+		if ok {
+			for i := range fm.ChunkMatches {
+				fm.ChunkMatches[i].Content = strings.TrimSuffix(fm.ChunkMatches[i].Content, "\n")
+			}
+			sender.Send(fm)
+		}
+	}
+	return nil
+}
+
+// runNativeAgainstZip matches the files of the zip archive at zipPath which
+// are selected by paths with the native matcher, using numWorkers goroutines.
+func runNativeAgainstZip(
+	ctx context.Context,
+	m *comby.Matcher,
+	zipPath comby.ZipPath,
+	paths filePatterns,
+	numWorkers int,
+	contextLines int32,
+	sender matchSender,
+) error {
+	zipReader, err := zip.OpenReader(string(zipPath))
+	if err != nil {
+		return err
+	}
+	defer zipReader.Close()
+
+	p := pool.New().WithContext(ctx).WithCancelOnError().WithMaxGoroutines(numWorkers)
+	for _, f := range zipReader.File {
+		if ctx.Err() != nil || sender.Remaining() <= 0 {
+			break
+		}
+		if f.FileInfo().IsDir() || !matchesFilePatterns(paths, f.Name) {
+			continue
+		}
+
+		f := f
+		p.Go(func(ctx context.Context) error {
+			if ctx.Err() != nil || sender.Remaining() <= 0 {
+				return nil
+			}
+
+			rc, err := f.Open()
+			if err != nil {
+				return err
+			}
+			content, err := io.ReadAll(rc)
+			rc.Close()
+			if err != nil {
+				return err
+			}
+
+			fm, ok, err := nativeFileMatch(m, f.Name, content, contextLines)
+			if err != nil {
+				return errors.Wrap(err, "nativeFileMatch")
+			}
+			if ok {
+				sender.Send(fm)
+			}
+			return nil
+		})
+	}
+	if err := p.Wait(); err != nil {
+		return err
+	}
+	return ctx.Err()
+}
+
 // runCombyAgainstTar runs comby with the flags `-tar` and `-chunk-matches 0`. `-chunk-matches 0` instructs comby to return
 // chunks as part of matches that it finds. Data is streamed into stdin from the channel on tarInput and out from stdout
 // to the result stream.
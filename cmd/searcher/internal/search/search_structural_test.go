@@ -3,11 +3,8 @@ package search
 import (
 	"archive/tar"
 	"context"
-	"os"
-	"os/exec"
 	"path/filepath"
 	"reflect"
-	"runtime"
 	"sort"
 	"strings"
 	"testing"
@@ -22,8 +19,6 @@ import (
 )
 
 func TestMatcherLookupByLanguage(t *testing.T) {
-	maybeSkipComby(t)
-
 	input := map[string]string{
 		"file_without_extension": `
 /* This foo(plain string) {} is in a Go comment should not match in Go, but should match in plaintext */
@@ -90,8 +85,6 @@ func foo(go string) {}
 }
 
 func TestMatcherLookupByExtension(t *testing.T) {
-	maybeSkipComby(t)
-
 	t.Parallel()
 
 	input := map[string]string{
@@ -182,8 +175,6 @@ func foo(go.txt) {}
 // Tests that structural search correctly infers the Go matcher from the .go
 // file extension.
 func TestInferredMatcher(t *testing.T) {
-	maybeSkipComby(t)
-
 	input := map[string]string{
 		"main.go": `
 /* This foo(ignore string) {} is in a Go comment should not match */
@@ -281,8 +272,6 @@ func TestRecordMetrics(t *testing.T) {
 // instead (currently) expects a list of patterns that represent a set of file
 // paths to search.
 func TestIncludePatterns(t *testing.T) {
-	maybeSkipComby(t)
-
 	input := map[string]string{
 		"a/b/c":         "",
 		"a/b/c/foo.go":  "",
@@ -327,8 +316,6 @@ func TestIncludePatterns(t *testing.T) {
 }
 
 func TestRule(t *testing.T) {
-	maybeSkipComby(t)
-
 	input := map[string]string{
 		"file.go": "func foo(success) {} func bar(fail) {}",
 	}
@@ -370,8 +357,6 @@ func TestRule(t *testing.T) {
 }
 
 func TestStructuralLimits(t *testing.T) {
-	maybeSkipComby(t)
-
 	input := map[string]string{
 		"test1.go": `
 func foo() {
@@ -424,8 +409,6 @@ func bar() {
 }
 
 func TestMatchCountForMultilineMatches(t *testing.T) {
-	maybeSkipComby(t)
-
 	input := map[string]string{
 		"main.go": `
 func foo() {
@@ -467,8 +450,6 @@ func bar() {
 }
 
 func TestMultilineMatches(t *testing.T) {
-	maybeSkipComby(t)
-
 	input := map[string]string{
 		"main.go": `
 func foo() {
@@ -531,8 +512,6 @@ func TestBuildQuery(t *testing.T) {
 }
 
 func TestTarInput(t *testing.T) {
-	maybeSkipComby(t)
-
 	input := map[string]string{
 		"main.go": `
 func foo() {
@@ -557,7 +536,7 @@ func bar() {
 	}
 	close(tarInputEventC)
 
-	t.Run("Structural search tar input to comby", func(t *testing.T) {
+	t.Run("Structural search tar input", func(t *testing.T) {
 		ctx, cancel, sender := newLimitedStreamCollector(context.Background(), 1000000000)
 		defer cancel()
 
@@ -588,16 +567,3 @@ func bar() {
 		require.Equal(t, expected, matches)
 	})
 }
-
-func maybeSkipComby(t *testing.T) {
-	t.Helper()
-	if os.Getenv("CI") != "" {
-		return
-	}
-	if runtime.GOOS == "darwin" && runtime.GOARCH == "arm64" {
-		t.Skip("Skipping due to limitations in comby and M1")
-	}
-	if _, err := exec.LookPath("comby"); err != nil {
-		t.Skipf("skipping comby test when not on CI: %v", err)
-	}
-}
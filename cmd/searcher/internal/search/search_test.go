@@ -10,8 +10,6 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"os"
-	"os/exec"
-	"runtime"
 	"sort"
 	"strconv"
 	"strings"
@@ -425,10 +423,6 @@ file contains invalid utf8 � characters
 
 			for i, test := range cases {
 				t.Run(strconv.Itoa(i), func(t *testing.T) {
-					if test.arg.IsStructuralPat {
-						maybeSkipComby(t)
-					}
-
 					req := protocol.Request{
 						Repo:            "foo",
 						Commit:          "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef",
@@ -453,19 +447,6 @@ file contains invalid utf8 � characters
 	}
 }
 
-func maybeSkipComby(t *testing.T) {
-	t.Helper()
-	if os.Getenv("CI") != "" {
-		return
-	}
-	if runtime.GOOS == "darwin" && runtime.GOARCH == "arm64" {
-		t.Skip("Skipping due to limitations in comby and M1")
-	}
-	if _, err := exec.LookPath("comby"); err != nil {
-		t.Skipf("skipping comby test when not on CI: %v", err)
-	}
-}
-
 func TestSearch_badrequest(t *testing.T) {
 	cases := []protocol.Request{
 		// Empty pattern and no file filters
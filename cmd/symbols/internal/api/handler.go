@@ -6,6 +6,8 @@ import (
 	"net/http"
 
 	logger "github.com/sourcegraph/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/sourcegraph/sourcegraph/cmd/symbols/internal/types"
 	internalgrpc "github.com/sourcegraph/sourcegraph/internal/grpc"
@@ -19,6 +21,7 @@ import (
 type grpcService struct {
 	searchFunc   types.SearchFunc
 	readFileFunc func(context.Context, internaltypes.RepoCommitPath) ([]byte, error)
+	history      types.SymbolHistory
 	proto.UnimplementedSymbolsServiceServer
 	logger logger.Logger
 }
@@ -48,6 +51,58 @@ func (s *grpcService) Search(ctx context.Context, r *proto.SearchRequest) (*prot
 	return &response, nil
 }
 
+// DiffSymbols returns the symbols that differ between two commits. It is only
+// implemented when the history of symbols is kept.
+func (s *grpcService) DiffSymbols(ctx context.Context, r *proto.DiffSymbolsRequest) (*proto.DiffSymbolsResponse, error) {
+	if s.history == nil {
+		return nil, status.Error(codes.Unimplemented, "symbol diffs require Rockskip")
+	}
+
+	params := r.ToInternal()
+	diff, err := s.history.DiffSymbols(ctx, params)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, status.FromContextError(ctxErr).Err()
+		}
+
+		s.logger.Error("symbol diff failed",
+			logger.String("arguments", fmt.Sprintf("%+v", params)),
+			logger.Error(err),
+		)
+		return nil, err
+	}
+
+	var response proto.DiffSymbolsResponse
+	response.FromInternal(diff)
+	return &response, nil
+}
+
+// SymbolIntroductions returns the commits in which symbols were added to their
+// paths. It is only implemented when the history of symbols is kept.
+func (s *grpcService) SymbolIntroductions(ctx context.Context, r *proto.SymbolIntroductionsRequest) (*proto.SymbolIntroductionsResponse, error) {
+	if s.history == nil {
+		return nil, status.Error(codes.Unimplemented, "symbol introductions require Rockskip")
+	}
+
+	params := r.ToInternal()
+	introductions, err := s.history.SymbolIntroductions(ctx, params)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, status.FromContextError(ctxErr).Err()
+		}
+
+		s.logger.Error("symbol introduction lookup failed",
+			logger.String("arguments", fmt.Sprintf("%+v", params)),
+			logger.Error(err),
+		)
+		return nil, err
+	}
+
+	var response proto.SymbolIntroductionsResponse
+	response.FromInternal(introductions)
+	return &response, nil
+}
+
 func (s *grpcService) Healthz(ctx context.Context, _ *proto.HealthzRequest) (*proto.HealthzResponse, error) {
 	// Note: Kubernetes only has beta support for GRPC Healthchecks since version >= 1.23. This means
 	// that we probably need the old non-GRPC healthcheck endpoint for a while.
@@ -60,6 +115,7 @@ func (s *grpcService) Healthz(ctx context.Context, _ *proto.HealthzRequest) (*pr
 func NewHandler(
 	searchFunc types.SearchFunc,
 	readFileFunc func(context.Context, internaltypes.RepoCommitPath) ([]byte, error),
+	history types.SymbolHistory,
 	handleStatus func(http.ResponseWriter, *http.Request),
 ) http.Handler {
 	rootLogger := logger.Scoped("symbolsServer")
@@ -69,6 +125,7 @@ func NewHandler(
 	proto.RegisterSymbolsServiceServer(grpcServer, &grpcService{
 		searchFunc:   searchFunc,
 		readFileFunc: readFileFunc,
+		history:      history,
 		logger:       rootLogger.Scoped("grpc"),
 	})
 
@@ -0,0 +1,306 @@
+package rockskip
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	pg "github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// DiffSymbols returns the symbols added, removed or moved between the base and
+// head commits. Both commits are indexed first if needed. Unlike Search, it never
+// falls back to the last indexed commit, since that would compare other trees.
+func (s *Service) DiffSymbols(ctx context.Context, args search.SymbolDiffParameters) (_ *search.SymbolDiff, err error) {
+	repo := string(args.Repo)
+
+	threadStatus := s.status.NewThreadStatus(fmt.Sprintf("diffing %s@%s...%s", repo, args.BaseCommitID, args.CommitID))
+	if s.logQueries {
+		defer threadStatus.Tasklog.Print()
+	}
+	defer threadStatus.End()
+
+	if args.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, args.Timeout)
+		defer cancel()
+	}
+
+	repoId, release, err := s.openRepo(ctx, repo, threadStatus)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errors.CombineErrors(err, release()) }()
+
+	base, err := s.awaitCommit(ctx, repo, repoId, string(args.BaseCommitID), threadStatus)
+	if err != nil {
+		return nil, err
+	}
+	head, err := s.awaitCommit(ctx, repo, repoId, string(args.CommitID), threadStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	db := database.NewDB(s.logger, s.db)
+	baseHops, err := getHops(ctx, db, base, threadStatus.Tasklog)
+	if err != nil {
+		return nil, err
+	}
+	headHops, err := getHops(ctx, db, head, threadStatus.Tasklog)
+	if err != nil {
+		return nil, err
+	}
+	// Drop the null commits.
+	baseHops = baseHops[:len(baseHops)-1]
+	headHops = headHops[:len(headHops)-1]
+
+	limit := DEFAULT_LIMIT
+	if args.First > 0 {
+		limit = args.First
+	}
+
+	// A symbol row is present at a commit when one of the commit's hops added it
+	// and none deleted it. Rows present at exactly one of the commits differ.
+	threadStatus.Tasklog.Start("run diff query")
+	q := sqlf.Sprintf(`
+		SELECT name, path, bool_or(%s && added AND NOT %s && deleted) AS in_head
+		FROM rockskip_symbols
+		WHERE
+			%s && singleton_integer(repo_id)
+			AND (%s && added OR %s && added)
+			AND %s
+		GROUP BY name, path
+		HAVING
+			bool_or(%s && added AND NOT %s && deleted) <>
+			bool_or(%s && added AND NOT %s && deleted)
+		ORDER BY name, path
+		LIMIT %s;`,
+		pg.Array(headHops), pg.Array(headHops),
+		pg.Array([]int{repoId}),
+		pg.Array(baseHops), pg.Array(headHops),
+		convertSearchArgsToSqlQuery(args.SymbolsParameters),
+		pg.Array(baseHops), pg.Array(baseHops),
+		pg.Array(headHops), pg.Array(headHops),
+		limit+1,
+	)
+
+	start := time.Now()
+	rows, err := s.db.QueryContext(ctx, q.Query(sqlf.PostgresBindVar), q.Args()...)
+	duration := time.Since(start)
+	if err != nil {
+		return nil, errors.Wrap(err, "DiffSymbols")
+	}
+	defer rows.Close()
+
+	var added, removed []search.SymbolLocation
+	limitHit := false
+	for rows.Next() {
+		if len(added)+len(removed) == limit {
+			limitHit = true
+			break
+		}
+		var symbol search.SymbolLocation
+		var inHead bool
+		if err := rows.Scan(&symbol.Name, &symbol.Path, &inHead); err != nil {
+			return nil, errors.Wrap(err, "DiffSymbols: Scan")
+		}
+		if inHead {
+			added = append(added, symbol)
+		} else {
+			removed = append(removed, symbol)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "DiffSymbols")
+	}
+
+	diff := newSymbolDiff(added, removed)
+	diff.LimitHit = limitHit
+
+	if s.logQueries {
+		err = logQuery(ctx, db, args.SymbolsParameters, q, duration, len(added)+len(removed))
+		if err != nil {
+			return nil, errors.Wrap(err, "logQuery")
+		}
+	}
+
+	return diff, nil
+}
+
+// newSymbolDiff pairs up symbols that were removed from one path and added to
+// another under the same name into moves. Both lists must be sorted by name and
+// path.
+func newSymbolDiff(added, removed []search.SymbolLocation) *search.SymbolDiff {
+	diff := &search.SymbolDiff{
+		Added:   []search.SymbolLocation{},
+		Removed: []search.SymbolLocation{},
+		Moved:   []search.SymbolMove{},
+	}
+
+	removedPaths := map[string][]string{}
+	for _, symbol := range removed {
+		removedPaths[symbol.Name] = append(removedPaths[symbol.Name], symbol.Path)
+	}
+
+	movedFrom := map[search.SymbolLocation]struct{}{}
+	for _, symbol := range added {
+		paths := removedPaths[symbol.Name]
+		if len(paths) == 0 {
+			diff.Added = append(diff.Added, symbol)
+			continue
+		}
+		diff.Moved = append(diff.Moved, search.SymbolMove{Name: symbol.Name, OldPath: paths[0], NewPath: symbol.Path})
+		movedFrom[search.SymbolLocation{Name: symbol.Name, Path: paths[0]}] = struct{}{}
+		removedPaths[symbol.Name] = paths[1:]
+	}
+
+	for _, symbol := range removed {
+		if _, ok := movedFrom[symbol]; !ok {
+			diff.Removed = append(diff.Removed, symbol)
+		}
+	}
+
+	return diff
+}
+
+// SymbolIntroductions returns, for each path that contains a symbol with the
+// given name at the given commit, the commit in which the symbol was added to that
+// path. If a path is given, only that path is considered.
+func (s *Service) SymbolIntroductions(ctx context.Context, args search.SymbolIntroductionsParameters) (_ []search.SymbolIntroduction, err error) {
+	repo, commitID, name, path := args.Repo, args.CommitID, args.Name, args.Path
+
+	threadStatus := s.status.NewThreadStatus(fmt.Sprintf("finding introduction of %s in %s@%s", name, repo, commitID))
+	if s.logQueries {
+		defer threadStatus.Tasklog.Print()
+	}
+	defer threadStatus.End()
+
+	repoId, release, err := s.openRepo(ctx, string(repo), threadStatus)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errors.CombineErrors(err, release()) }()
+
+	commit, err := s.awaitCommit(ctx, string(repo), repoId, string(commitID), threadStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	hops, err := getHops(ctx, database.NewDB(s.logger, s.db), commit, threadStatus.Tasklog)
+	if err != nil {
+		return nil, err
+	}
+	// Drop the null commit.
+	hops = hops[:len(hops)-1]
+
+	conds := []*sqlf.Query{
+		sqlf.Sprintf("%s && singleton_integer(s.repo_id)", pg.Array([]int{repoId})),
+		sqlf.Sprintf("%s && s.added", pg.Array(hops)),
+		sqlf.Sprintf("NOT %s && s.deleted", pg.Array(hops)),
+		sqlf.Sprintf("ARRAY[%s] && singleton(s.name)", name),
+	}
+	if path != "" {
+		conds = append(conds, sqlf.Sprintf("ARRAY[%s] && singleton(s.path)", path))
+	}
+
+	// Later hops are only ever appended to a symbol's added column, so the
+	// lowest commit in it is the one that inserted the symbol.
+	threadStatus.Tasklog.Start("run introduction query")
+	q := sqlf.Sprintf(`
+		SELECT s.name, s.path, a.commit_id, a.height
+		FROM rockskip_symbols s
+		JOIN LATERAL (
+			SELECT commit_id, height
+			FROM rockskip_ancestry
+			WHERE id = ANY(s.added)
+			ORDER BY height ASC
+			LIMIT 1
+		) a ON TRUE
+		WHERE %s
+		ORDER BY s.path;`,
+		sqlf.Join(conds, "AND"),
+	)
+	rows, err := s.db.QueryContext(ctx, q.Query(sqlf.PostgresBindVar), q.Args()...)
+	if err != nil {
+		return nil, errors.Wrap(err, "SymbolIntroductions")
+	}
+	defer rows.Close()
+
+	introductions := []search.SymbolIntroduction{}
+	for rows.Next() {
+		var introduction search.SymbolIntroduction
+		if err := rows.Scan(&introduction.Name, &introduction.Path, &introduction.CommitID, &introduction.Height); err != nil {
+			return nil, errors.Wrap(err, "SymbolIntroductions: Scan")
+		}
+		introductions = append(introductions, introduction)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "SymbolIntroductions")
+	}
+
+	return introductions, nil
+}
+
+// openRepo acquires a read lock on the repo and marks it as recently accessed. The
+// returned function releases the lock.
+func (s *Service) openRepo(ctx context.Context, repo string, threadStatus *ThreadStatus) (repoId int, release func() error, err error) {
+	locked, releaseRLock, err := tryRLock(ctx, s.db, threadStatus, repo)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !locked {
+		return 0, nil, errors.CombineErrors(errors.Newf("deletion in progress: %s", repo), releaseRLock())
+	}
+
+	threadStatus.Tasklog.Start("update last_accessed_at")
+	repoId, err = updateLastAccessedAt(ctx, s.db, repo)
+	if err != nil {
+		return 0, nil, errors.CombineErrors(err, releaseRLock())
+	}
+
+	// Non-blocking send on repoUpdates to notify the background deletion goroutine.
+	select {
+	case s.repoUpdates <- struct{}{}:
+	default:
+	}
+
+	return repoId, releaseRLock, nil
+}
+
+// awaitCommit returns the ID of the given commit, indexing it first if it has
+// not been indexed yet.
+func (s *Service) awaitCommit(ctx context.Context, repo string, repoId int, commitHash string, threadStatus *ThreadStatus) (CommitId, error) {
+	threadStatus.Tasklog.Start("check commit presence")
+	commit, _, present, err := GetCommitByHash(ctx, s.db, repoId, commitHash)
+	if err != nil {
+		return 0, err
+	} else if present {
+		return commit, nil
+	}
+
+	done, err := s.emitIndexRequest(repoCommit{repo: repo, commit: commitHash})
+	if err != nil {
+		return 0, err
+	}
+
+	threadStatus.Tasklog.Start("awaiting indexing completion")
+	select {
+	case <-done:
+		threadStatus.Tasklog.Start("recheck commit presence")
+		commit, _, present, err = GetCommitByHash(ctx, s.db, repoId, commitHash)
+		if err != nil {
+			return 0, err
+		}
+		if !present {
+			return 0, errors.Newf("indexing failed, check server logs")
+		}
+		return commit, nil
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
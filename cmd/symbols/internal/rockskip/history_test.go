@@ -0,0 +1,183 @@
+package rockskip
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+)
+
+func TestNewSymbolDiff(t *testing.T) {
+	added := []search.SymbolLocation{
+		{Name: "Bar", Path: "new/bar.go"},
+		{Name: "Foo", Path: "foo.go"},
+		{Name: "Qux", Path: "b.go"},
+	}
+	removed := []search.SymbolLocation{
+		{Name: "Bar", Path: "old/bar.go"},
+		{Name: "Baz", Path: "baz.go"},
+		{Name: "Qux", Path: "a.go"},
+		{Name: "Qux", Path: "c.go"},
+	}
+
+	require.Equal(t, &search.SymbolDiff{
+		Added: []search.SymbolLocation{{Name: "Foo", Path: "foo.go"}},
+		Removed: []search.SymbolLocation{
+			{Name: "Baz", Path: "baz.go"},
+			{Name: "Qux", Path: "c.go"},
+		},
+		Moved: []search.SymbolMove{
+			{Name: "Bar", OldPath: "old/bar.go", NewPath: "new/bar.go"},
+			{Name: "Qux", OldPath: "a.go", NewPath: "b.go"},
+		},
+	}, newSymbolDiff(added, removed))
+}
+
+func TestDiffSymbols(t *testing.T) {
+	repo, repoDir := gitserver.MakeGitRepositoryAndReturnDir(t)
+	// Needed in CI
+	gitRun(t, repoDir, "config", "user.email", "test@sourcegraph.com")
+
+	git, err := newSubprocessGit(t, repoDir)
+	require.NoError(t, err)
+	defer git.Close()
+
+	db, s := mockService(t, git)
+	defer db.Close()
+
+	// We don't use 'state' in this test, it's just needed for these method calls.
+	state := map[string][]string{}
+	gitAdd(t, repoDir, state, "a.txt", "symA\nsymMoved\n")
+	gitAdd(t, repoDir, state, "b.txt", "symB\n")
+	base := revParseHead(t, repoDir)
+
+	gitAdd(t, repoDir, state, "a.txt", "symA\nsymNew\n")
+	gitAdd(t, repoDir, state, "c.txt", "symMoved\n")
+	gitRm(t, repoDir, state, "b.txt")
+	head := revParseHead(t, repoDir)
+
+	tests := []struct {
+		name   string
+		params search.SymbolDiffParameters
+		want   *search.SymbolDiff
+	}{
+		{
+			name: "base to head",
+			params: search.SymbolDiffParameters{
+				SymbolsParameters: search.SymbolsParameters{Repo: repo, CommitID: head},
+				BaseCommitID:      base,
+			},
+			want: &search.SymbolDiff{
+				Added:   []search.SymbolLocation{{Name: "symNew", Path: "a.txt"}},
+				Removed: []search.SymbolLocation{{Name: "symB", Path: "b.txt"}},
+				Moved:   []search.SymbolMove{{Name: "symMoved", OldPath: "a.txt", NewPath: "c.txt"}},
+			},
+		},
+		{
+			name: "head to base",
+			params: search.SymbolDiffParameters{
+				SymbolsParameters: search.SymbolsParameters{Repo: repo, CommitID: base},
+				BaseCommitID:      head,
+			},
+			want: &search.SymbolDiff{
+				Added:   []search.SymbolLocation{{Name: "symB", Path: "b.txt"}},
+				Removed: []search.SymbolLocation{{Name: "symNew", Path: "a.txt"}},
+				Moved:   []search.SymbolMove{{Name: "symMoved", OldPath: "c.txt", NewPath: "a.txt"}},
+			},
+		},
+		{
+			name: "same commit",
+			params: search.SymbolDiffParameters{
+				SymbolsParameters: search.SymbolsParameters{Repo: repo, CommitID: head},
+				BaseCommitID:      head,
+			},
+			want: &search.SymbolDiff{Added: []search.SymbolLocation{}, Removed: []search.SymbolLocation{}, Moved: []search.SymbolMove{}},
+		},
+		{
+			name: "filtered by query",
+			params: search.SymbolDiffParameters{
+				SymbolsParameters: search.SymbolsParameters{Repo: repo, CommitID: head, Query: "^symB$", IsCaseSensitive: true},
+				BaseCommitID:      base,
+			},
+			want: &search.SymbolDiff{
+				Added:   []search.SymbolLocation{},
+				Removed: []search.SymbolLocation{{Name: "symB", Path: "b.txt"}},
+				Moved:   []search.SymbolMove{},
+			},
+		},
+		{
+			name: "limited",
+			params: search.SymbolDiffParameters{
+				SymbolsParameters: search.SymbolsParameters{Repo: repo, CommitID: head, First: 1},
+				BaseCommitID:      base,
+			},
+			want: &search.SymbolDiff{
+				Added:    []search.SymbolLocation{},
+				Removed:  []search.SymbolLocation{{Name: "symB", Path: "b.txt"}},
+				Moved:    []search.SymbolMove{},
+				LimitHit: true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := s.DiffSymbols(context.Background(), test.params)
+			require.NoError(t, err)
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestSymbolIntroductions(t *testing.T) {
+	repo, repoDir := gitserver.MakeGitRepositoryAndReturnDir(t)
+	// Needed in CI
+	gitRun(t, repoDir, "config", "user.email", "test@sourcegraph.com")
+
+	git, err := newSubprocessGit(t, repoDir)
+	require.NoError(t, err)
+	defer git.Close()
+
+	db, s := mockService(t, git)
+	defer db.Close()
+
+	// We don't use 'state' in this test, it's just needed for these method calls.
+	state := map[string][]string{}
+	gitAdd(t, repoDir, state, "a.txt", "sym1\n")
+	first := revParseHead(t, repoDir)
+	gitAdd(t, repoDir, state, "b.txt", "other\n")
+	gitAdd(t, repoDir, state, "b.txt", "other\nsym1\n")
+	second := revParseHead(t, repoDir)
+	gitAdd(t, repoDir, state, "a.txt", "sym1\nsym2\n")
+	gitAdd(t, repoDir, state, "c.txt", "sym3\n")
+	head := revParseHead(t, repoDir)
+
+	got, err := s.SymbolIntroductions(context.Background(), search.SymbolIntroductionsParameters{Repo: repo, CommitID: head, Name: "sym1"})
+	require.NoError(t, err)
+	require.Equal(t, []search.SymbolIntroduction{
+		{Name: "sym1", Path: "a.txt", CommitID: first, Height: 1},
+		{Name: "sym1", Path: "b.txt", CommitID: second, Height: 3},
+	}, got)
+
+	got, err = s.SymbolIntroductions(context.Background(), search.SymbolIntroductionsParameters{Repo: repo, CommitID: head, Name: "sym1", Path: "b.txt"})
+	require.NoError(t, err)
+	require.Equal(t, []search.SymbolIntroduction{
+		{Name: "sym1", Path: "b.txt", CommitID: second, Height: 3},
+	}, got)
+
+	// Symbols are only found if they are present at the given commit.
+	got, err = s.SymbolIntroductions(context.Background(), search.SymbolIntroductionsParameters{Repo: repo, CommitID: second, Name: "sym3"})
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func revParseHead(t *testing.T, repoDir string) api.CommitID {
+	out, err := gitserver.CreateGitCommand(repoDir, "git", "rev-parse", "HEAD").CombinedOutput()
+	require.NoError(t, err, string(out))
+	return api.CommitID(bytes.TrimSpace(out))
+}
@@ -99,3 +99,10 @@ func LoadRepositoryFetcherConfig(baseConfig env.BaseConfig) RepositoryFetcherCon
 }
 
 type SearchFunc func(ctx context.Context, args search.SymbolsParameters) (results result.Symbols, err error)
+
+// SymbolHistory is implemented by the symbols backends that keep the history
+// of symbols across commits.
+type SymbolHistory interface {
+	DiffSymbols(ctx context.Context, args search.SymbolDiffParameters) (*search.SymbolDiff, error)
+	SymbolIntroductions(ctx context.Context, args search.SymbolIntroductionsParameters) ([]search.SymbolIntroduction, error)
+}
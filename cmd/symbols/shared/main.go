@@ -46,7 +46,7 @@ var (
 
 const addr = ":3184"
 
-type SetupFunc func(observationCtx *observation.Context, db database.DB, gitserverClient symbolsgitserver.GitserverClient, repositoryFetcher fetcher.RepositoryFetcher) (types.SearchFunc, func(http.ResponseWriter, *http.Request), []goroutine.BackgroundRoutine, error)
+type SetupFunc func(observationCtx *observation.Context, db database.DB, gitserverClient symbolsgitserver.GitserverClient, repositoryFetcher fetcher.RepositoryFetcher) (types.SearchFunc, types.SymbolHistory, func(http.ResponseWriter, *http.Request), []goroutine.BackgroundRoutine, error)
 
 func Main(ctx context.Context, observationCtx *observation.Context, ready service.ReadyFunc, setup SetupFunc) error {
 	logger := observationCtx.Logger
@@ -82,7 +82,7 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 	// Run setup
 	gitserverClient := symbolsgitserver.NewClient(observationCtx, gitserver.NewClient("symbols"))
 	repositoryFetcher := fetcher.NewRepositoryFetcher(observationCtx, gitserverClient, RepositoryFetcherConfig.MaxTotalPathsLength, int64(RepositoryFetcherConfig.MaxFileSizeKb)*1000)
-	searchFunc, handleStatus, newRoutines, err := setup(observationCtx, db, gitserverClient, repositoryFetcher)
+	searchFunc, history, handleStatus, newRoutines, err := setup(observationCtx, db, gitserverClient, repositoryFetcher)
 	if err != nil {
 		return errors.Wrap(err, "failed to set up")
 	}
@@ -96,7 +96,7 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 		}
 		defer r.Close()
 		return io.ReadAll(r)
-	}, handleStatus)
+	}, history, handleStatus)
 
 	handler = handlePanic(logger, handler)
 	handler = trace.HTTPMiddleware(logger, handler)
@@ -41,17 +41,17 @@ func CreateSetup(config rockskipConfig) SetupFunc {
 	var repoToSize sync.Map
 
 	if useRockskip {
-		return func(observationCtx *observation.Context, db database.DB, gitserverClient symbolsGitserver.GitserverClient, repositoryFetcher fetcher.RepositoryFetcher) (types.SearchFunc, func(http.ResponseWriter, *http.Request), []goroutine.BackgroundRoutine, error) {
-			rockskipSearchFunc, rockskipHandleStatus, err := setupRockskip(observationCtx, config, gitserverClient, repositoryFetcher)
+		return func(observationCtx *observation.Context, db database.DB, gitserverClient symbolsGitserver.GitserverClient, repositoryFetcher fetcher.RepositoryFetcher) (types.SearchFunc, types.SymbolHistory, func(http.ResponseWriter, *http.Request), []goroutine.BackgroundRoutine, error) {
+			rockskipServer, err := setupRockskip(observationCtx, config, gitserverClient, repositoryFetcher)
 			if err != nil {
-				return nil, nil, nil, err
+				return nil, nil, nil, nil, err
 			}
+			rockskipSearchFunc := rockskipServer.Search
 
-			// The blanks are the SQLite status endpoint (it's always nil) and the ctags command (same as
-			// Rockskip's).
-			sqliteSearchFunc, _, sqliteBackgroundRoutines, err := SetupSqlite(observationCtx, db, gitserverClient, repositoryFetcher)
+			// The blanks are the SQLite symbol history and status endpoint (they're always nil).
+			sqliteSearchFunc, _, _, sqliteBackgroundRoutines, err := SetupSqlite(observationCtx, db, gitserverClient, repositoryFetcher)
 			if err != nil {
-				return nil, nil, nil, err
+				return nil, nil, nil, nil, err
 			}
 
 			searchFunc := func(ctx context.Context, args search.SymbolsParameters) (results result.Symbols, err error) {
@@ -86,7 +86,9 @@ func CreateSetup(config rockskipConfig) SetupFunc {
 				return sqliteSearchFunc(ctx, args)
 			}
 
-			return searchFunc, rockskipHandleStatus, sqliteBackgroundRoutines, nil
+			// Rockskip indexes the commits it is asked to diff on demand, so the
+			// symbol history is available for every repository.
+			return searchFunc, rockskipServer, rockskipServer.HandleStatus, sqliteBackgroundRoutines, nil
 		}
 	} else {
 		return SetupSqlite
@@ -128,7 +130,7 @@ func loadRockskipConfig(baseConfig env.BaseConfig, ctags types.CtagsConfig, numC
 	}
 }
 
-func setupRockskip(observationCtx *observation.Context, config rockskipConfig, gitserverClient symbolsGitserver.GitserverClient, repositoryFetcher fetcher.RepositoryFetcher) (types.SearchFunc, func(http.ResponseWriter, *http.Request), error) {
+func setupRockskip(observationCtx *observation.Context, config rockskipConfig, gitserverClient symbolsGitserver.GitserverClient, repositoryFetcher fetcher.RepositoryFetcher) (*rockskip.Service, error) {
 	observationCtx = observation.ContextWithLogger(observationCtx.Logger.Scoped("rockskip"), observationCtx)
 
 	codeintelDB := mustInitializeCodeIntelDB(observationCtx)
@@ -139,15 +141,10 @@ func setupRockskip(observationCtx *observation.Context, config rockskipConfig, g
 	}
 	symbolParserPool, err := symbolparser.NewParserPool(observationCtx, "src_rockskip_service", parserFactory, config.NumCtagsProcesses, parserTypesForDeployment())
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "failed to create symbol parser pool")
+		return nil, errors.Wrap(err, "failed to create symbol parser pool")
 	}
 
-	server, err := rockskip.NewService(observationCtx, codeintelDB, gitserverClient, repositoryFetcher, symbolParserPool, config.MaxConcurrentlyIndexing, config.MaxRepos, config.LogQueries, config.IndexRequestsQueueSize, config.SymbolsCacheSize, config.PathSymbolsCacheSize, config.SearchLastIndexedCommit)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return server.Search, server.HandleStatus, nil
+	return rockskip.NewService(observationCtx, codeintelDB, gitserverClient, repositoryFetcher, symbolParserPool, config.MaxConcurrentlyIndexing, config.MaxRepos, config.LogQueries, config.IndexRequestsQueueSize, config.SymbolsCacheSize, config.PathSymbolsCacheSize, config.SearchLastIndexedCommit)
 }
 
 func mustInitializeCodeIntelDB(observationCtx *observation.Context) *sql.DB {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/authz/permssync"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
@@ -111,11 +112,19 @@ func (h *permsSyncerWorker) handlePermsSync(ctx context.Context, reqType request
 
 	// NOTE(naman): here we are saving permissions added, removed and found results
 	// as well as the code host sync status to the job record.
+	syncErr := err
 	if saveErr := h.jobsStore.SaveSyncResult(ctx, recordID, err == nil, result, providerStates); saveErr != nil {
 		err = errors.Append(err, saveErr)
 		h.logger.Error(fmt.Sprintf("failed to save permissions sync job(%d) results", recordID), log.Error(saveErr))
 	}
 
+	switch reqType {
+	case requestTypeUser:
+		permssync.EnqueueUserSyncFinished(ctx, h.logger, h.jobsStore, recordID, reqID, result, syncErr)
+	case requestTypeRepo:
+		permssync.EnqueueRepoSyncFinished(ctx, h.logger, h.jobsStore, recordID, api.RepoID(reqID), result, syncErr)
+	}
+
 	return err
 }
 
@@ -39,6 +39,7 @@ func (j *schedulerJob) Routines(_ context.Context, observationCtx *observation.C
 
 	routines := []goroutine.BackgroundRoutine{
 		scheduler.NewScheduler(workCtx, bstore),
+		scheduler.NewRolloutAdvancer(workCtx, bstore),
 	}
 
 	return routines, nil
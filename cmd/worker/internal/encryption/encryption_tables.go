@@ -27,6 +27,7 @@ var encryptionConfigs = []encryptionConfig{
 	webhooklogsEncryptionConfig,
 	executorSecretsEncryptionConfig,
 	outboundWebhooksEncryptionConfig,
+	codeMonitorTemplatedWebhooksEncryptionConfig,
 }
 
 var externalServicesEncryptionConfig = encryptionConfig{
@@ -103,6 +104,17 @@ var outboundWebhooksEncryptionConfig = encryptionConfig{
 	Limit:               5,
 }
 
+var codeMonitorTemplatedWebhooksEncryptionConfig = encryptionConfig{
+	TableName:           "cm_templated_webhooks",
+	IDFieldName:         "id",
+	KeyIDFieldName:      "encryption_key_id",
+	EncryptedFieldNames: []string{"headers"},
+	UpdateAsBytes:       true,
+	Scan:                basestore.NewMapScanner(scanEncryptedBytea),
+	Key:                 func() encryption.Key { return keyring.Default().OutboundWebhookKey },
+	Limit:               5,
+}
+
 func scanEncryptedString(scanner dbutil.Scanner) (id int, e Encrypted, err error) {
 	e.Values = make([]string, 1)
 	err = scanner.Scan(&id, &e.KeyID, &e.Values[0])
@@ -5,11 +5,16 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
 	workerinsightsdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/codeinsights"
+	"github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/codeintel"
 	workerdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/db"
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/env"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/insights"
 	"github.com/sourcegraph/sourcegraph/internal/insights/background"
+	"github.com/sourcegraph/sourcegraph/internal/insights/background/queryrunner"
+	"github.com/sourcegraph/sourcegraph/internal/insights/query"
+	"github.com/sourcegraph/sourcegraph/internal/insights/query/symbolreferences"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
 
@@ -40,7 +45,28 @@ func (s *insightsJob) Routines(_ context.Context, observationCtx *observation.Co
 		return nil, err
 	}
 
-	return background.GetBackgroundJobs(context.Background(), observationCtx.Logger, db, insightsDB), nil
+	symbolReferences, err := newSymbolReferenceCounter(observationCtx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	return background.GetBackgroundJobs(context.Background(), observationCtx.Logger, db, insightsDB, symbolReferences), nil
+}
+
+// newSymbolReferenceCounter returns the counter used to record insight series generated from
+// symbol references, backed by the code intelligence services.
+func newSymbolReferenceCounter(observationCtx *observation.Context, db database.DB) (queryrunner.SymbolReferenceCounter, error) {
+	services, err := codeintel.InitServices(observationCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return symbolreferences.NewCounter(
+		services.CodenavService,
+		services.UploadsService,
+		db.Repos(),
+		query.NewStreamingRepoQueryExecutor(observationCtx.Logger.Scoped("StreamingRepoExecutor")),
+	), nil
 }
 
 func NewInsightsJob() job.Job {
@@ -42,7 +42,12 @@ func (s *insightsQueryRunnerJob) Routines(_ context.Context, observationCtx *obs
 		return nil, err
 	}
 
-	return background.GetBackgroundQueryRunnerJob(context.Background(), observationCtx.Logger, db, insightsDB), nil
+	symbolReferences, err := newSymbolReferenceCounter(observationCtx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	return background.GetBackgroundQueryRunnerJob(context.Background(), observationCtx.Logger, db, insightsDB, symbolReferences), nil
 }
 
 func NewInsightsQueryRunnerJob() job.Job {
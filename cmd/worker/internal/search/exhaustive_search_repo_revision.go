@@ -72,17 +72,78 @@ func (h *exhaustiveSearchRepoRevHandler) Handle(ctx context.Context, logger log.
 		return err
 	}
 
-	w, err := service.NewJSONWriter(ctx, h.uploadStore, fmt.Sprintf("%d-%d", jobID, record.ID))
+	previousJobID, err := h.store.GetPreviousJobID(ctx, jobID)
 	if err != nil {
 		return err
 	}
+	incremental := previousJobID != 0
 
-	err = q.Search(ctx, repoRev, w)
-	if closeErr := w.Flush(); closeErr != nil {
+	result := types.ExhaustiveSearchRevisionResult{
+		SearchJobID: jobID,
+		RepoID:      repoRev.Repository,
+		Revision:    repoRev.Revision,
+		BlobPrefix:  fmt.Sprintf("%d-%d", jobID, record.ID),
+	}
+
+	// The commit is recorded for every job, so that the job can be re-run
+	// incrementally later on.
+	result.CommitID, err = q.ResolveCommit(ctx, repoRev)
+	if err != nil {
+		return err
+	}
+
+	var previous *types.ExhaustiveSearchRevisionResult
+	if incremental {
+		previous, err = h.store.GetRevisionResult(ctx, previousJobID, repoRev.Repository, repoRev.Revision)
+		if err != nil && !errors.Is(err, store.ErrNoResults) {
+			return err
+		}
+	}
+
+	// The revision didn't move since the previous run, so neither did its
+	// matches.
+	if previous != nil && result.CommitID != "" && previous.CommitID == result.CommitID {
+		if err := service.CopyRevisionResults(ctx, h.uploadStore, previous.BlobPrefix, result.BlobPrefix); err != nil {
+			return err
+		}
+		result.MatchCount = previous.MatchCount
+		result.Reused = true
+		return h.store.UpsertRevisionResult(ctx, result)
+	}
+
+	jw, err := service.NewJSONWriter(ctx, h.uploadStore, result.BlobPrefix)
+	if err != nil {
+		return err
+	}
+	w := service.NewRevisionMatchWriter(jw, incremental)
+
+	// Search the resolved commit rather than the revision, so that the matches
+	// belong to the recorded commit even if the revision moves meanwhile.
+	searchRev := repoRev
+	if result.CommitID != "" {
+		searchRev.Revision = string(result.CommitID)
+	}
+	err = q.Search(ctx, searchRev, w)
+	if closeErr := jw.Flush(); closeErr != nil {
 		err = errors.Append(err, closeErr)
 	}
+	if err != nil {
+		return err
+	}
+
+	var previousPrefix string
+	if previous != nil {
+		previousPrefix = previous.BlobPrefix
+	}
+	diff, err := w.Diff(ctx, h.uploadStore, result.BlobPrefix, previousPrefix)
+	if err != nil {
+		return err
+	}
+	result.MatchCount = diff.MatchCount
+	result.MatchesAdded = diff.Added
+	result.MatchesRemoved = diff.Removed
 
-	return err
+	return h.store.UpsertRevisionResult(ctx, result)
 }
 
 func newExhaustiveSearchRepoRevisionWorkerResetter(
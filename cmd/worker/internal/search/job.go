@@ -128,6 +128,7 @@ func (j *searchJob) newSearchJobRoutines(
 			newExhaustiveSearchRepoRevisionWorkerResetter(observationCtx, revWorkerStore),
 
 			newJanitorJob(observationCtx, db, svc),
+			newSchedulerJob(observationCtx, db, svc),
 		}
 	})
 
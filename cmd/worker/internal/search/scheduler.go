@@ -0,0 +1,141 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/metrics"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/service"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+	"github.com/sourcegraph/sourcegraph/internal/txemail"
+	"github.com/sourcegraph/sourcegraph/internal/txemail/txtypes"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// newSchedulerJob returns a routine which creates search jobs for due
+// schedules, and notifies about and applies retention to finished runs.
+func newSchedulerJob(observationCtx *observation.Context, db database.DB, svc *service.Service) goroutine.BackgroundRoutine {
+	handler := goroutine.HandlerFunc(func(ctx context.Context) error {
+		ctx = actor.WithInternalActor(ctx)
+		now := time.Now()
+
+		if _, err := svc.RunDueSchedules(ctx, now); err != nil {
+			return err
+		}
+
+		return svc.FinalizeScheduleRuns(ctx, now, func(ctx context.Context, schedule *types.ExhaustiveSearchSchedule, run *types.ExhaustiveSearchJob) error {
+			return sendScheduleRunEmail(ctx, db, svc, schedule, run)
+		})
+	})
+
+	operation := observationCtx.Operation(observation.Op{
+		Name: "search.jobs.scheduler",
+		Metrics: metrics.NewREDMetrics(
+			observationCtx.Registerer,
+			"search_jobs_scheduler",
+			metrics.WithCountHelp("Total number of search_jobs_scheduler executions"),
+		),
+	})
+
+	return goroutine.NewPeriodicGoroutine(
+		context.Background(),
+		handler,
+		goroutine.WithName("search_jobs_scheduler"),
+		goroutine.WithDescription("runs scheduled search jobs"),
+		goroutine.WithInterval(1*time.Minute),
+		goroutine.WithOperation(operation),
+	)
+}
+
+type scheduleRunEmailData struct {
+	Query       string
+	Schedule    string
+	State       string
+	ResultsURL  string
+	Total       int32
+	Failed      int32
+	Incremental *types.IncrementalSummary
+}
+
+var scheduleRunEmailTemplates = txemail.MustValidate(txtypes.Templates{
+	Subject: `Scheduled search job {{.State}}: {{.Query}}`,
+	Text: `
+Your scheduled search job for the query
+
+  {{.Query}}
+
+has {{.State}}. It searched {{.Total}} repository revisions{{if .Failed}}, {{.Failed}} of which failed{{end}}.
+{{with .Incremental}}
+Compared to the previous run, {{.MatchesAdded}} matches were added and {{.MatchesRemoved}} matches were removed.
+{{end}}
+The job runs on the schedule "{{.Schedule}}". Download the results of this run here: {{.ResultsURL}}
+`,
+	HTML: `
+<p>Your scheduled search job for the query</p>
+<p><code>{{.Query}}</code></p>
+<p>has {{.State}}. It searched {{.Total}} repository revisions{{if .Failed}}, {{.Failed}} of which failed{{end}}.</p>
+{{with .Incremental}}
+<p>Compared to the previous run, {{.MatchesAdded}} matches were added and {{.MatchesRemoved}} matches were removed.</p>
+{{end}}
+<p>The job runs on the schedule <code>{{.Schedule}}</code>. <a href="{{.ResultsURL}}">Download the results of this run</a>.</p>
+`,
+})
+
+// scheduleRunResultsURL returns the URL to download the results of the run
+// from. Runs of a schedule share the same query, so the email links to the
+// results of the specific run rather than the list of search jobs.
+func scheduleRunResultsURL(externalURL *url.URL, runID int64) string {
+	return externalURL.JoinPath(".api/search/export", fmt.Sprintf("%d.jsonl", runID)).String()
+}
+
+// sendScheduleRunEmail notifies the creator of the schedule about the finished
+// run.
+func sendScheduleRunEmail(ctx context.Context, db database.DB, svc *service.Service, schedule *types.ExhaustiveSearchSchedule, run *types.ExhaustiveSearchJob) error {
+	// Stats are only visible to the initiator of the run.
+	runCtx := actor.WithActor(ctx, actor.FromUser(run.InitiatorID))
+
+	stats, err := svc.GetAggregateRepoRevState(runCtx, run.ID)
+	if err != nil {
+		return err
+	}
+
+	data := scheduleRunEmailData{
+		Query:      schedule.Query,
+		Schedule:   schedule.Schedule,
+		State:      string(run.AggState),
+		ResultsURL: scheduleRunResultsURL(conf.ExternalURLParsed(), run.ID),
+		Total:      stats.Total,
+		Failed:     stats.Failed,
+	}
+	if run.PreviousJobID != 0 {
+		data.Incremental, err = svc.GetIncrementalSummary(runCtx, run.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	email, verified, err := db.UserEmails().GetPrimaryEmail(ctx, schedule.CreatorID)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return errors.Errorf("unable to send email to user ID %d with unknown email address", schedule.CreatorID)
+		}
+		return errors.Errorf("get primary email for userID=%d: %w", schedule.CreatorID, err)
+	}
+	if !verified {
+		return errors.Newf("unable to send email to user ID %d's unverified primary email address", schedule.CreatorID)
+	}
+
+	return txemail.Send(ctx, "search_job_schedule", txtypes.Message{
+		To:       []string{email},
+		Template: scheduleRunEmailTemplates,
+		Data:     data,
+	})
+}
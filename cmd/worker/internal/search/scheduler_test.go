@@ -0,0 +1,15 @@
+package search
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleRunResultsURL(t *testing.T) {
+	externalURL, err := url.Parse("https://sourcegraph.example.com")
+	require.NoError(t, err)
+
+	require.Equal(t, "https://sourcegraph.example.com/.api/search/export/42.jsonl", scheduleRunResultsURL(externalURL, 42))
+}
@@ -0,0 +1,84 @@
+package permssync
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/repos/webhooks"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
+)
+
+const (
+	UserPermissionsSynced = "user:permissions_synced"
+	RepoPermissionsSynced = "repo:permissions_synced"
+)
+
+func init() {
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         UserPermissionsSynced,
+		Description: "sent when a permissions sync job for a user finishes, successfully or not",
+	})
+
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         RepoPermissionsSynced,
+		Description: "sent when a permissions sync job for a repository finishes, successfully or not",
+	})
+}
+
+// permissionsSync represents a finished permissions sync job in a webhook
+// payload.
+type permissionsSync struct {
+	JobID              int         `json:"job_id"`
+	UserID             *graphql.ID `json:"user_id,omitempty"`
+	RepositoryID       *graphql.ID `json:"repository_id,omitempty"`
+	Success            bool        `json:"success"`
+	Error              string      `json:"error,omitempty"`
+	PermissionsAdded   int         `json:"permissions_added"`
+	PermissionsRemoved int         `json:"permissions_removed"`
+	PermissionsFound   int         `json:"permissions_found"`
+}
+
+func newPermissionsSync(jobID int, result *database.SetPermissionsResult, syncErr error) permissionsSync {
+	payload := permissionsSync{JobID: jobID, Success: syncErr == nil}
+	if syncErr != nil {
+		payload.Error = syncErr.Error()
+	}
+	if result != nil {
+		payload.PermissionsAdded = result.Added
+		payload.PermissionsRemoved = result.Removed
+		payload.PermissionsFound = result.Found
+	}
+	return payload
+}
+
+// EnqueueUserSyncFinished enqueues a UserPermissionsSynced webhook for the
+// permissions sync job with the given ID. syncErr is the error the job failed
+// with, if any.
+func EnqueueUserSyncFinished(
+	ctx context.Context, logger log.Logger, db basestore.ShareableStore,
+	jobID int, userID int32, result *database.SetPermissionsResult, syncErr error,
+) {
+	payload := newPermissionsSync(jobID, result, syncErr)
+	id := relay.MarshalID("User", userID)
+	payload.UserID = &id
+	webhooks.Enqueue(ctx, logger, db, UserPermissionsSynced, payload)
+}
+
+// EnqueueRepoSyncFinished enqueues a RepoPermissionsSynced webhook for the
+// permissions sync job with the given ID. syncErr is the error the job failed
+// with, if any.
+func EnqueueRepoSyncFinished(
+	ctx context.Context, logger log.Logger, db basestore.ShareableStore,
+	jobID int, repoID api.RepoID, result *database.SetPermissionsResult, syncErr error,
+) {
+	payload := newPermissionsSync(jobID, result, syncErr)
+	id := relay.MarshalID("Repository", repoID)
+	payload.RepositoryID = &id
+	webhooks.Enqueue(ctx, logger, db, RepoPermissionsSynced, payload)
+}
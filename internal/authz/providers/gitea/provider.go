@@ -0,0 +1,161 @@
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/encryption"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Provider implements authz.Provider for Gitea. The connection's token, which
+// must belong to a site admin, is used to list repositories on behalf of the
+// Gitea account of a Sourcegraph user.
+type Provider struct {
+	urn      string
+	client   gitea.Client
+	codeHost *extsvc.CodeHost
+
+	// matchUsername is true if Sourcegraph users are matched to the Gitea users
+	// with the same username. Otherwise, only accounts linked through Gitea OAuth
+	// are used.
+	matchUsername bool
+}
+
+var _ authz.Provider = (*Provider)(nil)
+
+func NewProvider(conn *types.GiteaConnection) (*Provider, error) {
+	baseURL, err := url.Parse(conn.Url)
+	if err != nil {
+		return nil, err
+	}
+	client, err := gitea.NewClient(conn.URN, baseURL, &auth.OAuthBearerToken{Token: conn.Token}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{
+		urn:           conn.URN,
+		client:        client,
+		codeHost:      extsvc.NewCodeHost(baseURL, extsvc.TypeGitea),
+		matchUsername: conn.Authorization.IdentityProvider != nil && conn.Authorization.IdentityProvider.Username != nil,
+	}, nil
+}
+
+// FetchAccount looks up the Gitea user with the same username as the given
+// Sourcegraph user if the "username" identity provider is configured. It returns
+// nil if no such user exists.
+//
+// Without it, usernames are not trusted to identify Gitea users, and only accounts
+// linked through Gitea OAuth are synced, so nil is returned.
+func (p *Provider) FetchAccount(ctx context.Context, user *types.User, _ []*extsvc.Account, _ []string) (*extsvc.Account, error) {
+	if user == nil || !p.matchUsername {
+		return nil, nil
+	}
+
+	giteaUser, err := p.client.GetUser(ctx, user.Username)
+	if err != nil {
+		if gitea.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	accountData, err := json.Marshal(giteaUser)
+	if err != nil {
+		return nil, err
+	}
+
+	return &extsvc.Account{
+		UserID: user.ID,
+		AccountSpec: extsvc.AccountSpec{
+			ServiceType: p.codeHost.ServiceType,
+			ServiceID:   p.codeHost.ServiceID,
+			AccountID:   strconv.FormatInt(giteaUser.ID, 10),
+		},
+		AccountData: extsvc.AccountData{
+			Data: extsvc.NewUnencryptedData(accountData),
+		},
+	}, nil
+}
+
+// FetchUserPerms returns the IDs of the repositories the given account can read
+// that are not public, including those only visible to signed-in users or members
+// of their owner. The list is fetched by impersonating the user with the admin token.
+//
+// This method may return partial but valid results in case of error, and it is up to
+// callers to decide whether to discard.
+func (p *Provider) FetchUserPerms(ctx context.Context, account *extsvc.Account, opts authz.FetchPermsOptions) (*authz.ExternalUserPermissions, error) {
+	switch {
+	case account == nil:
+		return nil, errors.New("no account provided")
+	case account.Data == nil:
+		return nil, errors.New("no account data provided")
+	case !extsvc.IsHostOfAccount(p.codeHost, account):
+		return nil, errors.Errorf("not a code host of the account: want %q but have %q",
+			p.codeHost.ServiceID, account.AccountSpec.ServiceID)
+	}
+
+	user, err := encryption.DecryptJSON[gitea.User](ctx, account.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshaling account data")
+	}
+
+	client := p.client.WithSudo(user.Login)
+	extIDs := []extsvc.RepoID{}
+	for page := 1; ; page++ {
+		repos, hasNext, err := client.ListAuthenticatedUserRepos(ctx, page)
+		if err != nil {
+			return &authz.ExternalUserPermissions{Exacts: extIDs}, err
+		}
+		for _, r := range repos {
+			if !r.IsPublic() {
+				extIDs = append(extIDs, extsvc.RepoID(strconv.FormatInt(r.ID, 10)))
+			}
+		}
+		if !hasNext {
+			break
+		}
+	}
+
+	return &authz.ExternalUserPermissions{
+		Exacts: extIDs,
+	}, nil
+}
+
+// FetchRepoPerms is not implemented for Gitea, which has no API to list all
+// users with access to a repository including those inherited from teams.
+func (p *Provider) FetchRepoPerms(ctx context.Context, repo *extsvc.Repository, opts authz.FetchPermsOptions) ([]extsvc.AccountID, error) {
+	return nil, &authz.ErrUnimplemented{Feature: "gitea.FetchRepoPerms"}
+}
+
+func (p *Provider) ServiceType() string {
+	return p.codeHost.ServiceType
+}
+
+func (p *Provider) ServiceID() string {
+	return p.codeHost.ServiceID
+}
+
+func (p *Provider) URN() string {
+	return p.urn
+}
+
+// ValidateConnection validates that the configured token belongs to a Gitea
+// site admin, which is required to impersonate users.
+func (p *Provider) ValidateConnection(ctx context.Context) error {
+	user, err := p.client.GetAuthenticatedUser(ctx)
+	if err != nil {
+		return errors.Newf("Unable to fetch authenticated Gitea user: %s", err)
+	}
+	if !user.IsAdmin {
+		return errors.Newf("Gitea token of user %q is not a site admin token, which is required for authorization", user.Login)
+	}
+	return nil
+}
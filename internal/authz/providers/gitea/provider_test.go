@@ -0,0 +1,172 @@
+package gitea
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestProvider_ValidateConnection(t *testing.T) {
+	testCases := []struct {
+		name    string
+		user    *gitea.User
+		err     error
+		wantErr string
+	}{
+		{
+			name:    "request fails",
+			err:     errors.New("fake error"),
+			wantErr: "Unable to fetch authenticated Gitea user: fake error",
+		},
+		{
+			name:    "not an admin",
+			user:    &gitea.User{Login: "alice"},
+			wantErr: `Gitea token of user "alice" is not a site admin token, which is required for authorization`,
+		},
+		{
+			name: "admin",
+			user: &gitea.User{Login: "root", IsAdmin: true},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := NewStrictMockGiteaClient()
+			client.GetAuthenticatedUserFunc.SetDefaultReturn(tc.user, tc.err)
+
+			err := NewTestProvider(client).ValidateConnection(context.Background())
+			errMessage := ""
+			if err != nil {
+				errMessage = err.Error()
+			}
+			if diff := cmp.Diff(errMessage, tc.wantErr); diff != "" {
+				t.Fatalf("error did not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProvider_FetchAccount(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("user exists", func(t *testing.T) {
+		client := NewStrictMockGiteaClient()
+		client.GetUserFunc.SetDefaultHook(func(_ context.Context, username string) (*gitea.User, error) {
+			assert.Equal(t, "alice", username)
+			return &gitea.User{ID: 42, Login: "alice"}, nil
+		})
+
+		p := NewTestProvider(client)
+		p.matchUsername = true
+		acct, err := p.FetchAccount(ctx, &types.User{ID: 1, Username: "alice"}, nil, nil)
+		require.NoError(t, err)
+		require.NotNil(t, acct)
+		assert.Equal(t, int32(1), acct.UserID)
+		assert.Equal(t, extsvc.AccountSpec{
+			ServiceType: extsvc.TypeGitea,
+			ServiceID:   "https://gitea.sgdev.org/",
+			AccountID:   "42",
+		}, acct.AccountSpec)
+	})
+
+	t.Run("user does not exist", func(t *testing.T) {
+		client := NewStrictMockGiteaClient()
+		client.GetUserFunc.SetDefaultReturn(nil, notFoundError(t))
+
+		p := NewTestProvider(client)
+		p.matchUsername = true
+		acct, err := p.FetchAccount(ctx, &types.User{ID: 1, Username: "bob"}, nil, nil)
+		require.NoError(t, err)
+		assert.Nil(t, acct)
+	})
+
+	t.Run("username matching not configured", func(t *testing.T) {
+		// The strict mock fails the test if the user is looked up.
+		client := NewStrictMockGiteaClient()
+
+		acct, err := NewTestProvider(client).FetchAccount(ctx, &types.User{ID: 1, Username: "alice"}, nil, nil)
+		require.NoError(t, err)
+		assert.Nil(t, acct)
+	})
+}
+
+func TestProvider_FetchUserPerms(t *testing.T) {
+	ctx := context.Background()
+
+	sudoClient := NewStrictMockGiteaClient()
+	sudoClient.ListAuthenticatedUserReposFunc.SetDefaultHook(func(_ context.Context, page int) ([]*gitea.Repository, bool, error) {
+		switch page {
+		case 1:
+			return []*gitea.Repository{{ID: 1, Private: true}, {ID: 2}, {ID: 4, Internal: true}}, true, nil
+		case 2:
+			return []*gitea.Repository{
+				{ID: 3, Private: true},
+				{ID: 5, Owner: &gitea.User{Visibility: gitea.VisibilityPrivate}},
+				{ID: 6, Owner: &gitea.User{Visibility: gitea.VisibilityPublic}},
+			}, false, nil
+		}
+		t.Fatalf("unexpected page %d", page)
+		return nil, false, nil
+	})
+
+	client := NewStrictMockGiteaClient()
+	client.WithSudoFunc.SetDefaultHook(func(username string) gitea.Client {
+		assert.Equal(t, "alice", username)
+		return sudoClient
+	})
+
+	acct := &extsvc.Account{
+		AccountSpec: extsvc.AccountSpec{
+			ServiceType: extsvc.TypeGitea,
+			ServiceID:   "https://gitea.sgdev.org/",
+			AccountID:   "42",
+		},
+		AccountData: extsvc.AccountData{
+			Data: extsvc.NewUnencryptedData([]byte(`{"id":42,"login":"alice"}`)),
+		},
+	}
+
+	perms, err := NewTestProvider(client).FetchUserPerms(ctx, acct, authz.FetchPermsOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []extsvc.RepoID{"1", "4", "3", "5"}, perms.Exacts)
+}
+
+// notFoundError returns the error the Gitea client returns for a 404 response.
+func notFoundError(t *testing.T) error {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"The target couldn't be found."}`, http.StatusNotFound)
+	}))
+	t.Cleanup(srv.Close)
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	cli, err := gitea.NewClient("urn", u, nil, httpcli.TestExternalDoer)
+	require.NoError(t, err)
+
+	_, err = cli.GetUser(context.Background(), "bob")
+	require.True(t, gitea.IsNotFound(err))
+	return err
+}
+
+func NewTestProvider(client gitea.Client) *Provider {
+	baseURL, _ := url.Parse("https://gitea.sgdev.org")
+	return &Provider{
+		urn:      "Gitea",
+		client:   client,
+		codeHost: extsvc.NewCodeHost(baseURL, extsvc.TypeGitea),
+	}
+}
@@ -2,8 +2,10 @@ package processor
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
+	"github.com/graph-gophers/graphql-go/relay"
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
@@ -18,6 +20,10 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/batches/execution"
+	"github.com/sourcegraph/sourcegraph/lib/batches/execution/cache"
+	"github.com/sourcegraph/sourcegraph/lib/batches/template"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -39,9 +45,10 @@ var changesetIsProcessingErr = errors.New("cannot update a changeset that is cur
 
 func New(logger log.Logger, tx *store.Store, sourcer sources.Sourcer) BulkProcessor {
 	return &bulkProcessor{
-		tx:      tx,
-		sourcer: sourcer,
-		logger:  logger,
+		tx:              tx,
+		sourcer:         sourcer,
+		logger:          logger,
+		gitserverClient: gitserver.NewClient("batches.bulkprocessor"),
 	}
 }
 
@@ -50,9 +57,10 @@ type BulkProcessor interface {
 }
 
 type bulkProcessor struct {
-	tx      *store.Store
-	sourcer sources.Sourcer
-	logger  log.Logger
+	tx              *store.Store
+	sourcer         sources.Sourcer
+	logger          log.Logger
+	gitserverClient gitserver.Client
 
 	css  sources.ChangesetSource
 	repo *types.Repo
@@ -102,6 +110,8 @@ func (b *bulkProcessor) Process(ctx context.Context, job *btypes.ChangesetJob) (
 		return b.closeChangeset(ctx)
 	case btypes.ChangesetJobTypePublish:
 		return nil, b.publishChangeset(ctx, job)
+	case btypes.ChangesetJobTypeRebase:
+		return nil, b.rebaseChangeset(ctx, job)
 
 	default:
 		return nil, &unknownJobTypeErr{jobType: string(job.JobType)}
@@ -289,6 +299,190 @@ func (b *bulkProcessor) publishChangeset(ctx context.Context, job *btypes.Change
 	return nil
 }
 
+func (b *bulkProcessor) rebaseChangeset(ctx context.Context, job *btypes.ChangesetJob) (err error) {
+	if _, ok := job.Payload.(*btypes.ChangesetJobRebasePayload); !ok {
+		return errors.Errorf("invalid payload type for changeset_job, want=%T have=%T", &btypes.ChangesetJobRebasePayload{}, job.Payload)
+	}
+
+	// We can't rebase an imported changeset.
+	if b.ch.CurrentSpecID == 0 {
+		return errcode.MakeNonRetryable(errors.New("cannot rebase an imported changeset"))
+	}
+
+	// Only changesets created by a server-side execution can be rebased, since
+	// we need to re-execute their workspace.
+	workspace, err := b.tx.GetBatchSpecWorkspace(ctx, store.GetBatchSpecWorkspaceOpts{ChangesetSpecID: b.ch.CurrentSpecID})
+	if err != nil {
+		if err == store.ErrNoResults {
+			return errcode.MakeNonRetryable(errors.New("cannot rebase a changeset that was not created by a server-side batch spec execution"))
+		}
+		return errors.Wrap(err, "loading batch spec workspace")
+	}
+
+	executionJobs, err := b.tx.ListBatchSpecWorkspaceExecutionJobs(ctx, store.ListBatchSpecWorkspaceExecutionJobsOpts{
+		BatchSpecWorkspaceIDs: []int64{workspace.ID},
+		ExcludeRank:           true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "loading batch spec workspace execution jobs")
+	}
+	for _, j := range executionJobs {
+		if j.State == btypes.BatchSpecWorkspaceExecutionJobStateQueued || j.State == btypes.BatchSpecWorkspaceExecutionJobStateProcessing {
+			return errcode.MakeNonRetryable(errors.New("cannot rebase a changeset whose workspace is currently being executed"))
+		}
+	}
+
+	baseRev, err := b.gitserverClient.ResolveRevision(ctx, b.repo.Name, workspace.Branch, gitserver.ResolveRevisionOptions{EnsureRevision: true})
+	if err != nil {
+		return errors.Wrapf(err, "resolving head of base branch %q", workspace.Branch)
+	}
+
+	rebase := &btypes.ChangesetRebase{
+		BulkGroup:            job.BulkGroup,
+		ChangesetID:          b.ch.ID,
+		BatchSpecWorkspaceID: workspace.ID,
+		BaseRev:              string(baseRev),
+	}
+
+	// If the changeset is already based on the head of its base branch,
+	// re-executing the workspace won't change anything.
+	if string(baseRev) == workspace.Commit {
+		rebase.State = btypes.ChangesetRebaseStateUpToDate
+		return b.tx.CreateChangesetRebase(ctx, rebase)
+	}
+
+	batchSpec, err := b.tx.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: workspace.BatchSpecID})
+	if err != nil {
+		return errors.Wrap(err, "loading batch spec")
+	}
+
+	workspace.Commit = string(baseRev)
+	workspace.StepCacheResults, err = b.rebasedStepCacheResults(ctx, batchSpec, workspace)
+	if err != nil {
+		return errors.Wrap(err, "looking up step cache results")
+	}
+	if err := b.tx.RebaseBatchSpecWorkspace(ctx, workspace); err != nil {
+		return errors.Wrap(err, "updating batch spec workspace")
+	}
+
+	// Unlike when retrying a workspace, we don't delete the changeset specs
+	// the workspace created: the changeset still uses them until the
+	// re-execution has produced new ones.
+	if err := b.tx.DeleteBatchSpecWorkspaceExecutionJobs(ctx, store.DeleteBatchSpecWorkspaceExecutionJobsOpts{WorkspaceIDs: []int64{workspace.ID}}); err != nil {
+		return errors.Wrap(err, "deleting batch spec workspace execution jobs")
+	}
+	if err := b.tx.CreateBatchSpecWorkspaceExecutionJobsForWorkspaces(ctx, []int64{workspace.ID}); err != nil {
+		return errors.Wrap(err, "creating batch spec workspace execution job")
+	}
+
+	return b.tx.CreateChangesetRebase(ctx, rebase)
+}
+
+// rebasedStepCacheResults looks up the step results that have been cached for
+// the workspace on its current commit, so that the re-execution only runs the
+// steps that haven't been executed on that commit yet. The last step is always
+// re-executed, so that the execution produces new changeset specs.
+func (b *bulkProcessor) rebasedStepCacheResults(ctx context.Context, batchSpec *btypes.BatchSpec, workspace *btypes.BatchSpecWorkspace) (map[int]btypes.StepCacheResult, error) {
+	steps := batchSpec.Spec.Steps
+
+	// Secrets and mounted files are part of the cache keys, but are only
+	// resolved when the workspaces of a batch spec are created. We don't try
+	// to reuse cache entries for batch specs that use either of them.
+	if len(steps) < 2 || len(batchSpec.Spec.RequiredEnvVars()) > 0 {
+		return nil, nil
+	}
+	for _, step := range steps {
+		if len(step.Mount) > 0 {
+			return nil, nil
+		}
+	}
+
+	skippedSteps, err := batcheslib.SkippedStepsForRepo(batchSpec.Spec, string(b.repo.Name), workspace.FileMatches)
+	if err != nil {
+		return nil, err
+	}
+
+	repo := batcheslib.Repository{
+		ID:          string(relay.MarshalID("Repository", b.repo.ID)),
+		Name:        string(b.repo.Name),
+		BaseRef:     workspace.Branch,
+		BaseRev:     workspace.Commit,
+		FileMatches: workspace.FileMatches,
+	}
+
+	type stepCacheKey struct {
+		index int
+		key   string
+	}
+	var keys []stepCacheKey
+	for i := range len(steps) - 1 {
+		if _, ok := skippedSteps[i]; ok {
+			continue
+		}
+
+		key, err := cache.KeyForWorkspace(
+			&template.BatchChangeAttributes{
+				Name:        batchSpec.Spec.Name,
+				Description: batchSpec.Spec.Description,
+			},
+			repo,
+			workspace.Path,
+			nil,
+			workspace.OnlyFetchWorkspace,
+			steps,
+			i,
+			nil,
+		).Key()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, stepCacheKey{index: i, key: key})
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	rawKeys := make([]string, 0, len(keys))
+	for _, k := range keys {
+		rawKeys = append(rawKeys, k.key)
+	}
+	entries, err := b.tx.ListBatchSpecExecutionCacheEntries(ctx, store.ListBatchSpecExecutionCacheEntriesOpts{
+		UserID: batchSpec.UserID,
+		Keys:   rawKeys,
+	})
+	if err != nil {
+		return nil, err
+	}
+	entriesByKey := make(map[string]*btypes.BatchSpecExecutionCacheEntry, len(entries))
+	for _, entry := range entries {
+		entriesByKey[entry.Key] = entry
+	}
+
+	results := make(map[int]btypes.StepCacheResult)
+	var usedEntries []int64
+	for _, k := range keys {
+		entry, ok := entriesByKey[k.key]
+		if !ok {
+			// Only use cache entries up until we don't have the cache entry
+			// for the previous step anymore.
+			break
+		}
+
+		var res execution.AfterStepResult
+		if err := json.Unmarshal([]byte(entry.Value), &res); err != nil {
+			return nil, err
+		}
+		results[k.index+1] = btypes.StepCacheResult{Key: k.key, Value: &res}
+		usedEntries = append(usedEntries, entry.ID)
+	}
+
+	if err := b.tx.MarkUsedBatchSpecExecutionCacheEntries(ctx, usedEntries); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
 func (b *bulkProcessor) enqueueWebhook(ctx context.Context, store *store.Store, eventType string) {
 	webhooks.EnqueueChangeset(ctx, b.logger, store, eventType, bgql.MarshalChangesetID(b.ch.ID))
 }
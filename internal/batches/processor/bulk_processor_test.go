@@ -24,6 +24,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
@@ -483,4 +484,149 @@ func TestBulkProcessor(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("Rebase job", func(t *testing.T) {
+		gitserverClient := gitserver.NewMockClient()
+		gitserverClient.ResolveRevisionFunc.SetDefaultReturn("c0ffee", nil)
+
+		bp := &bulkProcessor{
+			tx:              bstore,
+			sourcer:         stesting.NewFakeSourcer(nil, &stesting.FakeChangesetSource{}),
+			logger:          logtest.Scoped(t),
+			gitserverClient: gitserverClient,
+		}
+
+		newRebaseJob := func(changesetID int64) *types.ChangesetJob {
+			return &types.ChangesetJob{
+				JobType:       types.ChangesetJobTypeRebase,
+				BulkGroup:     "rebase-group",
+				BatchChangeID: batchChange.ID,
+				ChangesetID:   changesetID,
+				UserID:        user.ID,
+				Payload:       &types.ChangesetJobRebasePayload{},
+			}
+		}
+
+		createWorkspace := func(t *testing.T, commit string) (*btypes.Changeset, *btypes.BatchSpecWorkspace) {
+			changesetSpec := bt.CreateChangesetSpec(t, ctx, bstore, bt.TestSpecOpts{
+				User:      user.ID,
+				Repo:      repo.ID,
+				BatchSpec: batchSpec.ID,
+				HeadRef:   "main",
+				Typ:       btypes.ChangesetSpecTypeBranch,
+			})
+			workspace := &btypes.BatchSpecWorkspace{
+				BatchSpecID:      batchSpec.ID,
+				ChangesetSpecIDs: []int64{changesetSpec.ID},
+				RepoID:           repo.ID,
+				Branch:           "refs/heads/main",
+				Commit:           commit,
+			}
+			if err := bstore.CreateBatchSpecWorkspace(ctx, workspace); err != nil {
+				t.Fatal(err)
+			}
+			changeset := bt.CreateChangeset(t, ctx, bstore, bt.TestChangesetOpts{
+				Repo:            repo.ID,
+				BatchChange:     batchChange.ID,
+				CurrentSpec:     changesetSpec.ID,
+				ReconcilerState: btypes.ReconcilerStateCompleted,
+				ExternalState:   btypes.ChangesetExternalStateOpen,
+			})
+			return changeset, workspace
+		}
+
+		t.Run("errors", func(t *testing.T) {
+			for name, currentSpec := range map[string]int64{
+				"imported changeset": 0,
+				"not created by a server-side execution": bt.CreateChangesetSpec(t, ctx, bstore, bt.TestSpecOpts{
+					User:      user.ID,
+					Repo:      repo.ID,
+					BatchSpec: batchSpec.ID,
+					HeadRef:   "main",
+					Typ:       btypes.ChangesetSpecTypeBranch,
+				}).ID,
+			} {
+				t.Run(name, func(t *testing.T) {
+					changeset := bt.CreateChangeset(t, ctx, bstore, bt.TestChangesetOpts{
+						Repo:            repo.ID,
+						BatchChange:     batchChange.ID,
+						CurrentSpec:     currentSpec,
+						ReconcilerState: btypes.ReconcilerStateCompleted,
+						ExternalState:   btypes.ChangesetExternalStateOpen,
+					})
+
+					_, err := bp.Process(ctx, newRebaseJob(changeset.ID))
+					if err == nil {
+						t.Fatal("unexpected nil error")
+					}
+					if !errcode.IsNonRetryable(err) {
+						t.Errorf("error is retryable: %v", err)
+					}
+				})
+			}
+		})
+
+		t.Run("up to date", func(t *testing.T) {
+			changeset, workspace := createWorkspace(t, "c0ffee")
+
+			if _, err := bp.Process(ctx, newRebaseJob(changeset.ID)); err != nil {
+				t.Fatal(err)
+			}
+
+			rebases, err := bstore.ListChangesetRebases(ctx, store.ListChangesetRebasesOpts{BulkGroup: "rebase-group"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			rebase := rebases[len(rebases)-1]
+			if rebase.BatchSpecWorkspaceID != workspace.ID || rebase.State != btypes.ChangesetRebaseStateUpToDate {
+				t.Fatalf("unexpected rebase: %+v", rebase)
+			}
+
+			jobs, err := bstore.ListBatchSpecWorkspaceExecutionJobs(ctx, store.ListBatchSpecWorkspaceExecutionJobsOpts{BatchSpecWorkspaceIDs: []int64{workspace.ID}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(jobs) != 0 {
+				t.Fatalf("unexpected execution jobs: %+v", jobs)
+			}
+		})
+
+		t.Run("success", func(t *testing.T) {
+			changeset, workspace := createWorkspace(t, "d34db33f")
+
+			if _, err := bp.Process(ctx, newRebaseJob(changeset.ID)); err != nil {
+				t.Fatal(err)
+			}
+
+			rebase, err := bstore.GetExecutingChangesetRebase(ctx, workspace.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rebase.ChangesetID != changeset.ID || rebase.BaseRev != "c0ffee" {
+				t.Fatalf("unexpected rebase: %+v", rebase)
+			}
+
+			workspace, err = bstore.GetBatchSpecWorkspace(ctx, store.GetBatchSpecWorkspaceOpts{ID: workspace.ID})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if workspace.Commit != "c0ffee" {
+				t.Fatalf("workspace has not been rebased: %s", workspace.Commit)
+			}
+
+			jobs, err := bstore.ListBatchSpecWorkspaceExecutionJobs(ctx, store.ListBatchSpecWorkspaceExecutionJobsOpts{BatchSpecWorkspaceIDs: []int64{workspace.ID}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(jobs) != 1 || jobs[0].State != btypes.BatchSpecWorkspaceExecutionJobStateQueued {
+				t.Fatalf("unexpected execution jobs: %+v", jobs)
+			}
+
+			// The workspace is now being executed, so it can't be rebased again.
+			_, err = bp.Process(ctx, newRebaseJob(changeset.ID))
+			if err == nil || !errcode.IsNonRetryable(err) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	})
 }
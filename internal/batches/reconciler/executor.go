@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"text/template"
@@ -39,6 +40,8 @@ func executePlan(ctx context.Context, logger log.Logger, client gitserver.Client
 		tx:                tx,
 		ch:                plan.Changeset,
 		spec:              plan.ChangesetSpec,
+		previousSpec:      plan.PreviousChangesetSpec,
+		delta:             plan.Delta,
 	}
 
 	return e.Run(ctx, plan)
@@ -52,6 +55,8 @@ type executor struct {
 	tx                *store.Store
 	ch                *btypes.Changeset
 	spec              *btypes.ChangesetSpec
+	previousSpec      *btypes.ChangesetSpec
+	delta             *ChangesetSpecDelta
 
 	// targetRepo represents the repo where the changeset should be opened.
 	targetRepo *types.Repo
@@ -270,6 +275,11 @@ func (e *executor) publishChangeset(ctx context.Context, asDraft bool) (afterDon
 		RemoteRepo: remoteRepo,
 		TargetRepo: e.targetRepo,
 		Changeset:  e.ch,
+
+		Labels:        e.spec.Labels,
+		Reviewers:     e.spec.Reviewers,
+		TeamReviewers: e.spec.TeamReviewers,
+		Assignees:     e.spec.Assignees,
 	}
 
 	var exists, outdated bool
@@ -316,6 +326,10 @@ func (e *executor) publishChangeset(ctx context.Context, asDraft bool) (afterDon
 		}
 	}
 
+	if err := e.updateChangesetMetadata(ctx, css, cs); err != nil {
+		return afterDoneUpdate, err
+	}
+
 	// Set the changeset to published.
 	e.ch.PublicationState = btypes.ChangesetPublicationStatePublished
 
@@ -407,21 +421,82 @@ func (e *executor) updateChangeset(ctx context.Context) (afterDone func(store *s
 		TargetRepo: e.targetRepo,
 		Changeset:  e.ch,
 	}
+	e.setMetadataChanges(&cs)
 
 	if err := css.UpdateChangeset(ctx, &cs); err != nil {
 		if errcode.IsArchived(err) {
 			if err := e.handleArchivedRepo(ctx); err != nil {
 				return afterDone, err
 			}
-		} else {
-			return afterDone, errors.Wrap(err, "updating changeset")
+			afterDone = func(store *store.Store) { e.enqueueWebhook(ctx, store, webhooks.ChangesetUpdate) }
+			return afterDone, nil
 		}
+		return afterDone, errors.Wrap(err, "updating changeset")
+	}
+
+	if err := e.updateChangesetMetadata(ctx, css, &cs); err != nil {
+		return afterDone, err
 	}
 
 	afterDone = func(store *store.Store) { e.enqueueWebhook(ctx, store, webhooks.ChangesetUpdate) }
 	return afterDone, nil
 }
 
+// setMetadataChanges sets the labels, reviewers, and assignees to add to and
+// remove from the changeset on update: only those that changed between the
+// previous and the current changeset spec, so that unchanged reviewers aren't
+// requested and notified again.
+func (e *executor) setMetadataChanges(cs *sources.Changeset) {
+	if e.delta == nil || e.previousSpec == nil {
+		return
+	}
+
+	if e.delta.LabelsChanged {
+		cs.Labels = difference(e.spec.Labels, e.previousSpec.Labels)
+		cs.RemovedLabels = difference(e.previousSpec.Labels, e.spec.Labels)
+	}
+	if e.delta.ReviewersChanged {
+		cs.Reviewers = difference(e.spec.Reviewers, e.previousSpec.Reviewers)
+		cs.RemovedReviewers = difference(e.previousSpec.Reviewers, e.spec.Reviewers)
+		cs.TeamReviewers = difference(e.spec.TeamReviewers, e.previousSpec.TeamReviewers)
+		cs.RemovedTeamReviewers = difference(e.previousSpec.TeamReviewers, e.spec.TeamReviewers)
+	}
+	if e.delta.AssigneesChanged {
+		cs.Assignees = difference(e.spec.Assignees, e.previousSpec.Assignees)
+		cs.RemovedAssignees = difference(e.previousSpec.Assignees, e.spec.Assignees)
+	}
+}
+
+// difference returns the elements of a that are not in b.
+func difference(a, b []string) []string {
+	var diff []string
+	for _, v := range a {
+		if !slices.Contains(b, v) {
+			diff = append(diff, v)
+		}
+	}
+	return diff
+}
+
+// updateChangesetMetadata adds and removes the labels, reviewers, and assignees
+// set on cs on the code host. Code hosts that don't support them are skipped.
+func (e *executor) updateChangesetMetadata(ctx context.Context, css sources.ChangesetSource, cs *sources.Changeset) error {
+	if !cs.HasMetadata() {
+		return nil
+	}
+
+	mcss, ok := css.(sources.MetadataChangesetSource)
+	if !ok {
+		e.logger.Warn("code host does not support changeset labels, reviewers, or assignees", log.Int64("changesetID", e.ch.ID))
+		return nil
+	}
+
+	if err := mcss.UpdateChangesetMetadata(ctx, cs); err != nil {
+		return errors.Wrap(err, "updating changeset labels, reviewers, and assignees")
+	}
+	return nil
+}
+
 // reopenChangeset reopens the given changeset attribute on the code host.
 func (e *executor) reopenChangeset(ctx context.Context) (afterDone func(store *store.Store), err error) {
 	afterDone = func(store *store.Store) { e.enqueueWebhook(ctx, store, webhooks.ChangesetUpdateError) }
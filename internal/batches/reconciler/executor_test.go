@@ -1254,6 +1254,51 @@ func TestDecorateChangesetBody(t *testing.T) {
 	}
 }
 
+func TestExecutor_SetMetadataChanges(t *testing.T) {
+	previous := &btypes.ChangesetSpec{
+		Labels:    []string{"a", "b"},
+		Reviewers: []string{"alice", "bob"},
+		Assignees: []string{"carol"},
+	}
+	current := &btypes.ChangesetSpec{
+		Labels:        []string{"b", "c"},
+		Reviewers:     []string{"alice", "bob"},
+		TeamReviewers: []string{"team"},
+		Assignees:     []string{"carol"},
+	}
+
+	t.Run("only changed metadata", func(t *testing.T) {
+		e := &executor{
+			spec:         current,
+			previousSpec: previous,
+			delta:        compareChangesetSpecs(previous, current, nil),
+		}
+		var cs sources.Changeset
+		e.setMetadataChanges(&cs)
+
+		assert.Equal(t, []string{"c"}, cs.Labels)
+		assert.Equal(t, []string{"a"}, cs.RemovedLabels)
+		assert.Empty(t, cs.Reviewers)
+		assert.Empty(t, cs.RemovedReviewers)
+		assert.Equal(t, []string{"team"}, cs.TeamReviewers)
+		assert.Empty(t, cs.RemovedTeamReviewers)
+		assert.Empty(t, cs.Assignees)
+		assert.Empty(t, cs.RemovedAssignees)
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		e := &executor{
+			spec:         current,
+			previousSpec: current,
+			delta:        compareChangesetSpecs(current, current, nil),
+		}
+		var cs sources.Changeset
+		e.setMetadataChanges(&cs)
+
+		assert.False(t, cs.HasMetadata())
+	})
+}
+
 func TestHandleArchivedRepo(t *testing.T) {
 	ctx := context.Background()
 
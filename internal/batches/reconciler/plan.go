@@ -3,6 +3,7 @@ package reconciler
 import (
 	"bytes"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -101,6 +102,9 @@ type Plan struct {
 	// The changeset spec that is used in this plan.
 	ChangesetSpec *btypes.ChangesetSpec
 
+	// The changeset spec the changeset was previously reconciled with, if any.
+	PreviousChangesetSpec *btypes.ChangesetSpec
+
 	// The operations that need to be done to reconcile the changeset.
 	Ops Operations
 
@@ -119,8 +123,9 @@ func (p *Plan) SetOp(op btypes.ReconcilerOperation) { p.Ops = Operations{op} }
 // error.
 func DeterminePlan(previousSpec, currentSpec *btypes.ChangesetSpec, currentChangeset, wantedChangeset *btypes.Changeset) (*Plan, error) {
 	pl := &Plan{
-		Changeset:     wantedChangeset,
-		ChangesetSpec: currentSpec,
+		Changeset:             wantedChangeset,
+		ChangesetSpec:         currentSpec,
+		PreviousChangesetSpec: previousSpec,
 	}
 
 	wantDetach := false
@@ -299,6 +304,15 @@ func compareChangesetSpecs(previous, current *btypes.ChangesetSpec, uiPublicatio
 	if previous.BaseRef != current.BaseRef {
 		delta.BaseRefChanged = true
 	}
+	if !slices.Equal(previous.Labels, current.Labels) {
+		delta.LabelsChanged = true
+	}
+	if !slices.Equal(previous.Reviewers, current.Reviewers) || !slices.Equal(previous.TeamReviewers, current.TeamReviewers) {
+		delta.ReviewersChanged = true
+	}
+	if !slices.Equal(previous.Assignees, current.Assignees) {
+		delta.AssigneesChanged = true
+	}
 
 	// If was set to "draft" and now "true", need to undraft the changeset.
 	// We currently ignore going from "true" to "draft".
@@ -348,6 +362,9 @@ type ChangesetSpecDelta struct {
 	CommitMessageChanged bool
 	AuthorNameChanged    bool
 	AuthorEmailChanged   bool
+	LabelsChanged        bool
+	ReviewersChanged     bool
+	AssigneesChanged     bool
 }
 
 func (d *ChangesetSpecDelta) String() string { return fmt.Sprintf("%#v", d) }
@@ -357,7 +374,12 @@ func (d *ChangesetSpecDelta) NeedCommitUpdate() bool {
 }
 
 func (d *ChangesetSpecDelta) NeedCodeHostUpdate() bool {
-	return d.TitleChanged || d.BodyChanged || d.BaseRefChanged
+	return d.TitleChanged || d.BodyChanged || d.BaseRefChanged || d.MetadataChanged()
+}
+
+// MetadataChanged returns true if the labels, reviewers, or assignees changed.
+func (d *ChangesetSpecDelta) MetadataChanged() bool {
+	return d.LabelsChanged || d.ReviewersChanged || d.AssigneesChanged
 }
 
 func (d *ChangesetSpecDelta) AttributesChanged() bool {
@@ -97,6 +97,20 @@ func (r *Reconciler) process(ctx context.Context, logger log.Logger, tx *store.S
 		return nil, err
 	}
 
+	// Changesets of batch changes that are rolled out in waves are only
+	// published once their wave has been reached. They are enqueued again when
+	// that happens.
+	if ch.Unpublished() && (plan.Ops.Contains(btypes.ReconcilerOperationPublish) || plan.Ops.Contains(btypes.ReconcilerOperationPublishDraft)) {
+		held, err := tx.IsChangesetHeldByRollout(ctx, ch.ID)
+		if err != nil {
+			return nil, err
+		}
+		if held {
+			logger.Info("Reconciler holding back changeset until its rollout wave is reached", log.Int64("changeset", ch.ID))
+			return nil, nil
+		}
+	}
+
 	logger.Info("Reconciler processing changeset", log.Int64("changeset", ch.ID), log.String("operations", fmt.Sprintf("%+v", plan.Ops)))
 
 	return executePlan(
@@ -0,0 +1,140 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+const rolloutAdvanceInterval = 1 * time.Minute
+
+// NewRolloutAdvancer creates a new goroutine.PeriodicGoroutine that advances
+// the rollouts of batch changes to their next wave once the gate of the
+// current wave is met, and enqueues the changesets of that wave.
+func NewRolloutAdvancer(ctx context.Context, s *store.Store) goroutine.BackgroundRoutine {
+	return goroutine.NewPeriodicGoroutine(
+		ctx,
+		goroutine.HandlerFunc(func(ctx context.Context) error {
+			return advanceRollouts(ctx, s)
+		}),
+		goroutine.WithName("batchchanges.rollout-advancer"),
+		goroutine.WithDescription("publishes the next wave of batch change rollouts"),
+		goroutine.WithInterval(rolloutAdvanceInterval),
+	)
+}
+
+func advanceRollouts(ctx context.Context, s *store.Store) error {
+	rollouts, err := s.ListBatchChangeRollouts(ctx, store.ListBatchChangeRolloutsOpts{OnlyAdvanceable: true})
+	if err != nil {
+		return errors.Wrap(err, "listing batch change rollouts")
+	}
+
+	var errs error
+	for _, r := range rollouts {
+		if err := advanceRollout(ctx, s, r.BatchChangeID); err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "advancing rollout of batch change %d", r.BatchChangeID))
+		}
+	}
+	return errs
+}
+
+func advanceRollout(ctx context.Context, s *store.Store, batchChangeID int64) (err error) {
+	tx, err := s.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	// Reload the rollout in the transaction, in case it was paused in the
+	// meantime.
+	r, err := tx.GetBatchChangeRollout(ctx, batchChangeID)
+	if err != nil {
+		return err
+	}
+	if r.Paused || r.Done() {
+		return nil
+	}
+
+	batchChange, err := tx.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: batchChangeID})
+	if err != nil {
+		return errors.Wrap(err, "getting batch change")
+	}
+
+	spec, err := tx.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: batchChange.BatchSpecID})
+	if err != nil {
+		return errors.Wrap(err, "getting batch spec")
+	}
+
+	var gate *batcheslib.RolloutGate
+	if spec.Spec.Rollout != nil {
+		gate = spec.Spec.Rollout.Gate
+	}
+
+	stats, err := tx.GetRolloutWaveStats(ctx, batchChangeID)
+	if err != nil {
+		return errors.Wrap(err, "getting rollout wave stats")
+	}
+
+	met, message := evaluateRolloutGate(gate, currentWaveStats(stats, r.CurrentWave))
+	if !met {
+		if pointers.DerefZero(r.GateMessage) == message {
+			return nil
+		}
+		r.GateMessage = &message
+		return tx.UpdateBatchChangeRollout(ctx, r)
+	}
+
+	r.CurrentWave++
+	r.GateMessage = nil
+	if err := tx.UpdateBatchChangeRollout(ctx, r); err != nil {
+		return err
+	}
+
+	return tx.EnqueueRolloutChangesets(ctx, batchChangeID, r.CurrentWave)
+}
+
+// currentWaveStats returns the stats of the given wave. Waves without any
+// changesets don't have stats, so empty stats are returned for them.
+func currentWaveStats(stats []btypes.RolloutWaveStats, wave int32) btypes.RolloutWaveStats {
+	for _, ws := range stats {
+		if ws.Wave == wave {
+			return ws
+		}
+	}
+	return btypes.RolloutWaveStats{Wave: wave}
+}
+
+// evaluateRolloutGate returns true if the changesets of a wave with the given
+// stats meet the gate, so that the next wave can be published. If they don't,
+// a message describing what the rollout is waiting for is returned.
+func evaluateRolloutGate(gate *batcheslib.RolloutGate, stats btypes.RolloutWaveStats) (bool, string) {
+	wave := stats.Wave + 1
+
+	if stats.Processing > 0 {
+		return false, fmt.Sprintf("Waiting for %d changesets of wave %d to be processed.", stats.Processing, wave)
+	}
+
+	if gate == nil {
+		return true, ""
+	}
+
+	if gate.MergedPercentage > 0 && stats.Merged*100 < int32(gate.MergedPercentage)*stats.Published {
+		return false, fmt.Sprintf(
+			"Waiting for %d%% of the published changesets of wave %d to be merged, %d of %d are merged.",
+			gate.MergedPercentage, wave, stats.Merged, stats.Published,
+		)
+	}
+
+	if gate.NoFailingChecks && stats.FailingChecks > 0 {
+		return false, fmt.Sprintf("%d changesets of wave %d have failing checks.", stats.FailingChecks, wave)
+	}
+
+	return true, ""
+}
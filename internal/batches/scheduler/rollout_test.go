@@ -0,0 +1,78 @@
+package scheduler
+
+import (
+	"testing"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+)
+
+func TestEvaluateRolloutGate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		gate        *batcheslib.RolloutGate
+		stats       btypes.RolloutWaveStats
+		wantMet     bool
+		wantMessage string
+	}{
+		"no gate": {
+			stats:   btypes.RolloutWaveStats{Total: 2, Published: 2},
+			wantMet: true,
+		},
+		"processing": {
+			stats:       btypes.RolloutWaveStats{Wave: 1, Total: 2, Published: 1, Processing: 1},
+			wantMet:     false,
+			wantMessage: "Waiting for 1 changesets of wave 2 to be processed.",
+		},
+		"merged percentage not met": {
+			gate:        &batcheslib.RolloutGate{MergedPercentage: 50},
+			stats:       btypes.RolloutWaveStats{Total: 4, Published: 4, Merged: 1},
+			wantMet:     false,
+			wantMessage: "Waiting for 50% of the published changesets of wave 1 to be merged, 1 of 4 are merged.",
+		},
+		"merged percentage met": {
+			gate:    &batcheslib.RolloutGate{MergedPercentage: 50},
+			stats:   btypes.RolloutWaveStats{Total: 4, Published: 4, Merged: 2},
+			wantMet: true,
+		},
+		"merged percentage without published changesets": {
+			gate:    &batcheslib.RolloutGate{MergedPercentage: 100},
+			stats:   btypes.RolloutWaveStats{Total: 1},
+			wantMet: true,
+		},
+		"failing checks": {
+			gate:        &batcheslib.RolloutGate{NoFailingChecks: true},
+			stats:       btypes.RolloutWaveStats{Total: 3, Published: 3, FailingChecks: 2},
+			wantMet:     false,
+			wantMessage: "2 changesets of wave 1 have failing checks.",
+		},
+		"failing checks allowed": {
+			gate:    &batcheslib.RolloutGate{MergedPercentage: 10},
+			stats:   btypes.RolloutWaveStats{Total: 3, Published: 3, Merged: 1, FailingChecks: 2},
+			wantMet: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			met, message := evaluateRolloutGate(tc.gate, tc.stats)
+			if met != tc.wantMet {
+				t.Errorf("unexpected result: want=%t have=%t", tc.wantMet, met)
+			}
+			if message != tc.wantMessage {
+				t.Errorf("unexpected message: want=%q have=%q", tc.wantMessage, message)
+			}
+		})
+	}
+}
+
+func TestCurrentWaveStats(t *testing.T) {
+	stats := []btypes.RolloutWaveStats{
+		{Wave: 0, Total: 2},
+		{Wave: 2, Total: 3},
+	}
+
+	if have := currentWaveStats(stats, 2); have.Total != 3 {
+		t.Errorf("unexpected stats: %+v", have)
+	}
+	if have := currentWaveStats(stats, 1); have != (btypes.RolloutWaveStats{Wave: 1}) {
+		t.Errorf("unexpected stats for empty wave: %+v", have)
+	}
+}
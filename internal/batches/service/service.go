@@ -102,6 +102,8 @@ type operations struct {
 	applyBatchChange                     *observation.Operation
 	reconcileBatchChange                 *observation.Operation
 	validateChangesetSpecs               *observation.Operation
+	pauseBatchChangeRollout              *observation.Operation
+	resumeBatchChangeRollout             *observation.Operation
 }
 
 var (
@@ -152,6 +154,8 @@ func newOperations(observationCtx *observation.Context) *operations {
 			applyBatchChange:                     op("ApplyBatchChange"),
 			reconcileBatchChange:                 op("ReconcileBatchChange"),
 			validateChangesetSpecs:               op("ValidateChangesetSpecs"),
+			pauseBatchChangeRollout:              op("PauseBatchChangeRollout"),
+			resumeBatchChangeRollout:             op("ResumeBatchChangeRollout"),
 		}
 	})
 
@@ -1710,6 +1714,7 @@ func (s *Service) GetAvailableBulkOperations(ctx context.Context, opts GetAvaila
 		btypes.ChangesetJobTypePublish:   0,
 		btypes.ChangesetJobTypeReenqueue: 0,
 		btypes.ChangesetJobTypeExport:    0,
+		btypes.ChangesetJobTypeRebase:    0,
 	}
 
 	changesets, _, err := s.store.ListChangesets(ctx, store.ListChangesetsOpts{
@@ -1774,6 +1779,11 @@ func (s *Service) GetAvailableBulkOperations(ctx context.Context, opts GetAvaila
 		if isChangesetCommentable {
 			bulkOperationsCounter[btypes.ChangesetJobTypeComment] += 1
 		}
+
+		// REBASE
+		if !isChangesetArchived && !changeset.IsImported() && isChangesetClosable {
+			bulkOperationsCounter[btypes.ChangesetJobTypeRebase] += 1
+		}
 	}
 
 	noOfChangesets := len(opts.Changesets)
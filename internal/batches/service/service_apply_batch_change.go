@@ -98,6 +98,13 @@ func (s *Service) ApplyBatchChange(
 		return batchChange, nil
 	}
 
+	// Resolving the repositories of rollout waves requires running searches,
+	// so we do that before opening the transaction.
+	rolloutRepos, err := s.resolveRolloutWaveRepos(ctx, batchSpec.Spec)
+	if err != nil {
+		return nil, err
+	}
+
 	// Before we write to the database in a transaction, we cancel all
 	// currently enqueued/errored-and-retryable changesets the batch change might
 	// have.
@@ -193,6 +200,12 @@ func (s *Service) ApplyBatchChange(
 		}
 	}
 
+	// The changesets are assigned to the rollout waves in the same transaction,
+	// so that the reconciler doesn't publish changesets of later waves.
+	if err := applyBatchChangeRollout(ctx, tx, batchChange.ID, batchSpec.Spec.Rollout, rolloutRepos); err != nil {
+		return nil, err
+	}
+
 	return batchChange, nil
 }
 
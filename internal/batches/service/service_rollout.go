@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ErrNoBatchChangeRollout is returned when a rollout is paused or resumed for
+// a batch change that isn't rolled out in waves.
+var ErrNoBatchChangeRollout = errors.New("batch change is not rolled out in waves")
+
+// PauseBatchChangeRollout pauses the rollout of the given batch change. No
+// further changesets are published until the rollout is resumed.
+func (s *Service) PauseBatchChangeRollout(ctx context.Context, batchChangeID int64) (batchChange *btypes.BatchChange, err error) {
+	ctx, _, endObservation := s.operations.pauseBatchChangeRollout.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	return s.setBatchChangeRolloutPaused(ctx, batchChangeID, true)
+}
+
+// ResumeBatchChangeRollout resumes the paused rollout of the given batch
+// change and enqueues the changesets of the current wave that were held back
+// while it was paused.
+func (s *Service) ResumeBatchChangeRollout(ctx context.Context, batchChangeID int64) (batchChange *btypes.BatchChange, err error) {
+	ctx, _, endObservation := s.operations.resumeBatchChangeRollout.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	return s.setBatchChangeRolloutPaused(ctx, batchChangeID, false)
+}
+
+func (s *Service) setBatchChangeRolloutPaused(ctx context.Context, batchChangeID int64, paused bool) (batchChange *btypes.BatchChange, err error) {
+	batchChange, err = s.store.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: batchChangeID})
+	if err != nil {
+		return nil, errors.Wrap(err, "getting batch change")
+	}
+
+	// 🚨 SECURITY: Only the author of the batch change or site admins can
+	// change its rollout.
+	if err := s.checkViewerCanAdminister(ctx, batchChange.NamespaceOrgID, batchChange.CreatorID, false); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.store.Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	rollout, err := tx.GetBatchChangeRollout(ctx, batchChange.ID)
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil, ErrNoBatchChangeRollout
+		}
+		return nil, err
+	}
+
+	if rollout.Paused == paused {
+		return batchChange, nil
+	}
+
+	rollout.Paused = paused
+	if err := tx.UpdateBatchChangeRollout(ctx, rollout); err != nil {
+		return nil, err
+	}
+
+	if !paused {
+		if err := tx.EnqueueRolloutChangesets(ctx, batchChange.ID, rollout.CurrentWave); err != nil {
+			return nil, err
+		}
+	}
+
+	return batchChange, nil
+}
+
+// rolloutWaveRepos maps the index of a rollout wave that selects changesets by
+// search query to the set of repositories matching the query.
+type rolloutWaveRepos map[int]map[api.RepoID]struct{}
+
+// resolveRolloutWaveRepos runs the search queries of the rollout waves of the
+// given batch spec. It needs to be called with the actor applying the batch
+// spec, so that only repositories the user can access are matched.
+func (s *Service) resolveRolloutWaveRepos(ctx context.Context, spec *batcheslib.BatchSpec) (rolloutWaveRepos, error) {
+	repos := rolloutWaveRepos{}
+	if spec.Rollout == nil {
+		return repos, nil
+	}
+
+	wr := newWorkspaceResolver(s.store)
+	for i, wave := range spec.Rollout.Waves {
+		if wave.RepositoriesMatchingQuery == "" {
+			continue
+		}
+
+		ids, err := wr.resolveRepositoryIDsMatchingQuery(ctx, wave.RepositoriesMatchingQuery, spec.Version)
+		if err != nil {
+			return nil, errors.Wrapf(err, "resolving repositories of rollout wave %d", i+1)
+		}
+
+		set := make(map[api.RepoID]struct{}, len(ids))
+		for _, id := range ids {
+			set[id] = struct{}{}
+		}
+		repos[i] = set
+	}
+
+	return repos, nil
+}
+
+// applyBatchChangeRollout creates or updates the rollout of the given batch
+// change and assigns its changesets to the waves of the policy. If policy is
+// nil, an existing rollout is removed.
+func applyBatchChangeRollout(ctx context.Context, tx *store.Store, batchChangeID int64, policy *batcheslib.RolloutPolicy, repos rolloutWaveRepos) error {
+	if policy == nil {
+		return tx.DeleteBatchChangeRollout(ctx, batchChangeID)
+	}
+
+	changesets, _, err := tx.ListChangesets(ctx, store.ListChangesetsOpts{
+		BatchChangeID:        batchChangeID,
+		OwnedByBatchChangeID: batchChangeID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "listing changesets")
+	}
+
+	waves, waveCount := assignRolloutWaves(policy, changesets, repos)
+
+	if err := tx.UpsertBatchChangeRollout(ctx, &btypes.BatchChangeRollout{
+		BatchChangeID: batchChangeID,
+		WaveCount:     waveCount,
+	}); err != nil {
+		return err
+	}
+
+	return tx.SetChangesetRolloutWaves(ctx, batchChangeID, waves)
+}
+
+// assignRolloutWaves assigns the given changesets to the zero-based waves of
+// the rollout policy, in the order of their IDs. Each changeset is assigned to
+// the first wave that selects it. Changesets not selected by any wave are
+// assigned to an additional final wave. It returns the assignments and the
+// number of waves.
+func assignRolloutWaves(policy *batcheslib.RolloutPolicy, changesets []*btypes.Changeset, repos rolloutWaveRepos) (map[int64]int32, int32) {
+	remaining := make([]*btypes.Changeset, len(changesets))
+	copy(remaining, changesets)
+	sort.Slice(remaining, func(i, j int) bool { return remaining[i].ID < remaining[j].ID })
+
+	total := len(remaining)
+	waves := make(map[int64]int32, total)
+
+	for i, wave := range policy.Waves {
+		var n int
+		switch {
+		case wave.RepositoriesMatchingQuery != "":
+			var rest []*btypes.Changeset
+			for _, c := range remaining {
+				if _, ok := repos[i][c.RepoID]; ok {
+					waves[c.ID] = int32(i)
+				} else {
+					rest = append(rest, c)
+				}
+			}
+			remaining = rest
+			continue
+
+		case wave.Count > 0:
+			n = wave.Count
+
+		case wave.Percentage > 0:
+			// Round up, so that small batch changes still publish a changeset
+			// in every wave.
+			n = (total*wave.Percentage + 99) / 100
+		}
+
+		if n > len(remaining) {
+			n = len(remaining)
+		}
+		for _, c := range remaining[:n] {
+			waves[c.ID] = int32(i)
+		}
+		remaining = remaining[n:]
+	}
+
+	waveCount := int32(len(policy.Waves))
+	if len(remaining) > 0 {
+		for _, c := range remaining {
+			waves[c.ID] = waveCount
+		}
+		waveCount++
+	}
+
+	return waves, waveCount
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+)
+
+func TestAssignRolloutWaves(t *testing.T) {
+	// Changesets are passed out of order to make sure they are assigned in the
+	// order of their IDs.
+	changesets := []*btypes.Changeset{
+		{ID: 5, RepoID: 1},
+		{ID: 2, RepoID: 2},
+		{ID: 4, RepoID: 3},
+		{ID: 1, RepoID: 4},
+		{ID: 3, RepoID: 5},
+		{ID: 6, RepoID: 6},
+	}
+
+	for _, tc := range []struct {
+		name          string
+		policy        *batcheslib.RolloutPolicy
+		repos         rolloutWaveRepos
+		wantWaves     map[int64]int32
+		wantWaveCount int32
+	}{
+		{
+			name: "count and percentage",
+			policy: &batcheslib.RolloutPolicy{Waves: []batcheslib.RolloutWave{
+				{Count: 1},
+				{Percentage: 50},
+			}},
+			wantWaves:     map[int64]int32{1: 0, 2: 1, 3: 1, 4: 1, 5: 2, 6: 2},
+			wantWaveCount: 3,
+		},
+		{
+			name: "percentage rounds up",
+			policy: &batcheslib.RolloutPolicy{Waves: []batcheslib.RolloutWave{
+				{Percentage: 10},
+				{Percentage: 100},
+			}},
+			wantWaves:     map[int64]int32{1: 0, 2: 1, 3: 1, 4: 1, 5: 1, 6: 1},
+			wantWaveCount: 2,
+		},
+		{
+			name: "repositories matching query",
+			policy: &batcheslib.RolloutPolicy{Waves: []batcheslib.RolloutWave{
+				{RepositoriesMatchingQuery: "repo:canary"},
+				{Count: 2},
+			}},
+			repos: rolloutWaveRepos{
+				0: {api.RepoID(1): {}, api.RepoID(6): {}},
+			},
+			wantWaves:     map[int64]int32{5: 0, 6: 0, 1: 1, 2: 1, 3: 2, 4: 2},
+			wantWaveCount: 3,
+		},
+		{
+			name: "count larger than changesets",
+			policy: &batcheslib.RolloutPolicy{Waves: []batcheslib.RolloutWave{
+				{Count: 4},
+				{Count: 4},
+				{Count: 4},
+			}},
+			wantWaves:     map[int64]int32{1: 0, 2: 0, 3: 0, 4: 0, 5: 1, 6: 1},
+			wantWaveCount: 3,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			haveWaves, haveWaveCount := assignRolloutWaves(tc.policy, changesets, tc.repos)
+			if diff := cmp.Diff(tc.wantWaves, haveWaves); diff != "" {
+				t.Errorf("unexpected waves (-want +have):\n%s", diff)
+			}
+			if haveWaveCount != tc.wantWaveCount {
+				t.Errorf("unexpected wave count: want=%d have=%d", tc.wantWaveCount, haveWaveCount)
+			}
+		})
+	}
+
+	// The input slice must not be reordered.
+	if changesets[0].ID != 5 {
+		t.Errorf("changesets were reordered")
+	}
+}
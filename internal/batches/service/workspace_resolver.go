@@ -74,6 +74,10 @@ type WorkspaceResolver interface {
 type WorkspaceResolverBuilder func(tx *store.Store) WorkspaceResolver
 
 func NewWorkspaceResolver(s *store.Store) WorkspaceResolver {
+	return newWorkspaceResolver(s)
+}
+
+func newWorkspaceResolver(s *store.Store) *workspaceResolver {
 	return &workspaceResolver{
 		store:               s,
 		logger:              log.Scoped("batches.workspaceResolver"),
@@ -386,6 +390,51 @@ func (wr *workspaceResolver) resolveRepositoriesMatchingQuery(ctx context.Contex
 	return revs, nil
 }
 
+// resolveRepositoryIDsMatchingQuery returns the IDs of the repositories
+// matching the given search query that the user can access. Unlike
+// resolveRepositoriesMatchingQuery it doesn't resolve any revisions.
+func (wr *workspaceResolver) resolveRepositoryIDsMatchingQuery(ctx context.Context, query string, batchSpecVersion int) (_ []api.RepoID, err error) {
+	tr, ctx := trace.New(ctx, "workspaceResolver.resolveRepositoryIDsMatchingQuery")
+	defer tr.EndWithErr(&err)
+
+	query = setDefaultQueryCount(query)
+
+	repoIDs := []api.RepoID{}
+	if err := wr.runSearch(ctx, query, batchSpecVersion, func(matches []streamhttp.EventMatch) {
+		for _, match := range matches {
+			switch m := match.(type) {
+			case *streamhttp.EventRepoMatch:
+				repoIDs = append(repoIDs, api.RepoID(m.RepositoryID))
+			case *streamhttp.EventContentMatch:
+				repoIDs = append(repoIDs, api.RepoID(m.RepositoryID))
+			case *streamhttp.EventPathMatch:
+				repoIDs = append(repoIDs, api.RepoID(m.RepositoryID))
+			case *streamhttp.EventSymbolMatch:
+				repoIDs = append(repoIDs, api.RepoID(m.RepositoryID))
+			}
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	if len(repoIDs) == 0 {
+		return repoIDs, nil
+	}
+
+	// 🚨 SECURITY: We use database.Repos.List to check whether the user has access to
+	// the repositories or not.
+	accessibleRepos, err := wr.store.Repos().List(ctx, database.ReposListOptions{IDs: repoIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]api.RepoID, 0, len(accessibleRepos))
+	for _, repo := range accessibleRepos {
+		ids = append(ids, repo.ID)
+	}
+	return ids, nil
+}
+
 const internalSearchClientUserAgent = "Batch Changes repository resolver"
 
 func determineDefaultPatternType(batchSpecVersion int) searchquery.SearchType {
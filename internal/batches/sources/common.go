@@ -10,6 +10,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
 )
 
 // ChangesetNotFoundError is returned by LoadChangeset if the changeset
@@ -60,6 +61,29 @@ type ForkableChangesetSource interface {
 	GetFork(ctx context.Context, targetRepo *types.Repo, namespace, name *string) (*types.Repo, error)
 }
 
+// A MetadataChangesetSource can apply labels, reviewers, and assignees to a
+// changeset on the code host.
+type MetadataChangesetSource interface {
+	ChangesetSource
+
+	// UpdateChangesetMetadata adds the labels, reviewers, and assignees set on
+	// the Changeset to the changeset on the code host, and removes the removed
+	// ones. Other metadata on the code host is left untouched, so manual edits
+	// are kept.
+	UpdateChangesetMetadata(context.Context, *Changeset) error
+}
+
+// An AutoMergeChangesetSource can enable auto-merge for a changeset on the
+// code host, so that the code host merges it once its checks have passed.
+type AutoMergeChangesetSource interface {
+	ChangesetSource
+
+	// EnableChangesetAutoMerge enables auto-merge for the given Changeset
+	// using the given merge method. If the merge method is not supported by
+	// the code host, an error is returned.
+	EnableChangesetAutoMerge(context.Context, *Changeset, batcheslib.AutoMergeMethod) error
+}
+
 // A ChangesetSource can load the latest state of a list of Changesets.
 type ChangesetSource interface {
 	// GitserverPushConfig returns an authenticated push config used for pushing
@@ -133,9 +157,29 @@ type Changeset struct {
 	// opened.
 	TargetRepo *types.Repo
 
+	// Labels, Reviewers, TeamReviewers, and Assignees are added, and their
+	// Removed counterparts removed, by sources that implement
+	// MetadataChangesetSource.
+	Labels        []string
+	Reviewers     []string
+	TeamReviewers []string
+	Assignees     []string
+
+	RemovedLabels        []string
+	RemovedReviewers     []string
+	RemovedTeamReviewers []string
+	RemovedAssignees     []string
+
 	*btypes.Changeset
 }
 
+// HasMetadata returns true if the Changeset has any labels, reviewers, or
+// assignees to add or remove on the code host.
+func (c *Changeset) HasMetadata() bool {
+	return len(c.Labels) > 0 || len(c.Reviewers) > 0 || len(c.TeamReviewers) > 0 || len(c.Assignees) > 0 ||
+		len(c.RemovedLabels) > 0 || len(c.RemovedReviewers) > 0 || len(c.RemovedTeamReviewers) > 0 || len(c.RemovedAssignees) > 0
+}
+
 // IsOutdated returns true when the attributes of the nested
 // batches.Changeset do not match the attributes (title, body, ...) set on
 // the Changeset.
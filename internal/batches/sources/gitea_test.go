@@ -0,0 +1,571 @@
+package sources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	gtcs "github.com/sourcegraph/sourcegraph/internal/batches/sources/gitea"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func TestNewGiteaSource(t *testing.T) {
+	t.Run("invalid", func(t *testing.T) {
+		for name, input := range map[string]string{
+			"invalid JSON":   "invalid JSON",
+			"invalid schema": `{"token": ["not a string"]}`,
+			"bad URL":        `{"url": "http://[::1]:namedport"}`,
+		} {
+			t.Run(name, func(t *testing.T) {
+				ctx := context.Background()
+				s, err := NewGiteaSource(ctx, &types.ExternalService{
+					Config: extsvc.NewUnencryptedConfig(input),
+				}, nil)
+				assert.Nil(t, s)
+				assert.NotNil(t, err)
+			})
+		}
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		ctx := context.Background()
+		s, err := NewGiteaSource(ctx, &types.ExternalService{
+			Config: extsvc.NewUnencryptedConfig(`{"url": "https://gitea.example.com", "token": "secret"}`),
+		}, nil)
+		assert.NotNil(t, s)
+		assert.Nil(t, err)
+	})
+}
+
+func TestGiteaSource_GitserverPushConfig(t *testing.T) {
+	au := auth.OAuthBearerToken{Token: "token"}
+	s, client := mockGiteaSource()
+	client.AuthenticatorFunc.SetDefaultReturn(&au)
+
+	repo := &types.Repo{
+		ExternalRepo: api.ExternalRepoSpec{
+			ServiceType: extsvc.TypeGitea,
+		},
+		Metadata: &gitea.Repository{
+			CloneURL: "https://gitea.example.com/org/repo.git",
+		},
+		Sources: map[string]*types.SourceInfo{
+			"1": {
+				ID:       "extsvc:gitea:1",
+				CloneURL: "https://gitea.example.com/org/repo.git",
+			},
+		},
+	}
+
+	pushConfig, err := s.GitserverPushConfig(context.Background(), repo)
+	require.NoError(t, err)
+	assert.Equal(t, "https://token@gitea.example.com/org/repo.git", pushConfig.RemoteURL)
+}
+
+func TestGiteaSource_WithAuthenticator(t *testing.T) {
+	t.Run("unsupported types", func(t *testing.T) {
+		s, _ := mockGiteaSource()
+
+		for _, au := range []auth.Authenticator{
+			&auth.BasicAuth{},
+			&auth.BasicAuthWithSSH{},
+			&auth.OAuthClient{},
+		} {
+			t.Run(fmt.Sprintf("%T", au), func(t *testing.T) {
+				newSource, err := s.WithAuthenticator(au)
+				assert.Nil(t, newSource)
+				assert.ErrorAs(t, err, &UnsupportedAuthenticatorError{})
+			})
+		}
+	})
+
+	t.Run("supported types", func(t *testing.T) {
+		for _, au := range []auth.Authenticator{
+			&auth.OAuthBearerToken{},
+			&auth.OAuthBearerTokenWithSSH{},
+		} {
+			t.Run(fmt.Sprintf("%T", au), func(t *testing.T) {
+				newClient := NewStrictMockGiteaClient()
+
+				s, client := mockGiteaSource()
+				client.WithAuthenticatorFunc.SetDefaultHook(func(a auth.Authenticator) (gitea.Client, error) {
+					assert.Same(t, au, a)
+					return newClient, nil
+				})
+
+				newSource, err := s.WithAuthenticator(au)
+				require.NoError(t, err)
+				assert.Same(t, newClient, newSource.(*GiteaSource).client)
+			})
+		}
+	})
+}
+
+func TestGiteaSource_LoadChangeset(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("invalid external ID", func(t *testing.T) {
+		s, _ := mockGiteaSource()
+
+		cs, _ := mockGiteaChangeset()
+		cs.ExternalID = "not a number"
+
+		assert.NotNil(t, s.LoadChangeset(ctx, cs))
+	})
+
+	t.Run("pull request not found", func(t *testing.T) {
+		cs, _ := mockGiteaChangeset()
+		s, client := mockGiteaSource()
+		client.GetPullRequestFunc.SetDefaultReturn(nil, &notFoundError{})
+
+		err := s.LoadChangeset(ctx, cs)
+		target := ChangesetNotFoundError{}
+		assert.ErrorAs(t, err, &target)
+		assert.Same(t, target.Changeset, cs)
+	})
+
+	t.Run("error annotating pull request", func(t *testing.T) {
+		cs, repo := mockGiteaChangeset()
+		s, client := mockGiteaSource()
+		client.GetPullRequestFunc.SetDefaultReturn(mockGiteaPullRequest(repo), nil)
+		want := errors.New("error")
+		client.ListPullRequestReviewsFunc.SetDefaultReturn(nil, want)
+
+		assert.ErrorIs(t, s.LoadChangeset(ctx, cs), want)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		cs, repo := mockGiteaChangeset()
+		s, client := mockGiteaSource()
+		mockGiteaAnnotatePullRequestSuccess(client)
+
+		pr := mockGiteaPullRequest(repo)
+		client.GetPullRequestFunc.SetDefaultHook(func(_ context.Context, owner, name string, index int64) (*gitea.PullRequest, error) {
+			assert.Equal(t, "org", owner)
+			assert.Equal(t, "repo", name)
+			assert.EqualValues(t, 42, index)
+			return pr, nil
+		})
+
+		require.NoError(t, s.LoadChangeset(ctx, cs))
+		assertGiteaChangesetMatchesPullRequest(t, cs, pr)
+	})
+}
+
+func TestGiteaSource_CreateChangeset(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("error creating pull request", func(t *testing.T) {
+		cs, _ := mockGiteaChangeset()
+		s, client := mockGiteaSource()
+		want := errors.New("error")
+		client.CreatePullRequestFunc.SetDefaultReturn(nil, want)
+
+		exists, err := s.CreateChangeset(ctx, cs)
+		assert.False(t, exists)
+		assert.ErrorIs(t, err, want)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		cs, repo := mockGiteaChangeset()
+		s, client := mockGiteaSource()
+		mockGiteaAnnotatePullRequestSuccess(client)
+
+		pr := mockGiteaPullRequest(repo)
+		client.CreatePullRequestFunc.SetDefaultHook(func(_ context.Context, owner, name string, input gitea.CreatePullRequestInput) (*gitea.PullRequest, error) {
+			assert.Equal(t, "org", owner)
+			assert.Equal(t, "repo", name)
+			assert.Equal(t, gitea.CreatePullRequestInput{
+				Head:  "branch",
+				Base:  "main",
+				Title: cs.Title,
+				Body:  cs.Body,
+			}, input)
+			return pr, nil
+		})
+
+		exists, err := s.CreateChangeset(ctx, cs)
+		require.NoError(t, err)
+		assert.False(t, exists)
+		assertGiteaChangesetMatchesPullRequest(t, cs, pr)
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		cs, repo := mockGiteaChangeset()
+		s, client := mockGiteaSource()
+		mockGiteaAnnotatePullRequestSuccess(client)
+
+		pr := mockGiteaPullRequest(repo)
+		client.CreatePullRequestFunc.SetDefaultReturn(nil, giteaHTTPError(t, http.StatusConflict))
+		client.GetPullRequestByBaseHeadFunc.SetDefaultHook(func(_ context.Context, owner, name, base, head string) (*gitea.PullRequest, error) {
+			assert.Equal(t, "main", base)
+			assert.Equal(t, "branch", head)
+			return pr, nil
+		})
+
+		exists, err := s.CreateChangeset(ctx, cs)
+		require.NoError(t, err)
+		assert.True(t, exists)
+		assertGiteaChangesetMatchesPullRequest(t, cs, pr)
+	})
+
+	t.Run("success with fork", func(t *testing.T) {
+		cs, repo := mockGiteaChangeset()
+		s, client := mockGiteaSource()
+		mockGiteaAnnotatePullRequestSuccess(client)
+
+		fork := &gitea.Repository{ID: 2, Owner: &gitea.User{Login: "fork"}, Name: "repo", FullName: "fork/repo"}
+		cs.RemoteRepo = &types.Repo{Metadata: fork}
+
+		pr := mockGiteaPullRequest(repo)
+		pr.Head.Repo = fork
+		client.CreatePullRequestFunc.SetDefaultHook(func(_ context.Context, owner, name string, input gitea.CreatePullRequestInput) (*gitea.PullRequest, error) {
+			assert.Equal(t, "org", owner)
+			assert.Equal(t, "fork:branch", input.Head)
+			return pr, nil
+		})
+
+		_, err := s.CreateChangeset(ctx, cs)
+		require.NoError(t, err)
+		assertGiteaChangesetMatchesPullRequest(t, cs, pr)
+	})
+}
+
+func TestGiteaSource_CreateDraftChangeset(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("new pull request", func(t *testing.T) {
+		cs, repo := mockGiteaChangeset()
+		s, client := mockGiteaSource()
+		mockGiteaAnnotatePullRequestSuccess(client)
+
+		pr := mockGiteaPullRequest(repo)
+		pr.Title = "WIP: title"
+		client.CreatePullRequestFunc.SetDefaultHook(func(_ context.Context, _, _ string, input gitea.CreatePullRequestInput) (*gitea.PullRequest, error) {
+			assert.Equal(t, "WIP: title", input.Title)
+			return pr, nil
+		})
+
+		exists, err := s.CreateDraftChangeset(ctx, cs)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("existing pull request that is not a draft", func(t *testing.T) {
+		cs, repo := mockGiteaChangeset()
+		s, client := mockGiteaSource()
+		mockGiteaAnnotatePullRequestSuccess(client)
+
+		pr := mockGiteaPullRequest(repo)
+		client.CreatePullRequestFunc.SetDefaultReturn(nil, giteaHTTPError(t, http.StatusConflict))
+		client.GetPullRequestByBaseHeadFunc.SetDefaultReturn(pr, nil)
+		client.EditPullRequestFunc.SetDefaultHook(func(_ context.Context, _, _ string, index int64, input gitea.EditPullRequestInput) (*gitea.PullRequest, error) {
+			assert.EqualValues(t, 42, index)
+			assert.Equal(t, "WIP: title", input.Title)
+			return pr, nil
+		})
+
+		exists, err := s.CreateDraftChangeset(ctx, cs)
+		require.NoError(t, err)
+		assert.True(t, exists)
+		assert.Len(t, client.EditPullRequestFunc.History(), 1)
+	})
+}
+
+func TestGiteaSource_UndraftChangeset(t *testing.T) {
+	cs, repo := mockGiteaChangeset()
+	cs.Title = "WIP: title"
+	cs.Metadata.(*gtcs.AnnotatedPullRequest).Title = "WIP: title"
+	s, client := mockGiteaSource()
+	mockGiteaAnnotatePullRequestSuccess(client)
+
+	pr := mockGiteaPullRequest(repo)
+	client.EditPullRequestFunc.SetDefaultHook(func(_ context.Context, _, _ string, _ int64, input gitea.EditPullRequestInput) (*gitea.PullRequest, error) {
+		assert.Equal(t, "title", input.Title)
+		return pr, nil
+	})
+
+	require.NoError(t, s.UndraftChangeset(context.Background(), cs))
+	assert.Equal(t, "title", cs.Title)
+}
+
+func TestGiteaSource_UpdateChangeset(t *testing.T) {
+	for name, tc := range map[string]struct {
+		currentTitle string
+		wantTitle    string
+	}{
+		"open":  {currentTitle: "old title", wantTitle: "title"},
+		"draft": {currentTitle: "WIP: old title", wantTitle: "WIP: title"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cs, repo := mockGiteaChangeset()
+			cs.Metadata.(*gtcs.AnnotatedPullRequest).Title = tc.currentTitle
+			s, client := mockGiteaSource()
+			mockGiteaAnnotatePullRequestSuccess(client)
+
+			pr := mockGiteaPullRequest(repo)
+			client.EditPullRequestFunc.SetDefaultHook(func(_ context.Context, _, _ string, _ int64, input gitea.EditPullRequestInput) (*gitea.PullRequest, error) {
+				assert.Equal(t, gitea.EditPullRequestInput{
+					Title: tc.wantTitle,
+					Body:  cs.Body,
+					Base:  "main",
+				}, input)
+				return pr, nil
+			})
+
+			require.NoError(t, s.UpdateChangeset(context.Background(), cs))
+		})
+	}
+}
+
+func TestGiteaSource_CloseAndReopenChangeset(t *testing.T) {
+	ctx := context.Background()
+
+	for name, tc := range map[string]struct {
+		fn        func(GiteaSource, *Changeset) error
+		wantState string
+	}{
+		"close":  {fn: func(s GiteaSource, cs *Changeset) error { return s.CloseChangeset(ctx, cs) }, wantState: gitea.PullRequestStateClosed},
+		"reopen": {fn: func(s GiteaSource, cs *Changeset) error { return s.ReopenChangeset(ctx, cs) }, wantState: gitea.PullRequestStateOpen},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cs, repo := mockGiteaChangeset()
+			s, client := mockGiteaSource()
+			mockGiteaAnnotatePullRequestSuccess(client)
+
+			pr := mockGiteaPullRequest(repo)
+			client.EditPullRequestFunc.SetDefaultHook(func(_ context.Context, _, _ string, _ int64, input gitea.EditPullRequestInput) (*gitea.PullRequest, error) {
+				assert.Equal(t, gitea.EditPullRequestInput{State: tc.wantState}, input)
+				return pr, nil
+			})
+
+			require.NoError(t, tc.fn(*s, cs))
+		})
+	}
+}
+
+func TestGiteaSource_MergeChangeset(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not mergeable", func(t *testing.T) {
+		cs, _ := mockGiteaChangeset()
+		s, client := mockGiteaSource()
+		client.MergePullRequestFunc.SetDefaultReturn(giteaHTTPError(t, http.StatusMethodNotAllowed))
+
+		err := s.MergeChangeset(ctx, cs, false)
+		assert.ErrorAs(t, err, &ChangesetNotMergeableError{})
+	})
+
+	t.Run("success", func(t *testing.T) {
+		for squash, style := range map[bool]string{false: gitea.MergeStyleMerge, true: gitea.MergeStyleSquash} {
+			cs, repo := mockGiteaChangeset()
+			s, client := mockGiteaSource()
+			mockGiteaAnnotatePullRequestSuccess(client)
+
+			pr := mockGiteaPullRequest(repo)
+			pr.Merged = true
+			client.MergePullRequestFunc.SetDefaultHook(func(_ context.Context, _, _ string, index int64, input gitea.MergePullRequestInput) error {
+				assert.EqualValues(t, 42, index)
+				assert.Equal(t, style, input.Do)
+				return nil
+			})
+			client.GetPullRequestFunc.SetDefaultReturn(pr, nil)
+
+			require.NoError(t, s.MergeChangeset(ctx, cs, squash))
+			assert.True(t, cs.Metadata.(*gtcs.AnnotatedPullRequest).Merged)
+		}
+	})
+}
+
+func TestGiteaSource_CreateComment(t *testing.T) {
+	cs, _ := mockGiteaChangeset()
+	s, client := mockGiteaSource()
+	client.CreateIssueCommentFunc.SetDefaultHook(func(_ context.Context, owner, name string, index int64, body string) error {
+		assert.Equal(t, "org", owner)
+		assert.Equal(t, "repo", name)
+		assert.EqualValues(t, 42, index)
+		assert.Equal(t, "comment", body)
+		return nil
+	})
+
+	require.NoError(t, s.CreateComment(context.Background(), cs, "comment"))
+}
+
+func TestGiteaSource_GetFork(t *testing.T) {
+	ctx := context.Background()
+
+	upstream := &gitea.Repository{
+		ID:       1,
+		Owner:    &gitea.User{Login: "upstream"},
+		Name:     "repo",
+		FullName: "upstream/repo",
+	}
+	urn := extsvc.URN(extsvc.KindGitea, 1)
+	upstreamRepo := &types.Repo{Metadata: upstream, Sources: map[string]*types.SourceInfo{
+		urn: {
+			ID:       urn,
+			CloneURL: "https://gitea.example.com/upstream/repo",
+		},
+	}}
+
+	fork := &gitea.Repository{
+		ID:       2,
+		Owner:    &gitea.User{Login: "fork"},
+		Name:     "upstream-repo",
+		FullName: "fork/upstream-repo",
+		Fork:     true,
+		Parent:   &gitea.Repository{ID: 1},
+	}
+
+	t.Run("error checking for repo", func(t *testing.T) {
+		s, client := mockGiteaSource()
+		want := errors.New("error")
+		client.GetRepoFunc.SetDefaultReturn(nil, want)
+
+		repo, err := s.GetFork(ctx, upstreamRepo, pointers.Ptr("fork"), nil)
+		assert.Nil(t, repo)
+		assert.ErrorIs(t, err, want)
+	})
+
+	t.Run("forked repo already exists", func(t *testing.T) {
+		s, client := mockGiteaSource()
+		client.GetRepoFunc.SetDefaultHook(func(_ context.Context, owner, name string) (*gitea.Repository, error) {
+			assert.Equal(t, "fork", owner)
+			assert.Equal(t, "upstream-repo", name)
+			return fork, nil
+		})
+
+		forkRepo, err := s.GetFork(ctx, upstreamRepo, pointers.Ptr("fork"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, fork, forkRepo.Metadata)
+		assert.Equal(t, "https://gitea.example.com/fork/upstream-repo", forkRepo.Sources[urn].CloneURL)
+	})
+
+	t.Run("fork into user namespace", func(t *testing.T) {
+		s, client := mockGiteaSource()
+		client.GetAuthenticatedUserFunc.SetDefaultReturn(&gitea.User{Login: "fork"}, nil)
+		client.GetRepoFunc.SetDefaultReturn(nil, &notFoundError{})
+		client.ForkRepoFunc.SetDefaultHook(func(_ context.Context, owner, name string, input gitea.ForkRepoInput) (*gitea.Repository, error) {
+			assert.Equal(t, "upstream", owner)
+			assert.Equal(t, "repo", name)
+			assert.Equal(t, gitea.ForkRepoInput{Name: "upstream-repo"}, input)
+			return fork, nil
+		})
+
+		forkRepo, err := s.GetFork(ctx, upstreamRepo, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, fork, forkRepo.Metadata)
+	})
+
+	t.Run("not forked from parent", func(t *testing.T) {
+		s, client := mockGiteaSource()
+		client.GetRepoFunc.SetDefaultReturn(&gitea.Repository{
+			ID:       3,
+			FullName: "fork/upstream-repo",
+			Fork:     true,
+			Parent:   &gitea.Repository{ID: 99},
+		}, nil)
+
+		forkRepo, err := s.GetFork(ctx, upstreamRepo, pointers.Ptr("fork"), nil)
+		assert.Nil(t, forkRepo)
+		assert.ErrorContains(t, err, "repo was not forked from the given parent")
+	})
+}
+
+func assertGiteaChangesetMatchesPullRequest(t *testing.T, cs *Changeset, pr *gitea.PullRequest) {
+	t.Helper()
+
+	apr, ok := cs.Metadata.(*gtcs.AnnotatedPullRequest)
+	require.True(t, ok)
+	assert.Same(t, pr, apr.PullRequest)
+	assert.Equal(t, "42", cs.ExternalID)
+	assert.Equal(t, extsvc.TypeGitea, cs.ExternalServiceType)
+	assert.Equal(t, "refs/heads/"+pr.Head.Ref, cs.ExternalBranch)
+
+	if pr.Head.Repo.ID != pr.Base.Repo.ID {
+		assert.Equal(t, pr.Head.Repo.Owner.Login, cs.ExternalForkNamespace)
+	} else {
+		assert.Empty(t, cs.ExternalForkNamespace)
+	}
+}
+
+// mockGiteaChangeset creates a plausible non-forked changeset and repo.
+func mockGiteaChangeset() (*Changeset, *gitea.Repository) {
+	giteaRepo := &gitea.Repository{ID: 1, Owner: &gitea.User{Login: "org"}, Name: "repo", FullName: "org/repo"}
+	repo := &types.Repo{Metadata: giteaRepo}
+	cs := &Changeset{
+		Title:   "title",
+		Body:    "body",
+		HeadRef: "refs/heads/branch",
+		BaseRef: "refs/heads/main",
+		Changeset: &btypes.Changeset{
+			ExternalID: "42",
+			Metadata: &gtcs.AnnotatedPullRequest{
+				PullRequest: &gitea.PullRequest{Index: 42, Title: "title"},
+			},
+		},
+		RemoteRepo: repo,
+		TargetRepo: repo,
+	}
+
+	return cs, giteaRepo
+}
+
+// mockGiteaPullRequest returns a plausible pull request that would be returned
+// from Gitea for a non-forked changeset.
+func mockGiteaPullRequest(repo *gitea.Repository) *gitea.PullRequest {
+	return &gitea.PullRequest{
+		ID:    420,
+		Index: 42,
+		Title: "title",
+		State: gitea.PullRequestStateOpen,
+		Head:  &gitea.PRBranch{Ref: "branch", Sha: "deadbeef", Repo: repo},
+		Base:  &gitea.PRBranch{Ref: "main", Sha: "cafebabe", Repo: repo},
+	}
+}
+
+func mockGiteaSource() (*GiteaSource, *MockGiteaClient) {
+	client := NewStrictMockGiteaClient()
+	return &GiteaSource{client: client}, client
+}
+
+// mockGiteaAnnotatePullRequestSuccess configures the mock client to return no
+// reviews and an empty combined status.
+func mockGiteaAnnotatePullRequestSuccess(client *MockGiteaClient) {
+	client.ListPullRequestReviewsFunc.SetDefaultReturn([]*gitea.PullReview{}, nil)
+	client.GetCombinedStatusFunc.SetDefaultReturn(&gitea.CombinedStatus{}, nil)
+}
+
+// giteaHTTPError returns the error the Gitea client returns for a response
+// with the given status code.
+func giteaHTTPError(t *testing.T, status int) error {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"error"}`, status)
+	}))
+	t.Cleanup(srv.Close)
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	cli, err := gitea.NewClient("urn", u, nil, httpcli.TestExternalDoer)
+	require.NoError(t, err)
+
+	_, err = cli.GetRepo(context.Background(), "org", "repo")
+	require.Error(t, err)
+	return err
+}
@@ -3,6 +3,7 @@ package sources
 import (
 	"context"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -21,6 +22,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/jsonc"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
@@ -31,6 +33,8 @@ type GitHubSource struct {
 }
 
 var _ ForkableChangesetSource = GitHubSource{}
+var _ MetadataChangesetSource = GitHubSource{}
+var _ AutoMergeChangesetSource = GitHubSource{}
 
 func NewGitHubSource(ctx context.Context, db database.DB, svc *types.ExternalService, cf *httpcli.Factory) (*GitHubSource, error) {
 	rawConfig, err := svc.Config.Decrypt(ctx)
@@ -334,6 +338,65 @@ func (s GitHubSource) UpdateChangeset(ctx context.Context, c *Changeset) error {
 	return c.Changeset.SetMetadata(updated)
 }
 
+// UpdateChangesetMetadata adds and removes the labels, reviewers, and assignees
+// of the given *Changeset on the pull request on the code host.
+func (s GitHubSource) UpdateChangesetMetadata(ctx context.Context, c *Changeset) error {
+	pr, ok := c.Changeset.Metadata.(*github.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not a GitHub pull request")
+	}
+
+	repo := c.TargetRepo.Metadata.(*github.Repository)
+	owner, name, err := github.SplitRepositoryNameWithOwner(repo.NameWithOwner)
+	if err != nil {
+		return errors.Wrap(err, "getting owner and repo name")
+	}
+
+	if len(c.Labels) > 0 {
+		if err := s.client.AddLabelsToIssue(ctx, owner, name, pr.Number, c.Labels); err != nil {
+			return errors.Wrap(err, "adding labels")
+		}
+	}
+	for _, label := range c.RemovedLabels {
+		// The label might have been removed manually already.
+		if err := s.client.RemoveLabelFromIssue(ctx, owner, name, pr.Number, label); err != nil && !github.IsNotFound(err) {
+			return errors.Wrap(err, "removing labels")
+		}
+	}
+	if len(c.Assignees) > 0 {
+		if err := s.client.AddAssigneesToIssue(ctx, owner, name, pr.Number, c.Assignees); err != nil {
+			return errors.Wrap(err, "adding assignees")
+		}
+	}
+	if len(c.RemovedAssignees) > 0 {
+		if err := s.client.RemoveAssigneesFromIssue(ctx, owner, name, pr.Number, c.RemovedAssignees); err != nil {
+			return errors.Wrap(err, "removing assignees")
+		}
+	}
+
+	// GitHub rejects review requests from the author of the pull request.
+	reviewers := slices.DeleteFunc(slices.Clone(c.Reviewers), func(reviewer string) bool {
+		return strings.EqualFold(reviewer, pr.Author.Login)
+	})
+	if len(reviewers) > 0 || len(c.TeamReviewers) > 0 {
+		if err := s.client.RequestPullRequestReviewers(ctx, owner, name, pr.Number, reviewers, c.TeamReviewers); err != nil {
+			return errors.Wrap(err, "requesting reviewers")
+		}
+	}
+	if len(c.RemovedReviewers) > 0 || len(c.RemovedTeamReviewers) > 0 {
+		if err := s.client.RemovePullRequestReviewers(ctx, owner, name, pr.Number, c.RemovedReviewers, c.RemovedTeamReviewers); err != nil {
+			return errors.Wrap(err, "removing reviewers")
+		}
+	}
+
+	// Reload the pull request so that the new labels are reflected in the
+	// changeset metadata.
+	if err := s.client.LoadPullRequest(ctx, pr); err != nil {
+		return err
+	}
+	return c.Changeset.SetMetadata(pr)
+}
+
 // ReopenChangeset reopens the given *Changeset on the code host.
 func (s GitHubSource) ReopenChangeset(ctx context.Context, c *Changeset) error {
 	pr, ok := c.Changeset.Metadata.(*github.PullRequest)
@@ -388,6 +451,30 @@ func (s GitHubSource) MergeChangeset(ctx context.Context, c *Changeset, squash b
 	return c.Changeset.SetMetadata(pr)
 }
 
+// EnableChangesetAutoMerge enables auto-merge for the given *Changeset on the
+// code host, so that GitHub merges the pull request once all required checks
+// and reviews of the base branch have passed.
+func (s GitHubSource) EnableChangesetAutoMerge(ctx context.Context, c *Changeset, method batcheslib.AutoMergeMethod) error {
+	pr, ok := c.Changeset.Metadata.(*github.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not a GitHub pull request")
+	}
+
+	var mergeMethod string
+	switch method {
+	case batcheslib.AutoMergeMethodMerge:
+		mergeMethod = "MERGE"
+	case batcheslib.AutoMergeMethodSquash:
+		mergeMethod = "SQUASH"
+	case batcheslib.AutoMergeMethodRebase:
+		mergeMethod = "REBASE"
+	default:
+		return errors.Errorf("unsupported merge method %q", method)
+	}
+
+	return s.client.EnablePullRequestAutoMerge(ctx, pr, mergeMethod)
+}
+
 func (GitHubSource) IsPushResponseArchived(s string) bool {
 	return strings.Contains(s, "This repository was archived so it is read-only.")
 }
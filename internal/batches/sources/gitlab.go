@@ -3,6 +3,7 @@ package sources
 import (
 	"context"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/jsonc"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
@@ -32,6 +34,8 @@ type GitLabSource struct {
 var _ ChangesetSource = &GitLabSource{}
 var _ DraftChangesetSource = &GitLabSource{}
 var _ ForkableChangesetSource = &GitLabSource{}
+var _ MetadataChangesetSource = &GitLabSource{}
+var _ AutoMergeChangesetSource = &GitLabSource{}
 
 // NewGitLabSource returns a new GitLabSource from the given external service.
 func NewGitLabSource(ctx context.Context, svc *types.ExternalService, cf *httpcli.Factory) (*GitLabSource, error) {
@@ -511,6 +515,93 @@ func (s *GitLabSource) UpdateChangeset(ctx context.Context, c *Changeset) error
 	return c.Changeset.SetMetadata(updated)
 }
 
+// UpdateChangesetMetadata adds and removes the labels, reviewers, and assignees
+// of the given *Changeset on the merge request on the code host. GitLab has no
+// concept of team reviewers, so TeamReviewers are ignored.
+func (s *GitLabSource) UpdateChangesetMetadata(ctx context.Context, c *Changeset) error {
+	mr, ok := c.Changeset.Metadata.(*gitlab.MergeRequest)
+	if !ok {
+		return errors.New("Changeset is not a GitLab merge request")
+	}
+	project := c.TargetRepo.Metadata.(*gitlab.Project)
+
+	// GitLab replaces the assignees and reviewers with the given IDs, so we
+	// apply the changes to the ones already set on the merge request.
+	assigneeIDs, assigneesChanged, err := s.updateUserIDs(ctx, mr.Assignees, c.Assignees, c.RemovedAssignees)
+	if err != nil {
+		return errors.Wrap(err, "resolving assignees")
+	}
+	reviewerIDs, reviewersChanged, err := s.updateUserIDs(ctx, mr.Reviewers, c.Reviewers, c.RemovedReviewers)
+	if err != nil {
+		return errors.Wrap(err, "resolving reviewers")
+	}
+
+	opts := gitlab.UpdateMergeRequestOpts{
+		AddLabels:    strings.Join(c.Labels, ","),
+		RemoveLabels: strings.Join(c.RemovedLabels, ","),
+	}
+	if assigneesChanged {
+		opts.AssigneeIDs = assigneeIDs
+	}
+	if reviewersChanged {
+		opts.ReviewerIDs = reviewerIDs
+	}
+	if opts.AddLabels == "" && opts.RemoveLabels == "" && opts.AssigneeIDs == nil && opts.ReviewerIDs == nil {
+		return nil
+	}
+
+	updated, err := s.client.UpdateMergeRequest(ctx, project, mr, opts)
+	if err != nil {
+		return errors.Wrap(err, "updating GitLab merge request")
+	}
+
+	// These additional API calls can go away once we can use the GraphQL API.
+	if err := s.decorateMergeRequestData(ctx, project, updated); err != nil {
+		return errors.Wrapf(err, "retrieving additional data for merge request %d", mr.IID)
+	}
+
+	return c.Changeset.SetMetadata(updated)
+}
+
+// updateUserIDs returns the IDs of the existing users without the removed ones,
+// together with the IDs of the users with the added usernames. The returned
+// bool is true if any user was added or removed. If no user is left, the ID 0
+// is returned, which unassigns all users.
+func (s *GitLabSource) updateUserIDs(ctx context.Context, existing []gitlab.User, added, removed []string) ([]int32, bool, error) {
+	ids := make([]int32, 0, len(existing)+len(added))
+	seen := make(map[string]struct{}, len(existing))
+	changed := false
+	for _, u := range existing {
+		if slices.Contains(removed, u.Username) {
+			changed = true
+			continue
+		}
+		ids = append(ids, u.ID)
+		seen[u.Username] = struct{}{}
+	}
+
+	for _, username := range added {
+		if _, ok := seen[username]; ok {
+			continue
+		}
+		users, _, err := s.client.ListUsers(ctx, "users?username="+url.QueryEscape(username))
+		if err != nil {
+			return nil, false, err
+		}
+		if len(users) == 0 {
+			return nil, false, errors.Newf("GitLab user %q not found", username)
+		}
+		ids = append(ids, users[0].ID)
+		seen[username] = struct{}{}
+		changed = true
+	}
+
+	if len(ids) == 0 {
+		ids = []int32{0}
+	}
+	return ids, changed, nil
+}
+
 // UndraftChangeset marks the changeset as *not* work in progress anymore.
 func (s *GitLabSource) UndraftChangeset(ctx context.Context, c *Changeset) error {
 	mr, ok := c.Changeset.Metadata.(*gitlab.MergeRequest)
@@ -568,6 +659,40 @@ func (s *GitLabSource) MergeChangeset(ctx context.Context, c *Changeset, squash
 	return c.Changeset.SetMetadata(updated)
 }
 
+// EnableChangesetAutoMerge enables auto-merge for the given *Changeset on the
+// code host, so that GitLab merges the merge request once its pipeline
+// succeeds. GitLab doesn't support rebasing as part of the merge.
+func (s *GitLabSource) EnableChangesetAutoMerge(ctx context.Context, c *Changeset, method batcheslib.AutoMergeMethod) error {
+	mr, ok := c.Changeset.Metadata.(*gitlab.MergeRequest)
+	if !ok {
+		return errors.New("Changeset is not a GitLab merge request")
+	}
+	project := c.TargetRepo.Metadata.(*gitlab.Project)
+
+	var squash bool
+	switch method {
+	case batcheslib.AutoMergeMethodMerge:
+	case batcheslib.AutoMergeMethodSquash:
+		squash = true
+	default:
+		return errors.Errorf("unsupported merge method %q", method)
+	}
+
+	updated, err := s.client.EnableMergeRequestAutoMerge(ctx, project, mr, squash)
+	if err != nil {
+		if errors.Is(err, gitlab.ErrNotMergeable) {
+			return ChangesetNotMergeableError{ErrorMsg: err.Error()}
+		}
+		return errors.Wrap(err, "enabling auto-merge of GitLab merge request")
+	}
+
+	if err := s.decorateMergeRequestData(ctx, project, updated); err != nil {
+		return errors.Wrapf(err, "retrieving additional data for merge request %d", updated.IID)
+	}
+
+	return c.Changeset.SetMetadata(updated)
+}
+
 func (*GitLabSource) IsPushResponseArchived(s string) bool {
 	return strings.Contains(s, "ERROR: You are not allowed to push code to this project")
 }
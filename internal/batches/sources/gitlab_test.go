@@ -22,6 +22,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/versions"
 	"github.com/sourcegraph/sourcegraph/internal/testutil"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/sourcegraph/sourcegraph/lib/pointers"
 	"github.com/sourcegraph/sourcegraph/schema"
@@ -882,6 +883,101 @@ func TestGitLabSource_ChangesetSource(t *testing.T) {
 		}
 	})
 
+	t.Run("UpdateChangesetMetadata", func(t *testing.T) {
+		in := &gitlab.MergeRequest{
+			IID:       2,
+			Assignees: []gitlab.User{{ID: 1, Username: "alice"}},
+			Reviewers: []gitlab.User{{ID: 3, Username: "carol"}},
+		}
+		out := &gitlab.MergeRequest{IID: 2}
+
+		p := newGitLabChangesetSourceTestProvider(t)
+		p.changeset.Changeset.Metadata = in
+		p.changeset.Labels = []string{"a", "b"}
+		p.changeset.RemovedLabels = []string{"c"}
+		p.changeset.Assignees = []string{"alice", "bob"}
+		p.changeset.RemovedReviewers = []string{"carol"}
+
+		oldListUsers := gitlab.MockListUsers
+		t.Cleanup(func() { gitlab.MockListUsers = oldListUsers })
+		gitlab.MockListUsers = func(c *gitlab.Client, ctx context.Context, urlStr string) ([]*gitlab.AuthUser, *string, error) {
+			if have, want := urlStr, "users?username=bob"; have != want {
+				t.Errorf("unexpected URL: have=%q want=%q", have, want)
+			}
+			return []*gitlab.AuthUser{{ID: 2, Username: "bob"}}, nil, nil
+		}
+
+		oldMock := gitlab.MockUpdateMergeRequest
+		t.Cleanup(func() { gitlab.MockUpdateMergeRequest = oldMock })
+		gitlab.MockUpdateMergeRequest = func(c *gitlab.Client, ctx context.Context, project *gitlab.Project, mr *gitlab.MergeRequest, opts gitlab.UpdateMergeRequestOpts) (*gitlab.MergeRequest, error) {
+			if have, want := opts.AddLabels, "a,b"; have != want {
+				t.Errorf("unexpected labels: have=%q want=%q", have, want)
+			}
+			if have, want := opts.RemoveLabels, "c"; have != want {
+				t.Errorf("unexpected removed labels: have=%q want=%q", have, want)
+			}
+			if diff := cmp.Diff([]int32{1, 2}, opts.AssigneeIDs); diff != "" {
+				t.Errorf("unexpected assignee IDs (-want +have):\n%s", diff)
+			}
+			// Removing the only reviewer unassigns all reviewers.
+			if diff := cmp.Diff([]int32{0}, opts.ReviewerIDs); diff != "" {
+				t.Errorf("unexpected reviewer IDs (-want +have):\n%s", diff)
+			}
+			return out, nil
+		}
+
+		p.mockGetMergeRequestNotes(in.IID, nil, 20, nil)
+		p.mockGetMergeRequestResourceStateEvents(in.IID, nil, 20, nil)
+		p.mockGetMergeRequestPipelines(in.IID, nil, 20, nil)
+
+		if err := p.source.UpdateChangesetMetadata(p.ctx, p.changeset); err != nil {
+			t.Errorf("unexpected non-nil error: %+v", err)
+		}
+		if p.changeset.Changeset.Metadata != out {
+			t.Errorf("metadata not correctly updated: have %+v; want %+v", p.changeset.Changeset.Metadata, out)
+		}
+	})
+
+	t.Run("EnableChangesetAutoMerge", func(t *testing.T) {
+		in := &gitlab.MergeRequest{IID: 2}
+		out := &gitlab.MergeRequest{IID: 2}
+
+		t.Run("unsupported merge method", func(t *testing.T) {
+			p := newGitLabChangesetSourceTestProvider(t)
+			p.changeset.Changeset.Metadata = in
+
+			err := p.source.EnableChangesetAutoMerge(p.ctx, p.changeset, batcheslib.AutoMergeMethodRebase)
+			if err == nil {
+				t.Fatal("unexpected nil error")
+			}
+		})
+
+		t.Run("success", func(t *testing.T) {
+			p := newGitLabChangesetSourceTestProvider(t)
+			p.changeset.Changeset.Metadata = in
+
+			oldMock := gitlab.MockEnableMergeRequestAutoMerge
+			t.Cleanup(func() { gitlab.MockEnableMergeRequestAutoMerge = oldMock })
+			gitlab.MockEnableMergeRequestAutoMerge = func(c *gitlab.Client, ctx context.Context, project *gitlab.Project, mr *gitlab.MergeRequest, squash bool) (*gitlab.MergeRequest, error) {
+				if !squash {
+					t.Error("expected squash to be requested")
+				}
+				return out, nil
+			}
+
+			p.mockGetMergeRequestNotes(in.IID, nil, 20, nil)
+			p.mockGetMergeRequestResourceStateEvents(in.IID, nil, 20, nil)
+			p.mockGetMergeRequestPipelines(in.IID, nil, 20, nil)
+
+			if err := p.source.EnableChangesetAutoMerge(p.ctx, p.changeset, batcheslib.AutoMergeMethodSquash); err != nil {
+				t.Errorf("unexpected non-nil error: %+v", err)
+			}
+			if p.changeset.Changeset.Metadata != out {
+				t.Errorf("metadata not correctly updated: have %+v; want %+v", p.changeset.Changeset.Metadata, out)
+			}
+		})
+	})
+
 	t.Run("CreateComment", func(t *testing.T) {
 		commentBody := "test-comment"
 		t.Run("invalid metadata", func(t *testing.T) {
@@ -22,6 +22,7 @@ import (
 	azuredevops "github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
 	bitbucketcloud "github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	gerrit "github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
+	gitea "github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
 	gitolite "github.com/sourcegraph/sourcegraph/internal/extsvc/gitolite"
 	store1 "github.com/sourcegraph/sourcegraph/internal/github_apps/store"
 	gitserver "github.com/sourcegraph/sourcegraph/internal/gitserver"
@@ -11220,6 +11221,2457 @@ func (c GerritClientWriteReviewCommentFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// MockGiteaClient is a mock implementation of the Client interface (from
+// the package github.com/sourcegraph/sourcegraph/internal/extsvc/gitea)
+// used for unit testing.
+type MockGiteaClient struct {
+	// AuthenticatorFunc is an instance of a mock function object
+	// controlling the behavior of the method Authenticator.
+	AuthenticatorFunc *GiteaClientAuthenticatorFunc
+	// CreateIssueCommentFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateIssueComment.
+	CreateIssueCommentFunc *GiteaClientCreateIssueCommentFunc
+	// CreatePullRequestFunc is an instance of a mock function object
+	// controlling the behavior of the method CreatePullRequest.
+	CreatePullRequestFunc *GiteaClientCreatePullRequestFunc
+	// EditPullRequestFunc is an instance of a mock function object
+	// controlling the behavior of the method EditPullRequest.
+	EditPullRequestFunc *GiteaClientEditPullRequestFunc
+	// ForkRepoFunc is an instance of a mock function object controlling the
+	// behavior of the method ForkRepo.
+	ForkRepoFunc *GiteaClientForkRepoFunc
+	// GetAuthenticatedUserFunc is an instance of a mock function object
+	// controlling the behavior of the method GetAuthenticatedUser.
+	GetAuthenticatedUserFunc *GiteaClientGetAuthenticatedUserFunc
+	// GetCombinedStatusFunc is an instance of a mock function object
+	// controlling the behavior of the method GetCombinedStatus.
+	GetCombinedStatusFunc *GiteaClientGetCombinedStatusFunc
+	// GetPullRequestFunc is an instance of a mock function object
+	// controlling the behavior of the method GetPullRequest.
+	GetPullRequestFunc *GiteaClientGetPullRequestFunc
+	// GetPullRequestByBaseHeadFunc is an instance of a mock function object
+	// controlling the behavior of the method GetPullRequestByBaseHead.
+	GetPullRequestByBaseHeadFunc *GiteaClientGetPullRequestByBaseHeadFunc
+	// GetRepoFunc is an instance of a mock function object controlling the
+	// behavior of the method GetRepo.
+	GetRepoFunc *GiteaClientGetRepoFunc
+	// GetURLFunc is an instance of a mock function object controlling the
+	// behavior of the method GetURL.
+	GetURLFunc *GiteaClientGetURLFunc
+	// GetUserFunc is an instance of a mock function object controlling the
+	// behavior of the method GetUser.
+	GetUserFunc *GiteaClientGetUserFunc
+	// ListAuthenticatedUserReposFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ListAuthenticatedUserRepos.
+	ListAuthenticatedUserReposFunc *GiteaClientListAuthenticatedUserReposFunc
+	// ListOrgReposFunc is an instance of a mock function object controlling
+	// the behavior of the method ListOrgRepos.
+	ListOrgReposFunc *GiteaClientListOrgReposFunc
+	// ListPullRequestReviewsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListPullRequestReviews.
+	ListPullRequestReviewsFunc *GiteaClientListPullRequestReviewsFunc
+	// ListUserReposFunc is an instance of a mock function object
+	// controlling the behavior of the method ListUserRepos.
+	ListUserReposFunc *GiteaClientListUserReposFunc
+	// MergePullRequestFunc is an instance of a mock function object
+	// controlling the behavior of the method MergePullRequest.
+	MergePullRequestFunc *GiteaClientMergePullRequestFunc
+	// WithAuthenticatorFunc is an instance of a mock function object
+	// controlling the behavior of the method WithAuthenticator.
+	WithAuthenticatorFunc *GiteaClientWithAuthenticatorFunc
+	// WithSudoFunc is an instance of a mock function object controlling the
+	// behavior of the method WithSudo.
+	WithSudoFunc *GiteaClientWithSudoFunc
+}
+
+// NewMockGiteaClient creates a new mock of the Client interface. All
+// methods return zero values for all results, unless overwritten.
+func NewMockGiteaClient() *MockGiteaClient {
+	return &MockGiteaClient{
+		AuthenticatorFunc: &GiteaClientAuthenticatorFunc{
+			defaultHook: func() (r0 auth.Authenticator) {
+				return
+			},
+		},
+		CreateIssueCommentFunc: &GiteaClientCreateIssueCommentFunc{
+			defaultHook: func(context.Context, string, string, int64, string) (r0 error) {
+				return
+			},
+		},
+		CreatePullRequestFunc: &GiteaClientCreatePullRequestFunc{
+			defaultHook: func(context.Context, string, string, gitea.CreatePullRequestInput) (r0 *gitea.PullRequest, r1 error) {
+				return
+			},
+		},
+		EditPullRequestFunc: &GiteaClientEditPullRequestFunc{
+			defaultHook: func(context.Context, string, string, int64, gitea.EditPullRequestInput) (r0 *gitea.PullRequest, r1 error) {
+				return
+			},
+		},
+		ForkRepoFunc: &GiteaClientForkRepoFunc{
+			defaultHook: func(context.Context, string, string, gitea.ForkRepoInput) (r0 *gitea.Repository, r1 error) {
+				return
+			},
+		},
+		GetAuthenticatedUserFunc: &GiteaClientGetAuthenticatedUserFunc{
+			defaultHook: func(context.Context) (r0 *gitea.User, r1 error) {
+				return
+			},
+		},
+		GetCombinedStatusFunc: &GiteaClientGetCombinedStatusFunc{
+			defaultHook: func(context.Context, string, string, string) (r0 *gitea.CombinedStatus, r1 error) {
+				return
+			},
+		},
+		GetPullRequestFunc: &GiteaClientGetPullRequestFunc{
+			defaultHook: func(context.Context, string, string, int64) (r0 *gitea.PullRequest, r1 error) {
+				return
+			},
+		},
+		GetPullRequestByBaseHeadFunc: &GiteaClientGetPullRequestByBaseHeadFunc{
+			defaultHook: func(context.Context, string, string, string, string) (r0 *gitea.PullRequest, r1 error) {
+				return
+			},
+		},
+		GetRepoFunc: &GiteaClientGetRepoFunc{
+			defaultHook: func(context.Context, string, string) (r0 *gitea.Repository, r1 error) {
+				return
+			},
+		},
+		GetURLFunc: &GiteaClientGetURLFunc{
+			defaultHook: func() (r0 *url.URL) {
+				return
+			},
+		},
+		GetUserFunc: &GiteaClientGetUserFunc{
+			defaultHook: func(context.Context, string) (r0 *gitea.User, r1 error) {
+				return
+			},
+		},
+		ListAuthenticatedUserReposFunc: &GiteaClientListAuthenticatedUserReposFunc{
+			defaultHook: func(context.Context, int) (r0 []*gitea.Repository, r1 bool, r2 error) {
+				return
+			},
+		},
+		ListOrgReposFunc: &GiteaClientListOrgReposFunc{
+			defaultHook: func(context.Context, string, int) (r0 []*gitea.Repository, r1 bool, r2 error) {
+				return
+			},
+		},
+		ListPullRequestReviewsFunc: &GiteaClientListPullRequestReviewsFunc{
+			defaultHook: func(context.Context, string, string, int64) (r0 []*gitea.PullReview, r1 error) {
+				return
+			},
+		},
+		ListUserReposFunc: &GiteaClientListUserReposFunc{
+			defaultHook: func(context.Context, string, int) (r0 []*gitea.Repository, r1 bool, r2 error) {
+				return
+			},
+		},
+		MergePullRequestFunc: &GiteaClientMergePullRequestFunc{
+			defaultHook: func(context.Context, string, string, int64, gitea.MergePullRequestInput) (r0 error) {
+				return
+			},
+		},
+		WithAuthenticatorFunc: &GiteaClientWithAuthenticatorFunc{
+			defaultHook: func(auth.Authenticator) (r0 gitea.Client, r1 error) {
+				return
+			},
+		},
+		WithSudoFunc: &GiteaClientWithSudoFunc{
+			defaultHook: func(string) (r0 gitea.Client) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockGiteaClient creates a new mock of the Client interface. All
+// methods panic on invocation, unless overwritten.
+func NewStrictMockGiteaClient() *MockGiteaClient {
+	return &MockGiteaClient{
+		AuthenticatorFunc: &GiteaClientAuthenticatorFunc{
+			defaultHook: func() auth.Authenticator {
+				panic("unexpected invocation of MockGiteaClient.Authenticator")
+			},
+		},
+		CreateIssueCommentFunc: &GiteaClientCreateIssueCommentFunc{
+			defaultHook: func(context.Context, string, string, int64, string) error {
+				panic("unexpected invocation of MockGiteaClient.CreateIssueComment")
+			},
+		},
+		CreatePullRequestFunc: &GiteaClientCreatePullRequestFunc{
+			defaultHook: func(context.Context, string, string, gitea.CreatePullRequestInput) (*gitea.PullRequest, error) {
+				panic("unexpected invocation of MockGiteaClient.CreatePullRequest")
+			},
+		},
+		EditPullRequestFunc: &GiteaClientEditPullRequestFunc{
+			defaultHook: func(context.Context, string, string, int64, gitea.EditPullRequestInput) (*gitea.PullRequest, error) {
+				panic("unexpected invocation of MockGiteaClient.EditPullRequest")
+			},
+		},
+		ForkRepoFunc: &GiteaClientForkRepoFunc{
+			defaultHook: func(context.Context, string, string, gitea.ForkRepoInput) (*gitea.Repository, error) {
+				panic("unexpected invocation of MockGiteaClient.ForkRepo")
+			},
+		},
+		GetAuthenticatedUserFunc: &GiteaClientGetAuthenticatedUserFunc{
+			defaultHook: func(context.Context) (*gitea.User, error) {
+				panic("unexpected invocation of MockGiteaClient.GetAuthenticatedUser")
+			},
+		},
+		GetCombinedStatusFunc: &GiteaClientGetCombinedStatusFunc{
+			defaultHook: func(context.Context, string, string, string) (*gitea.CombinedStatus, error) {
+				panic("unexpected invocation of MockGiteaClient.GetCombinedStatus")
+			},
+		},
+		GetPullRequestFunc: &GiteaClientGetPullRequestFunc{
+			defaultHook: func(context.Context, string, string, int64) (*gitea.PullRequest, error) {
+				panic("unexpected invocation of MockGiteaClient.GetPullRequest")
+			},
+		},
+		GetPullRequestByBaseHeadFunc: &GiteaClientGetPullRequestByBaseHeadFunc{
+			defaultHook: func(context.Context, string, string, string, string) (*gitea.PullRequest, error) {
+				panic("unexpected invocation of MockGiteaClient.GetPullRequestByBaseHead")
+			},
+		},
+		GetRepoFunc: &GiteaClientGetRepoFunc{
+			defaultHook: func(context.Context, string, string) (*gitea.Repository, error) {
+				panic("unexpected invocation of MockGiteaClient.GetRepo")
+			},
+		},
+		GetURLFunc: &GiteaClientGetURLFunc{
+			defaultHook: func() *url.URL {
+				panic("unexpected invocation of MockGiteaClient.GetURL")
+			},
+		},
+		GetUserFunc: &GiteaClientGetUserFunc{
+			defaultHook: func(context.Context, string) (*gitea.User, error) {
+				panic("unexpected invocation of MockGiteaClient.GetUser")
+			},
+		},
+		ListAuthenticatedUserReposFunc: &GiteaClientListAuthenticatedUserReposFunc{
+			defaultHook: func(context.Context, int) ([]*gitea.Repository, bool, error) {
+				panic("unexpected invocation of MockGiteaClient.ListAuthenticatedUserRepos")
+			},
+		},
+		ListOrgReposFunc: &GiteaClientListOrgReposFunc{
+			defaultHook: func(context.Context, string, int) ([]*gitea.Repository, bool, error) {
+				panic("unexpected invocation of MockGiteaClient.ListOrgRepos")
+			},
+		},
+		ListPullRequestReviewsFunc: &GiteaClientListPullRequestReviewsFunc{
+			defaultHook: func(context.Context, string, string, int64) ([]*gitea.PullReview, error) {
+				panic("unexpected invocation of MockGiteaClient.ListPullRequestReviews")
+			},
+		},
+		ListUserReposFunc: &GiteaClientListUserReposFunc{
+			defaultHook: func(context.Context, string, int) ([]*gitea.Repository, bool, error) {
+				panic("unexpected invocation of MockGiteaClient.ListUserRepos")
+			},
+		},
+		MergePullRequestFunc: &GiteaClientMergePullRequestFunc{
+			defaultHook: func(context.Context, string, string, int64, gitea.MergePullRequestInput) error {
+				panic("unexpected invocation of MockGiteaClient.MergePullRequest")
+			},
+		},
+		WithAuthenticatorFunc: &GiteaClientWithAuthenticatorFunc{
+			defaultHook: func(auth.Authenticator) (gitea.Client, error) {
+				panic("unexpected invocation of MockGiteaClient.WithAuthenticator")
+			},
+		},
+		WithSudoFunc: &GiteaClientWithSudoFunc{
+			defaultHook: func(string) gitea.Client {
+				panic("unexpected invocation of MockGiteaClient.WithSudo")
+			},
+		},
+	}
+}
+
+// NewMockGiteaClientFrom creates a new mock of the MockGiteaClient
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockGiteaClientFrom(i gitea.Client) *MockGiteaClient {
+	return &MockGiteaClient{
+		AuthenticatorFunc: &GiteaClientAuthenticatorFunc{
+			defaultHook: i.Authenticator,
+		},
+		CreateIssueCommentFunc: &GiteaClientCreateIssueCommentFunc{
+			defaultHook: i.CreateIssueComment,
+		},
+		CreatePullRequestFunc: &GiteaClientCreatePullRequestFunc{
+			defaultHook: i.CreatePullRequest,
+		},
+		EditPullRequestFunc: &GiteaClientEditPullRequestFunc{
+			defaultHook: i.EditPullRequest,
+		},
+		ForkRepoFunc: &GiteaClientForkRepoFunc{
+			defaultHook: i.ForkRepo,
+		},
+		GetAuthenticatedUserFunc: &GiteaClientGetAuthenticatedUserFunc{
+			defaultHook: i.GetAuthenticatedUser,
+		},
+		GetCombinedStatusFunc: &GiteaClientGetCombinedStatusFunc{
+			defaultHook: i.GetCombinedStatus,
+		},
+		GetPullRequestFunc: &GiteaClientGetPullRequestFunc{
+			defaultHook: i.GetPullRequest,
+		},
+		GetPullRequestByBaseHeadFunc: &GiteaClientGetPullRequestByBaseHeadFunc{
+			defaultHook: i.GetPullRequestByBaseHead,
+		},
+		GetRepoFunc: &GiteaClientGetRepoFunc{
+			defaultHook: i.GetRepo,
+		},
+		GetURLFunc: &GiteaClientGetURLFunc{
+			defaultHook: i.GetURL,
+		},
+		GetUserFunc: &GiteaClientGetUserFunc{
+			defaultHook: i.GetUser,
+		},
+		ListAuthenticatedUserReposFunc: &GiteaClientListAuthenticatedUserReposFunc{
+			defaultHook: i.ListAuthenticatedUserRepos,
+		},
+		ListOrgReposFunc: &GiteaClientListOrgReposFunc{
+			defaultHook: i.ListOrgRepos,
+		},
+		ListPullRequestReviewsFunc: &GiteaClientListPullRequestReviewsFunc{
+			defaultHook: i.ListPullRequestReviews,
+		},
+		ListUserReposFunc: &GiteaClientListUserReposFunc{
+			defaultHook: i.ListUserRepos,
+		},
+		MergePullRequestFunc: &GiteaClientMergePullRequestFunc{
+			defaultHook: i.MergePullRequest,
+		},
+		WithAuthenticatorFunc: &GiteaClientWithAuthenticatorFunc{
+			defaultHook: i.WithAuthenticator,
+		},
+		WithSudoFunc: &GiteaClientWithSudoFunc{
+			defaultHook: i.WithSudo,
+		},
+	}
+}
+
+// GiteaClientAuthenticatorFunc describes the behavior when the
+// Authenticator method of the parent MockGiteaClient instance is invoked.
+type GiteaClientAuthenticatorFunc struct {
+	defaultHook func() auth.Authenticator
+	hooks       []func() auth.Authenticator
+	history     []GiteaClientAuthenticatorFuncCall
+	mutex       sync.Mutex
+}
+
+// Authenticator delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGiteaClient) Authenticator() auth.Authenticator {
+	r0 := m.AuthenticatorFunc.nextHook()()
+	m.AuthenticatorFunc.appendCall(GiteaClientAuthenticatorFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Authenticator method
+// of the parent MockGiteaClient instance is invoked and the hook queue is
+// empty.
+func (f *GiteaClientAuthenticatorFunc) SetDefaultHook(hook func() auth.Authenticator) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Authenticator method of the parent MockGiteaClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GiteaClientAuthenticatorFunc) PushHook(hook func() auth.Authenticator) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientAuthenticatorFunc) SetDefaultReturn(r0 auth.Authenticator) {
+	f.SetDefaultHook(func() auth.Authenticator {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientAuthenticatorFunc) PushReturn(r0 auth.Authenticator) {
+	f.PushHook(func() auth.Authenticator {
+		return r0
+	})
+}
+
+func (f *GiteaClientAuthenticatorFunc) nextHook() func() auth.Authenticator {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientAuthenticatorFunc) appendCall(r0 GiteaClientAuthenticatorFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientAuthenticatorFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientAuthenticatorFunc) History() []GiteaClientAuthenticatorFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientAuthenticatorFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientAuthenticatorFuncCall is an object that describes an
+// invocation of method Authenticator on an instance of MockGiteaClient.
+type GiteaClientAuthenticatorFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 auth.Authenticator
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientAuthenticatorFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientAuthenticatorFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GiteaClientCreateIssueCommentFunc describes the behavior when the
+// CreateIssueComment method of the parent MockGiteaClient instance is
+// invoked.
+type GiteaClientCreateIssueCommentFunc struct {
+	defaultHook func(context.Context, string, string, int64, string) error
+	hooks       []func(context.Context, string, string, int64, string) error
+	history     []GiteaClientCreateIssueCommentFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateIssueComment delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) CreateIssueComment(v0 context.Context, v1 string, v2 string, v3 int64, v4 string) error {
+	r0 := m.CreateIssueCommentFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.CreateIssueCommentFunc.appendCall(GiteaClientCreateIssueCommentFuncCall{v0, v1, v2, v3, v4, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the CreateIssueComment
+// method of the parent MockGiteaClient instance is invoked and the hook
+// queue is empty.
+func (f *GiteaClientCreateIssueCommentFunc) SetDefaultHook(hook func(context.Context, string, string, int64, string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateIssueComment method of the parent MockGiteaClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GiteaClientCreateIssueCommentFunc) PushHook(hook func(context.Context, string, string, int64, string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientCreateIssueCommentFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, string, int64, string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientCreateIssueCommentFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, string, int64, string) error {
+		return r0
+	})
+}
+
+func (f *GiteaClientCreateIssueCommentFunc) nextHook() func(context.Context, string, string, int64, string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientCreateIssueCommentFunc) appendCall(r0 GiteaClientCreateIssueCommentFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientCreateIssueCommentFuncCall
+// objects describing the invocations of this function.
+func (f *GiteaClientCreateIssueCommentFunc) History() []GiteaClientCreateIssueCommentFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientCreateIssueCommentFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientCreateIssueCommentFuncCall is an object that describes an
+// invocation of method CreateIssueComment on an instance of
+// MockGiteaClient.
+type GiteaClientCreateIssueCommentFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int64
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientCreateIssueCommentFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientCreateIssueCommentFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GiteaClientCreatePullRequestFunc describes the behavior when the
+// CreatePullRequest method of the parent MockGiteaClient instance is
+// invoked.
+type GiteaClientCreatePullRequestFunc struct {
+	defaultHook func(context.Context, string, string, gitea.CreatePullRequestInput) (*gitea.PullRequest, error)
+	hooks       []func(context.Context, string, string, gitea.CreatePullRequestInput) (*gitea.PullRequest, error)
+	history     []GiteaClientCreatePullRequestFuncCall
+	mutex       sync.Mutex
+}
+
+// CreatePullRequest delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) CreatePullRequest(v0 context.Context, v1 string, v2 string, v3 gitea.CreatePullRequestInput) (*gitea.PullRequest, error) {
+	r0, r1 := m.CreatePullRequestFunc.nextHook()(v0, v1, v2, v3)
+	m.CreatePullRequestFunc.appendCall(GiteaClientCreatePullRequestFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CreatePullRequest
+// method of the parent MockGiteaClient instance is invoked and the hook
+// queue is empty.
+func (f *GiteaClientCreatePullRequestFunc) SetDefaultHook(hook func(context.Context, string, string, gitea.CreatePullRequestInput) (*gitea.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreatePullRequest method of the parent MockGiteaClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GiteaClientCreatePullRequestFunc) PushHook(hook func(context.Context, string, string, gitea.CreatePullRequestInput) (*gitea.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientCreatePullRequestFunc) SetDefaultReturn(r0 *gitea.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, gitea.CreatePullRequestInput) (*gitea.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientCreatePullRequestFunc) PushReturn(r0 *gitea.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, string, string, gitea.CreatePullRequestInput) (*gitea.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientCreatePullRequestFunc) nextHook() func(context.Context, string, string, gitea.CreatePullRequestInput) (*gitea.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientCreatePullRequestFunc) appendCall(r0 GiteaClientCreatePullRequestFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientCreatePullRequestFuncCall
+// objects describing the invocations of this function.
+func (f *GiteaClientCreatePullRequestFunc) History() []GiteaClientCreatePullRequestFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientCreatePullRequestFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientCreatePullRequestFuncCall is an object that describes an
+// invocation of method CreatePullRequest on an instance of MockGiteaClient.
+type GiteaClientCreatePullRequestFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 gitea.CreatePullRequestInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitea.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientCreatePullRequestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientCreatePullRequestFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientEditPullRequestFunc describes the behavior when the
+// EditPullRequest method of the parent MockGiteaClient instance is invoked.
+type GiteaClientEditPullRequestFunc struct {
+	defaultHook func(context.Context, string, string, int64, gitea.EditPullRequestInput) (*gitea.PullRequest, error)
+	hooks       []func(context.Context, string, string, int64, gitea.EditPullRequestInput) (*gitea.PullRequest, error)
+	history     []GiteaClientEditPullRequestFuncCall
+	mutex       sync.Mutex
+}
+
+// EditPullRequest delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) EditPullRequest(v0 context.Context, v1 string, v2 string, v3 int64, v4 gitea.EditPullRequestInput) (*gitea.PullRequest, error) {
+	r0, r1 := m.EditPullRequestFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.EditPullRequestFunc.appendCall(GiteaClientEditPullRequestFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the EditPullRequest
+// method of the parent MockGiteaClient instance is invoked and the hook
+// queue is empty.
+func (f *GiteaClientEditPullRequestFunc) SetDefaultHook(hook func(context.Context, string, string, int64, gitea.EditPullRequestInput) (*gitea.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// EditPullRequest method of the parent MockGiteaClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GiteaClientEditPullRequestFunc) PushHook(hook func(context.Context, string, string, int64, gitea.EditPullRequestInput) (*gitea.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientEditPullRequestFunc) SetDefaultReturn(r0 *gitea.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, int64, gitea.EditPullRequestInput) (*gitea.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientEditPullRequestFunc) PushReturn(r0 *gitea.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, string, string, int64, gitea.EditPullRequestInput) (*gitea.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientEditPullRequestFunc) nextHook() func(context.Context, string, string, int64, gitea.EditPullRequestInput) (*gitea.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientEditPullRequestFunc) appendCall(r0 GiteaClientEditPullRequestFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientEditPullRequestFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientEditPullRequestFunc) History() []GiteaClientEditPullRequestFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientEditPullRequestFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientEditPullRequestFuncCall is an object that describes an
+// invocation of method EditPullRequest on an instance of MockGiteaClient.
+type GiteaClientEditPullRequestFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int64
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 gitea.EditPullRequestInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitea.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientEditPullRequestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientEditPullRequestFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientForkRepoFunc describes the behavior when the ForkRepo method
+// of the parent MockGiteaClient instance is invoked.
+type GiteaClientForkRepoFunc struct {
+	defaultHook func(context.Context, string, string, gitea.ForkRepoInput) (*gitea.Repository, error)
+	hooks       []func(context.Context, string, string, gitea.ForkRepoInput) (*gitea.Repository, error)
+	history     []GiteaClientForkRepoFuncCall
+	mutex       sync.Mutex
+}
+
+// ForkRepo delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGiteaClient) ForkRepo(v0 context.Context, v1 string, v2 string, v3 gitea.ForkRepoInput) (*gitea.Repository, error) {
+	r0, r1 := m.ForkRepoFunc.nextHook()(v0, v1, v2, v3)
+	m.ForkRepoFunc.appendCall(GiteaClientForkRepoFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ForkRepo method of
+// the parent MockGiteaClient instance is invoked and the hook queue is
+// empty.
+func (f *GiteaClientForkRepoFunc) SetDefaultHook(hook func(context.Context, string, string, gitea.ForkRepoInput) (*gitea.Repository, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ForkRepo method of the parent MockGiteaClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GiteaClientForkRepoFunc) PushHook(hook func(context.Context, string, string, gitea.ForkRepoInput) (*gitea.Repository, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientForkRepoFunc) SetDefaultReturn(r0 *gitea.Repository, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, gitea.ForkRepoInput) (*gitea.Repository, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientForkRepoFunc) PushReturn(r0 *gitea.Repository, r1 error) {
+	f.PushHook(func(context.Context, string, string, gitea.ForkRepoInput) (*gitea.Repository, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientForkRepoFunc) nextHook() func(context.Context, string, string, gitea.ForkRepoInput) (*gitea.Repository, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientForkRepoFunc) appendCall(r0 GiteaClientForkRepoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientForkRepoFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientForkRepoFunc) History() []GiteaClientForkRepoFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientForkRepoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientForkRepoFuncCall is an object that describes an invocation of
+// method ForkRepo on an instance of MockGiteaClient.
+type GiteaClientForkRepoFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 gitea.ForkRepoInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitea.Repository
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientForkRepoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientForkRepoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientGetAuthenticatedUserFunc describes the behavior when the
+// GetAuthenticatedUser method of the parent MockGiteaClient instance is
+// invoked.
+type GiteaClientGetAuthenticatedUserFunc struct {
+	defaultHook func(context.Context) (*gitea.User, error)
+	hooks       []func(context.Context) (*gitea.User, error)
+	history     []GiteaClientGetAuthenticatedUserFuncCall
+	mutex       sync.Mutex
+}
+
+// GetAuthenticatedUser delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) GetAuthenticatedUser(v0 context.Context) (*gitea.User, error) {
+	r0, r1 := m.GetAuthenticatedUserFunc.nextHook()(v0)
+	m.GetAuthenticatedUserFunc.appendCall(GiteaClientGetAuthenticatedUserFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetAuthenticatedUser
+// method of the parent MockGiteaClient instance is invoked and the hook
+// queue is empty.
+func (f *GiteaClientGetAuthenticatedUserFunc) SetDefaultHook(hook func(context.Context) (*gitea.User, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetAuthenticatedUser method of the parent MockGiteaClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GiteaClientGetAuthenticatedUserFunc) PushHook(hook func(context.Context) (*gitea.User, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientGetAuthenticatedUserFunc) SetDefaultReturn(r0 *gitea.User, r1 error) {
+	f.SetDefaultHook(func(context.Context) (*gitea.User, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientGetAuthenticatedUserFunc) PushReturn(r0 *gitea.User, r1 error) {
+	f.PushHook(func(context.Context) (*gitea.User, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientGetAuthenticatedUserFunc) nextHook() func(context.Context) (*gitea.User, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientGetAuthenticatedUserFunc) appendCall(r0 GiteaClientGetAuthenticatedUserFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientGetAuthenticatedUserFuncCall
+// objects describing the invocations of this function.
+func (f *GiteaClientGetAuthenticatedUserFunc) History() []GiteaClientGetAuthenticatedUserFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientGetAuthenticatedUserFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientGetAuthenticatedUserFuncCall is an object that describes an
+// invocation of method GetAuthenticatedUser on an instance of
+// MockGiteaClient.
+type GiteaClientGetAuthenticatedUserFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitea.User
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientGetAuthenticatedUserFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientGetAuthenticatedUserFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientGetCombinedStatusFunc describes the behavior when the
+// GetCombinedStatus method of the parent MockGiteaClient instance is
+// invoked.
+type GiteaClientGetCombinedStatusFunc struct {
+	defaultHook func(context.Context, string, string, string) (*gitea.CombinedStatus, error)
+	hooks       []func(context.Context, string, string, string) (*gitea.CombinedStatus, error)
+	history     []GiteaClientGetCombinedStatusFuncCall
+	mutex       sync.Mutex
+}
+
+// GetCombinedStatus delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) GetCombinedStatus(v0 context.Context, v1 string, v2 string, v3 string) (*gitea.CombinedStatus, error) {
+	r0, r1 := m.GetCombinedStatusFunc.nextHook()(v0, v1, v2, v3)
+	m.GetCombinedStatusFunc.appendCall(GiteaClientGetCombinedStatusFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetCombinedStatus
+// method of the parent MockGiteaClient instance is invoked and the hook
+// queue is empty.
+func (f *GiteaClientGetCombinedStatusFunc) SetDefaultHook(hook func(context.Context, string, string, string) (*gitea.CombinedStatus, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetCombinedStatus method of the parent MockGiteaClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GiteaClientGetCombinedStatusFunc) PushHook(hook func(context.Context, string, string, string) (*gitea.CombinedStatus, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientGetCombinedStatusFunc) SetDefaultReturn(r0 *gitea.CombinedStatus, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, string) (*gitea.CombinedStatus, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientGetCombinedStatusFunc) PushReturn(r0 *gitea.CombinedStatus, r1 error) {
+	f.PushHook(func(context.Context, string, string, string) (*gitea.CombinedStatus, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientGetCombinedStatusFunc) nextHook() func(context.Context, string, string, string) (*gitea.CombinedStatus, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientGetCombinedStatusFunc) appendCall(r0 GiteaClientGetCombinedStatusFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientGetCombinedStatusFuncCall
+// objects describing the invocations of this function.
+func (f *GiteaClientGetCombinedStatusFunc) History() []GiteaClientGetCombinedStatusFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientGetCombinedStatusFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientGetCombinedStatusFuncCall is an object that describes an
+// invocation of method GetCombinedStatus on an instance of MockGiteaClient.
+type GiteaClientGetCombinedStatusFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitea.CombinedStatus
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientGetCombinedStatusFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientGetCombinedStatusFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientGetPullRequestFunc describes the behavior when the
+// GetPullRequest method of the parent MockGiteaClient instance is invoked.
+type GiteaClientGetPullRequestFunc struct {
+	defaultHook func(context.Context, string, string, int64) (*gitea.PullRequest, error)
+	hooks       []func(context.Context, string, string, int64) (*gitea.PullRequest, error)
+	history     []GiteaClientGetPullRequestFuncCall
+	mutex       sync.Mutex
+}
+
+// GetPullRequest delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) GetPullRequest(v0 context.Context, v1 string, v2 string, v3 int64) (*gitea.PullRequest, error) {
+	r0, r1 := m.GetPullRequestFunc.nextHook()(v0, v1, v2, v3)
+	m.GetPullRequestFunc.appendCall(GiteaClientGetPullRequestFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetPullRequest
+// method of the parent MockGiteaClient instance is invoked and the hook
+// queue is empty.
+func (f *GiteaClientGetPullRequestFunc) SetDefaultHook(hook func(context.Context, string, string, int64) (*gitea.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetPullRequest method of the parent MockGiteaClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GiteaClientGetPullRequestFunc) PushHook(hook func(context.Context, string, string, int64) (*gitea.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientGetPullRequestFunc) SetDefaultReturn(r0 *gitea.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, int64) (*gitea.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientGetPullRequestFunc) PushReturn(r0 *gitea.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, string, string, int64) (*gitea.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientGetPullRequestFunc) nextHook() func(context.Context, string, string, int64) (*gitea.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientGetPullRequestFunc) appendCall(r0 GiteaClientGetPullRequestFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientGetPullRequestFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientGetPullRequestFunc) History() []GiteaClientGetPullRequestFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientGetPullRequestFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientGetPullRequestFuncCall is an object that describes an
+// invocation of method GetPullRequest on an instance of MockGiteaClient.
+type GiteaClientGetPullRequestFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitea.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientGetPullRequestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientGetPullRequestFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientGetPullRequestByBaseHeadFunc describes the behavior when the
+// GetPullRequestByBaseHead method of the parent MockGiteaClient instance is
+// invoked.
+type GiteaClientGetPullRequestByBaseHeadFunc struct {
+	defaultHook func(context.Context, string, string, string, string) (*gitea.PullRequest, error)
+	hooks       []func(context.Context, string, string, string, string) (*gitea.PullRequest, error)
+	history     []GiteaClientGetPullRequestByBaseHeadFuncCall
+	mutex       sync.Mutex
+}
+
+// GetPullRequestByBaseHead delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) GetPullRequestByBaseHead(v0 context.Context, v1 string, v2 string, v3 string, v4 string) (*gitea.PullRequest, error) {
+	r0, r1 := m.GetPullRequestByBaseHeadFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.GetPullRequestByBaseHeadFunc.appendCall(GiteaClientGetPullRequestByBaseHeadFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetPullRequestByBaseHead method of the parent MockGiteaClient instance is
+// invoked and the hook queue is empty.
+func (f *GiteaClientGetPullRequestByBaseHeadFunc) SetDefaultHook(hook func(context.Context, string, string, string, string) (*gitea.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetPullRequestByBaseHead method of the parent MockGiteaClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GiteaClientGetPullRequestByBaseHeadFunc) PushHook(hook func(context.Context, string, string, string, string) (*gitea.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientGetPullRequestByBaseHeadFunc) SetDefaultReturn(r0 *gitea.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, string, string) (*gitea.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientGetPullRequestByBaseHeadFunc) PushReturn(r0 *gitea.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, string, string, string, string) (*gitea.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientGetPullRequestByBaseHeadFunc) nextHook() func(context.Context, string, string, string, string) (*gitea.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientGetPullRequestByBaseHeadFunc) appendCall(r0 GiteaClientGetPullRequestByBaseHeadFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientGetPullRequestByBaseHeadFuncCall
+// objects describing the invocations of this function.
+func (f *GiteaClientGetPullRequestByBaseHeadFunc) History() []GiteaClientGetPullRequestByBaseHeadFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientGetPullRequestByBaseHeadFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientGetPullRequestByBaseHeadFuncCall is an object that describes
+// an invocation of method GetPullRequestByBaseHead on an instance of
+// MockGiteaClient.
+type GiteaClientGetPullRequestByBaseHeadFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitea.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientGetPullRequestByBaseHeadFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientGetPullRequestByBaseHeadFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientGetRepoFunc describes the behavior when the GetRepo method of
+// the parent MockGiteaClient instance is invoked.
+type GiteaClientGetRepoFunc struct {
+	defaultHook func(context.Context, string, string) (*gitea.Repository, error)
+	hooks       []func(context.Context, string, string) (*gitea.Repository, error)
+	history     []GiteaClientGetRepoFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRepo delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGiteaClient) GetRepo(v0 context.Context, v1 string, v2 string) (*gitea.Repository, error) {
+	r0, r1 := m.GetRepoFunc.nextHook()(v0, v1, v2)
+	m.GetRepoFunc.appendCall(GiteaClientGetRepoFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetRepo method of
+// the parent MockGiteaClient instance is invoked and the hook queue is
+// empty.
+func (f *GiteaClientGetRepoFunc) SetDefaultHook(hook func(context.Context, string, string) (*gitea.Repository, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRepo method of the parent MockGiteaClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GiteaClientGetRepoFunc) PushHook(hook func(context.Context, string, string) (*gitea.Repository, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientGetRepoFunc) SetDefaultReturn(r0 *gitea.Repository, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string) (*gitea.Repository, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientGetRepoFunc) PushReturn(r0 *gitea.Repository, r1 error) {
+	f.PushHook(func(context.Context, string, string) (*gitea.Repository, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientGetRepoFunc) nextHook() func(context.Context, string, string) (*gitea.Repository, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientGetRepoFunc) appendCall(r0 GiteaClientGetRepoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientGetRepoFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientGetRepoFunc) History() []GiteaClientGetRepoFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientGetRepoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientGetRepoFuncCall is an object that describes an invocation of
+// method GetRepo on an instance of MockGiteaClient.
+type GiteaClientGetRepoFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitea.Repository
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientGetRepoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientGetRepoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientGetURLFunc describes the behavior when the GetURL method of
+// the parent MockGiteaClient instance is invoked.
+type GiteaClientGetURLFunc struct {
+	defaultHook func() *url.URL
+	hooks       []func() *url.URL
+	history     []GiteaClientGetURLFuncCall
+	mutex       sync.Mutex
+}
+
+// GetURL delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGiteaClient) GetURL() *url.URL {
+	r0 := m.GetURLFunc.nextHook()()
+	m.GetURLFunc.appendCall(GiteaClientGetURLFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the GetURL method of the
+// parent MockGiteaClient instance is invoked and the hook queue is empty.
+func (f *GiteaClientGetURLFunc) SetDefaultHook(hook func() *url.URL) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetURL method of the parent MockGiteaClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GiteaClientGetURLFunc) PushHook(hook func() *url.URL) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientGetURLFunc) SetDefaultReturn(r0 *url.URL) {
+	f.SetDefaultHook(func() *url.URL {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientGetURLFunc) PushReturn(r0 *url.URL) {
+	f.PushHook(func() *url.URL {
+		return r0
+	})
+}
+
+func (f *GiteaClientGetURLFunc) nextHook() func() *url.URL {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientGetURLFunc) appendCall(r0 GiteaClientGetURLFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientGetURLFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientGetURLFunc) History() []GiteaClientGetURLFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientGetURLFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientGetURLFuncCall is an object that describes an invocation of
+// method GetURL on an instance of MockGiteaClient.
+type GiteaClientGetURLFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *url.URL
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientGetURLFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientGetURLFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GiteaClientGetUserFunc describes the behavior when the GetUser method of
+// the parent MockGiteaClient instance is invoked.
+type GiteaClientGetUserFunc struct {
+	defaultHook func(context.Context, string) (*gitea.User, error)
+	hooks       []func(context.Context, string) (*gitea.User, error)
+	history     []GiteaClientGetUserFuncCall
+	mutex       sync.Mutex
+}
+
+// GetUser delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGiteaClient) GetUser(v0 context.Context, v1 string) (*gitea.User, error) {
+	r0, r1 := m.GetUserFunc.nextHook()(v0, v1)
+	m.GetUserFunc.appendCall(GiteaClientGetUserFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetUser method of
+// the parent MockGiteaClient instance is invoked and the hook queue is
+// empty.
+func (f *GiteaClientGetUserFunc) SetDefaultHook(hook func(context.Context, string) (*gitea.User, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetUser method of the parent MockGiteaClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GiteaClientGetUserFunc) PushHook(hook func(context.Context, string) (*gitea.User, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientGetUserFunc) SetDefaultReturn(r0 *gitea.User, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (*gitea.User, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientGetUserFunc) PushReturn(r0 *gitea.User, r1 error) {
+	f.PushHook(func(context.Context, string) (*gitea.User, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientGetUserFunc) nextHook() func(context.Context, string) (*gitea.User, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientGetUserFunc) appendCall(r0 GiteaClientGetUserFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientGetUserFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientGetUserFunc) History() []GiteaClientGetUserFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientGetUserFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientGetUserFuncCall is an object that describes an invocation of
+// method GetUser on an instance of MockGiteaClient.
+type GiteaClientGetUserFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitea.User
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientGetUserFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientGetUserFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientListAuthenticatedUserReposFunc describes the behavior when the
+// ListAuthenticatedUserRepos method of the parent MockGiteaClient instance
+// is invoked.
+type GiteaClientListAuthenticatedUserReposFunc struct {
+	defaultHook func(context.Context, int) ([]*gitea.Repository, bool, error)
+	hooks       []func(context.Context, int) ([]*gitea.Repository, bool, error)
+	history     []GiteaClientListAuthenticatedUserReposFuncCall
+	mutex       sync.Mutex
+}
+
+// ListAuthenticatedUserRepos delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) ListAuthenticatedUserRepos(v0 context.Context, v1 int) ([]*gitea.Repository, bool, error) {
+	r0, r1, r2 := m.ListAuthenticatedUserReposFunc.nextHook()(v0, v1)
+	m.ListAuthenticatedUserReposFunc.appendCall(GiteaClientListAuthenticatedUserReposFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the
+// ListAuthenticatedUserRepos method of the parent MockGiteaClient instance
+// is invoked and the hook queue is empty.
+func (f *GiteaClientListAuthenticatedUserReposFunc) SetDefaultHook(hook func(context.Context, int) ([]*gitea.Repository, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListAuthenticatedUserRepos method of the parent MockGiteaClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GiteaClientListAuthenticatedUserReposFunc) PushHook(hook func(context.Context, int) ([]*gitea.Repository, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientListAuthenticatedUserReposFunc) SetDefaultReturn(r0 []*gitea.Repository, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int) ([]*gitea.Repository, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientListAuthenticatedUserReposFunc) PushReturn(r0 []*gitea.Repository, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int) ([]*gitea.Repository, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *GiteaClientListAuthenticatedUserReposFunc) nextHook() func(context.Context, int) ([]*gitea.Repository, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientListAuthenticatedUserReposFunc) appendCall(r0 GiteaClientListAuthenticatedUserReposFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GiteaClientListAuthenticatedUserReposFuncCall objects describing the
+// invocations of this function.
+func (f *GiteaClientListAuthenticatedUserReposFunc) History() []GiteaClientListAuthenticatedUserReposFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientListAuthenticatedUserReposFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientListAuthenticatedUserReposFuncCall is an object that describes
+// an invocation of method ListAuthenticatedUserRepos on an instance of
+// MockGiteaClient.
+type GiteaClientListAuthenticatedUserReposFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*gitea.Repository
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientListAuthenticatedUserReposFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientListAuthenticatedUserReposFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// GiteaClientListOrgReposFunc describes the behavior when the ListOrgRepos
+// method of the parent MockGiteaClient instance is invoked.
+type GiteaClientListOrgReposFunc struct {
+	defaultHook func(context.Context, string, int) ([]*gitea.Repository, bool, error)
+	hooks       []func(context.Context, string, int) ([]*gitea.Repository, bool, error)
+	history     []GiteaClientListOrgReposFuncCall
+	mutex       sync.Mutex
+}
+
+// ListOrgRepos delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGiteaClient) ListOrgRepos(v0 context.Context, v1 string, v2 int) ([]*gitea.Repository, bool, error) {
+	r0, r1, r2 := m.ListOrgReposFunc.nextHook()(v0, v1, v2)
+	m.ListOrgReposFunc.appendCall(GiteaClientListOrgReposFuncCall{v0, v1, v2, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the ListOrgRepos method
+// of the parent MockGiteaClient instance is invoked and the hook queue is
+// empty.
+func (f *GiteaClientListOrgReposFunc) SetDefaultHook(hook func(context.Context, string, int) ([]*gitea.Repository, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListOrgRepos method of the parent MockGiteaClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GiteaClientListOrgReposFunc) PushHook(hook func(context.Context, string, int) ([]*gitea.Repository, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientListOrgReposFunc) SetDefaultReturn(r0 []*gitea.Repository, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, string, int) ([]*gitea.Repository, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientListOrgReposFunc) PushReturn(r0 []*gitea.Repository, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, string, int) ([]*gitea.Repository, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *GiteaClientListOrgReposFunc) nextHook() func(context.Context, string, int) ([]*gitea.Repository, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientListOrgReposFunc) appendCall(r0 GiteaClientListOrgReposFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientListOrgReposFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientListOrgReposFunc) History() []GiteaClientListOrgReposFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientListOrgReposFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientListOrgReposFuncCall is an object that describes an invocation
+// of method ListOrgRepos on an instance of MockGiteaClient.
+type GiteaClientListOrgReposFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*gitea.Repository
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientListOrgReposFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientListOrgReposFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// GiteaClientListPullRequestReviewsFunc describes the behavior when the
+// ListPullRequestReviews method of the parent MockGiteaClient instance is
+// invoked.
+type GiteaClientListPullRequestReviewsFunc struct {
+	defaultHook func(context.Context, string, string, int64) ([]*gitea.PullReview, error)
+	hooks       []func(context.Context, string, string, int64) ([]*gitea.PullReview, error)
+	history     []GiteaClientListPullRequestReviewsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListPullRequestReviews delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) ListPullRequestReviews(v0 context.Context, v1 string, v2 string, v3 int64) ([]*gitea.PullReview, error) {
+	r0, r1 := m.ListPullRequestReviewsFunc.nextHook()(v0, v1, v2, v3)
+	m.ListPullRequestReviewsFunc.appendCall(GiteaClientListPullRequestReviewsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListPullRequestReviews method of the parent MockGiteaClient instance is
+// invoked and the hook queue is empty.
+func (f *GiteaClientListPullRequestReviewsFunc) SetDefaultHook(hook func(context.Context, string, string, int64) ([]*gitea.PullReview, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListPullRequestReviews method of the parent MockGiteaClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GiteaClientListPullRequestReviewsFunc) PushHook(hook func(context.Context, string, string, int64) ([]*gitea.PullReview, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientListPullRequestReviewsFunc) SetDefaultReturn(r0 []*gitea.PullReview, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, int64) ([]*gitea.PullReview, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientListPullRequestReviewsFunc) PushReturn(r0 []*gitea.PullReview, r1 error) {
+	f.PushHook(func(context.Context, string, string, int64) ([]*gitea.PullReview, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientListPullRequestReviewsFunc) nextHook() func(context.Context, string, string, int64) ([]*gitea.PullReview, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientListPullRequestReviewsFunc) appendCall(r0 GiteaClientListPullRequestReviewsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientListPullRequestReviewsFuncCall
+// objects describing the invocations of this function.
+func (f *GiteaClientListPullRequestReviewsFunc) History() []GiteaClientListPullRequestReviewsFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientListPullRequestReviewsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientListPullRequestReviewsFuncCall is an object that describes an
+// invocation of method ListPullRequestReviews on an instance of
+// MockGiteaClient.
+type GiteaClientListPullRequestReviewsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*gitea.PullReview
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientListPullRequestReviewsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientListPullRequestReviewsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientListUserReposFunc describes the behavior when the
+// ListUserRepos method of the parent MockGiteaClient instance is invoked.
+type GiteaClientListUserReposFunc struct {
+	defaultHook func(context.Context, string, int) ([]*gitea.Repository, bool, error)
+	hooks       []func(context.Context, string, int) ([]*gitea.Repository, bool, error)
+	history     []GiteaClientListUserReposFuncCall
+	mutex       sync.Mutex
+}
+
+// ListUserRepos delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGiteaClient) ListUserRepos(v0 context.Context, v1 string, v2 int) ([]*gitea.Repository, bool, error) {
+	r0, r1, r2 := m.ListUserReposFunc.nextHook()(v0, v1, v2)
+	m.ListUserReposFunc.appendCall(GiteaClientListUserReposFuncCall{v0, v1, v2, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the ListUserRepos method
+// of the parent MockGiteaClient instance is invoked and the hook queue is
+// empty.
+func (f *GiteaClientListUserReposFunc) SetDefaultHook(hook func(context.Context, string, int) ([]*gitea.Repository, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListUserRepos method of the parent MockGiteaClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GiteaClientListUserReposFunc) PushHook(hook func(context.Context, string, int) ([]*gitea.Repository, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientListUserReposFunc) SetDefaultReturn(r0 []*gitea.Repository, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, string, int) ([]*gitea.Repository, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientListUserReposFunc) PushReturn(r0 []*gitea.Repository, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, string, int) ([]*gitea.Repository, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *GiteaClientListUserReposFunc) nextHook() func(context.Context, string, int) ([]*gitea.Repository, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientListUserReposFunc) appendCall(r0 GiteaClientListUserReposFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientListUserReposFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientListUserReposFunc) History() []GiteaClientListUserReposFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientListUserReposFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientListUserReposFuncCall is an object that describes an
+// invocation of method ListUserRepos on an instance of MockGiteaClient.
+type GiteaClientListUserReposFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*gitea.Repository
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientListUserReposFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientListUserReposFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// GiteaClientMergePullRequestFunc describes the behavior when the
+// MergePullRequest method of the parent MockGiteaClient instance is
+// invoked.
+type GiteaClientMergePullRequestFunc struct {
+	defaultHook func(context.Context, string, string, int64, gitea.MergePullRequestInput) error
+	hooks       []func(context.Context, string, string, int64, gitea.MergePullRequestInput) error
+	history     []GiteaClientMergePullRequestFuncCall
+	mutex       sync.Mutex
+}
+
+// MergePullRequest delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) MergePullRequest(v0 context.Context, v1 string, v2 string, v3 int64, v4 gitea.MergePullRequestInput) error {
+	r0 := m.MergePullRequestFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.MergePullRequestFunc.appendCall(GiteaClientMergePullRequestFuncCall{v0, v1, v2, v3, v4, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the MergePullRequest
+// method of the parent MockGiteaClient instance is invoked and the hook
+// queue is empty.
+func (f *GiteaClientMergePullRequestFunc) SetDefaultHook(hook func(context.Context, string, string, int64, gitea.MergePullRequestInput) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// MergePullRequest method of the parent MockGiteaClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GiteaClientMergePullRequestFunc) PushHook(hook func(context.Context, string, string, int64, gitea.MergePullRequestInput) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientMergePullRequestFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, string, int64, gitea.MergePullRequestInput) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientMergePullRequestFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, string, int64, gitea.MergePullRequestInput) error {
+		return r0
+	})
+}
+
+func (f *GiteaClientMergePullRequestFunc) nextHook() func(context.Context, string, string, int64, gitea.MergePullRequestInput) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientMergePullRequestFunc) appendCall(r0 GiteaClientMergePullRequestFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientMergePullRequestFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientMergePullRequestFunc) History() []GiteaClientMergePullRequestFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientMergePullRequestFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientMergePullRequestFuncCall is an object that describes an
+// invocation of method MergePullRequest on an instance of MockGiteaClient.
+type GiteaClientMergePullRequestFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int64
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 gitea.MergePullRequestInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientMergePullRequestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientMergePullRequestFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GiteaClientWithAuthenticatorFunc describes the behavior when the
+// WithAuthenticator method of the parent MockGiteaClient instance is
+// invoked.
+type GiteaClientWithAuthenticatorFunc struct {
+	defaultHook func(auth.Authenticator) (gitea.Client, error)
+	hooks       []func(auth.Authenticator) (gitea.Client, error)
+	history     []GiteaClientWithAuthenticatorFuncCall
+	mutex       sync.Mutex
+}
+
+// WithAuthenticator delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) WithAuthenticator(v0 auth.Authenticator) (gitea.Client, error) {
+	r0, r1 := m.WithAuthenticatorFunc.nextHook()(v0)
+	m.WithAuthenticatorFunc.appendCall(GiteaClientWithAuthenticatorFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the WithAuthenticator
+// method of the parent MockGiteaClient instance is invoked and the hook
+// queue is empty.
+func (f *GiteaClientWithAuthenticatorFunc) SetDefaultHook(hook func(auth.Authenticator) (gitea.Client, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// WithAuthenticator method of the parent MockGiteaClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GiteaClientWithAuthenticatorFunc) PushHook(hook func(auth.Authenticator) (gitea.Client, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientWithAuthenticatorFunc) SetDefaultReturn(r0 gitea.Client, r1 error) {
+	f.SetDefaultHook(func(auth.Authenticator) (gitea.Client, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientWithAuthenticatorFunc) PushReturn(r0 gitea.Client, r1 error) {
+	f.PushHook(func(auth.Authenticator) (gitea.Client, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientWithAuthenticatorFunc) nextHook() func(auth.Authenticator) (gitea.Client, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientWithAuthenticatorFunc) appendCall(r0 GiteaClientWithAuthenticatorFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientWithAuthenticatorFuncCall
+// objects describing the invocations of this function.
+func (f *GiteaClientWithAuthenticatorFunc) History() []GiteaClientWithAuthenticatorFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientWithAuthenticatorFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientWithAuthenticatorFuncCall is an object that describes an
+// invocation of method WithAuthenticator on an instance of MockGiteaClient.
+type GiteaClientWithAuthenticatorFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 auth.Authenticator
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 gitea.Client
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientWithAuthenticatorFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientWithAuthenticatorFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientWithSudoFunc describes the behavior when the WithSudo method
+// of the parent MockGiteaClient instance is invoked.
+type GiteaClientWithSudoFunc struct {
+	defaultHook func(string) gitea.Client
+	hooks       []func(string) gitea.Client
+	history     []GiteaClientWithSudoFuncCall
+	mutex       sync.Mutex
+}
+
+// WithSudo delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGiteaClient) WithSudo(v0 string) gitea.Client {
+	r0 := m.WithSudoFunc.nextHook()(v0)
+	m.WithSudoFunc.appendCall(GiteaClientWithSudoFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the WithSudo method of
+// the parent MockGiteaClient instance is invoked and the hook queue is
+// empty.
+func (f *GiteaClientWithSudoFunc) SetDefaultHook(hook func(string) gitea.Client) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// WithSudo method of the parent MockGiteaClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GiteaClientWithSudoFunc) PushHook(hook func(string) gitea.Client) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientWithSudoFunc) SetDefaultReturn(r0 gitea.Client) {
+	f.SetDefaultHook(func(string) gitea.Client {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientWithSudoFunc) PushReturn(r0 gitea.Client) {
+	f.PushHook(func(string) gitea.Client {
+		return r0
+	})
+}
+
+func (f *GiteaClientWithSudoFunc) nextHook() func(string) gitea.Client {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientWithSudoFunc) appendCall(r0 GiteaClientWithSudoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientWithSudoFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientWithSudoFunc) History() []GiteaClientWithSudoFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientWithSudoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientWithSudoFuncCall is an object that describes an invocation of
+// method WithSudo on an instance of MockGiteaClient.
+type GiteaClientWithSudoFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 gitea.Client
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientWithSudoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientWithSudoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // MockGitserverClient is a mock implementation of the Client interface
 // (from the package github.com/sourcegraph/sourcegraph/internal/gitserver)
 // used for unit testing.
@@ -522,6 +522,7 @@ func loadExternalService(ctx context.Context, s database.ExternalServiceStore, o
 			*schema.BitbucketCloudConnection,
 			*schema.AzureDevOpsConnection,
 			*schema.GerritConnection,
+			*schema.GiteaConnection,
 			*schema.PerforceConnection:
 			return e, nil
 		}
@@ -546,6 +547,8 @@ func buildChangesetSource(ctx context.Context, tx SourcerStore, cf *httpcli.Fact
 		return NewAzureDevOpsSource(ctx, externalService, cf)
 	case extsvc.KindGerrit:
 		return NewGerritSource(ctx, externalService, cf)
+	case extsvc.KindGitea:
+		return NewGiteaSource(ctx, externalService, cf)
 	case extsvc.KindPerforce:
 		return NewPerforceSource(ctx, gitserver.NewClient("batches.perforcesource"), externalService, cf)
 	default:
@@ -602,7 +605,7 @@ func setOAuthTokenAuth(u *vcs.URL, extSvcType, token string) error {
 	// will fail. The URL returned looks like `https://%20<TOKEN>@github.com/sourcegraph/sourcegraph.git` which is invalid.
 	trimmedToken := strings.TrimSpace(token)
 	switch extSvcType {
-	case extsvc.TypeGitHub:
+	case extsvc.TypeGitHub, extsvc.TypeGitea:
 		u.User = url.User(trimmedToken)
 
 	case extsvc.TypeGitLab:
@@ -621,7 +624,7 @@ func setOAuthTokenAuth(u *vcs.URL, extSvcType, token string) error {
 // password combination, with the specific quirks per code host.
 func setBasicAuth(u *vcs.URL, extSvcType, username, password string) error {
 	switch extSvcType {
-	case extsvc.TypeGitHub, extsvc.TypeGitLab:
+	case extsvc.TypeGitHub, extsvc.TypeGitLab, extsvc.TypeGitea:
 		return errors.New("need token to push commits to " + extSvcType)
 	case extsvc.TypeBitbucketServer, extsvc.TypeBitbucketCloud, extsvc.TypeAzureDevOps, extsvc.TypeGerrit:
 		u.User = url.UserPassword(username, password)
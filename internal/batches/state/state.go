@@ -10,9 +10,11 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/batches/sources/azuredevops"
 	gerritbatches "github.com/sourcegraph/sourcegraph/internal/batches/sources/gerrit"
+	giteabatches "github.com/sourcegraph/sourcegraph/internal/batches/sources/gitea"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	adobatches "github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
 	"github.com/sourcegraph/sourcegraph/internal/perforce"
 
 	"github.com/sourcegraph/go-diff/diff"
@@ -123,6 +125,8 @@ func computeCheckState(c *btypes.Changeset, events ChangesetEvents) btypes.Chang
 		return computeAzureDevOpsBuildState(m)
 	case *gerritbatches.AnnotatedChange:
 		return computeGerritBuildState(m)
+	case *giteabatches.AnnotatedPullRequest:
+		return computeGiteaBuildState(m)
 	case *perforce.ChangelistState:
 		// Perforce doesn't have builds built-in, its better to be explicit by still
 		// including this case for clarity.
@@ -331,6 +335,40 @@ func computeGerritBuildState(ac *gerritbatches.AnnotatedChange) btypes.Changeset
 	return combineCheckStates(states)
 }
 
+func computeGiteaBuildState(apr *giteabatches.AnnotatedPullRequest) btypes.ChangesetCheckState {
+	if apr.Status == nil {
+		return btypes.ChangesetCheckStateUnknown
+	}
+
+	// Gitea reports one status per context, most recent first, so we only
+	// keep the first status we see for each context.
+	stateMap := make(map[string]btypes.ChangesetCheckState)
+	for _, status := range apr.Status.Statuses {
+		if _, ok := stateMap[status.Context]; !ok {
+			stateMap[status.Context] = parseGiteaBuildState(status.State)
+		}
+	}
+
+	states := make([]btypes.ChangesetCheckState, 0, len(stateMap))
+	for _, v := range stateMap {
+		states = append(states, v)
+	}
+	return combineCheckStates(states)
+}
+
+func parseGiteaBuildState(s gitea.CommitStatusState) btypes.ChangesetCheckState {
+	switch s {
+	case gitea.CommitStatusError, gitea.CommitStatusFailure:
+		return btypes.ChangesetCheckStateFailed
+	case gitea.CommitStatusPending:
+		return btypes.ChangesetCheckStatePending
+	case gitea.CommitStatusSuccess, gitea.CommitStatusWarning:
+		return btypes.ChangesetCheckStatePassed
+	default:
+		return btypes.ChangesetCheckStateUnknown
+	}
+}
+
 func parseGerritBuildState(s string) btypes.ChangesetCheckState {
 	switch s {
 	case "-2", "-1":
@@ -640,6 +678,21 @@ func computeSingleChangesetExternalState(c *btypes.Changeset) (s btypes.Changese
 		default:
 			return "", errors.Errorf("unknown Gerrit Change state: %s", m.Change.Status)
 		}
+	case *giteabatches.AnnotatedPullRequest:
+		switch {
+		case m.Merged:
+			s = btypes.ChangesetExternalStateMerged
+		case m.State == gitea.PullRequestStateClosed:
+			s = btypes.ChangesetExternalStateClosed
+		case m.State == gitea.PullRequestStateOpen:
+			if m.IsDraft() {
+				s = btypes.ChangesetExternalStateDraft
+			} else {
+				s = btypes.ChangesetExternalStateOpen
+			}
+		default:
+			return "", errors.Errorf("unknown Gitea pull request state: %s", m.State)
+		}
 	case *perforce.Changelist:
 		switch m.State {
 		case perforce.ChangelistStateClosed:
@@ -759,6 +812,34 @@ func computeSingleChangesetReviewState(c *btypes.Changeset) (s btypes.ChangesetR
 			}
 
 		}
+	case *giteabatches.AnnotatedPullRequest:
+		// Reviewers can submit several reviews over the lifetime of a pull
+		// request, so only the most recent effective review of each reviewer
+		// is taken into account. Reviews are returned in chronological order.
+		latest := make(map[int64]gitea.PullReviewState)
+		for _, review := range m.Reviews {
+			if review.Dismissed || review.User == nil {
+				continue
+			}
+			switch review.State {
+			case gitea.PullReviewStateApproved, gitea.PullReviewStateRequestChanges:
+				latest[review.User.ID] = review.State
+			case gitea.PullReviewStateRequestReview:
+				if _, ok := latest[review.User.ID]; !ok {
+					latest[review.User.ID] = review.State
+				}
+			}
+		}
+		for _, state := range latest {
+			switch state {
+			case gitea.PullReviewStateApproved:
+				states[btypes.ChangesetReviewStateApproved] = true
+			case gitea.PullReviewStateRequestChanges:
+				states[btypes.ChangesetReviewStateChangesRequested] = true
+			default:
+				states[btypes.ChangesetReviewStatePending] = true
+			}
+		}
 	case *perforce.Changelist:
 		states[btypes.ChangesetReviewStatePending] = true
 	default:
@@ -875,6 +956,39 @@ var ComputeLabelsRequiredEventTypes = []btypes.ChangesetEventKind{
 	btypes.ChangesetEventKindGitHubUnlabeled,
 }
 
+// CountApprovals returns the number of reviewers whose most recent review of
+// the changeset is an approval. The events should be presorted.
+func CountApprovals(events ChangesetEvents) (int, error) {
+	lastReviewByAuthor := make(map[string]btypes.ChangesetReviewState)
+	for _, e := range events {
+		s, err := e.ReviewState()
+		if err != nil {
+			return 0, err
+		}
+
+		author := e.ReviewAuthor()
+		// If the user has been deleted, skip their reviews, as they don't count towards the approvals anymore.
+		if author == "" {
+			continue
+		}
+
+		switch s {
+		case btypes.ChangesetReviewStateApproved, btypes.ChangesetReviewStateChangesRequested:
+			lastReviewByAuthor[author] = s
+		case btypes.ChangesetReviewStateDismissed:
+			delete(lastReviewByAuthor, author)
+		}
+	}
+
+	approvals := 0
+	for _, s := range lastReviewByAuthor {
+		if s == btypes.ChangesetReviewStateApproved {
+			approvals++
+		}
+	}
+	return approvals, nil
+}
+
 // ComputeLabels returns a sorted list of current labels based the starting set
 // of labels found in the Changeset and looking at ChangesetEvents that have
 // occurred after the Changeset.UpdatedAt.
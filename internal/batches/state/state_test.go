@@ -10,7 +10,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	azuredevops2 "github.com/sourcegraph/sourcegraph/internal/batches/sources/azuredevops"
+	giteabatches "github.com/sourcegraph/sourcegraph/internal/batches/sources/gitea"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
 	"github.com/sourcegraph/sourcegraph/internal/perforce"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 
@@ -420,6 +422,76 @@ func TestComputeAzureDevOpsBuildStatus(t *testing.T) {
 	}
 }
 
+func TestComputeGiteaBuildState(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status *gitea.CombinedStatus
+		want   btypes.ChangesetCheckState
+	}{
+		{
+			name: "no status",
+			want: btypes.ChangesetCheckStateUnknown,
+		},
+		{
+			name:   "no statuses",
+			status: &gitea.CombinedStatus{},
+			want:   btypes.ChangesetCheckStateUnknown,
+		},
+		{
+			name: "single success",
+			status: &gitea.CombinedStatus{Statuses: []*gitea.CommitStatus{
+				{ID: 1, Context: "ci", State: gitea.CommitStatusSuccess},
+			}},
+			want: btypes.ChangesetCheckStatePassed,
+		},
+		{
+			name: "single warning",
+			status: &gitea.CombinedStatus{Statuses: []*gitea.CommitStatus{
+				{ID: 1, Context: "ci", State: gitea.CommitStatusWarning},
+			}},
+			want: btypes.ChangesetCheckStatePassed,
+		},
+		{
+			name: "failure + pending",
+			status: &gitea.CombinedStatus{Statuses: []*gitea.CommitStatus{
+				{ID: 2, Context: "ci", State: gitea.CommitStatusFailure},
+				{ID: 1, Context: "lint", State: gitea.CommitStatusPending},
+			}},
+			want: btypes.ChangesetCheckStatePending,
+		},
+		{
+			name: "error + success",
+			status: &gitea.CombinedStatus{Statuses: []*gitea.CommitStatus{
+				{ID: 2, Context: "ci", State: gitea.CommitStatusError},
+				{ID: 1, Context: "lint", State: gitea.CommitStatusSuccess},
+			}},
+			want: btypes.ChangesetCheckStateFailed,
+		},
+		{
+			name: "latest status per context wins",
+			status: &gitea.CombinedStatus{Statuses: []*gitea.CommitStatus{
+				{ID: 2, Context: "ci", State: gitea.CommitStatusSuccess},
+				{ID: 1, Context: "ci", State: gitea.CommitStatusFailure},
+			}},
+			want: btypes.ChangesetCheckStatePassed,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			have := computeGiteaBuildState(&giteabatches.AnnotatedPullRequest{
+				PullRequest: &gitea.PullRequest{},
+				Status:      tc.status,
+			})
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Fatalf(diff)
+			}
+		})
+	}
+}
+
 func TestComputeGitLabCheckState(t *testing.T) {
 	t.Parallel()
 
@@ -679,6 +751,39 @@ func TestComputeReviewState(t *testing.T) {
 			},
 			want: btypes.ChangesetReviewStateChangesRequested,
 		},
+		{
+			name:      "gitea - no reviews",
+			changeset: giteaChangeset(daysAgo(0), gitea.PullRequestStateOpen, "title"),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetReviewStatePending,
+		},
+		{
+			name: "gitea - approved after changes requested",
+			changeset: setGiteaReviews(giteaChangeset(daysAgo(0), gitea.PullRequestStateOpen, "title"),
+				&gitea.PullReview{User: &gitea.User{ID: 1}, State: gitea.PullReviewStateRequestChanges},
+				&gitea.PullReview{User: &gitea.User{ID: 1}, State: gitea.PullReviewStateComment},
+				&gitea.PullReview{User: &gitea.User{ID: 1}, State: gitea.PullReviewStateApproved},
+			),
+			history: []changesetStatesAtTime{},
+			want:    btypes.ChangesetReviewStateApproved,
+		},
+		{
+			name: "gitea - changes requested by one reviewer",
+			changeset: setGiteaReviews(giteaChangeset(daysAgo(0), gitea.PullRequestStateOpen, "title"),
+				&gitea.PullReview{User: &gitea.User{ID: 1}, State: gitea.PullReviewStateApproved},
+				&gitea.PullReview{User: &gitea.User{ID: 2}, State: gitea.PullReviewStateRequestChanges},
+			),
+			history: []changesetStatesAtTime{},
+			want:    btypes.ChangesetReviewStateChangesRequested,
+		},
+		{
+			name: "gitea - dismissed review",
+			changeset: setGiteaReviews(giteaChangeset(daysAgo(0), gitea.PullRequestStateOpen, "title"),
+				&gitea.PullReview{User: &gitea.User{ID: 1}, State: gitea.PullReviewStateRequestChanges, Dismissed: true},
+			),
+			history: []changesetStatesAtTime{},
+			want:    btypes.ChangesetReviewStatePending,
+		},
 	}
 
 	for i, tc := range tests {
@@ -888,6 +993,34 @@ func TestComputeExternalState(t *testing.T) {
 			},
 			want: btypes.ChangesetExternalStateReadOnly,
 		},
+		{
+			name:      "gitea open - no events",
+			changeset: giteaChangeset(daysAgo(10), gitea.PullRequestStateOpen, "title"),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetExternalStateOpen,
+		},
+		{
+			name:      "gitea draft - no events",
+			changeset: giteaChangeset(daysAgo(10), gitea.PullRequestStateOpen, "WIP: title"),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetExternalStateDraft,
+		},
+		{
+			name:      "gitea closed - no events",
+			changeset: giteaChangeset(daysAgo(10), gitea.PullRequestStateClosed, "title"),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetExternalStateClosed,
+		},
+		{
+			name: "gitea merged - no events",
+			changeset: func() *btypes.Changeset {
+				c := giteaChangeset(daysAgo(10), gitea.PullRequestStateClosed, "title")
+				c.Metadata.(*giteabatches.AnnotatedPullRequest).Merged = true
+				return c
+			}(),
+			history: []changesetStatesAtTime{},
+			want:    btypes.ChangesetExternalStateMerged,
+		},
 		{
 			name:      "perforce closed - no events",
 			changeset: perforceChangeset(daysAgo(10), perforce.ChangelistStateClosed),
@@ -943,6 +1076,67 @@ func TestComputeExternalState(t *testing.T) {
 	}
 }
 
+func TestCountApprovals(t *testing.T) {
+	t.Parallel()
+
+	now := timeutil.Now()
+	daysAgo := func(days int) time.Time { return now.AddDate(0, 0, -days) }
+
+	tests := []struct {
+		name   string
+		events ChangesetEvents
+		want   int
+	}{
+		{
+			name: "no events",
+			want: 0,
+		},
+		{
+			name: "approvals by different reviewers",
+			events: ChangesetEvents{
+				ghReview(1, daysAgo(3), "alice", "APPROVED"),
+				ghReview(1, daysAgo(2), "bob", "COMMENTED"),
+				ghReview(1, daysAgo(1), "carol", "APPROVED"),
+			},
+			want: 2,
+		},
+		{
+			name: "approval followed by changes requested",
+			events: ChangesetEvents{
+				ghReview(1, daysAgo(3), "alice", "APPROVED"),
+				ghReview(1, daysAgo(2), "bob", "APPROVED"),
+				ghReview(1, daysAgo(1), "alice", "CHANGES_REQUESTED"),
+			},
+			want: 1,
+		},
+		{
+			name: "dismissed approval",
+			events: ChangesetEvents{
+				ghReview(1, daysAgo(3), "alice", "APPROVED"),
+				ghReviewDismissed(1, daysAgo(2), "bob", "alice"),
+			},
+			want: 0,
+		},
+		{
+			name: "bitbucket server unapproval",
+			events: ChangesetEvents{
+				bbsParticipantEvent(1, daysAgo(3), "alice", btypes.ChangesetEventKindBitbucketServerApproved),
+				bbsParticipantEvent(1, daysAgo(2), "bob", btypes.ChangesetEventKindBitbucketServerApproved),
+				bbsParticipantEvent(1, daysAgo(1), "alice", btypes.ChangesetEventKindBitbucketServerUnapproved),
+			},
+			want: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			have, err := CountApprovals(tc.events)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
 func TestComputeLabels(t *testing.T) {
 	t.Parallel()
 
@@ -1085,6 +1279,24 @@ func perforceChangeset(updatedAt time.Time, state perforce.ChangelistState) *bty
 	}
 }
 
+func giteaChangeset(updatedAt time.Time, state, title string) *btypes.Changeset {
+	return &btypes.Changeset{
+		ExternalServiceType: extsvc.TypeGitea,
+		UpdatedAt:           updatedAt,
+		Metadata: &giteabatches.AnnotatedPullRequest{
+			PullRequest: &gitea.PullRequest{
+				State: state,
+				Title: title,
+			},
+		},
+	}
+}
+
+func setGiteaReviews(c *btypes.Changeset, reviews ...*gitea.PullReview) *btypes.Changeset {
+	c.Metadata.(*giteabatches.AnnotatedPullRequest).Reviews = reviews
+	return c
+}
+
 func setDeletedAt(c *btypes.Changeset, deletedAt time.Time) *btypes.Changeset {
 	c.ExternalDeletedAt = deletedAt
 	return c
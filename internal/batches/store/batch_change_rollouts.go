@@ -0,0 +1,414 @@
+package store
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/batches/global"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/batch"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// batchChangeRolloutInsertColumns is the list of batch_change_rollouts columns
+// that are modified in UpsertBatchChangeRollout.
+var batchChangeRolloutInsertColumns = SQLColumns{
+	"batch_change_id",
+	"wave_count",
+	"current_wave",
+	"paused",
+	"gate_message",
+	"created_at",
+	"updated_at",
+}
+
+// batchChangeRolloutColumns are used by the batch change rollout related Store
+// methods to query and create batch change rollouts.
+var batchChangeRolloutColumns = SQLColumns{
+	"batch_change_rollouts.batch_change_id",
+	"batch_change_rollouts.wave_count",
+	"batch_change_rollouts.current_wave",
+	"batch_change_rollouts.paused",
+	"batch_change_rollouts.gate_message",
+	"batch_change_rollouts.created_at",
+	"batch_change_rollouts.updated_at",
+}
+
+// UpsertBatchChangeRollout creates the given batch change rollout. If the
+// batch change already has a rollout, only its wave count is updated, so that
+// re-applying a batch spec doesn't restart the rollout. The current wave is
+// capped to the new wave count.
+func (s *Store) UpsertBatchChangeRollout(ctx context.Context, r *btypes.BatchChangeRollout) (err error) {
+	ctx, _, endObservation := s.operations.upsertBatchChangeRollout.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(r.BatchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if r.CreatedAt.IsZero() {
+		r.CreatedAt = s.now()
+	}
+
+	if r.UpdatedAt.IsZero() {
+		r.UpdatedAt = r.CreatedAt
+	}
+
+	q := sqlf.Sprintf(
+		upsertBatchChangeRolloutQueryFmtstr,
+		sqlf.Join(batchChangeRolloutInsertColumns.ToSqlf(), ", "),
+		r.BatchChangeID,
+		r.WaveCount,
+		r.CurrentWave,
+		r.Paused,
+		r.GateMessage,
+		r.CreatedAt,
+		r.UpdatedAt,
+		sqlf.Join(batchChangeRolloutColumns.ToSqlf(), ", "),
+	)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanBatchChangeRollout(r, sc)
+	})
+}
+
+var upsertBatchChangeRolloutQueryFmtstr = `
+INSERT INTO batch_change_rollouts (%s)
+VALUES ` + batchChangeRolloutInsertColumns.FmtStr() + `
+ON CONFLICT (batch_change_id)
+DO UPDATE SET
+	wave_count = EXCLUDED.wave_count,
+	current_wave = LEAST(batch_change_rollouts.current_wave, GREATEST(EXCLUDED.wave_count - 1, 0)),
+	updated_at = EXCLUDED.updated_at
+RETURNING %s
+`
+
+// UpdateBatchChangeRollout updates the current wave, the paused flag and the
+// gate message of the given batch change rollout.
+func (s *Store) UpdateBatchChangeRollout(ctx context.Context, r *btypes.BatchChangeRollout) (err error) {
+	ctx, _, endObservation := s.operations.updateBatchChangeRollout.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(r.BatchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	r.UpdatedAt = s.now()
+
+	q := sqlf.Sprintf(
+		updateBatchChangeRolloutQueryFmtstr,
+		r.CurrentWave,
+		r.Paused,
+		r.GateMessage,
+		r.UpdatedAt,
+		r.BatchChangeID,
+		sqlf.Join(batchChangeRolloutColumns.ToSqlf(), ", "),
+	)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanBatchChangeRollout(r, sc)
+	})
+}
+
+var updateBatchChangeRolloutQueryFmtstr = `
+UPDATE batch_change_rollouts
+SET
+	current_wave = %s,
+	paused = %s,
+	gate_message = %s,
+	updated_at = %s
+WHERE batch_change_id = %s
+RETURNING %s
+`
+
+// GetBatchChangeRollout gets the rollout of the batch change with the given
+// ID. ErrNoResults is returned if the batch change isn't rolled out in waves.
+func (s *Store) GetBatchChangeRollout(ctx context.Context, batchChangeID int64) (r *btypes.BatchChangeRollout, err error) {
+	ctx, _, endObservation := s.operations.getBatchChangeRollout.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(batchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		getBatchChangeRolloutQueryFmtstr,
+		sqlf.Join(batchChangeRolloutColumns.ToSqlf(), ", "),
+		batchChangeID,
+	)
+
+	var c btypes.BatchChangeRollout
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanBatchChangeRollout(&c, sc)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if c.BatchChangeID == 0 {
+		return nil, ErrNoResults
+	}
+
+	return &c, nil
+}
+
+var getBatchChangeRolloutQueryFmtstr = `
+SELECT %s FROM batch_change_rollouts
+WHERE batch_change_rollouts.batch_change_id = %s
+LIMIT 1
+`
+
+// ListBatchChangeRolloutsOpts captures the query options needed for listing
+// batch change rollouts.
+type ListBatchChangeRolloutsOpts struct {
+	// OnlyAdvanceable restricts the results to rollouts of open batch changes
+	// that aren't paused and have waves left to publish.
+	OnlyAdvanceable bool
+}
+
+// ListBatchChangeRollouts lists the batch change rollouts matching the given
+// options.
+func (s *Store) ListBatchChangeRollouts(ctx context.Context, opts ListBatchChangeRolloutsOpts) (rs []*btypes.BatchChangeRollout, err error) {
+	ctx, _, endObservation := s.operations.listBatchChangeRollouts.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	q := listBatchChangeRolloutsQuery(opts)
+
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		var r btypes.BatchChangeRollout
+		if err := scanBatchChangeRollout(&r, sc); err != nil {
+			return err
+		}
+		rs = append(rs, &r)
+		return nil
+	})
+
+	return rs, err
+}
+
+var listBatchChangeRolloutsQueryFmtstr = `
+SELECT %s FROM batch_change_rollouts
+JOIN batch_changes ON batch_changes.id = batch_change_rollouts.batch_change_id
+WHERE %s
+ORDER BY batch_change_rollouts.batch_change_id ASC
+`
+
+func listBatchChangeRolloutsQuery(opts ListBatchChangeRolloutsOpts) *sqlf.Query {
+	preds := []*sqlf.Query{
+		sqlf.Sprintf("TRUE"),
+	}
+
+	if opts.OnlyAdvanceable {
+		preds = append(preds,
+			sqlf.Sprintf("batch_changes.closed_at IS NULL"),
+			sqlf.Sprintf("NOT batch_change_rollouts.paused"),
+			sqlf.Sprintf("batch_change_rollouts.current_wave < batch_change_rollouts.wave_count - 1"),
+		)
+	}
+
+	return sqlf.Sprintf(
+		listBatchChangeRolloutsQueryFmtstr,
+		sqlf.Join(batchChangeRolloutColumns.ToSqlf(), ", "),
+		sqlf.Join(preds, "\n AND "),
+	)
+}
+
+// DeleteBatchChangeRollout deletes the rollout of the batch change with the
+// given ID, together with the wave assignments of its changesets. It doesn't
+// return an error if the batch change isn't rolled out in waves.
+func (s *Store) DeleteBatchChangeRollout(ctx context.Context, batchChangeID int64) (err error) {
+	ctx, _, endObservation := s.operations.deleteBatchChangeRollout.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(batchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.Exec(ctx, sqlf.Sprintf(deleteBatchChangeRolloutQueryFmtstr, batchChangeID))
+}
+
+var deleteBatchChangeRolloutQueryFmtstr = `
+DELETE FROM batch_change_rollouts WHERE batch_change_id = %s
+`
+
+// SetChangesetRolloutWaves replaces the wave assignments of the changesets of
+// the batch change with the given ID. waves maps changeset IDs to the
+// zero-based index of their wave. The batch change needs to have a rollout.
+func (s *Store) SetChangesetRolloutWaves(ctx context.Context, batchChangeID int64, waves map[int64]int32) (err error) {
+	ctx, _, endObservation := s.operations.setChangesetRolloutWaves.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(batchChangeID)),
+		attribute.Int("count", len(waves)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	tx, err := s.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	if err := tx.Exec(ctx, sqlf.Sprintf(deleteChangesetRolloutWavesQueryFmtstr, batchChangeID)); err != nil {
+		return err
+	}
+
+	return batch.WithInserter(
+		ctx,
+		tx.Handle(),
+		"changeset_rollout_waves",
+		batch.MaxNumPostgresParameters,
+		[]string{"changeset_id", "batch_change_id", "wave"},
+		func(inserter *batch.Inserter) error {
+			for changesetID, wave := range waves {
+				if err := inserter.Insert(ctx, changesetID, batchChangeID, wave); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
+
+var deleteChangesetRolloutWavesQueryFmtstr = `
+DELETE FROM changeset_rollout_waves WHERE batch_change_id = %s
+`
+
+// IsChangesetHeldByRollout returns true if the changeset with the given ID
+// must not be published yet, because its wave hasn't been reached or because
+// the rollout of its batch change is paused.
+func (s *Store) IsChangesetHeldByRollout(ctx context.Context, changesetID int64) (held bool, err error) {
+	ctx, _, endObservation := s.operations.isChangesetHeldByRollout.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("changesetID", int(changesetID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	held, _, err = basestore.ScanFirstBool(s.Query(ctx, sqlf.Sprintf(isChangesetHeldByRolloutQueryFmtstr, changesetID)))
+	return held, err
+}
+
+var isChangesetHeldByRolloutQueryFmtstr = `
+SELECT EXISTS (
+	SELECT 1
+	FROM changeset_rollout_waves
+	JOIN batch_change_rollouts ON batch_change_rollouts.batch_change_id = changeset_rollout_waves.batch_change_id
+	WHERE
+		changeset_rollout_waves.changeset_id = %s
+		AND (batch_change_rollouts.paused OR changeset_rollout_waves.wave > batch_change_rollouts.current_wave)
+)
+`
+
+// GetRolloutWaveStats returns the stats of the rollout waves of the batch
+// change with the given ID, ordered by wave. Waves without any changesets are
+// omitted.
+func (s *Store) GetRolloutWaveStats(ctx context.Context, batchChangeID int64) (stats []btypes.RolloutWaveStats, err error) {
+	ctx, _, endObservation := s.operations.getRolloutWaveStats.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(batchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		getRolloutWaveStatsQueryFmtstr,
+		btypes.ChangesetPublicationStatePublished,
+		btypes.ChangesetPublicationStatePublished,
+		btypes.ChangesetExternalStateMerged,
+		btypes.ChangesetPublicationStatePublished,
+		btypes.ChangesetExternalStateOpen,
+		btypes.ChangesetExternalStateDraft,
+		btypes.ChangesetCheckStateFailed,
+		btypes.ReconcilerStateQueued.ToDB(),
+		btypes.ReconcilerStateProcessing.ToDB(),
+		btypes.ReconcilerStateErrored.ToDB(),
+		btypes.ReconcilerStateScheduled.ToDB(),
+		batchChangeID,
+	)
+
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		var ws btypes.RolloutWaveStats
+		if err := sc.Scan(
+			&ws.Wave,
+			&ws.Total,
+			&ws.Published,
+			&ws.Merged,
+			&ws.FailingChecks,
+			&ws.Processing,
+		); err != nil {
+			return err
+		}
+		stats = append(stats, ws)
+		return nil
+	})
+
+	return stats, err
+}
+
+var getRolloutWaveStatsQueryFmtstr = `
+SELECT
+	changeset_rollout_waves.wave,
+	COUNT(*) AS total,
+	COUNT(*) FILTER (WHERE changesets.publication_state = %s) AS published,
+	COUNT(*) FILTER (WHERE changesets.publication_state = %s AND changesets.external_state = %s) AS merged,
+	COUNT(*) FILTER (WHERE changesets.publication_state = %s AND changesets.external_state IN (%s, %s) AND changesets.external_check_state = %s) AS failing_checks,
+	COUNT(*) FILTER (WHERE changesets.reconciler_state IN (%s, %s, %s, %s)) AS processing
+FROM changeset_rollout_waves
+JOIN changesets ON changesets.id = changeset_rollout_waves.changeset_id
+WHERE changeset_rollout_waves.batch_change_id = %s
+GROUP BY changeset_rollout_waves.wave
+ORDER BY changeset_rollout_waves.wave ASC
+`
+
+// EnqueueRolloutChangesets enqueues the unpublished changesets of the batch
+// change with the given ID that are in the waves up to and including the
+// given wave, so that the reconciler publishes the ones that were held back by
+// the rollout.
+func (s *Store) EnqueueRolloutChangesets(ctx context.Context, batchChangeID int64, upToWave int32) (err error) {
+	ctx, _, endObservation := s.operations.enqueueRolloutChangesets.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(batchChangeID)),
+		attribute.Int("upToWave", int(upToWave)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		enqueueRolloutChangesetsQueryFmtstr,
+		global.DefaultReconcilerEnqueueState().ToDB(),
+		batchChangeID,
+		upToWave,
+		btypes.ChangesetPublicationStateUnpublished,
+		btypes.ReconcilerStateCompleted.ToDB(),
+	)
+
+	return s.Exec(ctx, q)
+}
+
+var enqueueRolloutChangesetsQueryFmtstr = `
+UPDATE changesets
+SET
+	reconciler_state = %s,
+	failure_message = NULL,
+	num_resets = 0,
+	num_failures = 0,
+	updated_at = NOW()
+FROM changeset_rollout_waves
+WHERE
+	changeset_rollout_waves.changeset_id = changesets.id
+	AND changeset_rollout_waves.batch_change_id = %s
+	AND changeset_rollout_waves.wave <= %s
+	AND changesets.publication_state = %s
+	AND changesets.reconciler_state = %s
+`
+
+func scanBatchChangeRollout(r *btypes.BatchChangeRollout, s dbutil.Scanner) error {
+	var gateMessage string
+	if err := s.Scan(
+		&r.BatchChangeID,
+		&r.WaveCount,
+		&r.CurrentWave,
+		&r.Paused,
+		&dbutil.NullString{S: &gateMessage},
+		&r.CreatedAt,
+		&r.UpdatedAt,
+	); err != nil {
+		return err
+	}
+
+	r.GateMessage = nil
+	if gateMessage != "" {
+		r.GateMessage = &gateMessage
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/log/logtest"
+
+	bt "github.com/sourcegraph/sourcegraph/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func testStoreBatchChangeRollouts(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	logger := logtest.Scoped(t)
+	repoStore := database.ReposWith(logger, s)
+	esStore := database.ExternalServicesWith(logger, s)
+
+	repo := bt.TestRepo(t, esStore, extsvc.KindGitHub)
+	if err := repoStore.Create(ctx, repo); err != nil {
+		t.Fatal(err)
+	}
+
+	user := bt.CreateTestUser(t, s.DatabaseDB(), false)
+	spec := bt.CreateBatchSpec(t, ctx, s, "rollout", user.ID, 0)
+	batchChange := bt.CreateBatchChange(t, ctx, s, "rollout", user.ID, spec.ID)
+
+	published := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
+		Repo:               repo.ID,
+		BatchChange:        batchChange.ID,
+		OwnedByBatchChange: batchChange.ID,
+		PublicationState:   btypes.ChangesetPublicationStatePublished,
+		ExternalState:      btypes.ChangesetExternalStateMerged,
+		ReconcilerState:    btypes.ReconcilerStateCompleted,
+	})
+	failing := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
+		Repo:               repo.ID,
+		BatchChange:        batchChange.ID,
+		OwnedByBatchChange: batchChange.ID,
+		PublicationState:   btypes.ChangesetPublicationStatePublished,
+		ExternalState:      btypes.ChangesetExternalStateOpen,
+		ExternalCheckState: btypes.ChangesetCheckStateFailed,
+		ReconcilerState:    btypes.ReconcilerStateCompleted,
+	})
+	held := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
+		Repo:               repo.ID,
+		BatchChange:        batchChange.ID,
+		OwnedByBatchChange: batchChange.ID,
+		PublicationState:   btypes.ChangesetPublicationStateUnpublished,
+		ReconcilerState:    btypes.ReconcilerStateCompleted,
+	})
+
+	rollout := &btypes.BatchChangeRollout{BatchChangeID: batchChange.ID, WaveCount: 3}
+
+	t.Run("Get not found", func(t *testing.T) {
+		if _, err := s.GetBatchChangeRollout(ctx, batchChange.ID); err != ErrNoResults {
+			t.Fatalf("unexpected error: want=%v have=%v", ErrNoResults, err)
+		}
+	})
+
+	t.Run("Upsert", func(t *testing.T) {
+		if err := s.UpsertBatchChangeRollout(ctx, rollout); err != nil {
+			t.Fatal(err)
+		}
+		if !rollout.CreatedAt.Equal(clock.Now()) || !rollout.UpdatedAt.Equal(clock.Now()) {
+			t.Fatalf("unexpected timestamps: %s, %s", rollout.CreatedAt, rollout.UpdatedAt)
+		}
+
+		have, err := s.GetBatchChangeRollout(ctx, batchChange.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(rollout, have); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
+	t.Run("SetChangesetRolloutWaves", func(t *testing.T) {
+		waves := map[int64]int32{
+			published.ID: 0,
+			failing.ID:   0,
+			held.ID:      1,
+		}
+		if err := s.SetChangesetRolloutWaves(ctx, batchChange.ID, waves); err != nil {
+			t.Fatal(err)
+		}
+
+		for id, want := range map[int64]bool{published.ID: false, failing.ID: false, held.ID: true} {
+			have, err := s.IsChangesetHeldByRollout(ctx, id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if have != want {
+				t.Fatalf("unexpected held state for changeset %d: want=%t have=%t", id, want, have)
+			}
+		}
+	})
+
+	t.Run("GetRolloutWaveStats", func(t *testing.T) {
+		have, err := s.GetRolloutWaveStats(ctx, batchChange.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []btypes.RolloutWaveStats{
+			{Wave: 0, Total: 2, Published: 2, Merged: 1, FailingChecks: 1},
+			{Wave: 1, Total: 1},
+		}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
+	t.Run("List", func(t *testing.T) {
+		have, err := s.ListBatchChangeRollouts(ctx, ListBatchChangeRolloutsOpts{OnlyAdvanceable: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]*btypes.BatchChangeRollout{rollout}, have); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		clock.Add(1 * time.Minute)
+
+		rollout.Paused = true
+		rollout.GateMessage = pointers.Ptr("Waiting for 50% of published changesets to be merged.")
+		if err := s.UpdateBatchChangeRollout(ctx, rollout); err != nil {
+			t.Fatal(err)
+		}
+		if !rollout.UpdatedAt.Equal(clock.Now()) {
+			t.Fatalf("unexpected updated at: %s", rollout.UpdatedAt)
+		}
+
+		// Paused rollouts hold back all changesets and can't advance.
+		if isHeld, err := s.IsChangesetHeldByRollout(ctx, published.ID); err != nil {
+			t.Fatal(err)
+		} else if !isHeld {
+			t.Fatal("changeset of paused rollout not held")
+		}
+
+		have, err := s.ListBatchChangeRollouts(ctx, ListBatchChangeRolloutsOpts{OnlyAdvanceable: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(have) != 0 {
+			t.Fatalf("unexpected advanceable rollouts: %+v", have)
+		}
+	})
+
+	t.Run("EnqueueRolloutChangesets", func(t *testing.T) {
+		if err := s.EnqueueRolloutChangesets(ctx, batchChange.ID, 1); err != nil {
+			t.Fatal(err)
+		}
+
+		for id, want := range map[int64]btypes.ReconcilerState{
+			published.ID: btypes.ReconcilerStateCompleted,
+			failing.ID:   btypes.ReconcilerStateCompleted,
+			held.ID:      btypes.ReconcilerStateQueued,
+		} {
+			c, err := s.GetChangesetByID(ctx, id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.ReconcilerState != want {
+				t.Fatalf("unexpected reconciler state for changeset %d: want=%s have=%s", id, want, c.ReconcilerState)
+			}
+		}
+	})
+
+	t.Run("Upsert existing", func(t *testing.T) {
+		rollout.CurrentWave = 2
+		if err := s.UpdateBatchChangeRollout(ctx, rollout); err != nil {
+			t.Fatal(err)
+		}
+
+		// Re-applying with fewer waves keeps the paused state, but caps the
+		// current wave.
+		upserted := &btypes.BatchChangeRollout{BatchChangeID: batchChange.ID, WaveCount: 2}
+		if err := s.UpsertBatchChangeRollout(ctx, upserted); err != nil {
+			t.Fatal(err)
+		}
+		if upserted.CurrentWave != 1 {
+			t.Fatalf("unexpected current wave: %d", upserted.CurrentWave)
+		}
+		if !upserted.Paused {
+			t.Fatal("paused state not kept")
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		if err := s.DeleteBatchChangeRollout(ctx, batchChange.ID); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.GetBatchChangeRollout(ctx, batchChange.ID); err != ErrNoResults {
+			t.Fatalf("unexpected error: want=%v have=%v", ErrNoResults, err)
+		}
+		if isHeld, err := s.IsChangesetHeldByRollout(ctx, held.ID); err != nil {
+			t.Fatal(err)
+		} else if isHeld {
+			t.Fatal("changeset held after rollout was deleted")
+		}
+	})
+}
@@ -146,12 +146,16 @@ func (s *Store) CreateBatchSpecWorkspace(ctx context.Context, ws ...*btypes.Batc
 // GetBatchSpecWorkspaceOpts captures the query options needed for getting a BatchSpecWorkspace
 type GetBatchSpecWorkspaceOpts struct {
 	ID int64
+	// ChangesetSpecID, if set, only returns the workspace that created the
+	// changeset spec with the given ID.
+	ChangesetSpecID int64
 }
 
 // GetBatchSpecWorkspace gets a BatchSpecWorkspace matching the given options.
 func (s *Store) GetBatchSpecWorkspace(ctx context.Context, opts GetBatchSpecWorkspaceOpts) (job *btypes.BatchSpecWorkspace, err error) {
 	ctx, _, endObservation := s.operations.getBatchSpecWorkspace.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
 		attribute.Int("ID", int(opts.ID)),
+		attribute.Int("ChangesetSpecID", int(opts.ChangesetSpecID)),
 	}})
 	defer endObservation(1, observation.Args{})
 
@@ -181,7 +185,14 @@ LIMIT 1
 func getBatchSpecWorkspaceQuery(opts *GetBatchSpecWorkspaceOpts) *sqlf.Query {
 	preds := []*sqlf.Query{
 		sqlf.Sprintf("repo.deleted_at IS NULL"),
-		sqlf.Sprintf("batch_spec_workspaces.id = %s", opts.ID),
+	}
+
+	if opts.ID != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_spec_workspaces.id = %s", opts.ID))
+	}
+
+	if opts.ChangesetSpecID != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_spec_workspaces.changeset_spec_ids ? %s", opts.ChangesetSpecID))
 	}
 
 	return sqlf.Sprintf(
@@ -503,6 +514,53 @@ func (s *Store) DisableBatchSpecWorkspaceExecutionCache(ctx context.Context, bat
 	return s.Exec(ctx, q)
 }
 
+const rebaseBatchSpecWorkspaceQueryFmtstr = `
+UPDATE
+	batch_spec_workspaces
+SET
+	commit = %s,
+	step_cache_results = %s,
+	cached_result_found = FALSE,
+	skipped = FALSE,
+	updated_at = %s
+WHERE
+	id = %s
+RETURNING %s
+`
+
+// RebaseBatchSpecWorkspace points the given workspace at a new commit of its
+// branch, so that it's executed on that commit the next time an execution job
+// is created for it. The step cache results of the workspace are replaced with
+// the ones set on ws, which must have been computed for the new commit.
+func (s *Store) RebaseBatchSpecWorkspace(ctx context.Context, ws *btypes.BatchSpecWorkspace) (err error) {
+	ctx, _, endObservation := s.operations.rebaseBatchSpecWorkspace.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(ws.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	stepCacheResults := ws.StepCacheResults
+	if stepCacheResults == nil {
+		stepCacheResults = map[int]btypes.StepCacheResult{}
+	}
+	marshaledStepCacheResults, err := json.Marshal(stepCacheResults)
+	if err != nil {
+		return err
+	}
+
+	q := sqlf.Sprintf(
+		rebaseBatchSpecWorkspaceQueryFmtstr,
+		ws.Commit,
+		marshaledStepCacheResults,
+		s.now(),
+		ws.ID,
+		sqlf.Join(BatchSpecWorkspaceColums.ToSqlf(), ", "),
+	)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanBatchSpecWorkspace(ws, sc)
+	})
+}
+
 func scanBatchSpecWorkspace(wj *btypes.BatchSpecWorkspace, s dbutil.Scanner) error {
 	var stepCacheResults json.RawMessage
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/keegancsmith/sqlf"
@@ -107,6 +108,17 @@ func testStoreBatchSpecWorkspaces(t *testing.T, ctx context.Context, s *Store, c
 			}
 		})
 
+		t.Run("GetByChangesetSpecID", func(t *testing.T) {
+			have, err := s.GetBatchSpecWorkspace(ctx, GetBatchSpecWorkspaceOpts{ChangesetSpecID: workspaces[1].ChangesetSpecIDs[1]})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(have, workspaces[1]); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+
 		t.Run("NoResults", func(t *testing.T) {
 			opts := GetBatchSpecWorkspaceOpts{ID: 0xdeadbeef}
 
@@ -501,4 +513,46 @@ func testStoreBatchSpecWorkspaces(t *testing.T, ctx context.Context, s *Store, c
 		})
 		require.Error(t, err, ErrNoResults)
 	})
+
+	t.Run("RebaseBatchSpecWorkspace", func(t *testing.T) {
+		workspace := &btypes.BatchSpecWorkspace{
+			BatchSpecID:       9998,
+			RepoID:            repos[0].ID,
+			Branch:            "master",
+			Commit:            "d34db33f",
+			ChangesetSpecIDs:  []int64{42},
+			CachedResultFound: true,
+			Skipped:           true,
+			StepCacheResults: map[int]btypes.StepCacheResult{
+				2: {Key: "old", Value: &execution.AfterStepResult{StepIndex: 1}},
+			},
+		}
+		require.NoError(t, s.CreateBatchSpecWorkspace(ctx, workspace))
+
+		clock.Add(1 * time.Minute)
+
+		workspace.Commit = "c0ffee"
+		workspace.StepCacheResults = map[int]btypes.StepCacheResult{
+			1: {Key: "new", Value: &execution.AfterStepResult{StepIndex: 0}},
+		}
+		require.NoError(t, s.RebaseBatchSpecWorkspace(ctx, workspace))
+
+		want := &btypes.BatchSpecWorkspace{
+			ID:               workspace.ID,
+			BatchSpecID:      9998,
+			RepoID:           repos[0].ID,
+			Branch:           "master",
+			Commit:           "c0ffee",
+			ChangesetSpecIDs: []int64{42},
+			FileMatches:      []string{},
+			StepCacheResults: map[int]btypes.StepCacheResult{
+				1: {Key: "new", Value: &execution.AfterStepResult{StepIndex: 0}},
+			},
+			CreatedAt: workspace.CreatedAt,
+			UpdatedAt: clock.Now(),
+		}
+		if diff := cmp.Diff(want, workspace); diff != "" {
+			t.Fatalf("invalid workspace state: %s", diff)
+		}
+	})
 }
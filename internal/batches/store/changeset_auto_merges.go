@@ -0,0 +1,114 @@
+package store
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// changesetAutoMergeInsertColumns is the list of changeset_auto_merges columns
+// that are modified in UpsertChangesetAutoMerge.
+var changesetAutoMergeInsertColumns = SQLColumns{
+	"changeset_id",
+	"batch_change_id",
+	"merge_method",
+	"created_at",
+}
+
+// changesetAutoMergeColumns are used by the changeset auto-merge related Store
+// methods to query and create changeset auto-merges.
+var changesetAutoMergeColumns = SQLColumns{
+	"changeset_auto_merges.changeset_id",
+	"changeset_auto_merges.batch_change_id",
+	"changeset_auto_merges.merge_method",
+	"changeset_auto_merges.created_at",
+}
+
+// UpsertChangesetAutoMerge records that auto-merge has been enabled for the
+// given changeset. If a record already exists for the changeset, it is
+// overwritten.
+func (s *Store) UpsertChangesetAutoMerge(ctx context.Context, m *btypes.ChangesetAutoMerge) (err error) {
+	ctx, _, endObservation := s.operations.upsertChangesetAutoMerge.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("changesetID", int(m.ChangesetID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = s.now()
+	}
+
+	q := sqlf.Sprintf(
+		upsertChangesetAutoMergeQueryFmtstr,
+		sqlf.Join(changesetAutoMergeInsertColumns.ToSqlf(), ", "),
+		m.ChangesetID,
+		m.BatchChangeID,
+		m.MergeMethod,
+		m.CreatedAt,
+		sqlf.Join(changesetAutoMergeColumns.ToSqlf(), ", "),
+	)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanChangesetAutoMerge(m, sc)
+	})
+}
+
+var upsertChangesetAutoMergeQueryFmtstr = `
+INSERT INTO changeset_auto_merges (%s)
+VALUES ` + changesetAutoMergeInsertColumns.FmtStr() + `
+ON CONFLICT (changeset_id)
+DO UPDATE SET
+	batch_change_id = EXCLUDED.batch_change_id,
+	merge_method = EXCLUDED.merge_method,
+	created_at = EXCLUDED.created_at
+RETURNING %s
+`
+
+// GetChangesetAutoMerge gets the auto-merge record of the changeset with the
+// given ID. ErrNoResults is returned if auto-merge hasn't been enabled for the
+// changeset.
+func (s *Store) GetChangesetAutoMerge(ctx context.Context, changesetID int64) (m *btypes.ChangesetAutoMerge, err error) {
+	ctx, _, endObservation := s.operations.getChangesetAutoMerge.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("changesetID", int(changesetID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		getChangesetAutoMergeQueryFmtstr,
+		sqlf.Join(changesetAutoMergeColumns.ToSqlf(), ", "),
+		changesetID,
+	)
+
+	var c btypes.ChangesetAutoMerge
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanChangesetAutoMerge(&c, sc)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if c.ChangesetID == 0 {
+		return nil, ErrNoResults
+	}
+
+	return &c, nil
+}
+
+var getChangesetAutoMergeQueryFmtstr = `
+SELECT %s FROM changeset_auto_merges
+WHERE changeset_auto_merges.changeset_id = %s
+LIMIT 1
+`
+
+func scanChangesetAutoMerge(m *btypes.ChangesetAutoMerge, s dbutil.Scanner) error {
+	return s.Scan(
+		&m.ChangesetID,
+		&m.BatchChangeID,
+		&m.MergeMethod,
+		&m.CreatedAt,
+	)
+}
@@ -0,0 +1,83 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/log/logtest"
+
+	bt "github.com/sourcegraph/sourcegraph/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+)
+
+func testStoreChangesetAutoMerges(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	logger := logtest.Scoped(t)
+	repoStore := database.ReposWith(logger, s)
+	esStore := database.ExternalServicesWith(logger, s)
+
+	repo := bt.TestRepo(t, esStore, extsvc.KindGitHub)
+	if err := repoStore.Create(ctx, repo); err != nil {
+		t.Fatal(err)
+	}
+
+	changeset := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{Repo: repo.ID})
+
+	t.Run("Get not found", func(t *testing.T) {
+		if _, err := s.GetChangesetAutoMerge(ctx, changeset.ID); err != ErrNoResults {
+			t.Fatalf("unexpected error: want=%v have=%v", ErrNoResults, err)
+		}
+	})
+
+	t.Run("Upsert", func(t *testing.T) {
+		m := &btypes.ChangesetAutoMerge{
+			ChangesetID:   changeset.ID,
+			BatchChangeID: 910,
+			MergeMethod:   batcheslib.AutoMergeMethodMerge,
+		}
+		if err := s.UpsertChangesetAutoMerge(ctx, m); err != nil {
+			t.Fatal(err)
+		}
+
+		want := &btypes.ChangesetAutoMerge{
+			ChangesetID:   changeset.ID,
+			BatchChangeID: 910,
+			MergeMethod:   batcheslib.AutoMergeMethodMerge,
+			CreatedAt:     clock.Now(),
+		}
+		if diff := cmp.Diff(want, m); diff != "" {
+			t.Fatal(diff)
+		}
+
+		have, err := s.GetChangesetAutoMerge(ctx, changeset.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Fatal(diff)
+		}
+
+		// Upserting again overwrites the merge method.
+		m = &btypes.ChangesetAutoMerge{
+			ChangesetID:   changeset.ID,
+			BatchChangeID: 910,
+			MergeMethod:   batcheslib.AutoMergeMethodSquash,
+		}
+		if err := s.UpsertChangesetAutoMerge(ctx, m); err != nil {
+			t.Fatal(err)
+		}
+
+		have, err = s.GetChangesetAutoMerge(ctx, changeset.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want.MergeMethod = batcheslib.AutoMergeMethodSquash
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+}
@@ -0,0 +1,230 @@
+package store
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// changesetRebaseInsertColumns is the list of changeset_rebases columns that
+// are modified in CreateChangesetRebase.
+var changesetRebaseInsertColumns = SQLColumns{
+	"bulk_group",
+	"changeset_id",
+	"batch_spec_workspace_id",
+	"base_rev",
+	"state",
+	"failure_message",
+	"created_at",
+	"updated_at",
+}
+
+// changesetRebaseColumns are used by the changeset rebase related Store
+// methods to query and create changeset rebases.
+var changesetRebaseColumns = SQLColumns{
+	"changeset_rebases.id",
+	"changeset_rebases.bulk_group",
+	"changeset_rebases.changeset_id",
+	"changeset_rebases.batch_spec_workspace_id",
+	"changeset_rebases.base_rev",
+	"changeset_rebases.state",
+	"changeset_rebases.failure_message",
+	"changeset_rebases.created_at",
+	"changeset_rebases.updated_at",
+}
+
+// CreateChangesetRebase creates the given changeset rebase.
+func (s *Store) CreateChangesetRebase(ctx context.Context, r *btypes.ChangesetRebase) (err error) {
+	ctx, _, endObservation := s.operations.createChangesetRebase.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("changesetID", int(r.ChangesetID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if r.CreatedAt.IsZero() {
+		r.CreatedAt = s.now()
+	}
+
+	if r.UpdatedAt.IsZero() {
+		r.UpdatedAt = r.CreatedAt
+	}
+
+	if r.State == "" {
+		r.State = btypes.ChangesetRebaseStateExecuting
+	}
+
+	q := sqlf.Sprintf(
+		createChangesetRebaseQueryFmtstr,
+		sqlf.Join(changesetRebaseInsertColumns.ToSqlf(), ", "),
+		r.BulkGroup,
+		r.ChangesetID,
+		r.BatchSpecWorkspaceID,
+		r.BaseRev,
+		r.State,
+		r.FailureMessage,
+		r.CreatedAt,
+		r.UpdatedAt,
+		sqlf.Join(changesetRebaseColumns.ToSqlf(), ", "),
+	)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanChangesetRebase(r, sc)
+	})
+}
+
+var createChangesetRebaseQueryFmtstr = `
+INSERT INTO changeset_rebases (%s)
+VALUES ` + changesetRebaseInsertColumns.FmtStr() + `
+RETURNING %s
+`
+
+// UpdateChangesetRebase updates the state and failure message of the given
+// changeset rebase.
+func (s *Store) UpdateChangesetRebase(ctx context.Context, r *btypes.ChangesetRebase) (err error) {
+	ctx, _, endObservation := s.operations.updateChangesetRebase.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(r.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	r.UpdatedAt = s.now()
+
+	q := sqlf.Sprintf(
+		updateChangesetRebaseQueryFmtstr,
+		r.State,
+		r.FailureMessage,
+		r.UpdatedAt,
+		r.ID,
+		sqlf.Join(changesetRebaseColumns.ToSqlf(), ", "),
+	)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanChangesetRebase(r, sc)
+	})
+}
+
+var updateChangesetRebaseQueryFmtstr = `
+UPDATE changeset_rebases
+SET
+	state = %s,
+	failure_message = %s,
+	updated_at = %s
+WHERE id = %s
+RETURNING %s
+`
+
+// GetExecutingChangesetRebase gets the changeset rebase that is waiting for
+// the execution of the given workspace. ErrNoResults is returned if there is
+// none.
+func (s *Store) GetExecutingChangesetRebase(ctx context.Context, workspaceID int64) (r *btypes.ChangesetRebase, err error) {
+	ctx, _, endObservation := s.operations.getExecutingChangesetRebase.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("workspaceID", int(workspaceID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		getExecutingChangesetRebaseQueryFmtstr,
+		sqlf.Join(changesetRebaseColumns.ToSqlf(), ", "),
+		workspaceID,
+		btypes.ChangesetRebaseStateExecuting,
+	)
+
+	var c btypes.ChangesetRebase
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanChangesetRebase(&c, sc)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if c.ID == 0 {
+		return nil, ErrNoResults
+	}
+
+	return &c, nil
+}
+
+var getExecutingChangesetRebaseQueryFmtstr = `
+SELECT %s FROM changeset_rebases
+WHERE
+	changeset_rebases.batch_spec_workspace_id = %s
+	AND changeset_rebases.state = %s
+ORDER BY changeset_rebases.id DESC
+LIMIT 1
+`
+
+// ListChangesetRebasesOpts captures the query options needed for listing
+// changeset rebases.
+type ListChangesetRebasesOpts struct {
+	BulkGroup string
+}
+
+// ListChangesetRebases lists the changeset rebases matching the given options.
+func (s *Store) ListChangesetRebases(ctx context.Context, opts ListChangesetRebasesOpts) (rs []*btypes.ChangesetRebase, err error) {
+	ctx, _, endObservation := s.operations.listChangesetRebases.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("bulkGroup", opts.BulkGroup),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := listChangesetRebasesQuery(opts)
+
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		var r btypes.ChangesetRebase
+		if err := scanChangesetRebase(&r, sc); err != nil {
+			return err
+		}
+		rs = append(rs, &r)
+		return nil
+	})
+
+	return rs, err
+}
+
+var listChangesetRebasesQueryFmtstr = `
+SELECT %s FROM changeset_rebases
+WHERE %s
+ORDER BY changeset_rebases.id ASC
+`
+
+func listChangesetRebasesQuery(opts ListChangesetRebasesOpts) *sqlf.Query {
+	preds := []*sqlf.Query{
+		sqlf.Sprintf("TRUE"),
+	}
+
+	if opts.BulkGroup != "" {
+		preds = append(preds, sqlf.Sprintf("changeset_rebases.bulk_group = %s", opts.BulkGroup))
+	}
+
+	return sqlf.Sprintf(
+		listChangesetRebasesQueryFmtstr,
+		sqlf.Join(changesetRebaseColumns.ToSqlf(), ", "),
+		sqlf.Join(preds, "\n AND "),
+	)
+}
+
+func scanChangesetRebase(r *btypes.ChangesetRebase, s dbutil.Scanner) error {
+	var failureMessage string
+	if err := s.Scan(
+		&r.ID,
+		&r.BulkGroup,
+		&r.ChangesetID,
+		&r.BatchSpecWorkspaceID,
+		&r.BaseRev,
+		&r.State,
+		&dbutil.NullString{S: &failureMessage},
+		&r.CreatedAt,
+		&r.UpdatedAt,
+	); err != nil {
+		return err
+	}
+
+	r.FailureMessage = nil
+	if failureMessage != "" {
+		r.FailureMessage = &failureMessage
+	}
+
+	return nil
+}
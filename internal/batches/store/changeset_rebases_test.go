@@ -0,0 +1,106 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/log/logtest"
+
+	bt "github.com/sourcegraph/sourcegraph/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func testStoreChangesetRebases(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	logger := logtest.Scoped(t)
+	repoStore := database.ReposWith(logger, s)
+	esStore := database.ExternalServicesWith(logger, s)
+
+	repo := bt.TestRepo(t, esStore, extsvc.KindGitHub)
+	if err := repoStore.Create(ctx, repo); err != nil {
+		t.Fatal(err)
+	}
+
+	changeset := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{Repo: repo.ID})
+
+	rebases := []*btypes.ChangesetRebase{
+		{BulkGroup: "group-1", ChangesetID: changeset.ID, BatchSpecWorkspaceID: 123, BaseRev: "c0ffee"},
+		{BulkGroup: "group-1", ChangesetID: changeset.ID, BatchSpecWorkspaceID: 456, BaseRev: "c0ffee"},
+		{BulkGroup: "group-2", ChangesetID: changeset.ID, BatchSpecWorkspaceID: 123, BaseRev: "d34db33f"},
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		for _, r := range rebases {
+			if err := s.CreateChangesetRebase(ctx, r); err != nil {
+				t.Fatal(err)
+			}
+
+			if r.ID == 0 {
+				t.Fatal("ID should not be zero")
+			}
+			if r.State != btypes.ChangesetRebaseStateExecuting {
+				t.Fatalf("unexpected state: %s", r.State)
+			}
+			if !r.CreatedAt.Equal(clock.Now()) || !r.UpdatedAt.Equal(clock.Now()) {
+				t.Fatalf("unexpected timestamps: %s, %s", r.CreatedAt, r.UpdatedAt)
+			}
+		}
+	})
+
+	t.Run("GetExecuting", func(t *testing.T) {
+		// The most recent rebase for the workspace is returned.
+		have, err := s.GetExecutingChangesetRebase(ctx, 123)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(rebases[2], have); diff != "" {
+			t.Fatal(diff)
+		}
+
+		if _, err := s.GetExecutingChangesetRebase(ctx, 789); err != ErrNoResults {
+			t.Fatalf("unexpected error: want=%v have=%v", ErrNoResults, err)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		clock.Add(1 * time.Minute)
+
+		r := rebases[1]
+		r.State = btypes.ChangesetRebaseStateFailed
+		r.FailureMessage = pointers.Ptr("merge conflict")
+		if err := s.UpdateChangesetRebase(ctx, r); err != nil {
+			t.Fatal(err)
+		}
+		if !r.UpdatedAt.Equal(clock.Now()) {
+			t.Fatalf("unexpected updated at: %s", r.UpdatedAt)
+		}
+
+		// Failed rebases are no longer executing.
+		if _, err := s.GetExecutingChangesetRebase(ctx, 456); err != ErrNoResults {
+			t.Fatalf("unexpected error: want=%v have=%v", ErrNoResults, err)
+		}
+	})
+
+	t.Run("List", func(t *testing.T) {
+		have, err := s.ListChangesetRebases(ctx, ListChangesetRebasesOpts{BulkGroup: "group-1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(rebases[:2], have); diff != "" {
+			t.Fatal(diff)
+		}
+
+		have, err = s.ListChangesetRebases(ctx, ListChangesetRebasesOpts{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(rebases, have); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+}
@@ -45,6 +45,10 @@ var changesetSpecInsertColumns = []string{
 	"commit_author_name",
 	"commit_author_email",
 	"type",
+	"labels",
+	"reviewers",
+	"team_reviewers",
+	"assignees",
 }
 
 // changesetSpecColumns are used by the changeset spec related Store methods to
@@ -72,6 +76,10 @@ var changesetSpecColumns = SQLColumns{
 	"changeset_specs.commit_author_name",
 	"changeset_specs.commit_author_email",
 	"changeset_specs.type",
+	"changeset_specs.labels",
+	"changeset_specs.reviewers",
+	"changeset_specs.team_reviewers",
+	"changeset_specs.assignees",
 }
 
 var oneGigabyte = 1000000000
@@ -137,6 +145,10 @@ func (s *Store) CreateChangesetSpec(ctx context.Context, cs ...*btypes.Changeset
 				dbutil.NewNullString(c.CommitAuthorName),
 				dbutil.NewNullString(c.CommitAuthorEmail),
 				c.Type,
+				pq.Array(nonNilStrings(c.Labels)),
+				pq.Array(nonNilStrings(c.Reviewers)),
+				pq.Array(nonNilStrings(c.TeamReviewers)),
+				pq.Array(nonNilStrings(c.Assignees)),
 			); err != nil {
 				return err
 			}
@@ -563,6 +575,10 @@ func scanChangesetSpec(c *btypes.ChangesetSpec, s dbutil.Scanner) error {
 		&dbutil.NullString{S: &c.CommitAuthorName},
 		&dbutil.NullString{S: &c.CommitAuthorEmail},
 		&typ,
+		pq.Array(&c.Labels),
+		pq.Array(&c.Reviewers),
+		pq.Array(&c.TeamReviewers),
+		pq.Array(&c.Assignees),
 	)
 	if err != nil {
 		return errors.Wrap(err, "scanning changeset spec")
@@ -570,6 +586,12 @@ func scanChangesetSpec(c *btypes.ChangesetSpec, s dbutil.Scanner) error {
 
 	c.Type = btypes.ChangesetSpecType(typ)
 
+	for _, list := range []*[]string{&c.Labels, &c.Reviewers, &c.TeamReviewers, &c.Assignees} {
+		if len(*list) == 0 {
+			*list = nil
+		}
+	}
+
 	if len(published) != 0 {
 		if err := json.Unmarshal(published, &c.Published); err != nil {
 			return err
@@ -579,6 +601,15 @@ func scanChangesetSpec(c *btypes.ChangesetSpec, s dbutil.Scanner) error {
 	return nil
 }
 
+// nonNilStrings returns an empty slice if s is nil, so that it's stored as an
+// empty array instead of NULL.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
 type GetRewirerMappingsOpts struct {
 	BatchSpecID   int64
 	BatchChangeID int64
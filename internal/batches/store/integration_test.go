@@ -36,6 +36,9 @@ func TestIntegration(t *testing.T) {
 		t.Run("CodeHosts", storeTest(db, nil, testStoreCodeHost))
 		t.Run("UserDeleteCascades", storeTest(db, nil, testUserDeleteCascades))
 		t.Run("ChangesetJobs", storeTest(db, nil, testStoreChangesetJobs))
+		t.Run("ChangesetAutoMerges", storeTest(db, nil, testStoreChangesetAutoMerges))
+		t.Run("ChangesetRebases", storeTest(db, nil, testStoreChangesetRebases))
+		t.Run("BatchChangeRollouts", storeTest(db, nil, testStoreBatchChangeRollouts))
 		t.Run("BulkOperations", storeTest(db, nil, testStoreBulkOperations))
 		t.Run("BatchSpecWorkspaces", storeTest(db, nil, testStoreBatchSpecWorkspaces))
 		t.Run("BatchSpecWorkspaceExecutionJobs", storeTest(db, nil, testStoreBatchSpecWorkspaceExecutionJobs))
@@ -222,6 +222,24 @@ type operations struct {
 	createChangesetJob *observation.Operation
 	getChangesetJob    *observation.Operation
 
+	upsertChangesetAutoMerge *observation.Operation
+	getChangesetAutoMerge    *observation.Operation
+
+	createChangesetRebase       *observation.Operation
+	updateChangesetRebase       *observation.Operation
+	getExecutingChangesetRebase *observation.Operation
+	listChangesetRebases        *observation.Operation
+
+	upsertBatchChangeRollout *observation.Operation
+	updateBatchChangeRollout *observation.Operation
+	getBatchChangeRollout    *observation.Operation
+	listBatchChangeRollouts  *observation.Operation
+	deleteBatchChangeRollout *observation.Operation
+	setChangesetRolloutWaves *observation.Operation
+	isChangesetHeldByRollout *observation.Operation
+	getRolloutWaveStats      *observation.Operation
+	enqueueRolloutChangesets *observation.Operation
+
 	createChangesetSpec                      *observation.Operation
 	updateChangesetSpecBatchSpecID           *observation.Operation
 	deleteChangesetSpec                      *observation.Operation
@@ -271,6 +289,7 @@ type operations struct {
 	countBatchSpecWorkspaces       *observation.Operation
 	markSkippedBatchSpecWorkspaces *observation.Operation
 	listRetryBatchSpecWorkspaces   *observation.Operation
+	rebaseBatchSpecWorkspace       *observation.Operation
 
 	createBatchSpecWorkspaceExecutionJobs              *observation.Operation
 	createBatchSpecWorkspaceExecutionJobsForWorkspaces *observation.Operation
@@ -367,6 +386,24 @@ func newOperations(observationCtx *observation.Context) *operations {
 			createChangesetJob: op("CreateChangesetJob"),
 			getChangesetJob:    op("GetChangesetJob"),
 
+			upsertChangesetAutoMerge: op("UpsertChangesetAutoMerge"),
+			getChangesetAutoMerge:    op("GetChangesetAutoMerge"),
+
+			createChangesetRebase:       op("CreateChangesetRebase"),
+			updateChangesetRebase:       op("UpdateChangesetRebase"),
+			getExecutingChangesetRebase: op("GetExecutingChangesetRebase"),
+			listChangesetRebases:        op("ListChangesetRebases"),
+
+			upsertBatchChangeRollout: op("UpsertBatchChangeRollout"),
+			updateBatchChangeRollout: op("UpdateBatchChangeRollout"),
+			getBatchChangeRollout:    op("GetBatchChangeRollout"),
+			listBatchChangeRollouts:  op("ListBatchChangeRollouts"),
+			deleteBatchChangeRollout: op("DeleteBatchChangeRollout"),
+			setChangesetRolloutWaves: op("SetChangesetRolloutWaves"),
+			isChangesetHeldByRollout: op("IsChangesetHeldByRollout"),
+			getRolloutWaveStats:      op("GetRolloutWaveStats"),
+			enqueueRolloutChangesets: op("EnqueueRolloutChangesets"),
+
 			createChangesetSpec:                      op("CreateChangesetSpec"),
 			updateChangesetSpecBatchSpecID:           op("UpdateChangesetSpecBatchSpecID"),
 			deleteChangesetSpec:                      op("DeleteChangesetSpec"),
@@ -416,6 +453,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 			countBatchSpecWorkspaces:       op("CountBatchSpecWorkspaces"),
 			markSkippedBatchSpecWorkspaces: op("MarkSkippedBatchSpecWorkspaces"),
 			listRetryBatchSpecWorkspaces:   op("ListRetryBatchSpecWorkspaces"),
+			rebaseBatchSpecWorkspace:       op("RebaseBatchSpecWorkspace"),
 
 			createBatchSpecWorkspaceExecutionJobs:              op("CreateBatchSpecWorkspaceExecutionJobs"),
 			createBatchSpecWorkspaceExecutionJobsForWorkspaces: op("CreateBatchSpecWorkspaceExecutionJobsForWorkspaces"),
@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/batches/global"
 	"github.com/sourcegraph/sourcegraph/internal/batches/store/author"
 	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/database"
@@ -22,6 +24,7 @@ import (
 	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
 	"github.com/sourcegraph/sourcegraph/lib/batches/execution/cache"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
 )
 
 // batchSpecWorkspaceExecutionJobStalledJobMaximumAge is the maximum allowable
@@ -79,7 +82,7 @@ type batchSpecWorkspaceExecutionWorkerStore struct {
 
 type markFinal func(ctx context.Context, tx dbworkerstore.Store[*btypes.BatchSpecWorkspaceExecutionJob]) (_ bool, err error)
 
-func (s *batchSpecWorkspaceExecutionWorkerStore) markFinal(ctx context.Context, id int, fn markFinal) (ok bool, err error) {
+func (s *batchSpecWorkspaceExecutionWorkerStore) markFinal(ctx context.Context, id int, failureMessage string, fn markFinal) (ok bool, err error) {
 	batchesStore := New(database.NewDBWith(s.logger, s.Store), s.observationCtx, nil)
 	tx, err := batchesStore.Transact(ctx)
 	if err != nil {
@@ -126,17 +129,21 @@ func (s *batchSpecWorkspaceExecutionWorkerStore) markFinal(ctx context.Context,
 		return false, err
 	}
 
+	if err := failChangesetRebase(ctx, tx, workspace.ID, failureMessage); err != nil {
+		return false, err
+	}
+
 	return fn(ctx, s.Store.With(tx))
 }
 
 func (s *batchSpecWorkspaceExecutionWorkerStore) MarkErrored(ctx context.Context, id int, failureMessage string, options dbworkerstore.MarkFinalOptions) (_ bool, err error) {
-	return s.markFinal(ctx, id, func(ctx context.Context, tx dbworkerstore.Store[*btypes.BatchSpecWorkspaceExecutionJob]) (bool, error) {
+	return s.markFinal(ctx, id, failureMessage, func(ctx context.Context, tx dbworkerstore.Store[*btypes.BatchSpecWorkspaceExecutionJob]) (bool, error) {
 		return tx.MarkErrored(ctx, id, failureMessage, options)
 	})
 }
 
 func (s *batchSpecWorkspaceExecutionWorkerStore) MarkFailed(ctx context.Context, id int, failureMessage string, options dbworkerstore.MarkFinalOptions) (_ bool, err error) {
-	return s.markFinal(ctx, id, func(ctx context.Context, tx dbworkerstore.Store[*btypes.BatchSpecWorkspaceExecutionJob]) (bool, error) {
+	return s.markFinal(ctx, id, failureMessage, func(ctx context.Context, tx dbworkerstore.Store[*btypes.BatchSpecWorkspaceExecutionJob]) (bool, error) {
 		return tx.MarkFailed(ctx, id, failureMessage, options)
 	})
 }
@@ -262,9 +269,77 @@ func (s *batchSpecWorkspaceExecutionWorkerStore) MarkComplete(ctx context.Contex
 		return false, errors.Wrap(err, "setChangesetSpecIDs")
 	}
 
+	if err := completeChangesetRebase(ctx, tx, job.BatchSpecWorkspaceID, specs); err != nil {
+		return false, errors.Wrap(err, "completing changeset rebase")
+	}
+
 	return s.Store.With(tx).MarkComplete(ctx, id, options)
 }
 
+// completeChangesetRebase checks whether the workspace has been re-executed
+// by a rebase bulk operation. If so, it attaches the changeset spec built for
+// the branch of the rebased changeset to it and enqueues the changeset, so
+// that the reconciler force-pushes the rebased commit.
+func completeChangesetRebase(ctx context.Context, tx *Store, workspaceID int64, specs []*btypes.ChangesetSpec) error {
+	rebase, err := tx.GetExecutingChangesetRebase(ctx, workspaceID)
+	if err != nil {
+		if err == ErrNoResults {
+			return nil
+		}
+		return err
+	}
+
+	changeset, err := tx.GetChangeset(ctx, GetChangesetOpts{ID: rebase.ChangesetID})
+	if err != nil {
+		return errors.Wrap(err, "loading changeset")
+	}
+
+	currentSpec, err := tx.GetChangesetSpecByID(ctx, changeset.CurrentSpecID)
+	if err != nil {
+		return errors.Wrap(err, "loading current changeset spec")
+	}
+
+	var rebasedSpec *btypes.ChangesetSpec
+	for _, spec := range specs {
+		if spec.HeadRef == currentSpec.HeadRef {
+			rebasedSpec = spec
+			break
+		}
+	}
+
+	if rebasedSpec == nil {
+		rebase.State = btypes.ChangesetRebaseStateFailed
+		rebase.FailureMessage = pointers.Ptr(fmt.Sprintf("re-executing the workspace didn't produce changes for branch %q", currentSpec.HeadRef))
+		return tx.UpdateChangesetRebase(ctx, rebase)
+	}
+
+	changeset.PreviousSpecID = changeset.CurrentSpecID
+	changeset.CurrentSpecID = rebasedSpec.ID
+	changeset.ResetReconcilerState(global.DefaultReconcilerEnqueueState())
+	if err := tx.UpdateChangeset(ctx, changeset); err != nil {
+		return errors.Wrap(err, "updating changeset")
+	}
+
+	rebase.State = btypes.ChangesetRebaseStatePushed
+	return tx.UpdateChangesetRebase(ctx, rebase)
+}
+
+// failChangesetRebase marks the changeset rebase waiting for the execution of
+// the given workspace, if any, as failed.
+func failChangesetRebase(ctx context.Context, tx *Store, workspaceID int64, failureMessage string) error {
+	rebase, err := tx.GetExecutingChangesetRebase(ctx, workspaceID)
+	if err != nil {
+		if err == ErrNoResults {
+			return nil
+		}
+		return err
+	}
+
+	rebase.State = btypes.ChangesetRebaseStateFailed
+	rebase.FailureMessage = &failureMessage
+	return tx.UpdateChangesetRebase(ctx, rebase)
+}
+
 func (s *batchSpecWorkspaceExecutionWorkerStore) setChangesetSpecIDs(ctx context.Context, tx *Store, batchSpecWorkspaceID int64, changesetSpecIDs []int64) error {
 	// Marshal changeset spec IDs for database JSON column.
 	m := make(map[int64]struct{}, len(changesetSpecIDs))
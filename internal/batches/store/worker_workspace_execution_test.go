@@ -198,6 +198,74 @@ stdout: {"operation":"CACHE_AFTER_STEP_RESULT","timestamp":"2021-11-04T12:43:19.
 		}
 	})
 
+	t.Run("rebase", func(t *testing.T) {
+		job, workspace := setupEntities(t)
+		setProcessing(t, job)
+
+		// The batch spec has no branch set in its changesetTemplate, so that's
+		// the head ref of the changeset specs built by MarkComplete.
+		currentSpec := &btypes.ChangesetSpec{BatchSpecID: batchSpec.ID, BaseRepoID: repo.ID, UserID: user.ID, HeadRef: "refs/heads/"}
+		if err := s.CreateChangesetSpec(ctx, currentSpec); err != nil {
+			t.Fatal(err)
+		}
+		changeset := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
+			Repo:            repo.ID,
+			CurrentSpec:     currentSpec.ID,
+			ReconcilerState: btypes.ReconcilerStateCompleted,
+		})
+		// The changeset references the changeset specs of the batch spec, which
+		// are deleted by the next test.
+		t.Cleanup(func() {
+			if err := s.DeleteChangeset(ctx, changeset.ID); err != nil {
+				t.Fatal(err)
+			}
+		})
+		rebase := &btypes.ChangesetRebase{
+			BulkGroup:            "rebase",
+			ChangesetID:          changeset.ID,
+			BatchSpecWorkspaceID: workspace.ID,
+			BaseRev:              "c0ffee",
+		}
+		if err := s.CreateChangesetRebase(ctx, rebase); err != nil {
+			t.Fatal(err)
+		}
+
+		ok, err := executionStore.MarkComplete(context.Background(), int(job.ID), opts)
+		if !ok || err != nil {
+			t.Fatalf("MarkComplete failed. ok=%t, err=%s", ok, err)
+		}
+
+		reloadedWorkspace, err := s.GetBatchSpecWorkspace(ctx, GetBatchSpecWorkspaceOpts{ID: workspace.ID})
+		if err != nil {
+			t.Fatalf("failed to reload workspace: %s", err)
+		}
+		if len(reloadedWorkspace.ChangesetSpecIDs) != 1 {
+			t.Fatalf("invalid number of changeset specs created: %v", reloadedWorkspace.ChangesetSpecIDs)
+		}
+
+		reloadedChangeset, err := s.GetChangesetByID(ctx, changeset.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have, want := reloadedChangeset.CurrentSpecID, reloadedWorkspace.ChangesetSpecIDs[0]; have != want {
+			t.Fatalf("wrong current spec: have=%d want=%d", have, want)
+		}
+		if have, want := reloadedChangeset.PreviousSpecID, currentSpec.ID; have != want {
+			t.Fatalf("wrong previous spec: have=%d want=%d", have, want)
+		}
+		if have, want := reloadedChangeset.ReconcilerState, btypes.ReconcilerStateQueued; have != want {
+			t.Fatalf("wrong reconciler state: have=%s want=%s", have, want)
+		}
+
+		rebases, err := s.ListChangesetRebases(ctx, ListChangesetRebasesOpts{BulkGroup: "rebase"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have, want := rebases[0].State, btypes.ChangesetRebaseStatePushed; have != want {
+			t.Fatalf("wrong rebase state: have=%s want=%s", have, want)
+		}
+	})
+
 	t.Run("worker hostname mismatch", func(t *testing.T) {
 		job, _ := setupEntities(t)
 		setProcessing(t, job)
@@ -0,0 +1,171 @@
+package syncer
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/batches/sources"
+	"github.com/sourcegraph/sourcegraph/internal/batches/state"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/batches/types/scheduler/window"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// autoMergeAction is the action the syncer takes to apply the auto-merge
+// policy of a batch change to one of its changesets.
+type autoMergeAction int
+
+const (
+	// autoMergeActionNone means that the changeset must not be merged yet.
+	autoMergeActionNone autoMergeAction = iota
+	// autoMergeActionEnable means that auto-merge must be enabled on the code
+	// host, which merges the changeset once its checks have passed.
+	autoMergeActionEnable
+	// autoMergeActionMerge means that the changeset must be merged right away.
+	autoMergeActionMerge
+)
+
+// planAutoMerge determines what needs to be done to apply the given auto-merge
+// policy to the changeset at the given time. native is true if the code host
+// supports auto-merge natively.
+func planAutoMerge(policy *batcheslib.AutoMergePolicy, c *btypes.Changeset, events []*btypes.ChangesetEvent, native bool, now time.Time) (autoMergeAction, error) {
+	if policy == nil || c.ExternalState != btypes.ChangesetExternalStateOpen {
+		return autoMergeActionNone, nil
+	}
+
+	open, err := autoMergeWindowOpen(policy.Windows, now)
+	if err != nil {
+		return autoMergeActionNone, err
+	}
+	if !open {
+		return autoMergeActionNone, nil
+	}
+
+	if policy.RequiredApprovals > 0 {
+		sorted := make(state.ChangesetEvents, len(events))
+		copy(sorted, events)
+		sort.Sort(sorted)
+
+		approvals, err := state.CountApprovals(sorted)
+		if err != nil {
+			return autoMergeActionNone, errors.Wrap(err, "counting approvals")
+		}
+		if approvals < policy.RequiredApprovals {
+			return autoMergeActionNone, nil
+		}
+	}
+
+	if policy.ChecksRequired() {
+		// Prefer letting the code host merge the changeset once its checks
+		// have passed, so that we don't depend on the next sync to merge it.
+		// The code host doesn't know about the windows though, so we only
+		// do that if the policy has none.
+		if native && len(policy.Windows) == 0 {
+			return autoMergeActionEnable, nil
+		}
+		if c.ExternalCheckState != btypes.ChangesetCheckStatePassed {
+			return autoMergeActionNone, nil
+		}
+	}
+
+	if !native && policy.Method() == batcheslib.AutoMergeMethodRebase {
+		return autoMergeActionNone, errors.New("the rebase merge method is only supported on code hosts with native auto-merge")
+	}
+	return autoMergeActionMerge, nil
+}
+
+// autoMergeWindowOpen returns true if changesets may be merged at the given
+// time according to the given windows.
+func autoMergeWindowOpen(windows []batcheslib.AutoMergeWindow, now time.Time) (bool, error) {
+	if len(windows) == 0 {
+		return true, nil
+	}
+
+	raw := make([]*schema.BatchChangeRolloutWindow, 0, len(windows))
+	for _, w := range windows {
+		raw = append(raw, &schema.BatchChangeRolloutWindow{
+			Days:  w.Days,
+			Start: w.Start,
+			End:   w.End,
+			Rate:  "unlimited",
+		})
+	}
+	cfg, err := window.NewConfiguration(&raw)
+	if err != nil {
+		return false, errors.Wrap(err, "parsing auto-merge windows")
+	}
+	return cfg.IsOpen(now), nil
+}
+
+// autoMergeChangeset applies the auto-merge policy of the batch change that
+// owns the given changeset, if any. It returns true if the changeset has been
+// modified on the code host, in which case its derived state needs to be
+// recomputed.
+func autoMergeChangeset(ctx context.Context, syncStore SyncStore, source sources.ChangesetSource, cs *sources.Changeset, events []*btypes.ChangesetEvent) (bool, error) {
+	c := cs.Changeset
+	if c.IsImported() || c.IsDeleted() || c.ExternalState != btypes.ChangesetExternalStateOpen {
+		return false, nil
+	}
+
+	batchChange, err := syncStore.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: c.OwnedByBatchChangeID})
+	if err != nil {
+		return false, errors.Wrap(err, "getting batch change")
+	}
+	if batchChange.Closed() {
+		return false, nil
+	}
+
+	batchSpec, err := syncStore.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: batchChange.BatchSpecID})
+	if err != nil {
+		return false, errors.Wrap(err, "getting batch spec")
+	}
+	policy := batchSpec.Spec.AutoMerge
+	if policy == nil {
+		return false, nil
+	}
+
+	autoMergeSource, native := source.(sources.AutoMergeChangesetSource)
+	action, err := planAutoMerge(policy, c, events, native, syncStore.Clock()())
+	if err != nil {
+		return false, err
+	}
+
+	switch action {
+	case autoMergeActionEnable:
+		// Don't enable auto-merge again if it has already been enabled with
+		// the same merge method and is still enabled. The code host disables
+		// it on its own, for example when new commits are pushed or checks
+		// fail, in which case it needs to be enabled again.
+		existing, err := syncStore.GetChangesetAutoMerge(ctx, c.ID)
+		if err != nil && err != store.ErrNoResults {
+			return false, errors.Wrap(err, "getting changeset auto-merge")
+		}
+		if existing != nil && existing.MergeMethod == policy.Method() && c.AutoMergeEnabled() {
+			return false, nil
+		}
+
+		if err := autoMergeSource.EnableChangesetAutoMerge(ctx, cs, policy.Method()); err != nil {
+			return false, errors.Wrap(err, "enabling auto-merge")
+		}
+		if err := syncStore.UpsertChangesetAutoMerge(ctx, &btypes.ChangesetAutoMerge{
+			ChangesetID:   c.ID,
+			BatchChangeID: batchChange.ID,
+			MergeMethod:   policy.Method(),
+		}); err != nil {
+			return true, errors.Wrap(err, "recording changeset auto-merge")
+		}
+		return true, nil
+
+	case autoMergeActionMerge:
+		if err := source.MergeChangeset(ctx, cs, policy.Method() == batcheslib.AutoMergeMethodSquash); err != nil {
+			return false, errors.Wrap(err, "merging changeset")
+		}
+		return true, nil
+	}
+
+	return false, nil
+}
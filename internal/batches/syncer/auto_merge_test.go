@@ -0,0 +1,158 @@
+package syncer
+
+import (
+	"testing"
+	"time"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func TestPlanAutoMerge(t *testing.T) {
+	// A Monday.
+	now := time.Date(2024, 8, 19, 10, 0, 0, 0, time.UTC)
+
+	review := func(at time.Time, login, state string) *btypes.ChangesetEvent {
+		return &btypes.ChangesetEvent{
+			Kind: btypes.ChangesetEventKindGitHubReviewed,
+			Metadata: &github.PullRequestReview{
+				UpdatedAt: at,
+				State:     state,
+				Author:    github.Actor{Login: login},
+			},
+		}
+	}
+
+	open := &btypes.Changeset{
+		ExternalState:      btypes.ChangesetExternalStateOpen,
+		ExternalCheckState: btypes.ChangesetCheckStatePassed,
+	}
+	pending := &btypes.Changeset{
+		ExternalState:      btypes.ChangesetExternalStateOpen,
+		ExternalCheckState: btypes.ChangesetCheckStatePending,
+	}
+	draft := &btypes.Changeset{
+		ExternalState:      btypes.ChangesetExternalStateDraft,
+		ExternalCheckState: btypes.ChangesetCheckStatePassed,
+	}
+
+	tests := []struct {
+		name      string
+		policy    *batcheslib.AutoMergePolicy
+		changeset *btypes.Changeset
+		events    []*btypes.ChangesetEvent
+		native    bool
+		want      autoMergeAction
+		wantErr   bool
+	}{
+		{
+			name:      "no policy",
+			changeset: open,
+			want:      autoMergeActionNone,
+		},
+		{
+			name:      "draft changeset",
+			policy:    &batcheslib.AutoMergePolicy{},
+			changeset: draft,
+			want:      autoMergeActionNone,
+		},
+		{
+			name:      "checks passed",
+			policy:    &batcheslib.AutoMergePolicy{},
+			changeset: open,
+			want:      autoMergeActionMerge,
+		},
+		{
+			name:      "checks pending",
+			policy:    &batcheslib.AutoMergePolicy{},
+			changeset: pending,
+			want:      autoMergeActionNone,
+		},
+		{
+			name:      "checks pending with native auto-merge",
+			policy:    &batcheslib.AutoMergePolicy{},
+			changeset: pending,
+			native:    true,
+			want:      autoMergeActionEnable,
+		},
+		{
+			name:      "checks not required",
+			policy:    &batcheslib.AutoMergePolicy{RequirePassingChecks: pointers.Ptr(false)},
+			changeset: pending,
+			native:    true,
+			want:      autoMergeActionMerge,
+		},
+		{
+			name:      "not enough approvals",
+			policy:    &batcheslib.AutoMergePolicy{RequiredApprovals: 2},
+			changeset: open,
+			events: []*btypes.ChangesetEvent{
+				review(now.Add(-2*time.Hour), "alice", "APPROVED"),
+				review(now.Add(-2*time.Hour), "bob", "CHANGES_REQUESTED"),
+			},
+			want: autoMergeActionNone,
+		},
+		{
+			name:      "enough approvals",
+			policy:    &batcheslib.AutoMergePolicy{RequiredApprovals: 2},
+			changeset: open,
+			events: []*btypes.ChangesetEvent{
+				review(now.Add(-2*time.Hour), "alice", "APPROVED"),
+				review(now.Add(-2*time.Hour), "bob", "CHANGES_REQUESTED"),
+				review(now.Add(-1*time.Hour), "bob", "APPROVED"),
+			},
+			want: autoMergeActionMerge,
+		},
+		{
+			name: "inside window",
+			policy: &batcheslib.AutoMergePolicy{Windows: []batcheslib.AutoMergeWindow{
+				{Days: []string{"monday"}, Start: "09:00", End: "17:00"},
+			}},
+			changeset: open,
+			want:      autoMergeActionMerge,
+		},
+		{
+			name: "outside window",
+			policy: &batcheslib.AutoMergePolicy{Windows: []batcheslib.AutoMergeWindow{
+				{Days: []string{"saturday", "sunday"}},
+			}},
+			changeset: open,
+			want:      autoMergeActionNone,
+		},
+		{
+			name: "windows are not handed to the code host",
+			policy: &batcheslib.AutoMergePolicy{Windows: []batcheslib.AutoMergeWindow{
+				{Days: []string{"monday"}},
+			}},
+			changeset: pending,
+			native:    true,
+			want:      autoMergeActionNone,
+		},
+		{
+			name:      "rebase without native auto-merge",
+			policy:    &batcheslib.AutoMergePolicy{MergeMethod: batcheslib.AutoMergeMethodRebase},
+			changeset: open,
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			have, err := planAutoMerge(tc.policy, tc.changeset, tc.events, tc.native, now)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("unexpected nil error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if have != tc.want {
+				t.Errorf("wrong action: have=%d want=%d", have, tc.want)
+			}
+		})
+	}
+}
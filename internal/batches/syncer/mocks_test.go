@@ -33,9 +33,15 @@ type MockSyncStore struct {
 	// GetBatchChangeFunc is an instance of a mock function object
 	// controlling the behavior of the method GetBatchChange.
 	GetBatchChangeFunc *SyncStoreGetBatchChangeFunc
+	// GetBatchSpecFunc is an instance of a mock function object controlling
+	// the behavior of the method GetBatchSpec.
+	GetBatchSpecFunc *SyncStoreGetBatchSpecFunc
 	// GetChangesetFunc is an instance of a mock function object controlling
 	// the behavior of the method GetChangeset.
 	GetChangesetFunc *SyncStoreGetChangesetFunc
+	// GetChangesetAutoMergeFunc is an instance of a mock function object
+	// controlling the behavior of the method GetChangesetAutoMerge.
+	GetChangesetAutoMergeFunc *SyncStoreGetChangesetAutoMergeFunc
 	// GetChangesetSpecByIDFunc is an instance of a mock function object
 	// controlling the behavior of the method GetChangesetSpecByID.
 	GetChangesetSpecByIDFunc *SyncStoreGetChangesetSpecByIDFunc
@@ -67,6 +73,9 @@ type MockSyncStore struct {
 	// object controlling the behavior of the method
 	// UpdateChangesetCodeHostState.
 	UpdateChangesetCodeHostStateFunc *SyncStoreUpdateChangesetCodeHostStateFunc
+	// UpsertChangesetAutoMergeFunc is an instance of a mock function object
+	// controlling the behavior of the method UpsertChangesetAutoMerge.
+	UpsertChangesetAutoMergeFunc *SyncStoreUpsertChangesetAutoMergeFunc
 	// UpsertChangesetEventsFunc is an instance of a mock function object
 	// controlling the behavior of the method UpsertChangesetEvents.
 	UpsertChangesetEventsFunc *SyncStoreUpsertChangesetEventsFunc
@@ -99,11 +108,21 @@ func NewMockSyncStore() *MockSyncStore {
 				return
 			},
 		},
+		GetBatchSpecFunc: &SyncStoreGetBatchSpecFunc{
+			defaultHook: func(context.Context, store.GetBatchSpecOpts) (r0 *types.BatchSpec, r1 error) {
+				return
+			},
+		},
 		GetChangesetFunc: &SyncStoreGetChangesetFunc{
 			defaultHook: func(context.Context, store.GetChangesetOpts) (r0 *types.Changeset, r1 error) {
 				return
 			},
 		},
+		GetChangesetAutoMergeFunc: &SyncStoreGetChangesetAutoMergeFunc{
+			defaultHook: func(context.Context, int64) (r0 *types.ChangesetAutoMerge, r1 error) {
+				return
+			},
+		},
 		GetChangesetSpecByIDFunc: &SyncStoreGetChangesetSpecByIDFunc{
 			defaultHook: func(context.Context, int64) (r0 *types.ChangesetSpec, r1 error) {
 				return
@@ -154,6 +173,11 @@ func NewMockSyncStore() *MockSyncStore {
 				return
 			},
 		},
+		UpsertChangesetAutoMergeFunc: &SyncStoreUpsertChangesetAutoMergeFunc{
+			defaultHook: func(context.Context, *types.ChangesetAutoMerge) (r0 error) {
+				return
+			},
+		},
 		UpsertChangesetEventsFunc: &SyncStoreUpsertChangesetEventsFunc{
 			defaultHook: func(context.Context, ...*types.ChangesetEvent) (r0 error) {
 				return
@@ -191,11 +215,21 @@ func NewStrictMockSyncStore() *MockSyncStore {
 				panic("unexpected invocation of MockSyncStore.GetBatchChange")
 			},
 		},
+		GetBatchSpecFunc: &SyncStoreGetBatchSpecFunc{
+			defaultHook: func(context.Context, store.GetBatchSpecOpts) (*types.BatchSpec, error) {
+				panic("unexpected invocation of MockSyncStore.GetBatchSpec")
+			},
+		},
 		GetChangesetFunc: &SyncStoreGetChangesetFunc{
 			defaultHook: func(context.Context, store.GetChangesetOpts) (*types.Changeset, error) {
 				panic("unexpected invocation of MockSyncStore.GetChangeset")
 			},
 		},
+		GetChangesetAutoMergeFunc: &SyncStoreGetChangesetAutoMergeFunc{
+			defaultHook: func(context.Context, int64) (*types.ChangesetAutoMerge, error) {
+				panic("unexpected invocation of MockSyncStore.GetChangesetAutoMerge")
+			},
+		},
 		GetChangesetSpecByIDFunc: &SyncStoreGetChangesetSpecByIDFunc{
 			defaultHook: func(context.Context, int64) (*types.ChangesetSpec, error) {
 				panic("unexpected invocation of MockSyncStore.GetChangesetSpecByID")
@@ -246,6 +280,11 @@ func NewStrictMockSyncStore() *MockSyncStore {
 				panic("unexpected invocation of MockSyncStore.UpdateChangesetCodeHostState")
 			},
 		},
+		UpsertChangesetAutoMergeFunc: &SyncStoreUpsertChangesetAutoMergeFunc{
+			defaultHook: func(context.Context, *types.ChangesetAutoMerge) error {
+				panic("unexpected invocation of MockSyncStore.UpsertChangesetAutoMerge")
+			},
+		},
 		UpsertChangesetEventsFunc: &SyncStoreUpsertChangesetEventsFunc{
 			defaultHook: func(context.Context, ...*types.ChangesetEvent) error {
 				panic("unexpected invocation of MockSyncStore.UpsertChangesetEvents")
@@ -275,9 +314,15 @@ func NewMockSyncStoreFrom(i SyncStore) *MockSyncStore {
 		GetBatchChangeFunc: &SyncStoreGetBatchChangeFunc{
 			defaultHook: i.GetBatchChange,
 		},
+		GetBatchSpecFunc: &SyncStoreGetBatchSpecFunc{
+			defaultHook: i.GetBatchSpec,
+		},
 		GetChangesetFunc: &SyncStoreGetChangesetFunc{
 			defaultHook: i.GetChangeset,
 		},
+		GetChangesetAutoMergeFunc: &SyncStoreGetChangesetAutoMergeFunc{
+			defaultHook: i.GetChangesetAutoMerge,
+		},
 		GetChangesetSpecByIDFunc: &SyncStoreGetChangesetSpecByIDFunc{
 			defaultHook: i.GetChangesetSpecByID,
 		},
@@ -308,6 +353,9 @@ func NewMockSyncStoreFrom(i SyncStore) *MockSyncStore {
 		UpdateChangesetCodeHostStateFunc: &SyncStoreUpdateChangesetCodeHostStateFunc{
 			defaultHook: i.UpdateChangesetCodeHostState,
 		},
+		UpsertChangesetAutoMergeFunc: &SyncStoreUpsertChangesetAutoMergeFunc{
+			defaultHook: i.UpsertChangesetAutoMerge,
+		},
 		UpsertChangesetEventsFunc: &SyncStoreUpsertChangesetEventsFunc{
 			defaultHook: i.UpsertChangesetEvents,
 		},
@@ -720,6 +768,114 @@ func (c SyncStoreGetBatchChangeFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// SyncStoreGetBatchSpecFunc describes the behavior when the GetBatchSpec
+// method of the parent MockSyncStore instance is invoked.
+type SyncStoreGetBatchSpecFunc struct {
+	defaultHook func(context.Context, store.GetBatchSpecOpts) (*types.BatchSpec, error)
+	hooks       []func(context.Context, store.GetBatchSpecOpts) (*types.BatchSpec, error)
+	history     []SyncStoreGetBatchSpecFuncCall
+	mutex       sync.Mutex
+}
+
+// GetBatchSpec delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSyncStore) GetBatchSpec(v0 context.Context, v1 store.GetBatchSpecOpts) (*types.BatchSpec, error) {
+	r0, r1 := m.GetBatchSpecFunc.nextHook()(v0, v1)
+	m.GetBatchSpecFunc.appendCall(SyncStoreGetBatchSpecFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetBatchSpec method
+// of the parent MockSyncStore instance is invoked and the hook queue is
+// empty.
+func (f *SyncStoreGetBatchSpecFunc) SetDefaultHook(hook func(context.Context, store.GetBatchSpecOpts) (*types.BatchSpec, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetBatchSpec method of the parent MockSyncStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *SyncStoreGetBatchSpecFunc) PushHook(hook func(context.Context, store.GetBatchSpecOpts) (*types.BatchSpec, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *SyncStoreGetBatchSpecFunc) SetDefaultReturn(r0 *types.BatchSpec, r1 error) {
+	f.SetDefaultHook(func(context.Context, store.GetBatchSpecOpts) (*types.BatchSpec, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *SyncStoreGetBatchSpecFunc) PushReturn(r0 *types.BatchSpec, r1 error) {
+	f.PushHook(func(context.Context, store.GetBatchSpecOpts) (*types.BatchSpec, error) {
+		return r0, r1
+	})
+}
+
+func (f *SyncStoreGetBatchSpecFunc) nextHook() func(context.Context, store.GetBatchSpecOpts) (*types.BatchSpec, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SyncStoreGetBatchSpecFunc) appendCall(r0 SyncStoreGetBatchSpecFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SyncStoreGetBatchSpecFuncCall objects
+// describing the invocations of this function.
+func (f *SyncStoreGetBatchSpecFunc) History() []SyncStoreGetBatchSpecFuncCall {
+	f.mutex.Lock()
+	history := make([]SyncStoreGetBatchSpecFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SyncStoreGetBatchSpecFuncCall is an object that describes an invocation
+// of method GetBatchSpec on an instance of MockSyncStore.
+type SyncStoreGetBatchSpecFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 store.GetBatchSpecOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *types.BatchSpec
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SyncStoreGetBatchSpecFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SyncStoreGetBatchSpecFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // SyncStoreGetChangesetFunc describes the behavior when the GetChangeset
 // method of the parent MockSyncStore instance is invoked.
 type SyncStoreGetChangesetFunc struct {
@@ -828,6 +984,116 @@ func (c SyncStoreGetChangesetFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// SyncStoreGetChangesetAutoMergeFunc describes the behavior when the
+// GetChangesetAutoMerge method of the parent MockSyncStore instance is
+// invoked.
+type SyncStoreGetChangesetAutoMergeFunc struct {
+	defaultHook func(context.Context, int64) (*types.ChangesetAutoMerge, error)
+	hooks       []func(context.Context, int64) (*types.ChangesetAutoMerge, error)
+	history     []SyncStoreGetChangesetAutoMergeFuncCall
+	mutex       sync.Mutex
+}
+
+// GetChangesetAutoMerge delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockSyncStore) GetChangesetAutoMerge(v0 context.Context, v1 int64) (*types.ChangesetAutoMerge, error) {
+	r0, r1 := m.GetChangesetAutoMergeFunc.nextHook()(v0, v1)
+	m.GetChangesetAutoMergeFunc.appendCall(SyncStoreGetChangesetAutoMergeFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetChangesetAutoMerge method of the parent MockSyncStore instance is
+// invoked and the hook queue is empty.
+func (f *SyncStoreGetChangesetAutoMergeFunc) SetDefaultHook(hook func(context.Context, int64) (*types.ChangesetAutoMerge, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetChangesetAutoMerge method of the parent MockSyncStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *SyncStoreGetChangesetAutoMergeFunc) PushHook(hook func(context.Context, int64) (*types.ChangesetAutoMerge, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *SyncStoreGetChangesetAutoMergeFunc) SetDefaultReturn(r0 *types.ChangesetAutoMerge, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (*types.ChangesetAutoMerge, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *SyncStoreGetChangesetAutoMergeFunc) PushReturn(r0 *types.ChangesetAutoMerge, r1 error) {
+	f.PushHook(func(context.Context, int64) (*types.ChangesetAutoMerge, error) {
+		return r0, r1
+	})
+}
+
+func (f *SyncStoreGetChangesetAutoMergeFunc) nextHook() func(context.Context, int64) (*types.ChangesetAutoMerge, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SyncStoreGetChangesetAutoMergeFunc) appendCall(r0 SyncStoreGetChangesetAutoMergeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SyncStoreGetChangesetAutoMergeFuncCall
+// objects describing the invocations of this function.
+func (f *SyncStoreGetChangesetAutoMergeFunc) History() []SyncStoreGetChangesetAutoMergeFuncCall {
+	f.mutex.Lock()
+	history := make([]SyncStoreGetChangesetAutoMergeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SyncStoreGetChangesetAutoMergeFuncCall is an object that describes an
+// invocation of method GetChangesetAutoMerge on an instance of
+// MockSyncStore.
+type SyncStoreGetChangesetAutoMergeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *types.ChangesetAutoMerge
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SyncStoreGetChangesetAutoMergeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SyncStoreGetChangesetAutoMergeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // SyncStoreGetChangesetSpecByIDFunc describes the behavior when the
 // GetChangesetSpecByID method of the parent MockSyncStore instance is
 // invoked.
@@ -1895,6 +2161,114 @@ func (c SyncStoreUpdateChangesetCodeHostStateFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// SyncStoreUpsertChangesetAutoMergeFunc describes the behavior when the
+// UpsertChangesetAutoMerge method of the parent MockSyncStore instance is
+// invoked.
+type SyncStoreUpsertChangesetAutoMergeFunc struct {
+	defaultHook func(context.Context, *types.ChangesetAutoMerge) error
+	hooks       []func(context.Context, *types.ChangesetAutoMerge) error
+	history     []SyncStoreUpsertChangesetAutoMergeFuncCall
+	mutex       sync.Mutex
+}
+
+// UpsertChangesetAutoMerge delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockSyncStore) UpsertChangesetAutoMerge(v0 context.Context, v1 *types.ChangesetAutoMerge) error {
+	r0 := m.UpsertChangesetAutoMergeFunc.nextHook()(v0, v1)
+	m.UpsertChangesetAutoMergeFunc.appendCall(SyncStoreUpsertChangesetAutoMergeFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpsertChangesetAutoMerge method of the parent MockSyncStore instance is
+// invoked and the hook queue is empty.
+func (f *SyncStoreUpsertChangesetAutoMergeFunc) SetDefaultHook(hook func(context.Context, *types.ChangesetAutoMerge) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpsertChangesetAutoMerge method of the parent MockSyncStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SyncStoreUpsertChangesetAutoMergeFunc) PushHook(hook func(context.Context, *types.ChangesetAutoMerge) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *SyncStoreUpsertChangesetAutoMergeFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, *types.ChangesetAutoMerge) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *SyncStoreUpsertChangesetAutoMergeFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, *types.ChangesetAutoMerge) error {
+		return r0
+	})
+}
+
+func (f *SyncStoreUpsertChangesetAutoMergeFunc) nextHook() func(context.Context, *types.ChangesetAutoMerge) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SyncStoreUpsertChangesetAutoMergeFunc) appendCall(r0 SyncStoreUpsertChangesetAutoMergeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SyncStoreUpsertChangesetAutoMergeFuncCall
+// objects describing the invocations of this function.
+func (f *SyncStoreUpsertChangesetAutoMergeFunc) History() []SyncStoreUpsertChangesetAutoMergeFuncCall {
+	f.mutex.Lock()
+	history := make([]SyncStoreUpsertChangesetAutoMergeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SyncStoreUpsertChangesetAutoMergeFuncCall is an object that describes an
+// invocation of method UpsertChangesetAutoMerge on an instance of
+// MockSyncStore.
+type SyncStoreUpsertChangesetAutoMergeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *types.ChangesetAutoMerge
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SyncStoreUpsertChangesetAutoMergeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SyncStoreUpsertChangesetAutoMergeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // SyncStoreUpsertChangesetEventsFunc describes the behavior when the
 // UpsertChangesetEvents method of the parent MockSyncStore instance is
 // invoked.
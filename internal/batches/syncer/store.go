@@ -28,4 +28,7 @@ type SyncStore interface {
 	GetBatchChange(ctx context.Context, opts store.GetBatchChangeOpts) (*btypes.BatchChange, error)
 	GitHubAppsStore() ghastore.GitHubAppsStore
 	GetChangesetSpecByID(ctx context.Context, id int64) (*btypes.ChangesetSpec, error)
+	GetBatchSpec(ctx context.Context, opts store.GetBatchSpecOpts) (*btypes.BatchSpec, error)
+	GetChangesetAutoMerge(ctx context.Context, changesetID int64) (*btypes.ChangesetAutoMerge, error)
+	UpsertChangesetAutoMerge(ctx context.Context, m *btypes.ChangesetAutoMerge) error
 }
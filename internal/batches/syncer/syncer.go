@@ -537,6 +537,18 @@ func SyncChangeset(ctx context.Context, syncStore SyncStore, client gitserver.Cl
 	}
 	state.SetDerivedState(ctx, syncStore.Repos(), client, c, events)
 
+	// Apply the auto-merge policy of the batch change owning the changeset.
+	// Failing to do so shouldn't prevent the changeset from being synced, so
+	// the error is only surfaced as the syncer error.
+	changed, autoMergeErr := autoMergeChangeset(ctx, syncStore, source, repoChangeset, events)
+	if changed {
+		events, err = c.Events()
+		if err != nil {
+			return err
+		}
+		state.SetDerivedState(ctx, syncStore.Repos(), client, c, events)
+	}
+
 	tx, err := syncStore.Transact(ctx)
 	if err != nil {
 		return err
@@ -545,6 +557,10 @@ func SyncChangeset(ctx context.Context, syncStore SyncStore, client gitserver.Cl
 
 	// Reset syncer error message state.
 	c.SyncErrorMessage = nil
+	if autoMergeErr != nil {
+		errMsg := "auto-merge: " + autoMergeErr.Error()
+		c.SyncErrorMessage = &errMsg
+	}
 
 	err = tx.UpdateChangesetCodeHostState(ctx, c)
 	if err != nil {
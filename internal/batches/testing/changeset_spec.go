@@ -39,6 +39,10 @@ type TestSpecOpts struct {
 	BaseRev string
 	BaseRef string
 
+	Labels    []string
+	Reviewers []string
+	Assignees []string
+
 	Typ btypes.ChangesetSpecType
 }
 
@@ -74,6 +78,9 @@ func BuildChangesetSpec(t *testing.T, opts TestSpecOpts) *btypes.ChangesetSpec {
 		CommitAuthorName:  opts.CommitAuthorName,
 		DiffStatAdded:     TestChangsetSpecDiffStat.Added,
 		DiffStatDeleted:   TestChangsetSpecDiffStat.Deleted,
+		Labels:            opts.Labels,
+		Reviewers:         opts.Reviewers,
+		Assignees:         opts.Assignees,
 		Type:              opts.Typ,
 	}
 
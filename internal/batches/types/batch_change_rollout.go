@@ -0,0 +1,45 @@
+package types
+
+import "time"
+
+// BatchChangeRollout is the state of the rollout of a batch change whose
+// changesets are published in waves, as described by the rollout policy of
+// its batch spec.
+type BatchChangeRollout struct {
+	BatchChangeID int64
+	// WaveCount is the number of waves the changesets of the batch change have
+	// been assigned to.
+	WaveCount int32
+	// CurrentWave is the zero-based index of the wave whose changesets may
+	// currently be published. The changesets of all previous waves may be
+	// published as well.
+	CurrentWave int32
+	// Paused rollouts don't publish any changesets and don't advance to the
+	// next wave until they are resumed.
+	Paused bool
+	// GateMessage explains why the rollout hasn't advanced to the next wave
+	// yet, if the gate has been evaluated and didn't pass.
+	GateMessage *string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Done returns true if the changesets of all waves may be published.
+func (r *BatchChangeRollout) Done() bool {
+	return r.CurrentWave >= r.WaveCount-1
+}
+
+// RolloutWaveStats summarizes the state of the changesets in a rollout wave.
+type RolloutWaveStats struct {
+	Wave  int32
+	Total int32
+	// Published is the number of changesets that have been published,
+	// regardless of their external state.
+	Published int32
+	Merged    int32
+	// FailingChecks is the number of open changesets whose checks failed.
+	FailingChecks int32
+	// Processing is the number of changesets that the reconciler hasn't
+	// finished processing yet.
+	Processing int32
+}
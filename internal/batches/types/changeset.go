@@ -15,11 +15,13 @@ import (
 	adobatches "github.com/sourcegraph/sourcegraph/internal/batches/sources/azuredevops"
 	bbcs "github.com/sourcegraph/sourcegraph/internal/batches/sources/bitbucketcloud"
 	gerritbatches "github.com/sourcegraph/sourcegraph/internal/batches/sources/gerrit"
+	giteabatches "github.com/sourcegraph/sourcegraph/internal/batches/sources/gitea"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
@@ -468,6 +470,20 @@ func (c *Changeset) SetMetadata(meta any) error {
 		c.ExternalServiceType = extsvc.TypeGerrit
 		c.ExternalBranch = gitdomain.EnsureRefPrefix(pr.Change.Branch)
 		c.ExternalUpdatedAt = pr.Change.Updated
+	case *giteabatches.AnnotatedPullRequest:
+		c.Metadata = pr
+		c.ExternalID = strconv.FormatInt(pr.Index, 10)
+		c.ExternalServiceType = extsvc.TypeGitea
+		c.ExternalBranch = gitdomain.EnsureRefPrefix(pr.Head.Ref)
+		c.ExternalUpdatedAt = pr.UpdatedAt
+
+		if pr.Head.Repo != nil && pr.Base.Repo != nil && pr.Head.Repo.ID != pr.Base.Repo.ID {
+			c.ExternalForkNamespace = pr.Head.Repo.Owner.Login
+			c.ExternalForkName = pr.Head.Repo.Name
+		} else {
+			c.ExternalForkNamespace = ""
+			c.ExternalForkName = ""
+		}
 	case *perforce.Changelist:
 		c.Metadata = pr
 		c.ExternalID = pr.ID
@@ -508,6 +524,8 @@ func (c *Changeset) Title() (string, error) {
 		// Remove extra quotes added by the commit message
 		title = strings.TrimPrefix(strings.TrimSuffix(title, "\""), "\"")
 		return title, nil
+	case *giteabatches.AnnotatedPullRequest:
+		return m.Title, nil
 	case *perforce.Changelist:
 		return m.Title, nil
 	default:
@@ -535,6 +553,11 @@ func (c *Changeset) AuthorName() (string, error) {
 		return m.CreatedBy.UniqueName, nil
 	case *gerritbatches.AnnotatedChange:
 		return m.Change.Owner.Name, nil
+	case *giteabatches.AnnotatedPullRequest:
+		if m.User == nil {
+			return "", nil
+		}
+		return m.User.Login, nil
 	case *perforce.Changelist:
 		return m.Author, nil
 	default:
@@ -570,6 +593,11 @@ func (c *Changeset) AuthorEmail() (string, error) {
 		return m.CreatedBy.UniqueName, nil
 	case *gerritbatches.AnnotatedChange:
 		return m.Change.Owner.Email, nil
+	case *giteabatches.AnnotatedPullRequest:
+		if m.User == nil {
+			return "", nil
+		}
+		return m.User.Email, nil
 	case *perforce.Changelist:
 		return "", nil
 	default:
@@ -594,6 +622,8 @@ func (c *Changeset) ExternalCreatedAt() time.Time {
 		return m.CreationDate
 	case *gerritbatches.AnnotatedChange:
 		return m.Change.Created
+	case *giteabatches.AnnotatedPullRequest:
+		return m.CreatedAt
 	case *perforce.Changelist:
 		return m.CreationDate
 	default:
@@ -617,6 +647,8 @@ func (c *Changeset) Body() (string, error) {
 	case *gerritbatches.AnnotatedChange:
 		// Gerrit doesn't really differentiate between title/description.
 		return m.Change.Subject, nil
+	case *giteabatches.AnnotatedPullRequest:
+		return m.Body, nil
 	case *perforce.Changelist:
 		return "", nil
 	default:
@@ -685,6 +717,8 @@ func (c *Changeset) URL() (s string, err error) {
 		return returnURL.String(), nil
 	case *gerritbatches.AnnotatedChange:
 		return m.CodeHostURL.JoinPath("c", url.PathEscape(m.Change.Project), "+", url.PathEscape(strconv.Itoa(m.Change.ChangeNumber))).String(), nil
+	case *giteabatches.AnnotatedPullRequest:
+		return m.HTMLURL, nil
 	case *perforce.Changelist:
 		return "", nil
 	default:
@@ -905,6 +939,37 @@ func (c *Changeset) Events() (events []*ChangesetEvent, err error) {
 				Metadata:    reviewer,
 			})
 		}
+	case *giteabatches.AnnotatedPullRequest:
+		// There are two types of event that we create from an annotated pull
+		// request: review events, based on the reviews submitted on the pull
+		// request, and check events, based on the statuses of the head commit.
+		var kind ChangesetEventKind
+
+		for _, review := range m.Reviews {
+			if kind, err = ChangesetEventKindFor(review); err != nil {
+				return
+			}
+			appendEvent(&ChangesetEvent{
+				ChangesetID: c.ID,
+				Key:         strconv.FormatInt(review.ID, 10),
+				Kind:        kind,
+				Metadata:    review,
+			})
+		}
+
+		if m.Status != nil {
+			for _, status := range m.Status.Statuses {
+				if kind, err = ChangesetEventKindFor(status); err != nil {
+					return
+				}
+				appendEvent(&ChangesetEvent{
+					ChangesetID: c.ID,
+					Key:         strconv.FormatInt(status.ID, 10),
+					Kind:        kind,
+					Metadata:    status,
+				})
+			}
+		}
 	case *perforce.Changelist:
 		// We don't have any events we care about right now
 		break
@@ -930,6 +995,8 @@ func (c *Changeset) HeadRefOid() (string, error) {
 		return "", nil
 	case *gerritbatches.AnnotatedChange:
 		return "", nil
+	case *giteabatches.AnnotatedPullRequest:
+		return m.Head.Sha, nil
 	case *perforce.Changelist:
 		return "", nil
 	default:
@@ -953,6 +1020,8 @@ func (c *Changeset) HeadRef() (string, error) {
 		return m.SourceRefName, nil
 	case *gerritbatches.AnnotatedChange:
 		return "", nil
+	case *giteabatches.AnnotatedPullRequest:
+		return "refs/heads/" + m.Head.Ref, nil
 	case *perforce.Changelist:
 		return "", nil
 	default:
@@ -977,6 +1046,8 @@ func (c *Changeset) BaseRefOid() (string, error) {
 		return "", nil
 	case *gerritbatches.AnnotatedChange:
 		return "", nil
+	case *giteabatches.AnnotatedPullRequest:
+		return m.Base.Sha, nil
 	case *perforce.Changelist:
 		return "", nil
 	default:
@@ -1000,6 +1071,8 @@ func (c *Changeset) BaseRef() (string, error) {
 		return m.TargetRefName, nil
 	case *gerritbatches.AnnotatedChange:
 		return "refs/heads/" + m.Change.Branch, nil
+	case *giteabatches.AnnotatedPullRequest:
+		return "refs/heads/" + m.Base.Ref, nil
 	case *perforce.Changelist:
 		// TODO: @peterguy we may need to change this to something.
 		return "", nil
@@ -1087,6 +1160,34 @@ func (c *Changeset) SupportsDraft() bool {
 	return ExternalServiceSupports(c.ExternalServiceType, CodehostCapabilityDraftChangesets)
 }
 
+// HasConflicts returns whether the code host reports that the changeset
+// cannot be merged into its base branch because of conflicts. Code hosts that
+// don't report conflicts are treated as not having any.
+func (c *Changeset) HasConflicts() bool {
+	switch m := c.Metadata.(type) {
+	case *github.PullRequest:
+		return m.Mergeable == "CONFLICTING"
+	case *gitlab.MergeRequest:
+		return m.HasConflicts
+	default:
+		return false
+	}
+}
+
+// AutoMergeEnabled returns whether the code host reports that auto-merge is
+// enabled for the changeset, so that it merges the changeset once its checks
+// have passed.
+func (c *Changeset) AutoMergeEnabled() bool {
+	switch m := c.Metadata.(type) {
+	case *github.PullRequest:
+		return m.AutoMergeRequest != nil
+	case *gitlab.MergeRequest:
+		return m.MergeWhenPipelineSucceeds
+	default:
+		return false
+	}
+}
+
 func (c *Changeset) Labels() []ChangesetLabel {
 	switch m := c.Metadata.(type) {
 	case *github.PullRequest:
@@ -1119,6 +1220,12 @@ func (c *Changeset) Labels() []ChangesetLabel {
 			labels[i] = ChangesetLabel{Name: l, Color: "000000"}
 		}
 		return labels
+	case *giteabatches.AnnotatedPullRequest:
+		labels := make([]ChangesetLabel, len(m.Labels))
+		for i, l := range m.Labels {
+			labels[i] = ChangesetLabel{Name: l.Name, Color: l.Color}
+		}
+		return labels
 	default:
 		return []ChangesetLabel{}
 	}
@@ -1397,6 +1504,20 @@ func ChangesetEventKindFor(e any) (ChangesetEventKind, error) {
 				}
 			}
 		}
+	case *gitea.PullReview:
+		if e.Dismissed {
+			return ChangesetEventKindGiteaReviewed, nil
+		}
+		switch e.State {
+		case gitea.PullReviewStateApproved:
+			return ChangesetEventKindGiteaApproved, nil
+		case gitea.PullReviewStateRequestChanges:
+			return ChangesetEventKindGiteaChangesRequested, nil
+		default:
+			return ChangesetEventKindGiteaReviewed, nil
+		}
+	case *gitea.CommitStatus:
+		return ChangesetEventKindGiteaCommitStatus, nil
 	}
 
 	return ChangesetEventKindInvalid, errors.Errorf("changeset eventkindfor unknown changeset event kind for %T", e)
@@ -1546,6 +1667,15 @@ func NewChangesetEventMetadata(k ChangesetEventKind) (any, error) {
 			ChangesetEventKindGerritChangeBuildSucceeded:
 			return new(gerrit.Reviewer), nil
 		}
+	case strings.HasPrefix(string(k), "gitea"):
+		switch k {
+		case ChangesetEventKindGiteaApproved,
+			ChangesetEventKindGiteaChangesRequested,
+			ChangesetEventKindGiteaReviewed:
+			return new(gitea.PullReview), nil
+		case ChangesetEventKindGiteaCommitStatus:
+			return new(gitea.CommitStatus), nil
+		}
 	}
 	return nil, errors.Errorf("changeset event metadata unknown changeset event kind %q", k)
 }
@@ -0,0 +1,16 @@
+package types
+
+import (
+	"time"
+
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+)
+
+// ChangesetAutoMerge records that auto-merge has been enabled on the code host
+// for a changeset, so that the syncer doesn't enable it again on every sync.
+type ChangesetAutoMerge struct {
+	ChangesetID   int64
+	BatchChangeID int64
+	MergeMethod   batcheslib.AutoMergeMethod
+	CreatedAt     time.Time
+}
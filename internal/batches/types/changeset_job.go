@@ -49,6 +49,7 @@ var (
 	ChangesetJobTypeClose     ChangesetJobType = "close"
 	ChangesetJobTypePublish   ChangesetJobType = "publish"
 	ChangesetJobTypeExport    ChangesetJobType = "export"
+	ChangesetJobTypeRebase    ChangesetJobType = "rebase"
 )
 
 type ChangesetJobCommentPayload struct {
@@ -69,6 +70,8 @@ type ChangesetJobPublishPayload struct {
 	Draft bool `json:"draft"`
 }
 
+type ChangesetJobRebasePayload struct{}
+
 // ChangesetJob describes a one-time action to be taken on a changeset.
 type ChangesetJob struct {
 	ID int64
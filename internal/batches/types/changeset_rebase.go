@@ -0,0 +1,37 @@
+package types
+
+import "time"
+
+// ChangesetRebaseState defines the possible states of a ChangesetRebase.
+type ChangesetRebaseState string
+
+const (
+	// ChangesetRebaseStateExecuting means that the workspace of the changeset
+	// is being re-executed on the current head of the base branch.
+	ChangesetRebaseStateExecuting ChangesetRebaseState = "EXECUTING"
+	// ChangesetRebaseStatePushed means that the re-execution succeeded and the
+	// changeset has been enqueued to force-push the result.
+	ChangesetRebaseStatePushed ChangesetRebaseState = "PUSHED"
+	// ChangesetRebaseStateFailed means that the changeset couldn't be rebased.
+	ChangesetRebaseStateFailed ChangesetRebaseState = "FAILED"
+	// ChangesetRebaseStateUpToDate means that the changeset was already based
+	// on the head of its base branch, so nothing had to be done.
+	ChangesetRebaseStateUpToDate ChangesetRebaseState = "UP_TO_DATE"
+)
+
+// ChangesetRebase tracks the rebase of a changeset that has been requested by
+// a rebase bulk operation.
+type ChangesetRebase struct {
+	ID int64
+	// BulkGroup is the bulk group of the changeset job that requested the
+	// rebase.
+	BulkGroup            string
+	ChangesetID          int64
+	BatchSpecWorkspaceID int64
+	// BaseRev is the commit of the base branch the workspace is re-executed on.
+	BaseRev        string
+	State          ChangesetRebaseState
+	FailureMessage *string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
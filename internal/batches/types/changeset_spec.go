@@ -74,6 +74,10 @@ func NewChangesetSpecFromSpec(spec *batcheslib.ChangesetSpec) (*ChangesetSpec, e
 		c.CommitMessage = commitMsg
 		c.CommitAuthorName = authorName
 		c.CommitAuthorEmail = authorEmail
+		c.Labels = spec.Labels
+		c.Reviewers = spec.Reviewers
+		c.TeamReviewers = spec.TeamReviewers
+		c.Assignees = spec.Assignees
 	}
 
 	c.computeForkNamespace(spec.Fork)
@@ -115,6 +119,14 @@ type ChangesetSpec struct {
 	CommitAuthorName  string
 	CommitAuthorEmail string
 
+	// Labels, Reviewers, TeamReviewers, and Assignees are applied to the
+	// changeset on the code host in addition to any that were set there
+	// manually.
+	Labels        []string
+	Reviewers     []string
+	TeamReviewers []string
+	Assignees     []string
+
 	ForkNamespace *string
 }
 
@@ -808,6 +808,80 @@ func TestChangeset_Labels(t *testing.T) {
 	}
 }
 
+func TestChangeset_HasConflicts(t *testing.T) {
+	for name, tc := range map[string]struct {
+		meta any
+		want bool
+	}{
+		"bitbucketserver": {
+			meta: &bitbucketserver.PullRequest{},
+			want: false,
+		},
+		"GitHub conflicting": {
+			meta: &github.PullRequest{Mergeable: "CONFLICTING"},
+			want: true,
+		},
+		"GitHub mergeable": {
+			meta: &github.PullRequest{Mergeable: "MERGEABLE"},
+			want: false,
+		},
+		"GitHub unknown": {
+			meta: &github.PullRequest{},
+			want: false,
+		},
+		"GitLab conflicting": {
+			meta: &gitlab.MergeRequest{HasConflicts: true},
+			want: true,
+		},
+		"GitLab mergeable": {
+			meta: &gitlab.MergeRequest{},
+			want: false,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := &Changeset{Metadata: tc.meta}
+			if have := c.HasConflicts(); have != tc.want {
+				t.Errorf("unexpected result: have=%t want=%t", have, tc.want)
+			}
+		})
+	}
+}
+
+func TestChangeset_AutoMergeEnabled(t *testing.T) {
+	for name, tc := range map[string]struct {
+		meta any
+		want bool
+	}{
+		"bitbucketserver": {
+			meta: &bitbucketserver.PullRequest{},
+			want: false,
+		},
+		"GitHub enabled": {
+			meta: &github.PullRequest{AutoMergeRequest: &github.AutoMergeRequest{MergeMethod: "SQUASH"}},
+			want: true,
+		},
+		"GitHub disabled": {
+			meta: &github.PullRequest{},
+			want: false,
+		},
+		"GitLab enabled": {
+			meta: &gitlab.MergeRequest{MergeWhenPipelineSucceeds: true},
+			want: true,
+		},
+		"GitLab disabled": {
+			meta: &gitlab.MergeRequest{},
+			want: false,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := &Changeset{Metadata: tc.meta}
+			if have := c.AutoMergeEnabled(); have != tc.want {
+				t.Errorf("unexpected result: have=%t want=%t", have, tc.want)
+			}
+		})
+	}
+}
+
 func TestChangesetMetadata(t *testing.T) {
 	now := timeutil.Now()
 
@@ -91,6 +91,21 @@ func (cfg *Configuration) HasRolloutWindows() bool {
 	return len(cfg.windows) != 0
 }
 
+// IsOpen returns true if no windows have been defined, or if one of the
+// windows is open at the given time. Window rates are not taken into account.
+func (cfg *Configuration) IsOpen(at time.Time) bool {
+	if !cfg.HasRolloutWindows() {
+		return true
+	}
+
+	for i := range cfg.windows {
+		if cfg.windows[i].IsOpen(at) {
+			return true
+		}
+	}
+	return false
+}
+
 // Schedule returns the currently active schedule.
 func (cfg *Configuration) Schedule() *Schedule {
 	// If there are no rollout windows, then we return an unlimited schedule and
@@ -207,6 +207,42 @@ func FuzzEstimate(f *testing.F) {
 	})
 }
 
+func TestConfiguration_IsOpen(t *testing.T) {
+	t.Run("no windows", func(t *testing.T) {
+		cfg := &Configuration{}
+		if !cfg.IsOpen(time.Now()) {
+			t.Error("unexpected closed configuration")
+		}
+	})
+
+	t.Run("windows", func(t *testing.T) {
+		cfg := &Configuration{
+			windows: []Window{
+				{
+					days:  newWeekdaySet(time.Monday),
+					start: timeOfDayPtr(8, 0),
+					end:   timeOfDayPtr(10, 0),
+				},
+				{
+					days: newWeekdaySet(time.Saturday),
+				},
+			},
+		}
+
+		for at, want := range map[time.Time]bool{
+			time.Date(2021, 3, 8, 7, 59, 0, 0, time.UTC):  false,
+			time.Date(2021, 3, 8, 8, 0, 0, 0, time.UTC):   true,
+			time.Date(2021, 3, 8, 10, 0, 0, 0, time.UTC):  false,
+			time.Date(2021, 3, 9, 9, 0, 0, 0, time.UTC):   false,
+			time.Date(2021, 3, 13, 23, 0, 0, 0, time.UTC): true,
+		} {
+			if have := cfg.IsOpen(at); have != want {
+				t.Errorf("unexpected result at %v: have=%v want=%v", at, have, want)
+			}
+		}
+	})
+}
+
 func TestConfiguration_Schedule(t *testing.T) {
 	// We have other tests to test the actual implementation of scheduleAt();
 	// this is purely to ensure that we do the special case handling of not
@@ -26,6 +26,7 @@ type operations struct {
 	preciseUsages                     *observation.Operation
 	syntacticUsages                   *observation.Operation
 	searchBasedUsages                 *observation.Operation
+	countSymbolReferences             *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -63,6 +64,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 		preciseUsages:                     op("PreciseUsages"),
 		syntacticUsages:                   op("SyntacticUsages"),
 		searchBasedUsages:                 op("SearchBasedUsages"),
+		countSymbolReferences:             op("CountSymbolReferences"),
 	}
 }
 
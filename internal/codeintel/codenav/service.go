@@ -692,6 +692,27 @@ func (s *Service) GetClosestCompletedUploadsForBlob(ctx context.Context, opts up
 	return candidatesWithExistingCommitsAndPaths, nil
 }
 
+// CountSymbolReferences returns the number of references to the SCIP symbol
+// in the given uploads. Unlike the usages APIs, the count is not restricted to
+// a single page of results.
+func (s *Service) CountSymbolReferences(ctx context.Context, symbol string, uploadIDs []int) (_ int, err error) {
+	ctx, _, endObservation := s.operations.countSymbolReferences.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("symbol", symbol),
+		attribute.IntSlice("uploadIDs", uploadIDs),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	_, totalCount, err := s.lsifstore.GetSymbolUsages(ctx, lsifstore.SymbolUsagesOptions{
+		UsageKind:     shared.UsageKindReference,
+		UploadIDs:     uploadIDs,
+		LookupSymbols: []string{symbol},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return totalCount, nil
+}
+
 // filterUploadsWithCommits only keeps the uploads for commits which are known to gitserver.
 // A fresh slice is returned without modifying the original slice.
 func filterUploadsWithCommits(ctx context.Context, commitCache CommitCache, uploads []uploadsshared.CompletedUpload) ([]uploadsshared.CompletedUpload, error) {
@@ -3,6 +3,7 @@ package background
 import (
 	"net/url"
 
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 )
 
@@ -15,7 +16,12 @@ type actionArgs struct {
 	UTMSource          string
 	MonitorOwnerName   string
 
-	Query          string
-	Results        []*result.CommitMatch
+	Query   string
+	Results []*result.CommitMatch
+	// ContentChanges are set instead of Results for content triggers.
+	ContentChanges *database.ContentChanges
 	IncludeResults bool
+
+	// Digest is set if the notification is a digest of several trigger events.
+	Digest *digestArgs
 }
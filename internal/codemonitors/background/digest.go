@@ -0,0 +1,131 @@
+package background
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/codemonitors"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// digestResultLimit is the maximum number of commit matches or changed files
+// included in the webhook payload of a digest. Emails and Slack messages only
+// show the first few results of any notification.
+const digestResultLimit = 100
+
+// digestArgs describes a notification which is a digest of several trigger
+// events.
+type digestArgs struct {
+	Delivery database.ActionDelivery
+	// EventCount is the number of trigger events in the digest.
+	EventCount int
+}
+
+// Period returns "hourly" or "daily".
+func (d *digestArgs) Period() string {
+	if d.Delivery == database.DailyDigestDelivery {
+		return "daily"
+	}
+	return "hourly"
+}
+
+// summarySuffix is appended to the sentence which says what a monitor
+// detected.
+func (d *digestArgs) summarySuffix() string {
+	if d == nil {
+		return ""
+	}
+	return fmt.Sprintf(" in %d %s since its last %s digest", d.EventCount, pluralize("run", d.EventCount), d.Period())
+}
+
+// prepareNotification returns the arguments shared by all kinds of actions for
+// the notification sent by the action job, or nil if no notification should be
+// sent. The job of a digest action claims the other due jobs of the action, and
+// its notification includes their trigger events.
+func prepareNotification(ctx context.Context, s database.CodeMonitorStore, j *database.ActionJob, delivery database.ActionDelivery) (*actionArgs, error) {
+	jobs := []*database.ActionJob{j}
+	if delivery.DigestWindow() > 0 {
+		claimed, err := s.ClaimDigestActionJobs(ctx, j)
+		if err != nil {
+			return nil, errors.Wrap(err, "ClaimDigestActionJobs")
+		}
+		jobs = append(jobs, claimed...)
+		sort.Slice(jobs, func(i, k int) bool { return jobs[i].TriggerEvent < jobs[k].TriggerEvent })
+	}
+
+	events := make([]*database.ActionJobMetadata, 0, len(jobs))
+	for _, job := range jobs {
+		m, err := s.GetActionJobMetadata(ctx, job.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetActionJobMetadata")
+		}
+		events = append(events, m)
+	}
+
+	monitor, err := s.GetMonitor(ctx, events[0].MonitorID)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetMonitor")
+	}
+	if limit := monitor.NotificationLimit; limit != nil {
+		window := monitor.NotificationLimitWindow
+		count, err := s.CountSentNotifications(ctx, monitor.ID, s.Now().Add(-window.Duration()), j.TriggerEvent)
+		if err != nil {
+			return nil, errors.Wrap(err, "CountSentNotifications")
+		}
+		if count >= int(*limit) {
+			msg := fmt.Sprintf("Not sent: the monitor reached its limit of %d %s per %s", *limit, pluralize("notification", int(*limit)), strings.ToLower(string(window)))
+			return nil, s.SetActionJobLogContents(ctx, j.ID, msg)
+		}
+	}
+
+	if delivery.DigestWindow() == 0 {
+		m := events[0]
+		return &actionArgs{
+			MonitorDescription: m.Description,
+			MonitorID:          m.MonitorID,
+			MonitorOwnerName:   m.OwnerName,
+			Query:              m.Query,
+			Results:            m.Results,
+			ContentChanges:     m.ContentChanges,
+		}, nil
+	}
+
+	args := newDigestArgs(events, delivery)
+	if len(args.Results) == 0 && args.ContentChanges.Count() == 0 {
+		return nil, s.SetActionJobLogContents(ctx, j.ID, "Not sent: the changes of the trigger events in the digest cancel out")
+	}
+	if err := s.SetActionJobLogContents(ctx, j.ID, fmt.Sprintf("Sent a digest of %d trigger %s", len(events), pluralize("event", len(events)))); err != nil {
+		return nil, err
+	}
+	return args, nil
+}
+
+// newDigestArgs merges trigger events, ordered oldest first, into the
+// arguments of one notification.
+func newDigestArgs(events []*database.ActionJobMetadata, delivery database.ActionDelivery) *actionArgs {
+	first := events[0]
+	args := &actionArgs{
+		MonitorDescription: first.Description,
+		MonitorID:          first.MonitorID,
+		MonitorOwnerName:   first.OwnerName,
+		// The query of the oldest event has the earliest after: filter, so
+		// it finds the commits of all events.
+		Query:  first.Query,
+		Digest: &digestArgs{Delivery: delivery, EventCount: len(events)},
+	}
+
+	var changes []*database.ContentChanges
+	for _, e := range events {
+		args.Results = append(args.Results, e.Results...)
+		if e.ContentChanges != nil {
+			changes = append(changes, e.ContentChanges)
+		}
+	}
+	if len(changes) > 0 {
+		args.ContentChanges = codemonitors.MergeContentChanges(changes)
+	}
+	return args
+}
@@ -0,0 +1,105 @@
+package background
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/txemail"
+)
+
+func TestNewDigestArgs(t *testing.T) {
+	a := database.ContentMatch{RepoID: 1, RepoName: "github.com/a/a", Path: "a.go"}
+	b := database.ContentMatch{RepoID: 1, RepoName: "github.com/a/a", Path: "b.go"}
+
+	t.Run("commit results", func(t *testing.T) {
+		args := newDigestArgs([]*database.ActionJobMetadata{
+			{Description: "monitor", MonitorID: 1, OwnerName: "alice", Query: "first", Results: []*result.CommitMatch{&diffResultMock}},
+			{Description: "monitor", MonitorID: 1, OwnerName: "alice", Query: "second", Results: []*result.CommitMatch{&commitResultMock}},
+		}, database.DailyDigestDelivery)
+
+		require.Equal(t, "first", args.Query)
+		require.Equal(t, []*result.CommitMatch{&diffResultMock, &commitResultMock}, args.Results)
+		require.Nil(t, args.ContentChanges)
+		require.Equal(t, &digestArgs{Delivery: database.DailyDigestDelivery, EventCount: 2}, args.Digest)
+		require.Equal(t, "daily", args.Digest.Period())
+	})
+
+	t.Run("content changes", func(t *testing.T) {
+		args := newDigestArgs([]*database.ActionJobMetadata{
+			{ContentChanges: &database.ContentChanges{Added: []database.ContentMatch{a, b}}},
+			{ContentChanges: &database.ContentChanges{Removed: []database.ContentMatch{a}}},
+		}, database.HourlyDigestDelivery)
+
+		require.Empty(t, args.Results)
+		require.Equal(t, &database.ContentChanges{Added: []database.ContentMatch{b}}, args.ContentChanges)
+	})
+}
+
+func TestDigestNotifications(t *testing.T) {
+	args := actionArgs{
+		MonitorDescription: "My test monitor",
+		MonitorOwnerName:   "alice",
+		ExternalURL:        externalURLMock,
+		MonitorID:          42,
+		Query:              "repo:camdentest -file:id_rsa.pub BEGIN",
+		Results:            []*result.CommitMatch{&diffResultMock, &commitResultMock},
+		IncludeResults:     true,
+		Digest:             &digestArgs{Delivery: database.HourlyDigestDelivery, EventCount: 2},
+	}
+
+	t.Run("slack", func(t *testing.T) {
+		msg := slackPayload(args)
+		require.Equal(t,
+			"alice's Sourcegraph Code monitor, *My test monitor*, detected *3* new matches in 2 runs since its last hourly digest.",
+			msg.Blocks.BlockSet[0].(*slack.SectionBlock).Text.Text,
+		)
+	})
+
+	t.Run("email", func(t *testing.T) {
+		data, err := NewTemplateDataForNewSearchResults(args, &database.EmailAction{})
+		require.NoError(t, err)
+		template := txemail.MustParseTemplate(newSearchResultsDigestEmailTemplates)
+
+		var buf bytes.Buffer
+		require.NoError(t, template.Subj.Execute(&buf, data))
+		require.Equal(t, "Sourcegraph code monitor My test monitor hourly digest: 3 new results", buf.String())
+
+		buf.Reset()
+		require.NoError(t, template.Text.Execute(&buf, data))
+		require.Contains(t, buf.String(), "detected 3 new results in 2 runs since its last hourly digest.")
+	})
+
+	t.Run("webhook", func(t *testing.T) {
+		many := make([]*result.CommitMatch, digestResultLimit+3)
+		for i := range many {
+			many[i] = &commitResultMock
+		}
+		args := args
+		args.Results = many
+		args.ContentChanges = &database.ContentChanges{Added: []database.ContentMatch{{RepoName: "github.com/a/a", Path: "a.go"}}}
+
+		p := generateWebhookPayload(args)
+		require.Len(t, p.Results, digestResultLimit)
+		require.Empty(t, p.AddedFiles)
+		require.Equal(t, &webhookDigest{Delivery: "HOURLY_DIGEST", EventCount: 2, OmittedCount: 4}, p.Digest)
+
+		args.IncludeResults = false
+		p = generateWebhookPayload(args)
+		require.Empty(t, p.Results)
+		require.Equal(t, &webhookDigest{Delivery: "HOURLY_DIGEST", EventCount: 2}, p.Digest)
+	})
+
+	t.Run("immediate notifications are unchanged", func(t *testing.T) {
+		args := args
+		args.Digest = nil
+		require.Nil(t, generateWebhookPayload(args).Digest)
+		require.False(t, strings.Contains(fmt.Sprint(slackPayload(args).Blocks.BlockSet[0]), "digest"))
+	})
+}
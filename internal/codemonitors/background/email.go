@@ -29,7 +29,10 @@ func SendEmailForNewSearchResult(ctx context.Context, db database.DB, userID int
 	if MockSendEmailForNewSearchResult != nil {
 		return MockSendEmailForNewSearchResult(ctx, db, userID, data)
 	}
-	return sendEmail(ctx, db, userID, newSearchResultsEmailTemplates, data)
+	if data.Digest != nil {
+		return sendEmail(ctx, db, userID, "code-monitor-digest", newSearchResultsDigestEmailTemplates, data)
+	}
+	return sendEmail(ctx, db, userID, "code-monitor", newSearchResultsEmailTemplates, data)
 }
 
 var (
@@ -46,6 +49,14 @@ var newSearchResultsEmailTemplates = txemail.MustValidate(txtypes.Templates{
 	HTML:    htmlTemplate,
 })
 
+// newSearchResultsDigestEmailTemplates are used for digests of several trigger
+// events. The bodies are shared with newSearchResultsEmailTemplates.
+var newSearchResultsDigestEmailTemplates = txemail.MustValidate(txtypes.Templates{
+	Subject: `{{.Priority}}Sourcegraph code monitor {{.Description}} {{.Digest.Period}} digest: {{.TotalCount}} new {{.ResultPluralized}}`,
+	Text:    textTemplate,
+	HTML:    htmlTemplate,
+})
+
 type TemplateDataNewSearchResults struct {
 	Priority                  string
 	CodeMonitorURL            string
@@ -59,6 +70,27 @@ type TemplateDataNewSearchResults struct {
 	TruncatedResultPluralized string
 	DisplayMoreLink           bool
 	IsTest                    bool
+
+	// Digest is set if the email is a digest of several trigger events.
+	Digest *DigestTemplateData
+}
+
+type DigestTemplateData struct {
+	// Period is "hourly" or "daily".
+	Period          string
+	EventCount      int
+	EventPluralized string
+}
+
+func newDigestTemplateData(d *digestArgs) *DigestTemplateData {
+	if d == nil {
+		return nil
+	}
+	return &DigestTemplateData{
+		Period:          d.Period(),
+		EventCount:      d.EventCount,
+		EventPluralized: pluralize("run", d.EventCount),
+	}
 }
 
 func NewTemplateDataForNewSearchResults(args actionArgs, email *database.EmailAction) (d *TemplateDataNewSearchResults, err error) {
@@ -75,6 +107,24 @@ func NewTemplateDataForNewSearchResults(args actionArgs, email *database.EmailAc
 		priority = ""
 	}
 
+	if args.ContentChanges != nil {
+		displayResults, totalCount, truncatedCount := toContentChangeDisplayResults(args.ContentChanges, args.ExternalURL, 5)
+		return &TemplateDataNewSearchResults{
+			Priority:                  priority,
+			CodeMonitorURL:            codeMonitorURL,
+			SearchURL:                 searchURL,
+			Description:               args.MonitorDescription,
+			IncludeResults:            args.IncludeResults,
+			TruncatedResults:          displayResults,
+			TotalCount:                totalCount,
+			TruncatedCount:            truncatedCount,
+			ResultPluralized:          pluralize("change", totalCount),
+			TruncatedResultPluralized: pluralize("change", truncatedCount),
+			DisplayMoreLink:           args.IncludeResults && truncatedCount > 0,
+			Digest:                    newDigestTemplateData(args.Digest),
+		}, nil
+	}
+
 	truncatedResults, totalCount, truncatedCount := truncateResults(args.Results, 5)
 
 	displayResults := make([]*DisplayResult, len(truncatedResults))
@@ -94,6 +144,7 @@ func NewTemplateDataForNewSearchResults(args actionArgs, email *database.EmailAc
 		ResultPluralized:          pluralize("result", totalCount),
 		TruncatedResultPluralized: pluralize("result", truncatedCount),
 		DisplayMoreLink:           args.IncludeResults && truncatedCount > 0,
+		Digest:                    newDigestTemplateData(args.Digest),
 	}, nil
 }
 
@@ -118,7 +169,7 @@ func NewTestTemplateDataForNewSearchResults(monitorDescription string) *Template
 	}
 }
 
-func sendEmail(ctx context.Context, db database.DB, userID int32, template txtypes.Templates, data any) error {
+func sendEmail(ctx context.Context, db database.DB, userID int32, source string, template txtypes.Templates, data any) error {
 	email, verified, err := db.UserEmails().GetPrimaryEmail(ctx, userID)
 	if err != nil {
 		if errcode.IsNotFound(err) {
@@ -130,7 +181,7 @@ func sendEmail(ctx context.Context, db database.DB, userID int32, template txtyp
 		return errors.Newf("unable to send email to user ID %d's unverified primary email address", userID)
 	}
 
-	if err := txemail.Send(ctx, "code-monitor", txtypes.Message{
+	if err := txemail.Send(ctx, source, txtypes.Message{
 		To:       []string{email},
 		Template: template,
 		Data:     data,
@@ -152,6 +203,10 @@ func getCommitURL(externalURL *url.URL, repoName, oid, utmSource string) string
 	return sourcegraphURL(externalURL, fmt.Sprintf("%s/-/commit/%s", repoName, oid), "", utmSource)
 }
 
+func getFileURL(externalURL *url.URL, repoName, path, utmSource string) string {
+	return sourcegraphURL(externalURL, fmt.Sprintf("%s/-/blob/%s", repoName, path), "", utmSource)
+}
+
 func sourcegraphURL(externalURL *url.URL, path, query, utmSource string) string {
 	// Construct URL to the search query.
 	u := externalURL.ResolveReference(&url.URL{Path: path})
@@ -178,6 +233,12 @@ type DisplayResult struct {
 	RepoName   string
 	CommitID   string
 	Content    string
+
+	// Path and FileURL are set instead of the commit for files which started
+	// or stopped matching a content trigger. FileURL is empty for files which
+	// stopped matching, since they may no longer exist.
+	Path    string
+	FileURL string
 }
 
 func toDisplayResult(result *searchresult.CommitMatch, externalURL *url.URL) *DisplayResult {
@@ -195,3 +256,31 @@ func toDisplayResult(result *searchresult.CommitMatch, externalURL *url.URL) *Di
 		Content:    content,
 	}
 }
+
+// toContentChangeDisplayResults returns the display results of at most
+// maxResults files which started or stopped matching a content trigger, listing
+// new matches first.
+func toContentChangeDisplayResults(changes *database.ContentChanges, externalURL *url.URL, maxResults int) (_ []*DisplayResult, totalCount, truncatedCount int) {
+	var results []*DisplayResult
+	for _, m := range changes.Added {
+		results = append(results, &DisplayResult{
+			ResultType: "New match",
+			RepoName:   m.RepoName,
+			Path:       m.Path,
+			FileURL:    getFileURL(externalURL, m.RepoName, m.Path, utmSourceEmail),
+		})
+	}
+	for _, m := range changes.Removed {
+		results = append(results, &DisplayResult{
+			ResultType: "No longer matches",
+			RepoName:   m.RepoName,
+			Path:       m.Path,
+		})
+	}
+
+	totalCount = len(results)
+	if totalCount > maxResults {
+		results = results[:maxResults]
+	}
+	return results, totalCount, totalCount - len(results)
+}
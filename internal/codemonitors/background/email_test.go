@@ -2,12 +2,14 @@ package background
 
 import (
 	"bytes"
+	"net/url"
 	"testing"
 
 	"github.com/hexops/autogold/v2"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/txemail"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
@@ -189,3 +191,42 @@ func TestEmail(t *testing.T) {
 	})
 
 }
+
+func TestContentChangeDisplayResults(t *testing.T) {
+	externalURL, err := url.Parse("https://www.sourcegraph.com")
+	require.NoError(t, err)
+
+	changes := &database.ContentChanges{
+		Added: []database.ContentMatch{
+			{RepoID: 1, RepoName: "github.com/a/a", Path: "a.go"},
+			{RepoID: 1, RepoName: "github.com/a/a", Path: "b.go"},
+		},
+		Removed: []database.ContentMatch{
+			{RepoID: 2, RepoName: "github.com/a/b", Path: "c.go"},
+		},
+	}
+
+	results, totalCount, truncatedCount := toContentChangeDisplayResults(changes, externalURL, 2)
+	require.Equal(t, 3, totalCount)
+	require.Equal(t, 1, truncatedCount)
+	require.Equal(t, []*DisplayResult{{
+		ResultType: "New match",
+		RepoName:   "github.com/a/a",
+		Path:       "a.go",
+		FileURL:    "https://www.sourcegraph.com/github.com/a/a/-/blob/a.go?utm_source=code-monitoring-email",
+	}, {
+		ResultType: "New match",
+		RepoName:   "github.com/a/a",
+		Path:       "b.go",
+		FileURL:    "https://www.sourcegraph.com/github.com/a/a/-/blob/b.go?utm_source=code-monitoring-email",
+	}}, results)
+
+	results, totalCount, truncatedCount = toContentChangeDisplayResults(changes, externalURL, 5)
+	require.Equal(t, 3, totalCount)
+	require.Equal(t, 0, truncatedCount)
+	require.Equal(t, &DisplayResult{
+		ResultType: "No longer matches",
+		RepoName:   "github.com/a/b",
+		Path:       "c.go",
+	}, results[2])
+}
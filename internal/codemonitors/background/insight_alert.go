@@ -0,0 +1,184 @@
+package background
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/slack-go/slack"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/txemail"
+	"github.com/sourcegraph/sourcegraph/internal/txemail/txtypes"
+)
+
+// InsightAlert is a fired alert rule of a code insights series. Insight alerts
+// are delivered through the same actions as code monitors.
+type InsightAlert struct {
+	ExternalURL *url.URL
+	// Description describes the condition which was met, for example "rose
+	// above 100".
+	Description string
+	SeriesID    string
+	Query       string
+	// Time is the recording time of the point which fired the alert.
+	Time          time.Time
+	Value         float64
+	PreviousValue *float64
+	// Repositories are the repositories whose value fired the alert, if the
+	// alert is evaluated per repository.
+	Repositories []InsightAlertRepository
+}
+
+type InsightAlertRepository struct {
+	Name  string
+	Value float64
+}
+
+const (
+	utmSourceInsightAlertEmail        = "code-insights-alert-email"
+	utmSourceInsightAlertSlackWebhook = "code-insights-alert-slack-webhook"
+	utmSourceInsightAlertWebhook      = "code-insights-alert-webhook"
+)
+
+func getInsightsURL(externalURL *url.URL, utmSource string) string {
+	return sourcegraphURL(externalURL, "insights", "", utmSource)
+}
+
+// SendEmailForInsightAlert emails an insight alert to a user.
+func SendEmailForInsightAlert(ctx context.Context, db database.DB, userID int32, alert InsightAlert) error {
+	return sendEmail(ctx, db, userID, "code-insights-alert", insightAlertEmailTemplates, newTemplateDataInsightAlert(alert))
+}
+
+var insightAlertEmailTemplates = txemail.MustValidate(txtypes.Templates{
+	Subject: `Sourcegraph code insight alert: series {{.Description}}`,
+	Text: `
+The code insights series {{.Query}} {{.Description}}: {{.Value}} at {{.Time}}{{ if .PreviousValue }}, from {{.PreviousValue}}{{ end }}.
+{{- range .Repositories }}
+- {{.Name}}: {{.Value}}
+{{- end }}
+
+View code insights: {{.InsightsURL}}
+
+__
+You are receiving this notification because you created an alert on a code insights series.
+`,
+	HTML: `
+<p>The code insights series <code>{{.Query}}</code> {{.Description}}: <strong>{{.Value}}</strong> at {{.Time}}{{ if .PreviousValue }}, from {{.PreviousValue}}{{ end }}.</p>
+{{- if .Repositories }}
+<ul>
+{{- range .Repositories }}
+<li>{{.Name}}: {{.Value}}</li>
+{{- end }}
+</ul>
+{{- end }}
+<p><a href="{{.InsightsURL}}">View code insights</a></p>
+<p>You are receiving this notification because you created an alert on a code insights series.</p>
+`,
+})
+
+type templateDataInsightAlert struct {
+	Description   string
+	Query         string
+	Time          string
+	Value         string
+	PreviousValue string
+	Repositories  []templateDataInsightAlertRepository
+	InsightsURL   string
+}
+
+type templateDataInsightAlertRepository struct {
+	Name  string
+	Value string
+}
+
+func newTemplateDataInsightAlert(alert InsightAlert) *templateDataInsightAlert {
+	data := &templateDataInsightAlert{
+		Description: alert.Description,
+		Query:       alert.Query,
+		Time:        alert.Time.UTC().Format(time.RFC3339),
+		Value:       formatInsightValue(alert.Value),
+		InsightsURL: getInsightsURL(alert.ExternalURL, utmSourceInsightAlertEmail),
+	}
+	if alert.PreviousValue != nil {
+		data.PreviousValue = formatInsightValue(*alert.PreviousValue)
+	}
+	for _, repo := range alert.Repositories {
+		data.Repositories = append(data.Repositories, templateDataInsightAlertRepository{Name: repo.Name, Value: formatInsightValue(repo.Value)})
+	}
+	return data
+}
+
+func formatInsightValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// SendSlackForInsightAlert posts an insight alert to a Slack webhook.
+func SendSlackForInsightAlert(ctx context.Context, url string, alert InsightAlert) error {
+	return postSlackWebhook(ctx, httpcli.ExternalDoer, url, insightAlertSlackPayload(alert))
+}
+
+func insightAlertSlackPayload(alert InsightAlert) *slack.WebhookMessage {
+	newMarkdownSection := func(s string) slack.Block {
+		return slack.NewSectionBlock(slack.NewTextBlockObject("mrkdwn", s, false, false), nil, nil)
+	}
+
+	summary := fmt.Sprintf("The Sourcegraph code insights series `%s` %s: *%s*", alert.Query, alert.Description, formatInsightValue(alert.Value))
+	if alert.PreviousValue != nil {
+		summary += fmt.Sprintf(", from %s", formatInsightValue(*alert.PreviousValue))
+	}
+	blocks := []slack.Block{newMarkdownSection(summary + ".")}
+
+	// Keep the message readable and within Slack's block limits.
+	const maxRepositories = 10
+	for i, repo := range alert.Repositories {
+		if i == maxRepositories {
+			blocks = append(blocks, newMarkdownSection(fmt.Sprintf("...and %d more repositories.", len(alert.Repositories)-maxRepositories)))
+			break
+		}
+		blocks = append(blocks, newMarkdownSection(fmt.Sprintf("%s: *%s*", repo.Name, formatInsightValue(repo.Value))))
+	}
+
+	blocks = append(blocks, newMarkdownSection(fmt.Sprintf("<%s|View code insights>", getInsightsURL(alert.ExternalURL, utmSourceInsightAlertSlackWebhook))))
+	return &slack.WebhookMessage{Blocks: &slack.Blocks{BlockSet: blocks}}
+}
+
+// SendWebhookForInsightAlert posts an insight alert to a webhook.
+func SendWebhookForInsightAlert(ctx context.Context, url string, alert InsightAlert) error {
+	return postWebhook(ctx, httpcli.ExternalDoer, url, generateInsightAlertWebhookPayload(alert))
+}
+
+type insightAlertWebhookPayload struct {
+	Description   string                          `json:"description"`
+	SeriesID      string                          `json:"seriesId"`
+	Query         string                          `json:"query"`
+	Time          time.Time                       `json:"time"`
+	Value         float64                         `json:"value"`
+	PreviousValue *float64                        `json:"previousValue,omitempty"`
+	Repositories  []insightAlertWebhookRepository `json:"repositories,omitempty"`
+	InsightsURL   string                          `json:"insightsURL"`
+}
+
+type insightAlertWebhookRepository struct {
+	Name  string  `json:"name"`
+	Value float64 `json:"value"`
+}
+
+func generateInsightAlertWebhookPayload(alert InsightAlert) insightAlertWebhookPayload {
+	p := insightAlertWebhookPayload{
+		Description:   alert.Description,
+		SeriesID:      alert.SeriesID,
+		Query:         alert.Query,
+		Time:          alert.Time.UTC(),
+		Value:         alert.Value,
+		PreviousValue: alert.PreviousValue,
+		InsightsURL:   getInsightsURL(alert.ExternalURL, utmSourceInsightAlertWebhook),
+	}
+	for _, repo := range alert.Repositories {
+		p.Repositories = append(p.Repositories, insightAlertWebhookRepository{Name: repo.Name, Value: repo.Value})
+	}
+	return p
+}
@@ -0,0 +1,80 @@
+package background
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/txemail"
+)
+
+func TestInsightAlert(t *testing.T) {
+	eu, err := url.Parse("https://sourcegraph.com")
+	require.NoError(t, err)
+
+	previous := 80.0
+	alert := InsightAlert{
+		ExternalURL:   eu,
+		Description:   "rose above 100",
+		SeriesID:      "s1",
+		Query:         "TODO",
+		Time:          time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		Value:         120,
+		PreviousValue: &previous,
+		Repositories:  []InsightAlertRepository{{Name: "github.com/a/payments", Value: 101.5}},
+	}
+
+	t.Run("email", func(t *testing.T) {
+		template := txemail.MustParseTemplate(insightAlertEmailTemplates)
+		data := newTemplateDataInsightAlert(alert)
+
+		var buf bytes.Buffer
+		require.NoError(t, template.Subj.Execute(&buf, data))
+		require.Equal(t, "Sourcegraph code insight alert: series rose above 100", buf.String())
+
+		buf.Reset()
+		require.NoError(t, template.Text.Execute(&buf, data))
+		require.Contains(t, buf.String(), "The code insights series TODO rose above 100: 120 at 2024-01-02T00:00:00Z, from 80.")
+		require.Contains(t, buf.String(), "- github.com/a/payments: 101.5")
+		require.Contains(t, buf.String(), "https://sourcegraph.com/insights?utm_source=code-insights-alert-email")
+	})
+
+	t.Run("slack", func(t *testing.T) {
+		b, err := json.Marshal(insightAlertSlackPayload(alert))
+		require.NoError(t, err)
+		require.Contains(t, string(b), "The Sourcegraph code insights series `TODO` rose above 100: *120*, from 80.")
+		require.Contains(t, string(b), "github.com/a/payments: *101.5*")
+	})
+
+	t.Run("webhook", func(t *testing.T) {
+		var got insightAlertWebhookPayload
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(b, &got))
+			w.WriteHeader(200)
+		}))
+		defer s.Close()
+
+		err := postWebhook(context.Background(), s.Client(), s.URL, generateInsightAlertWebhookPayload(alert))
+		require.NoError(t, err)
+		require.Equal(t, insightAlertWebhookPayload{
+			Description:   "rose above 100",
+			SeriesID:      "s1",
+			Query:         "TODO",
+			Time:          alert.Time,
+			Value:         120,
+			PreviousValue: &previous,
+			Repositories:  []insightAlertWebhookRepository{{Name: "github.com/a/payments", Value: 101.5}},
+			InsightsURL:   "https://sourcegraph.com/insights?utm_source=code-insights-alert-webhook",
+		}, got)
+	})
+}
@@ -20,20 +20,19 @@ func sendSlackNotification(ctx context.Context, url string, args actionArgs) err
 	return postSlackWebhook(ctx, httpcli.ExternalDoer, url, slackPayload(args))
 }
 
+func newMarkdownSection(s string) slack.Block {
+	return slack.NewSectionBlock(slack.NewTextBlockObject("mrkdwn", s, false, false), nil, nil)
+}
+
 func slackPayload(args actionArgs) *slack.WebhookMessage {
-	newMarkdownSection := func(s string) slack.Block {
-		return slack.NewSectionBlock(slack.NewTextBlockObject("mrkdwn", s, false, false), nil, nil)
+	if args.ContentChanges != nil {
+		return slackContentChangesPayload(args)
 	}
 
 	truncatedResults, totalCount, truncatedCount := truncateResults(args.Results, 5)
 
 	blocks := []slack.Block{
-		newMarkdownSection(fmt.Sprintf(
-			"%s's Sourcegraph Code monitor, *%s*, detected *%d* new matches.",
-			args.MonitorOwnerName,
-			args.MonitorDescription,
-			totalCount,
-		)),
+		newMarkdownSection(slackSummary(args, fmt.Sprintf("*%d* new matches", totalCount))),
 	}
 
 	if args.IncludeResults {
@@ -67,16 +66,76 @@ func slackPayload(args actionArgs) *slack.WebhookMessage {
 		)))
 	}
 
+	blocks = append(blocks, editMonitorSection(args))
+	return &slack.WebhookMessage{Blocks: &slack.Blocks{BlockSet: blocks}}
+}
+
+// slackContentChangesPayload is the message sent when files started or stopped
+// matching the query of a content trigger.
+func slackContentChangesPayload(args actionArgs) *slack.WebhookMessage {
+	changes := args.ContentChanges
+	blocks := []slack.Block{
+		newMarkdownSection(slackSummary(args, fmt.Sprintf("*%d* new and *%d* removed matching files", len(changes.Added), len(changes.Removed)))),
+	}
+
+	if args.IncludeResults {
+		const maxFiles = 5
+		shown := 0
+		for _, m := range changes.Added {
+			if shown == maxFiles {
+				break
+			}
+			blocks = append(blocks, newMarkdownSection(fmt.Sprintf(
+				"New match: <%s|%s/%s>",
+				getFileURL(args.ExternalURL, m.RepoName, m.Path, args.UTMSource),
+				m.RepoName,
+				m.Path,
+			)))
+			shown++
+		}
+		for _, m := range changes.Removed {
+			if shown == maxFiles {
+				break
+			}
+			// The file may no longer exist, so we don't link to it.
+			blocks = append(blocks, newMarkdownSection(fmt.Sprintf("No longer matches: %s/%s", m.RepoName, m.Path)))
+			shown++
+		}
+		if truncatedCount := changes.Count() - shown; truncatedCount > 0 {
+			blocks = append(blocks, newMarkdownSection(fmt.Sprintf("...and %d more files.", truncatedCount)))
+		}
+	}
+
 	blocks = append(blocks,
 		newMarkdownSection(fmt.Sprintf(
-			`If you are %s, you can <%s|edit your code monitor>`,
-			args.MonitorOwnerName,
-			getCodeMonitorURL(args.ExternalURL, args.MonitorID, args.UTMSource),
+			"<%s|View results>",
+			getSearchURL(args.ExternalURL, args.Query, args.UTMSource),
 		)),
+		editMonitorSection(args),
 	)
 	return &slack.WebhookMessage{Blocks: &slack.Blocks{BlockSet: blocks}}
 }
 
+// slackSummary returns the first sentence of a message, which says what the
+// monitor detected. Digests also say how many trigger events they include.
+func slackSummary(args actionArgs, detected string) string {
+	return fmt.Sprintf(
+		"%s's Sourcegraph Code monitor, *%s*, detected %s%s.",
+		args.MonitorOwnerName,
+		args.MonitorDescription,
+		detected,
+		args.Digest.summarySuffix(),
+	)
+}
+
+func editMonitorSection(args actionArgs) slack.Block {
+	return newMarkdownSection(fmt.Sprintf(
+		`If you are %s, you can <%s|edit your code monitor>`,
+		args.MonitorOwnerName,
+		getCodeMonitorURL(args.ExternalURL, args.MonitorID, args.UTMSource),
+	))
+}
+
 func formatCodeBlock(s string) string {
 	return fmt.Sprintf("```%s```", strings.ReplaceAll(s, "```", "\\`\\`\\`"))
 }
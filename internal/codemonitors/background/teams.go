@@ -0,0 +1,177 @@
+package background
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func sendTeamsNotification(ctx context.Context, url string, args actionArgs) error {
+	return postTeamsWebhook(ctx, httpcli.ExternalDoer, url, teamsPayload(args))
+}
+
+// teamsMessage is a Microsoft Teams webhook message with a single adaptive card.
+type teamsMessage struct {
+	Type        string            `json:"type"`
+	Attachments []teamsAttachment `json:"attachments"`
+}
+
+type teamsAttachment struct {
+	ContentType string `json:"contentType"`
+	// ContentURL must be null for adaptive cards.
+	ContentURL *string      `json:"contentUrl"`
+	Content    adaptiveCard `json:"content"`
+}
+
+type adaptiveCard struct {
+	Schema  string               `json:"$schema"`
+	Type    string               `json:"type"`
+	Version string               `json:"version"`
+	Body    []adaptiveCardText   `json:"body"`
+	Actions []adaptiveCardAction `json:"actions,omitempty"`
+}
+
+// adaptiveCardText is a TextBlock element. Its text supports a subset of
+// Markdown, including bold text and links.
+type adaptiveCardText struct {
+	Type     string `json:"type"`
+	Text     string `json:"text"`
+	Wrap     bool   `json:"wrap"`
+	FontType string `json:"fontType,omitempty"`
+	IsSubtle bool   `json:"isSubtle,omitempty"`
+}
+
+type adaptiveCardAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+func newTeamsMessage(body []adaptiveCardText, actions ...adaptiveCardAction) *teamsMessage {
+	return &teamsMessage{
+		Type: "message",
+		Attachments: []teamsAttachment{{
+			ContentType: "application/vnd.microsoft.card.adaptive",
+			Content: adaptiveCard{
+				Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+				Type:    "AdaptiveCard",
+				Version: "1.4",
+				Body:    body,
+				Actions: actions,
+			},
+		}},
+	}
+}
+
+func newTeamsText(s string) adaptiveCardText {
+	return adaptiveCardText{Type: "TextBlock", Text: s, Wrap: true}
+}
+
+func newTeamsCode(s string) adaptiveCardText {
+	return adaptiveCardText{Type: "TextBlock", Text: s, Wrap: true, FontType: "Monospace", IsSubtle: true}
+}
+
+func newTeamsLink(title, url string) adaptiveCardAction {
+	return adaptiveCardAction{Type: "Action.OpenUrl", Title: title, URL: url}
+}
+
+func teamsPayload(args actionArgs) *teamsMessage {
+	actions := []adaptiveCardAction{
+		newTeamsLink("View results", getSearchURL(args.ExternalURL, args.Query, args.UTMSource)),
+		newTeamsLink("Edit code monitor", getCodeMonitorURL(args.ExternalURL, args.MonitorID, args.UTMSource)),
+	}
+
+	if args.ContentChanges != nil {
+		return newTeamsMessage(teamsContentChangesBody(args), actions...)
+	}
+
+	truncatedResults, totalCount, truncatedCount := truncateResults(args.Results, 5)
+
+	body := []adaptiveCardText{
+		newTeamsText(teamsSummary(args, fmt.Sprintf("**%d** new matches", totalCount))),
+	}
+	if args.IncludeResults {
+		for _, result := range truncatedResults {
+			resultType := "Message"
+			if result.DiffPreview != nil {
+				resultType = "Diff"
+			}
+			body = append(body,
+				newTeamsText(fmt.Sprintf(
+					"%s match: [%s@%s](%s)",
+					resultType,
+					result.Repo.Name,
+					result.Commit.ID.Short(),
+					getCommitURL(args.ExternalURL, string(result.Repo.Name), string(result.Commit.ID), args.UTMSource),
+				)),
+				newTeamsCode(truncateMatchContent(result)),
+			)
+		}
+		if truncatedCount > 0 {
+			body = append(body, newTeamsText(fmt.Sprintf("...and %d more matches.", truncatedCount)))
+		}
+	}
+	return newTeamsMessage(body, actions...)
+}
+
+// teamsContentChangesBody lists the files which started or stopped matching
+// the query of a content trigger.
+func teamsContentChangesBody(args actionArgs) []adaptiveCardText {
+	changes := args.ContentChanges
+	body := []adaptiveCardText{
+		newTeamsText(teamsSummary(args, fmt.Sprintf("**%d** new and **%d** removed matching files", len(changes.Added), len(changes.Removed)))),
+	}
+	if !args.IncludeResults {
+		return body
+	}
+
+	displayResults, _, truncatedCount := toContentChangeDisplayResults(changes, args.ExternalURL, 5)
+	for _, r := range displayResults {
+		if r.FileURL == "" {
+			// The file may no longer exist, so we don't link to it.
+			body = append(body, newTeamsText(fmt.Sprintf("%s: %s/%s", r.ResultType, r.RepoName, r.Path)))
+			continue
+		}
+		body = append(body, newTeamsText(fmt.Sprintf(
+			"%s: [%s/%s](%s)",
+			r.ResultType,
+			r.RepoName,
+			r.Path,
+			getFileURL(args.ExternalURL, r.RepoName, r.Path, args.UTMSource),
+		)))
+	}
+	if truncatedCount > 0 {
+		body = append(body, newTeamsText(fmt.Sprintf("...and %d more files.", truncatedCount)))
+	}
+	return body
+}
+
+// teamsSummary is the Teams flavor of slackSummary.
+func teamsSummary(args actionArgs, detected string) string {
+	return fmt.Sprintf(
+		"%s's Sourcegraph Code monitor, **%s**, detected %s%s.",
+		args.MonitorOwnerName,
+		args.MonitorDescription,
+		detected,
+		args.Digest.summarySuffix(),
+	)
+}
+
+func postTeamsWebhook(ctx context.Context, doer httpcli.Doer, url string, msg *teamsMessage) error {
+	raw, err := json.Marshal(msg)
+	if err != nil {
+		return errors.Wrap(err, "marshal failed")
+	}
+	// Teams workflows respond with 202 Accepted rather than 200 OK.
+	return postWebhookBody(ctx, doer, url, raw, nil)
+}
+
+func SendTestTeamsWebhook(ctx context.Context, doer httpcli.Doer, description, url string) error {
+	testMessage := newTeamsMessage([]adaptiveCardText{
+		newTeamsText(fmt.Sprintf("Test message for Code Monitor '%s'", description)),
+	})
+	return postTeamsWebhook(ctx, doer, url, testMessage)
+}
@@ -0,0 +1,96 @@
+package background
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+func TestTeamsPayload(t *testing.T) {
+	args := actionArgs{
+		MonitorDescription: "My test monitor",
+		MonitorOwnerName:   "alice",
+		ExternalURL:        externalURLMock,
+		MonitorID:          42,
+		UTMSource:          "code-monitor-teams-webhook",
+		Query:              "repo:camdentest -file:id_rsa.pub BEGIN",
+		Results:            []*result.CommitMatch{&diffResultMock, &commitResultMock},
+	}
+
+	texts := func(msg *teamsMessage) []string {
+		var out []string
+		for _, b := range msg.Attachments[0].Content.Body {
+			out = append(out, b.Text)
+		}
+		return out
+	}
+
+	t.Run("without results", func(t *testing.T) {
+		msg := teamsPayload(args)
+		require.Equal(t, "message", msg.Type)
+		require.Len(t, msg.Attachments, 1)
+		require.Equal(t, "application/vnd.microsoft.card.adaptive", msg.Attachments[0].ContentType)
+		require.Equal(t, []string{"alice's Sourcegraph Code monitor, **My test monitor**, detected **3** new matches."}, texts(msg))
+
+		actions := msg.Attachments[0].Content.Actions
+		require.Len(t, actions, 2)
+		require.Equal(t, "View results", actions[0].Title)
+		require.Contains(t, actions[0].URL, "utm_source=code-monitor-teams-webhook")
+		require.Equal(t, getCodeMonitorURL(externalURLMock, 42, "code-monitor-teams-webhook"), actions[1].URL)
+
+		// Teams rejects adaptive card attachments without a null contentUrl.
+		raw, err := json.Marshal(msg)
+		require.NoError(t, err)
+		require.Contains(t, string(raw), `"contentUrl":null`)
+	})
+
+	t.Run("with results", func(t *testing.T) {
+		args := args
+		args.IncludeResults = true
+		body := teamsBody(teamsPayload(args))
+		require.Len(t, body, 5)
+		require.Equal(t, "Diff match: [github.com/test/test@7815187](https://www.sourcegraph.com/github.com/test/test/-/commit/7815187511872asbasdfgasd?utm_source=code-monitor-teams-webhook)", body[1].Text)
+		require.Equal(t, "Monospace", body[2].FontType)
+		require.Equal(t, truncateMatchContent(&diffResultMock), body[2].Text)
+	})
+
+	t.Run("content changes", func(t *testing.T) {
+		args := args
+		args.IncludeResults = true
+		args.ContentChanges = &database.ContentChanges{
+			Added:   []database.ContentMatch{{RepoName: "github.com/a/a", Path: "new.go"}},
+			Removed: []database.ContentMatch{{RepoName: "github.com/a/a", Path: "old.go"}},
+		}
+		require.Equal(t, []string{
+			"alice's Sourcegraph Code monitor, **My test monitor**, detected **1** new and **1** removed matching files.",
+			"New match: [github.com/a/a/new.go](https://www.sourcegraph.com/github.com/a/a/-/blob/new.go?utm_source=code-monitor-teams-webhook)",
+			"No longer matches: github.com/a/a/old.go",
+		}, texts(teamsPayload(args)))
+	})
+}
+
+func teamsBody(m *teamsMessage) []adaptiveCardText {
+	return m.Attachments[0].Content.Body
+}
+
+func TestTriggerTestTeamsWebhookAction(t *testing.T) {
+	var got teamsMessage
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		// Teams workflows accept messages asynchronously.
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer s.Close()
+
+	err := SendTestTeamsWebhook(context.Background(), httpcli.TestExternalDoer, "My test monitor", s.URL)
+	require.NoError(t, err)
+	require.Equal(t, "Test message for Code Monitor 'My test monitor'", teamsBody(&got)[0].Text)
+}
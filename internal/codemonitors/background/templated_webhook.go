@@ -0,0 +1,132 @@
+package background
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// maxTemplatedPayloadSize is the maximum size of the rendered body of a
+// templated webhook.
+const maxTemplatedPayloadSize = 1 << 20
+
+// templatedWebhookFuncs are the functions available to payload templates in
+// addition to the builtin functions of text/template.
+var templatedWebhookFuncs = template.FuncMap{
+	// json encodes a value as JSON, so that templates can safely embed
+	// strings such as commit messages in a JSON body.
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// reservedWebhookHeaders are set by the HTTP client and cannot be overridden.
+var reservedWebhookHeaders = map[string]struct{}{
+	"Host":              {},
+	"Content-Length":    {},
+	"Transfer-Encoding": {},
+	"Connection":        {},
+}
+
+func sendTemplatedWebhookNotification(ctx context.Context, w *database.TemplatedWebhookAction, args actionArgs) error {
+	body, err := renderWebhookTemplate(w.PayloadTemplate, generateWebhookPayload(args))
+	if err != nil {
+		return err
+	}
+	headers, err := w.Headers.Decrypt(ctx)
+	if err != nil {
+		return errors.Wrap(err, "decrypting webhook headers")
+	}
+	return postWebhookBody(ctx, httpcli.ExternalDoer, w.URL, body, headers)
+}
+
+// renderWebhookTemplate executes a payload template. Templates are executed with
+// the payload of webhook actions, so they can refer to the same fields, for
+// example {{.MonitorDescription}} or {{range .Results}}{{.Repository}}{{end}}.
+func renderWebhookTemplate(payloadTemplate string, payload webhookPayload) ([]byte, error) {
+	tmpl, err := template.New("payload").Funcs(templatedWebhookFuncs).Parse(payloadTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing payload template")
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&limitedWriter{w: &buf, n: maxTemplatedPayloadSize}, payload); err != nil {
+		return nil, errors.Wrap(err, "executing payload template")
+	}
+	return buf.Bytes(), nil
+}
+
+// limitedWriter fails writes once more than n bytes were written.
+type limitedWriter struct {
+	w *bytes.Buffer
+	n int
+}
+
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	if l.w.Len()+len(p) > l.n {
+		return 0, errors.Newf("payload exceeds the maximum size of %d bytes", l.n)
+	}
+	return l.w.Write(p)
+}
+
+// ValidateTemplatedWebhook checks that a payload template can be executed, and
+// that the headers can be sent.
+func ValidateTemplatedWebhook(payloadTemplate string, headers []database.WebhookHeader) error {
+	if strings.TrimSpace(payloadTemplate) == "" {
+		return errors.New("the payload template of a templated webhook must not be empty")
+	}
+	// Executing the template with a sample payload catches references to
+	// fields which don't exist.
+	if _, err := renderWebhookTemplate(payloadTemplate, samplePayload("", true)); err != nil {
+		return err
+	}
+
+	for _, h := range headers {
+		if h.Name == "" || strings.ContainsAny(h.Name, " \t\r\n:") {
+			return errors.Errorf("invalid webhook header name %q", h.Name)
+		}
+		if strings.ContainsAny(h.Value, "\r\n") {
+			return errors.Errorf("the value of webhook header %q must not contain line breaks", h.Name)
+		}
+		if _, ok := reservedWebhookHeaders[http.CanonicalHeaderKey(h.Name)]; ok {
+			return errors.Errorf("webhook header %q cannot be set", h.Name)
+		}
+	}
+	return nil
+}
+
+// samplePayload is the payload of test notifications.
+func samplePayload(description string, includeResults bool) webhookPayload {
+	return generateWebhookPayload(actionArgs{
+		ExternalURL:        &url.URL{},
+		MonitorDescription: description,
+		Query:              "test query",
+		Results: []*result.CommitMatch{{
+			Repo:           types.MinimalRepo{Name: "testorg/testrepo"},
+			Commit:         gitdomain.Commit{ID: api.CommitID("0000000")},
+			MessagePreview: &result.MatchedString{Content: "This is a test\nfor a code monitoring result."},
+		}},
+		IncludeResults: includeResults,
+	})
+}
+
+func SendTestTemplatedWebhook(ctx context.Context, doer httpcli.Doer, description string, w *database.TemplatedWebhookActionArgs) error {
+	body, err := renderWebhookTemplate(w.PayloadTemplate, samplePayload(description, w.IncludeResults))
+	if err != nil {
+		return err
+	}
+	return postWebhookBody(ctx, doer, w.URL, body, w.Headers)
+}
@@ -0,0 +1,129 @@
+package background
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+func TestRenderWebhookTemplate(t *testing.T) {
+	args := actionArgs{
+		MonitorDescription: `My "quoted" monitor`,
+		ExternalURL:        externalURLMock,
+		MonitorID:          42,
+		Query:              "repo:camdentest BEGIN",
+		Results:            []*result.CommitMatch{&diffResultMock, &commitResultMock},
+		IncludeResults:     true,
+	}
+
+	t.Run("json", func(t *testing.T) {
+		body, err := renderWebhookTemplate(
+			`{"title": {{json .MonitorDescription}}, "commits": [{{range $i, $r := .Results}}{{if $i}}, {{end}}{{json $r.Commit}}{{end}}]}`,
+			generateWebhookPayload(args),
+		)
+		require.NoError(t, err)
+
+		var got map[string]any
+		require.NoError(t, json.Unmarshal(body, &got))
+		require.Equal(t, map[string]any{
+			"title":   `My "quoted" monitor`,
+			"commits": []any{"7815187511872asbasdfgasd", "7815187511872asbasdfgasd"},
+		}, got)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := renderWebhookTemplate(`{{.Unknown}}`, generateWebhookPayload(args))
+		require.Error(t, err)
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		_, err := renderWebhookTemplate(`{{range .Results}}`+strings.Repeat("x", maxTemplatedPayloadSize/2+1)+`{{end}}`, generateWebhookPayload(args))
+		require.ErrorContains(t, err, "maximum size")
+	})
+}
+
+func TestValidateTemplatedWebhook(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		template string
+		headers  []database.WebhookHeader
+		wantErr  string
+	}{{
+		name:     "valid",
+		template: `{"summary": {{json .MonitorDescription}}, "url": {{json .MonitorURL}}}`,
+		headers:  []database.WebhookHeader{{Name: "Authorization", Value: "Bearer token"}},
+	}, {
+		name:     "digest fields must be guarded",
+		template: `{{with .Digest}}{{.EventCount}}{{end}}`,
+	}, {
+		name:    "empty",
+		wantErr: "must not be empty",
+	}, {
+		name:     "syntax error",
+		template: `{{.MonitorDescription`,
+		wantErr:  "parsing payload template",
+	}, {
+		name:     "unknown field",
+		template: `{{.Description}}`,
+		wantErr:  "executing payload template",
+	}, {
+		name:     "invalid header name",
+		template: `{}`,
+		headers:  []database.WebhookHeader{{Name: "X Token", Value: "a"}},
+		wantErr:  "invalid webhook header name",
+	}, {
+		name:     "header injection",
+		template: `{}`,
+		headers:  []database.WebhookHeader{{Name: "X-Token", Value: "a\r\nHost: example.com"}},
+		wantErr:  "line breaks",
+	}, {
+		name:     "reserved header",
+		template: `{}`,
+		headers:  []database.WebhookHeader{{Name: "content-length", Value: "1"}},
+		wantErr:  "cannot be set",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateTemplatedWebhook(tc.template, tc.headers)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestTriggerTestTemplatedWebhookAction(t *testing.T) {
+	var (
+		gotBody   string
+		gotHeader http.Header
+	)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		gotBody, gotHeader = string(b), r.Header
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer s.Close()
+
+	err := SendTestTemplatedWebhook(context.Background(), httpcli.TestExternalDoer, "My test monitor", &database.TemplatedWebhookActionArgs{
+		URL:             s.URL,
+		PayloadTemplate: `{"title": {{json .MonitorDescription}}, "count": {{len .Results}}}`,
+		Headers:         []database.WebhookHeader{{Name: "X-Api-Key", Value: "secret"}},
+		IncludeResults:  true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, `{"title": "My test monitor", "count": 1}`, gotBody)
+	require.Equal(t, "secret", gotHeader.Get("X-Api-Key"))
+	require.Equal(t, "application/json", gotHeader.Get("Content-Type"))
+}
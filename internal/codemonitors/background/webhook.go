@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -17,7 +18,7 @@ func sendWebhookNotification(ctx context.Context, url string, args actionArgs) e
 	return postWebhook(ctx, httpcli.ExternalDoer, url, generateWebhookPayload(args))
 }
 
-func postWebhook(ctx context.Context, doer httpcli.Doer, url string, payload webhookPayload) error {
+func postWebhook(ctx context.Context, doer httpcli.Doer, url string, payload any) error {
 	raw, err := json.Marshal(payload)
 	if err != nil {
 		return errors.Wrap(err, "marshal failed")
@@ -47,6 +48,36 @@ func postWebhook(ctx context.Context, doer httpcli.Doer, url string, payload web
 	return nil
 }
 
+// postWebhookBody posts a JSON body with the given additional headers, and
+// accepts any successful response.
+func postWebhookBody(ctx context.Context, doer httpcli.Doer, url string, body []byte, headers []database.WebhookHeader) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed new request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for _, h := range headers {
+		req.Header.Set(h.Name, h.Value)
+	}
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to post webhook")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return StatusCodeError{
+			Code:   resp.StatusCode,
+			Status: resp.Status,
+			Body:   string(body),
+		}
+	}
+
+	return nil
+}
+
 func SendTestWebhook(ctx context.Context, doer httpcli.Doer, description string, u string) error {
 	args := actionArgs{
 		ExternalURL:        &url.URL{},
@@ -61,6 +92,22 @@ type webhookPayload struct {
 	MonitorURL         string          `json:"monitorURL"`
 	Query              string          `json:"query"`
 	Results            []webhookResult `json:"results,omitempty"`
+
+	// AddedFiles and RemovedFiles are the files which started or stopped
+	// matching the query of a content trigger.
+	AddedFiles   []webhookFile `json:"addedFiles,omitempty"`
+	RemovedFiles []webhookFile `json:"removedFiles,omitempty"`
+
+	// Digest is set if the payload is a digest of several trigger events.
+	Digest *webhookDigest `json:"digest,omitempty"`
+}
+
+type webhookDigest struct {
+	Delivery   string `json:"delivery"`
+	EventCount int    `json:"eventCount"`
+	// OmittedCount is the number of commit matches and changed files which
+	// were left out of the payload.
+	OmittedCount int `json:"omittedCount,omitempty"`
 }
 
 func generateWebhookPayload(args actionArgs) webhookPayload {
@@ -70,13 +117,60 @@ func generateWebhookPayload(args actionArgs) webhookPayload {
 		Query:              args.Query,
 	}
 
+	if args.Digest != nil {
+		p.Digest = &webhookDigest{
+			Delivery:   string(args.Digest.Delivery),
+			EventCount: args.Digest.EventCount,
+		}
+	}
+
 	if args.IncludeResults {
-		p.Results = generateResults(args.Results)
+		results := args.Results
+		var added, removed []database.ContentMatch
+		if args.ContentChanges != nil {
+			added, removed = args.ContentChanges.Added, args.ContentChanges.Removed
+		}
+		if p.Digest != nil {
+			// Digests can include the results of many trigger events.
+			remaining := digestResultLimit
+			results, remaining = truncateSlice(results, remaining, &p.Digest.OmittedCount)
+			added, remaining = truncateSlice(added, remaining, &p.Digest.OmittedCount)
+			removed, _ = truncateSlice(removed, remaining, &p.Digest.OmittedCount)
+		}
+
+		p.Results = generateResults(results)
+		if args.ContentChanges != nil {
+			p.AddedFiles = generateFiles(added)
+			p.RemovedFiles = generateFiles(removed)
+		}
 	}
 
 	return p
 }
 
+// truncateSlice returns the first limit elements of s and the remaining limit,
+// and adds the number of dropped elements to omitted.
+func truncateSlice[T any](s []T, limit int, omitted *int) ([]T, int) {
+	if len(s) <= limit {
+		return s, limit - len(s)
+	}
+	*omitted += len(s) - limit
+	return s[:limit], 0
+}
+
+type webhookFile struct {
+	Repository string `json:"repository"`
+	Path       string `json:"path"`
+}
+
+func generateFiles(in []database.ContentMatch) []webhookFile {
+	out := make([]webhookFile, len(in))
+	for i, match := range in {
+		out[i] = webhookFile{Repository: match.RepoName, Path: match.Path}
+	}
+	return out
+}
+
 type webhookResult struct {
 	Repository           string   `json:"repository"`
 	Commit               string   `json:"commit"`
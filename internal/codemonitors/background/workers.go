@@ -181,6 +181,10 @@ func (r *queryRunner) Handle(ctx context.Context, logger log.Logger, triggerJob
 	ctx = actor.WithActor(ctx, actor.FromUser(m.UserID))
 	ctx = featureflag.WithFlags(ctx, r.db.FeatureFlags())
 
+	if q.Kind == database.ContentQueryTrigger {
+		return r.handleContentTrigger(ctx, logger, triggerJob, q, m)
+	}
+
 	results, searchErr := codemonitors.Search(ctx, logger, r.db, q.QueryString, m.ID, triggerJob.ID)
 
 	// Log next_run and latest_result to table cm_queries.
@@ -210,6 +214,64 @@ func (r *queryRunner) Handle(ctx context.Context, logger log.Logger, triggerJob
 	return nil
 }
 
+// handleContentTrigger runs the content search of a trigger and compares the
+// files it matches to the files matched on the previous run. Actions are run if
+// any file started or stopped matching. The first run only records the matched
+// files.
+func (r *queryRunner) handleContentTrigger(ctx context.Context, logger log.Logger, triggerJob *database.TriggerJob, q *database.QueryTrigger, m *database.Monitor) (err error) {
+	cm := r.db.CodeMonitors()
+
+	matches, searchErr := codemonitors.SearchContent(ctx, logger, r.db, q.QueryString)
+
+	var changes *database.ContentChanges
+	if searchErr == nil {
+		previous, ok, err := cm.GetLastContentMatches(ctx, m.ID)
+		if err != nil {
+			return errors.Wrap(err, "GetLastContentMatches")
+		}
+		if ok {
+			changes = codemonitors.DiffContentMatches(previous, matches)
+		}
+	}
+
+	// Log next_run and latest_result to table cm_queries.
+	newLatestResult := cm.Clock()()
+	if changes.Count() == 0 && q.LatestResult != nil {
+		newLatestResult = *q.LatestResult
+	}
+	err = cm.SetQueryTriggerNextRun(ctx, q.ID, cm.Clock()().Add(conf.CodeMonitors().PollInterval), newLatestResult.UTC())
+	if err != nil {
+		return err
+	}
+
+	// After setting the next run, check the error value
+	if searchErr != nil {
+		return errors.Wrap(searchErr, "execute search")
+	}
+
+	tx, err := cm.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	if err := tx.UpsertLastContentMatches(ctx, m.ID, matches); err != nil {
+		return errors.Wrap(err, "UpsertLastContentMatches")
+	}
+
+	// Log the actual query we ran and which files changed.
+	if err := tx.UpdateTriggerJobWithContentChanges(ctx, triggerJob.ID, q.QueryString, changes); err != nil {
+		return errors.Wrap(err, "UpdateTriggerJobWithContentChanges")
+	}
+
+	if changes.Count() > 0 {
+		if _, err := tx.EnqueueActionJobsForMonitor(ctx, m.ID, triggerJob.ID); err != nil {
+			return errors.Wrap(err, "store.EnqueueActionJobsForQuery")
+		}
+	}
+	return nil
+}
+
 type actionRunner struct {
 	database.CodeMonitorStore
 }
@@ -223,28 +285,32 @@ func (r *actionRunner) Handle(ctx context.Context, logger log.Logger, j *databas
 		return errors.Wrap(r.handleWebhook(ctx, j), "Webhook")
 	case j.SlackWebhook != nil:
 		return errors.Wrap(r.handleSlackWebhook(ctx, j), "SlackWebhook")
+	case j.TeamsWebhook != nil:
+		return errors.Wrap(r.handleTeamsWebhook(ctx, j), "TeamsWebhook")
+	case j.TemplatedWebhook != nil:
+		return errors.Wrap(r.handleTemplatedWebhook(ctx, j), "TemplatedWebhook")
 	default:
-		return errors.New("job must be one of type email, webhook, or slack webhook")
+		return errors.New("job must be one of type email, webhook, slack webhook, teams webhook, or templated webhook")
 	}
 }
 
-func (r *actionRunner) handleEmail(ctx context.Context, j *database.ActionJob) error {
+func (r *actionRunner) handleEmail(ctx context.Context, j *database.ActionJob) (err error) {
 	s, err := r.CodeMonitorStore.Transact(ctx)
 	if err != nil {
 		return err
 	}
 	defer func() { err = s.Done(err) }()
 
-	m, err := s.GetActionJobMetadata(ctx, j.ID)
-	if err != nil {
-		return errors.Wrap(err, "GetActionJobMetadata")
-	}
-
 	e, err := s.GetEmailAction(ctx, *j.Email)
 	if err != nil {
 		return errors.Wrap(err, "GetEmailAction")
 	}
 
+	args, err := prepareNotification(ctx, s, j, e.Delivery)
+	if err != nil || args == nil {
+		return err
+	}
+
 	recs, err := s.ListRecipients(ctx, database.ListRecipientsOpts{EmailID: j.Email})
 	if err != nil {
 		return errors.Wrap(err, "ListRecipients")
@@ -255,18 +321,11 @@ func (r *actionRunner) handleEmail(ctx context.Context, j *database.ActionJob) e
 		return err
 	}
 
-	args := actionArgs{
-		MonitorDescription: m.Description,
-		MonitorID:          m.MonitorID,
-		ExternalURL:        externalURL,
-		UTMSource:          utmSourceEmail,
-		Query:              m.Query,
-		MonitorOwnerName:   m.OwnerName,
-		Results:            m.Results,
-		IncludeResults:     e.IncludeResults,
-	}
+	args.ExternalURL = externalURL
+	args.UTMSource = utmSourceEmail
+	args.IncludeResults = e.IncludeResults
 
-	data, err := NewTemplateDataForNewSearchResults(args, e)
+	data, err := NewTemplateDataForNewSearchResults(*args, e)
 	if err != nil {
 		return errors.Wrap(err, "NewTemplateDataForNewSearchResults")
 	}
@@ -283,60 +342,88 @@ func (r *actionRunner) handleEmail(ctx context.Context, j *database.ActionJob) e
 			return err
 		}
 	}
-	return nil
+	return s.MarkActionJobSent(ctx, j.ID)
 }
 
-func (r *actionRunner) handleWebhook(ctx context.Context, j *database.ActionJob) error {
+func (r *actionRunner) handleWebhook(ctx context.Context, j *database.ActionJob) (err error) {
 	s, err := r.CodeMonitorStore.Transact(ctx)
 	if err != nil {
 		return err
 	}
 	defer func() { err = s.Done(err) }()
 
-	m, err := s.GetActionJobMetadata(ctx, j.ID)
-	if err != nil {
-		return errors.Wrap(err, "GetActionJobMetadata")
-	}
-
 	w, err := s.GetWebhookAction(ctx, *j.Webhook)
 	if err != nil {
 		return errors.Wrap(err, "GetWebhookAction")
 	}
 
+	args, err := prepareNotification(ctx, s, j, w.Delivery)
+	if err != nil || args == nil {
+		return err
+	}
+
 	externalURL, err := url.Parse(conf.Get().ExternalURL)
 	if err != nil {
 		return err
 	}
 
-	args := actionArgs{
-		MonitorDescription: m.Description,
-		MonitorID:          w.Monitor,
-		ExternalURL:        externalURL,
-		UTMSource:          "code-monitor-webhook",
-		Query:              m.Query,
-		MonitorOwnerName:   m.OwnerName,
-		Results:            m.Results,
-		IncludeResults:     w.IncludeResults,
-	}
+	args.ExternalURL = externalURL
+	args.UTMSource = "code-monitor-webhook"
+	args.IncludeResults = w.IncludeResults
 
-	return sendWebhookNotification(ctx, w.URL, args)
+	if err := sendWebhookNotification(ctx, w.URL, *args); err != nil {
+		return err
+	}
+	return s.MarkActionJobSent(ctx, j.ID)
 }
 
-func (r *actionRunner) handleSlackWebhook(ctx context.Context, j *database.ActionJob) error {
+func (r *actionRunner) handleSlackWebhook(ctx context.Context, j *database.ActionJob) (err error) {
 	s, err := r.CodeMonitorStore.Transact(ctx)
 	if err != nil {
 		return err
 	}
 	defer func() { err = s.Done(err) }()
 
-	m, err := s.GetActionJobMetadata(ctx, j.ID)
+	w, err := s.GetSlackWebhookAction(ctx, *j.SlackWebhook)
 	if err != nil {
-		return errors.Wrap(err, "GetActionJobMetadata")
+		return errors.Wrap(err, "GetSlackWebhookAction")
 	}
 
-	w, err := s.GetSlackWebhookAction(ctx, *j.SlackWebhook)
+	args, err := prepareNotification(ctx, s, j, w.Delivery)
+	if err != nil || args == nil {
+		return err
+	}
+
+	externalURL, err := url.Parse(conf.Get().ExternalURL)
 	if err != nil {
-		return errors.Wrap(err, "GetSlackWebhookAction")
+		return err
+	}
+
+	args.ExternalURL = externalURL
+	args.UTMSource = "code-monitor-slack-webhook"
+	args.IncludeResults = w.IncludeResults
+
+	if err := sendSlackNotification(ctx, w.URL, *args); err != nil {
+		return err
+	}
+	return s.MarkActionJobSent(ctx, j.ID)
+}
+
+func (r *actionRunner) handleTeamsWebhook(ctx context.Context, j *database.ActionJob) (err error) {
+	s, err := r.CodeMonitorStore.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = s.Done(err) }()
+
+	w, err := s.GetTeamsWebhookAction(ctx, *j.TeamsWebhook)
+	if err != nil {
+		return errors.Wrap(err, "GetTeamsWebhookAction")
+	}
+
+	args, err := prepareNotification(ctx, s, j, w.Delivery)
+	if err != nil || args == nil {
+		return err
 	}
 
 	externalURL, err := url.Parse(conf.Get().ExternalURL)
@@ -344,18 +431,46 @@ func (r *actionRunner) handleSlackWebhook(ctx context.Context, j *database.Actio
 		return err
 	}
 
-	args := actionArgs{
-		MonitorDescription: m.Description,
-		MonitorID:          w.Monitor,
-		ExternalURL:        externalURL,
-		UTMSource:          "code-monitor-slack-webhook",
-		Query:              m.Query,
-		MonitorOwnerName:   m.OwnerName,
-		Results:            m.Results,
-		IncludeResults:     w.IncludeResults,
+	args.ExternalURL = externalURL
+	args.UTMSource = "code-monitor-teams-webhook"
+	args.IncludeResults = w.IncludeResults
+
+	if err := sendTeamsNotification(ctx, w.URL, *args); err != nil {
+		return err
 	}
+	return s.MarkActionJobSent(ctx, j.ID)
+}
 
-	return sendSlackNotification(ctx, w.URL, args)
+func (r *actionRunner) handleTemplatedWebhook(ctx context.Context, j *database.ActionJob) (err error) {
+	s, err := r.CodeMonitorStore.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = s.Done(err) }()
+
+	w, err := s.GetTemplatedWebhookAction(ctx, *j.TemplatedWebhook)
+	if err != nil {
+		return errors.Wrap(err, "GetTemplatedWebhookAction")
+	}
+
+	args, err := prepareNotification(ctx, s, j, w.Delivery)
+	if err != nil || args == nil {
+		return err
+	}
+
+	externalURL, err := url.Parse(conf.Get().ExternalURL)
+	if err != nil {
+		return err
+	}
+
+	args.ExternalURL = externalURL
+	args.UTMSource = "code-monitor-templated-webhook"
+	args.IncludeResults = w.IncludeResults
+
+	if err := sendTemplatedWebhookNotification(ctx, w, *args); err != nil {
+		return err
+	}
+	return s.MarkActionJobSent(ctx, j.ID)
 }
 
 type StatusCodeError struct {
@@ -0,0 +1,194 @@
+package codemonitors
+
+import (
+	"context"
+	"sort"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/client"
+	"github.com/sourcegraph/sourcegraph/internal/search/commit"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/jobutil"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+var ErrInvalidContentMonitorQuery = errors.New("content code monitors cannot use type:commit or type:diff searches")
+
+// ErrIncompleteContentSearch is returned when a content search did not search
+// everything it matches. Comparing incomplete results to the previous run would
+// report the files which were not searched as no longer matching.
+var ErrIncompleteContentSearch = errors.New("search did not complete, so its results were not compared to the previous run. If the search hit its result limit, add count:all to the query")
+
+// ValidateContentQuery returns an error if query cannot be run by a content
+// trigger.
+func ValidateContentQuery(ctx context.Context, logger log.Logger, db database.DB, query string) error {
+	searchClient := client.New(logger, db, gitserver.NewClient("monitors.search.content"))
+	_, err := planContentSearch(ctx, searchClient, query)
+	return err
+}
+
+// SearchContent runs the query of a content trigger and returns the files it
+// matches, sorted by repository and path.
+func SearchContent(ctx context.Context, logger log.Logger, db database.DB, query string) ([]database.ContentMatch, error) {
+	searchClient := client.New(logger, db, gitserver.NewClient("monitors.search.content"))
+	planJob, err := planContentSearch(ctx, searchClient, query)
+	if err != nil {
+		return nil, errcode.MakeNonRetryable(err)
+	}
+
+	agg := streaming.NewAggregatingStream()
+	_, err = planJob.Run(ctx, searchClient.JobClients(), agg)
+	if err != nil {
+		return nil, err
+	}
+	if agg.Stats.IsLimitHit || agg.Stats.Status.Any(search.RepoStatusTimedOut|search.RepoStatusCloning|search.RepoStatusLimitHit) {
+		return nil, ErrIncompleteContentSearch
+	}
+
+	seen := make(map[database.ContentMatch]struct{}, len(agg.Results))
+	matches := make([]database.ContentMatch, 0, len(agg.Results))
+	for _, res := range agg.Results {
+		fm, ok := res.(*result.FileMatch)
+		if !ok {
+			// Repository matches don't belong to a file.
+			continue
+		}
+		m := database.ContentMatch{RepoID: fm.Repo.ID, RepoName: string(fm.Repo.Name), Path: fm.Path}
+		if _, ok := seen[m]; ok {
+			continue
+		}
+		seen[m] = struct{}{}
+		matches = append(matches, m)
+	}
+	sortContentMatches(matches)
+	return matches, nil
+}
+
+func planContentSearch(ctx context.Context, searchClient client.SearchClient, query string) (job.Job, error) {
+	inputs, err := searchClient.Plan(
+		ctx,
+		"V3",
+		nil,
+		query,
+		search.Precise,
+		search.Streaming,
+		pointers.Ptr(int32(0)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	planJob, err := jobutil.NewPlanJob(inputs, inputs.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	isCommitSearch := false
+	job.Map(planJob, func(j job.Job) job.Job {
+		if _, ok := j.(*commit.SearchJob); ok {
+			isCommitSearch = true
+		}
+		return j
+	})
+	if isCommitSearch {
+		return nil, ErrInvalidContentMonitorQuery
+	}
+	return planJob, nil
+}
+
+// DiffContentMatches returns the files which are matched by current but not
+// previous, and the files which are matched by previous but not current.
+func DiffContentMatches(previous, current []database.ContentMatch) *database.ContentChanges {
+	key := func(m database.ContentMatch) database.ContentMatch {
+		// Repositories can be renamed, so files are compared by repository ID.
+		m.RepoName = ""
+		return m
+	}
+	previousSet := make(map[database.ContentMatch]struct{}, len(previous))
+	for _, m := range previous {
+		previousSet[key(m)] = struct{}{}
+	}
+	currentSet := make(map[database.ContentMatch]struct{}, len(current))
+	for _, m := range current {
+		currentSet[key(m)] = struct{}{}
+	}
+
+	changes := &database.ContentChanges{}
+	for _, m := range current {
+		if _, ok := previousSet[key(m)]; !ok {
+			changes.Added = append(changes.Added, m)
+		}
+	}
+	for _, m := range previous {
+		if _, ok := currentSet[key(m)]; !ok {
+			changes.Removed = append(changes.Removed, m)
+		}
+	}
+	sortContentMatches(changes.Added)
+	sortContentMatches(changes.Removed)
+	return changes
+}
+
+// MergeContentChanges returns the net changes of a sequence of changes, ordered
+// oldest first. A file which started and then stopped matching, or the other way
+// around, is not changed.
+func MergeContentChanges(changes []*database.ContentChanges) *database.ContentChanges {
+	type change struct {
+		match database.ContentMatch
+		added bool
+	}
+	key := func(m database.ContentMatch) database.ContentMatch {
+		m.RepoName = ""
+		return m
+	}
+
+	net := make(map[database.ContentMatch]change)
+	apply := func(m database.ContentMatch, added bool) {
+		k := key(m)
+		if c, ok := net[k]; ok && c.added != added {
+			delete(net, k)
+			return
+		}
+		net[k] = change{match: m, added: added}
+	}
+	for _, c := range changes {
+		if c == nil {
+			continue
+		}
+		for _, m := range c.Added {
+			apply(m, true)
+		}
+		for _, m := range c.Removed {
+			apply(m, false)
+		}
+	}
+
+	merged := &database.ContentChanges{}
+	for _, c := range net {
+		if c.added {
+			merged.Added = append(merged.Added, c.match)
+		} else {
+			merged.Removed = append(merged.Removed, c.match)
+		}
+	}
+	sortContentMatches(merged.Added)
+	sortContentMatches(merged.Removed)
+	return merged
+}
+
+func sortContentMatches(matches []database.ContentMatch) {
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].RepoName != matches[j].RepoName {
+			return matches[i].RepoName < matches[j].RepoName
+		}
+		return matches[i].Path < matches[j].Path
+	})
+}
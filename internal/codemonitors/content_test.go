@@ -0,0 +1,62 @@
+package codemonitors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+)
+
+func TestDiffContentMatches(t *testing.T) {
+	a := database.ContentMatch{RepoID: 1, RepoName: "github.com/a/a", Path: "a.go"}
+	b := database.ContentMatch{RepoID: 1, RepoName: "github.com/a/a", Path: "b.go"}
+	c := database.ContentMatch{RepoID: 2, RepoName: "github.com/a/c", Path: "a.go"}
+
+	t.Run("no changes", func(t *testing.T) {
+		changes := DiffContentMatches([]database.ContentMatch{a, b}, []database.ContentMatch{b, a})
+		require.Zero(t, changes.Count())
+	})
+
+	t.Run("added and removed", func(t *testing.T) {
+		changes := DiffContentMatches([]database.ContentMatch{a, b}, []database.ContentMatch{c, a})
+		require.Equal(t, []database.ContentMatch{c}, changes.Added)
+		require.Equal(t, []database.ContentMatch{b}, changes.Removed)
+	})
+
+	t.Run("renamed repository is not a change", func(t *testing.T) {
+		renamed := a
+		renamed.RepoName = "github.com/a/renamed"
+		changes := DiffContentMatches([]database.ContentMatch{a}, []database.ContentMatch{renamed})
+		require.Zero(t, changes.Count())
+	})
+
+	t.Run("everything removed", func(t *testing.T) {
+		changes := DiffContentMatches([]database.ContentMatch{c, a}, nil)
+		require.Empty(t, changes.Added)
+		require.Equal(t, []database.ContentMatch{a, c}, changes.Removed)
+	})
+}
+
+func TestMergeContentChanges(t *testing.T) {
+	a := database.ContentMatch{RepoID: 1, RepoName: "github.com/a/a", Path: "a.go"}
+	b := database.ContentMatch{RepoID: 1, RepoName: "github.com/a/a", Path: "b.go"}
+	c := database.ContentMatch{RepoID: 2, RepoName: "github.com/a/c", Path: "a.go"}
+
+	merged := MergeContentChanges([]*database.ContentChanges{
+		{Added: []database.ContentMatch{c, a}},
+		nil,
+		{Added: []database.ContentMatch{b}, Removed: []database.ContentMatch{a}},
+	})
+	require.Equal(t, []database.ContentMatch{b, c}, merged.Added)
+	require.Empty(t, merged.Removed)
+
+	t.Run("removed and added again is not a change", func(t *testing.T) {
+		merged := MergeContentChanges([]*database.ContentChanges{
+			{Removed: []database.ContentMatch{a}},
+			{Added: []database.ContentMatch{a}, Removed: []database.ContentMatch{b}},
+		})
+		require.Empty(t, merged.Added)
+		require.Equal(t, []database.ContentMatch{b}, merged.Removed)
+	})
+}
@@ -8,54 +8,112 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"syscall"
 	"testing"
 
-	"github.com/hexops/autogold/v2"
-
 	"github.com/sourcegraph/log/logtest"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
-func TestMatchesUnmarshalling(t *testing.T) {
-	// If we are not on CI skip the test if comby is not installed.
-	if os.Getenv("CI") == "" && !Exists() {
-		t.Skip("comby is not installed on the PATH. Try running 'bash <(curl -sL get.comby.dev)'.")
-	}
-
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+// The cases below describe the behaviour of the comby binary. They are also
+// run against the native matcher in native_test.go, which must agree with
+// comby on all of them.
 
-	files := map[string]string{
-		"main.go": `package main
+const helloFooGo = `package main
 
 import "fmt"
 
 func main() {
 	fmt.Println("Hello foo")
 }
+`
+
+type combyTestCase struct {
+	name  string
+	files map[string]string
+	// args is the comby invocation without its Input, which the tests set.
+	args Args
+	// want is the output of comby.
+	want string
+	// rewritten is the content of the single file changed by a rewrite.
+	// The native matcher does not produce diffs, so it is compared against
+	// this instead of want.
+	rewritten string
+}
+
+var matchesTestCases = []combyTestCase{{
+	name:  "func",
+	files: map[string]string{"main.go": helloFooGo},
+	args: Args{
+		MatchTemplate: "func",
+		FilePatterns:  []string{".go"},
+		Matcher:       ".go",
+	},
+	want: "func",
+}}
+
+var matchesInZipTestCases = []combyTestCase{{
+	name: "diff",
+	files: map[string]string{
+		"README.md": `# Hello World
+
+Hello world example in go`,
+		"main.go": helloFooGo,
+	},
+	args: Args{
+		MatchTemplate:   "func",
+		RewriteTemplate: "derp",
+		ResultKind:      Diff,
+		FilePatterns:    []string{".go"},
+		Matcher:         ".go",
+	},
+	want: `{"uri":"main.go","diff":"--- main.go\n+++ main.go\n@@ -2,6 +2,6 @@\n \n import \"fmt\"\n \n-func main() {\n+derp main() {\n \tfmt.Println(\"Hello foo\")\n }"}
 `,
-	}
+	rewritten: strings.Replace(helloFooGo, "func", "derp", 1),
+}}
+
+var stdinTestCases = []combyTestCase{{
+	name: "diff",
+	args: Args{
+		Input:           FileContent("yes\n"),
+		MatchTemplate:   "yes",
+		RewriteTemplate: "no",
+		ResultKind:      Diff,
+		FilePatterns:    []string{".go"},
+		Matcher:         ".go",
+	},
+	want: `{"uri":null,"diff":"--- /dev/null\n+++ /dev/null\n@@ -1,1 +1,1 @@\n-yes\n+no"}
+`,
+	rewritten: "no\n",
+}}
+
+var replacementsTestCases = []combyTestCase{{
+	name:  "replacement",
+	files: map[string]string{"main.go": `package tuesday`},
+	args: Args{
+		MatchTemplate:   "tuesday",
+		RewriteTemplate: "wednesday",
+		ResultKind:      Replacement,
+		FilePatterns:    []string{".go"},
+		Matcher:         ".go",
+	},
+	want: "package wednesday",
+}}
 
-	zipPath := tempZipFromFiles(t, files)
-
-	cases := []struct {
-		args Args
-		want string
-	}{
-		{
-			args: Args{
-				Input:         ZipPath(zipPath),
-				MatchTemplate: "func",
-				FilePatterns:  []string{".go"},
-				Matcher:       ".go",
-			},
-			want: "func",
-		},
+func TestMatchesUnmarshalling(t *testing.T) {
+	// If we are not on CI skip the test if comby is not installed.
+	if os.Getenv("CI") == "" && !Exists() {
+		t.Skip("comby is not installed on the PATH. Try running 'bash <(curl -sL get.comby.dev)'.")
 	}
 
-	for _, test := range cases {
-		m, err := Matches(ctx, logtest.Scoped(t), test.args)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for _, test := range matchesTestCases {
+		args := test.args
+		args.Input = ZipPath(tempZipFromFiles(t, test.files))
+		m, err := Matches(ctx, logtest.Scoped(t), args)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -76,43 +134,11 @@ func TestMatchesInZip(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	files := map[string]string{
-
-		"README.md": `# Hello World
-
-Hello world example in go`,
-		"main.go": `package main
-
-import "fmt"
-
-func main() {
-	fmt.Println("Hello foo")
-}
-`,
-	}
-
-	zipPath := tempZipFromFiles(t, files)
-
-	cases := []struct {
-		args Args
-		want string
-	}{
-		{
-			args: Args{
-				Input:           ZipPath(zipPath),
-				MatchTemplate:   "func",
-				RewriteTemplate: "derp",
-				ResultKind:      Diff,
-				FilePatterns:    []string{".go"},
-				Matcher:         ".go",
-			},
-			want: `{"uri":"main.go","diff":"--- main.go\n+++ main.go\n@@ -2,6 +2,6 @@\n \n import \"fmt\"\n \n-func main() {\n+derp main() {\n \tfmt.Println(\"Hello foo\")\n }"}
-`},
-	}
-
-	for _, test := range cases {
+	for _, test := range matchesInZipTestCases {
+		args := test.args
+		args.Input = ZipPath(tempZipFromFiles(t, test.files))
 		var b bytes.Buffer
-		err := runWithoutPipes(ctx, test.args, &b)
+		err := runWithoutPipes(ctx, args, &b)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -144,16 +170,11 @@ func Test_stdin(t *testing.T) {
 		return b.String()
 	}
 
-	autogold.Expect(`{"uri":null,"diff":"--- /dev/null\n+++ /dev/null\n@@ -1,1 +1,1 @@\n-yes\n+no"}
-`).
-		Equal(t, test(Args{
-			Input:           FileContent("yes\n"),
-			MatchTemplate:   "yes",
-			RewriteTemplate: "no",
-			ResultKind:      Diff,
-			FilePatterns:    []string{".go"},
-			Matcher:         ".go",
-		}))
+	for _, tc := range stdinTestCases {
+		if got := test(tc.args); got != tc.want {
+			t.Errorf("got %v, want %v", got, tc.want)
+		}
+	}
 }
 
 func TestReplacements(t *testing.T) {
@@ -165,31 +186,10 @@ func TestReplacements(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	files := map[string]string{
-		"main.go": `package tuesday`,
-	}
-
-	zipPath := tempZipFromFiles(t, files)
-
-	cases := []struct {
-		args Args
-		want string
-	}{
-		{
-			args: Args{
-				Input:           ZipPath(zipPath),
-				MatchTemplate:   "tuesday",
-				RewriteTemplate: "wednesday",
-				ResultKind:      Replacement,
-				FilePatterns:    []string{".go"},
-				Matcher:         ".go",
-			},
-			want: "package wednesday",
-		},
-	}
-
-	for _, test := range cases {
-		r, err := Replacements(ctx, logtest.Scoped(t), test.args)
+	for _, test := range replacementsTestCases {
+		args := test.args
+		args.Input = ZipPath(tempZipFromFiles(t, test.files))
+		r, err := Replacements(ctx, logtest.Scoped(t), args)
 		if err != nil {
 			t.Fatal(err)
 		}
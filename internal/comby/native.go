@@ -0,0 +1,717 @@
+package comby
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ErrUnsupported is returned by NewMatcher for match templates and rules that
+// only the comby binary can evaluate.
+var ErrUnsupported = errors.New("structural pattern is not supported by the native matcher")
+
+// maxMatchSteps bounds the backtracking work done for a single file, so that
+// pathological templates cannot stall a search.
+const maxMatchSteps = 10_000_000
+
+// Matcher matches a comby match template against file contents without the
+// comby binary. It supports the hole syntax of comby together with
+// delimiter, string and comment aware matching for the languages comby knows.
+type Matcher struct {
+	terms  []nativeTerm
+	rule   []ruleCondition
+	syntax *languageSyntax
+}
+
+type termKind int
+
+const (
+	termLiteral termKind = iota
+	termSpace
+	termHole
+)
+
+type holeKind int
+
+const (
+	// holeAnything is :[x] and ..., it matches balanced text lazily.
+	holeAnything holeKind = iota
+	// holeAlphanum is :[[x]], it matches a word.
+	holeAlphanum
+	// holePunctuation is :[x.], it matches non-whitespace that does not
+	// affect balanced syntax.
+	holePunctuation
+	// holeLine is :[x\n], it matches up to and including a newline.
+	holeLine
+	// holeWhitespace is :[ x], it matches whitespace other than newlines.
+	holeWhitespace
+	// holeRegexp is :[x~re], it matches a regular expression.
+	holeRegexp
+)
+
+type nativeTerm struct {
+	kind    termKind
+	literal []byte
+	// optional is set for termSpace next to punctuation, where comby allows
+	// the source to omit the whitespace of the template.
+	optional bool
+	hole     holeKind
+	name     string
+	re       *regexp.Regexp
+}
+
+var (
+	alphanumHolePattern    = lazyregexp.New(`^:\[\[(\w*)\]\]`)
+	punctuationHolePattern = lazyregexp.New(`^:\[(\w*)\.\]`)
+	lineHolePattern        = lazyregexp.New(`^:\[(\w*)\\n\]`)
+	whitespaceHolePattern  = lazyregexp.New(`^:\[ +(\w*)\]`)
+	anythingHolePattern    = lazyregexp.New(`^:\[(\w*)\]`)
+	regexpHolePattern      = lazyregexp.New(`^:\[(\w*)~`)
+	typedHolePattern       = lazyregexp.New(`^:\[\w*:`)
+)
+
+// NewMatcher returns a Matcher for matchTemplate and rule using the syntax of
+// the given comby matcher, e.g. ".go" or ".generic". It returns an error
+// wrapping ErrUnsupported if the template or rule needs the comby binary.
+func NewMatcher(matchTemplate, rule, matcher string) (*Matcher, error) {
+	terms, err := parseNativeTemplate(strings.TrimSpace(matchTemplate))
+	if err != nil {
+		return nil, err
+	}
+	conditions, err := parseRule(rule)
+	if err != nil {
+		return nil, err
+	}
+	return &Matcher{
+		terms:  terms,
+		rule:   conditions,
+		syntax: syntaxForMatcher(matcher),
+	}, nil
+}
+
+func parseNativeTemplate(template string) ([]nativeTerm, error) {
+	var terms []nativeTerm
+	var literal []byte
+
+	flushLiteral := func() {
+		if len(literal) > 0 {
+			terms = append(terms, nativeTerm{kind: termLiteral, literal: literal})
+			literal = nil
+		}
+	}
+	addHole := func(kind holeKind, name string) {
+		flushLiteral()
+		if name == "_" {
+			name = ""
+		}
+		terms = append(terms, nativeTerm{kind: termHole, hole: kind, name: name})
+	}
+
+	for i := 0; i < len(template); {
+		rest := template[i:]
+
+		if strings.HasPrefix(rest, "...") {
+			addHole(holeAnything, "")
+			i += len("...")
+			continue
+		}
+
+		if isSpace(rest[0]) {
+			flushLiteral()
+			for i < len(template) && isSpace(template[i]) {
+				i++
+			}
+			terms = append(terms, nativeTerm{kind: termSpace})
+			continue
+		}
+
+		if strings.HasPrefix(rest, ":[") {
+			holes := []struct {
+				pattern *lazyregexp.Regexp
+				kind    holeKind
+			}{
+				{alphanumHolePattern, holeAlphanum},
+				{punctuationHolePattern, holePunctuation},
+				{lineHolePattern, holeLine},
+				{whitespaceHolePattern, holeWhitespace},
+				{anythingHolePattern, holeAnything},
+			}
+			matched := false
+			for _, h := range holes {
+				if m := h.pattern.FindStringSubmatch(rest); m != nil {
+					addHole(h.kind, m[1])
+					i += len(m[0])
+					matched = true
+					break
+				}
+			}
+			if matched {
+				continue
+			}
+
+			if m := regexpHolePattern.FindStringSubmatch(rest); m != nil {
+				end := closingHoleBracket(rest, len(m[0]))
+				if end < 0 {
+					return nil, errors.Newf("unterminated regular expression hole in %q", rest)
+				}
+				re, err := regexp.Compile(`^(?:` + rest[len(m[0]):end] + `)`)
+				if err != nil {
+					return nil, err
+				}
+				addHole(holeRegexp, m[1])
+				terms[len(terms)-1].re = re
+				i += end + 1
+				continue
+			}
+
+			if typedHolePattern.MatchString(rest) {
+				return nil, errors.Wrapf(ErrUnsupported, "hole %q", rest)
+			}
+		}
+
+		literal = append(literal, rest[0])
+		i++
+	}
+	flushLiteral()
+
+	// Whitespace in the template may be omitted in the source when it is
+	// next to punctuation, e.g. "foo( :[x] )" matches "foo(x)".
+	for i := range terms {
+		if terms[i].kind != termSpace {
+			continue
+		}
+		if i > 0 && terms[i-1].kind == termLiteral && !isWordByte(terms[i-1].literal[len(terms[i-1].literal)-1]) {
+			terms[i].optional = true
+		}
+		if i+1 < len(terms) && terms[i+1].kind == termLiteral && !isWordByte(terms[i+1].literal[0]) {
+			terms[i].optional = true
+		}
+	}
+	return terms, nil
+}
+
+// closingHoleBracket returns the index of the ']' closing the regular
+// expression hole starting at s, where the expression begins at start.
+// Character classes inside the expression may contain brackets.
+func closingHoleBracket(s string, start int) int {
+	depth := 0
+	for i := start; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		}
+	}
+	return -1
+}
+
+// ruleCondition is a single comparison of a comby rule, e.g.
+// `:[x] == "foo"`. Operands are either a hole name or a string literal.
+type ruleCondition struct {
+	negated     bool
+	left, right ruleOperand
+}
+
+type ruleOperand struct {
+	hole    string
+	literal string
+	isHole  bool
+}
+
+var (
+	ruleHolePattern   = lazyregexp.New(`^:\[(\w+)\]`)
+	ruleStringPattern = lazyregexp.New(`^"(?:[^"\\]|\\.)*"`)
+)
+
+// parseRule parses the subset of comby rules supported natively: a
+// comma-separated conjunction of == and != comparisons.
+func parseRule(rule string) ([]ruleCondition, error) {
+	rule = strings.TrimSpace(rule)
+	if rule == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(rule, "where") {
+		return nil, errors.Wrapf(ErrUnsupported, "rule %q", rule)
+	}
+	rest := strings.TrimSpace(strings.TrimPrefix(rule, "where"))
+
+	parseOperand := func() (ruleOperand, error) {
+		rest = strings.TrimSpace(rest)
+		if m := ruleHolePattern.FindStringSubmatch(rest); m != nil {
+			rest = rest[len(m[0]):]
+			return ruleOperand{hole: m[1], isHole: true}, nil
+		}
+		if m := ruleStringPattern.FindString(rest); m != "" {
+			rest = rest[len(m):]
+			s, err := strconv.Unquote(m)
+			if err != nil {
+				return ruleOperand{}, errors.Wrapf(ErrUnsupported, "rule %q", rule)
+			}
+			return ruleOperand{literal: s}, nil
+		}
+		return ruleOperand{}, errors.Wrapf(ErrUnsupported, "rule %q", rule)
+	}
+
+	var conditions []ruleCondition
+	for {
+		left, err := parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		rest = strings.TrimSpace(rest)
+		var c ruleCondition
+		switch {
+		case strings.HasPrefix(rest, "=="):
+		case strings.HasPrefix(rest, "!="):
+			c.negated = true
+		default:
+			return nil, errors.Wrapf(ErrUnsupported, "rule %q", rule)
+		}
+		rest = rest[2:]
+		right, err := parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		c.left, c.right = left, right
+		conditions = append(conditions, c)
+
+		rest = strings.TrimSpace(rest)
+		if rest == "" {
+			return conditions, nil
+		}
+		if rest[0] != ',' {
+			return nil, errors.Wrapf(ErrUnsupported, "rule %q", rule)
+		}
+		rest = rest[1:]
+	}
+}
+
+// Matches returns the non-overlapping matches of the template in content, in
+// the order they appear. Locations use 1-based lines and columns like the
+// output of comby.
+func (m *Matcher) Matches(content []byte) []Match {
+	if len(m.terms) == 0 {
+		// Like comby, an empty template matches every file once.
+		return []Match{{}}
+	}
+
+	var matches []Match
+	m.scan(content, func(s *matchState, start, end int) {
+		matches = append(matches, s.src.toMatch(start, end))
+	})
+	return matches
+}
+
+// Rewrite replaces the non-overlapping matches of the template in content
+// with rewriteTemplate, in which holes are substituted with the text they
+// matched. Holes that are not bound by the match template are kept as is. It
+// returns the rewritten content and the number of replaced matches.
+func (m *Matcher) Rewrite(content []byte, rewriteTemplate string) ([]byte, int) {
+	if len(m.terms) == 0 {
+		return content, 0
+	}
+
+	var (
+		out   bytes.Buffer
+		last  int
+		count int
+	)
+	m.scan(content, func(s *matchState, start, end int) {
+		out.Write(content[last:start])
+		out.WriteString(rewriteHolePattern.ReplaceAllStringFunc(rewriteTemplate, func(hole string) string {
+			m := rewriteHolePattern.FindStringSubmatch(hole)
+			name := m[1] + m[2]
+			if v, ok := s.lookup(name); ok {
+				return v
+			}
+			return hole
+		}))
+		last = end
+		count++
+	})
+	if count == 0 {
+		return content, 0
+	}
+	out.Write(content[last:])
+	return out.Bytes(), count
+}
+
+// rewriteHolePattern matches the holes of a rewrite template. Like comby, the
+// kind of a hole doesn't matter when substituting it.
+var rewriteHolePattern = lazyregexp.New(`:\[\[(\w+)\]\]|:\[ *(\w+)(?:\.|\\n)?\]`)
+
+// scan calls yield for each of the non-overlapping matches of the template in
+// content, in the order they appear. The bindings of the match are set on the
+// matchState passed to yield.
+func (m *Matcher) scan(content []byte, yield func(s *matchState, start, end int)) {
+	s := &matchState{
+		terms: m.terms,
+		rule:  m.rule,
+		src:   newSource(content, m.syntax),
+	}
+
+	var first []byte
+	if m.terms[0].kind == termLiteral {
+		first = m.terms[0].literal
+	}
+
+	for start := 0; start < len(content); {
+		if first != nil {
+			// Skip ahead to the next occurrence of the leading literal.
+			idx := bytes.Index(content[start:], first)
+			if idx < 0 {
+				break
+			}
+			start += idx
+		}
+		if s.steps > maxMatchSteps {
+			break
+		}
+
+		if isSpace(content[start]) || s.src.inComment(start) {
+			start++
+			continue
+		}
+
+		s.bindings = s.bindings[:0]
+		end, ok := s.match(0, start)
+		if !ok {
+			start++
+			continue
+		}
+		yield(s, start, end)
+		if end > start {
+			start = end
+		} else {
+			start++
+		}
+	}
+}
+
+type binding struct {
+	name       string
+	start, end int
+}
+
+type matchState struct {
+	terms    []nativeTerm
+	rule     []ruleCondition
+	src      *source
+	bindings []binding
+	steps    int
+}
+
+func (s *matchState) lookup(name string) (string, bool) {
+	for _, b := range s.bindings {
+		if b.name == name {
+			return string(s.src.buf[b.start:b.end]), true
+		}
+	}
+	return "", false
+}
+
+func (s *matchState) ruleHolds() bool {
+	value := func(o ruleOperand) string {
+		if !o.isHole {
+			return o.literal
+		}
+		v, _ := s.lookup(o.hole)
+		return v
+	}
+	for _, c := range s.rule {
+		if (value(c.left) == value(c.right)) == c.negated {
+			return false
+		}
+	}
+	return true
+}
+
+// match matches the terms starting at ti against the source at pos and
+// returns the end of the match.
+func (s *matchState) match(ti, pos int) (int, bool) {
+	s.steps++
+	if s.steps > maxMatchSteps {
+		return 0, false
+	}
+	if ti == len(s.terms) {
+		return pos, s.ruleHolds()
+	}
+
+	buf := s.src.buf
+	t := &s.terms[ti]
+	switch t.kind {
+	case termLiteral:
+		if !bytes.HasPrefix(buf[pos:], t.literal) {
+			return 0, false
+		}
+		return s.match(ti+1, pos+len(t.literal))
+
+	case termSpace:
+		end := s.src.skipSpace(pos)
+		if end == pos && !t.optional {
+			return 0, false
+		}
+		return s.match(ti+1, end)
+	}
+
+	if t.name != "" {
+		if v, ok := s.lookup(t.name); ok {
+			// Holes sharing a name must match the same text.
+			if !bytes.HasPrefix(buf[pos:], []byte(v)) {
+				return 0, false
+			}
+			return s.match(ti+1, pos+len(v))
+		}
+	}
+
+	try := func(end int) (int, bool) {
+		if t.name != "" {
+			s.bindings = append(s.bindings, binding{name: t.name, start: pos, end: end})
+		}
+		matchEnd, ok := s.match(ti+1, end)
+		if !ok && t.name != "" {
+			s.bindings = s.bindings[:len(s.bindings)-1]
+		}
+		return matchEnd, ok
+	}
+
+	switch t.hole {
+	case holeAlphanum:
+		end := pos
+		for end < len(buf) && isWordByte(buf[end]) {
+			end++
+		}
+		if end == pos {
+			return 0, false
+		}
+		return try(end)
+
+	case holePunctuation:
+		end := pos
+		for end < len(buf) && !isSpace(buf[end]) && !s.src.syntax.isDelimiter(buf[end]) {
+			end++
+		}
+		for ; end > pos; end-- {
+			if matchEnd, ok := try(end); ok {
+				return matchEnd, true
+			}
+		}
+		return 0, false
+
+	case holeLine:
+		end := bytes.IndexByte(buf[pos:], '\n')
+		if end < 0 {
+			end = len(buf)
+		} else {
+			end += pos + 1
+		}
+		return try(end)
+
+	case holeWhitespace:
+		end := pos
+		for end < len(buf) && (buf[end] == ' ' || buf[end] == '\t' || buf[end] == '\r') {
+			end++
+		}
+		if end == pos {
+			return 0, false
+		}
+		return try(end)
+
+	case holeRegexp:
+		loc := t.re.FindIndex(buf[pos:])
+		if loc == nil {
+			return 0, false
+		}
+		return try(pos + loc[1])
+	}
+
+	// holeAnything
+	if ti == len(s.terms)-1 {
+		// A trailing hole extends as far as possible.
+		var ends []int
+		s.src.balancedEnds(pos, true, func(end int) bool {
+			ends = append(ends, end)
+			return true
+		})
+		for i := len(ends) - 1; i >= 0; i-- {
+			if matchEnd, ok := try(ends[i]); ok {
+				return matchEnd, true
+			}
+		}
+		return 0, false
+	}
+	matchEnd, found := 0, false
+	s.src.balancedEnds(pos, false, func(end int) bool {
+		matchEnd, found = try(end)
+		return !found && s.steps <= maxMatchSteps
+	})
+	return matchEnd, found
+}
+
+// source is file content annotated with its string and comment regions.
+type source struct {
+	buf    []byte
+	syntax *languageSyntax
+	// region holds the index into regions for every byte inside a string or
+	// comment, and -1 for code.
+	region  []int32
+	regions []sourceRegion
+	// lineStarts holds the offset of the first byte of every line.
+	lineStarts []int
+}
+
+type sourceRegion struct {
+	start, end int
+	// bodyEnd is the offset of the closing delimiter of the region.
+	bodyEnd int
+	comment bool
+}
+
+func newSource(buf []byte, syntax *languageSyntax) *source {
+	s := &source{
+		buf:        buf,
+		syntax:     syntax,
+		region:     make([]int32, len(buf)),
+		lineStarts: []int{0},
+	}
+	for i := range s.region {
+		s.region[i] = -1
+	}
+	for i, c := range buf {
+		if c == '\n' {
+			s.lineStarts = append(s.lineStarts, i+1)
+		}
+	}
+
+	for i := 0; i < len(buf); {
+		r, ok := syntax.regionAt(buf, i)
+		if !ok {
+			i++
+			continue
+		}
+		for j := r.start; j < r.end; j++ {
+			s.region[j] = int32(len(s.regions))
+		}
+		s.regions = append(s.regions, r)
+		i = r.end
+	}
+	return s
+}
+
+func (s *source) inComment(pos int) bool {
+	r := s.region[pos]
+	return r >= 0 && s.regions[r].comment
+}
+
+// skipSpace returns the end of the whitespace and comments starting at pos.
+func (s *source) skipSpace(pos int) int {
+	for pos < len(s.buf) {
+		if isSpace(s.buf[pos]) {
+			pos++
+			continue
+		}
+		if r := s.region[pos]; r >= 0 && s.regions[r].comment && s.regions[r].start == pos {
+			pos = s.regions[r].end
+			continue
+		}
+		break
+	}
+	return pos
+}
+
+// balancedEnds calls yield with the offsets, in increasing order, at which a
+// hole starting at pos may end, until yield returns false. Delimiters in the
+// hole must be balanced, and strings and comments cannot be split, unless the
+// hole starts inside one, in which case it cannot leave it. A trailing hole
+// does not extend past the end of the line it starts on unless it is inside
+// delimiters.
+func (s *source) balancedEnds(pos int, trailing bool, yield func(int) bool) {
+	buf := s.buf
+	if pos < len(buf) {
+		if r := s.region[pos]; r >= 0 && s.regions[r].start != pos {
+			for end := pos; end <= s.regions[r].bodyEnd; end++ {
+				if !yield(end) {
+					return
+				}
+			}
+			return
+		}
+	}
+
+	if !yield(pos) {
+		return
+	}
+	var stack []byte
+	for i := pos; i < len(buf); {
+		if r := s.region[i]; r >= 0 {
+			i = s.regions[r].end
+		} else {
+			c := buf[i]
+			if closer, ok := s.syntax.closerFor(c); ok {
+				stack = append(stack, closer)
+			} else if s.syntax.isCloser(c) {
+				if len(stack) == 0 || stack[len(stack)-1] != c {
+					return
+				}
+				stack = stack[:len(stack)-1]
+			} else if c == '\n' && trailing && len(stack) == 0 {
+				return
+			}
+			i++
+		}
+		if len(stack) == 0 && !yield(i) {
+			return
+		}
+	}
+}
+
+func (s *source) location(offset int) Location {
+	// Find the last line starting at or before offset.
+	lo, hi := 0, len(s.lineStarts)
+	for hi-lo > 1 {
+		mid := (lo + hi) / 2
+		if s.lineStarts[mid] <= offset {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return Location{
+		Offset: offset,
+		Line:   lo + 1,
+		Column: utf8.RuneCount(s.buf[s.lineStarts[lo]:offset]) + 1,
+	}
+}
+
+func (s *source) toMatch(start, end int) Match {
+	return Match{
+		Range: Range{
+			Start: s.location(start),
+			End:   s.location(end),
+		},
+		Matched: string(s.buf[start:end]),
+	}
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
+
+// isWordByte reports whether c belongs to a word. Bytes of multi-byte UTF-8
+// sequences are treated as word characters so that identifiers in other
+// scripts match :[[x]].
+func isWordByte(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= utf8.RuneSelf
+}
@@ -0,0 +1,230 @@
+package comby
+
+import "bytes"
+
+// languageSyntax describes the parts of a language the native matcher needs
+// to know about: balanced delimiters, and the strings and comments inside
+// which delimiters do not count.
+type languageSyntax struct {
+	delimiters    []delimiter
+	strings       []delimitedSyntax
+	lineComments  []string
+	blockComments []delimitedSyntax
+}
+
+type delimiter struct {
+	open, close byte
+}
+
+type delimitedSyntax struct {
+	open, close string
+	// escapes is set if a backslash escapes the character following it.
+	escapes bool
+	// multiline is set if the region may span several lines.
+	multiline bool
+}
+
+var balancedDelimiters = []delimiter{{'(', ')'}, {'[', ']'}, {'{', '}'}}
+
+var (
+	doubleQuoted = delimitedSyntax{open: `"`, close: `"`, escapes: true}
+	singleQuoted = delimitedSyntax{open: `'`, close: `'`, escapes: true}
+	backQuoted   = delimitedSyntax{open: "`", close: "`", multiline: true}
+
+	cBlockComment = delimitedSyntax{open: "/*", close: "*/", multiline: true}
+)
+
+func cLike(strings ...delimitedSyntax) *languageSyntax {
+	return &languageSyntax{
+		delimiters:    balancedDelimiters,
+		strings:       strings,
+		lineComments:  []string{"//"},
+		blockComments: []delimitedSyntax{cBlockComment},
+	}
+}
+
+var (
+	genericSyntax = &languageSyntax{
+		delimiters: balancedDelimiters,
+		strings:    []delimitedSyntax{doubleQuoted},
+	}
+	textSyntax = &languageSyntax{
+		delimiters: balancedDelimiters,
+	}
+	hashCommentSyntax = &languageSyntax{
+		delimiters:   balancedDelimiters,
+		strings:      []delimitedSyntax{doubleQuoted, singleQuoted},
+		lineComments: []string{"#"},
+	}
+	pythonSyntax = &languageSyntax{
+		delimiters: balancedDelimiters,
+		strings: []delimitedSyntax{
+			{open: `"""`, close: `"""`, escapes: true, multiline: true},
+			{open: `'''`, close: `'''`, escapes: true, multiline: true},
+			doubleQuoted,
+			singleQuoted,
+		},
+		lineComments: []string{"#"},
+	}
+	haskellSyntax = &languageSyntax{
+		delimiters:    balancedDelimiters,
+		strings:       []delimitedSyntax{doubleQuoted},
+		lineComments:  []string{"--"},
+		blockComments: []delimitedSyntax{{open: "{-", close: "-}", multiline: true}},
+	}
+	ocamlSyntax = &languageSyntax{
+		delimiters:    balancedDelimiters,
+		strings:       []delimitedSyntax{doubleQuoted},
+		blockComments: []delimitedSyntax{{open: "(*", close: "*)", multiline: true}},
+	}
+	sqlSyntax = &languageSyntax{
+		delimiters:    balancedDelimiters,
+		strings:       []delimitedSyntax{doubleQuoted, singleQuoted},
+		lineComments:  []string{"--"},
+		blockComments: []delimitedSyntax{cBlockComment},
+	}
+	markupSyntax = &languageSyntax{
+		delimiters:    balancedDelimiters,
+		strings:       []delimitedSyntax{doubleQuoted},
+		blockComments: []delimitedSyntax{{open: "<!--", close: "-->", multiline: true}},
+	}
+	lispSyntax = &languageSyntax{
+		delimiters:   balancedDelimiters,
+		strings:      []delimitedSyntax{doubleQuoted},
+		lineComments: []string{";"},
+	}
+	percentCommentSyntax = &languageSyntax{
+		delimiters:   balancedDelimiters,
+		strings:      []delimitedSyntax{doubleQuoted},
+		lineComments: []string{"%"},
+	}
+)
+
+// languageSyntaxes maps the comby matchers produced by the searcher to their
+// syntax. Matchers missing from the map use the generic syntax.
+var languageSyntaxes = map[string]*languageSyntax{
+	".generic": genericSyntax,
+	".txt":     textSyntax,
+	".md":      textSyntax,
+	".org":     textSyntax,
+	".rst":     textSyntax,
+
+	".go":    cLike(doubleQuoted, singleQuoted, backQuoted),
+	".c":     cLike(doubleQuoted, singleQuoted),
+	".cs":    cLike(doubleQuoted, singleQuoted),
+	".css":   cLike(doubleQuoted, singleQuoted),
+	".dart":  cLike(doubleQuoted, singleQuoted),
+	".java":  cLike(doubleQuoted, singleQuoted),
+	".js":    cLike(doubleQuoted, singleQuoted, backQuoted),
+	".ts":    cLike(doubleQuoted, singleQuoted, backQuoted),
+	".json":  cLike(doubleQuoted),
+	".kt":    cLike(doubleQuoted, singleQuoted),
+	".php":   cLike(doubleQuoted, singleQuoted),
+	".rs":    cLike(doubleQuoted),
+	".scala": cLike(doubleQuoted, singleQuoted),
+	".swift": cLike(doubleQuoted),
+	".fsx":   cLike(doubleQuoted),
+	".re":    cLike(doubleQuoted),
+	".pas":   cLike(doubleQuoted, singleQuoted),
+
+	".py":  pythonSyntax,
+	".rb":  hashCommentSyntax,
+	".sh":  hashCommentSyntax,
+	".jl":  hashCommentSyntax,
+	".nim": hashCommentSyntax,
+	".ex":  hashCommentSyntax,
+	".s":   hashCommentSyntax,
+
+	".hs":  haskellSyntax,
+	".elm": haskellSyntax,
+	".ml":  ocamlSyntax,
+	".sql": sqlSyntax,
+
+	".html": markupSyntax,
+	".xml":  markupSyntax,
+
+	".lisp": lispSyntax,
+	".clj":  lispSyntax,
+
+	".erl": percentCommentSyntax,
+	".tex": percentCommentSyntax,
+	".bib": percentCommentSyntax,
+	".f":   {delimiters: balancedDelimiters, strings: []delimitedSyntax{doubleQuoted, singleQuoted}, lineComments: []string{"!"}},
+}
+
+func syntaxForMatcher(matcher string) *languageSyntax {
+	if syntax, ok := languageSyntaxes[matcher]; ok {
+		return syntax
+	}
+	return genericSyntax
+}
+
+func (l *languageSyntax) closerFor(c byte) (byte, bool) {
+	for _, d := range l.delimiters {
+		if d.open == c {
+			return d.close, true
+		}
+	}
+	return 0, false
+}
+
+func (l *languageSyntax) isCloser(c byte) bool {
+	for _, d := range l.delimiters {
+		if d.close == c {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *languageSyntax) isDelimiter(c byte) bool {
+	_, ok := l.closerFor(c)
+	return ok || l.isCloser(c)
+}
+
+// regionAt returns the string or comment starting at offset i of buf, if
+// any. Unterminated single line strings are treated as code.
+func (l *languageSyntax) regionAt(buf []byte, i int) (sourceRegion, bool) {
+	rest := buf[i:]
+	for _, c := range l.blockComments {
+		if bytes.HasPrefix(rest, []byte(c.open)) {
+			if bodyEnd, end, ok := c.find(buf, i); ok {
+				return sourceRegion{start: i, end: end, bodyEnd: bodyEnd, comment: true}, true
+			}
+			return sourceRegion{start: i, end: len(buf), bodyEnd: len(buf), comment: true}, true
+		}
+	}
+	for _, prefix := range l.lineComments {
+		if bytes.HasPrefix(rest, []byte(prefix)) {
+			end := bytes.IndexByte(rest, '\n')
+			if end < 0 {
+				end = len(rest)
+			}
+			return sourceRegion{start: i, end: i + end, bodyEnd: i + end, comment: true}, true
+		}
+	}
+	for _, s := range l.strings {
+		if bytes.HasPrefix(rest, []byte(s.open)) {
+			if bodyEnd, end, ok := s.find(buf, i); ok {
+				return sourceRegion{start: i, end: end, bodyEnd: bodyEnd}, true
+			}
+		}
+	}
+	return sourceRegion{}, false
+}
+
+// find returns the offset of the closing delimiter of the region opened at
+// offset start of buf, and the offset just after it.
+func (d delimitedSyntax) find(buf []byte, start int) (bodyEnd, end int, ok bool) {
+	for i := start + len(d.open); i < len(buf); i++ {
+		switch {
+		case d.escapes && buf[i] == '\\':
+			i++
+		case buf[i] == '\n' && !d.multiline:
+			return 0, 0, false
+		case bytes.HasPrefix(buf[i:], []byte(d.close)):
+			return i, i + len(d.close), true
+		}
+	}
+	return 0, 0, false
+}
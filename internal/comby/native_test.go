@@ -0,0 +1,384 @@
+package comby
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// The cases below are taken from the structural search behaviour the comby
+// binary is relied upon for, so that the native matcher stays a drop-in
+// replacement.
+func TestMatcher(t *testing.T) {
+	goFuncs := `
+func foo() {
+    fmt.Println("foo")
+}
+
+func bar() {
+    fmt.Println("bar")
+}
+`
+	commentedFoo := `
+/* This foo(plain string) {} is in a Go comment should not match in Go, but should match in plaintext */
+func foo(go string) {}
+`
+
+	cases := []struct {
+		name     string
+		template string
+		rule     string
+		matcher  string
+		content  string
+		want     []string
+	}{{
+		name:     "literal",
+		template: "func",
+		matcher:  ".go",
+		content:  "package main\n\nfunc main() {}\n",
+		want:     []string{"func"},
+	}, {
+		name:     "balanced braces",
+		template: "{:[body]}",
+		matcher:  ".go",
+		content:  goFuncs,
+		want:     []string{"{\n    fmt.Println(\"foo\")\n}", "{\n    fmt.Println(\"bar\")\n}"},
+	}, {
+		name:     "strings do not count for balancing",
+		template: "(:[_])",
+		matcher:  ".go",
+		content:  goFuncs,
+		want:     []string{"()", `("foo")`, "()", `("bar")`},
+	}, {
+		name:     "go ignores comments",
+		template: "foo(:[args])",
+		matcher:  ".go",
+		content:  commentedFoo,
+		want:     []string{"foo(go string)"},
+	}, {
+		name:     "generic matches in comments",
+		template: "foo(:[args])",
+		matcher:  ".generic",
+		content:  commentedFoo,
+		want:     []string{"foo(plain string)", "foo(go string)"},
+	}, {
+		name:     "text matches in comments",
+		template: "foo(:[args])",
+		matcher:  ".txt",
+		content:  commentedFoo,
+		want:     []string{"foo(plain string)", "foo(go string)"},
+	}, {
+		name:     "unbalanced hole",
+		template: "foo(:[args])",
+		matcher:  ".go",
+		content:  "foo(a, b) foo(c))",
+		want:     []string{"foo(a, b)", "foo(c)"},
+	}, {
+		name:     "nested delimiters",
+		template: "foo(:[args])",
+		matcher:  ".go",
+		content:  "foo(bar(1), [2, {3}])",
+		want:     []string{"foo(bar(1), [2, {3}])"},
+	}, {
+		name:     "delimiters inside strings",
+		template: "foo(:[args])",
+		matcher:  ".go",
+		content:  `foo(")") + foo(a)`,
+		want:     []string{`foo(")")`, "foo(a)"},
+	}, {
+		name:     "hole inside string",
+		template: `":[x] world"`,
+		matcher:  ".go",
+		content:  `s := "hello world"`,
+		want:     []string{`"hello world"`},
+	}, {
+		name:     "whitespace matches any whitespace",
+		template: "if err != nil",
+		matcher:  ".go",
+		content:  "if err !=\n\tnil {",
+		want:     []string{"if err !=\n\tnil"},
+	}, {
+		name:     "whitespace next to punctuation is optional",
+		template: "foo( :[x] )",
+		matcher:  ".go",
+		content:  "foo(x)",
+		want:     []string{"foo(x)"},
+	}, {
+		name:     "whitespace between words is required",
+		template: "func foo",
+		matcher:  ".go",
+		content:  "funcfoo func  foo",
+		want:     []string{"func  foo"},
+	}, {
+		name:     "alphanumeric hole",
+		template: "func :[[fn]](",
+		matcher:  ".go",
+		content:  "func foo_1(a) {} func (r *R) bar()",
+		want:     []string{"func foo_1("},
+	}, {
+		name:     "punctuation hole",
+		template: "return :[x.];",
+		matcher:  ".c",
+		content:  "return a->b.c; return (d);",
+		want:     []string{"return a->b.c;"},
+	}, {
+		name:     "newline hole",
+		template: "import :[x\\n]",
+		matcher:  ".py",
+		content:  "import os\nimport sys",
+		want:     []string{"import os\n", "import sys"},
+	}, {
+		name:     "whitespace hole",
+		template: "a:[ w]b",
+		matcher:  ".generic",
+		content:  "a \tb a\nb ab",
+		want:     []string{"a \tb"},
+	}, {
+		name:     "regexp hole",
+		template: "foo(:[x~[0-9]+])",
+		matcher:  ".go",
+		content:  "foo(abc) foo(123)",
+		want:     []string{"foo(123)"},
+	}, {
+		name:     "ellipsis",
+		template: "foo(...)",
+		matcher:  ".go",
+		content:  "foo(a, b)",
+		want:     []string{"foo(a, b)"},
+	}, {
+		name:     "repeated hole must match the same text",
+		template: ":[[x]] == :[[x]]",
+		matcher:  ".go",
+		content:  "a == b; c == c",
+		want:     []string{"c == c"},
+	}, {
+		name:     "trailing hole stops at end of line",
+		template: "return :[x]",
+		matcher:  ".go",
+		content:  "return a + b\nfoo()",
+		want:     []string{"return a + b"},
+	}, {
+		name:     "trailing hole stops at enclosing delimiter",
+		template: "return :[x]",
+		matcher:  ".go",
+		content:  "func() { return a(b, c) }",
+		want:     []string{"return a(b, c) "},
+	}, {
+		name:     "rule equality",
+		template: "func :[[fn]](:[args])",
+		rule:     `where :[args] == "success"`,
+		matcher:  ".go",
+		content:  "func foo(success) {} func bar(fail) {}",
+		want:     []string{"func foo(success)"},
+	}, {
+		name:     "rule inequality and conjunction",
+		template: "func :[[fn]](:[args])",
+		rule:     `where :[args] != "success", :[fn] != "baz"`,
+		matcher:  ".go",
+		content:  "func foo(success) {} func bar(fail) {} func baz(fail) {}",
+		want:     []string{"func bar(fail)"},
+	}, {
+		name:     "rule comparing holes",
+		template: ":[[a]] = :[[b]]",
+		rule:     `where :[a] == :[b]`,
+		matcher:  ".go",
+		content:  "x = y; z = z",
+		want:     []string{"z = z"},
+	}, {
+		name:     "empty template",
+		template: "",
+		matcher:  ".generic",
+		content:  "",
+		want:     []string{""},
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := NewMatcher(tc.template, tc.rule, tc.matcher)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, match := range m.Matches([]byte(tc.content)) {
+				got = append(got, match.Matched)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestMatcherCombyTestCases runs the comby binary test cases of comby_test.go
+// against the native matcher.
+func TestMatcherCombyTestCases(t *testing.T) {
+	// inputs returns the files of tc selected by its file patterns, like the
+	// -f flag of comby, in lexical order.
+	inputs := func(tc combyTestCase) (names, contents []string) {
+		if content, ok := tc.args.Input.(FileContent); ok {
+			return []string{""}, []string{string(content)}
+		}
+		for name := range tc.files {
+			for _, p := range tc.args.FilePatterns {
+				if strings.HasSuffix(name, p) {
+					names = append(names, name)
+					break
+				}
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			contents = append(contents, tc.files[name])
+		}
+		return names, contents
+	}
+
+	newMatcher := func(t *testing.T, tc combyTestCase) *Matcher {
+		t.Helper()
+		m, err := NewMatcher(tc.args.MatchTemplate, tc.args.Rule, tc.args.Matcher)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+
+	for _, tc := range matchesTestCases {
+		t.Run("matches/"+tc.name, func(t *testing.T) {
+			m := newMatcher(t, tc)
+			_, contents := inputs(tc)
+			var got string
+			for _, content := range contents {
+				if matches := m.Matches([]byte(content)); len(matches) > 0 {
+					got = matches[0].Matched
+					break
+				}
+			}
+			if got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	// The native matcher does not produce diffs, so compare the rewritten
+	// file instead.
+	for _, tc := range append(append([]combyTestCase{}, matchesInZipTestCases...), stdinTestCases...) {
+		t.Run("diff/"+tc.name, func(t *testing.T) {
+			m := newMatcher(t, tc)
+			names, contents := inputs(tc)
+			var changed []string
+			for i, content := range contents {
+				if got, count := m.Rewrite([]byte(content), tc.args.RewriteTemplate); count > 0 {
+					changed = append(changed, names[i])
+					if diff := cmp.Diff(tc.rewritten, string(got)); diff != "" {
+						t.Errorf("mismatch (-want +got):\n%s", diff)
+					}
+				}
+			}
+			if len(changed) != 1 {
+				t.Errorf("want exactly one rewritten file, got %q", changed)
+			}
+		})
+	}
+
+	for _, tc := range replacementsTestCases {
+		t.Run("replacements/"+tc.name, func(t *testing.T) {
+			m := newMatcher(t, tc)
+			_, contents := inputs(tc)
+			got, _ := m.Rewrite([]byte(contents[0]), tc.args.RewriteTemplate)
+			if string(got) != tc.want {
+				t.Errorf("got %v, want %v", string(got), tc.want)
+			}
+		})
+	}
+}
+
+func TestMatcherLocations(t *testing.T) {
+	content := "\nfunc foo() {\n    fmt.Println(\"foo\")\n}\n\nfunc bär() {\n    fmt.Println(\"bar\")\n}\n"
+	m, err := NewMatcher("{:[body]}", "", ".go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Range{{
+		Start: Location{Offset: 12, Line: 2, Column: 12},
+		End:   Location{Offset: 38, Line: 4, Column: 2},
+	}, {
+		Start: Location{Offset: 52, Line: 6, Column: 12},
+		End:   Location{Offset: 78, Line: 8, Column: 2},
+	}}
+	var got []Range
+	for _, match := range m.Matches([]byte(content)) {
+		got = append(got, match.Range)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestMatcherRewrite(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		template string
+		rewrite  string
+		content  string
+		want     string
+		count    int
+	}{{
+		name:     "holes",
+		template: "fmt.Sprintf(:[format], :[args])",
+		rewrite:  "fmt.Errorf(:[format], :[[args]])",
+		content:  "a := fmt.Sprintf(\"%d\", f(1, 2))\nb := fmt.Sprintf(\"%s\", x)\n",
+		want:     "a := fmt.Errorf(\"%d\", f(1, 2))\nb := fmt.Errorf(\"%s\", x)\n",
+		count:    2,
+	}, {
+		name:     "unbound holes are kept",
+		template: "foo(:[x])",
+		rewrite:  "bar(:[x], :[y])",
+		content:  "foo(1)",
+		want:     "bar(1, :[y])",
+		count:    1,
+	}, {
+		name:     "no match",
+		template: "foo(:[x])",
+		rewrite:  "bar(:[x])",
+		content:  "baz(1)",
+		want:     "baz(1)",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := NewMatcher(tc.template, "", ".go")
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, count := m.Rewrite([]byte(tc.content), tc.rewrite)
+			if diff := cmp.Diff(tc.want, string(got)); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			if count != tc.count {
+				t.Errorf("unexpected count: want=%d have=%d", tc.count, count)
+			}
+		})
+	}
+}
+
+func TestMatcherUnsupported(t *testing.T) {
+	for _, tc := range []struct {
+		template, rule string
+	}{
+		{template: "foo(:[x:e])"},
+		{template: "foo(:[x])", rule: `where rewrite :[x] { "a" -> "b" }`},
+		{template: "foo(:[x])", rule: `where match :[x] { | "a" -> true }`},
+	} {
+		_, err := NewMatcher(tc.template, tc.rule, ".go")
+		if !errors.Is(err, ErrUnsupported) {
+			t.Errorf("NewMatcher(%q, %q) returned %v, want ErrUnsupported", tc.template, tc.rule, err)
+		}
+	}
+
+	if _, err := NewMatcher(":[x~*]", "", ".go"); err == nil || errors.Is(err, ErrUnsupported) {
+		t.Errorf("invalid regular expression returned %v, want a parse error", err)
+	}
+}
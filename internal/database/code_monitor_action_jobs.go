@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -11,14 +12,58 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
+// ActionDelivery is when the actions of a code monitor send notifications.
+type ActionDelivery string
+
+const (
+	// ImmediateDelivery sends a notification for every trigger event.
+	ImmediateDelivery ActionDelivery = "IMMEDIATE"
+	// HourlyDigestDelivery sends one notification for all trigger events at
+	// the end of each hour.
+	HourlyDigestDelivery ActionDelivery = "HOURLY_DIGEST"
+	// DailyDigestDelivery sends one notification for all trigger events at the
+	// end of each day (UTC).
+	DailyDigestDelivery ActionDelivery = "DAILY_DIGEST"
+)
+
+// DigestWindow returns the length of the windows whose trigger events are
+// sent in one notification, or zero for immediate delivery.
+func (d ActionDelivery) DigestWindow() time.Duration {
+	switch d {
+	case HourlyDigestDelivery:
+		return time.Hour
+	case DailyDigestDelivery:
+		return 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+func (d ActionDelivery) orDefault() ActionDelivery {
+	if d == "" {
+		return ImmediateDelivery
+	}
+	return d
+}
+
 type ActionJob struct {
 	ID           int32
 	Email        *int64
 	Webhook      *int64
 	SlackWebhook *int64
-	TriggerEvent int32
+	// TeamsWebhook and TemplatedWebhook are set for Microsoft Teams and
+	// templated webhook actions.
+	TeamsWebhook     *int64
+	TemplatedWebhook *int64
+	TriggerEvent     int32
+
+	// SentAt is the time at which the job sent a notification. It is nil for
+	// jobs whose trigger event was sent in the digest of another job, or which
+	// were suppressed by the notification limit of the monitor.
+	SentAt *time.Time
 
 	// Fields demanded by any dbworker.
 	State          string
@@ -45,6 +90,9 @@ type ActionJobMetadata struct {
 	Results     []*result.CommitMatch
 	OwnerName   string
 
+	// ContentChanges are set instead of Results for content triggers.
+	ContentChanges *ContentChanges
+
 	// The query with after: filter.
 	Query string
 }
@@ -56,6 +104,8 @@ var ActionJobColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_action_jobs.email"),
 	sqlf.Sprintf("cm_action_jobs.webhook"),
 	sqlf.Sprintf("cm_action_jobs.slack_webhook"),
+	sqlf.Sprintf("cm_action_jobs.teams_webhook"),
+	sqlf.Sprintf("cm_action_jobs.templated_webhook"),
 	sqlf.Sprintf("cm_action_jobs.trigger_event"),
 	sqlf.Sprintf("cm_action_jobs.state"),
 	sqlf.Sprintf("cm_action_jobs.failure_message"),
@@ -65,6 +115,7 @@ var ActionJobColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_action_jobs.num_resets"),
 	sqlf.Sprintf("cm_action_jobs.num_failures"),
 	sqlf.Sprintf("cm_action_jobs.log_contents"),
+	sqlf.Sprintf("cm_action_jobs.sent_at"),
 }
 
 // ListActionJobsOpts is a struct that contains options for listing and
@@ -87,6 +138,16 @@ type ListActionJobsOpts struct {
 	// the given slack webhook action. Refers to cm_slack_webhooks(id)
 	SlackWebhookID *int
 
+	// TeamsWebhookID, if set, will filter to only actions jobs that are
+	// executing the given Microsoft Teams webhook action. Refers to
+	// cm_teams_webhooks(id)
+	TeamsWebhookID *int
+
+	// TemplatedWebhookID, if set, will filter to only actions jobs that are
+	// executing the given templated webhook action. Refers to
+	// cm_templated_webhooks(id)
+	TemplatedWebhookID *int
+
 	// First, if defined, limits the operation to only the first n results
 	First *int
 
@@ -110,6 +171,12 @@ func (o ListActionJobsOpts) Conds() *sqlf.Query {
 	if o.SlackWebhookID != nil {
 		conds = append(conds, sqlf.Sprintf("slack_webhook = %s", *o.SlackWebhookID))
 	}
+	if o.TeamsWebhookID != nil {
+		conds = append(conds, sqlf.Sprintf("teams_webhook = %s", *o.TeamsWebhookID))
+	}
+	if o.TemplatedWebhookID != nil {
+		conds = append(conds, sqlf.Sprintf("templated_webhook = %s", *o.TemplatedWebhookID))
+	}
 	if o.After != nil {
 		conds = append(conds, sqlf.Sprintf("id > %s", *o.After))
 	}
@@ -171,52 +238,178 @@ func (s *codeMonitorStore) CountActionJobs(ctx context.Context, opts ListActionJ
 
 const enqueueActionEmailFmtStr = `
 WITH due_emails AS (
-	SELECT id
+	SELECT id, %s AS process_after
 	FROM cm_emails
 	WHERE monitor = %s
 		AND enabled = true
-	EXCEPT
-	SELECT DISTINCT email as id FROM cm_action_jobs
-	WHERE state = 'queued'
-		OR state = 'processing'
+		AND (
+			delivery != %s
+			OR NOT EXISTS (
+				SELECT 1 FROM cm_action_jobs
+				WHERE email = cm_emails.id
+					AND (state = 'queued' OR state = 'processing')
+			)
+		)
 ), due_webhooks AS (
-	SELECT id
+	SELECT id, %s AS process_after
 	FROM cm_webhooks
 	WHERE monitor = %s
 		AND enabled = true
-	EXCEPT
-	SELECT DISTINCT webhook as id FROM cm_action_jobs
-	WHERE state = 'queued'
-		OR state = 'processing'
+		AND (
+			delivery != %s
+			OR NOT EXISTS (
+				SELECT 1 FROM cm_action_jobs
+				WHERE webhook = cm_webhooks.id
+					AND (state = 'queued' OR state = 'processing')
+			)
+		)
 ), due_slack_webhooks AS (
-	SELECT id
+	SELECT id, %s AS process_after
 	FROM cm_slack_webhooks
 	WHERE monitor = %s
 		AND enabled = true
-	EXCEPT
-	SELECT DISTINCT slack_webhook as id FROM cm_action_jobs
-	WHERE state = 'queued'
-		OR state = 'processing'
+		AND (
+			delivery != %s
+			OR NOT EXISTS (
+				SELECT 1 FROM cm_action_jobs
+				WHERE slack_webhook = cm_slack_webhooks.id
+					AND (state = 'queued' OR state = 'processing')
+			)
+		)
+), due_teams_webhooks AS (
+	SELECT id, %s AS process_after
+	FROM cm_teams_webhooks
+	WHERE monitor = %s
+		AND enabled = true
+		AND (
+			delivery != %s
+			OR NOT EXISTS (
+				SELECT 1 FROM cm_action_jobs
+				WHERE teams_webhook = cm_teams_webhooks.id
+					AND (state = 'queued' OR state = 'processing')
+			)
+		)
+), due_templated_webhooks AS (
+	SELECT id, %s AS process_after
+	FROM cm_templated_webhooks
+	WHERE monitor = %s
+		AND enabled = true
+		AND (
+			delivery != %s
+			OR NOT EXISTS (
+				SELECT 1 FROM cm_action_jobs
+				WHERE templated_webhook = cm_templated_webhooks.id
+					AND (state = 'queued' OR state = 'processing')
+			)
+		)
 )
-INSERT INTO cm_action_jobs (email, webhook, slack_webhook, trigger_event)
-SELECT id, CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), %s::integer from due_emails
+INSERT INTO cm_action_jobs (email, webhook, slack_webhook, teams_webhook, templated_webhook, trigger_event, process_after)
+SELECT id, CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), %s::integer, process_after from due_emails
+UNION
+SELECT CAST(NULL AS BIGINT), id, CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), %s::integer, process_after from due_webhooks
+UNION
+SELECT CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), id, CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), %s::integer, process_after from due_slack_webhooks
 UNION
-SELECT CAST(NULL AS BIGINT), id, CAST(NULL AS BIGINT), %s::integer from due_webhooks
+SELECT CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), id, CAST(NULL AS BIGINT), %s::integer, process_after from due_teams_webhooks
 UNION
-SELECT CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), id, %s::integer from due_slack_webhooks
-ORDER BY 1, 2, 3
+SELECT CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), id, %s::integer, process_after from due_templated_webhooks
+ORDER BY 1, 2, 3, 4, 5
 RETURNING %s
 `
 
+// EnqueueActionJobsForMonitor enqueues an action job for each enabled action of
+// the monitor. Jobs of actions with immediate delivery are processed right away,
+// and are not enqueued if the action already has a pending job. Jobs of digest
+// actions are processed at the end of the current digest window, when the first
+// of them sends the trigger events of all of them.
 func (s *codeMonitorStore) EnqueueActionJobsForMonitor(ctx context.Context, monitorID int64, triggerJobID int32) ([]*ActionJob, error) {
+	now := s.Now()
+	processAfter := sqlf.Sprintf(
+		"CASE delivery WHEN %s THEN %s::timestamptz WHEN %s THEN %s::timestamptz END",
+		HourlyDigestDelivery,
+		digestWindowEnd(now, HourlyDigestDelivery),
+		DailyDigestDelivery,
+		digestWindowEnd(now, DailyDigestDelivery),
+	)
 	q := sqlf.Sprintf(
 		enqueueActionEmailFmtStr,
+		processAfter,
+		monitorID,
+		ImmediateDelivery,
+		processAfter,
 		monitorID,
+		ImmediateDelivery,
+		processAfter,
 		monitorID,
+		ImmediateDelivery,
+		processAfter,
 		monitorID,
+		ImmediateDelivery,
+		processAfter,
+		monitorID,
+		ImmediateDelivery,
+		triggerJobID,
 		triggerJobID,
 		triggerJobID,
 		triggerJobID,
+		triggerJobID,
+		sqlf.Join(ActionJobColumns, ","),
+	)
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanActionJobs(rows)
+}
+
+// digestWindowEnd returns the end of the digest window of the given delivery
+// which contains now.
+func digestWindowEnd(now time.Time, d ActionDelivery) time.Time {
+	window := d.DigestWindow()
+	return now.UTC().Truncate(window).Add(window)
+}
+
+const claimDigestActionJobsFmtStr = `
+UPDATE cm_action_jobs
+SET state = 'completed',
+	finished_at = %s,
+	log_contents = %s
+WHERE id != %s
+	AND %s
+	AND (state = 'queued' OR state = 'errored')
+	AND (process_after IS NULL OR process_after <= %s)
+RETURNING %s -- ActionJobColumns
+`
+
+// ClaimDigestActionJobs marks the other pending jobs of the action of job which
+// are due as completed, and returns them. Their trigger events are merged into
+// the digest sent by job.
+func (s *codeMonitorStore) ClaimDigestActionJobs(ctx context.Context, job *ActionJob) ([]*ActionJob, error) {
+	var actionCond *sqlf.Query
+	switch {
+	case job.Email != nil:
+		actionCond = sqlf.Sprintf("email = %s", *job.Email)
+	case job.Webhook != nil:
+		actionCond = sqlf.Sprintf("webhook = %s", *job.Webhook)
+	case job.SlackWebhook != nil:
+		actionCond = sqlf.Sprintf("slack_webhook = %s", *job.SlackWebhook)
+	case job.TeamsWebhook != nil:
+		actionCond = sqlf.Sprintf("teams_webhook = %s", *job.TeamsWebhook)
+	case job.TemplatedWebhook != nil:
+		actionCond = sqlf.Sprintf("templated_webhook = %s", *job.TemplatedWebhook)
+	default:
+		return nil, errors.New("job must be one of type email, webhook, slack webhook, teams webhook, or templated webhook")
+	}
+
+	now := s.Now()
+	q := sqlf.Sprintf(
+		claimDigestActionJobsFmtStr,
+		now,
+		fmt.Sprintf("Merged into the digest of action job %d", job.ID),
+		job.ID,
+		actionCond,
+		now,
 		sqlf.Join(ActionJobColumns, ","),
 	)
 	rows, err := s.Query(ctx, q)
@@ -227,13 +420,57 @@ func (s *codeMonitorStore) EnqueueActionJobsForMonitor(ctx context.Context, moni
 	return scanActionJobs(rows)
 }
 
+const markActionJobSentFmtStr = `
+UPDATE cm_action_jobs
+SET sent_at = %s
+WHERE id = %s
+`
+
+// MarkActionJobSent records that the action job sent a notification.
+func (s *codeMonitorStore) MarkActionJobSent(ctx context.Context, jobID int32) error {
+	return s.Exec(ctx, sqlf.Sprintf(markActionJobSentFmtStr, s.Now(), jobID))
+}
+
+const setActionJobLogContentsFmtStr = `
+UPDATE cm_action_jobs
+SET log_contents = %s
+WHERE id = %s
+`
+
+// SetActionJobLogContents sets a message describing how the action job was
+// processed.
+func (s *codeMonitorStore) SetActionJobLogContents(ctx context.Context, jobID int32, logContents string) error {
+	return s.Exec(ctx, sqlf.Sprintf(setActionJobLogContentsFmtStr, logContents, jobID))
+}
+
+const countSentNotificationsFmtStr = `
+SELECT COUNT(DISTINCT caj.trigger_event)
+FROM cm_action_jobs caj
+INNER JOIN cm_trigger_jobs ctj on caj.trigger_event = ctj.id
+INNER JOIN cm_queries cq on cq.id = ctj.query
+WHERE cq.monitor = %s
+	AND caj.sent_at > %s
+	AND caj.trigger_event != %s
+`
+
+// CountSentNotifications returns the number of trigger events of the monitor
+// which any action sent a notification for since the given time, not counting
+// excludeTriggerEvent. Excluding the trigger event of the job being processed
+// lets all actions notify about a trigger event once one of them did.
+func (s *codeMonitorStore) CountSentNotifications(ctx context.Context, monitorID int64, since time.Time, excludeTriggerEvent int32) (int, error) {
+	var count int
+	err := s.QueryRow(ctx, sqlf.Sprintf(countSentNotificationsFmtStr, monitorID, since, excludeTriggerEvent)).Scan(&count)
+	return count, err
+}
+
 const getActionJobMetadataFmtStr = `
 SELECT
 	cm.description,
 	ctj.query_string,
 	cm.id AS monitorID,
 	ctj.search_results,
-	CASE WHEN LENGTH(users.display_name) > 0 THEN users.display_name ELSE users.username END
+	CASE WHEN LENGTH(users.display_name) > 0 THEN users.display_name ELSE users.username END,
+	ctj.content_changes
 FROM cm_action_jobs caj
 INNER JOIN cm_trigger_jobs ctj on caj.trigger_event = ctj.id
 INNER JOIN cm_queries cq on cq.id = ctj.query
@@ -245,15 +482,20 @@ WHERE caj.id = %s
 // GetActionJobMetada returns the set of fields needed to execute all action jobs
 func (s *codeMonitorStore) GetActionJobMetadata(ctx context.Context, jobID int32) (*ActionJobMetadata, error) {
 	row := s.Store.QueryRow(ctx, sqlf.Sprintf(getActionJobMetadataFmtStr, jobID))
-	var resultsJSON []byte
+	var resultsJSON, changesJSON []byte
 	m := &ActionJobMetadata{}
-	err := row.Scan(&m.Description, &m.Query, &m.MonitorID, &resultsJSON, &m.OwnerName)
+	err := row.Scan(&m.Description, &m.Query, &m.MonitorID, &resultsJSON, &m.OwnerName, &changesJSON)
 	if err != nil {
 		return nil, err
 	}
 	if err := json.Unmarshal(resultsJSON, &m.Results); err != nil {
 		return nil, err
 	}
+	if len(changesJSON) > 0 {
+		if err := json.Unmarshal(changesJSON, &m.ContentChanges); err != nil {
+			return nil, err
+		}
+	}
 	return m, nil
 }
 
@@ -288,6 +530,8 @@ func ScanActionJob(row dbutil.Scanner) (*ActionJob, error) {
 		&aj.Email,
 		&aj.Webhook,
 		&aj.SlackWebhook,
+		&aj.TeamsWebhook,
+		&aj.TemplatedWebhook,
 		&aj.TriggerEvent,
 		&aj.State,
 		&aj.FailureMessage,
@@ -297,5 +541,6 @@ func ScanActionJob(row dbutil.Scanner) (*ActionJob, error) {
 		&aj.NumResets,
 		&aj.NumFailures,
 		&aj.LogContents,
+		&aj.SentAt,
 	)
 }
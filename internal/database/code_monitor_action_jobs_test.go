@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
 )
 
 func TestEnqueueActionEmailsForQueryIDInt64QueryByRecordID(t *testing.T) {
@@ -39,6 +40,42 @@ func TestEnqueueActionEmailsForQueryIDInt64QueryByRecordID(t *testing.T) {
 	require.Equal(t, want, actionJobs[0])
 }
 
+func TestEnqueueTeamsAndTemplatedWebhookActionJobs(t *testing.T) {
+	ctx, db, s := newTestStore(t)
+	_, _, userCTX := newTestUser(ctx, t, db)
+	fixtures := s.insertTestMonitor(userCTX, t)
+
+	teams, err := s.CreateTeamsWebhookAction(userCTX, fixtures.monitor.ID, true, false, "https://example.webhook.office.com/teams", ImmediateDelivery)
+	require.NoError(t, err)
+	templated, err := s.CreateTemplatedWebhookAction(userCTX, fixtures.monitor.ID, &TemplatedWebhookActionArgs{
+		Enabled:         true,
+		URL:             "https://tickets.example.com",
+		PayloadTemplate: `{"summary": {{json .MonitorDescription}}}`,
+	})
+	require.NoError(t, err)
+
+	triggerJobs, err := s.EnqueueQueryTriggerJobs(ctx)
+	require.NoError(t, err)
+	require.Len(t, triggerJobs, 1)
+
+	actionJobs, err := s.EnqueueActionJobsForMonitor(ctx, fixtures.monitor.ID, triggerJobs[0].ID)
+	require.NoError(t, err)
+	require.Len(t, actionJobs, 4)
+	require.Equal(t, &teams.ID, actionJobs[2].TeamsWebhook)
+	require.Nil(t, actionJobs[2].TemplatedWebhook)
+	require.Equal(t, &templated.ID, actionJobs[3].TemplatedWebhook)
+	require.Nil(t, actionJobs[3].TeamsWebhook)
+
+	// Jobs of immediate actions are not enqueued while one is pending.
+	actionJobs, err = s.EnqueueActionJobsForMonitor(ctx, fixtures.monitor.ID, triggerJobs[0].ID)
+	require.NoError(t, err)
+	require.Empty(t, actionJobs)
+
+	count, err := s.CountActionJobs(ctx, ListActionJobsOpts{TemplatedWebhookID: pointers.Ptr(int(templated.ID))})
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
+
 func TestGetActionJobMetadata(t *testing.T) {
 	ctx, db, s := newTestStore(t)
 	userName, _, userCTX := newTestUser(ctx, t, db)
@@ -96,3 +133,84 @@ func TestScanActionJob(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, int(actionJobID), job.RecordID())
 }
+
+func TestDigestActionJobs(t *testing.T) {
+	ctx, db, s := newTestStore(t)
+	_, _, userCTX := newTestUser(ctx, t, db)
+	fixtures := s.insertTestMonitor(userCTX, t)
+
+	// Send the notifications of the second email action in an hourly digest.
+	digestEmail, err := s.UpdateEmailAction(userCTX, fixtures.emails[1].ID, &EmailActionArgs{
+		Enabled:  true,
+		Delivery: HourlyDigestDelivery,
+		Priority: "NORMAL",
+	})
+	require.NoError(t, err)
+	require.Equal(t, HourlyDigestDelivery, digestEmail.Delivery)
+
+	fireTrigger := func() int32 {
+		t.Helper()
+		err := s.Exec(ctx, sqlf.Sprintf("UPDATE cm_trigger_jobs SET state = 'completed'"))
+		require.NoError(t, err)
+		triggerJobs, err := s.EnqueueQueryTriggerJobs(ctx)
+		require.NoError(t, err)
+		require.Len(t, triggerJobs, 1)
+		return triggerJobs[0].ID
+	}
+
+	firstEvent := fireTrigger()
+	actionJobs, err := s.EnqueueActionJobsForMonitor(ctx, fixtures.monitor.ID, firstEvent)
+	require.NoError(t, err)
+	require.Len(t, actionJobs, 2)
+	require.Nil(t, actionJobs[0].ProcessAfter)
+	wantProcessAfter := s.Now().UTC().Truncate(time.Hour).Add(time.Hour)
+	require.Equal(t, digestEmail.ID, *actionJobs[1].Email)
+	require.True(t, wantProcessAfter.Equal(*actionJobs[1].ProcessAfter))
+	digestJob := actionJobs[1]
+
+	// The immediate action still has a pending job, but digest actions get a
+	// job for every trigger event.
+	secondEvent := fireTrigger()
+	actionJobs, err = s.EnqueueActionJobsForMonitor(ctx, fixtures.monitor.ID, secondEvent)
+	require.NoError(t, err)
+	require.Len(t, actionJobs, 1)
+	require.Equal(t, digestEmail.ID, *actionJobs[0].Email)
+
+	// Jobs are only claimed once the digest window ended.
+	claimed, err := s.ClaimDigestActionJobs(ctx, digestJob)
+	require.NoError(t, err)
+	require.Empty(t, claimed)
+
+	later := CodeMonitorsWithClock(db, func() time.Time { return wantProcessAfter })
+	claimed, err = later.ClaimDigestActionJobs(ctx, digestJob)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	require.Equal(t, actionJobs[0].ID, claimed[0].ID)
+	require.Equal(t, "completed", claimed[0].State)
+	require.Equal(t, secondEvent, claimed[0].TriggerEvent)
+
+	count, err := s.CountSentNotifications(ctx, fixtures.monitor.ID, time.Time{}, 0)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+
+	err = later.MarkActionJobSent(ctx, digestJob.ID)
+	require.NoError(t, err)
+
+	count, err = s.CountSentNotifications(ctx, fixtures.monitor.ID, time.Time{}, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	count, err = s.CountSentNotifications(ctx, fixtures.monitor.ID, time.Time{}, firstEvent)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+
+	count, err = s.CountSentNotifications(ctx, fixtures.monitor.ID, wantProcessAfter, 0)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+}
+
+func TestDigestWindowEnd(t *testing.T) {
+	now := time.Date(2024, 8, 12, 13, 45, 10, 0, time.UTC)
+	require.Equal(t, time.Date(2024, 8, 12, 14, 0, 0, 0, time.UTC), digestWindowEnd(now, HourlyDigestDelivery))
+	require.Equal(t, time.Date(2024, 8, 13, 0, 0, 0, 0, time.UTC), digestWindowEnd(now, DailyDigestDelivery))
+}
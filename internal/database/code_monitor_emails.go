@@ -18,6 +18,7 @@ type EmailAction struct {
 	Priority       string
 	Header         string
 	IncludeResults bool
+	Delivery       ActionDelivery
 	CreatedBy      int32
 	CreatedAt      time.Time
 	ChangedBy      int32
@@ -28,6 +29,7 @@ const updateActionEmailFmtStr = `
 UPDATE cm_emails
 SET enabled = %s,
     include_results = %s,
+	delivery = %s,
 	priority = %s,
 	header = %s,
 	changed_by = %s,
@@ -45,6 +47,7 @@ RETURNING %s;
 type EmailActionArgs struct {
 	Enabled        bool
 	IncludeResults bool
+	Delivery       ActionDelivery
 	Priority       string
 	Header         string
 }
@@ -61,6 +64,7 @@ func (s *codeMonitorStore) UpdateEmailAction(ctx context.Context, id int64, args
 		updateActionEmailFmtStr,
 		args.Enabled,
 		args.IncludeResults,
+		args.Delivery.orDefault(),
 		args.Priority,
 		args.Header,
 		a.UID,
@@ -76,8 +80,8 @@ func (s *codeMonitorStore) UpdateEmailAction(ctx context.Context, id int64, args
 
 const createActionEmailFmtStr = `
 INSERT INTO cm_emails
-(monitor, enabled, include_results, priority, header, created_by, created_at, changed_by, changed_at)
-VALUES (%s,%s,%s,%s,%s,%s,%s,%s,%s)
+(monitor, enabled, include_results, delivery, priority, header, created_by, created_at, changed_by, changed_at)
+VALUES (%s,%s,%s,%s,%s,%s,%s,%s,%s,%s)
 RETURNING %s;
 `
 
@@ -89,6 +93,7 @@ func (s *codeMonitorStore) CreateEmailAction(ctx context.Context, monitorID int6
 		monitorID,
 		args.Enabled,
 		args.IncludeResults,
+		args.Delivery.orDefault(),
 		args.Priority,
 		args.Header,
 		a.UID,
@@ -208,6 +213,7 @@ var emailsColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_emails.priority"),
 	sqlf.Sprintf("cm_emails.header"),
 	sqlf.Sprintf("cm_emails.include_results"),
+	sqlf.Sprintf("cm_emails.delivery"),
 	sqlf.Sprintf("cm_emails.created_by"),
 	sqlf.Sprintf("cm_emails.created_at"),
 	sqlf.Sprintf("cm_emails.changed_by"),
@@ -237,6 +243,7 @@ func scanEmail(scanner dbutil.Scanner) (*EmailAction, error) {
 		&m.Priority,
 		&m.Header,
 		&m.IncludeResults,
+		&m.Delivery,
 		&m.CreatedBy,
 		&m.CreatedAt,
 		&m.ChangedBy,
@@ -0,0 +1,87 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ContentMatch is a file matched by the query of a content trigger.
+type ContentMatch struct {
+	RepoID   api.RepoID `json:"repoID"`
+	RepoName string     `json:"repoName"`
+	Path     string     `json:"path"`
+}
+
+// ContentChanges are the files which started or stopped matching the query of
+// a content trigger between two of its runs.
+type ContentChanges struct {
+	Added   []ContentMatch `json:"added,omitempty"`
+	Removed []ContentMatch `json:"removed,omitempty"`
+}
+
+// Count returns the number of files which started or stopped matching.
+func (c *ContentChanges) Count() int {
+	if c == nil {
+		return 0
+	}
+	return len(c.Added) + len(c.Removed)
+}
+
+// GetLastContentMatches returns the files matched by the content trigger of a
+// monitor on its last run. The returned bool is false if the trigger has not
+// run yet, or its query changed since it last ran.
+func (s *codeMonitorStore) GetLastContentMatches(ctx context.Context, monitorID int64) ([]ContentMatch, bool, error) {
+	rawQuery := `
+	SELECT matches
+	FROM cm_last_content_matches
+	WHERE monitor_id = %s
+	`
+
+	var matchesJSON []byte
+	err := s.QueryRow(ctx, sqlf.Sprintf(rawQuery, monitorID)).Scan(&matchesJSON)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	var matches []ContentMatch
+	if err := json.Unmarshal(matchesJSON, &matches); err != nil {
+		return nil, false, err
+	}
+	return matches, true, nil
+}
+
+func (s *codeMonitorStore) UpsertLastContentMatches(ctx context.Context, monitorID int64, matches []ContentMatch) error {
+	rawQuery := `
+	INSERT INTO cm_last_content_matches (monitor_id, matches, updated_at)
+	VALUES (%s, %s, %s)
+	ON CONFLICT (monitor_id) DO UPDATE
+	SET matches = EXCLUDED.matches,
+		updated_at = EXCLUDED.updated_at
+	`
+
+	// Appease non-null constraint on column
+	if matches == nil {
+		matches = []ContentMatch{}
+	}
+	matchesJSON, err := json.Marshal(matches)
+	if err != nil {
+		return err
+	}
+	return s.Exec(ctx, sqlf.Sprintf(rawQuery, monitorID, matchesJSON, s.Now()))
+}
+
+// DeleteLastContentMatches forgets the files matched by the content trigger of
+// a monitor, so that its next run records a new baseline instead of comparing
+// against results of a different query.
+func (s *codeMonitorStore) DeleteLastContentMatches(ctx context.Context, monitorID int64) error {
+	return s.Exec(ctx, sqlf.Sprintf("DELETE FROM cm_last_content_matches WHERE monitor_id = %s", monitorID))
+}
@@ -0,0 +1,46 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestCodeMonitorStoreLastContentMatches(t *testing.T) {
+	t.Parallel()
+
+	logger := logtest.Scoped(t)
+	ctx := context.Background()
+	db := NewDB(logger, dbtest.NewDB(t))
+	fixtures := populateCodeMonitorFixtures(t, db)
+	cm := db.CodeMonitors()
+
+	// No baseline before the first run.
+	_, ok, err := cm.GetLastContentMatches(ctx, fixtures.Monitor.ID)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// An empty result set is still a baseline.
+	require.NoError(t, cm.UpsertLastContentMatches(ctx, fixtures.Monitor.ID, nil))
+	matches, ok, err := cm.GetLastContentMatches(ctx, fixtures.Monitor.ID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Empty(t, matches)
+
+	want := []ContentMatch{{RepoID: fixtures.Repo.ID, RepoName: string(fixtures.Repo.Name), Path: "a.go"}}
+	require.NoError(t, cm.UpsertLastContentMatches(ctx, fixtures.Monitor.ID, want))
+	matches, ok, err = cm.GetLastContentMatches(ctx, fixtures.Monitor.ID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, want, matches)
+
+	require.NoError(t, cm.DeleteLastContentMatches(ctx, fixtures.Monitor.ID))
+	_, ok, err = cm.GetLastContentMatches(ctx, fixtures.Monitor.ID)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -20,6 +20,29 @@ type Monitor struct {
 	Description string
 	Enabled     bool
 	UserID      int32
+
+	// NotificationLimit is the maximum number of trigger events the actions
+	// of the monitor send notifications for within NotificationLimitWindow,
+	// or nil if unlimited.
+	NotificationLimit       *int32
+	NotificationLimitWindow NotificationLimitWindow
+}
+
+// NotificationLimitWindow is the rolling window the notification limit of a
+// monitor applies to.
+type NotificationLimitWindow string
+
+const (
+	NotificationLimitHour NotificationLimitWindow = "HOUR"
+	NotificationLimitDay  NotificationLimitWindow = "DAY"
+)
+
+// Duration returns the length of the window.
+func (w NotificationLimitWindow) Duration() time.Duration {
+	if w == NotificationLimitDay {
+		return 24 * time.Hour
+	}
+	return time.Hour
 }
 
 // monitorColumns are the columns needed to fill out a Monitor.
@@ -33,6 +56,8 @@ var monitorColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_monitors.description"),
 	sqlf.Sprintf("cm_monitors.enabled"),
 	sqlf.Sprintf("cm_monitors.namespace_user_id"),
+	sqlf.Sprintf("cm_monitors.notification_limit"),
+	sqlf.Sprintf("cm_monitors.notification_limit_window"),
 }
 
 type MonitorArgs struct {
@@ -40,12 +65,22 @@ type MonitorArgs struct {
 	Enabled         bool
 	NamespaceUserID *int32
 	NamespaceOrgID  *int32
+
+	NotificationLimit       *int32
+	NotificationLimitWindow NotificationLimitWindow
+}
+
+func (a MonitorArgs) notificationLimitWindow() NotificationLimitWindow {
+	if a.NotificationLimitWindow == "" {
+		return NotificationLimitHour
+	}
+	return a.NotificationLimitWindow
 }
 
 const insertCodeMonitorFmtStr = `
 INSERT INTO cm_monitors
-(created_at, created_by, changed_at, changed_by, description, enabled, namespace_user_id, namespace_org_id)
-VALUES (%s,%s,%s,%s,%s,%s,%s,%s)
+(created_at, created_by, changed_at, changed_by, description, enabled, namespace_user_id, namespace_org_id, notification_limit, notification_limit_window)
+VALUES (%s,%s,%s,%s,%s,%s,%s,%s,%s,%s)
 RETURNING %s -- monitorColumns
 `
 
@@ -62,6 +97,8 @@ func (s *codeMonitorStore) CreateMonitor(ctx context.Context, args MonitorArgs)
 		args.Enabled,
 		args.NamespaceUserID,
 		args.NamespaceOrgID,
+		args.NotificationLimit,
+		args.notificationLimitWindow(),
 		sqlf.Join(monitorColumns, ", "),
 	)
 
@@ -75,6 +112,8 @@ SET description = %s,
 	enabled = %s,
 	namespace_user_id = %s,
 	namespace_org_id = %s,
+	notification_limit = %s,
+	notification_limit_window = %s,
 	changed_by = %s,
 	changed_at = %s
 WHERE
@@ -92,6 +131,8 @@ func (s *codeMonitorStore) UpdateMonitor(ctx context.Context, id int64, args Mon
 		args.Enabled,
 		args.NamespaceUserID,
 		args.NamespaceOrgID,
+		args.NotificationLimit,
+		args.notificationLimitWindow(),
 		a.UID,
 		s.Now(),
 		id,
@@ -250,6 +291,8 @@ func scanMonitor(scanner dbutil.Scanner) (*Monitor, error) {
 		&m.Description,
 		&m.Enabled,
 		&m.UserID,
+		&m.NotificationLimit,
+		&m.NotificationLimitWindow,
 	)
 	return m, err
 }
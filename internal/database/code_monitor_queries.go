@@ -10,10 +10,23 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 )
 
+// QueryTriggerKind is the kind of search a query trigger runs.
+type QueryTriggerKind string
+
+const (
+	// CommitQueryTrigger runs commit and diff searches, firing on new matching
+	// commits.
+	CommitQueryTrigger QueryTriggerKind = "COMMIT"
+	// ContentQueryTrigger runs content searches, firing when the set of matching
+	// files changes.
+	ContentQueryTrigger QueryTriggerKind = "CONTENT"
+)
+
 type QueryTrigger struct {
 	ID           int64
 	Monitor      int64
 	QueryString  string
+	Kind         QueryTriggerKind
 	NextRun      time.Time
 	LatestResult *time.Time
 	CreatedBy    int32
@@ -34,22 +47,24 @@ var queryColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_queries.created_at"),
 	sqlf.Sprintf("cm_queries.changed_by"),
 	sqlf.Sprintf("cm_queries.changed_at"),
+	sqlf.Sprintf("cm_queries.kind"),
 }
 
 const createTriggerQueryFmtStr = `
 INSERT INTO cm_queries
-(monitor, query, created_by, created_at, changed_by, changed_at, next_run, latest_result)
-VALUES (%s,%s,%s,%s,%s,%s,%s,%s)
+(monitor, query, kind, created_by, created_at, changed_by, changed_at, next_run, latest_result)
+VALUES (%s,%s,%s,%s,%s,%s,%s,%s,%s)
 RETURNING %s;
 `
 
-func (s *codeMonitorStore) CreateQueryTrigger(ctx context.Context, monitorID int64, query string) (*QueryTrigger, error) {
+func (s *codeMonitorStore) CreateQueryTrigger(ctx context.Context, monitorID int64, query string, kind QueryTriggerKind) (*QueryTrigger, error) {
 	now := s.Now()
 	a := actor.FromContext(ctx)
 	q := sqlf.Sprintf(
 		createTriggerQueryFmtStr,
 		monitorID,
 		query,
+		kind,
 		a.UID,
 		now,
 		a.UID,
@@ -65,6 +80,7 @@ func (s *codeMonitorStore) CreateQueryTrigger(ctx context.Context, monitorID int
 const updateTriggerQueryFmtStr = `
 UPDATE cm_queries
 SET query = %s,
+	kind = %s,
 	changed_by = %s,
 	changed_at = %s,
 	latest_result = %s
@@ -78,7 +94,7 @@ WHERE
 RETURNING %s;
 `
 
-func (s *codeMonitorStore) UpdateQueryTrigger(ctx context.Context, id int64, query string) error {
+func (s *codeMonitorStore) UpdateQueryTrigger(ctx context.Context, id int64, query string, kind QueryTriggerKind) error {
 	now := s.Now()
 	a := actor.FromContext(ctx)
 
@@ -90,6 +106,7 @@ func (s *codeMonitorStore) UpdateQueryTrigger(ctx context.Context, id int64, que
 	q := sqlf.Sprintf(
 		updateTriggerQueryFmtStr,
 		query,
+		kind,
 		a.UID,
 		now,
 		now,
@@ -177,6 +194,7 @@ func scanTriggerQuery(scanner dbutil.Scanner) (*QueryTrigger, error) {
 		&m.CreatedAt,
 		&m.ChangedBy,
 		&m.ChangedAt,
+		&m.Kind,
 	)
 	return m, err
 }
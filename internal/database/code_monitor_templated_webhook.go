@@ -0,0 +1,301 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/encryption"
+	"github.com/sourcegraph/sourcegraph/internal/encryption/keyring"
+)
+
+// TemplatedWebhookAction is a webhook action whose request body is rendered
+// from a user-defined Go template.
+type TemplatedWebhookAction struct {
+	ID              int64
+	Monitor         int64
+	Enabled         bool
+	URL             string
+	PayloadTemplate string
+	// Headers are encrypted at rest, since their values are often credentials
+	// of the webhook receiver.
+	Headers        *encryption.JSONEncryptable[[]WebhookHeader]
+	IncludeResults bool
+	Delivery       ActionDelivery
+
+	CreatedBy int32
+	CreatedAt time.Time
+	ChangedBy int32
+	ChangedAt time.Time
+}
+
+// WebhookHeader is an additional HTTP header sent with a webhook request.
+type WebhookHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type TemplatedWebhookActionArgs struct {
+	Enabled         bool
+	IncludeResults  bool
+	URL             string
+	PayloadTemplate string
+	Headers         []WebhookHeader
+	Delivery        ActionDelivery
+}
+
+// templatedWebhookHeadersKey returns the key the headers of templated webhook
+// actions are encrypted with. Like the secrets of outbound webhooks, they are
+// sent to a third party along with the webhook request.
+func templatedWebhookHeadersKey() encryption.Key {
+	return keyring.Default().OutboundWebhookKey
+}
+
+// encryptHeaders returns the headers as they are stored in the headers column,
+// and the ID of the key they were encrypted with, if any.
+func (a *TemplatedWebhookActionArgs) encryptHeaders(ctx context.Context) ([]byte, *string, error) {
+	headers := a.Headers
+	if headers == nil {
+		headers = []WebhookHeader{}
+	}
+	raw, err := json.Marshal(headers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, keyID, err := encryption.MaybeEncrypt(ctx, templatedWebhookHeadersKey(), string(raw))
+	if err != nil {
+		return nil, nil, err
+	}
+	return []byte(data), dbutil.NullStringColumn(keyID), nil
+}
+
+const updateTemplatedWebhookActionQuery = `
+UPDATE cm_templated_webhooks
+SET enabled = %s,
+	include_results = %s,
+	url = %s,
+	payload_template = %s,
+	headers = %s,
+	encryption_key_id = %s,
+	delivery = %s,
+	changed_by = %s,
+	changed_at = %s
+WHERE
+	id = %s
+	AND EXISTS (
+		SELECT 1 FROM cm_monitors
+		WHERE cm_monitors.id = cm_templated_webhooks.monitor
+			AND %s
+	)
+RETURNING %s;
+`
+
+func (s *codeMonitorStore) UpdateTemplatedWebhookAction(ctx context.Context, id int64, args *TemplatedWebhookActionArgs) (*TemplatedWebhookAction, error) {
+	a := actor.FromContext(ctx)
+
+	user, err := a.User(ctx, s.userStore)
+	if err != nil {
+		return nil, err
+	}
+
+	headers, keyID, err := args.encryptHeaders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	q := sqlf.Sprintf(
+		updateTemplatedWebhookActionQuery,
+		args.Enabled,
+		args.IncludeResults,
+		args.URL,
+		args.PayloadTemplate,
+		headers,
+		keyID,
+		args.Delivery.orDefault(),
+		a.UID,
+		s.Now(),
+		id,
+		namespaceScopeQuery(user),
+		sqlf.Join(templatedWebhookActionColumns, ","),
+	)
+
+	row := s.QueryRow(ctx, q)
+	return scanTemplatedWebhookAction(row)
+}
+
+const createTemplatedWebhookActionQuery = `
+INSERT INTO cm_templated_webhooks
+(monitor, enabled, include_results, url, payload_template, headers, encryption_key_id, delivery, created_by, created_at, changed_by, changed_at)
+VALUES (%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s)
+RETURNING %s;
+`
+
+func (s *codeMonitorStore) CreateTemplatedWebhookAction(ctx context.Context, monitorID int64, args *TemplatedWebhookActionArgs) (*TemplatedWebhookAction, error) {
+	headers, keyID, err := args.encryptHeaders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.Now()
+	a := actor.FromContext(ctx)
+	q := sqlf.Sprintf(
+		createTemplatedWebhookActionQuery,
+		monitorID,
+		args.Enabled,
+		args.IncludeResults,
+		args.URL,
+		args.PayloadTemplate,
+		headers,
+		keyID,
+		args.Delivery.orDefault(),
+		a.UID,
+		now,
+		a.UID,
+		now,
+		sqlf.Join(templatedWebhookActionColumns, ","),
+	)
+
+	row := s.QueryRow(ctx, q)
+	return scanTemplatedWebhookAction(row)
+}
+
+const deleteTemplatedWebhookActionQuery = `
+DELETE FROM cm_templated_webhooks
+WHERE id in (%s)
+	AND MONITOR = %s
+`
+
+func (s *codeMonitorStore) DeleteTemplatedWebhookActions(ctx context.Context, monitorID int64, webhookIDs ...int64) error {
+	if len(webhookIDs) == 0 {
+		return nil
+	}
+
+	deleteIDs := make([]*sqlf.Query, 0, len(webhookIDs))
+	for _, ids := range webhookIDs {
+		deleteIDs = append(deleteIDs, sqlf.Sprintf("%d", ids))
+	}
+	q := sqlf.Sprintf(
+		deleteTemplatedWebhookActionQuery,
+		sqlf.Join(deleteIDs, ","),
+		monitorID,
+	)
+
+	return s.Exec(ctx, q)
+}
+
+const countTemplatedWebhookActionsQuery = `
+SELECT COUNT(*)
+FROM cm_templated_webhooks
+WHERE monitor = %s;
+`
+
+func (s *codeMonitorStore) CountTemplatedWebhookActions(ctx context.Context, monitorID int64) (int, error) {
+	var count int
+	err := s.QueryRow(ctx, sqlf.Sprintf(countTemplatedWebhookActionsQuery, monitorID)).Scan(&count)
+	return count, err
+}
+
+const getTemplatedWebhookActionQuery = `
+SELECT %s -- TemplatedWebhookActionColumns
+FROM cm_templated_webhooks
+WHERE id = %s
+`
+
+func (s *codeMonitorStore) GetTemplatedWebhookAction(ctx context.Context, id int64) (*TemplatedWebhookAction, error) {
+	q := sqlf.Sprintf(
+		getTemplatedWebhookActionQuery,
+		sqlf.Join(templatedWebhookActionColumns, ","),
+		id,
+	)
+	row := s.QueryRow(ctx, q)
+	return scanTemplatedWebhookAction(row)
+}
+
+const listTemplatedWebhookActionsQuery = `
+SELECT %s -- TemplatedWebhookActionColumns
+FROM cm_templated_webhooks
+WHERE %s
+ORDER BY id ASC
+LIMIT %s;
+`
+
+func (s *codeMonitorStore) ListTemplatedWebhookActions(ctx context.Context, opts ListActionsOpts) ([]*TemplatedWebhookAction, error) {
+	q := sqlf.Sprintf(
+		listTemplatedWebhookActionsQuery,
+		sqlf.Join(templatedWebhookActionColumns, ","),
+		opts.Conds(),
+		opts.Limit(),
+	)
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanTemplatedWebhookActions(rows)
+}
+
+// templatedWebhookActionColumns is the set of columns in the cm_templated_webhooks table
+// This must be kept in sync with scanTemplatedWebhookAction
+var templatedWebhookActionColumns = []*sqlf.Query{
+	sqlf.Sprintf("cm_templated_webhooks.id"),
+	sqlf.Sprintf("cm_templated_webhooks.monitor"),
+	sqlf.Sprintf("cm_templated_webhooks.enabled"),
+	sqlf.Sprintf("cm_templated_webhooks.url"),
+	sqlf.Sprintf("cm_templated_webhooks.payload_template"),
+	sqlf.Sprintf("cm_templated_webhooks.headers"),
+	sqlf.Sprintf("cm_templated_webhooks.encryption_key_id"),
+	sqlf.Sprintf("cm_templated_webhooks.include_results"),
+	sqlf.Sprintf("cm_templated_webhooks.delivery"),
+	sqlf.Sprintf("cm_templated_webhooks.created_by"),
+	sqlf.Sprintf("cm_templated_webhooks.created_at"),
+	sqlf.Sprintf("cm_templated_webhooks.changed_by"),
+	sqlf.Sprintf("cm_templated_webhooks.changed_at"),
+}
+
+func scanTemplatedWebhookActions(rows *sql.Rows) ([]*TemplatedWebhookAction, error) {
+	var ws []*TemplatedWebhookAction
+	for rows.Next() {
+		w, err := scanTemplatedWebhookAction(rows)
+		if err != nil {
+			return nil, err
+		}
+		ws = append(ws, w)
+	}
+	return ws, rows.Err()
+}
+
+// scanTemplatedWebhookAction scans a TemplatedWebhookAction from a *sql.Row or *sql.Rows.
+// It must be kept in sync with templatedWebhookActionColumns.
+func scanTemplatedWebhookAction(scanner dbutil.Scanner) (*TemplatedWebhookAction, error) {
+	var (
+		w       TemplatedWebhookAction
+		headers []byte
+		keyID   string
+	)
+	err := scanner.Scan(
+		&w.ID,
+		&w.Monitor,
+		&w.Enabled,
+		&w.URL,
+		&w.PayloadTemplate,
+		&headers,
+		&dbutil.NullString{S: &keyID},
+		&w.IncludeResults,
+		&w.Delivery,
+		&w.CreatedBy,
+		&w.CreatedAt,
+		&w.ChangedBy,
+		&w.ChangedAt,
+	)
+	if err != nil {
+		return &w, err
+	}
+	w.Headers = encryption.NewEncryptedJSON[[]WebhookHeader](string(headers), keyID, templatedWebhookHeadersKey())
+	return &w, nil
+}
@@ -0,0 +1,164 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestCodeMonitorStoreTemplatedWebhooks(t *testing.T) {
+	ctx := context.Background()
+	args1 := &TemplatedWebhookActionArgs{
+		Enabled:         true,
+		URL:             "https://icanhazcheezburger.com/templated_webhook",
+		PayloadTemplate: `{"title": {{json .MonitorDescription}}}`,
+		Headers:         []WebhookHeader{{Name: "Authorization", Value: "Bearer cheezburger"}},
+		Delivery:        ImmediateDelivery,
+	}
+	args2 := &TemplatedWebhookActionArgs{
+		URL:             "https://icanthazcheezburger.com/templated_webhook",
+		PayloadTemplate: `{"query": {{json .Query}}}`,
+		Delivery:        DailyDigestDelivery,
+	}
+
+	logger := logtest.Scoped(t)
+
+	t.Run("CreateThenGet", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		action, err := s.CreateTemplatedWebhookAction(ctx, fixtures.monitor.ID, args1)
+		require.NoError(t, err)
+
+		got, err := s.GetTemplatedWebhookAction(ctx, action.ID)
+		require.NoError(t, err)
+
+		require.Equal(t, action, got)
+
+		headers, err := got.Headers.Decrypt(ctx)
+		require.NoError(t, err)
+		require.Equal(t, args1.Headers, headers)
+	})
+
+	t.Run("CreateUpdateGet", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		action, err := s.CreateTemplatedWebhookAction(ctx, fixtures.monitor.ID, args1)
+		require.NoError(t, err)
+
+		updated, err := s.UpdateTemplatedWebhookAction(ctx, action.ID, args2)
+		require.NoError(t, err)
+		require.Equal(t, false, updated.Enabled)
+		require.Equal(t, args2.URL, updated.URL)
+		require.Equal(t, args2.PayloadTemplate, updated.PayloadTemplate)
+		require.Equal(t, DailyDigestDelivery, updated.Delivery)
+
+		got, err := s.GetTemplatedWebhookAction(ctx, action.ID)
+		require.NoError(t, err)
+		require.Equal(t, updated, got)
+
+		headers, err := got.Headers.Decrypt(ctx)
+		require.NoError(t, err)
+		require.Empty(t, headers)
+	})
+
+	t.Run("ErrorOnUpdateNonexistent", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+
+		_, err := s.UpdateTemplatedWebhookAction(ctx, 383838, args2)
+		require.Error(t, err)
+	})
+
+	t.Run("CreateDeleteGet", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		action1, err := s.CreateTemplatedWebhookAction(ctx, fixtures.monitor.ID, args1)
+		require.NoError(t, err)
+
+		action2, err := s.CreateTemplatedWebhookAction(ctx, fixtures.monitor.ID, args1)
+		require.NoError(t, err)
+
+		err = s.DeleteTemplatedWebhookActions(ctx, fixtures.monitor.ID, action1.ID)
+		require.NoError(t, err)
+
+		_, err = s.GetTemplatedWebhookAction(ctx, action1.ID)
+		require.Error(t, err)
+
+		_, err = s.GetTemplatedWebhookAction(ctx, action2.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("CountCreateList", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		count, err := s.CountTemplatedWebhookActions(ctx, fixtures.monitor.ID)
+		require.NoError(t, err)
+		require.Equal(t, 0, count)
+
+		_, err = s.CreateTemplatedWebhookAction(ctx, fixtures.monitor.ID, args1)
+		require.NoError(t, err)
+
+		_, err = s.CreateTemplatedWebhookAction(ctx, fixtures.monitor.ID, args2)
+		require.NoError(t, err)
+
+		count, err = s.CountTemplatedWebhookActions(ctx, fixtures.monitor.ID)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
+		first := 1
+		actions, err := s.ListTemplatedWebhookActions(ctx, ListActionsOpts{MonitorID: &fixtures.monitor.ID, First: &first})
+		require.NoError(t, err)
+		require.Len(t, actions, 1)
+		require.Equal(t, args1.URL, actions[0].URL)
+	})
+
+	t.Run("Update permissions", func(t *testing.T) {
+		ctx, db, s := newTestStore(t)
+		uid1 := insertTestUser(ctx, t, db, "u1", false)
+		ctx1 := actor.WithActor(ctx, actor.FromUser(uid1))
+		uid2 := insertTestUser(ctx, t, db, "u2", false)
+		ctx2 := actor.WithActor(ctx, actor.FromUser(uid2))
+		fixtures := s.insertTestMonitor(ctx1, t)
+		_ = s.insertTestMonitor(ctx2, t)
+
+		wa, err := s.CreateTemplatedWebhookAction(ctx1, fixtures.monitor.ID, args1)
+		require.NoError(t, err)
+
+		// User2 cannot update it
+		_, err = s.UpdateTemplatedWebhookAction(ctx2, wa.ID, args2)
+		require.Error(t, err)
+
+		// User1 can update it
+		_, err = s.UpdateTemplatedWebhookAction(ctx1, wa.ID, args2)
+		require.NoError(t, err)
+	})
+}
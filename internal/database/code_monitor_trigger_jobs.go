@@ -14,6 +14,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
 )
 
 type TriggerJob struct {
@@ -25,6 +26,10 @@ type TriggerJob struct {
 
 	SearchResults []*result.CommitMatch
 
+	// ContentChanges are set instead of SearchResults for content triggers,
+	// if the set of matching files changed.
+	ContentChanges *ContentChanges
+
 	// Fields demanded for any dbworker.
 	State          string
 	FailureMessage *string
@@ -110,6 +115,29 @@ func (s *codeMonitorStore) UpdateTriggerJobWithResults(ctx context.Context, trig
 	return s.Store.Exec(ctx, sqlf.Sprintf(logSearchFmtStr, queryString, resultsJSON, triggerJobID))
 }
 
+const logContentSearchFmtStr = `
+UPDATE cm_trigger_jobs
+SET query_string = %s,
+    search_results = '[]'::jsonb,
+    content_changes = %s
+WHERE id = %s
+`
+
+// UpdateTriggerJobWithContentChanges records the query run by a content
+// trigger and the files which started or stopped matching it, if any.
+func (s *codeMonitorStore) UpdateTriggerJobWithContentChanges(ctx context.Context, triggerJobID int32, queryString string, changes *ContentChanges) error {
+	// content_changes stays null if nothing changed.
+	var changesJSON *string
+	if changes.Count() > 0 {
+		raw, err := json.Marshal(changes)
+		if err != nil {
+			return err
+		}
+		changesJSON = pointers.Ptr(string(raw))
+	}
+	return s.Store.Exec(ctx, sqlf.Sprintf(logContentSearchFmtStr, queryString, changesJSON, triggerJobID))
+}
+
 const updateTriggerJobLogsFmtStr = `
 UPDATE cm_trigger_jobs
 SET logs = logs || %s::json
@@ -181,7 +209,7 @@ func (s *codeMonitorStore) ListQueryTriggerJobs(ctx context.Context, opts ListTr
 const totalCountEventsForQueryIDInt64FmtStr = `
 SELECT COUNT(*)
 FROM cm_trigger_jobs
-WHERE ((state = 'completed' AND jsonb_array_length(search_results) > 0) OR (state != 'completed'))
+WHERE ((state = 'completed' AND (jsonb_array_length(search_results) > 0 OR content_changes IS NOT NULL)) OR (state != 'completed'))
 AND query = %s
 `
 
@@ -208,7 +236,7 @@ func scanTriggerJobs(rows *sql.Rows) ([]*TriggerJob, error) {
 }
 
 func ScanTriggerJob(scanner dbutil.Scanner) (*TriggerJob, error) {
-	var resultsJSON []byte
+	var resultsJSON, changesJSON []byte
 	var logs []TriggerJobLogs
 	m := &TriggerJob{}
 	err := scanner.Scan(
@@ -224,6 +252,7 @@ func ScanTriggerJob(scanner dbutil.Scanner) (*TriggerJob, error) {
 		&m.NumResets,
 		&m.NumFailures,
 		pq.Array(&logs),
+		&changesJSON,
 	)
 	if err != nil {
 		return nil, err
@@ -237,6 +266,12 @@ func ScanTriggerJob(scanner dbutil.Scanner) (*TriggerJob, error) {
 		}
 	}
 
+	if len(changesJSON) > 0 {
+		if err := json.Unmarshal(changesJSON, &m.ContentChanges); err != nil {
+			return nil, err
+		}
+	}
+
 	return m, nil
 }
 
@@ -253,4 +288,5 @@ var TriggerJobsColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_trigger_jobs.num_resets"),
 	sqlf.Sprintf("cm_trigger_jobs.num_failures"),
 	sqlf.Sprintf("cm_trigger_jobs.logs"),
+	sqlf.Sprintf("cm_trigger_jobs.content_changes"),
 }
@@ -83,6 +83,28 @@ func TestUpdateTriggerJob(t *testing.T) {
 		err = db.CodeMonitors().UpdateTriggerJobWithResults(ctx, jobs[0].ID, "", nil)
 		require.NoError(t, err)
 	})
+
+	t.Run("content changes round-trip", func(t *testing.T) {
+		ctx := context.Background()
+		db := NewDB(logger, dbtest.NewDB(t))
+		f := populateCodeMonitorFixtures(t, db)
+		jobs, err := db.CodeMonitors().EnqueueQueryTriggerJobs(ctx)
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+
+		changes := &ContentChanges{
+			Added:   []ContentMatch{{RepoID: f.Repo.ID, RepoName: string(f.Repo.Name), Path: "b.go"}},
+			Removed: []ContentMatch{{RepoID: f.Repo.ID, RepoName: string(f.Repo.Name), Path: "a.go"}},
+		}
+		err = db.CodeMonitors().UpdateTriggerJobWithContentChanges(ctx, jobs[0].ID, "banned(", changes)
+		require.NoError(t, err)
+
+		js, err := db.CodeMonitors().ListQueryTriggerJobs(ctx, ListTriggerJobsOpts{QueryID: &f.Query.ID})
+		require.NoError(t, err)
+		require.Len(t, js, 1)
+		require.Equal(t, changes, js[0].ContentChanges)
+		require.Empty(t, js[0].SearchResults)
+	})
 }
 
 func TestListTriggerJobs(t *testing.T) {
@@ -0,0 +1,267 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// CodeownersLintReportStore stores the latest CODEOWNERS lint report of every
+// repository, as computed by the codeowners-lint own background job.
+type CodeownersLintReportStore interface {
+	// Upsert stores the given report, replacing the previous report of its
+	// repository if any.
+	Upsert(ctx context.Context, report *CodeownersLintReport) error
+	// GetForRepo returns the latest report of the given repository, or an
+	// error for which errcode.IsNotFound is true if there is none.
+	GetForRepo(ctx context.Context, repoID api.RepoID) (*CodeownersLintReport, error)
+	// List returns reports in ascending order of coverage, so that the
+	// repositories which need the most attention come first.
+	List(ctx context.Context, opts ListCodeownersLintReportsOpts) ([]*CodeownersLintReport, error)
+	// Count returns the number of reports List would return without limit.
+	Count(ctx context.Context, opts ListCodeownersLintReportsOpts) (int32, error)
+}
+
+// CodeownersLintReport describes the problems of the CODEOWNERS file of a
+// repository at a commit, and how many files of the repository it covers.
+type CodeownersLintReport struct {
+	RepoID   api.RepoID
+	CommitID api.CommitID
+	// HasCodeowners is false if the repository has no CODEOWNERS file, in
+	// which case all its files are unowned.
+	HasCodeowners bool
+	TotalFiles    int
+	// OwnedFiles is the number of files matched by a rule with at least one
+	// owner.
+	OwnedFiles int
+	// DeadRules are the rules which patterns do not match any file.
+	DeadRules []CodeownersLintRule
+	// UnknownOwners are the owners which cannot be resolved to a user or team.
+	UnknownOwners []CodeownersLintOwner
+	// UnownedPaths are the topmost directories and files which contain no
+	// owned files. It is capped at MaxCodeownersLintUnownedPaths.
+	UnownedPaths []string
+	UpdatedAt    time.Time
+}
+
+// MaxCodeownersLintUnownedPaths is the maximum number of unowned paths of a
+// CodeownersLintReport.
+const MaxCodeownersLintUnownedPaths = 1000
+
+// Coverage returns the percentage of files of the repository which are owned.
+// A repository without files is fully covered.
+func (r *CodeownersLintReport) Coverage() float64 {
+	if r.TotalFiles == 0 {
+		return 100
+	}
+	return 100 * float64(r.OwnedFiles) / float64(r.TotalFiles)
+}
+
+// CodeownersLintRule identifies a rule of a CODEOWNERS file.
+type CodeownersLintRule struct {
+	Pattern     string `json:"pattern"`
+	LineNumber  int32  `json:"lineNumber"`
+	SectionName string `json:"sectionName,omitempty"`
+}
+
+// CodeownersLintOwner is an owner referenced by a CODEOWNERS file.
+type CodeownersLintOwner struct {
+	Handle string `json:"handle,omitempty"`
+	Email  string `json:"email,omitempty"`
+	// LineNumbers are the lines of the rules referencing the owner.
+	LineNumbers []int32 `json:"lineNumbers"`
+}
+
+type ListCodeownersLintReportsOpts struct {
+	*LimitOffset
+	// MaxCoverage only lists reports of repositories which coverage
+	// percentage is at most the given value.
+	MaxCoverage *float64
+}
+
+func (opts ListCodeownersLintReportsOpts) sqlConds() *sqlf.Query {
+	conds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+	if opts.MaxCoverage != nil {
+		conds = append(conds, sqlf.Sprintf("clr.coverage <= %s", *opts.MaxCoverage))
+	}
+	return sqlf.Join(conds, "AND")
+}
+
+func CodeownersLintReportStoreWith(other basestore.ShareableStore) CodeownersLintReportStore {
+	return &codeownersLintReportStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+type codeownersLintReportStore struct {
+	*basestore.Store
+}
+
+type CodeownersLintReportNotFoundError struct {
+	repoID api.RepoID
+}
+
+func (e CodeownersLintReportNotFoundError) Error() string {
+	return fmt.Sprintf("codeowners lint report not found for repo %d", e.repoID)
+}
+
+func (CodeownersLintReportNotFoundError) NotFound() bool {
+	return true
+}
+
+const upsertCodeownersLintReportFmtstr = `
+	INSERT INTO codeowners_lint_reports (
+		repo_id, commit_id, has_codeowners, total_files, owned_files, coverage,
+		dead_rules, unknown_owners, unowned_paths, updated_at
+	)
+	VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+	ON CONFLICT (repo_id) DO UPDATE SET
+		commit_id = EXCLUDED.commit_id,
+		has_codeowners = EXCLUDED.has_codeowners,
+		total_files = EXCLUDED.total_files,
+		owned_files = EXCLUDED.owned_files,
+		coverage = EXCLUDED.coverage,
+		dead_rules = EXCLUDED.dead_rules,
+		unknown_owners = EXCLUDED.unknown_owners,
+		unowned_paths = EXCLUDED.unowned_paths,
+		updated_at = EXCLUDED.updated_at
+`
+
+func (s *codeownersLintReportStore) Upsert(ctx context.Context, r *CodeownersLintReport) error {
+	deadRules, err := json.Marshal(emptyIfNil(r.DeadRules))
+	if err != nil {
+		return errors.Wrap(err, "marshalling dead rules")
+	}
+	unknownOwners, err := json.Marshal(emptyIfNil(r.UnknownOwners))
+	if err != nil {
+		return errors.Wrap(err, "marshalling unknown owners")
+	}
+	unownedPaths, err := json.Marshal(emptyIfNil(r.UnownedPaths))
+	if err != nil {
+		return errors.Wrap(err, "marshalling unowned paths")
+	}
+	if r.UpdatedAt.IsZero() {
+		r.UpdatedAt = time.Now()
+	}
+	q := sqlf.Sprintf(
+		upsertCodeownersLintReportFmtstr,
+		r.RepoID,
+		r.CommitID,
+		r.HasCodeowners,
+		r.TotalFiles,
+		r.OwnedFiles,
+		r.Coverage(),
+		deadRules,
+		unknownOwners,
+		unownedPaths,
+		r.UpdatedAt,
+	)
+	return s.Exec(ctx, q)
+}
+
+func emptyIfNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
+
+var codeownersLintReportColumns = []*sqlf.Query{
+	sqlf.Sprintf("clr.repo_id"),
+	sqlf.Sprintf("clr.commit_id"),
+	sqlf.Sprintf("clr.has_codeowners"),
+	sqlf.Sprintf("clr.total_files"),
+	sqlf.Sprintf("clr.owned_files"),
+	sqlf.Sprintf("clr.dead_rules"),
+	sqlf.Sprintf("clr.unknown_owners"),
+	sqlf.Sprintf("clr.unowned_paths"),
+	sqlf.Sprintf("clr.updated_at"),
+}
+
+const getCodeownersLintReportFmtstr = `
+	SELECT %s
+	FROM codeowners_lint_reports AS clr
+	WHERE clr.repo_id = %s
+`
+
+func (s *codeownersLintReportStore) GetForRepo(ctx context.Context, repoID api.RepoID) (*CodeownersLintReport, error) {
+	q := sqlf.Sprintf(getCodeownersLintReportFmtstr, sqlf.Join(codeownersLintReportColumns, ","), repoID)
+	report, ok, err := scanFirstCodeownersLintReport(s.Query(ctx, q))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, CodeownersLintReportNotFoundError{repoID: repoID}
+	}
+	return report, nil
+}
+
+const listCodeownersLintReportsFmtstr = `
+	SELECT %s
+	FROM codeowners_lint_reports AS clr
+	INNER JOIN repo ON repo.id = clr.repo_id
+	WHERE repo.deleted_at IS NULL AND %s
+	ORDER BY clr.coverage ASC, clr.repo_id ASC
+	%s
+`
+
+func (s *codeownersLintReportStore) List(ctx context.Context, opts ListCodeownersLintReportsOpts) ([]*CodeownersLintReport, error) {
+	q := sqlf.Sprintf(
+		listCodeownersLintReportsFmtstr,
+		sqlf.Join(codeownersLintReportColumns, ","),
+		opts.sqlConds(),
+		opts.LimitOffset.SQL(),
+	)
+	return scanCodeownersLintReports(s.Query(ctx, q))
+}
+
+const countCodeownersLintReportsFmtstr = `
+	SELECT COUNT(*)
+	FROM codeowners_lint_reports AS clr
+	INNER JOIN repo ON repo.id = clr.repo_id
+	WHERE repo.deleted_at IS NULL AND %s
+`
+
+func (s *codeownersLintReportStore) Count(ctx context.Context, opts ListCodeownersLintReportsOpts) (int32, error) {
+	count, _, err := basestore.ScanFirstInt(s.Query(ctx, sqlf.Sprintf(countCodeownersLintReportsFmtstr, opts.sqlConds())))
+	return int32(count), err
+}
+
+func scanCodeownersLintReport(scanner dbutil.Scanner) (*CodeownersLintReport, error) {
+	var r CodeownersLintReport
+	var deadRules, unknownOwners, unownedPaths []byte
+	if err := scanner.Scan(
+		&r.RepoID,
+		&r.CommitID,
+		&r.HasCodeowners,
+		&r.TotalFiles,
+		&r.OwnedFiles,
+		&deadRules,
+		&unknownOwners,
+		&unownedPaths,
+		&r.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(deadRules, &r.DeadRules); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling dead rules")
+	}
+	if err := json.Unmarshal(unknownOwners, &r.UnknownOwners); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling unknown owners")
+	}
+	if err := json.Unmarshal(unownedPaths, &r.UnownedPaths); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling unowned paths")
+	}
+	return &r, nil
+}
+
+var (
+	scanCodeownersLintReports     = basestore.NewSliceScanner(scanCodeownersLintReport)
+	scanFirstCodeownersLintReport = basestore.NewFirstScanner(scanCodeownersLintReport)
+)
@@ -0,0 +1,103 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func TestCodeownersLintReportStore(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	t.Parallel()
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(t))
+	ctx := context.Background()
+
+	for _, repo := range []*types.Repo{
+		{ID: 1, Name: "github.com/sourcegraph/sourcegraph"},
+		{ID: 2, Name: "github.com/sourcegraph/sourcegraph2"},
+		{ID: 3, Name: "github.com/sourcegraph/sourcegraph3"},
+	} {
+		require.NoError(t, db.Repos().Create(ctx, repo))
+	}
+
+	store := db.CodeownersLintReports()
+
+	_, err := store.GetForRepo(ctx, 1)
+	assert.True(t, errcode.IsNotFound(err))
+
+	report := &CodeownersLintReport{
+		RepoID:        1,
+		CommitID:      "deadbeef",
+		HasCodeowners: true,
+		TotalFiles:    4,
+		OwnedFiles:    3,
+		DeadRules:     []CodeownersLintRule{{Pattern: "/old/**", LineNumber: 3}},
+		UnknownOwners: []CodeownersLintOwner{{Handle: "ghost", LineNumbers: []int32{1, 3}}},
+		UnownedPaths:  []string{"docs"},
+	}
+	require.NoError(t, store.Upsert(ctx, report))
+	require.NoError(t, store.Upsert(ctx, &CodeownersLintReport{RepoID: 2, CommitID: "cafebabe"}))
+	require.NoError(t, store.Upsert(ctx, &CodeownersLintReport{RepoID: 3, CommitID: "f00d", TotalFiles: 2}))
+
+	got, err := store.GetForRepo(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, report.CommitID, got.CommitID)
+	assert.True(t, got.HasCodeowners)
+	assert.Equal(t, report.DeadRules, got.DeadRules)
+	assert.Equal(t, report.UnknownOwners, got.UnknownOwners)
+	assert.Equal(t, report.UnownedPaths, got.UnownedPaths)
+	assert.Equal(t, 75.0, got.Coverage())
+
+	// Empty lists are stored as such, not as null.
+	got, err = store.GetForRepo(ctx, 2)
+	require.NoError(t, err)
+	assert.Empty(t, got.DeadRules)
+	assert.Empty(t, got.UnownedPaths)
+
+	// Reports are listed by ascending coverage.
+	reports, err := store.List(ctx, ListCodeownersLintReportsOpts{})
+	require.NoError(t, err)
+	var repoIDs []int32
+	for _, r := range reports {
+		repoIDs = append(repoIDs, int32(r.RepoID))
+	}
+	assert.Equal(t, []int32{3, 1, 2}, repoIDs)
+
+	opts := ListCodeownersLintReportsOpts{MaxCoverage: pointers.Ptr(80.0)}
+	count, err := store.Count(ctx, opts)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), count)
+	opts.LimitOffset = &LimitOffset{Limit: 1, Offset: 1}
+	reports, err = store.List(ctx, opts)
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.Equal(t, int32(1), int32(reports[0].RepoID))
+
+	// Upserting replaces the previous report of the repository.
+	require.NoError(t, store.Upsert(ctx, &CodeownersLintReport{RepoID: 1, CommitID: "abc", HasCodeowners: true, TotalFiles: 4, OwnedFiles: 4}))
+	got, err = store.GetForRepo(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, got.Coverage())
+	assert.Empty(t, got.DeadRules)
+	count, err = store.Count(ctx, ListCodeownersLintReportsOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), count)
+
+	// Reports of deleted repositories are not listed.
+	require.NoError(t, db.Repos().Delete(ctx, 3))
+	count, err = store.Count(ctx, ListCodeownersLintReportsOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), count)
+}
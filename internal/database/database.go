@@ -29,6 +29,7 @@ type DB interface {
 	CodeMonitors() CodeMonitorStore
 	CodeHosts() CodeHostStore
 	Codeowners() CodeownersStore
+	CodeownersLintReports() CodeownersLintReportStore
 	Conf() ConfStore
 	EventLogs() EventLogStore
 	SecurityEventLogs() SecurityEventLogsStore
@@ -81,6 +82,7 @@ type DB interface {
 	RecentViewSignal() RecentViewSignalStore
 	AssignedOwners() AssignedOwnersStore
 	AssignedTeams() AssignedTeamsStore
+	InferredOwnership() InferredOwnershipStore
 	OwnSignalConfigurations() SignalConfigurationStore
 	Prompts() PromptStore
 
@@ -162,6 +164,10 @@ func (d *db) Codeowners() CodeownersStore {
 	return CodeownersWith(basestore.NewWithHandle(d.Handle()))
 }
 
+func (d *db) CodeownersLintReports() CodeownersLintReportStore {
+	return CodeownersLintReportStoreWith(d.Store)
+}
+
 func (d *db) Conf() ConfStore {
 	return ConfStoreWith(d.Store)
 }
@@ -373,6 +379,10 @@ func (d *db) AssignedTeams() AssignedTeamsStore {
 	return AssignedTeamsStoreWith(d.Store, d.logger)
 }
 
+func (d *db) InferredOwnership() InferredOwnershipStore {
+	return InferredOwnershipStoreWith(d.Store)
+}
+
 func (d *db) OwnSignalConfigurations() SignalConfigurationStore {
 	return SignalConfigurationStoreWith(d.Store)
 }
@@ -6343,6 +6343,9 @@ func (c CodeHostStoreWithTransactFuncCall) Results() []interface{} {
 // github.com/sourcegraph/sourcegraph/internal/database) used for unit
 // testing.
 type MockCodeMonitorStore struct {
+	// ClaimDigestActionJobsFunc is an instance of a mock function object
+	// controlling the behavior of the method ClaimDigestActionJobs.
+	ClaimDigestActionJobsFunc *CodeMonitorStoreClaimDigestActionJobsFunc
 	// ClockFunc is an instance of a mock function object controlling the
 	// behavior of the method Clock.
 	ClockFunc *CodeMonitorStoreClockFunc
@@ -6358,9 +6361,19 @@ type MockCodeMonitorStore struct {
 	// CountRecipientsFunc is an instance of a mock function object
 	// controlling the behavior of the method CountRecipients.
 	CountRecipientsFunc *CodeMonitorStoreCountRecipientsFunc
+	// CountSentNotificationsFunc is an instance of a mock function object
+	// controlling the behavior of the method CountSentNotifications.
+	CountSentNotificationsFunc *CodeMonitorStoreCountSentNotificationsFunc
 	// CountSlackWebhookActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method CountSlackWebhookActions.
 	CountSlackWebhookActionsFunc *CodeMonitorStoreCountSlackWebhookActionsFunc
+	// CountTeamsWebhookActionsFunc is an instance of a mock function object
+	// controlling the behavior of the method CountTeamsWebhookActions.
+	CountTeamsWebhookActionsFunc *CodeMonitorStoreCountTeamsWebhookActionsFunc
+	// CountTemplatedWebhookActionsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// CountTemplatedWebhookActions.
+	CountTemplatedWebhookActionsFunc *CodeMonitorStoreCountTemplatedWebhookActionsFunc
 	// CountWebhookActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method CountWebhookActions.
 	CountWebhookActionsFunc *CodeMonitorStoreCountWebhookActionsFunc
@@ -6379,12 +6392,22 @@ type MockCodeMonitorStore struct {
 	// CreateSlackWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateSlackWebhookAction.
 	CreateSlackWebhookActionFunc *CodeMonitorStoreCreateSlackWebhookActionFunc
+	// CreateTeamsWebhookActionFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateTeamsWebhookAction.
+	CreateTeamsWebhookActionFunc *CodeMonitorStoreCreateTeamsWebhookActionFunc
+	// CreateTemplatedWebhookActionFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// CreateTemplatedWebhookAction.
+	CreateTemplatedWebhookActionFunc *CodeMonitorStoreCreateTemplatedWebhookActionFunc
 	// CreateWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateWebhookAction.
 	CreateWebhookActionFunc *CodeMonitorStoreCreateWebhookActionFunc
 	// DeleteEmailActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteEmailActions.
 	DeleteEmailActionsFunc *CodeMonitorStoreDeleteEmailActionsFunc
+	// DeleteLastContentMatchesFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteLastContentMatches.
+	DeleteLastContentMatchesFunc *CodeMonitorStoreDeleteLastContentMatchesFunc
 	// DeleteMonitorFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteMonitor.
 	DeleteMonitorFunc *CodeMonitorStoreDeleteMonitorFunc
@@ -6398,6 +6421,14 @@ type MockCodeMonitorStore struct {
 	// object controlling the behavior of the method
 	// DeleteSlackWebhookActions.
 	DeleteSlackWebhookActionsFunc *CodeMonitorStoreDeleteSlackWebhookActionsFunc
+	// DeleteTeamsWebhookActionsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// DeleteTeamsWebhookActions.
+	DeleteTeamsWebhookActionsFunc *CodeMonitorStoreDeleteTeamsWebhookActionsFunc
+	// DeleteTemplatedWebhookActionsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// DeleteTemplatedWebhookActions.
+	DeleteTemplatedWebhookActionsFunc *CodeMonitorStoreDeleteTemplatedWebhookActionsFunc
 	// DeleteWebhookActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteWebhookActions.
 	DeleteWebhookActionsFunc *CodeMonitorStoreDeleteWebhookActionsFunc
@@ -6423,6 +6454,9 @@ type MockCodeMonitorStore struct {
 	// GetEmailActionFunc is an instance of a mock function object
 	// controlling the behavior of the method GetEmailAction.
 	GetEmailActionFunc *CodeMonitorStoreGetEmailActionFunc
+	// GetLastContentMatchesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetLastContentMatches.
+	GetLastContentMatchesFunc *CodeMonitorStoreGetLastContentMatchesFunc
 	// GetLastSearchedFunc is an instance of a mock function object
 	// controlling the behavior of the method GetLastSearched.
 	GetLastSearchedFunc *CodeMonitorStoreGetLastSearchedFunc
@@ -6439,6 +6473,13 @@ type MockCodeMonitorStore struct {
 	// GetSlackWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method GetSlackWebhookAction.
 	GetSlackWebhookActionFunc *CodeMonitorStoreGetSlackWebhookActionFunc
+	// GetTeamsWebhookActionFunc is an instance of a mock function object
+	// controlling the behavior of the method GetTeamsWebhookAction.
+	GetTeamsWebhookActionFunc *CodeMonitorStoreGetTeamsWebhookActionFunc
+	// GetTemplatedWebhookActionFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetTemplatedWebhookAction.
+	GetTemplatedWebhookActionFunc *CodeMonitorStoreGetTemplatedWebhookActionFunc
 	// GetWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method GetWebhookAction.
 	GetWebhookActionFunc *CodeMonitorStoreGetWebhookActionFunc
@@ -6466,9 +6507,19 @@ type MockCodeMonitorStore struct {
 	// ListSlackWebhookActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method ListSlackWebhookActions.
 	ListSlackWebhookActionsFunc *CodeMonitorStoreListSlackWebhookActionsFunc
+	// ListTeamsWebhookActionsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListTeamsWebhookActions.
+	ListTeamsWebhookActionsFunc *CodeMonitorStoreListTeamsWebhookActionsFunc
+	// ListTemplatedWebhookActionsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ListTemplatedWebhookActions.
+	ListTemplatedWebhookActionsFunc *CodeMonitorStoreListTemplatedWebhookActionsFunc
 	// ListWebhookActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method ListWebhookActions.
 	ListWebhookActionsFunc *CodeMonitorStoreListWebhookActionsFunc
+	// MarkActionJobSentFunc is an instance of a mock function object
+	// controlling the behavior of the method MarkActionJobSent.
+	MarkActionJobSentFunc *CodeMonitorStoreMarkActionJobSentFunc
 	// NowFunc is an instance of a mock function object controlling the
 	// behavior of the method Now.
 	NowFunc *CodeMonitorStoreNowFunc
@@ -6476,6 +6527,9 @@ type MockCodeMonitorStore struct {
 	// object controlling the behavior of the method
 	// ResetQueryTriggerTimestamps.
 	ResetQueryTriggerTimestampsFunc *CodeMonitorStoreResetQueryTriggerTimestampsFunc
+	// SetActionJobLogContentsFunc is an instance of a mock function object
+	// controlling the behavior of the method SetActionJobLogContents.
+	SetActionJobLogContentsFunc *CodeMonitorStoreSetActionJobLogContentsFunc
 	// SetQueryTriggerNextRunFunc is an instance of a mock function object
 	// controlling the behavior of the method SetQueryTriggerNextRun.
 	SetQueryTriggerNextRunFunc *CodeMonitorStoreSetQueryTriggerNextRunFunc
@@ -6497,6 +6551,17 @@ type MockCodeMonitorStore struct {
 	// UpdateSlackWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateSlackWebhookAction.
 	UpdateSlackWebhookActionFunc *CodeMonitorStoreUpdateSlackWebhookActionFunc
+	// UpdateTeamsWebhookActionFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateTeamsWebhookAction.
+	UpdateTeamsWebhookActionFunc *CodeMonitorStoreUpdateTeamsWebhookActionFunc
+	// UpdateTemplatedWebhookActionFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// UpdateTemplatedWebhookAction.
+	UpdateTemplatedWebhookActionFunc *CodeMonitorStoreUpdateTemplatedWebhookActionFunc
+	// UpdateTriggerJobWithContentChangesFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// UpdateTriggerJobWithContentChanges.
+	UpdateTriggerJobWithContentChangesFunc *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc
 	// UpdateTriggerJobWithLogsFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateTriggerJobWithLogs.
 	UpdateTriggerJobWithLogsFunc *CodeMonitorStoreUpdateTriggerJobWithLogsFunc
@@ -6507,6 +6572,9 @@ type MockCodeMonitorStore struct {
 	// UpdateWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateWebhookAction.
 	UpdateWebhookActionFunc *CodeMonitorStoreUpdateWebhookActionFunc
+	// UpsertLastContentMatchesFunc is an instance of a mock function object
+	// controlling the behavior of the method UpsertLastContentMatches.
+	UpsertLastContentMatchesFunc *CodeMonitorStoreUpsertLastContentMatchesFunc
 	// UpsertLastSearchedFunc is an instance of a mock function object
 	// controlling the behavior of the method UpsertLastSearched.
 	UpsertLastSearchedFunc *CodeMonitorStoreUpsertLastSearchedFunc
@@ -6517,6 +6585,11 @@ type MockCodeMonitorStore struct {
 // overwritten.
 func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 	return &MockCodeMonitorStore{
+		ClaimDigestActionJobsFunc: &CodeMonitorStoreClaimDigestActionJobsFunc{
+			defaultHook: func(context.Context, *database.ActionJob) (r0 []*database.ActionJob, r1 error) {
+				return
+			},
+		},
 		ClockFunc: &CodeMonitorStoreClockFunc{
 			defaultHook: func() (r0 func() time.Time) {
 				return
@@ -6542,11 +6615,26 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		CountSentNotificationsFunc: &CodeMonitorStoreCountSentNotificationsFunc{
+			defaultHook: func(context.Context, int64, time.Time, int32) (r0 int, r1 error) {
+				return
+			},
+		},
 		CountSlackWebhookActionsFunc: &CodeMonitorStoreCountSlackWebhookActionsFunc{
 			defaultHook: func(context.Context, int64) (r0 int, r1 error) {
 				return
 			},
 		},
+		CountTeamsWebhookActionsFunc: &CodeMonitorStoreCountTeamsWebhookActionsFunc{
+			defaultHook: func(context.Context, int64) (r0 int, r1 error) {
+				return
+			},
+		},
+		CountTemplatedWebhookActionsFunc: &CodeMonitorStoreCountTemplatedWebhookActionsFunc{
+			defaultHook: func(context.Context, int64) (r0 int, r1 error) {
+				return
+			},
+		},
 		CountWebhookActionsFunc: &CodeMonitorStoreCountWebhookActionsFunc{
 			defaultHook: func(context.Context, int64) (r0 int, r1 error) {
 				return
@@ -6563,7 +6651,7 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 			},
 		},
 		CreateQueryTriggerFunc: &CodeMonitorStoreCreateQueryTriggerFunc{
-			defaultHook: func(context.Context, int64, string) (r0 *database.QueryTrigger, r1 error) {
+			defaultHook: func(context.Context, int64, string, database.QueryTriggerKind) (r0 *database.QueryTrigger, r1 error) {
 				return
 			},
 		},
@@ -6573,12 +6661,22 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 			},
 		},
 		CreateSlackWebhookActionFunc: &CodeMonitorStoreCreateSlackWebhookActionFunc{
-			defaultHook: func(context.Context, int64, bool, bool, string) (r0 *database.SlackWebhookAction, r1 error) {
+			defaultHook: func(context.Context, int64, bool, bool, string, database.ActionDelivery) (r0 *database.SlackWebhookAction, r1 error) {
+				return
+			},
+		},
+		CreateTeamsWebhookActionFunc: &CodeMonitorStoreCreateTeamsWebhookActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, string, database.ActionDelivery) (r0 *database.TeamsWebhookAction, r1 error) {
+				return
+			},
+		},
+		CreateTemplatedWebhookActionFunc: &CodeMonitorStoreCreateTemplatedWebhookActionFunc{
+			defaultHook: func(context.Context, int64, *database.TemplatedWebhookActionArgs) (r0 *database.TemplatedWebhookAction, r1 error) {
 				return
 			},
 		},
 		CreateWebhookActionFunc: &CodeMonitorStoreCreateWebhookActionFunc{
-			defaultHook: func(context.Context, int64, bool, bool, string) (r0 *database.WebhookAction, r1 error) {
+			defaultHook: func(context.Context, int64, bool, bool, string, database.ActionDelivery) (r0 *database.WebhookAction, r1 error) {
 				return
 			},
 		},
@@ -6587,6 +6685,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		DeleteLastContentMatchesFunc: &CodeMonitorStoreDeleteLastContentMatchesFunc{
+			defaultHook: func(context.Context, int64) (r0 error) {
+				return
+			},
+		},
 		DeleteMonitorFunc: &CodeMonitorStoreDeleteMonitorFunc{
 			defaultHook: func(context.Context, int64) (r0 error) {
 				return
@@ -6607,6 +6710,16 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		DeleteTeamsWebhookActionsFunc: &CodeMonitorStoreDeleteTeamsWebhookActionsFunc{
+			defaultHook: func(context.Context, int64, ...int64) (r0 error) {
+				return
+			},
+		},
+		DeleteTemplatedWebhookActionsFunc: &CodeMonitorStoreDeleteTemplatedWebhookActionsFunc{
+			defaultHook: func(context.Context, int64, ...int64) (r0 error) {
+				return
+			},
+		},
 		DeleteWebhookActionsFunc: &CodeMonitorStoreDeleteWebhookActionsFunc{
 			defaultHook: func(context.Context, int64, ...int64) (r0 error) {
 				return
@@ -6647,6 +6760,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		GetLastContentMatchesFunc: &CodeMonitorStoreGetLastContentMatchesFunc{
+			defaultHook: func(context.Context, int64) (r0 []database.ContentMatch, r1 bool, r2 error) {
+				return
+			},
+		},
 		GetLastSearchedFunc: &CodeMonitorStoreGetLastSearchedFunc{
 			defaultHook: func(context.Context, int64, api.RepoID) (r0 []string, r1 error) {
 				return
@@ -6672,6 +6790,16 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		GetTeamsWebhookActionFunc: &CodeMonitorStoreGetTeamsWebhookActionFunc{
+			defaultHook: func(context.Context, int64) (r0 *database.TeamsWebhookAction, r1 error) {
+				return
+			},
+		},
+		GetTemplatedWebhookActionFunc: &CodeMonitorStoreGetTemplatedWebhookActionFunc{
+			defaultHook: func(context.Context, int64) (r0 *database.TemplatedWebhookAction, r1 error) {
+				return
+			},
+		},
 		GetWebhookActionFunc: &CodeMonitorStoreGetWebhookActionFunc{
 			defaultHook: func(context.Context, int64) (r0 *database.WebhookAction, r1 error) {
 				return
@@ -6717,11 +6845,26 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		ListTeamsWebhookActionsFunc: &CodeMonitorStoreListTeamsWebhookActionsFunc{
+			defaultHook: func(context.Context, database.ListActionsOpts) (r0 []*database.TeamsWebhookAction, r1 error) {
+				return
+			},
+		},
+		ListTemplatedWebhookActionsFunc: &CodeMonitorStoreListTemplatedWebhookActionsFunc{
+			defaultHook: func(context.Context, database.ListActionsOpts) (r0 []*database.TemplatedWebhookAction, r1 error) {
+				return
+			},
+		},
 		ListWebhookActionsFunc: &CodeMonitorStoreListWebhookActionsFunc{
 			defaultHook: func(context.Context, database.ListActionsOpts) (r0 []*database.WebhookAction, r1 error) {
 				return
 			},
 		},
+		MarkActionJobSentFunc: &CodeMonitorStoreMarkActionJobSentFunc{
+			defaultHook: func(context.Context, int32) (r0 error) {
+				return
+			},
+		},
 		NowFunc: &CodeMonitorStoreNowFunc{
 			defaultHook: func() (r0 time.Time) {
 				return
@@ -6732,6 +6875,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		SetActionJobLogContentsFunc: &CodeMonitorStoreSetActionJobLogContentsFunc{
+			defaultHook: func(context.Context, int32, string) (r0 error) {
+				return
+			},
+		},
 		SetQueryTriggerNextRunFunc: &CodeMonitorStoreSetQueryTriggerNextRunFunc{
 			defaultHook: func(context.Context, int64, time.Time, time.Time) (r0 error) {
 				return
@@ -6758,12 +6906,27 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 			},
 		},
 		UpdateQueryTriggerFunc: &CodeMonitorStoreUpdateQueryTriggerFunc{
-			defaultHook: func(context.Context, int64, string) (r0 error) {
+			defaultHook: func(context.Context, int64, string, database.QueryTriggerKind) (r0 error) {
 				return
 			},
 		},
 		UpdateSlackWebhookActionFunc: &CodeMonitorStoreUpdateSlackWebhookActionFunc{
-			defaultHook: func(context.Context, int64, bool, bool, string) (r0 *database.SlackWebhookAction, r1 error) {
+			defaultHook: func(context.Context, int64, bool, bool, string, database.ActionDelivery) (r0 *database.SlackWebhookAction, r1 error) {
+				return
+			},
+		},
+		UpdateTeamsWebhookActionFunc: &CodeMonitorStoreUpdateTeamsWebhookActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, string, database.ActionDelivery) (r0 *database.TeamsWebhookAction, r1 error) {
+				return
+			},
+		},
+		UpdateTemplatedWebhookActionFunc: &CodeMonitorStoreUpdateTemplatedWebhookActionFunc{
+			defaultHook: func(context.Context, int64, *database.TemplatedWebhookActionArgs) (r0 *database.TemplatedWebhookAction, r1 error) {
+				return
+			},
+		},
+		UpdateTriggerJobWithContentChangesFunc: &CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc{
+			defaultHook: func(context.Context, int32, string, *database.ContentChanges) (r0 error) {
 				return
 			},
 		},
@@ -6778,7 +6941,12 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 			},
 		},
 		UpdateWebhookActionFunc: &CodeMonitorStoreUpdateWebhookActionFunc{
-			defaultHook: func(context.Context, int64, bool, bool, string) (r0 *database.WebhookAction, r1 error) {
+			defaultHook: func(context.Context, int64, bool, bool, string, database.ActionDelivery) (r0 *database.WebhookAction, r1 error) {
+				return
+			},
+		},
+		UpsertLastContentMatchesFunc: &CodeMonitorStoreUpsertLastContentMatchesFunc{
+			defaultHook: func(context.Context, int64, []database.ContentMatch) (r0 error) {
 				return
 			},
 		},
@@ -6794,6 +6962,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 // interface. All methods panic on invocation, unless overwritten.
 func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 	return &MockCodeMonitorStore{
+		ClaimDigestActionJobsFunc: &CodeMonitorStoreClaimDigestActionJobsFunc{
+			defaultHook: func(context.Context, *database.ActionJob) ([]*database.ActionJob, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.ClaimDigestActionJobs")
+			},
+		},
 		ClockFunc: &CodeMonitorStoreClockFunc{
 			defaultHook: func() func() time.Time {
 				panic("unexpected invocation of MockCodeMonitorStore.Clock")
@@ -6819,11 +6992,26 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.CountRecipients")
 			},
 		},
+		CountSentNotificationsFunc: &CodeMonitorStoreCountSentNotificationsFunc{
+			defaultHook: func(context.Context, int64, time.Time, int32) (int, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CountSentNotifications")
+			},
+		},
 		CountSlackWebhookActionsFunc: &CodeMonitorStoreCountSlackWebhookActionsFunc{
 			defaultHook: func(context.Context, int64) (int, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CountSlackWebhookActions")
 			},
 		},
+		CountTeamsWebhookActionsFunc: &CodeMonitorStoreCountTeamsWebhookActionsFunc{
+			defaultHook: func(context.Context, int64) (int, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CountTeamsWebhookActions")
+			},
+		},
+		CountTemplatedWebhookActionsFunc: &CodeMonitorStoreCountTemplatedWebhookActionsFunc{
+			defaultHook: func(context.Context, int64) (int, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CountTemplatedWebhookActions")
+			},
+		},
 		CountWebhookActionsFunc: &CodeMonitorStoreCountWebhookActionsFunc{
 			defaultHook: func(context.Context, int64) (int, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CountWebhookActions")
@@ -6840,7 +7028,7 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 			},
 		},
 		CreateQueryTriggerFunc: &CodeMonitorStoreCreateQueryTriggerFunc{
-			defaultHook: func(context.Context, int64, string) (*database.QueryTrigger, error) {
+			defaultHook: func(context.Context, int64, string, database.QueryTriggerKind) (*database.QueryTrigger, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateQueryTrigger")
 			},
 		},
@@ -6850,12 +7038,22 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 			},
 		},
 		CreateSlackWebhookActionFunc: &CodeMonitorStoreCreateSlackWebhookActionFunc{
-			defaultHook: func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error) {
+			defaultHook: func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.SlackWebhookAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateSlackWebhookAction")
 			},
 		},
+		CreateTeamsWebhookActionFunc: &CodeMonitorStoreCreateTeamsWebhookActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.TeamsWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CreateTeamsWebhookAction")
+			},
+		},
+		CreateTemplatedWebhookActionFunc: &CodeMonitorStoreCreateTemplatedWebhookActionFunc{
+			defaultHook: func(context.Context, int64, *database.TemplatedWebhookActionArgs) (*database.TemplatedWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CreateTemplatedWebhookAction")
+			},
+		},
 		CreateWebhookActionFunc: &CodeMonitorStoreCreateWebhookActionFunc{
-			defaultHook: func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error) {
+			defaultHook: func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.WebhookAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateWebhookAction")
 			},
 		},
@@ -6864,6 +7062,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.DeleteEmailActions")
 			},
 		},
+		DeleteLastContentMatchesFunc: &CodeMonitorStoreDeleteLastContentMatchesFunc{
+			defaultHook: func(context.Context, int64) error {
+				panic("unexpected invocation of MockCodeMonitorStore.DeleteLastContentMatches")
+			},
+		},
 		DeleteMonitorFunc: &CodeMonitorStoreDeleteMonitorFunc{
 			defaultHook: func(context.Context, int64) error {
 				panic("unexpected invocation of MockCodeMonitorStore.DeleteMonitor")
@@ -6884,6 +7087,16 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.DeleteSlackWebhookActions")
 			},
 		},
+		DeleteTeamsWebhookActionsFunc: &CodeMonitorStoreDeleteTeamsWebhookActionsFunc{
+			defaultHook: func(context.Context, int64, ...int64) error {
+				panic("unexpected invocation of MockCodeMonitorStore.DeleteTeamsWebhookActions")
+			},
+		},
+		DeleteTemplatedWebhookActionsFunc: &CodeMonitorStoreDeleteTemplatedWebhookActionsFunc{
+			defaultHook: func(context.Context, int64, ...int64) error {
+				panic("unexpected invocation of MockCodeMonitorStore.DeleteTemplatedWebhookActions")
+			},
+		},
 		DeleteWebhookActionsFunc: &CodeMonitorStoreDeleteWebhookActionsFunc{
 			defaultHook: func(context.Context, int64, ...int64) error {
 				panic("unexpected invocation of MockCodeMonitorStore.DeleteWebhookActions")
@@ -6924,6 +7137,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.GetEmailAction")
 			},
 		},
+		GetLastContentMatchesFunc: &CodeMonitorStoreGetLastContentMatchesFunc{
+			defaultHook: func(context.Context, int64) ([]database.ContentMatch, bool, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.GetLastContentMatches")
+			},
+		},
 		GetLastSearchedFunc: &CodeMonitorStoreGetLastSearchedFunc{
 			defaultHook: func(context.Context, int64, api.RepoID) ([]string, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.GetLastSearched")
@@ -6949,6 +7167,16 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.GetSlackWebhookAction")
 			},
 		},
+		GetTeamsWebhookActionFunc: &CodeMonitorStoreGetTeamsWebhookActionFunc{
+			defaultHook: func(context.Context, int64) (*database.TeamsWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.GetTeamsWebhookAction")
+			},
+		},
+		GetTemplatedWebhookActionFunc: &CodeMonitorStoreGetTemplatedWebhookActionFunc{
+			defaultHook: func(context.Context, int64) (*database.TemplatedWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.GetTemplatedWebhookAction")
+			},
+		},
 		GetWebhookActionFunc: &CodeMonitorStoreGetWebhookActionFunc{
 			defaultHook: func(context.Context, int64) (*database.WebhookAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.GetWebhookAction")
@@ -6994,11 +7222,26 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.ListSlackWebhookActions")
 			},
 		},
+		ListTeamsWebhookActionsFunc: &CodeMonitorStoreListTeamsWebhookActionsFunc{
+			defaultHook: func(context.Context, database.ListActionsOpts) ([]*database.TeamsWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.ListTeamsWebhookActions")
+			},
+		},
+		ListTemplatedWebhookActionsFunc: &CodeMonitorStoreListTemplatedWebhookActionsFunc{
+			defaultHook: func(context.Context, database.ListActionsOpts) ([]*database.TemplatedWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.ListTemplatedWebhookActions")
+			},
+		},
 		ListWebhookActionsFunc: &CodeMonitorStoreListWebhookActionsFunc{
 			defaultHook: func(context.Context, database.ListActionsOpts) ([]*database.WebhookAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.ListWebhookActions")
 			},
 		},
+		MarkActionJobSentFunc: &CodeMonitorStoreMarkActionJobSentFunc{
+			defaultHook: func(context.Context, int32) error {
+				panic("unexpected invocation of MockCodeMonitorStore.MarkActionJobSent")
+			},
+		},
 		NowFunc: &CodeMonitorStoreNowFunc{
 			defaultHook: func() time.Time {
 				panic("unexpected invocation of MockCodeMonitorStore.Now")
@@ -7009,6 +7252,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.ResetQueryTriggerTimestamps")
 			},
 		},
+		SetActionJobLogContentsFunc: &CodeMonitorStoreSetActionJobLogContentsFunc{
+			defaultHook: func(context.Context, int32, string) error {
+				panic("unexpected invocation of MockCodeMonitorStore.SetActionJobLogContents")
+			},
+		},
 		SetQueryTriggerNextRunFunc: &CodeMonitorStoreSetQueryTriggerNextRunFunc{
 			defaultHook: func(context.Context, int64, time.Time, time.Time) error {
 				panic("unexpected invocation of MockCodeMonitorStore.SetQueryTriggerNextRun")
@@ -7035,15 +7283,30 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 			},
 		},
 		UpdateQueryTriggerFunc: &CodeMonitorStoreUpdateQueryTriggerFunc{
-			defaultHook: func(context.Context, int64, string) error {
+			defaultHook: func(context.Context, int64, string, database.QueryTriggerKind) error {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateQueryTrigger")
 			},
 		},
 		UpdateSlackWebhookActionFunc: &CodeMonitorStoreUpdateSlackWebhookActionFunc{
-			defaultHook: func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error) {
+			defaultHook: func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.SlackWebhookAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateSlackWebhookAction")
 			},
 		},
+		UpdateTeamsWebhookActionFunc: &CodeMonitorStoreUpdateTeamsWebhookActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.TeamsWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.UpdateTeamsWebhookAction")
+			},
+		},
+		UpdateTemplatedWebhookActionFunc: &CodeMonitorStoreUpdateTemplatedWebhookActionFunc{
+			defaultHook: func(context.Context, int64, *database.TemplatedWebhookActionArgs) (*database.TemplatedWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.UpdateTemplatedWebhookAction")
+			},
+		},
+		UpdateTriggerJobWithContentChangesFunc: &CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc{
+			defaultHook: func(context.Context, int32, string, *database.ContentChanges) error {
+				panic("unexpected invocation of MockCodeMonitorStore.UpdateTriggerJobWithContentChanges")
+			},
+		},
 		UpdateTriggerJobWithLogsFunc: &CodeMonitorStoreUpdateTriggerJobWithLogsFunc{
 			defaultHook: func(context.Context, int32, database.TriggerJobLogs) error {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateTriggerJobWithLogs")
@@ -7055,10 +7318,15 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 			},
 		},
 		UpdateWebhookActionFunc: &CodeMonitorStoreUpdateWebhookActionFunc{
-			defaultHook: func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error) {
+			defaultHook: func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.WebhookAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateWebhookAction")
 			},
 		},
+		UpsertLastContentMatchesFunc: &CodeMonitorStoreUpsertLastContentMatchesFunc{
+			defaultHook: func(context.Context, int64, []database.ContentMatch) error {
+				panic("unexpected invocation of MockCodeMonitorStore.UpsertLastContentMatches")
+			},
+		},
 		UpsertLastSearchedFunc: &CodeMonitorStoreUpsertLastSearchedFunc{
 			defaultHook: func(context.Context, int64, api.RepoID, []string) error {
 				panic("unexpected invocation of MockCodeMonitorStore.UpsertLastSearched")
@@ -7072,6 +7340,9 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 // implementation, unless overwritten.
 func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorStore {
 	return &MockCodeMonitorStore{
+		ClaimDigestActionJobsFunc: &CodeMonitorStoreClaimDigestActionJobsFunc{
+			defaultHook: i.ClaimDigestActionJobs,
+		},
 		ClockFunc: &CodeMonitorStoreClockFunc{
 			defaultHook: i.Clock,
 		},
@@ -7087,9 +7358,18 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		CountRecipientsFunc: &CodeMonitorStoreCountRecipientsFunc{
 			defaultHook: i.CountRecipients,
 		},
+		CountSentNotificationsFunc: &CodeMonitorStoreCountSentNotificationsFunc{
+			defaultHook: i.CountSentNotifications,
+		},
 		CountSlackWebhookActionsFunc: &CodeMonitorStoreCountSlackWebhookActionsFunc{
 			defaultHook: i.CountSlackWebhookActions,
 		},
+		CountTeamsWebhookActionsFunc: &CodeMonitorStoreCountTeamsWebhookActionsFunc{
+			defaultHook: i.CountTeamsWebhookActions,
+		},
+		CountTemplatedWebhookActionsFunc: &CodeMonitorStoreCountTemplatedWebhookActionsFunc{
+			defaultHook: i.CountTemplatedWebhookActions,
+		},
 		CountWebhookActionsFunc: &CodeMonitorStoreCountWebhookActionsFunc{
 			defaultHook: i.CountWebhookActions,
 		},
@@ -7108,12 +7388,21 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		CreateSlackWebhookActionFunc: &CodeMonitorStoreCreateSlackWebhookActionFunc{
 			defaultHook: i.CreateSlackWebhookAction,
 		},
+		CreateTeamsWebhookActionFunc: &CodeMonitorStoreCreateTeamsWebhookActionFunc{
+			defaultHook: i.CreateTeamsWebhookAction,
+		},
+		CreateTemplatedWebhookActionFunc: &CodeMonitorStoreCreateTemplatedWebhookActionFunc{
+			defaultHook: i.CreateTemplatedWebhookAction,
+		},
 		CreateWebhookActionFunc: &CodeMonitorStoreCreateWebhookActionFunc{
 			defaultHook: i.CreateWebhookAction,
 		},
 		DeleteEmailActionsFunc: &CodeMonitorStoreDeleteEmailActionsFunc{
 			defaultHook: i.DeleteEmailActions,
 		},
+		DeleteLastContentMatchesFunc: &CodeMonitorStoreDeleteLastContentMatchesFunc{
+			defaultHook: i.DeleteLastContentMatches,
+		},
 		DeleteMonitorFunc: &CodeMonitorStoreDeleteMonitorFunc{
 			defaultHook: i.DeleteMonitor,
 		},
@@ -7126,6 +7415,12 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		DeleteSlackWebhookActionsFunc: &CodeMonitorStoreDeleteSlackWebhookActionsFunc{
 			defaultHook: i.DeleteSlackWebhookActions,
 		},
+		DeleteTeamsWebhookActionsFunc: &CodeMonitorStoreDeleteTeamsWebhookActionsFunc{
+			defaultHook: i.DeleteTeamsWebhookActions,
+		},
+		DeleteTemplatedWebhookActionsFunc: &CodeMonitorStoreDeleteTemplatedWebhookActionsFunc{
+			defaultHook: i.DeleteTemplatedWebhookActions,
+		},
 		DeleteWebhookActionsFunc: &CodeMonitorStoreDeleteWebhookActionsFunc{
 			defaultHook: i.DeleteWebhookActions,
 		},
@@ -7150,6 +7445,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		GetEmailActionFunc: &CodeMonitorStoreGetEmailActionFunc{
 			defaultHook: i.GetEmailAction,
 		},
+		GetLastContentMatchesFunc: &CodeMonitorStoreGetLastContentMatchesFunc{
+			defaultHook: i.GetLastContentMatches,
+		},
 		GetLastSearchedFunc: &CodeMonitorStoreGetLastSearchedFunc{
 			defaultHook: i.GetLastSearched,
 		},
@@ -7165,6 +7463,12 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		GetSlackWebhookActionFunc: &CodeMonitorStoreGetSlackWebhookActionFunc{
 			defaultHook: i.GetSlackWebhookAction,
 		},
+		GetTeamsWebhookActionFunc: &CodeMonitorStoreGetTeamsWebhookActionFunc{
+			defaultHook: i.GetTeamsWebhookAction,
+		},
+		GetTemplatedWebhookActionFunc: &CodeMonitorStoreGetTemplatedWebhookActionFunc{
+			defaultHook: i.GetTemplatedWebhookAction,
+		},
 		GetWebhookActionFunc: &CodeMonitorStoreGetWebhookActionFunc{
 			defaultHook: i.GetWebhookAction,
 		},
@@ -7192,15 +7496,27 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		ListSlackWebhookActionsFunc: &CodeMonitorStoreListSlackWebhookActionsFunc{
 			defaultHook: i.ListSlackWebhookActions,
 		},
+		ListTeamsWebhookActionsFunc: &CodeMonitorStoreListTeamsWebhookActionsFunc{
+			defaultHook: i.ListTeamsWebhookActions,
+		},
+		ListTemplatedWebhookActionsFunc: &CodeMonitorStoreListTemplatedWebhookActionsFunc{
+			defaultHook: i.ListTemplatedWebhookActions,
+		},
 		ListWebhookActionsFunc: &CodeMonitorStoreListWebhookActionsFunc{
 			defaultHook: i.ListWebhookActions,
 		},
+		MarkActionJobSentFunc: &CodeMonitorStoreMarkActionJobSentFunc{
+			defaultHook: i.MarkActionJobSent,
+		},
 		NowFunc: &CodeMonitorStoreNowFunc{
 			defaultHook: i.Now,
 		},
 		ResetQueryTriggerTimestampsFunc: &CodeMonitorStoreResetQueryTriggerTimestampsFunc{
 			defaultHook: i.ResetQueryTriggerTimestamps,
 		},
+		SetActionJobLogContentsFunc: &CodeMonitorStoreSetActionJobLogContentsFunc{
+			defaultHook: i.SetActionJobLogContents,
+		},
 		SetQueryTriggerNextRunFunc: &CodeMonitorStoreSetQueryTriggerNextRunFunc{
 			defaultHook: i.SetQueryTriggerNextRun,
 		},
@@ -7222,6 +7538,15 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		UpdateSlackWebhookActionFunc: &CodeMonitorStoreUpdateSlackWebhookActionFunc{
 			defaultHook: i.UpdateSlackWebhookAction,
 		},
+		UpdateTeamsWebhookActionFunc: &CodeMonitorStoreUpdateTeamsWebhookActionFunc{
+			defaultHook: i.UpdateTeamsWebhookAction,
+		},
+		UpdateTemplatedWebhookActionFunc: &CodeMonitorStoreUpdateTemplatedWebhookActionFunc{
+			defaultHook: i.UpdateTemplatedWebhookAction,
+		},
+		UpdateTriggerJobWithContentChangesFunc: &CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc{
+			defaultHook: i.UpdateTriggerJobWithContentChanges,
+		},
 		UpdateTriggerJobWithLogsFunc: &CodeMonitorStoreUpdateTriggerJobWithLogsFunc{
 			defaultHook: i.UpdateTriggerJobWithLogs,
 		},
@@ -7231,12 +7556,127 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		UpdateWebhookActionFunc: &CodeMonitorStoreUpdateWebhookActionFunc{
 			defaultHook: i.UpdateWebhookAction,
 		},
+		UpsertLastContentMatchesFunc: &CodeMonitorStoreUpsertLastContentMatchesFunc{
+			defaultHook: i.UpsertLastContentMatches,
+		},
 		UpsertLastSearchedFunc: &CodeMonitorStoreUpsertLastSearchedFunc{
 			defaultHook: i.UpsertLastSearched,
 		},
 	}
 }
 
+// CodeMonitorStoreClaimDigestActionJobsFunc describes the behavior when the
+// ClaimDigestActionJobs method of the parent MockCodeMonitorStore instance
+// is invoked.
+type CodeMonitorStoreClaimDigestActionJobsFunc struct {
+	defaultHook func(context.Context, *database.ActionJob) ([]*database.ActionJob, error)
+	hooks       []func(context.Context, *database.ActionJob) ([]*database.ActionJob, error)
+	history     []CodeMonitorStoreClaimDigestActionJobsFuncCall
+	mutex       sync.Mutex
+}
+
+// ClaimDigestActionJobs delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) ClaimDigestActionJobs(v0 context.Context, v1 *database.ActionJob) ([]*database.ActionJob, error) {
+	r0, r1 := m.ClaimDigestActionJobsFunc.nextHook()(v0, v1)
+	m.ClaimDigestActionJobsFunc.appendCall(CodeMonitorStoreClaimDigestActionJobsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ClaimDigestActionJobs method of the parent MockCodeMonitorStore instance
+// is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreClaimDigestActionJobsFunc) SetDefaultHook(hook func(context.Context, *database.ActionJob) ([]*database.ActionJob, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ClaimDigestActionJobs method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreClaimDigestActionJobsFunc) PushHook(hook func(context.Context, *database.ActionJob) ([]*database.ActionJob, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreClaimDigestActionJobsFunc) SetDefaultReturn(r0 []*database.ActionJob, r1 error) {
+	f.SetDefaultHook(func(context.Context, *database.ActionJob) ([]*database.ActionJob, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreClaimDigestActionJobsFunc) PushReturn(r0 []*database.ActionJob, r1 error) {
+	f.PushHook(func(context.Context, *database.ActionJob) ([]*database.ActionJob, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreClaimDigestActionJobsFunc) nextHook() func(context.Context, *database.ActionJob) ([]*database.ActionJob, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreClaimDigestActionJobsFunc) appendCall(r0 CodeMonitorStoreClaimDigestActionJobsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreClaimDigestActionJobsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreClaimDigestActionJobsFunc) History() []CodeMonitorStoreClaimDigestActionJobsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreClaimDigestActionJobsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreClaimDigestActionJobsFuncCall is an object that describes
+// an invocation of method ClaimDigestActionJobs on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreClaimDigestActionJobsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *database.ActionJob
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*database.ActionJob
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreClaimDigestActionJobsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreClaimDigestActionJobsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreClockFunc describes the behavior when the Clock method of
 // the parent MockCodeMonitorStore instance is invoked.
 type CodeMonitorStoreClockFunc struct {
@@ -7780,6 +8220,124 @@ func (c CodeMonitorStoreCountRecipientsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreCountSentNotificationsFunc describes the behavior when
+// the CountSentNotifications method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreCountSentNotificationsFunc struct {
+	defaultHook func(context.Context, int64, time.Time, int32) (int, error)
+	hooks       []func(context.Context, int64, time.Time, int32) (int, error)
+	history     []CodeMonitorStoreCountSentNotificationsFuncCall
+	mutex       sync.Mutex
+}
+
+// CountSentNotifications delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CountSentNotifications(v0 context.Context, v1 int64, v2 time.Time, v3 int32) (int, error) {
+	r0, r1 := m.CountSentNotificationsFunc.nextHook()(v0, v1, v2, v3)
+	m.CountSentNotificationsFunc.appendCall(CodeMonitorStoreCountSentNotificationsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CountSentNotifications method of the parent MockCodeMonitorStore instance
+// is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreCountSentNotificationsFunc) SetDefaultHook(hook func(context.Context, int64, time.Time, int32) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CountSentNotifications method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreCountSentNotificationsFunc) PushHook(hook func(context.Context, int64, time.Time, int32) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCountSentNotificationsFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, time.Time, int32) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCountSentNotificationsFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, int64, time.Time, int32) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCountSentNotificationsFunc) nextHook() func(context.Context, int64, time.Time, int32) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCountSentNotificationsFunc) appendCall(r0 CodeMonitorStoreCountSentNotificationsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreCountSentNotificationsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreCountSentNotificationsFunc) History() []CodeMonitorStoreCountSentNotificationsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCountSentNotificationsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCountSentNotificationsFuncCall is an object that
+// describes an invocation of method CountSentNotifications on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreCountSentNotificationsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 time.Time
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int32
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCountSentNotificationsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCountSentNotificationsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreCountSlackWebhookActionsFunc describes the behavior when
 // the CountSlackWebhookActions method of the parent MockCodeMonitorStore
 // instance is invoked.
@@ -7892,6 +8450,230 @@ func (c CodeMonitorStoreCountSlackWebhookActionsFuncCall) Results() []interface{
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreCountTeamsWebhookActionsFunc describes the behavior when
+// the CountTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreCountTeamsWebhookActionsFunc struct {
+	defaultHook func(context.Context, int64) (int, error)
+	hooks       []func(context.Context, int64) (int, error)
+	history     []CodeMonitorStoreCountTeamsWebhookActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// CountTeamsWebhookActions delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CountTeamsWebhookActions(v0 context.Context, v1 int64) (int, error) {
+	r0, r1 := m.CountTeamsWebhookActionsFunc.nextHook()(v0, v1)
+	m.CountTeamsWebhookActionsFunc.appendCall(CodeMonitorStoreCountTeamsWebhookActionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CountTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) SetDefaultHook(hook func(context.Context, int64) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CountTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) PushHook(hook func(context.Context, int64) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, int64) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) nextHook() func(context.Context, int64) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) appendCall(r0 CodeMonitorStoreCountTeamsWebhookActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreCountTeamsWebhookActionsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) History() []CodeMonitorStoreCountTeamsWebhookActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCountTeamsWebhookActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCountTeamsWebhookActionsFuncCall is an object that
+// describes an invocation of method CountTeamsWebhookActions on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreCountTeamsWebhookActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCountTeamsWebhookActionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCountTeamsWebhookActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeMonitorStoreCountTemplatedWebhookActionsFunc describes the behavior
+// when the CountTemplatedWebhookActions method of the parent
+// MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreCountTemplatedWebhookActionsFunc struct {
+	defaultHook func(context.Context, int64) (int, error)
+	hooks       []func(context.Context, int64) (int, error)
+	history     []CodeMonitorStoreCountTemplatedWebhookActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// CountTemplatedWebhookActions delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CountTemplatedWebhookActions(v0 context.Context, v1 int64) (int, error) {
+	r0, r1 := m.CountTemplatedWebhookActionsFunc.nextHook()(v0, v1)
+	m.CountTemplatedWebhookActionsFunc.appendCall(CodeMonitorStoreCountTemplatedWebhookActionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CountTemplatedWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreCountTemplatedWebhookActionsFunc) SetDefaultHook(hook func(context.Context, int64) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CountTemplatedWebhookActions method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreCountTemplatedWebhookActionsFunc) PushHook(hook func(context.Context, int64) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCountTemplatedWebhookActionsFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCountTemplatedWebhookActionsFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, int64) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCountTemplatedWebhookActionsFunc) nextHook() func(context.Context, int64) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCountTemplatedWebhookActionsFunc) appendCall(r0 CodeMonitorStoreCountTemplatedWebhookActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreCountTemplatedWebhookActionsFuncCall objects describing
+// the invocations of this function.
+func (f *CodeMonitorStoreCountTemplatedWebhookActionsFunc) History() []CodeMonitorStoreCountTemplatedWebhookActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCountTemplatedWebhookActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCountTemplatedWebhookActionsFuncCall is an object that
+// describes an invocation of method CountTemplatedWebhookActions on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreCountTemplatedWebhookActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCountTemplatedWebhookActionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCountTemplatedWebhookActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreCountWebhookActionsFunc describes the behavior when the
 // CountWebhookActions method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -8231,24 +9013,24 @@ func (c CodeMonitorStoreCreateMonitorFuncCall) Results() []interface{} {
 // CreateQueryTrigger method of the parent MockCodeMonitorStore instance is
 // invoked.
 type CodeMonitorStoreCreateQueryTriggerFunc struct {
-	defaultHook func(context.Context, int64, string) (*database.QueryTrigger, error)
-	hooks       []func(context.Context, int64, string) (*database.QueryTrigger, error)
+	defaultHook func(context.Context, int64, string, database.QueryTriggerKind) (*database.QueryTrigger, error)
+	hooks       []func(context.Context, int64, string, database.QueryTriggerKind) (*database.QueryTrigger, error)
 	history     []CodeMonitorStoreCreateQueryTriggerFuncCall
 	mutex       sync.Mutex
 }
 
 // CreateQueryTrigger delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) CreateQueryTrigger(v0 context.Context, v1 int64, v2 string) (*database.QueryTrigger, error) {
-	r0, r1 := m.CreateQueryTriggerFunc.nextHook()(v0, v1, v2)
-	m.CreateQueryTriggerFunc.appendCall(CodeMonitorStoreCreateQueryTriggerFuncCall{v0, v1, v2, r0, r1})
+func (m *MockCodeMonitorStore) CreateQueryTrigger(v0 context.Context, v1 int64, v2 string, v3 database.QueryTriggerKind) (*database.QueryTrigger, error) {
+	r0, r1 := m.CreateQueryTriggerFunc.nextHook()(v0, v1, v2, v3)
+	m.CreateQueryTriggerFunc.appendCall(CodeMonitorStoreCreateQueryTriggerFuncCall{v0, v1, v2, v3, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the CreateQueryTrigger
 // method of the parent MockCodeMonitorStore instance is invoked and the
 // hook queue is empty.
-func (f *CodeMonitorStoreCreateQueryTriggerFunc) SetDefaultHook(hook func(context.Context, int64, string) (*database.QueryTrigger, error)) {
+func (f *CodeMonitorStoreCreateQueryTriggerFunc) SetDefaultHook(hook func(context.Context, int64, string, database.QueryTriggerKind) (*database.QueryTrigger, error)) {
 	f.defaultHook = hook
 }
 
@@ -8257,7 +9039,7 @@ func (f *CodeMonitorStoreCreateQueryTriggerFunc) SetDefaultHook(hook func(contex
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *CodeMonitorStoreCreateQueryTriggerFunc) PushHook(hook func(context.Context, int64, string) (*database.QueryTrigger, error)) {
+func (f *CodeMonitorStoreCreateQueryTriggerFunc) PushHook(hook func(context.Context, int64, string, database.QueryTriggerKind) (*database.QueryTrigger, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -8266,19 +9048,19 @@ func (f *CodeMonitorStoreCreateQueryTriggerFunc) PushHook(hook func(context.Cont
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
 func (f *CodeMonitorStoreCreateQueryTriggerFunc) SetDefaultReturn(r0 *database.QueryTrigger, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64, string) (*database.QueryTrigger, error) {
+	f.SetDefaultHook(func(context.Context, int64, string, database.QueryTriggerKind) (*database.QueryTrigger, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
 func (f *CodeMonitorStoreCreateQueryTriggerFunc) PushReturn(r0 *database.QueryTrigger, r1 error) {
-	f.PushHook(func(context.Context, int64, string) (*database.QueryTrigger, error) {
+	f.PushHook(func(context.Context, int64, string, database.QueryTriggerKind) (*database.QueryTrigger, error) {
 		return r0, r1
 	})
 }
 
-func (f *CodeMonitorStoreCreateQueryTriggerFunc) nextHook() func(context.Context, int64, string) (*database.QueryTrigger, error) {
+func (f *CodeMonitorStoreCreateQueryTriggerFunc) nextHook() func(context.Context, int64, string, database.QueryTriggerKind) (*database.QueryTrigger, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -8321,6 +9103,9 @@ type CodeMonitorStoreCreateQueryTriggerFuncCall struct {
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 database.QueryTriggerKind
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 *database.QueryTrigger
@@ -8332,7 +9117,7 @@ type CodeMonitorStoreCreateQueryTriggerFuncCall struct {
 // Args returns an interface slice containing the arguments of this
 // invocation.
 func (c CodeMonitorStoreCreateQueryTriggerFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
@@ -8462,24 +9247,24 @@ func (c CodeMonitorStoreCreateRecipientFuncCall) Results() []interface{} {
 // the CreateSlackWebhookAction method of the parent MockCodeMonitorStore
 // instance is invoked.
 type CodeMonitorStoreCreateSlackWebhookActionFunc struct {
-	defaultHook func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error)
-	hooks       []func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error)
+	defaultHook func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.SlackWebhookAction, error)
+	hooks       []func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.SlackWebhookAction, error)
 	history     []CodeMonitorStoreCreateSlackWebhookActionFuncCall
 	mutex       sync.Mutex
 }
 
 // CreateSlackWebhookAction delegates to the next hook function in the queue
 // and stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) CreateSlackWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string) (*database.SlackWebhookAction, error) {
-	r0, r1 := m.CreateSlackWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.CreateSlackWebhookActionFunc.appendCall(CodeMonitorStoreCreateSlackWebhookActionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+func (m *MockCodeMonitorStore) CreateSlackWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string, v5 database.ActionDelivery) (*database.SlackWebhookAction, error) {
+	r0, r1 := m.CreateSlackWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.CreateSlackWebhookActionFunc.appendCall(CodeMonitorStoreCreateSlackWebhookActionFuncCall{v0, v1, v2, v3, v4, v5, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
 // CreateSlackWebhookAction method of the parent MockCodeMonitorStore
 // instance is invoked and the hook queue is empty.
-func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error)) {
+func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.SlackWebhookAction, error)) {
 	f.defaultHook = hook
 }
 
@@ -8488,7 +9273,7 @@ func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) SetDefaultHook(hook func(
 // instance invokes the hook at the front of the queue and discards it.
 // After the queue is empty, the default hook function is invoked for any
 // future action.
-func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error)) {
+func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.SlackWebhookAction, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -8497,19 +9282,19 @@ func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) PushHook(hook func(contex
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
 func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) SetDefaultReturn(r0 *database.SlackWebhookAction, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error) {
+	f.SetDefaultHook(func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.SlackWebhookAction, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
 func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) PushReturn(r0 *database.SlackWebhookAction, r1 error) {
-	f.PushHook(func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error) {
+	f.PushHook(func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.SlackWebhookAction, error) {
 		return r0, r1
 	})
 }
 
-func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error) {
+func (f *CodeMonitorStoreCreateSlackWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.SlackWebhookAction, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -8559,6 +9344,9 @@ type CodeMonitorStoreCreateSlackWebhookActionFuncCall struct {
 	// Arg4 is the value of the 5th argument passed to this method
 	// invocation.
 	Arg4 string
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 database.ActionDelivery
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 *database.SlackWebhookAction
@@ -8570,7 +9358,7 @@ type CodeMonitorStoreCreateSlackWebhookActionFuncCall struct {
 // Args returns an interface slice containing the arguments of this
 // invocation.
 func (c CodeMonitorStoreCreateSlackWebhookActionFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
 }
 
 // Results returns an interface slice containing the results of this
@@ -8579,28 +9367,267 @@ func (c CodeMonitorStoreCreateSlackWebhookActionFuncCall) Results() []interface{
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreCreateTeamsWebhookActionFunc describes the behavior when
+// the CreateTeamsWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreCreateTeamsWebhookActionFunc struct {
+	defaultHook func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.TeamsWebhookAction, error)
+	hooks       []func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.TeamsWebhookAction, error)
+	history     []CodeMonitorStoreCreateTeamsWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateTeamsWebhookAction delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CreateTeamsWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string, v5 database.ActionDelivery) (*database.TeamsWebhookAction, error) {
+	r0, r1 := m.CreateTeamsWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.CreateTeamsWebhookActionFunc.appendCall(CodeMonitorStoreCreateTeamsWebhookActionFuncCall{v0, v1, v2, v3, v4, v5, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CreateTeamsWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.TeamsWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateTeamsWebhookAction method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.TeamsWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) SetDefaultReturn(r0 *database.TeamsWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.TeamsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) PushReturn(r0 *database.TeamsWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.TeamsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.TeamsWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) appendCall(r0 CodeMonitorStoreCreateTeamsWebhookActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreCreateTeamsWebhookActionFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) History() []CodeMonitorStoreCreateTeamsWebhookActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCreateTeamsWebhookActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCreateTeamsWebhookActionFuncCall is an object that
+// describes an invocation of method CreateTeamsWebhookAction on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreCreateTeamsWebhookActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 bool
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 database.ActionDelivery
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.TeamsWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCreateTeamsWebhookActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCreateTeamsWebhookActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeMonitorStoreCreateTemplatedWebhookActionFunc describes the behavior
+// when the CreateTemplatedWebhookAction method of the parent
+// MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreCreateTemplatedWebhookActionFunc struct {
+	defaultHook func(context.Context, int64, *database.TemplatedWebhookActionArgs) (*database.TemplatedWebhookAction, error)
+	hooks       []func(context.Context, int64, *database.TemplatedWebhookActionArgs) (*database.TemplatedWebhookAction, error)
+	history     []CodeMonitorStoreCreateTemplatedWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateTemplatedWebhookAction delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CreateTemplatedWebhookAction(v0 context.Context, v1 int64, v2 *database.TemplatedWebhookActionArgs) (*database.TemplatedWebhookAction, error) {
+	r0, r1 := m.CreateTemplatedWebhookActionFunc.nextHook()(v0, v1, v2)
+	m.CreateTemplatedWebhookActionFunc.appendCall(CodeMonitorStoreCreateTemplatedWebhookActionFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CreateTemplatedWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreCreateTemplatedWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, *database.TemplatedWebhookActionArgs) (*database.TemplatedWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateTemplatedWebhookAction method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreCreateTemplatedWebhookActionFunc) PushHook(hook func(context.Context, int64, *database.TemplatedWebhookActionArgs) (*database.TemplatedWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCreateTemplatedWebhookActionFunc) SetDefaultReturn(r0 *database.TemplatedWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, *database.TemplatedWebhookActionArgs) (*database.TemplatedWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCreateTemplatedWebhookActionFunc) PushReturn(r0 *database.TemplatedWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, int64, *database.TemplatedWebhookActionArgs) (*database.TemplatedWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCreateTemplatedWebhookActionFunc) nextHook() func(context.Context, int64, *database.TemplatedWebhookActionArgs) (*database.TemplatedWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCreateTemplatedWebhookActionFunc) appendCall(r0 CodeMonitorStoreCreateTemplatedWebhookActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreCreateTemplatedWebhookActionFuncCall objects describing
+// the invocations of this function.
+func (f *CodeMonitorStoreCreateTemplatedWebhookActionFunc) History() []CodeMonitorStoreCreateTemplatedWebhookActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCreateTemplatedWebhookActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCreateTemplatedWebhookActionFuncCall is an object that
+// describes an invocation of method CreateTemplatedWebhookAction on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreCreateTemplatedWebhookActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 *database.TemplatedWebhookActionArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.TemplatedWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCreateTemplatedWebhookActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCreateTemplatedWebhookActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreCreateWebhookActionFunc describes the behavior when the
 // CreateWebhookAction method of the parent MockCodeMonitorStore instance is
 // invoked.
 type CodeMonitorStoreCreateWebhookActionFunc struct {
-	defaultHook func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error)
-	hooks       []func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error)
+	defaultHook func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.WebhookAction, error)
+	hooks       []func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.WebhookAction, error)
 	history     []CodeMonitorStoreCreateWebhookActionFuncCall
 	mutex       sync.Mutex
 }
 
 // CreateWebhookAction delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) CreateWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string) (*database.WebhookAction, error) {
-	r0, r1 := m.CreateWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.CreateWebhookActionFunc.appendCall(CodeMonitorStoreCreateWebhookActionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+func (m *MockCodeMonitorStore) CreateWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string, v5 database.ActionDelivery) (*database.WebhookAction, error) {
+	r0, r1 := m.CreateWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.CreateWebhookActionFunc.appendCall(CodeMonitorStoreCreateWebhookActionFuncCall{v0, v1, v2, v3, v4, v5, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the CreateWebhookAction
 // method of the parent MockCodeMonitorStore instance is invoked and the
 // hook queue is empty.
-func (f *CodeMonitorStoreCreateWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error)) {
+func (f *CodeMonitorStoreCreateWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.WebhookAction, error)) {
 	f.defaultHook = hook
 }
 
@@ -8609,7 +9636,7 @@ func (f *CodeMonitorStoreCreateWebhookActionFunc) SetDefaultHook(hook func(conte
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *CodeMonitorStoreCreateWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error)) {
+func (f *CodeMonitorStoreCreateWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.WebhookAction, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -8618,19 +9645,19 @@ func (f *CodeMonitorStoreCreateWebhookActionFunc) PushHook(hook func(context.Con
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
 func (f *CodeMonitorStoreCreateWebhookActionFunc) SetDefaultReturn(r0 *database.WebhookAction, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error) {
+	f.SetDefaultHook(func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.WebhookAction, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
 func (f *CodeMonitorStoreCreateWebhookActionFunc) PushReturn(r0 *database.WebhookAction, r1 error) {
-	f.PushHook(func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error) {
+	f.PushHook(func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.WebhookAction, error) {
 		return r0, r1
 	})
 }
 
-func (f *CodeMonitorStoreCreateWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error) {
+func (f *CodeMonitorStoreCreateWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.WebhookAction, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -8679,6 +9706,9 @@ type CodeMonitorStoreCreateWebhookActionFuncCall struct {
 	// Arg4 is the value of the 5th argument passed to this method
 	// invocation.
 	Arg4 string
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 database.ActionDelivery
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 *database.WebhookAction
@@ -8690,7 +9720,7 @@ type CodeMonitorStoreCreateWebhookActionFuncCall struct {
 // Args returns an interface slice containing the arguments of this
 // invocation.
 func (c CodeMonitorStoreCreateWebhookActionFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
 }
 
 // Results returns an interface slice containing the results of this
@@ -8810,6 +9840,115 @@ func (c CodeMonitorStoreDeleteEmailActionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// CodeMonitorStoreDeleteLastContentMatchesFunc describes the behavior when
+// the DeleteLastContentMatches method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreDeleteLastContentMatchesFunc struct {
+	defaultHook func(context.Context, int64) error
+	hooks       []func(context.Context, int64) error
+	history     []CodeMonitorStoreDeleteLastContentMatchesFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteLastContentMatches delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) DeleteLastContentMatches(v0 context.Context, v1 int64) error {
+	r0 := m.DeleteLastContentMatchesFunc.nextHook()(v0, v1)
+	m.DeleteLastContentMatchesFunc.appendCall(CodeMonitorStoreDeleteLastContentMatchesFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// DeleteLastContentMatches method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreDeleteLastContentMatchesFunc) SetDefaultHook(hook func(context.Context, int64) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteLastContentMatches method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreDeleteLastContentMatchesFunc) PushHook(hook func(context.Context, int64) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreDeleteLastContentMatchesFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreDeleteLastContentMatchesFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreDeleteLastContentMatchesFunc) nextHook() func(context.Context, int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreDeleteLastContentMatchesFunc) appendCall(r0 CodeMonitorStoreDeleteLastContentMatchesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreDeleteLastContentMatchesFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreDeleteLastContentMatchesFunc) History() []CodeMonitorStoreDeleteLastContentMatchesFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreDeleteLastContentMatchesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreDeleteLastContentMatchesFuncCall is an object that
+// describes an invocation of method DeleteLastContentMatches on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreDeleteLastContentMatchesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreDeleteLastContentMatchesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreDeleteLastContentMatchesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreDeleteMonitorFunc describes the behavior when the
 // DeleteMonitor method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -9253,6 +10392,244 @@ func (c CodeMonitorStoreDeleteSlackWebhookActionsFuncCall) Results() []interface
 	return []interface{}{c.Result0}
 }
 
+// CodeMonitorStoreDeleteTeamsWebhookActionsFunc describes the behavior when
+// the DeleteTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreDeleteTeamsWebhookActionsFunc struct {
+	defaultHook func(context.Context, int64, ...int64) error
+	hooks       []func(context.Context, int64, ...int64) error
+	history     []CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteTeamsWebhookActions delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) DeleteTeamsWebhookActions(v0 context.Context, v1 int64, v2 ...int64) error {
+	r0 := m.DeleteTeamsWebhookActionsFunc.nextHook()(v0, v1, v2...)
+	m.DeleteTeamsWebhookActionsFunc.appendCall(CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// DeleteTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) SetDefaultHook(hook func(context.Context, int64, ...int64) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) PushHook(hook func(context.Context, int64, ...int64) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, ...int64) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, ...int64) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) nextHook() func(context.Context, int64, ...int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) appendCall(r0 CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) History() []CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall is an object that
+// describes an invocation of method DeleteTeamsWebhookActions on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// CodeMonitorStoreDeleteTemplatedWebhookActionsFunc describes the behavior
+// when the DeleteTemplatedWebhookActions method of the parent
+// MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreDeleteTemplatedWebhookActionsFunc struct {
+	defaultHook func(context.Context, int64, ...int64) error
+	hooks       []func(context.Context, int64, ...int64) error
+	history     []CodeMonitorStoreDeleteTemplatedWebhookActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteTemplatedWebhookActions delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) DeleteTemplatedWebhookActions(v0 context.Context, v1 int64, v2 ...int64) error {
+	r0 := m.DeleteTemplatedWebhookActionsFunc.nextHook()(v0, v1, v2...)
+	m.DeleteTemplatedWebhookActionsFunc.appendCall(CodeMonitorStoreDeleteTemplatedWebhookActionsFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// DeleteTemplatedWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreDeleteTemplatedWebhookActionsFunc) SetDefaultHook(hook func(context.Context, int64, ...int64) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteTemplatedWebhookActions method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreDeleteTemplatedWebhookActionsFunc) PushHook(hook func(context.Context, int64, ...int64) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreDeleteTemplatedWebhookActionsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, ...int64) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreDeleteTemplatedWebhookActionsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, ...int64) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreDeleteTemplatedWebhookActionsFunc) nextHook() func(context.Context, int64, ...int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreDeleteTemplatedWebhookActionsFunc) appendCall(r0 CodeMonitorStoreDeleteTemplatedWebhookActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreDeleteTemplatedWebhookActionsFuncCall objects describing
+// the invocations of this function.
+func (f *CodeMonitorStoreDeleteTemplatedWebhookActionsFunc) History() []CodeMonitorStoreDeleteTemplatedWebhookActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreDeleteTemplatedWebhookActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreDeleteTemplatedWebhookActionsFuncCall is an object that
+// describes an invocation of method DeleteTemplatedWebhookActions on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreDeleteTemplatedWebhookActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c CodeMonitorStoreDeleteTemplatedWebhookActionsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreDeleteTemplatedWebhookActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreDeleteWebhookActionsFunc describes the behavior when the
 // DeleteWebhookActions method of the parent MockCodeMonitorStore instance
 // is invoked.
@@ -10134,6 +11511,121 @@ func (c CodeMonitorStoreGetEmailActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreGetLastContentMatchesFunc describes the behavior when the
+// GetLastContentMatches method of the parent MockCodeMonitorStore instance
+// is invoked.
+type CodeMonitorStoreGetLastContentMatchesFunc struct {
+	defaultHook func(context.Context, int64) ([]database.ContentMatch, bool, error)
+	hooks       []func(context.Context, int64) ([]database.ContentMatch, bool, error)
+	history     []CodeMonitorStoreGetLastContentMatchesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetLastContentMatches delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) GetLastContentMatches(v0 context.Context, v1 int64) ([]database.ContentMatch, bool, error) {
+	r0, r1, r2 := m.GetLastContentMatchesFunc.nextHook()(v0, v1)
+	m.GetLastContentMatchesFunc.appendCall(CodeMonitorStoreGetLastContentMatchesFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the
+// GetLastContentMatches method of the parent MockCodeMonitorStore instance
+// is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreGetLastContentMatchesFunc) SetDefaultHook(hook func(context.Context, int64) ([]database.ContentMatch, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetLastContentMatches method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreGetLastContentMatchesFunc) PushHook(hook func(context.Context, int64) ([]database.ContentMatch, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreGetLastContentMatchesFunc) SetDefaultReturn(r0 []database.ContentMatch, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int64) ([]database.ContentMatch, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreGetLastContentMatchesFunc) PushReturn(r0 []database.ContentMatch, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int64) ([]database.ContentMatch, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *CodeMonitorStoreGetLastContentMatchesFunc) nextHook() func(context.Context, int64) ([]database.ContentMatch, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreGetLastContentMatchesFunc) appendCall(r0 CodeMonitorStoreGetLastContentMatchesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreGetLastContentMatchesFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreGetLastContentMatchesFunc) History() []CodeMonitorStoreGetLastContentMatchesFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreGetLastContentMatchesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreGetLastContentMatchesFuncCall is an object that describes
+// an invocation of method GetLastContentMatches on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreGetLastContentMatchesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []database.ContentMatch
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreGetLastContentMatchesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreGetLastContentMatchesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // CodeMonitorStoreGetLastSearchedFunc describes the behavior when the
 // GetLastSearched method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -10692,6 +12184,230 @@ func (c CodeMonitorStoreGetSlackWebhookActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreGetTeamsWebhookActionFunc describes the behavior when the
+// GetTeamsWebhookAction method of the parent MockCodeMonitorStore instance
+// is invoked.
+type CodeMonitorStoreGetTeamsWebhookActionFunc struct {
+	defaultHook func(context.Context, int64) (*database.TeamsWebhookAction, error)
+	hooks       []func(context.Context, int64) (*database.TeamsWebhookAction, error)
+	history     []CodeMonitorStoreGetTeamsWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// GetTeamsWebhookAction delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) GetTeamsWebhookAction(v0 context.Context, v1 int64) (*database.TeamsWebhookAction, error) {
+	r0, r1 := m.GetTeamsWebhookActionFunc.nextHook()(v0, v1)
+	m.GetTeamsWebhookActionFunc.appendCall(CodeMonitorStoreGetTeamsWebhookActionFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetTeamsWebhookAction method of the parent MockCodeMonitorStore instance
+// is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64) (*database.TeamsWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetTeamsWebhookAction method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) PushHook(hook func(context.Context, int64) (*database.TeamsWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) SetDefaultReturn(r0 *database.TeamsWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (*database.TeamsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) PushReturn(r0 *database.TeamsWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, int64) (*database.TeamsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) nextHook() func(context.Context, int64) (*database.TeamsWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) appendCall(r0 CodeMonitorStoreGetTeamsWebhookActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreGetTeamsWebhookActionFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) History() []CodeMonitorStoreGetTeamsWebhookActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreGetTeamsWebhookActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreGetTeamsWebhookActionFuncCall is an object that describes
+// an invocation of method GetTeamsWebhookAction on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreGetTeamsWebhookActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.TeamsWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreGetTeamsWebhookActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreGetTeamsWebhookActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeMonitorStoreGetTemplatedWebhookActionFunc describes the behavior when
+// the GetTemplatedWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreGetTemplatedWebhookActionFunc struct {
+	defaultHook func(context.Context, int64) (*database.TemplatedWebhookAction, error)
+	hooks       []func(context.Context, int64) (*database.TemplatedWebhookAction, error)
+	history     []CodeMonitorStoreGetTemplatedWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// GetTemplatedWebhookAction delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) GetTemplatedWebhookAction(v0 context.Context, v1 int64) (*database.TemplatedWebhookAction, error) {
+	r0, r1 := m.GetTemplatedWebhookActionFunc.nextHook()(v0, v1)
+	m.GetTemplatedWebhookActionFunc.appendCall(CodeMonitorStoreGetTemplatedWebhookActionFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetTemplatedWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreGetTemplatedWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64) (*database.TemplatedWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetTemplatedWebhookAction method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreGetTemplatedWebhookActionFunc) PushHook(hook func(context.Context, int64) (*database.TemplatedWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreGetTemplatedWebhookActionFunc) SetDefaultReturn(r0 *database.TemplatedWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (*database.TemplatedWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreGetTemplatedWebhookActionFunc) PushReturn(r0 *database.TemplatedWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, int64) (*database.TemplatedWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreGetTemplatedWebhookActionFunc) nextHook() func(context.Context, int64) (*database.TemplatedWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreGetTemplatedWebhookActionFunc) appendCall(r0 CodeMonitorStoreGetTemplatedWebhookActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreGetTemplatedWebhookActionFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreGetTemplatedWebhookActionFunc) History() []CodeMonitorStoreGetTemplatedWebhookActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreGetTemplatedWebhookActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreGetTemplatedWebhookActionFuncCall is an object that
+// describes an invocation of method GetTemplatedWebhookAction on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreGetTemplatedWebhookActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.TemplatedWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreGetTemplatedWebhookActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreGetTemplatedWebhookActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreGetWebhookActionFunc describes the behavior when the
 // GetWebhookAction method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -11677,6 +13393,230 @@ func (c CodeMonitorStoreListSlackWebhookActionsFuncCall) Results() []interface{}
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreListTeamsWebhookActionsFunc describes the behavior when
+// the ListTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreListTeamsWebhookActionsFunc struct {
+	defaultHook func(context.Context, database.ListActionsOpts) ([]*database.TeamsWebhookAction, error)
+	hooks       []func(context.Context, database.ListActionsOpts) ([]*database.TeamsWebhookAction, error)
+	history     []CodeMonitorStoreListTeamsWebhookActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListTeamsWebhookActions delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) ListTeamsWebhookActions(v0 context.Context, v1 database.ListActionsOpts) ([]*database.TeamsWebhookAction, error) {
+	r0, r1 := m.ListTeamsWebhookActionsFunc.nextHook()(v0, v1)
+	m.ListTeamsWebhookActionsFunc.appendCall(CodeMonitorStoreListTeamsWebhookActionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) SetDefaultHook(hook func(context.Context, database.ListActionsOpts) ([]*database.TeamsWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) PushHook(hook func(context.Context, database.ListActionsOpts) ([]*database.TeamsWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) SetDefaultReturn(r0 []*database.TeamsWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ListActionsOpts) ([]*database.TeamsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) PushReturn(r0 []*database.TeamsWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, database.ListActionsOpts) ([]*database.TeamsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) nextHook() func(context.Context, database.ListActionsOpts) ([]*database.TeamsWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) appendCall(r0 CodeMonitorStoreListTeamsWebhookActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreListTeamsWebhookActionsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) History() []CodeMonitorStoreListTeamsWebhookActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreListTeamsWebhookActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreListTeamsWebhookActionsFuncCall is an object that
+// describes an invocation of method ListTeamsWebhookActions on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreListTeamsWebhookActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.ListActionsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*database.TeamsWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreListTeamsWebhookActionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreListTeamsWebhookActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeMonitorStoreListTemplatedWebhookActionsFunc describes the behavior
+// when the ListTemplatedWebhookActions method of the parent
+// MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreListTemplatedWebhookActionsFunc struct {
+	defaultHook func(context.Context, database.ListActionsOpts) ([]*database.TemplatedWebhookAction, error)
+	hooks       []func(context.Context, database.ListActionsOpts) ([]*database.TemplatedWebhookAction, error)
+	history     []CodeMonitorStoreListTemplatedWebhookActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListTemplatedWebhookActions delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) ListTemplatedWebhookActions(v0 context.Context, v1 database.ListActionsOpts) ([]*database.TemplatedWebhookAction, error) {
+	r0, r1 := m.ListTemplatedWebhookActionsFunc.nextHook()(v0, v1)
+	m.ListTemplatedWebhookActionsFunc.appendCall(CodeMonitorStoreListTemplatedWebhookActionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListTemplatedWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreListTemplatedWebhookActionsFunc) SetDefaultHook(hook func(context.Context, database.ListActionsOpts) ([]*database.TemplatedWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListTemplatedWebhookActions method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreListTemplatedWebhookActionsFunc) PushHook(hook func(context.Context, database.ListActionsOpts) ([]*database.TemplatedWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreListTemplatedWebhookActionsFunc) SetDefaultReturn(r0 []*database.TemplatedWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ListActionsOpts) ([]*database.TemplatedWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreListTemplatedWebhookActionsFunc) PushReturn(r0 []*database.TemplatedWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, database.ListActionsOpts) ([]*database.TemplatedWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreListTemplatedWebhookActionsFunc) nextHook() func(context.Context, database.ListActionsOpts) ([]*database.TemplatedWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreListTemplatedWebhookActionsFunc) appendCall(r0 CodeMonitorStoreListTemplatedWebhookActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreListTemplatedWebhookActionsFuncCall objects describing
+// the invocations of this function.
+func (f *CodeMonitorStoreListTemplatedWebhookActionsFunc) History() []CodeMonitorStoreListTemplatedWebhookActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreListTemplatedWebhookActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreListTemplatedWebhookActionsFuncCall is an object that
+// describes an invocation of method ListTemplatedWebhookActions on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreListTemplatedWebhookActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.ListActionsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*database.TemplatedWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreListTemplatedWebhookActionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreListTemplatedWebhookActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreListWebhookActionsFunc describes the behavior when the
 // ListWebhookActions method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -11788,6 +13728,114 @@ func (c CodeMonitorStoreListWebhookActionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreMarkActionJobSentFunc describes the behavior when the
+// MarkActionJobSent method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreMarkActionJobSentFunc struct {
+	defaultHook func(context.Context, int32) error
+	hooks       []func(context.Context, int32) error
+	history     []CodeMonitorStoreMarkActionJobSentFuncCall
+	mutex       sync.Mutex
+}
+
+// MarkActionJobSent delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) MarkActionJobSent(v0 context.Context, v1 int32) error {
+	r0 := m.MarkActionJobSentFunc.nextHook()(v0, v1)
+	m.MarkActionJobSentFunc.appendCall(CodeMonitorStoreMarkActionJobSentFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the MarkActionJobSent
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreMarkActionJobSentFunc) SetDefaultHook(hook func(context.Context, int32) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// MarkActionJobSent method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreMarkActionJobSentFunc) PushHook(hook func(context.Context, int32) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreMarkActionJobSentFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreMarkActionJobSentFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreMarkActionJobSentFunc) nextHook() func(context.Context, int32) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreMarkActionJobSentFunc) appendCall(r0 CodeMonitorStoreMarkActionJobSentFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreMarkActionJobSentFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreMarkActionJobSentFunc) History() []CodeMonitorStoreMarkActionJobSentFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreMarkActionJobSentFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreMarkActionJobSentFuncCall is an object that describes an
+// invocation of method MarkActionJobSent on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreMarkActionJobSentFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int32
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreMarkActionJobSentFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreMarkActionJobSentFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreNowFunc describes the behavior when the Now method of the
 // parent MockCodeMonitorStore instance is invoked.
 type CodeMonitorStoreNowFunc struct {
@@ -11996,6 +14044,118 @@ func (c CodeMonitorStoreResetQueryTriggerTimestampsFuncCall) Results() []interfa
 	return []interface{}{c.Result0}
 }
 
+// CodeMonitorStoreSetActionJobLogContentsFunc describes the behavior when
+// the SetActionJobLogContents method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreSetActionJobLogContentsFunc struct {
+	defaultHook func(context.Context, int32, string) error
+	hooks       []func(context.Context, int32, string) error
+	history     []CodeMonitorStoreSetActionJobLogContentsFuncCall
+	mutex       sync.Mutex
+}
+
+// SetActionJobLogContents delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) SetActionJobLogContents(v0 context.Context, v1 int32, v2 string) error {
+	r0 := m.SetActionJobLogContentsFunc.nextHook()(v0, v1, v2)
+	m.SetActionJobLogContentsFunc.appendCall(CodeMonitorStoreSetActionJobLogContentsFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// SetActionJobLogContents method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreSetActionJobLogContentsFunc) SetDefaultHook(hook func(context.Context, int32, string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SetActionJobLogContents method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreSetActionJobLogContentsFunc) PushHook(hook func(context.Context, int32, string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreSetActionJobLogContentsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32, string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreSetActionJobLogContentsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32, string) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreSetActionJobLogContentsFunc) nextHook() func(context.Context, int32, string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreSetActionJobLogContentsFunc) appendCall(r0 CodeMonitorStoreSetActionJobLogContentsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreSetActionJobLogContentsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreSetActionJobLogContentsFunc) History() []CodeMonitorStoreSetActionJobLogContentsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreSetActionJobLogContentsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreSetActionJobLogContentsFuncCall is an object that
+// describes an invocation of method SetActionJobLogContents on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreSetActionJobLogContentsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int32
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreSetActionJobLogContentsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreSetActionJobLogContentsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreSetQueryTriggerNextRunFunc describes the behavior when
 // the SetQueryTriggerNextRun method of the parent MockCodeMonitorStore
 // instance is invoked.
@@ -12562,24 +14722,24 @@ func (c CodeMonitorStoreUpdateMonitorEnabledFuncCall) Results() []interface{} {
 // UpdateQueryTrigger method of the parent MockCodeMonitorStore instance is
 // invoked.
 type CodeMonitorStoreUpdateQueryTriggerFunc struct {
-	defaultHook func(context.Context, int64, string) error
-	hooks       []func(context.Context, int64, string) error
+	defaultHook func(context.Context, int64, string, database.QueryTriggerKind) error
+	hooks       []func(context.Context, int64, string, database.QueryTriggerKind) error
 	history     []CodeMonitorStoreUpdateQueryTriggerFuncCall
 	mutex       sync.Mutex
 }
 
 // UpdateQueryTrigger delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) UpdateQueryTrigger(v0 context.Context, v1 int64, v2 string) error {
-	r0 := m.UpdateQueryTriggerFunc.nextHook()(v0, v1, v2)
-	m.UpdateQueryTriggerFunc.appendCall(CodeMonitorStoreUpdateQueryTriggerFuncCall{v0, v1, v2, r0})
+func (m *MockCodeMonitorStore) UpdateQueryTrigger(v0 context.Context, v1 int64, v2 string, v3 database.QueryTriggerKind) error {
+	r0 := m.UpdateQueryTriggerFunc.nextHook()(v0, v1, v2, v3)
+	m.UpdateQueryTriggerFunc.appendCall(CodeMonitorStoreUpdateQueryTriggerFuncCall{v0, v1, v2, v3, r0})
 	return r0
 }
 
 // SetDefaultHook sets function that is called when the UpdateQueryTrigger
 // method of the parent MockCodeMonitorStore instance is invoked and the
 // hook queue is empty.
-func (f *CodeMonitorStoreUpdateQueryTriggerFunc) SetDefaultHook(hook func(context.Context, int64, string) error) {
+func (f *CodeMonitorStoreUpdateQueryTriggerFunc) SetDefaultHook(hook func(context.Context, int64, string, database.QueryTriggerKind) error) {
 	f.defaultHook = hook
 }
 
@@ -12588,7 +14748,7 @@ func (f *CodeMonitorStoreUpdateQueryTriggerFunc) SetDefaultHook(hook func(contex
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *CodeMonitorStoreUpdateQueryTriggerFunc) PushHook(hook func(context.Context, int64, string) error) {
+func (f *CodeMonitorStoreUpdateQueryTriggerFunc) PushHook(hook func(context.Context, int64, string, database.QueryTriggerKind) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -12597,19 +14757,19 @@ func (f *CodeMonitorStoreUpdateQueryTriggerFunc) PushHook(hook func(context.Cont
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
 func (f *CodeMonitorStoreUpdateQueryTriggerFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int64, string) error {
+	f.SetDefaultHook(func(context.Context, int64, string, database.QueryTriggerKind) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
 func (f *CodeMonitorStoreUpdateQueryTriggerFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int64, string) error {
+	f.PushHook(func(context.Context, int64, string, database.QueryTriggerKind) error {
 		return r0
 	})
 }
 
-func (f *CodeMonitorStoreUpdateQueryTriggerFunc) nextHook() func(context.Context, int64, string) error {
+func (f *CodeMonitorStoreUpdateQueryTriggerFunc) nextHook() func(context.Context, int64, string, database.QueryTriggerKind) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -12652,6 +14812,9 @@ type CodeMonitorStoreUpdateQueryTriggerFuncCall struct {
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 database.QueryTriggerKind
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -12660,7 +14823,7 @@ type CodeMonitorStoreUpdateQueryTriggerFuncCall struct {
 // Args returns an interface slice containing the arguments of this
 // invocation.
 func (c CodeMonitorStoreUpdateQueryTriggerFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
@@ -12673,24 +14836,24 @@ func (c CodeMonitorStoreUpdateQueryTriggerFuncCall) Results() []interface{} {
 // the UpdateSlackWebhookAction method of the parent MockCodeMonitorStore
 // instance is invoked.
 type CodeMonitorStoreUpdateSlackWebhookActionFunc struct {
-	defaultHook func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error)
-	hooks       []func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error)
+	defaultHook func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.SlackWebhookAction, error)
+	hooks       []func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.SlackWebhookAction, error)
 	history     []CodeMonitorStoreUpdateSlackWebhookActionFuncCall
 	mutex       sync.Mutex
 }
 
 // UpdateSlackWebhookAction delegates to the next hook function in the queue
 // and stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) UpdateSlackWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string) (*database.SlackWebhookAction, error) {
-	r0, r1 := m.UpdateSlackWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.UpdateSlackWebhookActionFunc.appendCall(CodeMonitorStoreUpdateSlackWebhookActionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+func (m *MockCodeMonitorStore) UpdateSlackWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string, v5 database.ActionDelivery) (*database.SlackWebhookAction, error) {
+	r0, r1 := m.UpdateSlackWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.UpdateSlackWebhookActionFunc.appendCall(CodeMonitorStoreUpdateSlackWebhookActionFuncCall{v0, v1, v2, v3, v4, v5, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
 // UpdateSlackWebhookAction method of the parent MockCodeMonitorStore
 // instance is invoked and the hook queue is empty.
-func (f *CodeMonitorStoreUpdateSlackWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error)) {
+func (f *CodeMonitorStoreUpdateSlackWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.SlackWebhookAction, error)) {
 	f.defaultHook = hook
 }
 
@@ -12699,7 +14862,7 @@ func (f *CodeMonitorStoreUpdateSlackWebhookActionFunc) SetDefaultHook(hook func(
 // instance invokes the hook at the front of the queue and discards it.
 // After the queue is empty, the default hook function is invoked for any
 // future action.
-func (f *CodeMonitorStoreUpdateSlackWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error)) {
+func (f *CodeMonitorStoreUpdateSlackWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.SlackWebhookAction, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -12708,19 +14871,19 @@ func (f *CodeMonitorStoreUpdateSlackWebhookActionFunc) PushHook(hook func(contex
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
 func (f *CodeMonitorStoreUpdateSlackWebhookActionFunc) SetDefaultReturn(r0 *database.SlackWebhookAction, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error) {
+	f.SetDefaultHook(func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.SlackWebhookAction, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
 func (f *CodeMonitorStoreUpdateSlackWebhookActionFunc) PushReturn(r0 *database.SlackWebhookAction, r1 error) {
-	f.PushHook(func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error) {
+	f.PushHook(func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.SlackWebhookAction, error) {
 		return r0, r1
 	})
 }
 
-func (f *CodeMonitorStoreUpdateSlackWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string) (*database.SlackWebhookAction, error) {
+func (f *CodeMonitorStoreUpdateSlackWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.SlackWebhookAction, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -12770,6 +14933,9 @@ type CodeMonitorStoreUpdateSlackWebhookActionFuncCall struct {
 	// Arg4 is the value of the 5th argument passed to this method
 	// invocation.
 	Arg4 string
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 database.ActionDelivery
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 *database.SlackWebhookAction
@@ -12781,7 +14947,7 @@ type CodeMonitorStoreUpdateSlackWebhookActionFuncCall struct {
 // Args returns an interface slice containing the arguments of this
 // invocation.
 func (c CodeMonitorStoreUpdateSlackWebhookActionFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
 }
 
 // Results returns an interface slice containing the results of this
@@ -12790,6 +14956,360 @@ func (c CodeMonitorStoreUpdateSlackWebhookActionFuncCall) Results() []interface{
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreUpdateTeamsWebhookActionFunc describes the behavior when
+// the UpdateTeamsWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreUpdateTeamsWebhookActionFunc struct {
+	defaultHook func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.TeamsWebhookAction, error)
+	hooks       []func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.TeamsWebhookAction, error)
+	history     []CodeMonitorStoreUpdateTeamsWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateTeamsWebhookAction delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpdateTeamsWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string, v5 database.ActionDelivery) (*database.TeamsWebhookAction, error) {
+	r0, r1 := m.UpdateTeamsWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.UpdateTeamsWebhookActionFunc.appendCall(CodeMonitorStoreUpdateTeamsWebhookActionFuncCall{v0, v1, v2, v3, v4, v5, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateTeamsWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.TeamsWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateTeamsWebhookAction method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.TeamsWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) SetDefaultReturn(r0 *database.TeamsWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.TeamsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) PushReturn(r0 *database.TeamsWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.TeamsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.TeamsWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) appendCall(r0 CodeMonitorStoreUpdateTeamsWebhookActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreUpdateTeamsWebhookActionFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) History() []CodeMonitorStoreUpdateTeamsWebhookActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreUpdateTeamsWebhookActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreUpdateTeamsWebhookActionFuncCall is an object that
+// describes an invocation of method UpdateTeamsWebhookAction on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreUpdateTeamsWebhookActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 bool
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 database.ActionDelivery
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.TeamsWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreUpdateTeamsWebhookActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreUpdateTeamsWebhookActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeMonitorStoreUpdateTemplatedWebhookActionFunc describes the behavior
+// when the UpdateTemplatedWebhookAction method of the parent
+// MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreUpdateTemplatedWebhookActionFunc struct {
+	defaultHook func(context.Context, int64, *database.TemplatedWebhookActionArgs) (*database.TemplatedWebhookAction, error)
+	hooks       []func(context.Context, int64, *database.TemplatedWebhookActionArgs) (*database.TemplatedWebhookAction, error)
+	history     []CodeMonitorStoreUpdateTemplatedWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateTemplatedWebhookAction delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpdateTemplatedWebhookAction(v0 context.Context, v1 int64, v2 *database.TemplatedWebhookActionArgs) (*database.TemplatedWebhookAction, error) {
+	r0, r1 := m.UpdateTemplatedWebhookActionFunc.nextHook()(v0, v1, v2)
+	m.UpdateTemplatedWebhookActionFunc.appendCall(CodeMonitorStoreUpdateTemplatedWebhookActionFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateTemplatedWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreUpdateTemplatedWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, *database.TemplatedWebhookActionArgs) (*database.TemplatedWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateTemplatedWebhookAction method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreUpdateTemplatedWebhookActionFunc) PushHook(hook func(context.Context, int64, *database.TemplatedWebhookActionArgs) (*database.TemplatedWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreUpdateTemplatedWebhookActionFunc) SetDefaultReturn(r0 *database.TemplatedWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, *database.TemplatedWebhookActionArgs) (*database.TemplatedWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreUpdateTemplatedWebhookActionFunc) PushReturn(r0 *database.TemplatedWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, int64, *database.TemplatedWebhookActionArgs) (*database.TemplatedWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreUpdateTemplatedWebhookActionFunc) nextHook() func(context.Context, int64, *database.TemplatedWebhookActionArgs) (*database.TemplatedWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreUpdateTemplatedWebhookActionFunc) appendCall(r0 CodeMonitorStoreUpdateTemplatedWebhookActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreUpdateTemplatedWebhookActionFuncCall objects describing
+// the invocations of this function.
+func (f *CodeMonitorStoreUpdateTemplatedWebhookActionFunc) History() []CodeMonitorStoreUpdateTemplatedWebhookActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreUpdateTemplatedWebhookActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreUpdateTemplatedWebhookActionFuncCall is an object that
+// describes an invocation of method UpdateTemplatedWebhookAction on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreUpdateTemplatedWebhookActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 *database.TemplatedWebhookActionArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.TemplatedWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreUpdateTemplatedWebhookActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreUpdateTemplatedWebhookActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc describes the
+// behavior when the UpdateTriggerJobWithContentChanges method of the parent
+// MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc struct {
+	defaultHook func(context.Context, int32, string, *database.ContentChanges) error
+	hooks       []func(context.Context, int32, string, *database.ContentChanges) error
+	history     []CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateTriggerJobWithContentChanges delegates to the next hook function in
+// the queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpdateTriggerJobWithContentChanges(v0 context.Context, v1 int32, v2 string, v3 *database.ContentChanges) error {
+	r0 := m.UpdateTriggerJobWithContentChangesFunc.nextHook()(v0, v1, v2, v3)
+	m.UpdateTriggerJobWithContentChangesFunc.appendCall(CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateTriggerJobWithContentChanges method of the parent
+// MockCodeMonitorStore instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) SetDefaultHook(hook func(context.Context, int32, string, *database.ContentChanges) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateTriggerJobWithContentChanges method of the parent
+// MockCodeMonitorStore instance invokes the hook at the front of the queue
+// and discards it. After the queue is empty, the default hook function is
+// invoked for any future action.
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) PushHook(hook func(context.Context, int32, string, *database.ContentChanges) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32, string, *database.ContentChanges) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32, string, *database.ContentChanges) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) nextHook() func(context.Context, int32, string, *database.ContentChanges) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) appendCall(r0 CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall objects
+// describing the invocations of this function.
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) History() []CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall is an object
+// that describes an invocation of method UpdateTriggerJobWithContentChanges
+// on an instance of MockCodeMonitorStore.
+type CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int32
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 *database.ContentChanges
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreUpdateTriggerJobWithLogsFunc describes the behavior when
 // the UpdateTriggerJobWithLogs method of the parent MockCodeMonitorStore
 // instance is invoked.
@@ -13021,24 +15541,24 @@ func (c CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall) Results() []interfa
 // UpdateWebhookAction method of the parent MockCodeMonitorStore instance is
 // invoked.
 type CodeMonitorStoreUpdateWebhookActionFunc struct {
-	defaultHook func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error)
-	hooks       []func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error)
+	defaultHook func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.WebhookAction, error)
+	hooks       []func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.WebhookAction, error)
 	history     []CodeMonitorStoreUpdateWebhookActionFuncCall
 	mutex       sync.Mutex
 }
 
 // UpdateWebhookAction delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) UpdateWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string) (*database.WebhookAction, error) {
-	r0, r1 := m.UpdateWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.UpdateWebhookActionFunc.appendCall(CodeMonitorStoreUpdateWebhookActionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+func (m *MockCodeMonitorStore) UpdateWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string, v5 database.ActionDelivery) (*database.WebhookAction, error) {
+	r0, r1 := m.UpdateWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.UpdateWebhookActionFunc.appendCall(CodeMonitorStoreUpdateWebhookActionFuncCall{v0, v1, v2, v3, v4, v5, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the UpdateWebhookAction
 // method of the parent MockCodeMonitorStore instance is invoked and the
 // hook queue is empty.
-func (f *CodeMonitorStoreUpdateWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error)) {
+func (f *CodeMonitorStoreUpdateWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.WebhookAction, error)) {
 	f.defaultHook = hook
 }
 
@@ -13047,7 +15567,7 @@ func (f *CodeMonitorStoreUpdateWebhookActionFunc) SetDefaultHook(hook func(conte
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *CodeMonitorStoreUpdateWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error)) {
+func (f *CodeMonitorStoreUpdateWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.WebhookAction, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -13056,19 +15576,19 @@ func (f *CodeMonitorStoreUpdateWebhookActionFunc) PushHook(hook func(context.Con
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
 func (f *CodeMonitorStoreUpdateWebhookActionFunc) SetDefaultReturn(r0 *database.WebhookAction, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error) {
+	f.SetDefaultHook(func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.WebhookAction, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
 func (f *CodeMonitorStoreUpdateWebhookActionFunc) PushReturn(r0 *database.WebhookAction, r1 error) {
-	f.PushHook(func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error) {
+	f.PushHook(func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.WebhookAction, error) {
 		return r0, r1
 	})
 }
 
-func (f *CodeMonitorStoreUpdateWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error) {
+func (f *CodeMonitorStoreUpdateWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string, database.ActionDelivery) (*database.WebhookAction, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -13117,6 +15637,9 @@ type CodeMonitorStoreUpdateWebhookActionFuncCall struct {
 	// Arg4 is the value of the 5th argument passed to this method
 	// invocation.
 	Arg4 string
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 database.ActionDelivery
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 *database.WebhookAction
@@ -13128,7 +15651,7 @@ type CodeMonitorStoreUpdateWebhookActionFuncCall struct {
 // Args returns an interface slice containing the arguments of this
 // invocation.
 func (c CodeMonitorStoreUpdateWebhookActionFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
 }
 
 // Results returns an interface slice containing the results of this
@@ -13137,6 +15660,118 @@ func (c CodeMonitorStoreUpdateWebhookActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreUpsertLastContentMatchesFunc describes the behavior when
+// the UpsertLastContentMatches method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreUpsertLastContentMatchesFunc struct {
+	defaultHook func(context.Context, int64, []database.ContentMatch) error
+	hooks       []func(context.Context, int64, []database.ContentMatch) error
+	history     []CodeMonitorStoreUpsertLastContentMatchesFuncCall
+	mutex       sync.Mutex
+}
+
+// UpsertLastContentMatches delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpsertLastContentMatches(v0 context.Context, v1 int64, v2 []database.ContentMatch) error {
+	r0 := m.UpsertLastContentMatchesFunc.nextHook()(v0, v1, v2)
+	m.UpsertLastContentMatchesFunc.appendCall(CodeMonitorStoreUpsertLastContentMatchesFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpsertLastContentMatches method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreUpsertLastContentMatchesFunc) SetDefaultHook(hook func(context.Context, int64, []database.ContentMatch) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpsertLastContentMatches method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreUpsertLastContentMatchesFunc) PushHook(hook func(context.Context, int64, []database.ContentMatch) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreUpsertLastContentMatchesFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, []database.ContentMatch) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreUpsertLastContentMatchesFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, []database.ContentMatch) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreUpsertLastContentMatchesFunc) nextHook() func(context.Context, int64, []database.ContentMatch) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreUpsertLastContentMatchesFunc) appendCall(r0 CodeMonitorStoreUpsertLastContentMatchesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreUpsertLastContentMatchesFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreUpsertLastContentMatchesFunc) History() []CodeMonitorStoreUpsertLastContentMatchesFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreUpsertLastContentMatchesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreUpsertLastContentMatchesFuncCall is an object that
+// describes an invocation of method UpsertLastContentMatches on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreUpsertLastContentMatchesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []database.ContentMatch
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreUpsertLastContentMatchesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreUpsertLastContentMatchesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreUpsertLastSearchedFunc describes the behavior when the
 // UpsertLastSearched method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -13251,6 +15886,538 @@ func (c CodeMonitorStoreUpsertLastSearchedFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// MockCodeownersLintReportStore is a mock implementation of the
+// CodeownersLintReportStore interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockCodeownersLintReportStore struct {
+	// CountFunc is an instance of a mock function object controlling the
+	// behavior of the method Count.
+	CountFunc *CodeownersLintReportStoreCountFunc
+	// GetForRepoFunc is an instance of a mock function object controlling
+	// the behavior of the method GetForRepo.
+	GetForRepoFunc *CodeownersLintReportStoreGetForRepoFunc
+	// ListFunc is an instance of a mock function object controlling the
+	// behavior of the method List.
+	ListFunc *CodeownersLintReportStoreListFunc
+	// UpsertFunc is an instance of a mock function object controlling the
+	// behavior of the method Upsert.
+	UpsertFunc *CodeownersLintReportStoreUpsertFunc
+}
+
+// NewMockCodeownersLintReportStore creates a new mock of the
+// CodeownersLintReportStore interface. All methods return zero values for
+// all results, unless overwritten.
+func NewMockCodeownersLintReportStore() *MockCodeownersLintReportStore {
+	return &MockCodeownersLintReportStore{
+		CountFunc: &CodeownersLintReportStoreCountFunc{
+			defaultHook: func(context.Context, database.ListCodeownersLintReportsOpts) (r0 int32, r1 error) {
+				return
+			},
+		},
+		GetForRepoFunc: &CodeownersLintReportStoreGetForRepoFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 *database.CodeownersLintReport, r1 error) {
+				return
+			},
+		},
+		ListFunc: &CodeownersLintReportStoreListFunc{
+			defaultHook: func(context.Context, database.ListCodeownersLintReportsOpts) (r0 []*database.CodeownersLintReport, r1 error) {
+				return
+			},
+		},
+		UpsertFunc: &CodeownersLintReportStoreUpsertFunc{
+			defaultHook: func(context.Context, *database.CodeownersLintReport) (r0 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockCodeownersLintReportStore creates a new mock of the
+// CodeownersLintReportStore interface. All methods panic on invocation,
+// unless overwritten.
+func NewStrictMockCodeownersLintReportStore() *MockCodeownersLintReportStore {
+	return &MockCodeownersLintReportStore{
+		CountFunc: &CodeownersLintReportStoreCountFunc{
+			defaultHook: func(context.Context, database.ListCodeownersLintReportsOpts) (int32, error) {
+				panic("unexpected invocation of MockCodeownersLintReportStore.Count")
+			},
+		},
+		GetForRepoFunc: &CodeownersLintReportStoreGetForRepoFunc{
+			defaultHook: func(context.Context, api.RepoID) (*database.CodeownersLintReport, error) {
+				panic("unexpected invocation of MockCodeownersLintReportStore.GetForRepo")
+			},
+		},
+		ListFunc: &CodeownersLintReportStoreListFunc{
+			defaultHook: func(context.Context, database.ListCodeownersLintReportsOpts) ([]*database.CodeownersLintReport, error) {
+				panic("unexpected invocation of MockCodeownersLintReportStore.List")
+			},
+		},
+		UpsertFunc: &CodeownersLintReportStoreUpsertFunc{
+			defaultHook: func(context.Context, *database.CodeownersLintReport) error {
+				panic("unexpected invocation of MockCodeownersLintReportStore.Upsert")
+			},
+		},
+	}
+}
+
+// NewMockCodeownersLintReportStoreFrom creates a new mock of the
+// MockCodeownersLintReportStore interface. All methods delegate to the
+// given implementation, unless overwritten.
+func NewMockCodeownersLintReportStoreFrom(i database.CodeownersLintReportStore) *MockCodeownersLintReportStore {
+	return &MockCodeownersLintReportStore{
+		CountFunc: &CodeownersLintReportStoreCountFunc{
+			defaultHook: i.Count,
+		},
+		GetForRepoFunc: &CodeownersLintReportStoreGetForRepoFunc{
+			defaultHook: i.GetForRepo,
+		},
+		ListFunc: &CodeownersLintReportStoreListFunc{
+			defaultHook: i.List,
+		},
+		UpsertFunc: &CodeownersLintReportStoreUpsertFunc{
+			defaultHook: i.Upsert,
+		},
+	}
+}
+
+// CodeownersLintReportStoreCountFunc describes the behavior when the Count
+// method of the parent MockCodeownersLintReportStore instance is invoked.
+type CodeownersLintReportStoreCountFunc struct {
+	defaultHook func(context.Context, database.ListCodeownersLintReportsOpts) (int32, error)
+	hooks       []func(context.Context, database.ListCodeownersLintReportsOpts) (int32, error)
+	history     []CodeownersLintReportStoreCountFuncCall
+	mutex       sync.Mutex
+}
+
+// Count delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockCodeownersLintReportStore) Count(v0 context.Context, v1 database.ListCodeownersLintReportsOpts) (int32, error) {
+	r0, r1 := m.CountFunc.nextHook()(v0, v1)
+	m.CountFunc.appendCall(CodeownersLintReportStoreCountFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Count method of the
+// parent MockCodeownersLintReportStore instance is invoked and the hook
+// queue is empty.
+func (f *CodeownersLintReportStoreCountFunc) SetDefaultHook(hook func(context.Context, database.ListCodeownersLintReportsOpts) (int32, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Count method of the parent MockCodeownersLintReportStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeownersLintReportStoreCountFunc) PushHook(hook func(context.Context, database.ListCodeownersLintReportsOpts) (int32, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeownersLintReportStoreCountFunc) SetDefaultReturn(r0 int32, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ListCodeownersLintReportsOpts) (int32, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeownersLintReportStoreCountFunc) PushReturn(r0 int32, r1 error) {
+	f.PushHook(func(context.Context, database.ListCodeownersLintReportsOpts) (int32, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeownersLintReportStoreCountFunc) nextHook() func(context.Context, database.ListCodeownersLintReportsOpts) (int32, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeownersLintReportStoreCountFunc) appendCall(r0 CodeownersLintReportStoreCountFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeownersLintReportStoreCountFuncCall
+// objects describing the invocations of this function.
+func (f *CodeownersLintReportStoreCountFunc) History() []CodeownersLintReportStoreCountFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeownersLintReportStoreCountFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeownersLintReportStoreCountFuncCall is an object that describes an
+// invocation of method Count on an instance of
+// MockCodeownersLintReportStore.
+type CodeownersLintReportStoreCountFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.ListCodeownersLintReportsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int32
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeownersLintReportStoreCountFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeownersLintReportStoreCountFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeownersLintReportStoreGetForRepoFunc describes the behavior when the
+// GetForRepo method of the parent MockCodeownersLintReportStore instance is
+// invoked.
+type CodeownersLintReportStoreGetForRepoFunc struct {
+	defaultHook func(context.Context, api.RepoID) (*database.CodeownersLintReport, error)
+	hooks       []func(context.Context, api.RepoID) (*database.CodeownersLintReport, error)
+	history     []CodeownersLintReportStoreGetForRepoFuncCall
+	mutex       sync.Mutex
+}
+
+// GetForRepo delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockCodeownersLintReportStore) GetForRepo(v0 context.Context, v1 api.RepoID) (*database.CodeownersLintReport, error) {
+	r0, r1 := m.GetForRepoFunc.nextHook()(v0, v1)
+	m.GetForRepoFunc.appendCall(CodeownersLintReportStoreGetForRepoFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetForRepo method of
+// the parent MockCodeownersLintReportStore instance is invoked and the hook
+// queue is empty.
+func (f *CodeownersLintReportStoreGetForRepoFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (*database.CodeownersLintReport, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetForRepo method of the parent MockCodeownersLintReportStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeownersLintReportStoreGetForRepoFunc) PushHook(hook func(context.Context, api.RepoID) (*database.CodeownersLintReport, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeownersLintReportStoreGetForRepoFunc) SetDefaultReturn(r0 *database.CodeownersLintReport, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) (*database.CodeownersLintReport, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeownersLintReportStoreGetForRepoFunc) PushReturn(r0 *database.CodeownersLintReport, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID) (*database.CodeownersLintReport, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeownersLintReportStoreGetForRepoFunc) nextHook() func(context.Context, api.RepoID) (*database.CodeownersLintReport, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeownersLintReportStoreGetForRepoFunc) appendCall(r0 CodeownersLintReportStoreGetForRepoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeownersLintReportStoreGetForRepoFuncCall
+// objects describing the invocations of this function.
+func (f *CodeownersLintReportStoreGetForRepoFunc) History() []CodeownersLintReportStoreGetForRepoFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeownersLintReportStoreGetForRepoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeownersLintReportStoreGetForRepoFuncCall is an object that describes
+// an invocation of method GetForRepo on an instance of
+// MockCodeownersLintReportStore.
+type CodeownersLintReportStoreGetForRepoFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.CodeownersLintReport
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeownersLintReportStoreGetForRepoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeownersLintReportStoreGetForRepoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeownersLintReportStoreListFunc describes the behavior when the List
+// method of the parent MockCodeownersLintReportStore instance is invoked.
+type CodeownersLintReportStoreListFunc struct {
+	defaultHook func(context.Context, database.ListCodeownersLintReportsOpts) ([]*database.CodeownersLintReport, error)
+	hooks       []func(context.Context, database.ListCodeownersLintReportsOpts) ([]*database.CodeownersLintReport, error)
+	history     []CodeownersLintReportStoreListFuncCall
+	mutex       sync.Mutex
+}
+
+// List delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockCodeownersLintReportStore) List(v0 context.Context, v1 database.ListCodeownersLintReportsOpts) ([]*database.CodeownersLintReport, error) {
+	r0, r1 := m.ListFunc.nextHook()(v0, v1)
+	m.ListFunc.appendCall(CodeownersLintReportStoreListFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the List method of the
+// parent MockCodeownersLintReportStore instance is invoked and the hook
+// queue is empty.
+func (f *CodeownersLintReportStoreListFunc) SetDefaultHook(hook func(context.Context, database.ListCodeownersLintReportsOpts) ([]*database.CodeownersLintReport, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// List method of the parent MockCodeownersLintReportStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeownersLintReportStoreListFunc) PushHook(hook func(context.Context, database.ListCodeownersLintReportsOpts) ([]*database.CodeownersLintReport, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeownersLintReportStoreListFunc) SetDefaultReturn(r0 []*database.CodeownersLintReport, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ListCodeownersLintReportsOpts) ([]*database.CodeownersLintReport, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeownersLintReportStoreListFunc) PushReturn(r0 []*database.CodeownersLintReport, r1 error) {
+	f.PushHook(func(context.Context, database.ListCodeownersLintReportsOpts) ([]*database.CodeownersLintReport, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeownersLintReportStoreListFunc) nextHook() func(context.Context, database.ListCodeownersLintReportsOpts) ([]*database.CodeownersLintReport, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeownersLintReportStoreListFunc) appendCall(r0 CodeownersLintReportStoreListFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeownersLintReportStoreListFuncCall
+// objects describing the invocations of this function.
+func (f *CodeownersLintReportStoreListFunc) History() []CodeownersLintReportStoreListFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeownersLintReportStoreListFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeownersLintReportStoreListFuncCall is an object that describes an
+// invocation of method List on an instance of
+// MockCodeownersLintReportStore.
+type CodeownersLintReportStoreListFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.ListCodeownersLintReportsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*database.CodeownersLintReport
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeownersLintReportStoreListFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeownersLintReportStoreListFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeownersLintReportStoreUpsertFunc describes the behavior when the
+// Upsert method of the parent MockCodeownersLintReportStore instance is
+// invoked.
+type CodeownersLintReportStoreUpsertFunc struct {
+	defaultHook func(context.Context, *database.CodeownersLintReport) error
+	hooks       []func(context.Context, *database.CodeownersLintReport) error
+	history     []CodeownersLintReportStoreUpsertFuncCall
+	mutex       sync.Mutex
+}
+
+// Upsert delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockCodeownersLintReportStore) Upsert(v0 context.Context, v1 *database.CodeownersLintReport) error {
+	r0 := m.UpsertFunc.nextHook()(v0, v1)
+	m.UpsertFunc.appendCall(CodeownersLintReportStoreUpsertFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Upsert method of the
+// parent MockCodeownersLintReportStore instance is invoked and the hook
+// queue is empty.
+func (f *CodeownersLintReportStoreUpsertFunc) SetDefaultHook(hook func(context.Context, *database.CodeownersLintReport) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Upsert method of the parent MockCodeownersLintReportStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeownersLintReportStoreUpsertFunc) PushHook(hook func(context.Context, *database.CodeownersLintReport) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeownersLintReportStoreUpsertFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, *database.CodeownersLintReport) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeownersLintReportStoreUpsertFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, *database.CodeownersLintReport) error {
+		return r0
+	})
+}
+
+func (f *CodeownersLintReportStoreUpsertFunc) nextHook() func(context.Context, *database.CodeownersLintReport) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeownersLintReportStoreUpsertFunc) appendCall(r0 CodeownersLintReportStoreUpsertFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeownersLintReportStoreUpsertFuncCall
+// objects describing the invocations of this function.
+func (f *CodeownersLintReportStoreUpsertFunc) History() []CodeownersLintReportStoreUpsertFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeownersLintReportStoreUpsertFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeownersLintReportStoreUpsertFuncCall is an object that describes an
+// invocation of method Upsert on an instance of
+// MockCodeownersLintReportStore.
+type CodeownersLintReportStoreUpsertFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *database.CodeownersLintReport
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeownersLintReportStoreUpsertFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeownersLintReportStoreUpsertFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // MockCodeownersStore is a mock implementation of the CodeownersStore
 // interface (from the package
 // github.com/sourcegraph/sourcegraph/internal/database) used for unit
@@ -15186,6 +18353,9 @@ type MockDB struct {
 	// CodeownersFunc is an instance of a mock function object controlling
 	// the behavior of the method Codeowners.
 	CodeownersFunc *DBCodeownersFunc
+	// CodeownersLintReportsFunc is an instance of a mock function object
+	// controlling the behavior of the method CodeownersLintReports.
+	CodeownersLintReportsFunc *DBCodeownersLintReportsFunc
 	// ConfFunc is an instance of a mock function object controlling the
 	// behavior of the method Conf.
 	ConfFunc *DBConfFunc
@@ -15225,6 +18395,9 @@ type MockDB struct {
 	// HandleFunc is an instance of a mock function object controlling the
 	// behavior of the method Handle.
 	HandleFunc *DBHandleFunc
+	// InferredOwnershipFunc is an instance of a mock function object
+	// controlling the behavior of the method InferredOwnership.
+	InferredOwnershipFunc *DBInferredOwnershipFunc
 	// NamespacePermissionsFunc is an instance of a mock function object
 	// controlling the behavior of the method NamespacePermissions.
 	NamespacePermissionsFunc *DBNamespacePermissionsFunc
@@ -15410,6 +18583,11 @@ func NewMockDB() *MockDB {
 				return
 			},
 		},
+		CodeownersLintReportsFunc: &DBCodeownersLintReportsFunc{
+			defaultHook: func() (r0 database.CodeownersLintReportStore) {
+				return
+			},
+		},
 		ConfFunc: &DBConfFunc{
 			defaultHook: func() (r0 database.ConfStore) {
 				return
@@ -15475,6 +18653,11 @@ func NewMockDB() *MockDB {
 				return
 			},
 		},
+		InferredOwnershipFunc: &DBInferredOwnershipFunc{
+			defaultHook: func() (r0 database.InferredOwnershipStore) {
+				return
+			},
+		},
 		NamespacePermissionsFunc: &DBNamespacePermissionsFunc{
 			defaultHook: func() (r0 database.NamespacePermissionStore) {
 				return
@@ -15747,6 +18930,11 @@ func NewStrictMockDB() *MockDB {
 				panic("unexpected invocation of MockDB.Codeowners")
 			},
 		},
+		CodeownersLintReportsFunc: &DBCodeownersLintReportsFunc{
+			defaultHook: func() database.CodeownersLintReportStore {
+				panic("unexpected invocation of MockDB.CodeownersLintReports")
+			},
+		},
 		ConfFunc: &DBConfFunc{
 			defaultHook: func() database.ConfStore {
 				panic("unexpected invocation of MockDB.Conf")
@@ -15812,6 +19000,11 @@ func NewStrictMockDB() *MockDB {
 				panic("unexpected invocation of MockDB.Handle")
 			},
 		},
+		InferredOwnershipFunc: &DBInferredOwnershipFunc{
+			defaultHook: func() database.InferredOwnershipStore {
+				panic("unexpected invocation of MockDB.InferredOwnership")
+			},
+		},
 		NamespacePermissionsFunc: &DBNamespacePermissionsFunc{
 			defaultHook: func() database.NamespacePermissionStore {
 				panic("unexpected invocation of MockDB.NamespacePermissions")
@@ -16066,6 +19259,9 @@ func NewMockDBFrom(i database.DB) *MockDB {
 		CodeownersFunc: &DBCodeownersFunc{
 			defaultHook: i.Codeowners,
 		},
+		CodeownersLintReportsFunc: &DBCodeownersLintReportsFunc{
+			defaultHook: i.CodeownersLintReports,
+		},
 		ConfFunc: &DBConfFunc{
 			defaultHook: i.Conf,
 		},
@@ -16105,6 +19301,9 @@ func NewMockDBFrom(i database.DB) *MockDB {
 		HandleFunc: &DBHandleFunc{
 			defaultHook: i.Handle,
 		},
+		InferredOwnershipFunc: &DBInferredOwnershipFunc{
+			defaultHook: i.InferredOwnership,
+		},
 		NamespacePermissionsFunc: &DBNamespacePermissionsFunc{
 			defaultHook: i.NamespacePermissions,
 		},
@@ -17127,6 +20326,105 @@ func (c DBCodeownersFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBCodeownersLintReportsFunc describes the behavior when the
+// CodeownersLintReports method of the parent MockDB instance is invoked.
+type DBCodeownersLintReportsFunc struct {
+	defaultHook func() database.CodeownersLintReportStore
+	hooks       []func() database.CodeownersLintReportStore
+	history     []DBCodeownersLintReportsFuncCall
+	mutex       sync.Mutex
+}
+
+// CodeownersLintReports delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockDB) CodeownersLintReports() database.CodeownersLintReportStore {
+	r0 := m.CodeownersLintReportsFunc.nextHook()()
+	m.CodeownersLintReportsFunc.appendCall(DBCodeownersLintReportsFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// CodeownersLintReports method of the parent MockDB instance is invoked and
+// the hook queue is empty.
+func (f *DBCodeownersLintReportsFunc) SetDefaultHook(hook func() database.CodeownersLintReportStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CodeownersLintReports method of the parent MockDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *DBCodeownersLintReportsFunc) PushHook(hook func() database.CodeownersLintReportStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *DBCodeownersLintReportsFunc) SetDefaultReturn(r0 database.CodeownersLintReportStore) {
+	f.SetDefaultHook(func() database.CodeownersLintReportStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *DBCodeownersLintReportsFunc) PushReturn(r0 database.CodeownersLintReportStore) {
+	f.PushHook(func() database.CodeownersLintReportStore {
+		return r0
+	})
+}
+
+func (f *DBCodeownersLintReportsFunc) nextHook() func() database.CodeownersLintReportStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBCodeownersLintReportsFunc) appendCall(r0 DBCodeownersLintReportsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBCodeownersLintReportsFuncCall objects
+// describing the invocations of this function.
+func (f *DBCodeownersLintReportsFunc) History() []DBCodeownersLintReportsFuncCall {
+	f.mutex.Lock()
+	history := make([]DBCodeownersLintReportsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBCodeownersLintReportsFuncCall is an object that describes an invocation
+// of method CodeownersLintReports on an instance of MockDB.
+type DBCodeownersLintReportsFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.CodeownersLintReportStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBCodeownersLintReportsFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBCodeownersLintReportsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBConfFunc describes the behavior when the Conf method of the parent
 // MockDB instance is invoked.
 type DBConfFunc struct {
@@ -18428,6 +21726,105 @@ func (c DBHandleFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBInferredOwnershipFunc describes the behavior when the InferredOwnership
+// method of the parent MockDB instance is invoked.
+type DBInferredOwnershipFunc struct {
+	defaultHook func() database.InferredOwnershipStore
+	hooks       []func() database.InferredOwnershipStore
+	history     []DBInferredOwnershipFuncCall
+	mutex       sync.Mutex
+}
+
+// InferredOwnership delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDB) InferredOwnership() database.InferredOwnershipStore {
+	r0 := m.InferredOwnershipFunc.nextHook()()
+	m.InferredOwnershipFunc.appendCall(DBInferredOwnershipFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the InferredOwnership
+// method of the parent MockDB instance is invoked and the hook queue is
+// empty.
+func (f *DBInferredOwnershipFunc) SetDefaultHook(hook func() database.InferredOwnershipStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// InferredOwnership method of the parent MockDB instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBInferredOwnershipFunc) PushHook(hook func() database.InferredOwnershipStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *DBInferredOwnershipFunc) SetDefaultReturn(r0 database.InferredOwnershipStore) {
+	f.SetDefaultHook(func() database.InferredOwnershipStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *DBInferredOwnershipFunc) PushReturn(r0 database.InferredOwnershipStore) {
+	f.PushHook(func() database.InferredOwnershipStore {
+		return r0
+	})
+}
+
+func (f *DBInferredOwnershipFunc) nextHook() func() database.InferredOwnershipStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBInferredOwnershipFunc) appendCall(r0 DBInferredOwnershipFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBInferredOwnershipFuncCall objects
+// describing the invocations of this function.
+func (f *DBInferredOwnershipFunc) History() []DBInferredOwnershipFuncCall {
+	f.mutex.Lock()
+	history := make([]DBInferredOwnershipFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBInferredOwnershipFuncCall is an object that describes an invocation of
+// method InferredOwnership on an instance of MockDB.
+type DBInferredOwnershipFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.InferredOwnershipStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBInferredOwnershipFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBInferredOwnershipFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBNamespacePermissionsFunc describes the behavior when the
 // NamespacePermissions method of the parent MockDB instance is invoked.
 type DBNamespacePermissionsFunc struct {
@@ -39774,6 +43171,292 @@ func (c GlobalStateStoreSiteInitializedFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// MockInferredOwnershipStore is a mock implementation of the
+// InferredOwnershipStore interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockInferredOwnershipStore struct {
+	// ListForRepoFunc is an instance of a mock function object controlling
+	// the behavior of the method ListForRepo.
+	ListForRepoFunc *InferredOwnershipStoreListForRepoFunc
+	// ReplaceForRepoFunc is an instance of a mock function object
+	// controlling the behavior of the method ReplaceForRepo.
+	ReplaceForRepoFunc *InferredOwnershipStoreReplaceForRepoFunc
+}
+
+// NewMockInferredOwnershipStore creates a new mock of the
+// InferredOwnershipStore interface. All methods return zero values for all
+// results, unless overwritten.
+func NewMockInferredOwnershipStore() *MockInferredOwnershipStore {
+	return &MockInferredOwnershipStore{
+		ListForRepoFunc: &InferredOwnershipStoreListForRepoFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 []*database.InferredOwner, r1 error) {
+				return
+			},
+		},
+		ReplaceForRepoFunc: &InferredOwnershipStoreReplaceForRepoFunc{
+			defaultHook: func(context.Context, api.RepoID, []database.InferredOwner) (r0 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockInferredOwnershipStore creates a new mock of the
+// InferredOwnershipStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockInferredOwnershipStore() *MockInferredOwnershipStore {
+	return &MockInferredOwnershipStore{
+		ListForRepoFunc: &InferredOwnershipStoreListForRepoFunc{
+			defaultHook: func(context.Context, api.RepoID) ([]*database.InferredOwner, error) {
+				panic("unexpected invocation of MockInferredOwnershipStore.ListForRepo")
+			},
+		},
+		ReplaceForRepoFunc: &InferredOwnershipStoreReplaceForRepoFunc{
+			defaultHook: func(context.Context, api.RepoID, []database.InferredOwner) error {
+				panic("unexpected invocation of MockInferredOwnershipStore.ReplaceForRepo")
+			},
+		},
+	}
+}
+
+// NewMockInferredOwnershipStoreFrom creates a new mock of the
+// MockInferredOwnershipStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockInferredOwnershipStoreFrom(i database.InferredOwnershipStore) *MockInferredOwnershipStore {
+	return &MockInferredOwnershipStore{
+		ListForRepoFunc: &InferredOwnershipStoreListForRepoFunc{
+			defaultHook: i.ListForRepo,
+		},
+		ReplaceForRepoFunc: &InferredOwnershipStoreReplaceForRepoFunc{
+			defaultHook: i.ReplaceForRepo,
+		},
+	}
+}
+
+// InferredOwnershipStoreListForRepoFunc describes the behavior when the
+// ListForRepo method of the parent MockInferredOwnershipStore instance is
+// invoked.
+type InferredOwnershipStoreListForRepoFunc struct {
+	defaultHook func(context.Context, api.RepoID) ([]*database.InferredOwner, error)
+	hooks       []func(context.Context, api.RepoID) ([]*database.InferredOwner, error)
+	history     []InferredOwnershipStoreListForRepoFuncCall
+	mutex       sync.Mutex
+}
+
+// ListForRepo delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockInferredOwnershipStore) ListForRepo(v0 context.Context, v1 api.RepoID) ([]*database.InferredOwner, error) {
+	r0, r1 := m.ListForRepoFunc.nextHook()(v0, v1)
+	m.ListForRepoFunc.appendCall(InferredOwnershipStoreListForRepoFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListForRepo method
+// of the parent MockInferredOwnershipStore instance is invoked and the hook
+// queue is empty.
+func (f *InferredOwnershipStoreListForRepoFunc) SetDefaultHook(hook func(context.Context, api.RepoID) ([]*database.InferredOwner, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListForRepo method of the parent MockInferredOwnershipStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *InferredOwnershipStoreListForRepoFunc) PushHook(hook func(context.Context, api.RepoID) ([]*database.InferredOwner, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *InferredOwnershipStoreListForRepoFunc) SetDefaultReturn(r0 []*database.InferredOwner, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) ([]*database.InferredOwner, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *InferredOwnershipStoreListForRepoFunc) PushReturn(r0 []*database.InferredOwner, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID) ([]*database.InferredOwner, error) {
+		return r0, r1
+	})
+}
+
+func (f *InferredOwnershipStoreListForRepoFunc) nextHook() func(context.Context, api.RepoID) ([]*database.InferredOwner, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *InferredOwnershipStoreListForRepoFunc) appendCall(r0 InferredOwnershipStoreListForRepoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of InferredOwnershipStoreListForRepoFuncCall
+// objects describing the invocations of this function.
+func (f *InferredOwnershipStoreListForRepoFunc) History() []InferredOwnershipStoreListForRepoFuncCall {
+	f.mutex.Lock()
+	history := make([]InferredOwnershipStoreListForRepoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// InferredOwnershipStoreListForRepoFuncCall is an object that describes an
+// invocation of method ListForRepo on an instance of
+// MockInferredOwnershipStore.
+type InferredOwnershipStoreListForRepoFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*database.InferredOwner
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c InferredOwnershipStoreListForRepoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c InferredOwnershipStoreListForRepoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// InferredOwnershipStoreReplaceForRepoFunc describes the behavior when the
+// ReplaceForRepo method of the parent MockInferredOwnershipStore instance
+// is invoked.
+type InferredOwnershipStoreReplaceForRepoFunc struct {
+	defaultHook func(context.Context, api.RepoID, []database.InferredOwner) error
+	hooks       []func(context.Context, api.RepoID, []database.InferredOwner) error
+	history     []InferredOwnershipStoreReplaceForRepoFuncCall
+	mutex       sync.Mutex
+}
+
+// ReplaceForRepo delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockInferredOwnershipStore) ReplaceForRepo(v0 context.Context, v1 api.RepoID, v2 []database.InferredOwner) error {
+	r0 := m.ReplaceForRepoFunc.nextHook()(v0, v1, v2)
+	m.ReplaceForRepoFunc.appendCall(InferredOwnershipStoreReplaceForRepoFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the ReplaceForRepo
+// method of the parent MockInferredOwnershipStore instance is invoked and
+// the hook queue is empty.
+func (f *InferredOwnershipStoreReplaceForRepoFunc) SetDefaultHook(hook func(context.Context, api.RepoID, []database.InferredOwner) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReplaceForRepo method of the parent MockInferredOwnershipStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *InferredOwnershipStoreReplaceForRepoFunc) PushHook(hook func(context.Context, api.RepoID, []database.InferredOwner) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *InferredOwnershipStoreReplaceForRepoFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, []database.InferredOwner) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *InferredOwnershipStoreReplaceForRepoFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoID, []database.InferredOwner) error {
+		return r0
+	})
+}
+
+func (f *InferredOwnershipStoreReplaceForRepoFunc) nextHook() func(context.Context, api.RepoID, []database.InferredOwner) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *InferredOwnershipStoreReplaceForRepoFunc) appendCall(r0 InferredOwnershipStoreReplaceForRepoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// InferredOwnershipStoreReplaceForRepoFuncCall objects describing the
+// invocations of this function.
+func (f *InferredOwnershipStoreReplaceForRepoFunc) History() []InferredOwnershipStoreReplaceForRepoFuncCall {
+	f.mutex.Lock()
+	history := make([]InferredOwnershipStoreReplaceForRepoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// InferredOwnershipStoreReplaceForRepoFuncCall is an object that describes
+// an invocation of method ReplaceForRepo on an instance of
+// MockInferredOwnershipStore.
+type InferredOwnershipStoreReplaceForRepoFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []database.InferredOwner
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c InferredOwnershipStoreReplaceForRepoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c InferredOwnershipStoreReplaceForRepoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // MockNamespaceStore is a mock implementation of the NamespaceStore
 // interface (from the package
 // github.com/sourcegraph/sourcegraph/internal/database) used for unit
@@ -0,0 +1,134 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// InferredOwnershipStore stores owners of directories which are inferred from
+// the commit history of repositories, rather than declared in CODEOWNERS files
+// or assigned within Sourcegraph.
+type InferredOwnershipStore interface {
+	// ReplaceForRepo replaces all inferred owners of the given repository.
+	ReplaceForRepo(ctx context.Context, repoID api.RepoID, owners []InferredOwner) error
+	// ListForRepo returns the inferred owners of all directories of the given
+	// repository, ordered by path and then by descending confidence.
+	ListForRepo(ctx context.Context, repoID api.RepoID) ([]*InferredOwner, error)
+}
+
+// InferredOwner is an owner of a directory inferred from commit history. It is
+// either a commit author, in which case AuthorEmail is set, or a team, in which
+// case TeamID is set.
+type InferredOwner struct {
+	// FilePath is the directory the owner was inferred for. The empty path is
+	// the repository root.
+	FilePath    string
+	AuthorName  string
+	AuthorEmail string
+	TeamID      int32
+	// Score is the sum of the time-decayed contributions of the owner to files
+	// within the directory.
+	Score float64
+	// Confidence is between 0 and 1, and grows with the share of the owner in
+	// all contributions to the directory.
+	Confidence float64
+	UpdatedAt  time.Time
+}
+
+func InferredOwnershipStoreWith(other basestore.ShareableStore) InferredOwnershipStore {
+	return &inferredOwnershipStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+type inferredOwnershipStore struct {
+	*basestore.Store
+}
+
+const deleteInferredOwnershipFmtstr = `
+	DELETE FROM own_inferred_ownership
+	WHERE file_path_id IN (SELECT id FROM repo_paths WHERE repo_id = %s)
+`
+
+const insertInferredOwnershipFmtstr = `
+	INSERT INTO own_inferred_ownership (file_path_id, commit_author_id, team_id, score, confidence, updated_at)
+	VALUES (%s, %s, %s, %s, %s, %s)
+`
+
+func (s *inferredOwnershipStore) ReplaceForRepo(ctx context.Context, repoID api.RepoID, owners []InferredOwner) error {
+	return s.WithTransact(ctx, func(tx *basestore.Store) error {
+		if err := tx.Exec(ctx, sqlf.Sprintf(deleteInferredOwnershipFmtstr, repoID)); err != nil {
+			return errors.Wrap(err, "deleting inferred owners")
+		}
+		if len(owners) == 0 {
+			return nil
+		}
+
+		paths := make([]string, 0, len(owners))
+		for _, o := range owners {
+			paths = append(paths, o.FilePath)
+		}
+		pathIDs, err := ensureRepoPaths(ctx, tx, paths, repoID)
+		if err != nil {
+			return errors.Wrap(err, "cannot insert repo paths")
+		}
+
+		contributions := &recentContributionSignalStore{Store: tx}
+		authorIDs := map[[2]string]int{}
+		now := time.Now()
+		for i, o := range owners {
+			var authorID, teamID *int32
+			if o.TeamID != 0 {
+				teamID = &o.TeamID
+			} else {
+				key := [2]string{o.AuthorName, o.AuthorEmail}
+				id, ok := authorIDs[key]
+				if !ok {
+					if id, err = contributions.ensureAuthor(ctx, Commit{AuthorName: o.AuthorName, AuthorEmail: o.AuthorEmail}); err != nil {
+						return errors.Wrap(err, "cannot insert commit author")
+					}
+					authorIDs[key] = id
+				}
+				authorID32 := int32(id)
+				authorID = &authorID32
+			}
+			q := sqlf.Sprintf(insertInferredOwnershipFmtstr, pathIDs[i], authorID, teamID, o.Score, o.Confidence, now)
+			if err := tx.Exec(ctx, q); err != nil {
+				return errors.Wrap(err, "inserting inferred owner")
+			}
+		}
+		return nil
+	})
+}
+
+const listInferredOwnershipFmtstr = `
+	SELECT p.absolute_path, COALESCE(a.name, ''), COALESCE(a.email, ''), o.team_id, o.score, o.confidence, o.updated_at
+	FROM own_inferred_ownership AS o
+	INNER JOIN repo_paths AS p ON p.id = o.file_path_id
+	LEFT JOIN commit_authors AS a ON a.id = o.commit_author_id
+	WHERE p.repo_id = %s
+	ORDER BY p.absolute_path, o.confidence DESC, o.id
+`
+
+func (s *inferredOwnershipStore) ListForRepo(ctx context.Context, repoID api.RepoID) ([]*InferredOwner, error) {
+	return scanInferredOwners(s.Query(ctx, sqlf.Sprintf(listInferredOwnershipFmtstr, repoID)))
+}
+
+var scanInferredOwners = basestore.NewSliceScanner(func(scanner dbutil.Scanner) (*InferredOwner, error) {
+	var o InferredOwner
+	err := scanner.Scan(
+		&o.FilePath,
+		&o.AuthorName,
+		&o.AuthorEmail,
+		&dbutil.NullInt32{N: &o.TeamID},
+		&o.Score,
+		&o.Confidence,
+		&o.UpdatedAt,
+	)
+	return &o, err
+})
@@ -0,0 +1,85 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestInferredOwnershipStore_ReplaceForRepo(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	t.Parallel()
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(t))
+	ctx := context.Background()
+
+	team := createTeam(t, ctx, db, teamName)
+	err := db.Repos().Create(ctx, &types.Repo{ID: 1, Name: "github.com/sourcegraph/sourcegraph"})
+	require.NoError(t, err)
+	err = db.Repos().Create(ctx, &types.Repo{ID: 2, Name: "github.com/sourcegraph/sourcegraph2"})
+	require.NoError(t, err)
+
+	store := db.InferredOwnership()
+
+	// Getting inferred owners for a repo without owners.
+	owners, err := store.ListForRepo(ctx, 1)
+	require.NoError(t, err)
+	assert.Empty(t, owners)
+
+	err = store.ReplaceForRepo(ctx, 1, []InferredOwner{
+		{FilePath: "", AuthorName: "alice", AuthorEmail: "alice@example.com", Score: 2, Confidence: 0.6},
+		{FilePath: "src", AuthorName: "bob", AuthorEmail: "bob@example.com", Score: 0.5, Confidence: 0.4},
+		{FilePath: "src", AuthorName: "alice", AuthorEmail: "alice@example.com", Score: 1, Confidence: 0.5},
+		{FilePath: "src", TeamID: team.ID, Score: 1.5, Confidence: 0.7},
+	})
+	require.NoError(t, err)
+	err = store.ReplaceForRepo(ctx, 2, []InferredOwner{
+		{FilePath: "lib", AuthorName: "bob", AuthorEmail: "bob@example.com", Score: 1, Confidence: 0.5},
+	})
+	require.NoError(t, err)
+
+	owners, err = store.ListForRepo(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, owners, 4)
+	// All owners of a repo are replaced at once, so they share a timestamp.
+	updatedAt := owners[0].UpdatedAt
+	assert.NotZero(t, updatedAt)
+	assert.Equal(t, []*InferredOwner{
+		{FilePath: "", AuthorName: "alice", AuthorEmail: "alice@example.com", Score: 2, Confidence: 0.6, UpdatedAt: updatedAt},
+		{FilePath: "src", TeamID: team.ID, Score: 1.5, Confidence: 0.7, UpdatedAt: updatedAt},
+		{FilePath: "src", AuthorName: "alice", AuthorEmail: "alice@example.com", Score: 1, Confidence: 0.5, UpdatedAt: updatedAt},
+		{FilePath: "src", AuthorName: "bob", AuthorEmail: "bob@example.com", Score: 0.5, Confidence: 0.4, UpdatedAt: updatedAt},
+	}, owners)
+
+	// Replacing owners of a repo removes all previous owners of the repo, but
+	// leaves other repos alone.
+	err = store.ReplaceForRepo(ctx, 1, []InferredOwner{
+		{FilePath: "src/abc", AuthorName: "bob", AuthorEmail: "bob@example.com", Score: 3, Confidence: 0.9},
+	})
+	require.NoError(t, err)
+	owners, err = store.ListForRepo(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, owners, 1)
+	assert.Equal(t, "src/abc", owners[0].FilePath)
+	assert.Equal(t, "bob@example.com", owners[0].AuthorEmail)
+
+	owners, err = store.ListForRepo(ctx, 2)
+	require.NoError(t, err)
+	assert.Len(t, owners, 1)
+
+	// Replacing with no owners clears the repo.
+	err = store.ReplaceForRepo(ctx, 1, nil)
+	require.NoError(t, err)
+	owners, err = store.ListForRepo(ctx, 1)
+	require.NoError(t, err)
+	assert.Empty(t, owners)
+}
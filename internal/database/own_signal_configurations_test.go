@@ -37,6 +37,16 @@ func Test_LoadConfigurations(t *testing.T) {
 			Name:        "analytics",
 			Description: "Indexes ownership data to present in aggregated views like Admin > Analytics > Own and Repo > Ownership",
 		},
+		{
+			ID:          4,
+			Name:        "inferred-ownership",
+			Description: "Indexes owners of each directory inferred from repository history and blame, used by ownership search when files have no other owners.",
+		},
+		{
+			ID:          5,
+			Name:        "codeowners-lint",
+			Description: "Lints the CODEOWNERS file of each repository and reports its coverage, dead rules, unknown owners and unowned paths.",
+		},
 	}).Equal(t, configurations)
 
 	t.Run("load by name", func(t *testing.T) {
@@ -85,6 +95,16 @@ func Test_LoadConfigurations(t *testing.T) {
 				Name:        "analytics",
 				Description: "Indexes ownership data to present in aggregated views like Admin > Analytics > Own and Repo > Ownership",
 			},
+			{
+				ID:          4,
+				Name:        "inferred-ownership",
+				Description: "Indexes owners of each directory inferred from repository history and blame, used by ownership search when files have no other owners.",
+			},
+			{
+				ID:          5,
+				Name:        "codeowners-lint",
+				Description: "Lints the CODEOWNERS file of each repository and reports its coverage, dead rules, unknown owners and unowned paths.",
+			},
 		}).Equal(t, configurations)
 	})
 }
@@ -280,6 +280,20 @@ type DockerStep struct {
 
 	// Env specifies a set of NAME=value pairs to supply to the docker command.
 	Env []string `json:"env"`
+
+	// Builtin is set for the steps of builtin batch spec steps, whose Commands
+	// invoke batcheshelper. Runtimes with access to the workspace on the host
+	// run them in-process instead of in Image.
+	Builtin *BuiltinStep `json:"builtin,omitempty"`
+}
+
+// BuiltinStep describes the batcheshelper invocation of a DockerStep.
+type BuiltinStep struct {
+	// Mode is the batcheshelper mode: pre, builtin or post.
+	Mode string `json:"mode"`
+
+	// Step is the index of the batch spec step.
+	Step int `json:"step"`
 }
 
 // CliStep is a step that runs a src-cli command.
@@ -46,6 +46,12 @@ func TestJob_MarshalJSON(t *testing.T) {
 						Dir:      "faz/baz",
 						Env:      []string{"FOO=BAR"},
 					},
+					{
+						Image:    "batcheshelper",
+						Commands: []string{"batcheshelper builtin 0"},
+						Dir:      ".",
+						Builtin:  &BuiltinStep{Mode: "builtin", Step: 0},
+					},
 				},
 				CliSteps: []CliStep{
 					{
@@ -85,6 +91,12 @@ func TestJob_MarshalJSON(t *testing.T) {
 			"commands": ["run"],
 			"dir": "faz/baz",
 			"env": ["FOO=BAR"]
+		}, {
+			"image": "batcheshelper",
+			"commands": ["batcheshelper builtin 0"],
+			"dir": ".",
+			"env": null,
+			"builtin": {"mode": "builtin", "step": 0}
 		}],
 		"cliSteps": [{
 			"command": ["x", "y", "z"],
@@ -215,6 +227,11 @@ func TestJob_UnmarshalJSON(t *testing.T) {
 		"commands": ["run"],
 		"dir": "faz/baz",
 		"env": ["FOO=BAR"]
+	}, {
+		"image": "batcheshelper",
+		"commands": ["batcheshelper builtin 0"],
+		"dir": ".",
+		"builtin": {"mode": "builtin", "step": 0}
 	}],
 	"cliSteps": [{
 		"command": ["x", "y", "z"],
@@ -251,6 +268,12 @@ func TestJob_UnmarshalJSON(t *testing.T) {
 						Dir:      "faz/baz",
 						Env:      []string{"FOO=BAR"},
 					},
+					{
+						Image:    "batcheshelper",
+						Commands: []string{"batcheshelper builtin 0"},
+						Dir:      ".",
+						Builtin:  &BuiltinStep{Mode: "builtin", Step: 0},
+					},
 				},
 				CliSteps: []CliStep{
 					{
@@ -0,0 +1,208 @@
+//nolint:bodyclose // Body is closed in Client.Do, but the response is still returned to provide access to the headers
+package gitea
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// defaultPageSize is the number of items requested per page. Gitea caps
+// the page size to MAX_RESPONSE_ITEMS, which defaults to 50.
+const defaultPageSize = 50
+
+// Client access a Gitea (or Forgejo) instance via the REST API.
+type client struct {
+	// HTTP Client used to communicate with the API
+	httpClient httpcli.Doer
+
+	// URL is the base URL of Gitea.
+	URL *url.URL
+
+	// RateLimit is the self-imposed rate limiter (since Gitea does not have a concept
+	// of rate limiting in HTTP response headers).
+	rateLimit *ratelimit.InstrumentedLimiter
+
+	// Authenticator used to authenticate HTTP requests.
+	auther auth.Authenticator
+
+	// sudo is the username requests are impersonating, if any. It requires
+	// the authenticator to belong to a site admin.
+	sudo string
+}
+
+type Client interface {
+	GetURL() *url.URL
+	WithAuthenticator(a auth.Authenticator) (Client, error)
+	Authenticator() auth.Authenticator
+	// WithSudo returns a copy of the client that performs all requests on
+	// behalf of the given user. The client's token must belong to a site admin.
+	WithSudo(username string) Client
+
+	GetAuthenticatedUser(ctx context.Context) (*User, error)
+	GetUser(ctx context.Context, username string) (*User, error)
+
+	GetRepo(ctx context.Context, owner, name string) (*Repository, error)
+	ListOrgRepos(ctx context.Context, org string, page int) (repos []*Repository, nextPage bool, err error)
+	ListUserRepos(ctx context.Context, username string, page int) (repos []*Repository, nextPage bool, err error)
+	ListAuthenticatedUserRepos(ctx context.Context, page int) (repos []*Repository, nextPage bool, err error)
+	ForkRepo(ctx context.Context, owner, name string, input ForkRepoInput) (*Repository, error)
+
+	GetPullRequest(ctx context.Context, owner, name string, index int64) (*PullRequest, error)
+	GetPullRequestByBaseHead(ctx context.Context, owner, name, base, head string) (*PullRequest, error)
+	CreatePullRequest(ctx context.Context, owner, name string, input CreatePullRequestInput) (*PullRequest, error)
+	EditPullRequest(ctx context.Context, owner, name string, index int64, input EditPullRequestInput) (*PullRequest, error)
+	MergePullRequest(ctx context.Context, owner, name string, index int64, input MergePullRequestInput) error
+	ListPullRequestReviews(ctx context.Context, owner, name string, index int64) ([]*PullReview, error)
+	CreateIssueComment(ctx context.Context, owner, name string, index int64, body string) error
+	GetCombinedStatus(ctx context.Context, owner, name, ref string) (*CombinedStatus, error)
+}
+
+// NewClient returns an authenticated Gitea API client with the provided
+// configuration. If a nil httpClient is provided, httpcli.ExternalDoer will be
+// used.
+func NewClient(urn string, url *url.URL, auther auth.Authenticator, httpClient httpcli.Doer) (Client, error) {
+	if httpClient == nil {
+		httpClient = httpcli.ExternalDoer
+	}
+
+	return &client{
+		httpClient: httpClient,
+		URL:        url,
+		rateLimit:  ratelimit.NewInstrumentedLimiter(urn, ratelimit.NewGlobalRateLimiter(log.Scoped("GiteaClient"), urn)),
+		auther:     auther,
+	}, nil
+}
+
+func (c *client) WithAuthenticator(a auth.Authenticator) (Client, error) {
+	switch a.(type) {
+	case *auth.OAuthBearerToken, *auth.OAuthBearerTokenWithSSH, *auth.BasicAuth, *auth.BasicAuthWithSSH:
+		break
+	default:
+		return nil, errors.Errorf("authenticator type unsupported for Gitea clients: %s", a)
+	}
+
+	return &client{
+		httpClient: c.httpClient,
+		URL:        c.URL,
+		rateLimit:  c.rateLimit,
+		auther:     a,
+		sudo:       c.sudo,
+	}, nil
+}
+
+func (c *client) WithSudo(username string) Client {
+	cc := *c
+	cc.sudo = username
+	return &cc
+}
+
+func (c *client) Authenticator() auth.Authenticator {
+	return c.auther
+}
+
+func (c *client) GetURL() *url.URL {
+	return c.URL
+}
+
+func (c *client) get(ctx context.Context, path string, qs url.Values, result any) (*http.Response, error) {
+	u := url.URL{Path: path}
+	if len(qs) > 0 {
+		u.RawQuery = qs.Encode()
+	}
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(ctx, req, result)
+}
+
+func (c *client) send(ctx context.Context, method, path string, payload, result any) (*http.Response, error) {
+	bs, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling payload")
+	}
+	req, err := http.NewRequest(method, (&url.URL{Path: path}).String(), bytes.NewReader(bs))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return c.do(ctx, req, result)
+}
+
+func (c *client) do(ctx context.Context, req *http.Request, result any) (*http.Response, error) {
+	resp, err := c.doHTTP(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if result == nil {
+		return resp, nil
+	}
+
+	bs, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return resp, json.Unmarshal(bs, result)
+}
+
+func (c *client) doHTTP(ctx context.Context, req *http.Request) (*http.Response, error) {
+	req.URL = c.URL.ResolveReference(req.URL)
+	req.Header.Set("Accept", "application/json")
+
+	// Authenticate request with auther
+	if c.auther != nil {
+		if err := c.auther.Authenticate(req); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.sudo != "" {
+		req.Header.Set("Sudo", c.sudo)
+	}
+
+	if err := c.rateLimit.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		// Read the body best effort.
+		bs, _ := io.ReadAll(resp.Body)
+		return nil, &httpError{
+			URL:        req.URL,
+			StatusCode: resp.StatusCode,
+			Body:       bs,
+		}
+	}
+
+	return resp, nil
+}
+
+func pageParams(page int) url.Values {
+	if page < 1 {
+		page = 1
+	}
+	return url.Values{
+		"page":  []string{strconv.Itoa(page)},
+		"limit": []string{strconv.Itoa(defaultPageSize)},
+	}
+}
@@ -0,0 +1,127 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// wipPrefixes are the title prefixes Gitea treats as marking a pull request as
+// work in progress by default (see repository.pull_request.WORK_IN_PROGRESS_PREFIXES).
+var wipPrefixes = []string{"WIP:", "[WIP]"}
+
+// IsDraft returns true if the pull request is a work in progress, either
+// because the code host reports it as a draft or because its title carries a
+// work in progress prefix.
+func (pr *PullRequest) IsDraft() bool {
+	if pr.Draft {
+		return true
+	}
+	for _, prefix := range wipPrefixes {
+		if strings.HasPrefix(strings.ToUpper(pr.Title), prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetWIP ensures a "WIP: " prefix on the given title.
+func SetWIP(title string) string {
+	return "WIP: " + UnsetWIP(title)
+}
+
+// UnsetWIP removes any work in progress prefix from the given title.
+func UnsetWIP(title string) string {
+	for _, prefix := range wipPrefixes {
+		if strings.HasPrefix(strings.ToUpper(title), prefix) {
+			return strings.TrimSpace(title[len(prefix):])
+		}
+	}
+	return title
+}
+
+// GetPullRequest returns the pull request with the given index.
+func (c *client) GetPullRequest(ctx context.Context, owner, name string, index int64) (*PullRequest, error) {
+	var pr PullRequest
+	if _, err := c.get(ctx, pullPath(owner, name, index), nil, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// GetPullRequestByBaseHead returns the pull request merging head into base.
+func (c *client) GetPullRequestByBaseHead(ctx context.Context, owner, name, base, head string) (*PullRequest, error) {
+	var pr PullRequest
+	path := fmt.Sprintf("%s/pulls/%s/%s", repoPath(owner, name), url.PathEscape(base), url.PathEscape(head))
+	if _, err := c.get(ctx, path, nil, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// CreatePullRequest opens a new pull request. A conflict error is returned
+// if a pull request for the same head and base already exists; see
+// IsConflict.
+func (c *client) CreatePullRequest(ctx context.Context, owner, name string, input CreatePullRequestInput) (*PullRequest, error) {
+	var pr PullRequest
+	if _, err := c.send(ctx, http.MethodPost, repoPath(owner, name)+"/pulls", input, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// EditPullRequest updates the given pull request. Empty fields in input are
+// left unchanged.
+func (c *client) EditPullRequest(ctx context.Context, owner, name string, index int64, input EditPullRequestInput) (*PullRequest, error) {
+	var pr PullRequest
+	if _, err := c.send(ctx, http.MethodPatch, pullPath(owner, name, index), input, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// MergePullRequest merges the given pull request.
+func (c *client) MergePullRequest(ctx context.Context, owner, name string, index int64, input MergePullRequestInput) error {
+	_, err := c.send(ctx, http.MethodPost, pullPath(owner, name, index)+"/merge", input, nil)
+	return err
+}
+
+// ListPullRequestReviews returns the reviews submitted on the given pull
+// request.
+func (c *client) ListPullRequestReviews(ctx context.Context, owner, name string, index int64) ([]*PullReview, error) {
+	var all []*PullReview
+	for page := 1; ; page++ {
+		var reviews []*PullReview
+		if _, err := c.get(ctx, pullPath(owner, name, index)+"/reviews", pageParams(page), &reviews); err != nil {
+			return nil, err
+		}
+		all = append(all, reviews...)
+		if len(reviews) < defaultPageSize {
+			return all, nil
+		}
+	}
+}
+
+// CreateIssueComment posts a comment on the issue or pull request with the
+// given index.
+func (c *client) CreateIssueComment(ctx context.Context, owner, name string, index int64, body string) error {
+	path := fmt.Sprintf("%s/issues/%d/comments", repoPath(owner, name), index)
+	_, err := c.send(ctx, http.MethodPost, path, map[string]string{"body": body}, nil)
+	return err
+}
+
+// GetCombinedStatus returns the combined commit status of ref.
+func (c *client) GetCombinedStatus(ctx context.Context, owner, name, ref string) (*CombinedStatus, error) {
+	var status CombinedStatus
+	path := fmt.Sprintf("%s/commits/%s/status", repoPath(owner, name), url.PathEscape(ref))
+	if _, err := c.get(ctx, path, nil, &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+func pullPath(owner, name string, index int64) string {
+	return fmt.Sprintf("%s/pulls/%d", repoPath(owner, name), index)
+}
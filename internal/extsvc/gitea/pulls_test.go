@@ -0,0 +1,95 @@
+package gitea
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/testutil"
+)
+
+func TestClient_GetPullRequest(t *testing.T) {
+	cli, save := NewTestClient(t, "GetPullRequest", *update)
+	defer save()
+
+	pr, err := cli.GetPullRequest(context.Background(), "sourcegraph", "src-cli", 7)
+	require.NoError(t, err)
+
+	testutil.AssertGolden(t, "testdata/golden/GetPullRequest.json", *update, pr)
+}
+
+func TestClient_CreatePullRequest(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		cli, save := NewTestClient(t, "CreatePullRequest", *update)
+		defer save()
+
+		pr, err := cli.CreatePullRequest(ctx, "sourcegraph", "src-cli", CreatePullRequestInput{
+			Head:  "batch/readme",
+			Base:  "main",
+			Title: "Update README",
+			Body:  "Created by Sourcegraph batch change `alice/readme`.",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), pr.Index)
+		assert.Equal(t, "batch/readme", pr.Head.Ref)
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		cli, save := NewTestClient(t, "CreatePullRequestConflict", *update)
+		defer save()
+
+		_, err := cli.CreatePullRequest(ctx, "sourcegraph", "src-cli", CreatePullRequestInput{
+			Head:  "batch/readme",
+			Base:  "main",
+			Title: "Update README",
+		})
+		require.Error(t, err)
+		assert.True(t, IsConflict(err))
+	})
+}
+
+func TestClient_ListPullRequestReviews(t *testing.T) {
+	cli, save := NewTestClient(t, "ListPullRequestReviews", *update)
+	defer save()
+
+	reviews, err := cli.ListPullRequestReviews(context.Background(), "sourcegraph", "src-cli", 7)
+	require.NoError(t, err)
+
+	testutil.AssertGolden(t, "testdata/golden/ListPullRequestReviews.json", *update, reviews)
+}
+
+func TestClient_GetCombinedStatus(t *testing.T) {
+	cli, save := NewTestClient(t, "GetCombinedStatus", *update)
+	defer save()
+
+	status, err := cli.GetCombinedStatus(context.Background(), "sourcegraph", "src-cli", "9f0b1e5e1d4c2b9a1e16a4e1b6a5f2c0e3d7a8b1")
+	require.NoError(t, err)
+
+	testutil.AssertGolden(t, "testdata/golden/GetCombinedStatus.json", *update, status)
+}
+
+func TestWIP(t *testing.T) {
+	for _, tc := range []struct {
+		title     string
+		draft     bool
+		wantDraft bool
+		wantTitle string
+	}{
+		{title: "Update README", wantTitle: "Update README"},
+		{title: "WIP: Update README", wantDraft: true, wantTitle: "Update README"},
+		{title: "wip: Update README", wantDraft: true, wantTitle: "Update README"},
+		{title: "[WIP] Update README", wantDraft: true, wantTitle: "Update README"},
+		{title: "Update README", draft: true, wantDraft: true, wantTitle: "Update README"},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			pr := &PullRequest{Title: tc.title, Draft: tc.draft}
+			assert.Equal(t, tc.wantDraft, pr.IsDraft())
+			assert.Equal(t, tc.wantTitle, UnsetWIP(tc.title))
+			assert.Equal(t, "WIP: "+tc.wantTitle, SetWIP(tc.title))
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GetRepo returns the repository owner/name.
+func (c *client) GetRepo(ctx context.Context, owner, name string) (*Repository, error) {
+	var repo Repository
+	if _, err := c.get(ctx, repoPath(owner, name), nil, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// ListOrgRepos lists the repositories of the given organization that are
+// visible to the authenticated user. Pages are 1-indexed.
+func (c *client) ListOrgRepos(ctx context.Context, org string, page int) ([]*Repository, bool, error) {
+	return c.listRepos(ctx, fmt.Sprintf("api/v1/orgs/%s/repos", url.PathEscape(org)), page)
+}
+
+// ListUserRepos lists the repositories owned by the given user that are
+// visible to the authenticated user. Pages are 1-indexed.
+func (c *client) ListUserRepos(ctx context.Context, username string, page int) ([]*Repository, bool, error) {
+	return c.listRepos(ctx, fmt.Sprintf("api/v1/users/%s/repos", url.PathEscape(username)), page)
+}
+
+// ListAuthenticatedUserRepos lists all repositories the authenticated user
+// has access to, including those shared through organizations and teams.
+// Pages are 1-indexed.
+func (c *client) ListAuthenticatedUserRepos(ctx context.Context, page int) ([]*Repository, bool, error) {
+	return c.listRepos(ctx, "api/v1/user/repos", page)
+}
+
+func (c *client) listRepos(ctx context.Context, path string, page int) ([]*Repository, bool, error) {
+	var repos []*Repository
+	if _, err := c.get(ctx, path, pageParams(page), &repos); err != nil {
+		return nil, false, err
+	}
+	return repos, len(repos) == defaultPageSize, nil
+}
+
+// ForkRepo forks owner/name. Gitea returns a conflict error if the fork
+// already exists, in which case the existing fork is returned.
+func (c *client) ForkRepo(ctx context.Context, owner, name string, input ForkRepoInput) (*Repository, error) {
+	var repo Repository
+	_, err := c.send(ctx, http.MethodPost, repoPath(owner, name)+"/forks", input, &repo)
+	if err == nil {
+		return &repo, nil
+	}
+	if !IsConflict(err) {
+		return nil, err
+	}
+
+	forkOwner := input.Organization
+	if forkOwner == "" {
+		user, err := c.GetAuthenticatedUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+		forkOwner = user.Login
+	}
+	forkName := input.Name
+	if forkName == "" {
+		forkName = name
+	}
+	return c.GetRepo(ctx, forkOwner, forkName)
+}
+
+func repoPath(owner, name string) string {
+	return fmt.Sprintf("api/v1/repos/%s/%s", url.PathEscape(owner), url.PathEscape(name))
+}
@@ -0,0 +1,42 @@
+package gitea
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/testutil"
+)
+
+func TestClient_ListOrgRepos(t *testing.T) {
+	cli, save := NewTestClient(t, "ListOrgRepos", *update)
+	defer save()
+
+	repos, nextPage, err := cli.ListOrgRepos(context.Background(), "sourcegraph", 1)
+	require.NoError(t, err)
+	assert.False(t, nextPage)
+
+	testutil.AssertGolden(t, "testdata/golden/ListOrgRepos.json", *update, repos)
+}
+
+func TestClient_ListAuthenticatedUserRepos(t *testing.T) {
+	cli, save := NewTestClient(t, "ListAuthenticatedUserRepos", *update)
+	defer save()
+
+	repos, nextPage, err := cli.ListAuthenticatedUserRepos(context.Background(), 1)
+	require.NoError(t, err)
+	assert.False(t, nextPage)
+
+	testutil.AssertGolden(t, "testdata/golden/ListAuthenticatedUserRepos.json", *update, repos)
+}
+
+func TestClient_GetRepoNotFound(t *testing.T) {
+	cli, save := NewTestClient(t, "GetRepoNotFound", *update)
+	defer save()
+
+	_, err := cli.GetRepo(context.Background(), "sourcegraph", "missing")
+	require.Error(t, err)
+	assert.True(t, IsNotFound(err))
+}
@@ -0,0 +1,243 @@
+package gitea
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// User is a Gitea user or organization account.
+type User struct {
+	ID        int64  `json:"id"`
+	Login     string `json:"login"`
+	FullName  string `json:"full_name"`
+	Email     string `json:"email"`
+	AvatarURL string `json:"avatar_url"`
+	IsAdmin   bool   `json:"is_admin"`
+	// Visibility is the visibility of the account, one of the Visibility*
+	// constants. It is empty on instances that predate it.
+	Visibility string `json:"visibility"`
+}
+
+// User visibility values.
+const (
+	VisibilityPublic  = "public"
+	VisibilityLimited = "limited"
+	VisibilityPrivate = "private"
+)
+
+// Repository is a Gitea repository.
+type Repository struct {
+	ID            int64       `json:"id"`
+	Owner         *User       `json:"owner"`
+	Name          string      `json:"name"`
+	FullName      string      `json:"full_name"`
+	Description   string      `json:"description"`
+	Private       bool        `json:"private"`
+	Internal      bool        `json:"internal"`
+	Fork          bool        `json:"fork"`
+	Archived      bool        `json:"archived"`
+	Mirror        bool        `json:"mirror"`
+	Empty         bool        `json:"empty"`
+	HTMLURL       string      `json:"html_url"`
+	CloneURL      string      `json:"clone_url"`
+	SSHURL        string      `json:"ssh_url"`
+	DefaultBranch string      `json:"default_branch"`
+	Topics        []string    `json:"topics"`
+	Parent        *Repository `json:"parent,omitempty"`
+	Permissions   *Permission `json:"permissions,omitempty"`
+}
+
+// IsInternal returns true if the repository is not private, but only visible to
+// signed-in users because the visibility of its owner is limited.
+func (r *Repository) IsInternal() bool {
+	if r.Private {
+		return false
+	}
+	return r.Internal || (r.Owner != nil && r.Owner.Visibility == VisibilityLimited)
+}
+
+// IsPublic returns true if the repository is visible to anonymous users. Repositories
+// that are not private are still only visible to the members of a private owner.
+func (r *Repository) IsPublic() bool {
+	if r.Private || r.IsInternal() {
+		return false
+	}
+	return r.Owner == nil || r.Owner.Visibility != VisibilityPrivate
+}
+
+// Permission is the permission the requesting user has on a repository.
+type Permission struct {
+	Admin bool `json:"admin"`
+	Push  bool `json:"push"`
+	Pull  bool `json:"pull"`
+}
+
+type ForkRepoInput struct {
+	// Organization is the organization to fork into. If empty, the repository
+	// is forked into the namespace of the authenticated user.
+	Organization string `json:"organization,omitempty"`
+	// Name is the name of the fork. If empty, the name of the parent is used.
+	Name string `json:"name,omitempty"`
+}
+
+// PullRequest state values.
+const (
+	PullRequestStateOpen   = "open"
+	PullRequestStateClosed = "closed"
+)
+
+// PullRequest is a Gitea pull request.
+type PullRequest struct {
+	ID        int64      `json:"id"`
+	Index     int64      `json:"number"`
+	User      *User      `json:"user"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body"`
+	State     string     `json:"state"`
+	Draft     bool       `json:"draft"`
+	HTMLURL   string     `json:"html_url"`
+	Mergeable bool       `json:"mergeable"`
+	Merged    bool       `json:"merged"`
+	MergedAt  *time.Time `json:"merged_at"`
+	MergedBy  *User      `json:"merged_by"`
+	ClosedAt  *time.Time `json:"closed_at"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	Base      *PRBranch  `json:"base"`
+	Head      *PRBranch  `json:"head"`
+	Labels    []*Label   `json:"labels"`
+}
+
+// PRBranch is the base or head branch of a pull request.
+type PRBranch struct {
+	Label  string      `json:"label"`
+	Ref    string      `json:"ref"`
+	Sha    string      `json:"sha"`
+	RepoID int64       `json:"repo_id"`
+	Repo   *Repository `json:"repo"`
+}
+
+type Label struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type CreatePullRequestInput struct {
+	// Head is the branch to merge. For cross repository pull requests, it
+	// must be of the form "owner:branch".
+	Head  string `json:"head"`
+	Base  string `json:"base"`
+	Title string `json:"title"`
+	Body  string `json:"body,omitempty"`
+}
+
+type EditPullRequestInput struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+	Base  string `json:"base,omitempty"`
+	// State is either PullRequestStateOpen or PullRequestStateClosed.
+	State string `json:"state,omitempty"`
+}
+
+// Merge styles accepted by MergePullRequest.
+const (
+	MergeStyleMerge  = "merge"
+	MergeStyleSquash = "squash"
+)
+
+type MergePullRequestInput struct {
+	Do                     string `json:"Do"`
+	DeleteBranchAfterMerge bool   `json:"delete_branch_after_merge,omitempty"`
+}
+
+// PullReviewState is the state of a pull request review.
+type PullReviewState string
+
+const (
+	PullReviewStateApproved       PullReviewState = "APPROVED"
+	PullReviewStatePending        PullReviewState = "PENDING"
+	PullReviewStateComment        PullReviewState = "COMMENT"
+	PullReviewStateRequestChanges PullReviewState = "REQUEST_CHANGES"
+	PullReviewStateRequestReview  PullReviewState = "REQUEST_REVIEW"
+)
+
+// PullReview is a review submitted on a pull request.
+type PullReview struct {
+	ID          int64           `json:"id"`
+	User        *User           `json:"user"`
+	State       PullReviewState `json:"state"`
+	Body        string          `json:"body"`
+	CommitID    string          `json:"commit_id"`
+	Stale       bool            `json:"stale"`
+	Official    bool            `json:"official"`
+	Dismissed   bool            `json:"dismissed"`
+	SubmittedAt time.Time       `json:"submitted_at"`
+}
+
+// CommitStatusState is the state of a commit status.
+type CommitStatusState string
+
+const (
+	CommitStatusPending CommitStatusState = "pending"
+	CommitStatusSuccess CommitStatusState = "success"
+	CommitStatusError   CommitStatusState = "error"
+	CommitStatusFailure CommitStatusState = "failure"
+	CommitStatusWarning CommitStatusState = "warning"
+)
+
+// CommitStatus is a single status reported for a commit.
+type CommitStatus struct {
+	ID          int64             `json:"id"`
+	State       CommitStatusState `json:"status"`
+	TargetURL   string            `json:"target_url"`
+	Description string            `json:"description"`
+	Context     string            `json:"context"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+// CombinedStatus is the combined status of all statuses reported for a
+// commit.
+type CombinedStatus struct {
+	State      CommitStatusState `json:"state"`
+	SHA        string            `json:"sha"`
+	TotalCount int               `json:"total_count"`
+	Statuses   []*CommitStatus   `json:"statuses"`
+}
+
+type httpError struct {
+	StatusCode int
+	URL        *url.URL
+	Body       []byte
+}
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("Gitea API HTTP error: code=%d url=%q body=%q", e.StatusCode, e.URL, e.Body)
+}
+
+func (e *httpError) Unauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized
+}
+
+func (e *httpError) NotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
+
+// IsNotFound reports whether err is a Gitea API error with a 404 status.
+func IsNotFound(err error) bool {
+	var e *httpError
+	return errors.As(err, &e) && e.NotFound()
+}
+
+// IsConflict reports whether err is a Gitea API error with a 409 status, which
+// is returned for example when a pull request for the same branches already
+// exists.
+func IsConflict(err error) bool {
+	var e *httpError
+	return errors.As(err, &e) && e.StatusCode == http.StatusConflict
+}
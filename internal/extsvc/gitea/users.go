@@ -0,0 +1,32 @@
+package gitea
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// GetAuthenticatedUser returns the user the client is authenticated as, or the
+// impersonated user if WithSudo was used.
+func (c *client) GetAuthenticatedUser(ctx context.Context) (*User, error) {
+	var user User
+	if _, err := c.get(ctx, "api/v1/user", nil, &user); err != nil {
+		if httpErr := (&httpError{}); errors.As(err, &httpErr) {
+			if httpErr.Unauthorized() {
+				return nil, errors.New("Invalid Gitea access token.")
+			}
+		}
+		return nil, err
+	}
+	return &user, nil
+}
+
+// GetUser returns the user with the given username.
+func (c *client) GetUser(ctx context.Context, username string) (*User, error) {
+	var user User
+	if _, err := c.get(ctx, "api/v1/users/"+url.PathEscape(username), nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
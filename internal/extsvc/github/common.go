@@ -242,6 +242,8 @@ type PullRequest struct {
 	BaseRefName    string
 	Number         int64
 	ReviewDecision string
+	// Mergeable is one of MERGEABLE, CONFLICTING or UNKNOWN.
+	Mergeable      string
 	Author         Actor
 	BaseRepository PullRequestRepo
 	HeadRepository PullRequestRepo
@@ -252,6 +254,15 @@ type PullRequest struct {
 	IsDraft        bool
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
+
+	// AutoMergeRequest is nil unless auto-merge is enabled for the pull
+	// request. It is only requested from GitHub Enterprise 3.3 on.
+	AutoMergeRequest *AutoMergeRequest
+}
+
+// AutoMergeRequest represents the auto-merge settings of a PullRequest.
+type AutoMergeRequest struct {
+	MergeMethod string
 }
 
 // AssignedEvent represents an 'assigned' event on a PullRequest.
@@ -1125,6 +1136,36 @@ func (c *V4Client) MergePullRequest(ctx context.Context, pr *PullRequest, squash
 	return nil
 }
 
+const enablePullRequestAutoMergeMutation = `
+mutation EnablePullRequestAutoMerge($input: EnablePullRequestAutoMergeInput!) {
+  enablePullRequestAutoMerge(input: $input) {
+    pullRequest { id }
+  }
+}
+`
+
+// EnablePullRequestAutoMerge enables auto-merge for the PullRequest on Github,
+// so that it's merged with the given merge method (MERGE, SQUASH or REBASE)
+// once all the requirements of the base branch are met.
+func (c *V4Client) EnablePullRequestAutoMerge(ctx context.Context, pr *PullRequest, mergeMethod string) error {
+	var result struct {
+		EnablePullRequestAutoMerge struct {
+			PullRequest struct {
+				ID string
+			} `json:"pullRequest"`
+		} `json:"enablePullRequestAutoMerge"`
+	}
+
+	input := map[string]any{"input": struct {
+		PullRequestID string `json:"pullRequestId"`
+		MergeMethod   string `json:"mergeMethod,omitempty"`
+	}{
+		PullRequestID: pr.ID,
+		MergeMethod:   mergeMethod,
+	}}
+	return c.requestGraphQL(ctx, enablePullRequestAutoMergeMutation, input, &result)
+}
+
 func (c *V4Client) loadRemainingTimelineItems(ctx context.Context, prID string, pageInfo PageInfo) (items []TimelineItem, err error) {
 	version := c.determineGitHubVersion(ctx)
 	timelineItemTypes, err := timelineItemTypes(version)
@@ -1513,6 +1554,7 @@ fragment pr on PullRequest {
   headRefName
   baseRefName
   reviewDecision
+  mergeable
   %s
   author {
     ...actor
@@ -1580,6 +1622,7 @@ fragment pr on PullRequest {
   headRefName
   baseRefName
   reviewDecision
+  mergeable
   %s
   author {
     ...actor
@@ -1604,6 +1647,13 @@ func pullRequestFragmentsSimple(version *semver.Version) (string, error) {
 	return "", errors.Errorf("unsupported version of GitHub: %s", version)
 }
 
+// autoMergeRequestFields are the fields of a pull request requested from
+// versions of GitHub that support auto-merge, in addition to isDraft.
+const autoMergeRequestFields = `isDraft
+  autoMergeRequest {
+    mergeMethod
+  }`
+
 func pullRequestFragments(version *semver.Version) (string, error) {
 	timelineItemTypes, err := timelineItemTypes(version)
 	if err != nil {
@@ -1617,6 +1667,9 @@ func pullRequestFragments(version *semver.Version) (string, error) {
 		// Don't ask for isDraft for ghe 2.20.
 		return fmt.Sprintf(timelineItemsFragment+pullRequestFragmentsFmtstr, "", timelineItemTypes), nil
 	}
+	if ghe330PlusOrDotComSemver.Check(version) {
+		return fmt.Sprintf(timelineItemsFragment+pullRequestFragmentsFmtstr, autoMergeRequestFields, timelineItemTypes), nil
+	}
 	if ghe221PlusOrDotComSemver.Check(version) {
 		return fmt.Sprintf(timelineItemsFragment+pullRequestFragmentsFmtstr, "isDraft", timelineItemTypes), nil
 	}
@@ -204,6 +204,22 @@ func (c *V3Client) delete(ctx context.Context, requestURI string) (*httpResponse
 	return c.request(ctx, req, struct{}{})
 }
 
+func (c *V3Client) deleteWithPayload(ctx context.Context, requestURI string, payload any) (*httpResponseState, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling payload")
+	}
+
+	req, err := http.NewRequest("DELETE", requestURI, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+
+	return c.request(ctx, req, &struct{}{})
+}
+
 func (c *V3Client) request(ctx context.Context, req *http.Request, result any) (*httpResponseState, error) {
 	// Include node_id (GraphQL ID) in response. See
 	// https://developer.github.com/changes/2017-12-19-graphql-node-id/.
@@ -901,6 +917,72 @@ func (c *V3Client) UpdateRef(ctx context.Context, owner, repo, ref, commit strin
 	return &updatedRef, nil
 }
 
+// AddLabelsToIssue adds the given labels to an issue or pull request. Labels
+// that don't exist in the repository yet are created.
+//
+// API docs: https://docs.github.com/en/rest/issues/labels#add-labels-to-an-issue
+func (c *V3Client) AddLabelsToIssue(ctx context.Context, owner, repo string, number int64, labels []string) error {
+	_, err := c.post(ctx, fmt.Sprintf("repos/%s/%s/issues/%d/labels", owner, repo, number), struct {
+		Labels []string `json:"labels"`
+	}{Labels: labels}, &[]Label{})
+	return err
+}
+
+// AddAssigneesToIssue adds the given users as assignees to an issue or pull
+// request. Existing assignees are kept.
+//
+// API docs: https://docs.github.com/en/rest/issues/assignees#add-assignees-to-an-issue
+func (c *V3Client) AddAssigneesToIssue(ctx context.Context, owner, repo string, number int64, assignees []string) error {
+	_, err := c.post(ctx, fmt.Sprintf("repos/%s/%s/issues/%d/assignees", owner, repo, number), struct {
+		Assignees []string `json:"assignees"`
+	}{Assignees: assignees}, &struct{}{})
+	return err
+}
+
+// RequestPullRequestReviewers requests reviews on a pull request from the given
+// users and teams. Teams are identified by their slug. Existing review
+// requests are kept.
+//
+// API docs: https://docs.github.com/en/rest/pulls/review-requests#request-reviewers-for-a-pull-request
+func (c *V3Client) RequestPullRequestReviewers(ctx context.Context, owner, repo string, number int64, reviewers, teamReviewers []string) error {
+	_, err := c.post(ctx, fmt.Sprintf("repos/%s/%s/pulls/%d/requested_reviewers", owner, repo, number), struct {
+		Reviewers     []string `json:"reviewers,omitempty"`
+		TeamReviewers []string `json:"team_reviewers,omitempty"`
+	}{Reviewers: reviewers, TeamReviewers: teamReviewers}, &struct{}{})
+	return err
+}
+
+// RemoveLabelFromIssue removes the given label from an issue or pull request.
+//
+// API docs: https://docs.github.com/en/rest/issues/labels#remove-a-label-from-an-issue
+func (c *V3Client) RemoveLabelFromIssue(ctx context.Context, owner, repo string, number int64, label string) error {
+	_, err := c.delete(ctx, fmt.Sprintf("repos/%s/%s/issues/%d/labels/%s", owner, repo, number, url.PathEscape(label)))
+	return err
+}
+
+// RemoveAssigneesFromIssue removes the given users from the assignees of an
+// issue or pull request.
+//
+// API docs: https://docs.github.com/en/rest/issues/assignees#remove-assignees-from-an-issue
+func (c *V3Client) RemoveAssigneesFromIssue(ctx context.Context, owner, repo string, number int64, assignees []string) error {
+	_, err := c.deleteWithPayload(ctx, fmt.Sprintf("repos/%s/%s/issues/%d/assignees", owner, repo, number), struct {
+		Assignees []string `json:"assignees"`
+	}{Assignees: assignees})
+	return err
+}
+
+// RemovePullRequestReviewers removes the review requests of the given users and
+// teams from a pull request. Teams are identified by their slug.
+//
+// API docs: https://docs.github.com/en/rest/pulls/review-requests#remove-requested-reviewers-from-a-pull-request
+func (c *V3Client) RemovePullRequestReviewers(ctx context.Context, owner, repo string, number int64, reviewers, teamReviewers []string) error {
+	_, err := c.deleteWithPayload(ctx, fmt.Sprintf("repos/%s/%s/pulls/%d/requested_reviewers", owner, repo, number), struct {
+		Reviewers     []string `json:"reviewers"`
+		TeamReviewers []string `json:"team_reviewers,omitempty"`
+	}{Reviewers: reviewers, TeamReviewers: teamReviewers})
+	return err
+}
+
 // GetAppInstallation gets information of a GitHub App installation.
 //
 // API docs: https://docs.github.com/en/rest/reference/apps#get-an-installation-for-the-authenticated-app
@@ -675,6 +675,52 @@ func (c *V4Client) DeleteBranch(ctx context.Context, owner, repo, branch string)
 	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).DeleteBranch(ctx, owner, repo, branch)
 }
 
+// AddLabelsToIssue adds the given labels to an issue or pull request.
+func (c *V4Client) AddLabelsToIssue(ctx context.Context, owner, repo string, number int64, labels []string) error {
+	// The GraphQL API requires label IDs and doesn't create missing labels, so
+	// we fall back to the REST API.
+	logger := c.log.Scoped("AddLabelsToIssue")
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).AddLabelsToIssue(ctx, owner, repo, number, labels)
+}
+
+// AddAssigneesToIssue adds the given users as assignees to an issue or pull
+// request.
+func (c *V4Client) AddAssigneesToIssue(ctx context.Context, owner, repo string, number int64, assignees []string) error {
+	// The GraphQL API requires node IDs for users, but the REST API accepts
+	// logins.
+	logger := c.log.Scoped("AddAssigneesToIssue")
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).AddAssigneesToIssue(ctx, owner, repo, number, assignees)
+}
+
+// RequestPullRequestReviewers requests reviews on a pull request from the given
+// users and teams.
+func (c *V4Client) RequestPullRequestReviewers(ctx context.Context, owner, repo string, number int64, reviewers, teamReviewers []string) error {
+	// The GraphQL API requires node IDs for users and teams, but the REST API
+	// accepts logins and slugs.
+	logger := c.log.Scoped("RequestPullRequestReviewers")
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).RequestPullRequestReviewers(ctx, owner, repo, number, reviewers, teamReviewers)
+}
+
+// RemoveLabelFromIssue removes the given label from an issue or pull request.
+func (c *V4Client) RemoveLabelFromIssue(ctx context.Context, owner, repo string, number int64, label string) error {
+	logger := c.log.Scoped("RemoveLabelFromIssue")
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).RemoveLabelFromIssue(ctx, owner, repo, number, label)
+}
+
+// RemoveAssigneesFromIssue removes the given users from the assignees of an
+// issue or pull request.
+func (c *V4Client) RemoveAssigneesFromIssue(ctx context.Context, owner, repo string, number int64, assignees []string) error {
+	logger := c.log.Scoped("RemoveAssigneesFromIssue")
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).RemoveAssigneesFromIssue(ctx, owner, repo, number, assignees)
+}
+
+// RemovePullRequestReviewers removes the review requests of the given users and
+// teams from a pull request.
+func (c *V4Client) RemovePullRequestReviewers(ctx context.Context, owner, repo string, number int64, reviewers, teamReviewers []string) error {
+	logger := c.log.Scoped("RemovePullRequestReviewers")
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).RemovePullRequestReviewers(ctx, owner, repo, number, reviewers, teamReviewers)
+}
+
 // GetRef gets the contents of a single commit reference in a repository. The ref should
 // be supplied in a fully qualified format, such as `refs/heads/branch` or
 // `refs/tags/tag`.
@@ -48,10 +48,17 @@ type MergeRequest struct {
 	WorkInProgress          bool              `json:"work_in_progress"`
 	Draft                   bool              `json:"draft"`
 	ForceRemoveSourceBranch bool              `json:"force_remove_source_branch"`
+	HasConflicts            bool              `json:"has_conflicts"`
+	// MergeWhenPipelineSucceeds is true if auto-merge is enabled for the merge
+	// request.
+	MergeWhenPipelineSucceeds bool `json:"merge_when_pipeline_succeeds"`
 	// We only get a partial User object back from the REST API. For example, it lacks
 	// `Email` and `Identities`. If we need more, we need to issue an additional API
 	// request. Otherwise, we should use a different type here.
 	Author User `json:"author"`
+	// Assignees and Reviewers are also partial User objects.
+	Assignees []User `json:"assignees,omitempty"`
+	Reviewers []User `json:"reviewers,omitempty"`
 
 	DiffRefs DiffRefs `json:"diff_refs"`
 
@@ -223,6 +230,14 @@ type UpdateMergeRequestOpts struct {
 	Description        string                       `json:"description,omitempty"`
 	StateEvent         UpdateMergeRequestStateEvent `json:"state_event,omitempty"`
 	RemoveSourceBranch bool                         `json:"remove_source_branch,omitempty"`
+	// AddLabels and RemoveLabels are comma-separated lists of labels to add to
+	// and remove from the merge request. Other labels are kept.
+	AddLabels    string `json:"add_labels,omitempty"`
+	RemoveLabels string `json:"remove_labels,omitempty"`
+	// AssigneeIDs and ReviewerIDs replace the current assignees and reviewers
+	// of the merge request. The ID 0 unassigns all of them.
+	AssigneeIDs []int32 `json:"assignee_ids,omitempty"`
+	ReviewerIDs []int32 `json:"reviewer_ids,omitempty"`
 }
 
 type UpdateMergeRequestStateEvent string
@@ -306,6 +321,47 @@ func (c *Client) MergeMergeRequest(ctx context.Context, project *Project, mr *Me
 	return resp, nil
 }
 
+// EnableMergeRequestAutoMerge sets the merge request to be merged once its
+// pipeline succeeds. If the pipeline has already succeeded, GitLab merges the
+// merge request right away.
+func (c *Client) EnableMergeRequestAutoMerge(ctx context.Context, project *Project, mr *MergeRequest, squash bool) (*MergeRequest, error) {
+	if MockEnableMergeRequestAutoMerge != nil {
+		return MockEnableMergeRequestAutoMerge(c, ctx, project, mr, squash)
+	}
+
+	payload := struct {
+		MergeWhenPipelineSucceeds bool   `json:"merge_when_pipeline_succeeds"`
+		Squash                    bool   `json:"squash,omitempty"`
+		SquashCommitMessage       string `json:"squash_commit_message,omitempty"`
+	}{
+		MergeWhenPipelineSucceeds: true,
+		Squash:                    squash,
+	}
+	if squash {
+		payload.SquashCommitMessage = mr.Title + "\n\n" + mr.Description
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling options")
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("projects/%d/merge_requests/%d/merge", project.ID, mr.IID), bytes.NewBuffer(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request to enable auto-merge of a merge request")
+	}
+
+	resp := &MergeRequest{}
+	if _, _, err := c.do(ctx, req, resp); err != nil {
+		var e HTTPError
+		if errors.As(err, &e) && e.Code() == http.StatusMethodNotAllowed {
+			return nil, errors.Wrap(ErrNotMergeable, err.Error())
+		}
+		return nil, errors.Wrap(err, "sending request to enable auto-merge of a merge request")
+	}
+
+	return resp, nil
+}
+
 func (c *Client) CreateMergeRequestNote(ctx context.Context, project *Project, mr *MergeRequest, body string) error {
 	if MockCreateMergeRequestNote != nil {
 		return MockCreateMergeRequestNote(c, ctx, project, mr, body)
@@ -570,3 +570,46 @@ func TestMergeMergeRequest(t *testing.T) {
 		}
 	})
 }
+
+func TestEnableMergeRequestAutoMerge(t *testing.T) {
+	ctx := context.Background()
+	empty := &MergeRequest{}
+	project := &Project{}
+
+	t.Run("error status code", func(t *testing.T) {
+		client := newTestClient(t)
+		client.httpClient = &mockHTTPEmptyResponse{http.StatusNotFound}
+
+		_, err := client.EnableMergeRequestAutoMerge(ctx, project, empty, false)
+		if err == nil {
+			t.Error("unexpected nil error")
+		}
+	})
+
+	t.Run("not mergeable", func(t *testing.T) {
+		client := newTestClient(t)
+		client.httpClient = &mockHTTPEmptyResponse{
+			statusCode: 405,
+		}
+
+		_, err := client.EnableMergeRequestAutoMerge(ctx, project, empty, false)
+		if !errors.Is(err, ErrNotMergeable) {
+			t.Errorf("invalid error, want=%v have=%v", ErrNotMergeable, err)
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		client := newTestClient(t)
+		client.httpClient = &mockHTTPResponseBody{
+			responseBody: `{"iid":1,"merge_when_pipeline_succeeds":true}`,
+		}
+
+		mr, err := client.EnableMergeRequestAutoMerge(ctx, project, empty, true)
+		if err != nil {
+			t.Fatalf("unexpected non-nil error: %+v", err)
+		}
+		if mr.IID != 1 {
+			t.Errorf("unexpected merge request IID: %d", mr.IID)
+		}
+	})
+}
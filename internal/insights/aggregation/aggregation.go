@@ -167,7 +167,9 @@ func countRepoMetadata(r result.Match, repo *sTypes.Repo) (map[MatchKey]int, err
 	return matches, nil
 }
 
-func GetCountFuncForMode(query, patternType string, mode types.SearchAggregationMode) (AggregationCountFunc, error) {
+// GetCountFuncForMode returns the AggregationCountFunc for mode. ctx is used
+// by modes which need to look up additional data, like OWNER_AGGREGATION_MODE.
+func GetCountFuncForMode(ctx context.Context, query, patternType string, mode types.SearchAggregationMode, opts GroupOptions) (AggregationCountFunc, error) {
 	modeCountTypes := map[types.SearchAggregationMode]AggregationCountFunc{
 		types.REPO_AGGREGATION_MODE:          countRepo,
 		types.PATH_AGGREGATION_MODE:          countPath,
@@ -175,12 +177,21 @@ func GetCountFuncForMode(query, patternType string, mode types.SearchAggregation
 		types.REPO_METADATA_AGGREGATION_MODE: countRepoMetadata,
 	}
 
-	if mode == types.CAPTURE_GROUP_AGGREGATION_MODE {
+	switch mode {
+	case types.CAPTURE_GROUP_AGGREGATION_MODE:
 		captureGroupsCount, err := countCaptureGroupsFunc(query)
 		if err != nil {
 			return nil, err
 		}
 		modeCountTypes[types.CAPTURE_GROUP_AGGREGATION_MODE] = captureGroupsCount
+	case types.DIRECTORY_AGGREGATION_MODE,
+		types.OWNER_AGGREGATION_MODE,
+		types.LANGUAGE_AGGREGATION_MODE,
+		types.SYMBOL_KIND_AGGREGATION_MODE,
+		types.COMMIT_MONTH_AGGREGATION_MODE:
+		if group, ok := groupFuncForMode(mode, opts); ok {
+			modeCountTypes[mode] = countGroupsFunc(ctx, group)
+		}
 	}
 
 	modeCountFunc, ok := modeCountTypes[mode]
@@ -276,12 +287,12 @@ func (r *searchAggregationResults) Send(event streaming.SearchEvent) {
 			return
 		default:
 			groups, err := r.countFunc(match, repos[match.RepoName().ID])
-			for groupKey, count := range groups {
+			if err != nil {
 				// delegate error handling to the passed in tabulator
-				if err != nil {
-					r.tabulator(nil, err)
-					continue
-				}
+				r.tabulator(nil, err)
+				continue
+			}
+			for groupKey, count := range groups {
 				current := combined[groupKey]
 				combined[groupKey] = current + count
 			}
@@ -2,6 +2,7 @@ package aggregation
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -12,6 +13,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
+	codeownerspb "github.com/sourcegraph/sourcegraph/internal/own/codeowners/v1"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
 	dTypes "github.com/sourcegraph/sourcegraph/internal/types"
@@ -260,7 +264,7 @@ func TestRepoAggregation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, _ := GetCountFuncForMode("", "", tc.mode)
+			countFunc, _ := GetCountFuncForMode(context.Background(), "", "", tc.mode, GroupOptions{})
 			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, nil)
 			sra.Send(tc.searchEvent)
 			tc.want.Equal(t, aggregator.results)
@@ -330,7 +334,7 @@ func TestAuthorAggregation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, _ := GetCountFuncForMode("", "", tc.mode)
+			countFunc, _ := GetCountFuncForMode(context.Background(), "", "", tc.mode, GroupOptions{})
 			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, nil)
 			sra.Send(tc.searchEvent)
 			tc.want.Equal(t, aggregator.results)
@@ -438,7 +442,7 @@ func TestPathAggregation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, _ := GetCountFuncForMode("", "", tc.mode)
+			countFunc, _ := GetCountFuncForMode(context.Background(), "", "", tc.mode, GroupOptions{})
 			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, nil)
 			sra.Send(tc.searchEvent)
 			tc.want.Equal(t, aggregator.results)
@@ -649,7 +653,7 @@ func TestCaptureGroupAggregation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, err := GetCountFuncForMode(tc.query, "regexp", tc.mode)
+			countFunc, err := GetCountFuncForMode(context.Background(), tc.query, "regexp", tc.mode, GroupOptions{})
 			if err != nil {
 				t.Errorf("expected test not to error, got %v", err)
 				t.FailNow()
@@ -703,7 +707,7 @@ func TestRepoMetadataAggregation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, _ := GetCountFuncForMode("", "", tc.mode)
+			countFunc, _ := GetCountFuncForMode(context.Background(), "", "", tc.mode, GroupOptions{})
 			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, db)
 			sra.Send(tc.searchEvent)
 			tc.want.Equal(t, aggregator.results)
@@ -736,7 +740,7 @@ func TestAggregationCancelation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, err := GetCountFuncForMode(tc.query, "regexp", tc.mode)
+			countFunc, err := GetCountFuncForMode(context.Background(), tc.query, "regexp", tc.mode, GroupOptions{})
 			if err != nil {
 				t.Errorf("expected test not to error, got %v", err)
 				t.FailNow()
@@ -753,3 +757,166 @@ func TestAggregationCancelation(t *testing.T) {
 		})
 	}
 }
+
+func symbolMatchWithKinds(repo, path string, repoID int32, kinds ...string) result.Match {
+	symbolMatches := make([]*result.SymbolMatch, 0, len(kinds))
+	for i, kind := range kinds {
+		symbolMatches = append(symbolMatches, &result.SymbolMatch{Symbol: result.Symbol{Name: fmt.Sprintf("s%d", i), Kind: kind}})
+	}
+
+	return &result.FileMatch{
+		File: result.File{
+			Repo: internaltypes.MinimalRepo{Name: api.RepoName(repo), ID: api.RepoID(repoID)},
+			Path: path,
+		},
+		Symbols: symbolMatches,
+	}
+}
+
+func datedCommitMatch(repo string, repoID int32, date time.Time) result.Match {
+	return &result.CommitMatch{
+		Commit: gitdomain.Commit{
+			Author:    gitdomain.Signature{Name: "author", Date: date},
+			Committer: &gitdomain.Signature{},
+		},
+		Repo: internaltypes.MinimalRepo{Name: api.RepoName(repo), ID: api.RepoID(repoID)},
+	}
+}
+
+type fakeOwnService struct {
+	own.Service
+	ruleset *codeowners.Ruleset
+	calls   int
+}
+
+func (s *fakeOwnService) RulesetForRepo(context.Context, api.RepoName, api.RepoID, api.CommitID) (*codeowners.Ruleset, error) {
+	s.calls++
+	return s.ruleset, nil
+}
+
+func TestGroupOptionsAggregation(t *testing.T) {
+	owners := &fakeOwnService{ruleset: codeowners.NewRuleset(codeowners.IngestedRulesetSource{}, &codeownerspb.File{
+		Rule: []*codeownerspb.Rule{
+			{Pattern: "*.go", Owner: []*codeownerspb.Owner{{Handle: "gophers"}, {Email: "lead@example.com"}}},
+			{Pattern: "/docs/", Owner: []*codeownerspb.Owner{{Handle: "writers"}}},
+		},
+	})}
+
+	testCases := []struct {
+		name        string
+		mode        types.SearchAggregationMode
+		opts        GroupOptions
+		searchEvent streaming.SearchEvent
+		want        autogold.Value
+	}{
+		{
+			"Directory at depth 1",
+			types.DIRECTORY_AGGREGATION_MODE,
+			GroupOptions{DirectoryDepth: 1},
+			streaming.SearchEvent{
+				Results: []result.Match{
+					contentMatch("myRepo", "cmd/a/main.go", 1, "a", "b"),
+					contentMatch("myRepo", "cmd/b/main.go", 1, "c"),
+					pathMatch("myRepo", "README.md", 1),
+					repoMatch("myRepo", 1),
+				}},
+			autogold.Expect(map[string]int{"/": 1, "cmd": 3}),
+		},
+		{
+			"Directory at depth 2",
+			types.DIRECTORY_AGGREGATION_MODE,
+			GroupOptions{DirectoryDepth: 2},
+			streaming.SearchEvent{
+				Results: []result.Match{
+					contentMatch("myRepo", "cmd/a/main.go", 1, "a", "b"),
+					contentMatch("myRepo", "cmd/b/main.go", 1, "c"),
+					contentMatch("myRepo", "cmd/main.go", 1, "d"),
+				}},
+			autogold.Expect(map[string]int{"cmd": 1, "cmd/a": 2, "cmd/b": 1}),
+		},
+		{
+			"Language",
+			types.LANGUAGE_AGGREGATION_MODE,
+			GroupOptions{},
+			streaming.SearchEvent{
+				Results: []result.Match{
+					contentMatch("myRepo", "main.go", 1, "a", "b"),
+					pathMatch("myRepo", "README.md", 1),
+					pathMatch("myRepo", "unknown.zzz", 1),
+					repoMatch("myRepo", 1),
+				}},
+			autogold.Expect(map[string]int{"Go": 2, "Markdown": 1, "Unknown language": 1}),
+		},
+		{
+			"Symbol kind",
+			types.SYMBOL_KIND_AGGREGATION_MODE,
+			GroupOptions{},
+			streaming.SearchEvent{
+				Results: []result.Match{
+					symbolMatchWithKinds("myRepo", "main.go", 1, "function", "function", "struct"),
+					symbolMatchWithKinds("myRepo", "other.go", 1, "function", "notakind"),
+					contentMatch("myRepo", "main.go", 1, "a"),
+				}},
+			autogold.Expect(map[string]int{"FUNCTION": 3, "STRUCT": 1, "UNKNOWN": 1}),
+		},
+		{
+			"Commit month",
+			types.COMMIT_MONTH_AGGREGATION_MODE,
+			GroupOptions{},
+			streaming.SearchEvent{
+				Results: []result.Match{
+					datedCommitMatch("myRepo", 1, sampleDate),
+					datedCommitMatch("myRepo", 1, sampleDate.Add(48*time.Hour)),
+					datedCommitMatch("myRepo", 1, sampleDate.AddDate(0, 1, 0)),
+					contentMatch("myRepo", "main.go", 1, "a"),
+				}},
+			autogold.Expect(map[string]int{"2022-04": 2, "2022-05": 1}),
+		},
+		{
+			"Owner",
+			types.OWNER_AGGREGATION_MODE,
+			GroupOptions{Owners: owners},
+			streaming.SearchEvent{
+				Results: []result.Match{
+					contentMatch("myRepo", "main.go", 1, "a", "b"),
+					pathMatch("myRepo", "docs/index.md", 1),
+					pathMatch("myRepo", "README.md", 1),
+				}},
+			autogold.Expect(map[string]int{"@gophers": 2, "@writers": 1, "No owner": 1, "lead@example.com": 2}),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			aggregator := testAggregator{results: make(map[string]int)}
+			countFunc, err := GetCountFuncForMode(context.Background(), "", "", tc.mode, tc.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, nil)
+			sra.Send(tc.searchEvent)
+			tc.want.Equal(t, aggregator.results)
+		})
+	}
+
+	t.Run("owner rulesets are cached per commit", func(t *testing.T) {
+		owners.calls = 0
+		countFunc, err := GetCountFuncForMode(context.Background(), "", "", types.OWNER_AGGREGATION_MODE, GroupOptions{Owners: owners})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for range 3 {
+			if _, err := countFunc(pathMatch("myRepo", "main.go", 1), nil); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if owners.calls != 1 {
+			t.Errorf("expected 1 ruleset lookup, got %d", owners.calls)
+		}
+	})
+
+	t.Run("owner mode requires an own service", func(t *testing.T) {
+		if _, err := GetCountFuncForMode(context.Background(), "", "", types.OWNER_AGGREGATION_MODE, GroupOptions{}); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package aggregation
+
+import (
+	"context"
+	"sort"
+
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ExhaustiveAggregationModes are the modes the results of a search job can be
+// grouped by. Capture group and repo metadata aggregations need the original
+// search results and are not available.
+var ExhaustiveAggregationModes = []types.SearchAggregationMode{
+	types.REPO_AGGREGATION_MODE,
+	types.PATH_AGGREGATION_MODE,
+	types.AUTHOR_AGGREGATION_MODE,
+	types.DIRECTORY_AGGREGATION_MODE,
+	types.OWNER_AGGREGATION_MODE,
+	types.LANGUAGE_AGGREGATION_MODE,
+	types.SYMBOL_KIND_AGGREGATION_MODE,
+	types.COMMIT_MONTH_AGGREGATION_MODE,
+}
+
+// ExhaustiveAggregator computes exact counts over the stored results of a
+// search job. Unlike LimitedAggregator it keeps every group, so it should only
+// be fed results that were already written out by a search job rather than
+// held in memory. It is not thread safe.
+type ExhaustiveAggregator struct {
+	group  groupFunc
+	counts map[string]int32
+}
+
+func NewExhaustiveAggregator(mode types.SearchAggregationMode, opts GroupOptions) (*ExhaustiveAggregator, error) {
+	group, ok := groupFuncForMode(mode, opts)
+	if !ok {
+		return nil, errors.Newf("unsupported aggregation mode: %s for search jobs", mode)
+	}
+	return &ExhaustiveAggregator{group: group, counts: map[string]int32{}}, nil
+}
+
+// Add counts a single match of a search job.
+func (a *ExhaustiveAggregator) Add(ctx context.Context, m streamhttp.EventMatch) error {
+	groups, err := a.group(ctx, fieldsFromEvent(m))
+	if err != nil {
+		return err
+	}
+	for label, count := range groups {
+		a.counts[label] += int32(count)
+	}
+	return nil
+}
+
+// SortAggregate returns all groups in descending order of their count.
+func (a *ExhaustiveAggregator) SortAggregate() []*Aggregate {
+	aggregates := make([]*Aggregate, 0, len(a.counts))
+	for label, count := range a.counts {
+		aggregates = append(aggregates, &Aggregate{Label: label, Count: count})
+	}
+	sort.Slice(aggregates, func(i, j int) bool {
+		return aggregates[j].Less(aggregates[i])
+	})
+	return aggregates
+}
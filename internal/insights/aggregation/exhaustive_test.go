@@ -0,0 +1,98 @@
+package aggregation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hexops/autogold/v2"
+
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+)
+
+func TestExhaustiveAggregator(t *testing.T) {
+	events := []streamhttp.EventMatch{
+		&streamhttp.EventContentMatch{
+			Repository: "repo-a",
+			Path:       "internal/api/client.go",
+			Language:   "Go",
+			ChunkMatches: []streamhttp.ChunkMatch{
+				{Ranges: make([]streamhttp.Range, 2)},
+				{Ranges: make([]streamhttp.Range, 1)},
+			},
+		},
+		&streamhttp.EventPathMatch{Repository: "repo-a", Path: "internal/README.md", Language: "Markdown"},
+		&streamhttp.EventSymbolMatch{
+			Repository: "repo-b",
+			Path:       "main.go",
+			Language:   "Go",
+			Symbols:    []streamhttp.Symbol{{Kind: "FUNCTION"}, {Kind: "FUNCTION"}, {Kind: "STRUCT"}},
+		},
+		&streamhttp.EventCommitMatch{
+			Repository: "repo-b",
+			AuthorName: "alice",
+			AuthorDate: time.Date(2024, time.March, 31, 23, 0, 0, 0, time.UTC),
+		},
+		&streamhttp.EventRepoMatch{Repository: "repo-c"},
+	}
+
+	testCases := []struct {
+		mode types.SearchAggregationMode
+		opts GroupOptions
+		want autogold.Value
+	}{
+		{types.REPO_AGGREGATION_MODE, GroupOptions{}, autogold.Expect(map[string]int32{"repo-a": 4, "repo-b": 4, "repo-c": 1})},
+		{types.PATH_AGGREGATION_MODE, GroupOptions{}, autogold.Expect(map[string]int32{"internal/README.md": 1, "internal/api/client.go": 3, "main.go": 3})},
+		{types.AUTHOR_AGGREGATION_MODE, GroupOptions{}, autogold.Expect(map[string]int32{"alice": 1})},
+		{types.DIRECTORY_AGGREGATION_MODE, GroupOptions{DirectoryDepth: 1}, autogold.Expect(map[string]int32{"/": 3, "internal": 4})},
+		{types.DIRECTORY_AGGREGATION_MODE, GroupOptions{}, autogold.Expect(map[string]int32{"/": 3, "internal": 1, "internal/api": 3})},
+		{types.LANGUAGE_AGGREGATION_MODE, GroupOptions{}, autogold.Expect(map[string]int32{"Go": 6, "Markdown": 1})},
+		{types.SYMBOL_KIND_AGGREGATION_MODE, GroupOptions{}, autogold.Expect(map[string]int32{"FUNCTION": 2, "STRUCT": 1})},
+		{types.COMMIT_MONTH_AGGREGATION_MODE, GroupOptions{}, autogold.Expect(map[string]int32{"2024-03": 1})},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.mode), func(t *testing.T) {
+			a, err := NewExhaustiveAggregator(tc.mode, tc.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, e := range events {
+				if err := a.Add(context.Background(), e); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got := map[string]int32{}
+			for _, agg := range a.SortAggregate() {
+				got[agg.Label] = agg.Count
+			}
+			tc.want.Equal(t, got)
+		})
+	}
+
+	t.Run("sorted by count", func(t *testing.T) {
+		a, err := NewExhaustiveAggregator(types.REPO_AGGREGATION_MODE, GroupOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, e := range events {
+			if err := a.Add(context.Background(), e); err != nil {
+				t.Fatal(err)
+			}
+		}
+		var labels []string
+		for _, agg := range a.SortAggregate() {
+			labels = append(labels, agg.Label)
+		}
+		autogold.Expect([]string{"repo-b", "repo-a", "repo-c"}).Equal(t, labels)
+	})
+
+	t.Run("unsupported modes", func(t *testing.T) {
+		for _, mode := range []types.SearchAggregationMode{types.CAPTURE_GROUP_AGGREGATION_MODE, types.REPO_METADATA_AGGREGATION_MODE, types.OWNER_AGGREGATION_MODE} {
+			if _, err := NewExhaustiveAggregator(mode, GroupOptions{}); err == nil {
+				t.Errorf("expected an error for %s", mode)
+			}
+		}
+	})
+}
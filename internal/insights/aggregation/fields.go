@@ -0,0 +1,320 @@
+package aggregation
+
+import (
+	"context"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sourcegraph/go-lsp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+	sTypes "github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// matchFields are the properties of a search match that results can be
+// grouped by. They are extracted both from live search results and from the
+// stored results of search jobs, so that both are grouped the same way.
+type matchFields struct {
+	repo       string
+	repoID     int32
+	commit     api.CommitID
+	path       string
+	language   string
+	authorName string
+	authorDate time.Time
+	// symbolKinds holds the upper case LSP kind of every symbol of a symbol
+	// match, in the format used by the streaming API.
+	symbolKinds []string
+	count       int
+}
+
+func fieldsFromMatch(m result.Match) matchFields {
+	f := matchFields{
+		repo:   string(m.RepoName().Name),
+		repoID: int32(m.RepoName().ID),
+		count:  m.ResultCount(),
+	}
+	switch v := m.(type) {
+	case *result.FileMatch:
+		f.commit = v.CommitID
+		f.path = v.Path
+		f.language = v.MostLikelyLanguage()
+		for _, sym := range v.Symbols {
+			f.symbolKinds = append(f.symbolKinds, symbolKindString(sym.Symbol.LSPKind()))
+		}
+	case *result.CommitMatch:
+		f.commit = v.Commit.ID
+		f.authorName = v.Commit.Author.Name
+		f.authorDate = v.Commit.Author.Date
+	}
+	return f
+}
+
+func fieldsFromEvent(e streamhttp.EventMatch) matchFields {
+	switch v := e.(type) {
+	case *streamhttp.EventContentMatch:
+		count := 0
+		for _, cm := range v.ChunkMatches {
+			count += len(cm.Ranges)
+		}
+		return matchFields{
+			repo:     v.Repository,
+			repoID:   v.RepositoryID,
+			commit:   api.CommitID(v.Commit),
+			path:     v.Path,
+			language: v.Language,
+			count:    max(count, 1),
+		}
+	case *streamhttp.EventPathMatch:
+		return matchFields{
+			repo:     v.Repository,
+			repoID:   v.RepositoryID,
+			commit:   api.CommitID(v.Commit),
+			path:     v.Path,
+			language: v.Language,
+			count:    1,
+		}
+	case *streamhttp.EventSymbolMatch:
+		f := matchFields{
+			repo:     v.Repository,
+			repoID:   v.RepositoryID,
+			commit:   api.CommitID(v.Commit),
+			path:     v.Path,
+			language: v.Language,
+			count:    max(len(v.Symbols), 1),
+		}
+		for _, sym := range v.Symbols {
+			f.symbolKinds = append(f.symbolKinds, sym.Kind)
+		}
+		return f
+	case *streamhttp.EventCommitMatch:
+		return matchFields{
+			repo:       v.Repository,
+			repoID:     v.RepositoryID,
+			commit:     api.CommitID(v.OID),
+			authorName: v.AuthorName,
+			authorDate: v.AuthorDate,
+			count:      max(len(v.Ranges), 1),
+		}
+	case *streamhttp.EventRepoMatch:
+		return matchFields{
+			repo:   v.Repository,
+			repoID: v.RepositoryID,
+			count:  1,
+		}
+	default:
+		return matchFields{}
+	}
+}
+
+// symbolKindString matches the symbol kinds reported by the streaming API.
+func symbolKindString(kind lsp.SymbolKind) string {
+	if kind == 0 {
+		return "UNKNOWN"
+	}
+	return strings.ToUpper(kind.String())
+}
+
+// groupFunc returns the groups a match belongs to, together with the number
+// of results it contributes to each group.
+type groupFunc func(context.Context, matchFields) (map[string]int, error)
+
+func groupByRepo(_ context.Context, f matchFields) (map[string]int, error) {
+	if f.repo == "" {
+		return nil, nil
+	}
+	return map[string]int{f.repo: f.count}, nil
+}
+
+func groupByPath(_ context.Context, f matchFields) (map[string]int, error) {
+	if f.path == "" {
+		return nil, nil
+	}
+	return map[string]int{f.path: f.count}, nil
+}
+
+func groupByAuthor(_ context.Context, f matchFields) (map[string]int, error) {
+	if f.authorName == "" {
+		return nil, nil
+	}
+	return map[string]int{f.authorName: f.count}, nil
+}
+
+func groupByDirectoryFunc(depth int) groupFunc {
+	return func(_ context.Context, f matchFields) (map[string]int, error) {
+		if f.path == "" {
+			return nil, nil
+		}
+		return map[string]int{DirectoryAtDepth(f.path, depth): f.count}, nil
+	}
+}
+
+// DirectoryAtDepth returns the directory of filePath truncated to at most
+// depth path components. Files at the root of a repository are grouped under
+// types.ROOT_DIRECTORY_TEXT.
+func DirectoryAtDepth(filePath string, depth int) string {
+	dir := path.Dir(filePath)
+	if dir == "." || dir == "/" {
+		return types.ROOT_DIRECTORY_TEXT
+	}
+	components := strings.Split(strings.TrimPrefix(dir, "/"), "/")
+	if depth > 0 && len(components) > depth {
+		components = components[:depth]
+	}
+	return strings.Join(components, "/")
+}
+
+func groupByLanguage(_ context.Context, f matchFields) (map[string]int, error) {
+	if f.path == "" {
+		return nil, nil
+	}
+	language := f.language
+	if language == "" {
+		language = types.NO_LANGUAGE_TEXT
+	}
+	return map[string]int{language: f.count}, nil
+}
+
+func groupBySymbolKind(_ context.Context, f matchFields) (map[string]int, error) {
+	if len(f.symbolKinds) == 0 {
+		return nil, nil
+	}
+	groups := make(map[string]int, len(f.symbolKinds))
+	for _, kind := range f.symbolKinds {
+		groups[kind]++
+	}
+	return groups, nil
+}
+
+func groupByCommitMonth(_ context.Context, f matchFields) (map[string]int, error) {
+	if f.authorDate.IsZero() {
+		return nil, nil
+	}
+	return map[string]int{f.authorDate.UTC().Format("2006-01"): f.count}, nil
+}
+
+// ownerGrouper groups files by the owners the CODEOWNERS rules of their
+// repository assign to them. Rulesets are cached per repository and commit,
+// since results usually contain many files of the same commit.
+type ownerGrouper struct {
+	owners own.Service
+
+	mu    sync.Mutex
+	rules map[ownerRulesKey]*codeowners.Ruleset
+}
+
+type ownerRulesKey struct {
+	repoID int32
+	commit api.CommitID
+}
+
+func newOwnerGrouper(owners own.Service) *ownerGrouper {
+	return &ownerGrouper{owners: owners, rules: map[ownerRulesKey]*codeowners.Ruleset{}}
+}
+
+func (g *ownerGrouper) ruleset(ctx context.Context, f matchFields) (*codeowners.Ruleset, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	key := ownerRulesKey{repoID: f.repoID, commit: f.commit}
+	if rs, ok := g.rules[key]; ok {
+		return rs, nil
+	}
+	rs, err := g.owners.RulesetForRepo(ctx, api.RepoName(f.repo), api.RepoID(f.repoID), f.commit)
+	if err != nil {
+		return nil, err
+	}
+	g.rules[key] = rs
+	return rs, nil
+}
+
+func (g *ownerGrouper) group(ctx context.Context, f matchFields) (map[string]int, error) {
+	if f.path == "" {
+		return nil, nil
+	}
+	rs, err := g.ruleset(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := map[string]int{}
+	if rs != nil {
+		for _, rule := range rs.MatchAll(f.path) {
+			for _, o := range rule.GetOwner() {
+				switch {
+				case o.GetHandle() != "":
+					groups["@"+o.GetHandle()] = f.count
+				case o.GetEmail() != "":
+					groups[o.GetEmail()] = f.count
+				}
+			}
+		}
+	}
+	if len(groups) == 0 {
+		groups[types.NO_OWNER_TEXT] = f.count
+	}
+	return groups, nil
+}
+
+// GroupOptions configures how matches are grouped by the modes which need
+// more than the match itself.
+type GroupOptions struct {
+	// DirectoryDepth is the number of leading directories that
+	// DIRECTORY_AGGREGATION_MODE groups by. Values below 1 group by the full
+	// directory of a file.
+	DirectoryDepth int
+	// Owners resolves the CODEOWNERS rules for OWNER_AGGREGATION_MODE.
+	Owners own.Service
+}
+
+// groupFuncForMode returns the groupFunc for the modes which can be computed
+// from matchFields alone.
+func groupFuncForMode(mode types.SearchAggregationMode, opts GroupOptions) (groupFunc, bool) {
+	switch mode {
+	case types.REPO_AGGREGATION_MODE:
+		return groupByRepo, true
+	case types.PATH_AGGREGATION_MODE:
+		return groupByPath, true
+	case types.AUTHOR_AGGREGATION_MODE:
+		return groupByAuthor, true
+	case types.DIRECTORY_AGGREGATION_MODE:
+		return groupByDirectoryFunc(opts.DirectoryDepth), true
+	case types.LANGUAGE_AGGREGATION_MODE:
+		return groupByLanguage, true
+	case types.SYMBOL_KIND_AGGREGATION_MODE:
+		return groupBySymbolKind, true
+	case types.COMMIT_MONTH_AGGREGATION_MODE:
+		return groupByCommitMonth, true
+	case types.OWNER_AGGREGATION_MODE:
+		if opts.Owners == nil {
+			return nil, false
+		}
+		return newOwnerGrouper(opts.Owners).group, true
+	default:
+		return nil, false
+	}
+}
+
+// countGroupsFunc adapts a groupFunc to an AggregationCountFunc for live
+// search results.
+func countGroupsFunc(ctx context.Context, group groupFunc) AggregationCountFunc {
+	return func(r result.Match, _ *sTypes.Repo) (map[MatchKey]int, error) {
+		f := fieldsFromMatch(r)
+		groups, err := group(ctx, f)
+		if err != nil || len(groups) == 0 {
+			return nil, err
+		}
+		matches := make(map[MatchKey]int, len(groups))
+		for g, count := range groups {
+			matches[MatchKey{Repo: f.repo, RepoID: f.repoID, Group: g}] = count
+		}
+		return matches, nil
+	}
+}
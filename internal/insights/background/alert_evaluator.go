@@ -0,0 +1,264 @@
+package background
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	codemonitors "github.com/sourcegraph/sourcegraph/internal/codemonitors/background"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// AlertStore is the subset of store.AlertStore used to evaluate alerts.
+type AlertStore interface {
+	GetAlerts(ctx context.Context, args store.AlertQueryArgs) ([]types.InsightSeriesAlert, error)
+	MarkEvaluated(ctx context.Context, id int, pointTime time.Time, fired bool) error
+}
+
+// RecordedPointStore is the subset of store.Store used to evaluate alerts.
+type RecordedPointStore interface {
+	RecordedTotals(ctx context.Context, seriesID string, limit int) ([]store.SeriesPoint, error)
+	RecordedRepoValues(ctx context.Context, seriesID string, recordingTime time.Time) ([]store.RepoSeriesValue, error)
+}
+
+// alertEvaluator evaluates the alert rules of insight series against the
+// points recorded since their previous evaluation, and delivers the alerts
+// which fire through the actions of code monitors.
+type alertEvaluator struct {
+	logger      log.Logger
+	alertStore  AlertStore
+	pointStore  RecordedPointStore
+	seriesStore store.DataSeriesStore
+	notify      func(ctx context.Context, alert types.InsightSeriesAlert, fired codemonitors.InsightAlert) error
+}
+
+func newAlertEvaluator(logger log.Logger, mainAppDB database.DB, alertStore AlertStore, pointStore RecordedPointStore, seriesStore store.DataSeriesStore) *alertEvaluator {
+	return &alertEvaluator{
+		logger:      logger,
+		alertStore:  alertStore,
+		pointStore:  pointStore,
+		seriesStore: seriesStore,
+		notify: func(ctx context.Context, alert types.InsightSeriesAlert, fired codemonitors.InsightAlert) error {
+			return deliverAlert(ctx, mainAppDB, alert, fired)
+		},
+	}
+}
+
+// EvaluateSeries evaluates the alerts of the series with the given unique ID
+// against its latest recorded point. The query runner calls it after each
+// recording of the series.
+func (e *alertEvaluator) EvaluateSeries(ctx context.Context, seriesID string) error {
+	alerts, err := e.alertStore.GetAlerts(ctx, store.AlertQueryArgs{SeriesID: seriesID})
+	if err != nil {
+		return errors.Wrap(err, "GetAlerts")
+	}
+
+	var errs error
+	for _, alert := range alerts {
+		if err := e.evaluate(ctx, alert); err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "alert %d", alert.ID))
+		}
+	}
+	return errs
+}
+
+func (e *alertEvaluator) evaluate(ctx context.Context, alert types.InsightSeriesAlert) error {
+	// 🚨 SECURITY: Alerts are evaluated with the repository permissions of their creator, so that
+	// they never reveal values of repositories the creator cannot see.
+	ctx = actor.WithActor(ctx, actor.FromUser(alert.UserID))
+
+	points, err := e.pointStore.RecordedTotals(ctx, alert.SeriesID, 2)
+	if err != nil {
+		return errors.Wrap(err, "RecordedTotals")
+	}
+	if len(points) == 0 {
+		return nil
+	}
+	latest := points[0]
+	if alert.LastEvaluatedAt != nil && !latest.Time.After(*alert.LastEvaluatedAt) {
+		// No point was recorded since the previous evaluation.
+		return nil
+	}
+	if alert.LastEvaluatedAt == nil && latest.Time.Before(alert.CreatedAt) {
+		// Points recorded before the alert was created never fire it.
+		return e.alertStore.MarkEvaluated(ctx, alert.ID, latest.Time, false)
+	}
+	var previous *store.SeriesPoint
+	if len(points) > 1 {
+		previous = &points[1]
+	}
+
+	fired, ok, err := e.check(ctx, alert, latest, previous)
+	if err != nil {
+		return err
+	}
+	if ok {
+		series, err := e.seriesStore.GetDataSeries(ctx, store.GetDataSeriesArgs{SeriesID: alert.SeriesID})
+		if err != nil {
+			return errors.Wrap(err, "GetDataSeries")
+		}
+		if len(series) > 0 {
+			fired.Query = series[0].Query
+		}
+		// If delivery fails the alert is not marked as evaluated, so that
+		// delivery is retried on the next evaluation.
+		if err := e.notify(ctx, alert, fired); err != nil {
+			return errors.Wrap(err, "notify")
+		}
+		e.logger.Info("insight series alert fired", log.Int("alertID", alert.ID), log.String("seriesID", alert.SeriesID))
+	}
+	return e.alertStore.MarkEvaluated(ctx, alert.ID, latest.Time, ok)
+}
+
+// check returns whether alert fires for the latest point of its series, and
+// the alert to deliver if it does.
+func (e *alertEvaluator) check(ctx context.Context, alert types.InsightSeriesAlert, latest store.SeriesPoint, previous *store.SeriesPoint) (codemonitors.InsightAlert, bool, error) {
+	fired := codemonitors.InsightAlert{
+		SeriesID: alert.SeriesID,
+		Time:     latest.Time,
+		Value:    latest.Value,
+	}
+	if previous != nil {
+		fired.PreviousValue = &previous.Value
+	}
+
+	switch alert.Kind {
+	case types.AbsoluteThresholdAlert:
+		if !crossedThreshold(alert.Threshold, latest.Value, fired.PreviousValue) {
+			return fired, false, nil
+		}
+		fired.Description = fmt.Sprintf("reached %s", formatAlertValue(alert.Threshold))
+		return fired, true, nil
+
+	case types.PercentChangeAlert:
+		if previous == nil {
+			return fired, false, nil
+		}
+		change, ok := percentChange(previous.Value, latest.Value)
+		if !ok || change < alert.Threshold {
+			return fired, false, nil
+		}
+		if math.IsInf(change, 1) {
+			fired.Description = fmt.Sprintf("changed from %s", formatAlertValue(previous.Value))
+		} else {
+			fired.Description = fmt.Sprintf("changed by %s%%", formatAlertValue(math.Round(change*100)/100))
+		}
+		return fired, true, nil
+
+	case types.RepoThresholdAlert:
+		repos, err := e.reposCrossingThreshold(ctx, alert, latest, previous)
+		if err != nil {
+			return fired, false, err
+		}
+		if len(repos) == 0 {
+			return fired, false, nil
+		}
+		fired.Repositories = repos
+		fired.Description = fmt.Sprintf("reached %s in %d %s", formatAlertValue(alert.Threshold), len(repos), pluralize("repository", "repositories", len(repos)))
+		return fired, true, nil
+
+	default:
+		return fired, false, errors.Newf("unknown alert kind %q", alert.Kind)
+	}
+}
+
+// reposCrossingThreshold returns the repositories whose value reached the
+// threshold of alert at the latest point, having been below it at the
+// previous point.
+func (e *alertEvaluator) reposCrossingThreshold(ctx context.Context, alert types.InsightSeriesAlert, latest store.SeriesPoint, previous *store.SeriesPoint) ([]codemonitors.InsightAlertRepository, error) {
+	latestValues, err := e.pointStore.RecordedRepoValues(ctx, alert.SeriesID, latest.Time)
+	if err != nil {
+		return nil, errors.Wrap(err, "RecordedRepoValues")
+	}
+	previousValues := map[api.RepoID]float64{}
+	if previous != nil {
+		values, err := e.pointStore.RecordedRepoValues(ctx, alert.SeriesID, previous.Time)
+		if err != nil {
+			return nil, errors.Wrap(err, "RecordedRepoValues")
+		}
+		for _, v := range values {
+			previousValues[v.RepoID] = v.Value
+		}
+	}
+
+	var repos []codemonitors.InsightAlertRepository
+	for _, v := range latestValues {
+		var previousValue *float64
+		if previous != nil {
+			// Repositories without a value had no matches.
+			p := previousValues[v.RepoID]
+			previousValue = &p
+		}
+		if crossedThreshold(alert.Threshold, v.Value, previousValue) {
+			repos = append(repos, codemonitors.InsightAlertRepository{Name: v.RepoName, Value: v.Value})
+		}
+	}
+	return repos, nil
+}
+
+// crossedThreshold returns true if value reached threshold, having been below
+// it at the previous point if there is one.
+func crossedThreshold(threshold, value float64, previous *float64) bool {
+	return value >= threshold && (previous == nil || *previous < threshold)
+}
+
+// percentChange returns the absolute change from previous to value as a
+// percentage of previous. Any change from zero is infinite, and no change is
+// reported as false.
+func percentChange(previous, value float64) (float64, bool) {
+	if previous == value {
+		return 0, false
+	}
+	if previous == 0 {
+		return math.Inf(1), true
+	}
+	return math.Abs(value-previous) / math.Abs(previous) * 100, true
+}
+
+func formatAlertValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func pluralize(singular, plural string, n int) string {
+	if n == 1 {
+		return singular
+	}
+	return plural
+}
+
+// deliverAlert delivers a fired alert through its action.
+func deliverAlert(ctx context.Context, db database.DB, alert types.InsightSeriesAlert, fired codemonitors.InsightAlert) error {
+	externalURL, err := url.Parse(conf.Get().ExternalURL)
+	if err != nil {
+		return err
+	}
+	fired.ExternalURL = externalURL
+
+	switch alert.Action {
+	case types.EmailAlertAction:
+		return codemonitors.SendEmailForInsightAlert(ctx, db, alert.UserID, fired)
+	case types.SlackWebhookAlertAction:
+		if alert.ActionURL == nil {
+			return errors.New("Slack webhook action without URL")
+		}
+		return codemonitors.SendSlackForInsightAlert(ctx, *alert.ActionURL, fired)
+	case types.WebhookAlertAction:
+		if alert.ActionURL == nil {
+			return errors.New("webhook action without URL")
+		}
+		return codemonitors.SendWebhookForInsightAlert(ctx, *alert.ActionURL, fired)
+	default:
+		return errors.Newf("unknown alert action %q", alert.Action)
+	}
+}
@@ -0,0 +1,209 @@
+package background
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	codemonitors "github.com/sourcegraph/sourcegraph/internal/codemonitors/background"
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestAlertEvaluator(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return created.AddDate(0, 0, d) }
+
+	type evaluation struct {
+		pointTime time.Time
+		fired     bool
+	}
+
+	setup := func(alert types.InsightSeriesAlert, points []store.SeriesPoint, repoValues map[time.Time][]store.RepoSeriesValue, notifyErr error) (*alertEvaluator, *[]codemonitors.InsightAlert, *[]evaluation) {
+		alertStore := NewMockAlertStore()
+		alertStore.GetAlertsFunc.SetDefaultHook(func(_ context.Context, args store.AlertQueryArgs) ([]types.InsightSeriesAlert, error) {
+			require.Equal(t, alert.SeriesID, args.SeriesID)
+			return []types.InsightSeriesAlert{alert}, nil
+		})
+		var evaluations []evaluation
+		alertStore.MarkEvaluatedFunc.SetDefaultHook(func(_ context.Context, id int, pointTime time.Time, fired bool) error {
+			require.Equal(t, alert.ID, id)
+			evaluations = append(evaluations, evaluation{pointTime: pointTime, fired: fired})
+			return nil
+		})
+
+		pointStore := NewMockRecordedPointStore()
+		pointStore.RecordedTotalsFunc.SetDefaultHook(func(ctx context.Context, seriesID string, limit int) ([]store.SeriesPoint, error) {
+			require.Equal(t, alert.UserID, actor.FromContext(ctx).UID)
+			if len(points) > limit {
+				return points[:limit], nil
+			}
+			return points, nil
+		})
+		pointStore.RecordedRepoValuesFunc.SetDefaultHook(func(ctx context.Context, seriesID string, recordingTime time.Time) ([]store.RepoSeriesValue, error) {
+			require.Equal(t, alert.UserID, actor.FromContext(ctx).UID)
+			return repoValues[recordingTime], nil
+		})
+
+		seriesStore := store.NewMockDataSeriesStore()
+		seriesStore.GetDataSeriesFunc.SetDefaultReturn([]types.InsightSeries{{SeriesID: alert.SeriesID, Query: "TODO"}}, nil)
+
+		var notified []codemonitors.InsightAlert
+		evaluator := &alertEvaluator{
+			logger:      logtest.Scoped(t),
+			alertStore:  alertStore,
+			pointStore:  pointStore,
+			seriesStore: seriesStore,
+			notify: func(_ context.Context, _ types.InsightSeriesAlert, fired codemonitors.InsightAlert) error {
+				if notifyErr != nil {
+					return notifyErr
+				}
+				notified = append(notified, fired)
+				return nil
+			},
+		}
+		return evaluator, &notified, &evaluations
+	}
+
+	alertOf := func(kind types.AlertKind, threshold float64) types.InsightSeriesAlert {
+		return types.InsightSeriesAlert{
+			ID:        1,
+			SeriesID:  "s1",
+			Kind:      kind,
+			Threshold: threshold,
+			Action:    types.EmailAlertAction,
+			UserID:    7,
+			CreatedAt: created,
+		}
+	}
+
+	t.Run("absolute threshold fires when crossed", func(t *testing.T) {
+		e, notified, evaluations := setup(alertOf(types.AbsoluteThresholdAlert, 100), []store.SeriesPoint{
+			{Time: day(2), Value: 120},
+			{Time: day(1), Value: 80},
+		}, nil, nil)
+		require.NoError(t, e.EvaluateSeries(context.Background(), "s1"))
+
+		require.Len(t, *notified, 1)
+		require.Equal(t, "reached 100", (*notified)[0].Description)
+		require.Equal(t, "TODO", (*notified)[0].Query)
+		require.Equal(t, 120.0, (*notified)[0].Value)
+		require.Equal(t, []evaluation{{pointTime: day(2), fired: true}}, *evaluations)
+	})
+
+	t.Run("absolute threshold does not fire while above", func(t *testing.T) {
+		e, notified, evaluations := setup(alertOf(types.AbsoluteThresholdAlert, 100), []store.SeriesPoint{
+			{Time: day(2), Value: 130},
+			{Time: day(1), Value: 120},
+		}, nil, nil)
+		require.NoError(t, e.EvaluateSeries(context.Background(), "s1"))
+
+		require.Empty(t, *notified)
+		require.Equal(t, []evaluation{{pointTime: day(2), fired: false}}, *evaluations)
+	})
+
+	t.Run("percent change", func(t *testing.T) {
+		e, notified, _ := setup(alertOf(types.PercentChangeAlert, 20), []store.SeriesPoint{
+			{Time: day(2), Value: 75},
+			{Time: day(1), Value: 100},
+		}, nil, nil)
+		require.NoError(t, e.EvaluateSeries(context.Background(), "s1"))
+
+		require.Len(t, *notified, 1)
+		require.Equal(t, "changed by 25%", (*notified)[0].Description)
+	})
+
+	t.Run("percent change below threshold", func(t *testing.T) {
+		e, notified, _ := setup(alertOf(types.PercentChangeAlert, 20), []store.SeriesPoint{
+			{Time: day(2), Value: 110},
+			{Time: day(1), Value: 100},
+		}, nil, nil)
+		require.NoError(t, e.EvaluateSeries(context.Background(), "s1"))
+
+		require.Empty(t, *notified)
+	})
+
+	t.Run("repo threshold", func(t *testing.T) {
+		e, notified, _ := setup(alertOf(types.RepoThresholdAlert, 10), []store.SeriesPoint{
+			{Time: day(2), Value: 30},
+			{Time: day(1), Value: 15},
+		}, map[time.Time][]store.RepoSeriesValue{
+			day(1): {
+				{RepoID: 1, RepoName: "github.com/a/a", Value: 12},
+				{RepoID: 2, RepoName: "github.com/a/b", Value: 3},
+			},
+			day(2): {
+				{RepoID: 1, RepoName: "github.com/a/a", Value: 14},
+				{RepoID: 2, RepoName: "github.com/a/b", Value: 11},
+				{RepoID: 3, RepoName: "github.com/a/c", Value: 5},
+			},
+		}, nil)
+		require.NoError(t, e.EvaluateSeries(context.Background(), "s1"))
+
+		require.Len(t, *notified, 1)
+		require.Equal(t, "reached 10 in 1 repository", (*notified)[0].Description)
+		require.Equal(t, []codemonitors.InsightAlertRepository{{Name: "github.com/a/b", Value: 11}}, (*notified)[0].Repositories)
+	})
+
+	t.Run("skips points already evaluated", func(t *testing.T) {
+		alert := alertOf(types.AbsoluteThresholdAlert, 100)
+		evaluated := day(2)
+		alert.LastEvaluatedAt = &evaluated
+		e, notified, evaluations := setup(alert, []store.SeriesPoint{
+			{Time: day(2), Value: 120},
+			{Time: day(1), Value: 80},
+		}, nil, nil)
+		require.NoError(t, e.EvaluateSeries(context.Background(), "s1"))
+
+		require.Empty(t, *notified)
+		require.Empty(t, *evaluations)
+	})
+
+	t.Run("skips points recorded before the alert was created", func(t *testing.T) {
+		e, notified, evaluations := setup(alertOf(types.AbsoluteThresholdAlert, 100), []store.SeriesPoint{
+			{Time: day(-1), Value: 120},
+			{Time: day(-2), Value: 80},
+		}, nil, nil)
+		require.NoError(t, e.EvaluateSeries(context.Background(), "s1"))
+
+		require.Empty(t, *notified)
+		require.Equal(t, []evaluation{{pointTime: day(-1), fired: false}}, *evaluations)
+	})
+
+	t.Run("retries failed deliveries", func(t *testing.T) {
+		e, _, evaluations := setup(alertOf(types.AbsoluteThresholdAlert, 100), []store.SeriesPoint{
+			{Time: day(2), Value: 120},
+			{Time: day(1), Value: 80},
+		}, nil, errors.New("boom"))
+		require.Error(t, e.EvaluateSeries(context.Background(), "s1"))
+
+		require.Empty(t, *evaluations)
+	})
+}
+
+func TestPercentChange(t *testing.T) {
+	for _, tc := range []struct {
+		previous, value float64
+		want            float64
+		ok              bool
+	}{
+		{previous: 100, value: 150, want: 50, ok: true},
+		{previous: 100, value: 50, want: 50, ok: true},
+		{previous: 100, value: 100, ok: false},
+		{previous: 0, value: 0, ok: false},
+	} {
+		got, ok := percentChange(tc.previous, tc.value)
+		require.Equal(t, tc.ok, ok)
+		require.Equal(t, tc.want, got)
+	}
+
+	got, ok := percentChange(0, 1)
+	require.True(t, ok)
+	require.True(t, math.IsInf(got, 1))
+}
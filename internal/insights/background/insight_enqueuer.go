@@ -148,6 +148,12 @@ func (ie *InsightEnqueuer) EnqueueSingle(
 	var modifiedQuery querybuilder.BasicQuery
 	var finalQuery string
 
+	if series.GenerationMethod.IsSymbolReferences() {
+		// Series generated from symbol references have no search query. An
+		// empty scope records all repositories of the series at once.
+		return ie.enqueueSeries(ctx, series, queryrunner.SymbolReferencesScope{}.String(), mode, stampFunc)
+	}
+
 	if series.RepositoryCriteria != nil {
 		modifiedQuery, err = querybuilder.MakeQueryWithRepoFilters(*series.RepositoryCriteria, basicQuery, true, querybuilder.CodeInsightsQueryDefaults(true)...)
 	} else if len(series.Repositories) > 0 {
@@ -167,7 +173,18 @@ func (ie *InsightEnqueuer) EnqueueSingle(
 		finalQuery = computeQuery.String()
 	}
 
-	err = ie.enqueueQueryRunnerJob(ctx, &queryrunner.Job{
+	return ie.enqueueSeries(ctx, series, finalQuery, mode, stampFunc)
+}
+
+func (ie *InsightEnqueuer) enqueueSeries(
+	ctx context.Context,
+	series types.InsightSeries,
+	finalQuery string,
+	mode store.PersistMode,
+	stampFunc func(ctx context.Context, insightSeries types.InsightSeries) (types.InsightSeries, error),
+) error {
+	seriesID := series.SeriesID
+	err := ie.enqueueQueryRunnerJob(ctx, &queryrunner.Job{
 		SearchJob: queryrunner.SearchJob{
 			SeriesID:    seriesID,
 			SearchQuery: finalQuery,
@@ -9,11 +9,585 @@ package background
 import (
 	"context"
 	"sync"
+	"time"
 
 	api "github.com/sourcegraph/sourcegraph/internal/api"
-	types "github.com/sourcegraph/sourcegraph/internal/types"
+	store "github.com/sourcegraph/sourcegraph/internal/insights/store"
+	types "github.com/sourcegraph/sourcegraph/internal/insights/types"
+	types1 "github.com/sourcegraph/sourcegraph/internal/types"
 )
 
+// MockAlertStore is a mock implementation of the AlertStore interface (from
+// the package
+// github.com/sourcegraph/sourcegraph/internal/insights/background) used for
+// unit testing.
+type MockAlertStore struct {
+	// GetAlertsFunc is an instance of a mock function object controlling
+	// the behavior of the method GetAlerts.
+	GetAlertsFunc *AlertStoreGetAlertsFunc
+	// MarkEvaluatedFunc is an instance of a mock function object
+	// controlling the behavior of the method MarkEvaluated.
+	MarkEvaluatedFunc *AlertStoreMarkEvaluatedFunc
+}
+
+// NewMockAlertStore creates a new mock of the AlertStore interface. All
+// methods return zero values for all results, unless overwritten.
+func NewMockAlertStore() *MockAlertStore {
+	return &MockAlertStore{
+		GetAlertsFunc: &AlertStoreGetAlertsFunc{
+			defaultHook: func(context.Context, store.AlertQueryArgs) (r0 []types.InsightSeriesAlert, r1 error) {
+				return
+			},
+		},
+		MarkEvaluatedFunc: &AlertStoreMarkEvaluatedFunc{
+			defaultHook: func(context.Context, int, time.Time, bool) (r0 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockAlertStore creates a new mock of the AlertStore interface.
+// All methods panic on invocation, unless overwritten.
+func NewStrictMockAlertStore() *MockAlertStore {
+	return &MockAlertStore{
+		GetAlertsFunc: &AlertStoreGetAlertsFunc{
+			defaultHook: func(context.Context, store.AlertQueryArgs) ([]types.InsightSeriesAlert, error) {
+				panic("unexpected invocation of MockAlertStore.GetAlerts")
+			},
+		},
+		MarkEvaluatedFunc: &AlertStoreMarkEvaluatedFunc{
+			defaultHook: func(context.Context, int, time.Time, bool) error {
+				panic("unexpected invocation of MockAlertStore.MarkEvaluated")
+			},
+		},
+	}
+}
+
+// NewMockAlertStoreFrom creates a new mock of the MockAlertStore interface.
+// All methods delegate to the given implementation, unless overwritten.
+func NewMockAlertStoreFrom(i AlertStore) *MockAlertStore {
+	return &MockAlertStore{
+		GetAlertsFunc: &AlertStoreGetAlertsFunc{
+			defaultHook: i.GetAlerts,
+		},
+		MarkEvaluatedFunc: &AlertStoreMarkEvaluatedFunc{
+			defaultHook: i.MarkEvaluated,
+		},
+	}
+}
+
+// AlertStoreGetAlertsFunc describes the behavior when the GetAlerts method
+// of the parent MockAlertStore instance is invoked.
+type AlertStoreGetAlertsFunc struct {
+	defaultHook func(context.Context, store.AlertQueryArgs) ([]types.InsightSeriesAlert, error)
+	hooks       []func(context.Context, store.AlertQueryArgs) ([]types.InsightSeriesAlert, error)
+	history     []AlertStoreGetAlertsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetAlerts delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAlertStore) GetAlerts(v0 context.Context, v1 store.AlertQueryArgs) ([]types.InsightSeriesAlert, error) {
+	r0, r1 := m.GetAlertsFunc.nextHook()(v0, v1)
+	m.GetAlertsFunc.appendCall(AlertStoreGetAlertsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetAlerts method of
+// the parent MockAlertStore instance is invoked and the hook queue is
+// empty.
+func (f *AlertStoreGetAlertsFunc) SetDefaultHook(hook func(context.Context, store.AlertQueryArgs) ([]types.InsightSeriesAlert, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetAlerts method of the parent MockAlertStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *AlertStoreGetAlertsFunc) PushHook(hook func(context.Context, store.AlertQueryArgs) ([]types.InsightSeriesAlert, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AlertStoreGetAlertsFunc) SetDefaultReturn(r0 []types.InsightSeriesAlert, r1 error) {
+	f.SetDefaultHook(func(context.Context, store.AlertQueryArgs) ([]types.InsightSeriesAlert, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AlertStoreGetAlertsFunc) PushReturn(r0 []types.InsightSeriesAlert, r1 error) {
+	f.PushHook(func(context.Context, store.AlertQueryArgs) ([]types.InsightSeriesAlert, error) {
+		return r0, r1
+	})
+}
+
+func (f *AlertStoreGetAlertsFunc) nextHook() func(context.Context, store.AlertQueryArgs) ([]types.InsightSeriesAlert, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AlertStoreGetAlertsFunc) appendCall(r0 AlertStoreGetAlertsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AlertStoreGetAlertsFuncCall objects
+// describing the invocations of this function.
+func (f *AlertStoreGetAlertsFunc) History() []AlertStoreGetAlertsFuncCall {
+	f.mutex.Lock()
+	history := make([]AlertStoreGetAlertsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AlertStoreGetAlertsFuncCall is an object that describes an invocation of
+// method GetAlerts on an instance of MockAlertStore.
+type AlertStoreGetAlertsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 store.AlertQueryArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []types.InsightSeriesAlert
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AlertStoreGetAlertsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AlertStoreGetAlertsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AlertStoreMarkEvaluatedFunc describes the behavior when the MarkEvaluated
+// method of the parent MockAlertStore instance is invoked.
+type AlertStoreMarkEvaluatedFunc struct {
+	defaultHook func(context.Context, int, time.Time, bool) error
+	hooks       []func(context.Context, int, time.Time, bool) error
+	history     []AlertStoreMarkEvaluatedFuncCall
+	mutex       sync.Mutex
+}
+
+// MarkEvaluated delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockAlertStore) MarkEvaluated(v0 context.Context, v1 int, v2 time.Time, v3 bool) error {
+	r0 := m.MarkEvaluatedFunc.nextHook()(v0, v1, v2, v3)
+	m.MarkEvaluatedFunc.appendCall(AlertStoreMarkEvaluatedFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the MarkEvaluated method
+// of the parent MockAlertStore instance is invoked and the hook queue is
+// empty.
+func (f *AlertStoreMarkEvaluatedFunc) SetDefaultHook(hook func(context.Context, int, time.Time, bool) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// MarkEvaluated method of the parent MockAlertStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *AlertStoreMarkEvaluatedFunc) PushHook(hook func(context.Context, int, time.Time, bool) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AlertStoreMarkEvaluatedFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int, time.Time, bool) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AlertStoreMarkEvaluatedFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int, time.Time, bool) error {
+		return r0
+	})
+}
+
+func (f *AlertStoreMarkEvaluatedFunc) nextHook() func(context.Context, int, time.Time, bool) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AlertStoreMarkEvaluatedFunc) appendCall(r0 AlertStoreMarkEvaluatedFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AlertStoreMarkEvaluatedFuncCall objects
+// describing the invocations of this function.
+func (f *AlertStoreMarkEvaluatedFunc) History() []AlertStoreMarkEvaluatedFuncCall {
+	f.mutex.Lock()
+	history := make([]AlertStoreMarkEvaluatedFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AlertStoreMarkEvaluatedFuncCall is an object that describes an invocation
+// of method MarkEvaluated on an instance of MockAlertStore.
+type AlertStoreMarkEvaluatedFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 time.Time
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 bool
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AlertStoreMarkEvaluatedFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AlertStoreMarkEvaluatedFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// MockRecordedPointStore is a mock implementation of the RecordedPointStore
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/insights/background) used for
+// unit testing.
+type MockRecordedPointStore struct {
+	// RecordedRepoValuesFunc is an instance of a mock function object
+	// controlling the behavior of the method RecordedRepoValues.
+	RecordedRepoValuesFunc *RecordedPointStoreRecordedRepoValuesFunc
+	// RecordedTotalsFunc is an instance of a mock function object
+	// controlling the behavior of the method RecordedTotals.
+	RecordedTotalsFunc *RecordedPointStoreRecordedTotalsFunc
+}
+
+// NewMockRecordedPointStore creates a new mock of the RecordedPointStore
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockRecordedPointStore() *MockRecordedPointStore {
+	return &MockRecordedPointStore{
+		RecordedRepoValuesFunc: &RecordedPointStoreRecordedRepoValuesFunc{
+			defaultHook: func(context.Context, string, time.Time) (r0 []store.RepoSeriesValue, r1 error) {
+				return
+			},
+		},
+		RecordedTotalsFunc: &RecordedPointStoreRecordedTotalsFunc{
+			defaultHook: func(context.Context, string, int) (r0 []store.SeriesPoint, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockRecordedPointStore creates a new mock of the
+// RecordedPointStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockRecordedPointStore() *MockRecordedPointStore {
+	return &MockRecordedPointStore{
+		RecordedRepoValuesFunc: &RecordedPointStoreRecordedRepoValuesFunc{
+			defaultHook: func(context.Context, string, time.Time) ([]store.RepoSeriesValue, error) {
+				panic("unexpected invocation of MockRecordedPointStore.RecordedRepoValues")
+			},
+		},
+		RecordedTotalsFunc: &RecordedPointStoreRecordedTotalsFunc{
+			defaultHook: func(context.Context, string, int) ([]store.SeriesPoint, error) {
+				panic("unexpected invocation of MockRecordedPointStore.RecordedTotals")
+			},
+		},
+	}
+}
+
+// NewMockRecordedPointStoreFrom creates a new mock of the
+// MockRecordedPointStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockRecordedPointStoreFrom(i RecordedPointStore) *MockRecordedPointStore {
+	return &MockRecordedPointStore{
+		RecordedRepoValuesFunc: &RecordedPointStoreRecordedRepoValuesFunc{
+			defaultHook: i.RecordedRepoValues,
+		},
+		RecordedTotalsFunc: &RecordedPointStoreRecordedTotalsFunc{
+			defaultHook: i.RecordedTotals,
+		},
+	}
+}
+
+// RecordedPointStoreRecordedRepoValuesFunc describes the behavior when the
+// RecordedRepoValues method of the parent MockRecordedPointStore instance
+// is invoked.
+type RecordedPointStoreRecordedRepoValuesFunc struct {
+	defaultHook func(context.Context, string, time.Time) ([]store.RepoSeriesValue, error)
+	hooks       []func(context.Context, string, time.Time) ([]store.RepoSeriesValue, error)
+	history     []RecordedPointStoreRecordedRepoValuesFuncCall
+	mutex       sync.Mutex
+}
+
+// RecordedRepoValues delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRecordedPointStore) RecordedRepoValues(v0 context.Context, v1 string, v2 time.Time) ([]store.RepoSeriesValue, error) {
+	r0, r1 := m.RecordedRepoValuesFunc.nextHook()(v0, v1, v2)
+	m.RecordedRepoValuesFunc.appendCall(RecordedPointStoreRecordedRepoValuesFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RecordedRepoValues
+// method of the parent MockRecordedPointStore instance is invoked and the
+// hook queue is empty.
+func (f *RecordedPointStoreRecordedRepoValuesFunc) SetDefaultHook(hook func(context.Context, string, time.Time) ([]store.RepoSeriesValue, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RecordedRepoValues method of the parent MockRecordedPointStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RecordedPointStoreRecordedRepoValuesFunc) PushHook(hook func(context.Context, string, time.Time) ([]store.RepoSeriesValue, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RecordedPointStoreRecordedRepoValuesFunc) SetDefaultReturn(r0 []store.RepoSeriesValue, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, time.Time) ([]store.RepoSeriesValue, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RecordedPointStoreRecordedRepoValuesFunc) PushReturn(r0 []store.RepoSeriesValue, r1 error) {
+	f.PushHook(func(context.Context, string, time.Time) ([]store.RepoSeriesValue, error) {
+		return r0, r1
+	})
+}
+
+func (f *RecordedPointStoreRecordedRepoValuesFunc) nextHook() func(context.Context, string, time.Time) ([]store.RepoSeriesValue, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RecordedPointStoreRecordedRepoValuesFunc) appendCall(r0 RecordedPointStoreRecordedRepoValuesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RecordedPointStoreRecordedRepoValuesFuncCall objects describing the
+// invocations of this function.
+func (f *RecordedPointStoreRecordedRepoValuesFunc) History() []RecordedPointStoreRecordedRepoValuesFuncCall {
+	f.mutex.Lock()
+	history := make([]RecordedPointStoreRecordedRepoValuesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RecordedPointStoreRecordedRepoValuesFuncCall is an object that describes
+// an invocation of method RecordedRepoValues on an instance of
+// MockRecordedPointStore.
+type RecordedPointStoreRecordedRepoValuesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []store.RepoSeriesValue
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RecordedPointStoreRecordedRepoValuesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RecordedPointStoreRecordedRepoValuesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RecordedPointStoreRecordedTotalsFunc describes the behavior when the
+// RecordedTotals method of the parent MockRecordedPointStore instance is
+// invoked.
+type RecordedPointStoreRecordedTotalsFunc struct {
+	defaultHook func(context.Context, string, int) ([]store.SeriesPoint, error)
+	hooks       []func(context.Context, string, int) ([]store.SeriesPoint, error)
+	history     []RecordedPointStoreRecordedTotalsFuncCall
+	mutex       sync.Mutex
+}
+
+// RecordedTotals delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRecordedPointStore) RecordedTotals(v0 context.Context, v1 string, v2 int) ([]store.SeriesPoint, error) {
+	r0, r1 := m.RecordedTotalsFunc.nextHook()(v0, v1, v2)
+	m.RecordedTotalsFunc.appendCall(RecordedPointStoreRecordedTotalsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RecordedTotals
+// method of the parent MockRecordedPointStore instance is invoked and the
+// hook queue is empty.
+func (f *RecordedPointStoreRecordedTotalsFunc) SetDefaultHook(hook func(context.Context, string, int) ([]store.SeriesPoint, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RecordedTotals method of the parent MockRecordedPointStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RecordedPointStoreRecordedTotalsFunc) PushHook(hook func(context.Context, string, int) ([]store.SeriesPoint, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RecordedPointStoreRecordedTotalsFunc) SetDefaultReturn(r0 []store.SeriesPoint, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, int) ([]store.SeriesPoint, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RecordedPointStoreRecordedTotalsFunc) PushReturn(r0 []store.SeriesPoint, r1 error) {
+	f.PushHook(func(context.Context, string, int) ([]store.SeriesPoint, error) {
+		return r0, r1
+	})
+}
+
+func (f *RecordedPointStoreRecordedTotalsFunc) nextHook() func(context.Context, string, int) ([]store.SeriesPoint, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RecordedPointStoreRecordedTotalsFunc) appendCall(r0 RecordedPointStoreRecordedTotalsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RecordedPointStoreRecordedTotalsFuncCall
+// objects describing the invocations of this function.
+func (f *RecordedPointStoreRecordedTotalsFunc) History() []RecordedPointStoreRecordedTotalsFuncCall {
+	f.mutex.Lock()
+	history := make([]RecordedPointStoreRecordedTotalsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RecordedPointStoreRecordedTotalsFuncCall is an object that describes an
+// invocation of method RecordedTotals on an instance of
+// MockRecordedPointStore.
+type RecordedPointStoreRecordedTotalsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []store.SeriesPoint
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RecordedPointStoreRecordedTotalsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RecordedPointStoreRecordedTotalsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // MockRepoStore is a mock implementation of the RepoStore interface (from
 // the package
 // github.com/sourcegraph/sourcegraph/internal/insights/background) used for
@@ -29,7 +603,7 @@ type MockRepoStore struct {
 func NewMockRepoStore() *MockRepoStore {
 	return &MockRepoStore{
 		GetByNameFunc: &RepoStoreGetByNameFunc{
-			defaultHook: func(context.Context, api.RepoName) (r0 *types.Repo, r1 error) {
+			defaultHook: func(context.Context, api.RepoName) (r0 *types1.Repo, r1 error) {
 				return
 			},
 		},
@@ -41,7 +615,7 @@ func NewMockRepoStore() *MockRepoStore {
 func NewStrictMockRepoStore() *MockRepoStore {
 	return &MockRepoStore{
 		GetByNameFunc: &RepoStoreGetByNameFunc{
-			defaultHook: func(context.Context, api.RepoName) (*types.Repo, error) {
+			defaultHook: func(context.Context, api.RepoName) (*types1.Repo, error) {
 				panic("unexpected invocation of MockRepoStore.GetByName")
 			},
 		},
@@ -61,15 +635,15 @@ func NewMockRepoStoreFrom(i RepoStore) *MockRepoStore {
 // RepoStoreGetByNameFunc describes the behavior when the GetByName method
 // of the parent MockRepoStore instance is invoked.
 type RepoStoreGetByNameFunc struct {
-	defaultHook func(context.Context, api.RepoName) (*types.Repo, error)
-	hooks       []func(context.Context, api.RepoName) (*types.Repo, error)
+	defaultHook func(context.Context, api.RepoName) (*types1.Repo, error)
+	hooks       []func(context.Context, api.RepoName) (*types1.Repo, error)
 	history     []RepoStoreGetByNameFuncCall
 	mutex       sync.Mutex
 }
 
 // GetByName delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRepoStore) GetByName(v0 context.Context, v1 api.RepoName) (*types.Repo, error) {
+func (m *MockRepoStore) GetByName(v0 context.Context, v1 api.RepoName) (*types1.Repo, error) {
 	r0, r1 := m.GetByNameFunc.nextHook()(v0, v1)
 	m.GetByNameFunc.appendCall(RepoStoreGetByNameFuncCall{v0, v1, r0, r1})
 	return r0, r1
@@ -77,7 +651,7 @@ func (m *MockRepoStore) GetByName(v0 context.Context, v1 api.RepoName) (*types.R
 
 // SetDefaultHook sets function that is called when the GetByName method of
 // the parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreGetByNameFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*types.Repo, error)) {
+func (f *RepoStoreGetByNameFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*types1.Repo, error)) {
 	f.defaultHook = hook
 }
 
@@ -85,7 +659,7 @@ func (f *RepoStoreGetByNameFunc) SetDefaultHook(hook func(context.Context, api.R
 // GetByName method of the parent MockRepoStore instance invokes the hook at
 // the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *RepoStoreGetByNameFunc) PushHook(hook func(context.Context, api.RepoName) (*types.Repo, error)) {
+func (f *RepoStoreGetByNameFunc) PushHook(hook func(context.Context, api.RepoName) (*types1.Repo, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -93,20 +667,20 @@ func (f *RepoStoreGetByNameFunc) PushHook(hook func(context.Context, api.RepoNam
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetByNameFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName) (*types.Repo, error) {
+func (f *RepoStoreGetByNameFunc) SetDefaultReturn(r0 *types1.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (*types1.Repo, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetByNameFunc) PushReturn(r0 *types.Repo, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName) (*types.Repo, error) {
+func (f *RepoStoreGetByNameFunc) PushReturn(r0 *types1.Repo, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (*types1.Repo, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreGetByNameFunc) nextHook() func(context.Context, api.RepoName) (*types.Repo, error) {
+func (f *RepoStoreGetByNameFunc) nextHook() func(context.Context, api.RepoName) (*types1.Repo, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -147,7 +721,7 @@ type RepoStoreGetByNameFuncCall struct {
 	Arg1 api.RepoName
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.Repo
+	Result0 *types1.Repo
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -20,7 +20,10 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 )
 
-func GetSearchHandlers() map[types.GenerationMethod]InsightsHandler {
+// GetSearchHandlers returns the handlers recording points for each generation
+// method. Series generated from symbol references are only handled if
+// symbolReferences is not nil.
+func GetSearchHandlers(symbolReferences SymbolReferenceCounter) map[types.GenerationMethod]InsightsHandler {
 	searchStream := func(ctx context.Context, query string) (*streaming.TabulationResult, error) {
 		tr, ctx := trace.New(ctx, "CodeInsightsSearch.searchStream")
 		defer tr.End()
@@ -56,12 +59,16 @@ func GetSearchHandlers() map[types.GenerationMethod]InsightsHandler {
 		return streamResults, nil
 	}
 
-	return map[types.GenerationMethod]InsightsHandler{
+	handlers := map[types.GenerationMethod]InsightsHandler{
 		types.MappingCompute: makeMappingComputeHandler(computeTextExtraSearch),
 		types.SearchCompute:  makeComputeHandler(computeSearchStream),
 		types.Search:         makeSearchHandler(searchStream),
 	}
-
+	if symbolReferences != nil {
+		handlers[types.PreciseSymbolReferences] = makeSymbolReferencesHandler(symbolReferences)
+		handlers[types.SyntacticSymbolReferences] = makeSymbolReferencesHandler(symbolReferences)
+	}
+	return handlers
 }
 
 func toRecording(record *SearchJob, value float64, recordTime time.Time, repoName string, repoID api.RepoID, capture *string) []store.RecordSeriesPointArgs {
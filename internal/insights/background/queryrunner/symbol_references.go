@@ -0,0 +1,114 @@
+package queryrunner
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// SymbolReferenceCounter counts references to the SCIP symbol of series
+// generated from symbol references, using code intelligence indexes.
+type SymbolReferenceCounter interface {
+	// CountAtCommit returns the number of references to the symbol of series
+	// in the indexes of repo closest to commit.
+	CountAtCommit(ctx context.Context, series *types.InsightSeries, repo api.RepoID, commit api.CommitID) (int, error)
+
+	// CountAtTip returns the number of references to the symbol of series in
+	// the indexes visible at the tip of the default branch of the repositories
+	// in scope of series. Repositories without references are omitted.
+	CountAtTip(ctx context.Context, series *types.InsightSeries) ([]RepoReferenceCount, error)
+}
+
+// RepoReferenceCount is the number of references to a symbol in a repository.
+type RepoReferenceCount struct {
+	RepoID   api.RepoID
+	RepoName api.RepoName
+	Count    int
+}
+
+// SymbolReferencesScope takes the place of the search query of jobs for
+// series generated from symbol references. Jobs with an empty scope record the
+// current references in every repository in scope of the series, otherwise
+// the references in a single repository at a commit are recorded.
+type SymbolReferencesScope struct {
+	RepoID   api.RepoID   `json:"repoID,omitempty"`
+	RepoName api.RepoName `json:"repoName,omitempty"`
+	Commit   api.CommitID `json:"commit,omitempty"`
+}
+
+func (s SymbolReferencesScope) String() string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+// ParseSymbolReferencesScope parses the query of a job for a series generated
+// from symbol references.
+func ParseSymbolReferencesScope(query string) (SymbolReferencesScope, error) {
+	var scope SymbolReferencesScope
+	if err := json.Unmarshal([]byte(query), &scope); err != nil {
+		return scope, errors.Wrap(err, "invalid symbol references scope")
+	}
+	if scope.RepoID != 0 && scope.Commit == "" {
+		return scope, errors.New("invalid symbol references scope: repository without commit")
+	}
+	return scope, nil
+}
+
+func makeSymbolReferencesHandler(counter SymbolReferenceCounter) InsightsHandler {
+	return func(ctx context.Context, job *SearchJob, series *types.InsightSeries, recordTime time.Time) ([]store.RecordSeriesPointArgs, error) {
+		recordings, err := generateSymbolReferencesRecordings(ctx, job, series, recordTime, counter, log.Scoped("SymbolReferencesRecordingsGenerator"))
+		if err != nil {
+			return nil, errors.Wrap(err, "symbolReferencesHandler")
+		}
+		return recordings, nil
+	}
+}
+
+func generateSymbolReferencesRecordings(ctx context.Context, job *SearchJob, series *types.InsightSeries, recordTime time.Time, counter SymbolReferenceCounter, logger log.Logger) ([]store.RecordSeriesPointArgs, error) {
+	scope, err := ParseSymbolReferencesScope(job.SearchQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	var counts []RepoReferenceCount
+	if scope.RepoID != 0 {
+		count, err := counter.CountAtCommit(ctx, series, scope.RepoID, scope.Commit)
+		if err != nil {
+			return nil, errors.Wrap(err, "CountAtCommit")
+		}
+		counts = append(counts, RepoReferenceCount{RepoID: scope.RepoID, RepoName: scope.RepoName, Count: count})
+	} else {
+		counts, err = counter.CountAtTip(ctx, series)
+		if err != nil {
+			return nil, errors.Wrap(err, "CountAtTip")
+		}
+	}
+
+	checker := authz.DefaultSubRepoPermsChecker
+	var recordings []store.RecordSeriesPointArgs
+	for _, c := range counts {
+		if c.Count == 0 {
+			continue
+		}
+		// Indexes cover every file of a repository, so repositories with
+		// sub-repo permissions are excluded like they are from search results.
+		subRepoEnabled, subRepoErr := authz.SubRepoEnabledForRepoID(ctx, checker, c.RepoID)
+		if subRepoErr != nil {
+			logger.Error("sub-repo permissions check errored", log.String("seriesID", job.SeriesID), log.String("repo", string(c.RepoName)), log.Error(subRepoErr))
+			continue
+		}
+		if subRepoEnabled {
+			continue
+		}
+		recordings = append(recordings, toRecording(job, float64(c.Count), recordTime, string(c.RepoName), c.RepoID, nil)...)
+	}
+	return recordings, nil
+}
@@ -0,0 +1,101 @@
+package queryrunner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+)
+
+type fakeSymbolReferenceCounter struct {
+	atCommit map[api.CommitID]int
+	atTip    []RepoReferenceCount
+}
+
+func (f *fakeSymbolReferenceCounter) CountAtCommit(_ context.Context, _ *types.InsightSeries, _ api.RepoID, commit api.CommitID) (int, error) {
+	return f.atCommit[commit], nil
+}
+
+func (f *fakeSymbolReferenceCounter) CountAtTip(context.Context, *types.InsightSeries) ([]RepoReferenceCount, error) {
+	return f.atTip, nil
+}
+
+func TestParseSymbolReferencesScope(t *testing.T) {
+	scope := SymbolReferencesScope{RepoID: 1, RepoName: "github.com/a/a", Commit: "abc"}
+	parsed, err := ParseSymbolReferencesScope(scope.String())
+	require.NoError(t, err)
+	require.Equal(t, scope, parsed)
+
+	parsed, err = ParseSymbolReferencesScope(SymbolReferencesScope{}.String())
+	require.NoError(t, err)
+	require.Equal(t, SymbolReferencesScope{}, parsed)
+
+	_, err = ParseSymbolReferencesScope(`{"repoID":1}`)
+	require.Error(t, err)
+	_, err = ParseSymbolReferencesScope("repo:a b")
+	require.Error(t, err)
+}
+
+func TestGenerateSymbolReferencesRecordings(t *testing.T) {
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	series := &types.InsightSeries{SeriesID: "testseries1", Query: "scip-go gomod a v1 `a`/Func().", GenerationMethod: types.PreciseSymbolReferences}
+	counter := &fakeSymbolReferenceCounter{
+		atCommit: map[api.CommitID]int{"abc": 4},
+		atTip: []RepoReferenceCount{
+			{RepoID: 1, RepoName: "github.com/a/a", Count: 2},
+			{RepoID: 2, RepoName: "github.com/b/b", Count: 0},
+			{RepoID: 3, RepoName: "github.com/c/c", Count: 7},
+		},
+	}
+
+	t.Run("at commit", func(t *testing.T) {
+		job := &SearchJob{
+			SeriesID:    "testseries1",
+			SearchQuery: SymbolReferencesScope{RepoID: 1, RepoName: "github.com/a/a", Commit: "abc"}.String(),
+			PersistMode: "record",
+		}
+		recordings, err := generateSymbolReferencesRecordings(context.Background(), job, series, date, counter, logtest.Scoped(t))
+		require.NoError(t, err)
+		require.Equal(t, []string{"github.com/a/a 1 2024-01-01 00:00:00 +0000 UTC  4.000000"}, stringify(recordings))
+	})
+
+	t.Run("at tip", func(t *testing.T) {
+		job := &SearchJob{
+			SeriesID:        "testseries1",
+			SearchQuery:     SymbolReferencesScope{}.String(),
+			PersistMode:     "snapshot",
+			DependentFrames: []time.Time{date.AddDate(0, -1, 0)},
+		}
+		recordings, err := generateSymbolReferencesRecordings(context.Background(), job, series, date, counter, logtest.Scoped(t))
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"github.com/a/a 1 2023-12-01 00:00:00 +0000 UTC  2.000000",
+			"github.com/a/a 1 2024-01-01 00:00:00 +0000 UTC  2.000000",
+			"github.com/c/c 3 2023-12-01 00:00:00 +0000 UTC  7.000000",
+			"github.com/c/c 3 2024-01-01 00:00:00 +0000 UTC  7.000000",
+		}, stringify(recordings))
+	})
+
+	t.Run("sub-repo permissions", func(t *testing.T) {
+		checker := authz.NewMockSubRepoPermissionChecker()
+		checker.EnabledFunc.SetDefaultHook(func() bool {
+			return true
+		})
+		checker.EnabledForRepoIDFunc.SetDefaultHook(func(ctx context.Context, id api.RepoID) (bool, error) {
+			return id == 3, nil
+		})
+		authz.DefaultSubRepoPermsChecker = checker
+		t.Cleanup(func() { authz.DefaultSubRepoPermsChecker = nil })
+
+		job := &SearchJob{SeriesID: "testseries1", SearchQuery: SymbolReferencesScope{}.String(), PersistMode: "record"}
+		recordings, err := generateSymbolReferencesRecordings(context.Background(), job, series, date, counter, logtest.Scoped(t))
+		require.NoError(t, err)
+		require.Equal(t, []string{"github.com/a/a 1 2024-01-01 00:00:00 +0000 UTC  2.000000"}, stringify(recordings))
+	})
+}
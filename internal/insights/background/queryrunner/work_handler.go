@@ -36,6 +36,13 @@ type workHandler struct {
 	seriesCache map[string]*types.InsightSeries
 
 	searchHandlers map[types.GenerationMethod]InsightsHandler
+	alerts         SeriesAlertEvaluator
+}
+
+// SeriesAlertEvaluator evaluates the alerts of an insight series against its
+// most recently recorded point.
+type SeriesAlertEvaluator interface {
+	EvaluateSeries(ctx context.Context, seriesID string) error
 }
 
 type InsightsHandler func(ctx context.Context, job *SearchJob, series *types.InsightSeries, recordTime time.Time) ([]store.RecordSeriesPointArgs, error)
@@ -142,7 +149,20 @@ func (r *workHandler) Handle(ctx context.Context, logger log.Logger, record *Job
 		return err
 	}
 
-	return r.persistRecordings(ctx, &job.SearchJob, series, recordings, recordTime)
+	if err := r.persistRecordings(ctx, &job.SearchJob, series, recordings, recordTime); err != nil {
+		return err
+	}
+
+	// Alerts are evaluated as soon as a new point of the series is recorded. Snapshots are not
+	// recorded points, and jobs with a record time backfill points of the past.
+	if r.alerts != nil && isGlobal && job.PersistMode == string(store.RecordMode) {
+		// The points are already recorded, so we don't fail the job: retrying it would record them
+		// again. Alerts which weren't evaluated are evaluated after the next recording.
+		if alertErr := r.alerts.EvaluateSeries(ctx, series.SeriesID); alertErr != nil {
+			logger.Error("evaluating insight series alerts", log.String("seriesID", series.SeriesID), log.Error(alertErr))
+		}
+	}
+	return nil
 }
 
 func TranslateIncompleteReasons(err error) store.IncompleteReason {
@@ -220,13 +220,18 @@ func makeHistoricalSearchJobFunc(logger log.Logger, commitClient GitCommitClient
 	return func(ctx context.Context, bctx *buildSeriesContext) (err error, job *queryrunner.SearchJob, preempted []store.RecordSeriesPointArgs) {
 		logger.Debug("making search job")
 		rawQuery := bctx.series.Query
-		containsRepo, err := querybuilder.ContainsField(rawQuery, query.FieldRepo)
-		if err != nil {
-			return err, nil, nil
-		}
-		if containsRepo {
-			// This maintains existing behavior that searches with a repo filter are ignored
-			return nil, nil, nil
+		// The query of series generated from symbol references is a symbol,
+		// not a search query.
+		symbolReferences := bctx.series.GenerationMethod.IsSymbolReferences()
+		if !symbolReferences {
+			containsRepo, err := querybuilder.ContainsField(rawQuery, query.FieldRepo)
+			if err != nil {
+				return err, nil, nil
+			}
+			if containsRepo {
+				// This maintains existing behavior that searches with a repo filter are ignored
+				return nil, nil, nil
+			}
 		}
 
 		// Optimization: If the timeframe we're building data for starts (or ends) before the first commit in the
@@ -262,6 +267,21 @@ func makeHistoricalSearchJobFunc(logger log.Logger, commitClient GitCommitClient
 			revision = string(nearestCommit.ID)
 		}
 
+		if symbolReferences {
+			job = &queryrunner.SearchJob{
+				SeriesID: bctx.seriesID,
+				SearchQuery: queryrunner.SymbolReferencesScope{
+					RepoID:   bctx.id,
+					RepoName: bctx.repoName,
+					Commit:   api.CommitID(revision),
+				}.String(),
+				RecordTime:      &bctx.execution.RecordingTime,
+				PersistMode:     string(store.RecordMode),
+				DependentFrames: bctx.execution.SharedRecordings,
+			}
+			return err, job, preempted
+		}
+
 		// Construct the search query that will generate data for this repository and time (revision) tuple.
 		var newQueryStr string
 		modifiedQuery, err := querybuilder.SingleRepoQuery(querybuilder.BasicQuery(rawQuery), repoName, revision, querybuilder.CodeInsightsQueryDefaults(len(bctx.series.Repositories) == 0))
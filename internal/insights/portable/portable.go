@@ -0,0 +1,291 @@
+// Package portable defines the JSON format code insights dashboards are
+// exported to and imported from, so that they can be moved between instances
+// and kept in version control.
+//
+// The format is versioned. Any change which older versions of Sourcegraph
+// cannot read must increment Version.
+package portable
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/insights/timeseries"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Version is the version of the format written by Marshal.
+const Version = 1
+
+// Dashboard is an exported dashboard and the insights on it.
+type Dashboard struct {
+	Version  int       `json:"version"`
+	Title    string    `json:"title"`
+	Insights []Insight `json:"insights"`
+}
+
+// Insight is an exported insight view.
+type Insight struct {
+	Title          string        `json:"title"`
+	Description    string        `json:"description,omitempty"`
+	Presentation   string        `json:"presentation"`
+	OtherThreshold *float64      `json:"otherThreshold,omitempty"`
+	Filters        Filters       `json:"filters"`
+	SeriesOptions  SeriesOptions `json:"seriesOptions"`
+	Series         []Series      `json:"series"`
+}
+
+// Filters are the default filters of an insight view.
+type Filters struct {
+	IncludeRepoRegex *string  `json:"includeRepoRegex,omitempty"`
+	ExcludeRepoRegex *string  `json:"excludeRepoRegex,omitempty"`
+	SearchContexts   []string `json:"searchContexts,omitempty"`
+}
+
+// SeriesOptions are the default display options of the series of an insight
+// view.
+type SeriesOptions struct {
+	SortMode      *string `json:"sortMode,omitempty"`
+	SortDirection *string `json:"sortDirection,omitempty"`
+	Limit         *int32  `json:"limit,omitempty"`
+	NumSamples    *int32  `json:"numSamples,omitempty"`
+}
+
+// Series is an exported series definition and how it is presented on its
+// insight view, optionally with its recorded points.
+type Series struct {
+	Label                      string   `json:"label,omitempty"`
+	Color                      string   `json:"color,omitempty"`
+	Query                      string   `json:"query"`
+	GenerationMethod           string   `json:"generationMethod"`
+	Repositories               []string `json:"repositories,omitempty"`
+	RepositoryCriteria         *string  `json:"repositoryCriteria,omitempty"`
+	IntervalUnit               string   `json:"intervalUnit"`
+	IntervalValue              int      `json:"intervalValue"`
+	GeneratedFromCaptureGroups bool     `json:"generatedFromCaptureGroups,omitempty"`
+	GroupBy                    *string  `json:"groupBy,omitempty"`
+
+	// RecordingTimes and Points are only set if the recorded points were
+	// exported.
+	RecordingTimes []time.Time `json:"recordingTimes,omitempty"`
+	Points         []Point     `json:"points,omitempty"`
+}
+
+// Point is a recorded point of a series in a repository. Repositories are
+// referenced by name, since their IDs differ between instances.
+type Point struct {
+	Time       time.Time `json:"time"`
+	Repository string    `json:"repository"`
+	Value      float64   `json:"value"`
+	Capture    *string   `json:"capture,omitempty"`
+}
+
+// NewInsight returns the exported form of an insight view.
+func NewInsight(insight types.Insight) Insight {
+	exported := Insight{
+		Title:          insight.Title,
+		Description:    insight.Description,
+		Presentation:   string(insight.PresentationType),
+		OtherThreshold: insight.OtherThreshold,
+		Filters: Filters{
+			IncludeRepoRegex: insight.Filters.IncludeRepoRegex,
+			ExcludeRepoRegex: insight.Filters.ExcludeRepoRegex,
+			SearchContexts:   insight.Filters.SearchContexts,
+		},
+		SeriesOptions: SeriesOptions{
+			Limit:      insight.SeriesOptions.Limit,
+			NumSamples: insight.SeriesOptions.NumSamples,
+		},
+	}
+	if sortOptions := insight.SeriesOptions.SortOptions; sortOptions != nil {
+		mode, direction := string(sortOptions.Mode), string(sortOptions.Direction)
+		exported.SeriesOptions.SortMode = &mode
+		exported.SeriesOptions.SortDirection = &direction
+	}
+	for _, series := range insight.Series {
+		exported.Series = append(exported.Series, NewSeries(series))
+	}
+	return exported
+}
+
+// NewSeries returns the exported form of a series of an insight view, without
+// its points.
+func NewSeries(series types.InsightViewSeries) Series {
+	return Series{
+		Label:                      series.Label,
+		Color:                      series.LineColor,
+		Query:                      series.Query,
+		GenerationMethod:           string(series.GenerationMethod),
+		Repositories:               series.Repositories,
+		RepositoryCriteria:         series.RepositoryCriteria,
+		IntervalUnit:               series.SampleIntervalUnit,
+		IntervalValue:              series.SampleIntervalValue,
+		GeneratedFromCaptureGroups: series.GeneratedFromCaptureGroups,
+		GroupBy:                    series.GroupBy,
+	}
+}
+
+// SetPoints sets the recorded points of a series from the points recorded
+// per repository and the recording times of the series.
+func (s *Series) SetPoints(recordingTimes []types.RecordingTime, points []store.RecordSeriesPointArgs) {
+	s.RecordingTimes = nil
+	for _, rt := range recordingTimes {
+		if !rt.Snapshot {
+			s.RecordingTimes = append(s.RecordingTimes, rt.Timestamp.UTC())
+		}
+	}
+	sort.Slice(s.RecordingTimes, func(i, j int) bool { return s.RecordingTimes[i].Before(s.RecordingTimes[j]) })
+
+	s.Points = nil
+	for _, p := range points {
+		if p.RepoName == nil {
+			continue
+		}
+		s.Points = append(s.Points, Point{
+			Time:       p.Point.Time.UTC(),
+			Repository: *p.RepoName,
+			Value:      p.Point.Value,
+			Capture:    p.Point.Capture,
+		})
+	}
+	sort.SliceStable(s.Points, func(i, j int) bool {
+		a, b := s.Points[i], s.Points[j]
+		if !a.Time.Equal(b.Time) {
+			return a.Time.Before(b.Time)
+		}
+		if a.Repository != b.Repository {
+			return a.Repository < b.Repository
+		}
+		return derefString(a.Capture) < derefString(b.Capture)
+	})
+}
+
+// Marshal returns the JSON form of a dashboard, at the current Version.
+func Marshal(dashboard Dashboard) ([]byte, error) {
+	dashboard.Version = Version
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(dashboard); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal parses and validates the JSON form of a dashboard.
+func Unmarshal(data []byte) (Dashboard, error) {
+	var version struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &version); err != nil {
+		return Dashboard{}, errors.Wrap(err, "invalid dashboard JSON")
+	}
+	if version.Version < 1 || version.Version > Version {
+		return Dashboard{}, errors.Newf("unsupported dashboard format version %d, expected at most %d", version.Version, Version)
+	}
+
+	var dashboard Dashboard
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&dashboard); err != nil {
+		return Dashboard{}, errors.Wrap(err, "invalid dashboard JSON")
+	}
+	if err := dashboard.validate(); err != nil {
+		return Dashboard{}, err
+	}
+	return dashboard, nil
+}
+
+func (d Dashboard) validate() error {
+	if strings.TrimSpace(d.Title) == "" {
+		return errors.New("dashboard title is required")
+	}
+	for i, insight := range d.Insights {
+		if err := insight.validate(); err != nil {
+			return errors.Wrapf(err, "insight %d", i)
+		}
+	}
+	return nil
+}
+
+func (i Insight) validate() error {
+	if len(i.Series) == 0 {
+		return errors.New("at least one series is required")
+	}
+	switch types.PresentationType(i.Presentation) {
+	case types.Line:
+		for _, series := range i.Series {
+			if types.GenerationMethod(series.GenerationMethod) == types.LanguageStats {
+				return errors.New("line charts cannot have language statistics series")
+			}
+		}
+	case types.Pie:
+		if len(i.Series) != 1 || types.GenerationMethod(i.Series[0].GenerationMethod) != types.LanguageStats {
+			return errors.New("pie charts must have a single language statistics series")
+		}
+		if i.OtherThreshold == nil {
+			return errors.New("pie charts require an other threshold")
+		}
+	default:
+		return errors.Newf("unknown presentation %q", i.Presentation)
+	}
+	if mode := i.SeriesOptions.SortMode; mode != nil {
+		switch types.SeriesSortMode(*mode) {
+		case types.ResultCount, types.DateAdded, types.Lexicographical:
+		default:
+			return errors.Newf("unknown series sort mode %q", *mode)
+		}
+	}
+	if direction := i.SeriesOptions.SortDirection; direction != nil {
+		switch types.SeriesSortDirection(*direction) {
+		case types.Asc, types.Desc:
+		default:
+			return errors.Newf("unknown series sort direction %q", *direction)
+		}
+	}
+	if (i.SeriesOptions.SortMode == nil) != (i.SeriesOptions.SortDirection == nil) {
+		return errors.New("series sort mode and direction must be specified together")
+	}
+
+	for n, series := range i.Series {
+		if err := series.validate(); err != nil {
+			return errors.Wrapf(err, "series %d", n)
+		}
+	}
+	return nil
+}
+
+func (s Series) validate() error {
+	if strings.TrimSpace(s.Query) == "" {
+		return errors.New("query is required")
+	}
+	switch types.GenerationMethod(s.GenerationMethod) {
+	case types.Search, types.SearchCompute, types.MappingCompute, types.LanguageStats,
+		types.PreciseSymbolReferences, types.SyntacticSymbolReferences:
+	default:
+		return errors.Newf("unknown generation method %q", s.GenerationMethod)
+	}
+	interval := timeseries.TimeInterval{Unit: types.IntervalUnit(s.IntervalUnit), Value: s.IntervalValue}
+	if !interval.IsValid() && types.GenerationMethod(s.GenerationMethod) != types.LanguageStats {
+		return errors.Newf("invalid interval %d %s", s.IntervalValue, s.IntervalUnit)
+	}
+	for _, p := range s.Points {
+		if p.Repository == "" {
+			return errors.New("points must have a repository")
+		}
+	}
+	return nil
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
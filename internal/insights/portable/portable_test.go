@@ -0,0 +1,121 @@
+package portable
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+)
+
+func TestRoundTrip(t *testing.T) {
+	include := "^github\\.com/sourcegraph/"
+	limit := int32(5)
+	insight := NewInsight(types.Insight{
+		Title:            "TODOs",
+		PresentationType: types.Line,
+		Filters:          types.InsightViewFilters{IncludeRepoRegex: &include},
+		SeriesOptions: types.SeriesDisplayOptions{
+			SortOptions: &types.SeriesSortOptions{Mode: types.ResultCount, Direction: types.Desc},
+			Limit:       &limit,
+		},
+		Series: []types.InsightViewSeries{{
+			SeriesID:            "s1",
+			Label:               "TODO",
+			LineColor:           "#fff",
+			Query:               "TODO",
+			GenerationMethod:    types.Search,
+			Repositories:        []string{"github.com/sourcegraph/a"},
+			SampleIntervalUnit:  string(types.Month),
+			SampleIntervalValue: 1,
+		}},
+	})
+
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.AddDate(0, 1, 0)
+	repoA, repoB := "github.com/sourcegraph/a", "github.com/sourcegraph/b"
+	idA, idB := api.RepoID(1), api.RepoID(2)
+	insight.Series[0].SetPoints(
+		[]types.RecordingTime{{Timestamp: t1}, {Timestamp: t0}, {Timestamp: t1.AddDate(0, 0, 3), Snapshot: true}},
+		[]store.RecordSeriesPointArgs{
+			{RepoName: &repoB, RepoID: &idB, Point: store.SeriesPoint{Time: t1, Value: 2}},
+			{RepoName: &repoA, RepoID: &idA, Point: store.SeriesPoint{Time: t1, Value: 4}},
+			{RepoName: &repoA, RepoID: &idA, Point: store.SeriesPoint{Time: t0, Value: 3}},
+			{Point: store.SeriesPoint{Time: t0, Value: 10}},
+		},
+	)
+	require.Equal(t, []time.Time{t0, t1}, insight.Series[0].RecordingTimes)
+	require.Equal(t, []Point{
+		{Time: t0, Repository: repoA, Value: 3},
+		{Time: t1, Repository: repoA, Value: 4},
+		{Time: t1, Repository: repoB, Value: 2},
+	}, insight.Series[0].Points)
+
+	data, err := Marshal(Dashboard{Title: "Code health", Insights: []Insight{insight}})
+	require.NoError(t, err)
+
+	got, err := Unmarshal(data)
+	require.NoError(t, err)
+	require.Equal(t, Dashboard{Version: Version, Title: "Code health", Insights: []Insight{insight}}, got)
+
+	again, err := Marshal(got)
+	require.NoError(t, err)
+	require.Equal(t, string(data), string(again))
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			name: "missing version",
+			data: `{"title": "a", "insights": []}`,
+			want: "unsupported dashboard format version 0",
+		},
+		{
+			name: "future version",
+			data: `{"version": 2, "title": "a", "insights": []}`,
+			want: "unsupported dashboard format version 2",
+		},
+		{
+			name: "unknown field",
+			data: `{"version": 1, "title": "a", "insights": [], "owner": "me"}`,
+			want: "unknown field",
+		},
+		{
+			name: "missing title",
+			data: `{"version": 1, "title": " ", "insights": []}`,
+			want: "dashboard title is required",
+		},
+		{
+			name: "no series",
+			data: `{"version": 1, "title": "a", "insights": [{"title": "b", "presentation": "LINE", "filters": {}, "seriesOptions": {}, "series": []}]}`,
+			want: "at least one series is required",
+		},
+		{
+			name: "unknown generation method",
+			data: `{"version": 1, "title": "a", "insights": [{"title": "b", "presentation": "LINE", "filters": {}, "seriesOptions": {}, "series": [{"query": "x", "generationMethod": "magic", "intervalUnit": "MONTH", "intervalValue": 1}]}]}`,
+			want: "unknown generation method",
+		},
+		{
+			name: "pie chart with search series",
+			data: `{"version": 1, "title": "a", "insights": [{"title": "b", "presentation": "PIE", "otherThreshold": 0.03, "filters": {}, "seriesOptions": {}, "series": [{"query": "x", "generationMethod": "search", "intervalUnit": "MONTH", "intervalValue": 1}]}]}`,
+			want: "pie charts must have a single language statistics series",
+		},
+		{
+			name: "invalid interval",
+			data: `{"version": 1, "title": "a", "insights": [{"title": "b", "presentation": "LINE", "filters": {}, "seriesOptions": {}, "series": [{"query": "x", "generationMethod": "search", "intervalUnit": "FORTNIGHT", "intervalValue": 1}]}]}`,
+			want: "invalid interval",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Unmarshal([]byte(tc.data))
+			require.ErrorContains(t, err, tc.want)
+		})
+	}
+}
@@ -2,6 +2,8 @@ package querybuilder
 
 import (
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -495,6 +497,85 @@ func AddRepoMetadataFilter(query BasicQuery, repoMeta string) (BasicQuery, error
 	return BasicQuery(searchquery.StringHuman(mutatedQuery.ToQ())), nil
 }
 
+// AddDirectoryFilter restricts a query to the files below a directory, as
+// grouped by the DIRECTORY aggregation mode.
+func AddDirectoryFilter(query BasicQuery, directory string) (BasicQuery, error) {
+	value := fmt.Sprintf("^%s/", regexp.QuoteMeta(directory))
+	if directory == types.ROOT_DIRECTORY_TEXT {
+		value = "^[^/]+$"
+	}
+	return addParameters(query, searchquery.Parameter{Field: searchquery.FieldFile, Value: value})
+}
+
+func AddLanguageFilter(query BasicQuery, language string) (BasicQuery, error) {
+	if language == types.NO_LANGUAGE_TEXT {
+		return query, errors.New("Can't search for files without a language")
+	}
+	if strings.Contains(language, " ") {
+		language = strconv.Quote(language)
+	}
+	return addParameters(query, searchquery.Parameter{Field: searchquery.FieldLang, Value: language})
+}
+
+// AddOwnerFilter restricts a query to the files owned by owner, as grouped by
+// the OWNER aggregation mode. Files without an owner are matched by excluding
+// all owned files.
+func AddOwnerFilter(query BasicQuery, owner string) (BasicQuery, error) {
+	if owner == types.NO_OWNER_TEXT {
+		return addParameters(query, searchquery.Parameter{Field: searchquery.FieldFile, Value: "has.owner()", Negated: true})
+	}
+	return addParameters(query, searchquery.Parameter{Field: searchquery.FieldFile, Value: fmt.Sprint("has.owner(", owner, ")")})
+}
+
+// AddSymbolKindFilter replaces any select: filter of a query with a selection
+// of the symbols of the given kind.
+func AddSymbolKindFilter(query BasicQuery, kind string) (BasicQuery, error) {
+	return replaceParameters(query, []string{searchquery.FieldSelect}, searchquery.Parameter{
+		Field: searchquery.FieldSelect,
+		Value: "symbol." + strings.ToLower(kind),
+	})
+}
+
+// AddCommitMonthFilter replaces any after: and before: filters of a query with
+// the bounds of a month in the format "2006-01", as grouped by the
+// COMMIT_MONTH aggregation mode.
+func AddCommitMonthFilter(query BasicQuery, month string) (BasicQuery, error) {
+	start, err := time.Parse("2006-01", month)
+	if err != nil {
+		return query, errors.Wrap(err, "invalid month")
+	}
+	return replaceParameters(query, []string{searchquery.FieldAfter, searchquery.FieldBefore},
+		searchquery.Parameter{Field: searchquery.FieldAfter, Value: strconv.Quote(start.Format(time.DateOnly))},
+		searchquery.Parameter{Field: searchquery.FieldBefore, Value: strconv.Quote(start.AddDate(0, 1, 0).Format(time.DateOnly))},
+	)
+}
+
+func addParameters(query BasicQuery, params ...searchquery.Parameter) (BasicQuery, error) {
+	return replaceParameters(query, nil, params...)
+}
+
+// replaceParameters drops the parameters of the given fields from every step
+// of a query plan and appends params.
+func replaceParameters(query BasicQuery, fields []string, params ...searchquery.Parameter) (BasicQuery, error) {
+	plan, err := searchquery.Pipeline(searchquery.Init(string(query), searchquery.SearchTypeLiteral))
+	if err != nil {
+		return "", err
+	}
+
+	mutatedQuery := searchquery.MapPlan(plan, func(basic searchquery.Basic) searchquery.Basic {
+		modified := make([]searchquery.Parameter, 0, len(basic.Parameters)+len(params))
+		for _, parameter := range basic.Parameters {
+			if slices.Contains(fields, parameter.Field) {
+				continue
+			}
+			modified = append(modified, parameter)
+		}
+		modified = append(modified, params...)
+		return basic.MapParameters(modified)
+	})
+	return BasicQuery(searchquery.StringHuman(mutatedQuery.ToQ())), nil
+}
+
 func buildFilterText(raw string) string {
 	quoted := regexp.QuoteMeta(raw)
 	if strings.Contains(raw, " ") {
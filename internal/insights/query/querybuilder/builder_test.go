@@ -652,6 +652,83 @@ func Test_addRepoMetadataFilter(t *testing.T) {
 	}
 }
 
+func Test_addGroupFilters(t *testing.T) {
+	tests := []struct {
+		name  string
+		add   func(BasicQuery, string) (BasicQuery, error)
+		input string
+		value string
+		want  autogold.Value
+	}{
+		{
+			name:  "directory",
+			add:   AddDirectoryFilter,
+			input: "myquery",
+			value: "cmd/frontend",
+			want:  autogold.Expect(BasicQuery("file:^cmd/frontend/ myquery")),
+		},
+		{
+			name:  "root directory",
+			add:   AddDirectoryFilter,
+			input: "myquery repo:supergreat",
+			value: "/",
+			want:  autogold.Expect(BasicQuery("repo:supergreat file:^[^/]+$ myquery")),
+		},
+		{
+			name:  "language",
+			add:   AddLanguageFilter,
+			input: "myquery",
+			value: "Protocol Buffer",
+			want:  autogold.Expect(BasicQuery(`lang:"Protocol Buffer" myquery`)),
+		},
+		{
+			name:  "unknown language",
+			add:   AddLanguageFilter,
+			input: "myquery",
+			value: "Unknown language",
+			want:  autogold.Expect("Can't search for files without a language"),
+		},
+		{
+			name:  "owner",
+			add:   AddOwnerFilter,
+			input: "myquery",
+			value: "@alice",
+			want:  autogold.Expect(BasicQuery("file:has.owner(@alice) myquery")),
+		},
+		{
+			name:  "no owner",
+			add:   AddOwnerFilter,
+			input: "myquery",
+			value: "No owner",
+			want:  autogold.Expect(BasicQuery("-file:has.owner() myquery")),
+		},
+		{
+			name:  "symbol kind replaces select",
+			add:   AddSymbolKindFilter,
+			input: "myquery select:symbol.class",
+			value: "METHOD",
+			want:  autogold.Expect(BasicQuery("select:symbol.method myquery")),
+		},
+		{
+			name:  "commit month replaces date bounds",
+			add:   AddCommitMonthFilter,
+			input: "type:diff after:2020 myquery",
+			value: "2024-12",
+			want:  autogold.Expect(BasicQuery(`type:diff after:"2024-12-01" before:"2025-01-01" myquery`)),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.add(BasicQuery(test.input), test.value)
+			if err != nil {
+				test.want.Equal(t, err.Error())
+			} else {
+				test.want.Equal(t, got)
+			}
+		})
+	}
+}
+
 func TestRepositoryScopeQuery(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -0,0 +1,203 @@
+// Package symbolreferences counts references to SCIP symbols in code
+// intelligence indexes, for insight series generated from symbol references.
+package symbolreferences
+
+import (
+	"context"
+	"sort"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/core"
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/insights/background/queryrunner"
+	"github.com/sourcegraph/sourcegraph/internal/insights/discovery"
+	"github.com/sourcegraph/sourcegraph/internal/insights/query"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// CodenavService is the subset of the codenav service used to count
+// references.
+type CodenavService interface {
+	GetClosestCompletedUploadsForBlob(ctx context.Context, opts uploadsshared.UploadMatchingOptions) ([]uploadsshared.CompletedUpload, error)
+	CountSymbolReferences(ctx context.Context, symbol string, uploadIDs []int) (int, error)
+}
+
+// UploadsService is the subset of the uploads service used to find the
+// indexes visible at the tip of the default branch of repositories.
+type UploadsService interface {
+	GetUploads(ctx context.Context, opts uploadsshared.GetUploadsOptions) ([]uploadsshared.Upload, int, error)
+}
+
+// uploadsPageSize is the number of uploads fetched at once when listing the
+// uploads visible at the tip of repositories.
+const uploadsPageSize = 1000
+
+// NewCounter returns a queryrunner.SymbolReferenceCounter which counts
+// references using code intelligence indexes. The repositories in scope of a
+// series are resolved with repoStore and repoQueryExecutor.
+func NewCounter(codenav CodenavService, uploads UploadsService, repoStore discovery.RepoStore, repoQueryExecutor query.RepoQueryExecutor) queryrunner.SymbolReferenceCounter {
+	return &counter{
+		codenav:           codenav,
+		uploads:           uploads,
+		repoStore:         repoStore,
+		repoQueryExecutor: repoQueryExecutor,
+	}
+}
+
+type counter struct {
+	codenav           CodenavService
+	uploads           UploadsService
+	repoStore         discovery.RepoStore
+	repoQueryExecutor query.RepoQueryExecutor
+}
+
+func (c *counter) CountAtCommit(ctx context.Context, series *types.InsightSeries, repo api.RepoID, commit api.CommitID) (int, error) {
+	indexer, err := indexerFor(series)
+	if err != nil {
+		return 0, err
+	}
+	// The uploads closest to commit in the commit graph are those code
+	// navigation would use for commit, which may be historical uploads.
+	uploads, err := c.codenav.GetClosestCompletedUploadsForBlob(ctx, uploadsshared.UploadMatchingOptions{
+		RepositoryID:       repo,
+		Commit:             commit,
+		Path:               core.NewRepoRelPathUnchecked(""),
+		RootToPathMatching: uploadsshared.RootEnclosesPathOrPathEnclosesRoot,
+		Indexer:            indexer,
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "GetClosestCompletedUploadsForBlob")
+	}
+	if len(uploads) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]int, 0, len(uploads))
+	for _, upload := range uploads {
+		ids = append(ids, upload.ID)
+	}
+	return c.codenav.CountSymbolReferences(ctx, series.Query, ids)
+}
+
+func (c *counter) CountAtTip(ctx context.Context, series *types.InsightSeries) ([]queryrunner.RepoReferenceCount, error) {
+	indexer, err := indexerFor(series)
+	if err != nil {
+		return nil, err
+	}
+
+	var uploads []uploadsshared.Upload
+	repos, err := c.reposInScope(ctx, series)
+	if err != nil {
+		return nil, err
+	}
+	if repos == nil {
+		// Only repositories with uploads can have references, so global
+		// series list uploads instead of repositories.
+		uploads, err = c.visibleUploads(ctx, 0, indexer)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for _, repo := range repos {
+			repoUploads, err := c.visibleUploads(ctx, repo, indexer)
+			if err != nil {
+				return nil, err
+			}
+			uploads = append(uploads, repoUploads...)
+		}
+	}
+
+	uploadIDs := map[api.RepoID][]int{}
+	repoNames := map[api.RepoID]api.RepoName{}
+	for _, upload := range uploads {
+		repo := api.RepoID(upload.RepositoryID)
+		uploadIDs[repo] = append(uploadIDs[repo], upload.ID)
+		repoNames[repo] = api.RepoName(upload.RepositoryName)
+	}
+
+	counts := make([]queryrunner.RepoReferenceCount, 0, len(uploadIDs))
+	for repo, ids := range uploadIDs {
+		count, err := c.codenav.CountSymbolReferences(ctx, series.Query, ids)
+		if err != nil {
+			return nil, errors.Wrapf(err, "CountSymbolReferences repo:%s", repoNames[repo])
+		}
+		if count == 0 {
+			continue
+		}
+		counts = append(counts, queryrunner.RepoReferenceCount{RepoID: repo, RepoName: repoNames[repo], Count: count})
+	}
+	sort.Slice(counts, func(i, j int) bool { return counts[i].RepoID < counts[j].RepoID })
+	return counts, nil
+}
+
+// reposInScope returns the repositories in scope of series, or nil if every
+// repository is in scope.
+func (c *counter) reposInScope(ctx context.Context, series *types.InsightSeries) ([]api.RepoID, error) {
+	var iterator discovery.RepoIterator
+	var err error
+	switch {
+	case len(series.Repositories) > 0:
+		iterator, err = discovery.NewScopedRepoIterator(ctx, series.Repositories, c.repoStore)
+	case series.RepositoryCriteria != nil:
+		iterator, err = discovery.NewRepoIteratorFromQuery(ctx, *series.RepositoryCriteria, c.repoQueryExecutor)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "resolving series repositories")
+	}
+
+	repos := []api.RepoID{}
+	err = iterator.ForEach(ctx, func(_ string, id api.RepoID) error {
+		repos = append(repos, id)
+		return nil
+	})
+	return repos, err
+}
+
+// visibleUploads returns the completed uploads of indexer visible at the tip
+// of the default branch of repo, or of every repository if repo is 0.
+func (c *counter) visibleUploads(ctx context.Context, repo api.RepoID, indexer string) ([]uploadsshared.Upload, error) {
+	opts := uploadsshared.GetUploadsOptions{
+		RepositoryID: int(repo),
+		State:        string(uploadsshared.StateCompleted),
+		VisibleAtTip: true,
+		Limit:        uploadsPageSize,
+	}
+	if indexer != "" {
+		opts.IndexerNames = []string{indexer}
+	}
+
+	var uploads []uploadsshared.Upload
+	for {
+		page, totalCount, err := c.uploads.GetUploads(ctx, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetUploads")
+		}
+		for _, upload := range page {
+			// Precise series only count precise indexes.
+			if indexer == "" && upload.Indexer == uploadsshared.SyntacticIndexer {
+				continue
+			}
+			uploads = append(uploads, upload)
+		}
+		opts.Offset += len(page)
+		if len(page) == 0 || opts.Offset >= totalCount {
+			return uploads, nil
+		}
+	}
+}
+
+// indexerFor returns the indexer whose uploads are counted for series, using
+// the conventions of uploadsshared.UploadMatchingOptions.
+func indexerFor(series *types.InsightSeries) (string, error) {
+	switch series.GenerationMethod {
+	case types.PreciseSymbolReferences:
+		return "", nil
+	case types.SyntacticSymbolReferences:
+		return uploadsshared.SyntacticIndexer, nil
+	default:
+		return "", errors.Newf("series %s does not count symbol references", series.SeriesID)
+	}
+}
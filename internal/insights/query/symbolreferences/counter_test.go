@@ -0,0 +1,134 @@
+package symbolreferences
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/insights/background/queryrunner"
+	"github.com/sourcegraph/sourcegraph/internal/insights/discovery"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	itypes "github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+const symbol = "scip-go gomod github.com/example/lib v1.0.0 `github.com/example/lib`/Func()."
+
+// fakeCodenav counts one reference per upload, so counts identify the uploads
+// they were computed from.
+type fakeCodenav struct {
+	closest []uploadsshared.CompletedUpload
+	counted [][]int
+}
+
+func (f *fakeCodenav) GetClosestCompletedUploadsForBlob(_ context.Context, opts uploadsshared.UploadMatchingOptions) ([]uploadsshared.CompletedUpload, error) {
+	var uploads []uploadsshared.CompletedUpload
+	for _, upload := range f.closest {
+		if upload.RepositoryID == int(opts.RepositoryID) && upload.Commit == string(opts.Commit) && upload.Indexer == opts.Indexer {
+			uploads = append(uploads, upload)
+		}
+	}
+	return uploads, nil
+}
+
+func (f *fakeCodenav) CountSymbolReferences(_ context.Context, _ string, uploadIDs []int) (int, error) {
+	ids := append([]int(nil), uploadIDs...)
+	sort.Ints(ids)
+	f.counted = append(f.counted, ids)
+	return len(uploadIDs), nil
+}
+
+type fakeUploads struct {
+	uploads []uploadsshared.Upload
+}
+
+func (f *fakeUploads) GetUploads(_ context.Context, opts uploadsshared.GetUploadsOptions) ([]uploadsshared.Upload, int, error) {
+	var matching []uploadsshared.Upload
+	for _, upload := range f.uploads {
+		if opts.RepositoryID != 0 && upload.RepositoryID != opts.RepositoryID {
+			continue
+		}
+		if len(opts.IndexerNames) > 0 && upload.Indexer != opts.IndexerNames[0] {
+			continue
+		}
+		matching = append(matching, upload)
+	}
+	total := len(matching)
+	if opts.Offset >= total {
+		return nil, total, nil
+	}
+	matching = matching[opts.Offset:]
+	if len(matching) > opts.Limit {
+		matching = matching[:opts.Limit]
+	}
+	return matching, total, nil
+}
+
+func TestCountAtCommit(t *testing.T) {
+	codenav := &fakeCodenav{closest: []uploadsshared.CompletedUpload{
+		{ID: 1, RepositoryID: 1, Commit: "c1", Indexer: ""},
+		{ID: 2, RepositoryID: 1, Commit: "c1", Indexer: ""},
+		{ID: 3, RepositoryID: 1, Commit: "c1", Indexer: uploadsshared.SyntacticIndexer},
+	}}
+	c := NewCounter(codenav, &fakeUploads{}, nil, nil)
+
+	count, err := c.CountAtCommit(context.Background(), &types.InsightSeries{Query: symbol, GenerationMethod: types.PreciseSymbolReferences}, 1, "c1")
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	count, err = c.CountAtCommit(context.Background(), &types.InsightSeries{Query: symbol, GenerationMethod: types.SyntacticSymbolReferences}, 1, "c1")
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	// Commits without uploads have no references.
+	count, err = c.CountAtCommit(context.Background(), &types.InsightSeries{Query: symbol, GenerationMethod: types.PreciseSymbolReferences}, 1, "c2")
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+	require.Len(t, codenav.counted, 2)
+
+	_, err = c.CountAtCommit(context.Background(), &types.InsightSeries{Query: symbol, GenerationMethod: types.Search}, 1, "c1")
+	require.Error(t, err)
+}
+
+func TestCountAtTip(t *testing.T) {
+	var uploads []uploadsshared.Upload
+	// More uploads than fit in a page for repo 1.
+	for id := 1; id <= uploadsPageSize+1; id++ {
+		uploads = append(uploads, uploadsshared.Upload{ID: id, RepositoryID: 1, RepositoryName: "github.com/a/a", Indexer: "scip-go"})
+	}
+	uploads = append(uploads,
+		uploadsshared.Upload{ID: 5000, RepositoryID: 2, RepositoryName: "github.com/b/b", Indexer: uploadsshared.SyntacticIndexer},
+		uploadsshared.Upload{ID: 5001, RepositoryID: 3, RepositoryName: "github.com/c/c", Indexer: "scip-typescript"},
+	)
+
+	repoStore := discovery.NewMockRepoStore()
+	repoStore.ListFunc.SetDefaultHook(func(_ context.Context, opts database.ReposListOptions) ([]*itypes.Repo, error) {
+		require.Equal(t, []string{"github.com/c/c"}, opts.Names)
+		return []*itypes.Repo{{ID: 3, Name: "github.com/c/c"}}, nil
+	})
+	c := NewCounter(&fakeCodenav{}, &fakeUploads{uploads: uploads}, repoStore, nil)
+
+	t.Run("global precise", func(t *testing.T) {
+		counts, err := c.CountAtTip(context.Background(), &types.InsightSeries{Query: symbol, GenerationMethod: types.PreciseSymbolReferences})
+		require.NoError(t, err)
+		require.Equal(t, []queryrunner.RepoReferenceCount{
+			{RepoID: 1, RepoName: "github.com/a/a", Count: uploadsPageSize + 1},
+			{RepoID: 3, RepoName: "github.com/c/c", Count: 1},
+		}, counts)
+	})
+
+	t.Run("global syntactic", func(t *testing.T) {
+		counts, err := c.CountAtTip(context.Background(), &types.InsightSeries{Query: symbol, GenerationMethod: types.SyntacticSymbolReferences})
+		require.NoError(t, err)
+		require.Equal(t, []queryrunner.RepoReferenceCount{{RepoID: 2, RepoName: "github.com/b/b", Count: 1}}, counts)
+	})
+
+	t.Run("scoped", func(t *testing.T) {
+		counts, err := c.CountAtTip(context.Background(), &types.InsightSeries{Query: symbol, GenerationMethod: types.PreciseSymbolReferences, Repositories: []string{"github.com/c/c"}})
+		require.NoError(t, err)
+		require.Equal(t, []queryrunner.RepoReferenceCount{{RepoID: 3, RepoName: "github.com/c/c", Count: 1}}, counts)
+	})
+}
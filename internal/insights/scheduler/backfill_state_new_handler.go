@@ -174,6 +174,11 @@ func (h *newBackfillHandler) Handle(ctx context.Context, logger log.Logger, job
 }
 
 func parseQuery(series types.InsightSeries) (query.Plan, error) {
+	if series.GenerationMethod.IsSymbolReferences() {
+		// The query is a symbol, which is costed by the number of
+		// repositories alone.
+		return nil, nil
+	}
 	if series.GeneratedFromCaptureGroups {
 		seriesQuery, err := compute.Parse(series.Query)
 		if err != nil {
@@ -0,0 +1,139 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	edb "github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// AlertStore persists the alert rules of insight series.
+type AlertStore struct {
+	*basestore.Store
+	Now func() time.Time
+}
+
+// NewAlertStore returns a new AlertStore backed by the given Postgres db.
+func NewAlertStore(db edb.InsightsDB) *AlertStore {
+	return &AlertStore{Store: basestore.NewWithHandle(db.Handle()), Now: time.Now}
+}
+
+// With creates a new AlertStore with the given basestore.Shareable store as the underlying basestore.Store.
+// Needed to implement the basestore.Store interface
+func (s *AlertStore) With(other basestore.ShareableStore) *AlertStore {
+	return &AlertStore{Store: s.Store.With(other), Now: s.Now}
+}
+
+type AlertQueryArgs struct {
+	ID       int
+	SeriesID string
+	UserID   int32
+}
+
+// GetAlerts returns the alerts matching args. Alerts of deleted series are
+// never returned.
+func (s *AlertStore) GetAlerts(ctx context.Context, args AlertQueryArgs) ([]types.InsightSeriesAlert, error) {
+	preds := []*sqlf.Query{sqlf.Sprintf("s.deleted_at IS NULL")}
+	if args.ID > 0 {
+		preds = append(preds, sqlf.Sprintf("a.id = %s", args.ID))
+	}
+	if args.SeriesID != "" {
+		preds = append(preds, sqlf.Sprintf("a.series_id = %s", args.SeriesID))
+	}
+	if args.UserID > 0 {
+		preds = append(preds, sqlf.Sprintf("a.user_id = %s", args.UserID))
+	}
+	return scanAlerts(s.Query(ctx, sqlf.Sprintf(getAlertsSql, sqlf.Join(preds, "AND"))))
+}
+
+const getAlertsSql = `
+SELECT a.id, a.series_id, a.kind, a.threshold, a.action_type, a.action_url, a.user_id,
+	a.last_evaluated_at, a.last_fired_at, a.created_at
+FROM insight_series_alerts a
+JOIN insight_series s ON s.series_id = a.series_id
+WHERE %s
+ORDER BY a.id
+`
+
+// CreateAlert creates an alert and returns it.
+func (s *AlertStore) CreateAlert(ctx context.Context, alert types.InsightSeriesAlert) (types.InsightSeriesAlert, error) {
+	if (alert.Action == types.EmailAlertAction) != (alert.ActionURL == nil) {
+		return types.InsightSeriesAlert{}, errors.New("exactly the Slack webhook and webhook actions require a URL")
+	}
+	row := s.QueryRow(ctx, sqlf.Sprintf(
+		createAlertSql,
+		alert.SeriesID,
+		alert.Kind,
+		alert.Threshold,
+		alert.Action,
+		alert.ActionURL,
+		alert.UserID,
+		s.Now(),
+	))
+	if err := row.Scan(&alert.ID, &alert.CreatedAt); err != nil {
+		return types.InsightSeriesAlert{}, errors.Wrap(err, "CreateAlert")
+	}
+	return alert, nil
+}
+
+const createAlertSql = `
+INSERT INTO insight_series_alerts (series_id, kind, threshold, action_type, action_url, user_id, created_at)
+VALUES (%s, %s, %s, %s, %s, %s, %s)
+RETURNING id, created_at
+`
+
+// DeleteAlert deletes an alert.
+func (s *AlertStore) DeleteAlert(ctx context.Context, id int) error {
+	return s.Exec(ctx, sqlf.Sprintf("DELETE FROM insight_series_alerts WHERE id = %s", id))
+}
+
+// MarkEvaluated records that an alert was evaluated against the point of its
+// series recorded at pointTime, and whether the alert fired.
+func (s *AlertStore) MarkEvaluated(ctx context.Context, id int, pointTime time.Time, fired bool) error {
+	var firedAt *time.Time
+	if fired {
+		now := s.Now()
+		firedAt = &now
+	}
+	return s.Exec(ctx, sqlf.Sprintf(markAlertEvaluatedSql, pointTime, firedAt, id))
+}
+
+const markAlertEvaluatedSql = `
+UPDATE insight_series_alerts
+SET last_evaluated_at = %s, last_fired_at = COALESCE(%s::timestamptz, last_fired_at)
+WHERE id = %s
+`
+
+func scanAlerts(rows *sql.Rows, queryErr error) (_ []types.InsightSeriesAlert, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var results []types.InsightSeriesAlert
+	for rows.Next() {
+		var temp types.InsightSeriesAlert
+		if err := rows.Scan(
+			&temp.ID,
+			&temp.SeriesID,
+			&temp.Kind,
+			&temp.Threshold,
+			&temp.Action,
+			&temp.ActionURL,
+			&temp.UserID,
+			&temp.LastEvaluatedAt,
+			&temp.LastFiredAt,
+			&temp.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		results = append(results, temp)
+	}
+	return results, nil
+}
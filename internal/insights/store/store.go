@@ -191,6 +191,118 @@ func (s *Store) SeriesPoints(ctx context.Context, opts SeriesPointsOpts) ([]Seri
 	return points, nil
 }
 
+// RecordedTotals returns the total value of a series across repositories and
+// captured values at its most recent recording times, most recent first. At
+// most limit points are returned. Snapshots are not included.
+func (s *Store) RecordedTotals(ctx context.Context, seriesID string, limit int) ([]SeriesPoint, error) {
+	// 🚨 SECURITY: Repositories the current user cannot see are excluded from the totals.
+	denylist, err := s.permStore.GetUnauthorizedRepoIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var points []SeriesPoint
+	err = s.query(ctx, sqlf.Sprintf(recordedTotalsSql, seriesID, pq.Array(repoIDsToInt32s(denylist)), limit), func(sc scanner) error {
+		point := SeriesPoint{SeriesID: seriesID}
+		if err := sc.Scan(&point.Time, &point.Value); err != nil {
+			return err
+		}
+		points = append(points, point)
+		return nil
+	})
+	return points, err
+}
+
+const recordedTotalsSql = `
+SELECT sub.time, SUM(sub.value) FROM (
+	SELECT date_trunc('seconds', sp.time) AS time, MAX(sp.value) AS value
+	FROM series_points sp
+	WHERE sp.series_id = %s AND (sp.repo_id IS NULL OR NOT sp.repo_id = ANY(%s))
+	GROUP BY date_trunc('seconds', sp.time), sp.repo_name_id, sp.capture
+) sub
+GROUP BY sub.time
+ORDER BY sub.time DESC
+LIMIT %s
+`
+
+// RepoSeriesValue is the value of a series in a single repository.
+type RepoSeriesValue struct {
+	RepoID   api.RepoID
+	RepoName string
+	Value    float64
+}
+
+// RecordedRepoValues returns the value of a series in each repository at a
+// recording time, summed across captured values.
+func (s *Store) RecordedRepoValues(ctx context.Context, seriesID string, recordingTime time.Time) ([]RepoSeriesValue, error) {
+	// 🚨 SECURITY: Repositories the current user cannot see are excluded.
+	denylist, err := s.permStore.GetUnauthorizedRepoIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []RepoSeriesValue
+	err = s.query(ctx, sqlf.Sprintf(recordedRepoValuesSql, seriesID, recordingTime, pq.Array(repoIDsToInt32s(denylist))), func(sc scanner) error {
+		var value RepoSeriesValue
+		if err := sc.Scan(&value.RepoID, &value.RepoName, &value.Value); err != nil {
+			return err
+		}
+		values = append(values, value)
+		return nil
+	})
+	return values, err
+}
+
+const recordedRepoValuesSql = `
+SELECT sub.repo_id, rn.name, SUM(sub.value) FROM (
+	SELECT sp.repo_id, sp.repo_name_id, MAX(sp.value) AS value
+	FROM series_points sp
+	WHERE sp.series_id = %s AND date_trunc('seconds', sp.time) = %s AND NOT sp.repo_id = ANY(%s)
+	GROUP BY sp.repo_id, sp.repo_name_id, sp.capture
+) sub
+JOIN repo_names rn ON rn.id = sub.repo_name_id
+GROUP BY sub.repo_id, rn.name
+ORDER BY sub.repo_id
+`
+
+// RecordedRepoPoints returns every point recorded for a series in a
+// repository, oldest first. Snapshots and points not associated with a
+// repository are not included.
+func (s *Store) RecordedRepoPoints(ctx context.Context, seriesID string) ([]RecordSeriesPointArgs, error) {
+	// 🚨 SECURITY: Repositories the current user cannot see are excluded.
+	denylist, err := s.permStore.GetUnauthorizedRepoIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var points []RecordSeriesPointArgs
+	err = s.query(ctx, sqlf.Sprintf(recordedRepoPointsSql, seriesID, pq.Array(repoIDsToInt32s(denylist))), func(sc scanner) error {
+		point := RecordSeriesPointArgs{SeriesID: seriesID, Point: SeriesPoint{SeriesID: seriesID}, PersistMode: RecordMode}
+		if err := sc.Scan(&point.Point.Time, &point.Point.Value, &point.Point.Capture, &point.RepoID, &point.RepoName); err != nil {
+			return err
+		}
+		points = append(points, point)
+		return nil
+	})
+	return points, err
+}
+
+const recordedRepoPointsSql = `
+SELECT date_trunc('seconds', sp.time), sp.value, sp.capture, sp.repo_id, rn.name
+FROM series_points sp
+JOIN repo_names rn ON rn.id = sp.repo_name_id
+WHERE sp.series_id = %s AND NOT sp.repo_id = ANY(%s)
+ORDER BY sp.time, rn.name, sp.capture
+`
+
+func repoIDsToInt32s(ids []api.RepoID) []int32 {
+	out := make([]int32, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, int32(id))
+	}
+	return out
+}
+
 func (s *Store) LoadSeriesInMem(ctx context.Context, opts SeriesPointsOpts) (points []SeriesPoint, err error) {
 	denylist, err := s.permStore.GetUnauthorizedRepoIDs(ctx)
 	if err != nil {
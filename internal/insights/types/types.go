@@ -131,6 +131,61 @@ const (
 	SearchCompute  GenerationMethod = "search-compute"
 	LanguageStats  GenerationMethod = "language-stats"
 	MappingCompute GenerationMethod = "mapping-compute"
+
+	// PreciseSymbolReferences and SyntacticSymbolReferences series count the
+	// references to the SCIP symbol held in their query, using precise or
+	// syntactic code intelligence indexes respectively.
+	PreciseSymbolReferences   GenerationMethod = "precise-symbol-references"
+	SyntacticSymbolReferences GenerationMethod = "syntactic-symbol-references"
+)
+
+// IsSymbolReferences returns true if series of this generation method count
+// references to a SCIP symbol rather than search results.
+func (m GenerationMethod) IsSymbolReferences() bool {
+	return m == PreciseSymbolReferences || m == SyntacticSymbolReferences
+}
+
+// InsightSeriesAlert is a rule notifying a user when the recorded values of an
+// insight series meet a condition.
+type InsightSeriesAlert struct {
+	ID        int
+	SeriesID  string
+	Kind      AlertKind
+	Threshold float64
+	Action    AlertActionType
+	// ActionURL is the URL of Slack webhook and webhook actions.
+	ActionURL *string
+	// UserID is the user who created the alert. Alerts are evaluated with the
+	// repository permissions of this user, and emails are sent to them.
+	UserID          int32
+	LastEvaluatedAt *time.Time
+	LastFiredAt     *time.Time
+	CreatedAt       time.Time
+}
+
+// AlertKind is the condition of an InsightSeriesAlert.
+type AlertKind string
+
+const (
+	// AbsoluteThresholdAlert fires when the value of a series reaches the
+	// threshold, having been below it at the previous point.
+	AbsoluteThresholdAlert AlertKind = "ABSOLUTE_THRESHOLD"
+	// PercentChangeAlert fires when the value of a series changes by at least
+	// the threshold percentage, in either direction, between two points.
+	PercentChangeAlert AlertKind = "PERCENT_CHANGE"
+	// RepoThresholdAlert fires when the value of a series in any repository
+	// reaches the threshold, having been below it at the previous point.
+	RepoThresholdAlert AlertKind = "REPO_THRESHOLD"
+)
+
+// AlertActionType is how an InsightSeriesAlert is delivered. These are the
+// action types of code monitors.
+type AlertActionType string
+
+const (
+	EmailAlertAction        AlertActionType = "EMAIL"
+	SlackWebhookAlertAction AlertActionType = "SLACK_WEBHOOK"
+	WebhookAlertAction      AlertActionType = "WEBHOOK"
 )
 
 type Dashboard struct {
@@ -214,9 +269,14 @@ const (
 	AUTHOR_AGGREGATION_MODE        SearchAggregationMode = "AUTHOR"
 	CAPTURE_GROUP_AGGREGATION_MODE SearchAggregationMode = "CAPTURE_GROUP"
 	REPO_METADATA_AGGREGATION_MODE SearchAggregationMode = "REPO_METADATA"
+	DIRECTORY_AGGREGATION_MODE     SearchAggregationMode = "DIRECTORY"
+	OWNER_AGGREGATION_MODE         SearchAggregationMode = "OWNER"
+	LANGUAGE_AGGREGATION_MODE      SearchAggregationMode = "LANGUAGE"
+	SYMBOL_KIND_AGGREGATION_MODE   SearchAggregationMode = "SYMBOL_KIND"
+	COMMIT_MONTH_AGGREGATION_MODE  SearchAggregationMode = "COMMIT_MONTH"
 )
 
-var SearchAggregationModes = []SearchAggregationMode{REPO_AGGREGATION_MODE, PATH_AGGREGATION_MODE, AUTHOR_AGGREGATION_MODE, CAPTURE_GROUP_AGGREGATION_MODE, REPO_METADATA_AGGREGATION_MODE}
+var SearchAggregationModes = []SearchAggregationMode{REPO_AGGREGATION_MODE, PATH_AGGREGATION_MODE, AUTHOR_AGGREGATION_MODE, CAPTURE_GROUP_AGGREGATION_MODE, REPO_METADATA_AGGREGATION_MODE, DIRECTORY_AGGREGATION_MODE, OWNER_AGGREGATION_MODE, LANGUAGE_AGGREGATION_MODE, SYMBOL_KIND_AGGREGATION_MODE, COMMIT_MONTH_AGGREGATION_MODE}
 
 type AggregationNotAvailableReasonType string
 
@@ -230,4 +290,7 @@ const (
 
 const (
 	NO_REPO_METADATA_TEXT = "No metadata"
+	NO_OWNER_TEXT         = "No owner"
+	NO_LANGUAGE_TEXT      = "Unknown language"
+	ROOT_DIRECTORY_TEXT   = "/"
 )
@@ -133,9 +133,12 @@ func (r *analyticsIndexer) codeowners(ctx context.Context, repo *types.Repo, com
 		return noOwners
 	}
 	return func(path string) bool {
-		rule := ruleset.Match(path)
-		owners := rule.GetOwner()
-		return len(owners) > 0
+		for _, rule := range ruleset.MatchAll(path) {
+			if len(rule.GetOwner()) > 0 {
+				return true
+			}
+		}
+		return false
 	}
 }
 
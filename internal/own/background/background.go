@@ -183,6 +183,10 @@ func (h *handler) Handle(ctx context.Context, lgr log.Logger, record *Job) error
 	switch record.ConfigName {
 	case types.SignalRecentContributors:
 		delegate = handleRecentContributors
+	case types.SignalInferredOwnership:
+		delegate = handleInferredOwnership
+	case types.SignalCodeownersLint:
+		delegate = handleCodeownersLint
 	case types.Analytics:
 		delegate = handleAnalytics
 	default:
@@ -0,0 +1,76 @@
+package background
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func handleCodeownersLint(ctx context.Context, lgr log.Logger, repoId api.RepoID, db database.DB) error {
+	// 🚨 SECURITY: we use the internal actor because the background indexer is not associated with any user,
+	// and needs to see all repos and files.
+	internalCtx := actor.WithInternalActor(ctx)
+	indexer := newCodeownersLintIndexer(gitserver.NewClient("own.codeownerslint"), db, lgr)
+	err := indexer.indexRepo(internalCtx, repoId, authz.DefaultSubRepoPermsChecker)
+	if err != nil {
+		lgr.Error("own codeowners lint failure", log.String("msg", err.Error()))
+	}
+	return err
+}
+
+type codeownersLintIndexer struct {
+	client gitserver.Client
+	db     database.DB
+	logger log.Logger
+}
+
+func newCodeownersLintIndexer(client gitserver.Client, db database.DB, lgr log.Logger) *codeownersLintIndexer {
+	return &codeownersLintIndexer{client: client, db: db, logger: lgr}
+}
+
+var ownCodeownersLintReportsCounter = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: "src",
+	Name:      "own_codeowners_lint_reports_total",
+})
+
+// indexRepo lints the CODEOWNERS file of the repository at HEAD and stores the
+// report, replacing the previous report of the repository.
+func (r *codeownersLintIndexer) indexRepo(ctx context.Context, repoId api.RepoID, checker authz.SubRepoPermissionChecker) error {
+	// If the repo has sub-repo perms enabled, skip indexing
+	isSubRepoPermsRepo, err := authz.SubRepoEnabledForRepoID(ctx, checker, repoId)
+	if err != nil {
+		return errcode.MakeNonRetryable(err)
+	} else if isSubRepoPermsRepo {
+		r.logger.Debug("skipping own codeowners lint due to the repo having subrepo perms enabled", log.Int32("repoID", int32(repoId)))
+		return nil
+	}
+
+	repo, err := r.db.Repos().Get(ctx, repoId)
+	if err != nil {
+		return errors.Wrap(err, "repoStore.Get")
+	}
+	commitID, err := r.client.ResolveRevision(ctx, repo.Name, "HEAD", gitserver.ResolveRevisionOptions{EnsureRevision: false})
+	if err != nil {
+		return errcode.MakeNonRetryable(errors.Wrapf(err, "cannot resolve HEAD"))
+	}
+	report, err := own.NewLintService(r.client, r.db).LintCodeowners(ctx, repo.Name, repo.ID, commitID)
+	if err != nil {
+		return errors.Wrap(err, "LintCodeowners")
+	}
+	if err := r.db.CodeownersLintReports().Upsert(ctx, report); err != nil {
+		return errors.Wrap(err, "CodeownersLintReports.Upsert")
+	}
+	ownCodeownersLintReportsCounter.Inc()
+	return nil
+}
@@ -0,0 +1,79 @@
+package background
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/rcache"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestCodeownersLintIndexer(t *testing.T) {
+	rcache.SetupForTest(t)
+	obsCtx := observation.TestContextTB(t)
+	logger := obsCtx.Logger
+	db := database.NewDB(logger, dbtest.NewDB(t))
+	ctx := context.Background()
+	_, err := db.Users().Create(ctx, database.NewUser{Username: "owner"})
+	require.NoError(t, err)
+	var repoID api.RepoID = 1
+	require.NoError(t, db.Repos().Create(ctx, &types.Repo{Name: "repo", ID: repoID}))
+	client := fakeGitServer{
+		files: []string{
+			"CODEOWNERS",
+			"notOwned.go",
+			"owned/file1.go",
+			"owned/file2.go",
+			"owned/nested/file3.go",
+		},
+		fileContents: map[string]string{
+			"CODEOWNERS": "/owned/** @owner\n/removed/** @owner\n*.go @unknown\n",
+		},
+	}
+	checker := authz.NewMockSubRepoPermissionChecker()
+	checker.EnabledFunc.SetDefaultReturn(true)
+	checker.EnabledForRepoIDFunc.SetDefaultReturn(false, nil)
+	require.NoError(t, newCodeownersLintIndexer(client, db, logger).indexRepo(ctx, repoID, checker))
+
+	report, err := db.CodeownersLintReports().GetForRepo(ctx, repoID)
+	require.NoError(t, err)
+	assert.True(t, report.HasCodeowners)
+	assert.Equal(t, 5, report.TotalFiles)
+	assert.Equal(t, 4, report.OwnedFiles)
+	assert.Equal(t, 80.0, report.Coverage())
+	assert.Equal(t, []database.CodeownersLintRule{{Pattern: "/removed/**", LineNumber: 2}}, report.DeadRules)
+	assert.Equal(t, []database.CodeownersLintOwner{{Handle: "unknown", LineNumbers: []int32{3}}}, report.UnknownOwners)
+	assert.Equal(t, []string{"CODEOWNERS"}, report.UnownedPaths)
+}
+
+func TestCodeownersLintIndexerSkipsReposWithSubRepoPerms(t *testing.T) {
+	rcache.SetupForTest(t)
+	obsCtx := observation.TestContextTB(t)
+	logger := obsCtx.Logger
+	db := database.NewDB(logger, dbtest.NewDB(t))
+	ctx := context.Background()
+	var repoID api.RepoID = 1
+	require.NoError(t, db.Repos().Create(ctx, &types.Repo{Name: "repo", ID: repoID}))
+	client := fakeGitServer{
+		files: []string{"owned/file1.go"},
+		fileContents: map[string]string{
+			"CODEOWNERS": "/owned/* @owner",
+		},
+	}
+	checker := authz.NewMockSubRepoPermissionChecker()
+	checker.EnabledFunc.SetDefaultReturn(true)
+	checker.EnabledForRepoIDFunc.SetDefaultReturn(true, nil)
+	require.NoError(t, newCodeownersLintIndexer(client, db, logger).indexRepo(ctx, repoID, checker))
+
+	_, err := db.CodeownersLintReports().GetForRepo(ctx, repoID)
+	assert.True(t, errcode.IsNotFound(err))
+}
@@ -0,0 +1,449 @@
+package background
+
+import (
+	"cmp"
+	"context"
+	"io"
+	"io/fs"
+	"math"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	logger "github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func handleInferredOwnership(ctx context.Context, lgr logger.Logger, repoId api.RepoID, db database.DB) error {
+	// 🚨 SECURITY: we use the internal actor because the background indexer is not associated with any user, and needs
+	// to see all repos and files
+	internalCtx := actor.WithInternalActor(ctx)
+
+	indexer := newInferredOwnershipIndexer(gitserver.NewClient("own.inferredownership"), db, lgr)
+	return indexer.indexRepo(internalCtx, repoId, authz.DefaultSubRepoPermsChecker)
+}
+
+const (
+	DefaultInferredOwnershipHalfLifeDays  = 90
+	DefaultInferredOwnershipLookbackDays  = 365
+	DefaultInferredOwnershipMinConfidence = 0.3
+	DefaultInferredOwnershipMaxOwners     = 3
+	DefaultInferredOwnershipMaxBlameFiles = 500
+)
+
+type inferredOwnershipConfig struct {
+	halfLife      time.Duration
+	lookback      time.Duration
+	minConfidence float64
+	maxOwners     int
+	maxBlameFiles int
+}
+
+func getInferredOwnershipConfig() inferredOwnershipConfig {
+	c := inferredOwnershipConfig{
+		halfLife:      DefaultInferredOwnershipHalfLifeDays * 24 * time.Hour,
+		lookback:      DefaultInferredOwnershipLookbackDays * 24 * time.Hour,
+		minConfidence: DefaultInferredOwnershipMinConfidence,
+		maxOwners:     DefaultInferredOwnershipMaxOwners,
+		maxBlameFiles: DefaultInferredOwnershipMaxBlameFiles,
+	}
+	cfg := conf.Get().SiteConfiguration.OwnInferredOwnership
+	if cfg == nil {
+		return c
+	}
+	if cfg.HalfLifeDays > 0 {
+		c.halfLife = time.Duration(cfg.HalfLifeDays) * 24 * time.Hour
+	}
+	if cfg.LookbackDays > 0 {
+		c.lookback = time.Duration(cfg.LookbackDays) * 24 * time.Hour
+	}
+	if cfg.MinConfidence != nil {
+		c.minConfidence = *cfg.MinConfidence
+	}
+	if cfg.MaxOwnersPerDirectory > 0 {
+		c.maxOwners = cfg.MaxOwnersPerDirectory
+	}
+	if cfg.MaxBlameFiles != nil {
+		c.maxBlameFiles = *cfg.MaxBlameFiles
+	}
+	return c
+}
+
+type inferredOwnershipIndexer struct {
+	client gitserver.Client
+	db     database.DB
+	logger logger.Logger
+	now    func() time.Time
+}
+
+func newInferredOwnershipIndexer(client gitserver.Client, db database.DB, lgr logger.Logger) *inferredOwnershipIndexer {
+	return &inferredOwnershipIndexer{client: client, db: db, logger: lgr, now: time.Now}
+}
+
+var inferredOwnersCounter = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: "src",
+	Name:      "own_inferred_ownership_owners_indexed_total",
+})
+
+func (r *inferredOwnershipIndexer) indexRepo(ctx context.Context, repoId api.RepoID, checker authz.SubRepoPermissionChecker) error {
+	// If the repo has sub-repo perms enabled, skip indexing.
+	isSubRepoPermsRepo, err := authz.SubRepoEnabledForRepoID(ctx, checker, repoId)
+	if err != nil {
+		return errcode.MakeNonRetryable(err)
+	} else if isSubRepoPermsRepo {
+		r.logger.Debug("skipping own inferred ownership signal due to the repo having subrepo perms enabled", logger.Int32("repoID", int32(repoId)))
+		return nil
+	}
+
+	repo, err := r.db.Repos().Get(ctx, repoId)
+	if err != nil {
+		return errors.Wrap(err, "repoStore.Get")
+	}
+
+	cfg := getInferredOwnershipConfig()
+	now := r.now()
+	commits, err := r.client.Commits(ctx, repo.Name, gitserver.CommitsOptions{
+		Order:  gitserver.CommitsOrderTopoDate,
+		After:  now.Add(-cfg.lookback),
+		Ranges: []string{"HEAD"},
+	})
+	if err != nil {
+		return errors.Wrap(err, "Commits")
+	}
+
+	scorer := newOwnershipScorer(now, cfg.halfLife)
+	// lastChanged is the time of the most recent change of each file, used to
+	// pick the files to blame.
+	lastChanged := map[string]time.Time{}
+	for _, commit := range commits {
+		if len(commit.Parents) > 1 { // We don't care about merge commits.
+			continue
+		}
+		files, err := changedFiles(ctx, r.client, repo.Name, commit.ID)
+		if err != nil {
+			return err
+		}
+		scorer.addCommit(commit.Author, files)
+		for _, f := range files {
+			if t, ok := lastChanged[f]; !ok || commit.Author.Date.After(t) {
+				lastChanged[f] = commit.Author.Date
+			}
+		}
+	}
+
+	if len(commits) > 0 {
+		for _, f := range recentlyChanged(lastChanged, cfg.maxBlameFiles) {
+			hunks, err := r.blame(ctx, repo.Name, commits[0].ID, f)
+			if err != nil {
+				return errors.Wrapf(err, "blame %q", f)
+			}
+			scorer.addBlame(f, hunks)
+		}
+	}
+
+	teams, err := r.teamsByEmail(ctx, scorer.emails())
+	if err != nil {
+		return err
+	}
+
+	owners := scorer.inferOwners(teams, cfg.minConfidence, cfg.maxOwners)
+	if err := r.db.InferredOwnership().ReplaceForRepo(ctx, repoId, owners); err != nil {
+		return errors.Wrap(err, "ReplaceForRepo")
+	}
+	r.logger.Info("inferred owners inserted", logger.Int("count", len(owners)), logger.Int("repo_id", int(repoId)))
+	inferredOwnersCounter.Add(float64(len(owners)))
+	return nil
+}
+
+// changedFiles returns the paths of all files changed by the given commit.
+func changedFiles(ctx context.Context, client gitserver.Client, repo api.RepoName, commitID api.CommitID) ([]string, error) {
+	it, err := client.ChangedFiles(ctx, repo, "", string(commitID))
+	if err != nil {
+		return nil, errors.Wrap(err, "ChangedFiles")
+	}
+	defer it.Close()
+	var files []string
+	for {
+		ps, err := it.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return files, nil
+			}
+			return nil, errors.Wrap(err, "ChangedFilesIterator")
+		}
+		files = append(files, ps.Path)
+	}
+}
+
+// recentlyChanged returns up to limit paths, most recently changed first.
+func recentlyChanged(lastChanged map[string]time.Time, limit int) []string {
+	files := make([]string, 0, len(lastChanged))
+	for f := range lastChanged {
+		files = append(files, f)
+	}
+	slices.SortFunc(files, func(a, b string) int {
+		if c := lastChanged[b].Compare(lastChanged[a]); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	})
+	if len(files) > limit {
+		files = files[:limit]
+	}
+	return files
+}
+
+// blame returns the blame hunks of a file at the given commit, or nothing if
+// the file no longer exists.
+func (r *inferredOwnershipIndexer) blame(ctx context.Context, repo api.RepoName, commitID api.CommitID, file string) ([]*gitdomain.Hunk, error) {
+	hr, err := r.client.StreamBlameFile(ctx, repo, file, &gitserver.BlameOptions{NewestCommit: commitID})
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer hr.Close()
+	var hunks []*gitdomain.Hunk
+	for {
+		h, err := hr.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return hunks, nil
+			}
+			return nil, err
+		}
+		hunks = append(hunks, h)
+	}
+}
+
+// teamsByEmail returns the IDs of the teams of the users with the given
+// verified emails, keyed by lowercase email.
+func (r *inferredOwnershipIndexer) teamsByEmail(ctx context.Context, emails []string) (map[string][]int32, error) {
+	userEmails, err := r.db.UserEmails().GetVerifiedEmails(ctx, emails...)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetVerifiedEmails")
+	}
+	teamsByUser := map[int32][]int32{}
+	teamsByEmail := map[string][]int32{}
+	for _, e := range userEmails {
+		teamIDs, ok := teamsByUser[e.UserID]
+		if !ok {
+			teams, _, err := r.db.Teams().ListTeams(ctx, database.ListTeamsOpts{ForUserMember: e.UserID})
+			if err != nil {
+				return nil, errors.Wrap(err, "ListTeams")
+			}
+			for _, t := range teams {
+				teamIDs = append(teamIDs, t.ID)
+			}
+			teamsByUser[e.UserID] = teamIDs
+		}
+		teamsByEmail[strings.ToLower(e.Email)] = teamIDs
+	}
+	return teamsByEmail, nil
+}
+
+// ownershipScorer accumulates evidence that people own files, where every
+// contribution is weighted by an exponential decay of its age.
+type ownershipScorer struct {
+	now      time.Time
+	halfLife time.Duration
+	// weights maps file paths to lowercase author emails to the decayed
+	// weight of their contributions to the file.
+	weights map[string]map[string]float64
+	// names maps lowercase author emails to the name of their most recent
+	// contribution.
+	names map[string]string
+	// nameDates are the dates of the contributions names were taken from.
+	nameDates map[string]time.Time
+}
+
+func newOwnershipScorer(now time.Time, halfLife time.Duration) *ownershipScorer {
+	return &ownershipScorer{
+		now:       now,
+		halfLife:  halfLife,
+		weights:   map[string]map[string]float64{},
+		names:     map[string]string{},
+		nameDates: map[string]time.Time{},
+	}
+}
+
+// decay returns the weight of a contribution made at the given time, which
+// halves with every half-life that passed since.
+func (s *ownershipScorer) decay(t time.Time) float64 {
+	age := s.now.Sub(t)
+	if age < 0 {
+		age = 0
+	}
+	return math.Exp2(-float64(age) / float64(s.halfLife))
+}
+
+func (s *ownershipScorer) add(file string, author gitdomain.Signature, weight float64) {
+	email := strings.ToLower(author.Email)
+	if email == "" || weight == 0 {
+		return
+	}
+	byAuthor, ok := s.weights[file]
+	if !ok {
+		byAuthor = map[string]float64{}
+		s.weights[file] = byAuthor
+	}
+	byAuthor[email] += weight
+	if t, ok := s.nameDates[email]; !ok || author.Date.After(t) {
+		s.names[email] = author.Name
+		s.nameDates[email] = author.Date
+	}
+}
+
+// addCommit counts a commit as one contribution to every file it changed.
+func (s *ownershipScorer) addCommit(author gitdomain.Signature, files []string) {
+	w := s.decay(author.Date)
+	for _, f := range files {
+		s.add(f, author, w)
+	}
+}
+
+// addBlame counts the lines of a file last changed by each author as a share of
+// one contribution, so that the current contents of a file weigh as much as a
+// single commit made at the same time.
+func (s *ownershipScorer) addBlame(file string, hunks []*gitdomain.Hunk) {
+	var total uint32
+	for _, h := range hunks {
+		total += h.EndLine - h.StartLine
+	}
+	if total == 0 {
+		return
+	}
+	for _, h := range hunks {
+		share := float64(h.EndLine-h.StartLine) / float64(total)
+		s.add(file, h.Author, share*s.decay(h.Author.Date))
+	}
+}
+
+// emails returns the emails of all authors seen.
+func (s *ownershipScorer) emails() []string {
+	emails := make([]string, 0, len(s.names))
+	for e := range s.names {
+		emails = append(emails, e)
+	}
+	slices.Sort(emails)
+	return emails
+}
+
+// directoryScores sums the weights of all files within each directory, including
+// the repository root, which is the empty path.
+func (s *ownershipScorer) directoryScores() map[string]map[string]float64 {
+	dirs := map[string]map[string]float64{}
+	for file, byAuthor := range s.weights {
+		dir := file
+		for dir != "" {
+			if dir = path.Dir(dir); dir == "." {
+				dir = ""
+			}
+			scores, ok := dirs[dir]
+			if !ok {
+				scores = map[string]float64{}
+				dirs[dir] = scores
+			}
+			for email, w := range byAuthor {
+				scores[email] += w
+			}
+		}
+	}
+	return dirs
+}
+
+// inferredConfidence is the share of an owner in the total score of all
+// contributors to a directory, discounted when there are few contributions: a
+// single recent commit by a single author yields a confidence of one half.
+func inferredConfidence(score, total float64) float64 {
+	return score / (total + 1)
+}
+
+// inferOwners returns the most confident owners of every directory, both
+// people and the teams they are members of. A team scores the sum of the
+// scores of its members.
+func (s *ownershipScorer) inferOwners(teamsByEmail map[string][]int32, minConfidence float64, limit int) []database.InferredOwner {
+	dirScores := s.directoryScores()
+	dirs := make([]string, 0, len(dirScores))
+	for dir := range dirScores {
+		dirs = append(dirs, dir)
+	}
+	slices.Sort(dirs)
+
+	var owners []database.InferredOwner
+	for _, dir := range dirs {
+		scores := dirScores[dir]
+		var total float64
+		teamScores := map[int32]float64{}
+		for email, score := range scores {
+			total += score
+			for _, t := range teamsByEmail[email] {
+				teamScores[t] += score
+			}
+		}
+		var dirOwners []database.InferredOwner
+		for _, o := range topScores(scores, total, minConfidence, limit) {
+			dirOwners = append(dirOwners, database.InferredOwner{
+				FilePath:    dir,
+				AuthorName:  s.names[o.key],
+				AuthorEmail: o.key,
+				Score:       o.score,
+				Confidence:  o.confidence,
+			})
+		}
+		for _, o := range topScores(teamScores, total, minConfidence, limit) {
+			dirOwners = append(dirOwners, database.InferredOwner{
+				FilePath:   dir,
+				TeamID:     o.key,
+				Score:      o.score,
+				Confidence: o.confidence,
+			})
+		}
+		slices.SortStableFunc(dirOwners, func(a, b database.InferredOwner) int {
+			return cmp.Compare(b.Confidence, a.Confidence)
+		})
+		owners = append(owners, dirOwners...)
+	}
+	return owners
+}
+
+type scored[K cmp.Ordered] struct {
+	key        K
+	score      float64
+	confidence float64
+}
+
+// topScores returns up to limit keys whose confidence is at least
+// minConfidence, highest score first.
+func topScores[K cmp.Ordered](scores map[K]float64, total, minConfidence float64, limit int) []scored[K] {
+	var top []scored[K]
+	for k, score := range scores {
+		if c := inferredConfidence(score, total); c >= minConfidence {
+			top = append(top, scored[K]{key: k, score: score, confidence: c})
+		}
+	}
+	slices.SortFunc(top, func(a, b scored[K]) int {
+		if c := cmp.Compare(b.score, a.score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.key, b.key)
+	})
+	if len(top) > limit {
+		top = top[:limit]
+	}
+	return top
+}
@@ -0,0 +1,91 @@
+package background
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+)
+
+func TestOwnershipScorer_Decay(t *testing.T) {
+	now := time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)
+	halfLife := 90 * 24 * time.Hour
+	s := newOwnershipScorer(now, halfLife)
+
+	assert.Equal(t, 1.0, s.decay(now))
+	assert.Equal(t, 1.0, s.decay(now.Add(time.Hour)), "contributions from the future are not amplified")
+	assert.InDelta(t, 0.5, s.decay(now.Add(-halfLife)), 1e-9)
+	assert.InDelta(t, 0.25, s.decay(now.Add(-2*halfLife)), 1e-9)
+}
+
+func TestOwnershipScorer_InferOwners(t *testing.T) {
+	now := time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)
+	halfLife := 90 * 24 * time.Hour
+	alice := gitdomain.Signature{Name: "alice", Email: "Alice@example.com", Date: now}
+	bob := gitdomain.Signature{Name: "bob", Email: "bob@example.com", Date: now.Add(-halfLife)}
+
+	s := newOwnershipScorer(now, halfLife)
+	s.addCommit(alice, []string{"src/main.go", "src/lib/lib.go"})
+	s.addCommit(alice, []string{"src/main.go"})
+	s.addCommit(bob, []string{"src/main.go", "docs/README.md"})
+	// The current contents of a file weigh as much as a single commit.
+	s.addBlame("docs/README.md", []*gitdomain.Hunk{
+		{StartLine: 1, EndLine: 4, Author: bob},
+		{StartLine: 4, EndLine: 5, Author: alice},
+	})
+
+	assert.Equal(t, []string{"alice@example.com", "bob@example.com"}, s.emails())
+
+	teams := map[string][]int32{"alice@example.com": {7}}
+	owners := s.inferOwners(teams, 0.3, 3)
+	got := map[string][]string{}
+	for _, o := range owners {
+		ref := o.AuthorEmail
+		if o.TeamID != 0 {
+			ref = "team"
+		}
+		got[o.FilePath] = append(got[o.FilePath], ref)
+	}
+	assert.Equal(t, map[string][]string{
+		"":        {"alice@example.com", "team"},
+		"src":     {"alice@example.com", "team"},
+		"src/lib": {"alice@example.com", "team"},
+		"docs":    {"bob@example.com"},
+	}, got)
+
+	for _, o := range owners {
+		if o.FilePath == "src/lib" && o.TeamID == 0 {
+			assert.Equal(t, "alice", o.AuthorName)
+			// A single recent commit by a single author.
+			assert.InDelta(t, 0.5, o.Confidence, 1e-9)
+		}
+	}
+
+	t.Run("limits owners per directory", func(t *testing.T) {
+		owners := s.inferOwners(teams, 0, 1)
+		var root []database.InferredOwner
+		for _, o := range owners {
+			if o.FilePath == "" && o.TeamID == 0 {
+				root = append(root, o)
+			}
+		}
+		require.Len(t, root, 1)
+		assert.Equal(t, "alice@example.com", root[0].AuthorEmail)
+	})
+}
+
+func TestRecentlyChanged(t *testing.T) {
+	now := time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)
+	lastChanged := map[string]time.Time{
+		"old.go":   now.Add(-time.Hour),
+		"b.go":     now,
+		"a.go":     now,
+		"older.go": now.Add(-2 * time.Hour),
+	}
+	assert.Equal(t, []string{"a.go", "b.go", "old.go"}, recentlyChanged(lastChanged, 3))
+	assert.Empty(t, recentlyChanged(lastChanged, 0))
+}
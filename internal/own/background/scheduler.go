@@ -33,6 +33,14 @@ var QueuePerRepoIndexJobs = []IndexJobType{
 		Name:            types.SignalRecentContributors,
 		IndexInterval:   time.Hour * 24,
 		RefreshInterval: time.Minute * 5,
+	}, {
+		Name:            types.SignalInferredOwnership,
+		IndexInterval:   time.Hour * 24 * 7,
+		RefreshInterval: time.Hour,
+	}, {
+		Name:            types.SignalCodeownersLint,
+		IndexInterval:   time.Hour * 24,
+		RefreshInterval: time.Minute * 5,
 	}, {
 		Name:            types.Analytics,
 		IndexInterval:   time.Hour * 24,
@@ -43,6 +43,8 @@ func TestOwnRepoIndexSchedulerJob_JobsAutoIndex(t *testing.T) {
 
 	wantJobCountByName := map[string]int{
 		types.SignalRecentContributors: 3,
+		types.SignalInferredOwnership:  0, // Turned off by default
+		types.SignalCodeownersLint:     0, // Turned off by default
 		types.Analytics:                0, // Turned off by default
 	}
 
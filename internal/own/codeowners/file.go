@@ -1,6 +1,7 @@
 package codeowners
 
 import (
+	"sort"
 	"sync"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
@@ -36,15 +37,22 @@ type Ruleset struct {
 	rules        []*CompiledRule
 	source       RulesetSource
 	codeHostType string
+	// sectionOrder maps section names to the index of their first
+	// rule, so that MatchAll returns rules in file order.
+	sectionOrder map[string]int
 }
 
 func NewRuleset(source RulesetSource, proto *codeownerspb.File) *Ruleset {
 	f := &Ruleset{
-		proto:  proto,
-		source: source,
+		proto:        proto,
+		source:       source,
+		sectionOrder: map[string]int{},
 	}
-	for _, r := range proto.GetRule() {
+	for i, r := range proto.GetRule() {
 		f.rules = append(f.rules, &CompiledRule{proto: r})
+		if _, ok := f.sectionOrder[r.GetSectionName()]; !ok {
+			f.sectionOrder[r.GetSectionName()] = i
+		}
 	}
 	return f
 }
@@ -83,6 +91,46 @@ func (x *Ruleset) Match(path string) *codeownerspb.Rule {
 	return nil
 }
 
+// MatchAll returns the rules matching the given path in every section of this
+// CODEOWNERS ruleset. Like in GitLab, sections are evaluated independently: For
+// every section, the returned rule is the rule of that section which pattern
+// matches the given path that is the furthest down the input file. Rules
+// outside of any section form a section of their own. The returned rules are
+// ordered by the first appearance of their section in the input file.
+func (x *Ruleset) MatchAll(path string) []*codeownerspb.Rule {
+	if path[0] != '/' {
+		path = "/" + path
+	}
+	var matches []*codeownerspb.Rule
+	seen := map[string]struct{}{}
+	for i := len(x.rules) - 1; i >= 0; i-- {
+		rule := x.rules[i]
+		section := rule.proto.GetSectionName()
+		if _, ok := seen[section]; ok {
+			continue
+		}
+		if rule.match(path) {
+			seen[section] = struct{}{}
+			matches = append(matches, rule.proto)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return x.sectionOrder[matches[i].GetSectionName()] < x.sectionOrder[matches[j].GetSectionName()]
+	})
+	return matches
+}
+
+// Section returns the section of the given name, or nil if the CODEOWNERS
+// file does not define such a section.
+func (x *Ruleset) Section(name string) *codeownerspb.Section {
+	for _, s := range x.proto.GetSection() {
+		if s.GetName() == name {
+			return s
+		}
+	}
+	return nil
+}
+
 type CompiledRule struct {
 	proto       *codeownerspb.Rule
 	glob        *paths.GlobPattern
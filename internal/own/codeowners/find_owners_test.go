@@ -3,9 +3,11 @@ package codeowners_test
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
 	codeownerspb "github.com/sourcegraph/sourcegraph/internal/own/codeowners/v1"
@@ -255,6 +257,75 @@ func TestFileOwnersOrder(t *testing.T) {
 	assert.Equal(t, wantOwner, got.GetOwner())
 }
 
+func TestFileOwnersMatchAllSections(t *testing.T) {
+	file, err := codeowners.Parse(strings.NewReader(
+		`*.go @go-owner
+
+[Frontend] @frontend-team
+/client/
+/client/legacy/ @legacy-owner
+
+^[Docs][2] @docs-team
+*.md
+
+[frontend]
+*.go @go-frontend-owner
+`))
+	require.NoError(t, err)
+	rs := codeowners.NewRuleset(codeowners.IngestedRulesetSource{}, file)
+
+	owners := func(rules []*codeownerspb.Rule) map[string][]string {
+		got := map[string][]string{}
+		for _, r := range rules {
+			for _, o := range r.GetOwner() {
+				got[r.GetSectionName()] = append(got[r.GetSectionName()], o.GetHandle())
+			}
+		}
+		return got
+	}
+
+	// The last matching rule of every section applies.
+	assert.Equal(t, map[string][]string{
+		"":         {"go-owner"},
+		"frontend": {"go-frontend-owner"},
+	}, owners(rs.MatchAll("client/legacy/main.go")))
+	// Rules without owners get the default owners of their section.
+	assert.Equal(t, map[string][]string{
+		"frontend": {"legacy-owner"},
+		"docs":     {"docs-team"},
+	}, owners(rs.MatchAll("/client/legacy/README.md")))
+	assert.Equal(t, map[string][]string{
+		"frontend": {"frontend-team"},
+	}, owners(rs.MatchAll("/client/index.ts")))
+	assert.Empty(t, rs.MatchAll("/LICENSE"))
+
+	// Rules are ordered by the first appearance of their section.
+	var sections []string
+	for _, r := range rs.MatchAll("/client/main.go") {
+		sections = append(sections, r.GetSectionName())
+	}
+	assert.Equal(t, []string{"", "frontend"}, sections)
+
+	// Match still returns the last matching rule across sections.
+	assert.Equal(t, "go-frontend-owner", rs.Match("/client/main.go").GetOwner()[0].GetHandle())
+
+	docs := rs.Section("docs")
+	assert.True(t, docs.GetOptional())
+	assert.Equal(t, int32(2), docs.GetApprovalsRequired())
+	assert.False(t, rs.Section("frontend").GetOptional())
+	assert.Nil(t, rs.Section("backend"))
+
+	assert.Equal(t, `*.go @go-owner
+[frontend] @frontend-team
+/client/ @frontend-team
+/client/legacy/ @legacy-owner
+^[docs][2] @docs-team
+*.md @docs-team
+[frontend] @frontend-team
+*.go @go-frontend-owner
+`, rs.Repr())
+}
+
 func BenchmarkOwnersMatchLiteral(b *testing.B) {
 	pattern := "/main/src/foo/bar/README.md"
 	paths := []string{
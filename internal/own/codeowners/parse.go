@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"io"
 	"net/mail"
+	"strconv"
 	"strings"
 
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
@@ -14,10 +15,15 @@ import (
 // Parse parses CODEOWNERS file given as a Reader and returns the proto
 // representation of all rules within. The rules are in the same order
 // as in the file, since this matters for evaluation.
+//
+// GitLab sections are supported: Rules following a section header are
+// associated with the section, and rules without owners are assigned the
+// default owners of their section header, if any. The sections are returned
+// in the order they first appear in the file.
 func Parse(codeownersFile io.Reader) (*codeownerspb.File, error) {
 	scanner := bufio.NewScanner(codeownersFile)
 	var rs []*codeownerspb.Rule
-	p := new(parsing)
+	p := &parsing{sections: map[string]*codeownerspb.Section{}}
 	lineNumber := int32(0)
 	for scanner.Scan() {
 		p.nextLine(scanner.Text())
@@ -32,6 +38,9 @@ func Parse(codeownersFile io.Reader) (*codeownerspb.File, error) {
 		if !ok {
 			return nil, errors.Errorf("failed to match rule: %s", p.line)
 		}
+		if len(owners) == 0 {
+			owners = p.sectionDefaultOwners
+		}
 		// Need to handle this error once, codeownerspb.File supports
 		// error metadata.
 		r := codeownerspb.Rule{
@@ -49,7 +58,7 @@ func Parse(codeownersFile io.Reader) (*codeownerspb.File, error) {
 	if err := scanner.Err(); err != nil {
 		return nil, err
 	}
-	return &codeownerspb.File{Rule: rs}, nil
+	return &codeownerspb.File{Rule: rs, Section: p.sectionList}, nil
 }
 
 func ParseOwner(ownerText string) *codeownerspb.Owner {
@@ -73,6 +82,11 @@ type parsing struct {
 	line string
 	// The most recently defined section, or "" if none.
 	section string
+	// The default owners listed on the most recent section header.
+	sectionDefaultOwners []string
+	// sections indexes sectionList by lowercase section name.
+	sections    map[string]*codeownerspb.Section
+	sectionList []*codeownerspb.Section
 }
 
 // nextLine advances parsing to focus on the next line.
@@ -113,17 +127,47 @@ func (p *parsing) matchRule() (string, []string, bool) {
 	return filePattern, owners, true
 }
 
-var sectionPattern = lazyregexp.New(`^\s*\^?\s*\[([^\]]+)\]\s*(?:\[[0-9]+\])?\s*$`)
+var sectionPattern = lazyregexp.New(`^\s*(\^?)\s*\[([^\]]+)\]\s*(?:\[([0-9]+)\])?((?:\s+\S+)*)\s*$`)
 
 // matchSection tries to extract a section which looks like `[section name]`.
 // A section can also be defined as `^[Section]`, meaning it is optional for approval.
 // It can also be `[Section][2]`, meaning two approvals are required.
+// The section header can be followed by default owners of the section,
+// like `[Section] @owner`.
+//
+// Sections which are defined multiple times are combined. A combined section
+// is only optional if every definition is, and it requires the highest number
+// of approvals of any definition.
 func (p *parsing) matchSection() bool {
 	match := sectionPattern.FindStringSubmatch(p.lineWithoutComments())
-	if len(match) != 2 {
+	if len(match) != 5 {
 		return false
 	}
-	p.section = match[1]
+	p.section = match[2]
+	p.sectionDefaultOwners = strings.Fields(match[4])
+
+	optional := match[1] == "^"
+	var approvals int32
+	if n, err := strconv.ParseInt(match[3], 10, 32); err == nil {
+		approvals = int32(n)
+	}
+	// Section names are case-insensitive, so we lowercase it.
+	name := strings.TrimSpace(strings.ToLower(p.section))
+	s, ok := p.sections[name]
+	if !ok {
+		s = &codeownerspb.Section{Name: name, Optional: optional}
+		p.sections[name] = s
+		p.sectionList = append(p.sectionList, s)
+	}
+	s.Optional = s.Optional && optional
+	if approvals > s.ApprovalsRequired {
+		s.ApprovalsRequired = approvals
+	}
+	if len(s.DefaultOwner) == 0 {
+		for _, ownerText := range p.sectionDefaultOwners {
+			s.DefaultOwner = append(s.DefaultOwner, ParseOwner(ownerText))
+		}
+	}
 	return true
 }
 
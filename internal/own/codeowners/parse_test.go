@@ -362,7 +362,11 @@ README.md  @docs
 			LineNumber:  69,
 		},
 	}
-	assert.Equal(t, &codeownerspb.File{Rule: want}, got)
+	wantSections := []*codeownerspb.Section{
+		{Name: "documentation"},
+		{Name: "database"},
+	}
+	assert.Equal(t, &codeownerspb.File{Rule: want, Section: wantSections}, got)
 }
 
 func TestParseAtHandle(t *testing.T) {
@@ -482,7 +486,12 @@ own/codeowners/* @own-pms
 			},
 			LineNumber: 14,
 		}}
-	assert.Equal(t, &codeownerspb.File{Rule: want}, got)
+	wantSections := []*codeownerspb.Section{
+		{Name: "pm"},
+		// [Eng][2] makes the combined section required.
+		{Name: "eng", ApprovalsRequired: 2},
+	}
+	assert.Equal(t, &codeownerspb.File{Rule: want, Section: wantSections}, got)
 }
 
 func TestParseManySections(t *testing.T) {
@@ -518,7 +527,11 @@ func TestParseManySections(t *testing.T) {
 			LineNumber: 5,
 		},
 	}
-	assert.Equal(t, &codeownerspb.File{Rule: want}, got)
+	wantSections := []*codeownerspb.Section{
+		{Name: "pm"},
+		{Name: "docs"},
+	}
+	assert.Equal(t, &codeownerspb.File{Rule: want, Section: wantSections}, got)
 }
 
 func TestParseEmptyString(t *testing.T) {
@@ -571,5 +584,47 @@ func TestParseSectionWithComment(t *testing.T) {
 			LineNumber: 2,
 		},
 	}
-	assert.Equal(t, &codeownerspb.File{Rule: want}, got)
+	wantSections := []*codeownerspb.Section{
+		{Name: "section"},
+	}
+	assert.Equal(t, &codeownerspb.File{Rule: want, Section: wantSections}, got)
+}
+
+func TestParseSectionDefaultOwners(t *testing.T) {
+	got, err := codeowners.Parse(strings.NewReader(
+		`^[Docs][2] @docs-team docs@example.com # Inline comment
+*.md
+/docs/api/ @api-docs`))
+	require.NoError(t, err)
+	want := []*codeownerspb.Rule{
+		{
+			Pattern:     "*.md",
+			SectionName: "docs",
+			Owner: []*codeownerspb.Owner{
+				{Handle: "docs-team"},
+				{Email: "docs@example.com"},
+			},
+			LineNumber: 2,
+		},
+		{
+			Pattern:     "/docs/api/",
+			SectionName: "docs",
+			Owner: []*codeownerspb.Owner{
+				{Handle: "api-docs"},
+			},
+			LineNumber: 3,
+		},
+	}
+	wantSections := []*codeownerspb.Section{
+		{
+			Name:              "docs",
+			Optional:          true,
+			ApprovalsRequired: 2,
+			DefaultOwner: []*codeownerspb.Owner{
+				{Handle: "docs-team"},
+				{Email: "docs@example.com"},
+			},
+		},
+	}
+	assert.Equal(t, &codeownerspb.File{Rule: want, Section: wantSections}, got)
 }
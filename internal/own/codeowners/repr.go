@@ -3,6 +3,8 @@ package codeowners
 import (
 	"fmt"
 	"strings"
+
+	codeownerspb "github.com/sourcegraph/sourcegraph/internal/own/codeowners/v1"
 )
 
 // Repr returns a string representation that resembles the syntax
@@ -15,19 +17,35 @@ func (f *Ruleset) Repr() string {
 	var lastSeenSection string
 	for _, r := range f.proto.GetRule() {
 		if s := r.SectionName; s != lastSeenSection {
-			fmt.Fprintf(w, "[%s]\n", s)
+			writeSectionHeader(w, s, f.Section(s))
 			lastSeenSection = s
 		}
 		fmt.Fprint(w, r.Pattern)
-		for _, o := range r.GetOwner() {
-			if h := o.GetHandle(); h != "" {
-				fmt.Fprintf(w, " @%s", h)
-			}
-			if e := o.GetEmail(); e != "" {
-				fmt.Fprintf(w, " %s", e)
-			}
-		}
+		writeOwners(w, r.GetOwner())
 		fmt.Fprintln(w)
 	}
 	return w.String()
 }
+
+func writeSectionHeader(w *strings.Builder, name string, s *codeownerspb.Section) {
+	if s.GetOptional() {
+		fmt.Fprint(w, "^")
+	}
+	fmt.Fprintf(w, "[%s]", name)
+	if n := s.GetApprovalsRequired(); n > 0 {
+		fmt.Fprintf(w, "[%d]", n)
+	}
+	writeOwners(w, s.GetDefaultOwner())
+	fmt.Fprintln(w)
+}
+
+func writeOwners(w *strings.Builder, owners []*codeownerspb.Owner) {
+	for _, o := range owners {
+		if h := o.GetHandle(); h != "" {
+			fmt.Fprintf(w, " @%s", h)
+		}
+		if e := o.GetEmail(); e != "" {
+			fmt.Fprintf(w, " %s", e)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+package own
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/collections"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
+	codeownerspb "github.com/sourcegraph/sourcegraph/internal/own/codeowners/v1"
+	"github.com/sourcegraph/sourcegraph/internal/paths"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// LintService reports problems of the CODEOWNERS file of a repository, and how
+// much of the repository it covers.
+type LintService interface {
+	// LintCodeowners lints the CODEOWNERS file of the given repository at the
+	// given commit against the files of the repository at that commit. If the
+	// repository has no CODEOWNERS file, the report lists all its files as
+	// unowned.
+	LintCodeowners(context.Context, api.RepoName, api.RepoID, api.CommitID) (*database.CodeownersLintReport, error)
+}
+
+var _ LintService = &lintService{}
+
+func NewLintService(g gitserver.Client, db database.DB) LintService {
+	return &lintService{
+		gitserverClient: g,
+		db:              db,
+	}
+}
+
+type lintService struct {
+	gitserverClient gitserver.Client
+	db              database.DB
+}
+
+func (s *lintService) LintCodeowners(ctx context.Context, repoName api.RepoName, repoID api.RepoID, commitID api.CommitID) (*database.CodeownersLintReport, error) {
+	ruleset, err := NewService(s.gitserverClient, s.db).RulesetForRepo(ctx, repoName, repoID, commitID)
+	if err != nil {
+		return nil, errors.Wrap(err, "RulesetForRepo")
+	}
+	files, err := s.listFiles(ctx, repoName, commitID)
+	if err != nil {
+		return nil, err
+	}
+	report := &database.CodeownersLintReport{
+		RepoID:        repoID,
+		CommitID:      commitID,
+		HasCodeowners: ruleset != nil,
+		TotalFiles:    len(files),
+	}
+	l := newCodeownersLinter(ruleset)
+	for _, file := range files {
+		l.addFile(file)
+	}
+	report.OwnedFiles = len(l.ownedFiles)
+	report.DeadRules = l.deadRules()
+	report.UnownedPaths = l.unownedPaths(files)
+	if ruleset != nil {
+		report.UnknownOwners = s.unknownOwners(ctx, repoName, ruleset)
+	}
+	return report, nil
+}
+
+// listFiles returns the paths of all files of the repository at the given
+// commit.
+func (s *lintService) listFiles(ctx context.Context, repoName api.RepoName, commitID api.CommitID) ([]string, error) {
+	it, err := s.gitserverClient.ReadDir(ctx, repoName, commitID, "", true)
+	if err != nil {
+		return nil, errors.Wrap(err, "ls-tree")
+	}
+	defer it.Close()
+	var files []string
+	for {
+		f, err := it.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if f.IsDir() {
+			continue
+		}
+		files = append(files, f.Name())
+	}
+	return files, nil
+}
+
+// unknownOwners returns the owners referenced by the given ruleset which
+// cannot be resolved to a Sourcegraph user or team.
+func (s *lintService) unknownOwners(ctx context.Context, repoName api.RepoName, ruleset *codeowners.Ruleset) []database.CodeownersLintOwner {
+	repoContext := &RepoContext{
+		Name:         repoName,
+		CodeHostKind: ruleset.GetCodeHostType(),
+	}
+	type ownerKey struct{ handle, email string }
+	var owners []ownerKey
+	lineNumbers := map[ownerKey][]int32{}
+	bag := EmptyBag()
+	for _, rule := range ruleset.GetFile().GetRule() {
+		for _, o := range rule.GetOwner() {
+			k := ownerKey{handle: o.GetHandle(), email: o.GetEmail()}
+			if _, ok := lineNumbers[k]; !ok {
+				owners = append(owners, k)
+				bag.Add(Reference{RepoContext: repoContext, Handle: k.handle, Email: k.email})
+			}
+			lineNumbers[k] = append(lineNumbers[k], rule.GetLineNumber())
+		}
+	}
+	bag.Resolve(ctx, s.db)
+	var unknown []database.CodeownersLintOwner
+	for _, k := range owners {
+		if _, ok := bag.FindResolved(Reference{RepoContext: repoContext, Handle: k.handle, Email: k.email}); ok {
+			continue
+		}
+		unknown = append(unknown, database.CodeownersLintOwner{
+			Handle:      k.handle,
+			Email:       k.email,
+			LineNumbers: lineNumbers[k],
+		})
+	}
+	return unknown
+}
+
+// codeownersLinter matches the files of a repository against the rules of its
+// CODEOWNERS file.
+type codeownersLinter struct {
+	ruleset *codeowners.Ruleset
+	// unmatched are the rules which pattern did not match any file yet, in
+	// file order.
+	unmatched []compiledLintRule
+	// ownedFiles are the files matched by a rule with at least one owner.
+	ownedFiles collections.Set[string]
+}
+
+type compiledLintRule struct {
+	proto *codeownerspb.Rule
+	// glob is nil if the pattern of the rule does not compile, in which case
+	// the rule never matches.
+	glob *paths.GlobPattern
+}
+
+func newCodeownersLinter(ruleset *codeowners.Ruleset) *codeownersLinter {
+	l := &codeownersLinter{
+		ruleset:    ruleset,
+		ownedFiles: collections.NewSet[string](),
+	}
+	if ruleset == nil {
+		return l
+	}
+	for _, rule := range ruleset.GetFile().GetRule() {
+		glob, _ := paths.Compile(rule.GetPattern())
+		l.unmatched = append(l.unmatched, compiledLintRule{proto: rule, glob: glob})
+	}
+	return l
+}
+
+func (l *codeownersLinter) addFile(path string) {
+	if l.ruleset == nil {
+		return
+	}
+	for _, rule := range l.ruleset.MatchAll(path) {
+		if len(rule.GetOwner()) > 0 {
+			l.ownedFiles.Add(path)
+			break
+		}
+	}
+	// Rules are matched against paths with a leading slash, see Ruleset.Match.
+	rooted := "/" + strings.TrimPrefix(path, "/")
+	unmatched := l.unmatched[:0]
+	for _, rule := range l.unmatched {
+		if rule.glob == nil || !rule.glob.Match(rooted) {
+			unmatched = append(unmatched, rule)
+		}
+	}
+	l.unmatched = unmatched
+}
+
+// deadRules returns the rules which pattern did not match any of the added
+// files.
+func (l *codeownersLinter) deadRules() []database.CodeownersLintRule {
+	var dead []database.CodeownersLintRule
+	for _, rule := range l.unmatched {
+		dead = append(dead, database.CodeownersLintRule{
+			Pattern:     rule.proto.GetPattern(),
+			LineNumber:  rule.proto.GetLineNumber(),
+			SectionName: rule.proto.GetSectionName(),
+		})
+	}
+	return dead
+}
+
+// unownedPaths returns the topmost directories and files among the given
+// files which do not contain any owned file, sorted and capped at
+// database.MaxCodeownersLintUnownedPaths.
+func (l *codeownersLinter) unownedPaths(files []string) []string {
+	// ownedDirs are all directories containing an owned file.
+	ownedDirs := collections.NewSet[string]()
+	for path := range l.ownedFiles {
+		for lastSlash := strings.LastIndex(path, "/"); lastSlash != -1; lastSlash = strings.LastIndex(path, "/") {
+			path = path[:lastSlash]
+			ownedDirs.Add(path)
+		}
+	}
+	unowned := collections.NewSet[string]()
+	for _, path := range files {
+		if l.ownedFiles.Has(path) {
+			continue
+		}
+		// Find the topmost ancestor of the file which contains no owned file.
+		// The file itself is the topmost if all its ancestors contain an owned
+		// file.
+		top := path
+		for i := 0; i < len(path); i++ {
+			if path[i] == '/' && !ownedDirs.Has(path[:i]) {
+				top = path[:i]
+				break
+			}
+		}
+		unowned.Add(top)
+	}
+	sorted := collections.SortedSetValues(unowned)
+	if len(sorted) > database.MaxCodeownersLintUnownedPaths {
+		sorted = sorted[:database.MaxCodeownersLintUnownedPaths]
+	}
+	return sorted
+}
@@ -0,0 +1,147 @@
+package own
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/collections"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/fileutil"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
+	codeownerspb "github.com/sourcegraph/sourcegraph/internal/own/codeowners/v1"
+	itypes "github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// readDir returns a ReadDir hook listing the given files and their parent
+// directories.
+func readDir(files ...string) func(context.Context, api.RepoName, api.CommitID, string, bool) (gitserver.ReadDirIterator, error) {
+	return func(context.Context, api.RepoName, api.CommitID, string, bool) (gitserver.ReadDirIterator, error) {
+		var fis []fs.FileInfo
+		dirs := map[string]bool{}
+		for _, file := range files {
+			for i := range file {
+				if file[i] == '/' && !dirs[file[:i]] {
+					dirs[file[:i]] = true
+					fis = append(fis, &fileutil.FileInfo{Name_: file[:i], Mode_: os.ModeDir})
+				}
+			}
+			fis = append(fis, &fileutil.FileInfo{Name_: file})
+		}
+		return gitserver.NewReadDirIteratorFromSlice(fis), nil
+	}
+}
+
+func TestCodeownersLinter(t *testing.T) {
+	owner := []*codeownerspb.Owner{{Handle: "owner"}}
+	ruleset := codeowners.NewRuleset(codeowners.IngestedRulesetSource{}, &codeownerspb.File{
+		Rule: []*codeownerspb.Rule{
+			{Pattern: "/src/**", Owner: owner, LineNumber: 1},
+			{Pattern: "/src/generated/**", LineNumber: 2},
+			{Pattern: "/old/**", Owner: owner, LineNumber: 3},
+			{Pattern: "*.md", Owner: owner, LineNumber: 5, SectionName: "docs"},
+		},
+	})
+	files := []string{
+		"README.md",
+		"go.mod",
+		"src/main.go",
+		"src/generated/gen.go",
+		"build/ci/pipeline.yml",
+		"build/ci/README.md",
+		"build/scripts/run.sh",
+		"vendor/lib/lib.go",
+	}
+	l := newCodeownersLinter(ruleset)
+	for _, file := range files {
+		l.addFile(file)
+	}
+
+	assert.Equal(t, []string{"README.md", "build/ci/README.md", "src/main.go"}, collections.SortedSetValues(l.ownedFiles))
+	assert.Equal(t, []database.CodeownersLintRule{{Pattern: "/old/**", LineNumber: 3}}, l.deadRules())
+	// Rules without owners still count as matching files, but do not own
+	// them. Directories containing an owned file are broken down further.
+	assert.Equal(t, []string{
+		"build/ci/pipeline.yml",
+		"build/scripts",
+		"go.mod",
+		"src/generated",
+		"vendor",
+	}, l.unownedPaths(files))
+
+	t.Run("no CODEOWNERS file", func(t *testing.T) {
+		l := newCodeownersLinter(nil)
+		for _, file := range files {
+			l.addFile(file)
+		}
+		assert.Empty(t, l.ownedFiles)
+		assert.Empty(t, l.deadRules())
+		assert.Equal(t, []string{"README.md", "build", "go.mod", "src", "vendor"}, l.unownedPaths(files))
+	})
+}
+
+func TestLintCodeownersWithoutCodeownersFile(t *testing.T) {
+	git := gitserver.NewMockClient()
+	git.NewFileReaderFunc.SetDefaultHook(repoFiles{}.NewFileReader)
+	git.ReadDirFunc.SetDefaultHook(readDir("a/b.go", "c.go"))
+
+	codeownersStore := dbmocks.NewMockCodeownersStore()
+	codeownersStore.GetCodeownersForRepoFunc.SetDefaultReturn(nil, database.CodeownersFileNotFoundError{})
+	db := dbmocks.NewMockDB()
+	db.CodeownersFunc.SetDefaultReturn(codeownersStore)
+
+	report, err := NewLintService(git, db).LintCodeowners(context.Background(), "repo", 1, "SHA")
+	require.NoError(t, err)
+	assert.Equal(t, &database.CodeownersLintReport{
+		RepoID:       1,
+		CommitID:     "SHA",
+		TotalFiles:   2,
+		UnownedPaths: []string{"a", "c.go"},
+	}, report)
+	assert.Equal(t, 0.0, report.Coverage())
+}
+
+func TestLintCodeowners(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(t))
+	ctx := context.Background()
+
+	_, err := db.Users().Create(ctx, database.NewUser{Username: "alice", Email: "alice@example.com", EmailIsVerified: true})
+	require.NoError(t, err)
+	require.NoError(t, db.Repos().Create(ctx, &itypes.Repo{ID: repoID, Name: "repo", ExternalRepo: api.ExternalRepoSpec{ServiceType: "github"}}))
+
+	git := gitserver.NewMockClient()
+	git.NewFileReaderFunc.SetDefaultHook(repoFiles{
+		{"repo", "SHA", "CODEOWNERS"}: "/src/** @alice @ghost\n" +
+			"/old/** @alice\n" +
+			"*.md ghost@example.com @ghost\n",
+	}.NewFileReader)
+	git.ReadDirFunc.SetDefaultHook(readDir("CODEOWNERS", "README.md", "src/main.go", "lib/lib.go"))
+
+	report, err := NewLintService(git, db).LintCodeowners(ctx, "repo", repoID, "SHA")
+	require.NoError(t, err)
+	assert.True(t, report.HasCodeowners)
+	assert.Equal(t, 4, report.TotalFiles)
+	assert.Equal(t, 2, report.OwnedFiles)
+	assert.Equal(t, 50.0, report.Coverage())
+	assert.Equal(t, []database.CodeownersLintRule{{Pattern: "/old/**", LineNumber: 2}}, report.DeadRules)
+	assert.Equal(t, []database.CodeownersLintOwner{
+		{Handle: "ghost", LineNumbers: []int32{1, 3}},
+		{Email: "ghost@example.com", LineNumbers: []int32{3}},
+	}, report.UnknownOwners)
+	assert.Equal(t, []string{"CODEOWNERS", "lib"}, report.UnownedPaths)
+}
@@ -450,6 +450,39 @@ func TestApplyCodeOwnershipFiltering(t *testing.T) {
 				},
 			}),
 		},
+		{
+			name: "selects results by inferred owners when there are no other owners",
+			args: args{
+				includeOwners: []string{"alice@example.com"},
+				excludeOwners: []string{},
+				matches: []result.Match{
+					&result.FileMatch{
+						File: result.File{
+							Path: "src/main.go",
+						},
+					},
+					&result.FileMatch{
+						File: result.File{
+							Path: "src/owned.go",
+						},
+					},
+					&result.FileMatch{
+						File: result.File{
+							Path: "README.md",
+						},
+					},
+				},
+				repoContent: map[string]string{
+					"CODEOWNERS": "src/owned.go @test\n",
+				},
+			},
+			setup: inferredOwnerSetup("src", "alice@example.com"),
+			want: autogold.Expect([]result.Match{&result.FileMatch{
+				File: result.File{
+					Path: "src/main.go",
+				},
+			}}),
+		},
 		{
 			name: "match commits where any file is owned by included owner",
 			args: args{
@@ -572,6 +605,7 @@ func TestApplyCodeOwnershipFiltering(t *testing.T) {
 			repoStore := dbmocks.NewMockRepoStore()
 			repoStore.GetFunc.SetDefaultReturn(&types.Repo{ExternalRepo: api.ExternalRepoSpec{ServiceType: "github"}}, nil)
 			db.ReposFunc.SetDefaultReturn(repoStore)
+			db.OwnSignalConfigurationsFunc.SetDefaultReturn(dbmocks.NewMockSignalConfigurationStore())
 			if tt.setup != nil {
 				tt.setup(db)
 			}
@@ -624,3 +658,20 @@ func assignedOwnerSetup(path string, user *types.User) func(*dbmocks.MockDB) {
 		db.AssignedOwnersFunc.SetDefaultReturn(assignedOwnersStore)
 	}
 }
+
+func inferredOwnerSetup(path, email string) func(*dbmocks.MockDB) {
+	return func(db *dbmocks.MockDB) {
+		signals := dbmocks.NewMockSignalConfigurationStore()
+		signals.IsEnabledFunc.SetDefaultReturn(true, nil)
+		db.OwnSignalConfigurationsFunc.SetDefaultReturn(signals)
+		inferredStore := dbmocks.NewMockInferredOwnershipStore()
+		inferredStore.ListForRepoFunc.SetDefaultReturn([]*database.InferredOwner{
+			{
+				FilePath:    path,
+				AuthorEmail: email,
+				Confidence:  0.8,
+			},
+		}, nil)
+		db.InferredOwnershipFunc.SetDefaultReturn(inferredStore)
+	}
+}
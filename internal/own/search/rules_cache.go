@@ -27,11 +27,13 @@ type RulesCache struct {
 	rules         map[RulesKey]*codeowners.Ruleset
 	assigned      map[AssignedKey]own.AssignedOwners
 	assignedTeams map[AssignedKey]own.AssignedTeams
+	inferred      map[AssignedKey]own.InferredOwners
 	ownService    own.Service
 
 	rulesMu         sync.RWMutex
 	assignedMu      sync.RWMutex
 	assignedTeamsMu sync.RWMutex
+	inferredMu      sync.RWMutex
 }
 
 func NewRulesCache(gs gitserver.Client, db database.DB) RulesCache {
@@ -39,6 +41,7 @@ func NewRulesCache(gs gitserver.Client, db database.DB) RulesCache {
 		rules:         make(map[RulesKey]*codeowners.Ruleset),
 		assigned:      make(map[AssignedKey]own.AssignedOwners),
 		assignedTeams: make(map[AssignedKey]own.AssignedTeams),
+		inferred:      make(map[AssignedKey]own.InferredOwners),
 		ownService:    own.NewService(gs, db),
 	}
 }
@@ -52,6 +55,10 @@ func (c *RulesCache) GetFromCacheOrFetch(ctx context.Context, repoName api.RepoN
 	if err != nil {
 		return repoOwnershipData{}, err
 	}
+	inferred, err := c.InferredOwners(ctx, repoID)
+	if err != nil {
+		return repoOwnershipData{}, err
+	}
 	codeowners, err := c.Codeowners(ctx, repoName, repoID, commitID)
 	if err != nil {
 		return repoOwnershipData{}, err
@@ -59,6 +66,7 @@ func (c *RulesCache) GetFromCacheOrFetch(ctx context.Context, repoName api.RepoN
 	return repoOwnershipData{
 		assigned:      assigned,
 		assignedTeams: assignedTeams,
+		inferred:      inferred,
 		codeowners:    codeowners,
 	}, nil
 }
@@ -105,6 +113,27 @@ func (c *RulesCache) AssignedTeams(ctx context.Context, repoID api.RepoID, commi
 	return c.assignedTeams[key], nil
 }
 
+func (c *RulesCache) InferredOwners(ctx context.Context, repoID api.RepoID) (own.InferredOwners, error) {
+	c.inferredMu.RLock()
+	key := AssignedKey{repoID}
+	if v, ok := c.inferred[key]; ok {
+		defer c.inferredMu.RUnlock()
+		return v, nil
+	}
+	c.inferredMu.RUnlock()
+	c.inferredMu.Lock()
+	defer c.inferredMu.Unlock()
+	if _, ok := c.inferred[key]; !ok {
+		inferred, err := c.ownService.InferredOwnership(ctx, repoID)
+		if err != nil {
+			// Error is picked up on a call site and in most cases a search alert is created.
+			return nil, err
+		}
+		c.inferred[key] = inferred
+	}
+	return c.inferred[key], nil
+}
+
 func (c *RulesCache) Codeowners(ctx context.Context, repoName api.RepoName, repoID api.RepoID, commitID api.CommitID) (*codeowners.Ruleset, error) {
 	c.rulesMu.RLock()
 	key := RulesKey{repoName, commitID}
@@ -134,36 +163,60 @@ type repoOwnershipData struct {
 	codeowners    *codeowners.Ruleset
 	assigned      own.AssignedOwners
 	assignedTeams own.AssignedTeams
+	inferred      own.InferredOwners
 }
 
 func (o repoOwnershipData) Match(path string) fileOwnershipData {
-	var rule *codeownerspb.Rule
+	var rules []*codeownerspb.Rule
 	if o.codeowners != nil {
-		rule = o.codeowners.Match(path)
+		rules = o.codeowners.MatchAll(path)
 	}
-	return fileOwnershipData{
-		rule:           rule,
+	d := fileOwnershipData{
+		rules:          rules,
 		assignedOwners: o.assigned.Match(path),
 		assignedTeams:  o.assignedTeams.Match(path),
 	}
+	// Inferred owners are only a fallback for files which have no declared or
+	// assigned owners.
+	if d.Empty() {
+		d.inferredOwners = o.inferred.Match(path)
+	}
+	return d
 }
 
 type fileOwnershipData struct {
-	rule           *codeownerspb.Rule
+	// rules are the matching CODEOWNERS rules, one for every section.
+	rules          []*codeownerspb.Rule
 	assignedOwners []database.AssignedOwnerSummary
 	assignedTeams  []database.AssignedTeamSummary
+	inferredOwners []database.InferredOwner
+}
+
+// sectionReference is an owner reference along with the name of the
+// CODEOWNERS section it was found in, if any.
+type sectionReference struct {
+	own.Reference
+	section string
 }
 
-func (d fileOwnershipData) References() []own.Reference {
-	var rs []own.Reference
-	for _, o := range d.rule.GetOwner() {
-		rs = append(rs, own.Reference{Handle: o.Handle, Email: o.Email})
+func (d fileOwnershipData) References() []sectionReference {
+	var rs []sectionReference
+	for _, r := range d.rules {
+		for _, o := range r.GetOwner() {
+			rs = append(rs, sectionReference{
+				Reference: own.Reference{Handle: o.Handle, Email: o.Email},
+				section:   r.GetSectionName(),
+			})
+		}
 	}
 	for _, o := range d.assignedOwners {
-		rs = append(rs, own.Reference{UserID: o.OwnerUserID})
+		rs = append(rs, sectionReference{Reference: own.Reference{UserID: o.OwnerUserID}})
 	}
 	for _, o := range d.assignedTeams {
-		rs = append(rs, own.Reference{TeamID: o.OwnerTeamID})
+		rs = append(rs, sectionReference{Reference: own.Reference{TeamID: o.OwnerTeamID}})
+	}
+	for _, o := range d.inferredOwners {
+		rs = append(rs, sectionReference{Reference: inferredReference(o)})
 	}
 	return rs
 }
@@ -173,8 +226,10 @@ func (d fileOwnershipData) Empty() bool {
 }
 
 func (d fileOwnershipData) NonEmpty() bool {
-	if d.rule != nil && len(d.rule.Owner) > 0 {
-		return true
+	for _, r := range d.rules {
+		if len(r.GetOwner()) > 0 {
+			return true
+		}
 	}
 	if len(d.assignedOwners) > 0 {
 		return true
@@ -182,16 +237,21 @@ func (d fileOwnershipData) NonEmpty() bool {
 	if len(d.assignedTeams) > 0 {
 		return true
 	}
+	if len(d.inferredOwners) > 0 {
+		return true
+	}
 	return false
 }
 
 func (d fileOwnershipData) IsWithin(bag own.Bag) bool {
-	for _, o := range d.rule.GetOwner() {
-		if bag.Contains(own.Reference{
-			Handle: o.Handle,
-			Email:  o.Email,
-		}) {
-			return true
+	for _, r := range d.rules {
+		for _, o := range r.GetOwner() {
+			if bag.Contains(own.Reference{
+				Handle: o.Handle,
+				Email:  o.Email,
+			}) {
+				return true
+			}
 		}
 	}
 	for _, o := range d.assignedOwners {
@@ -204,17 +264,24 @@ func (d fileOwnershipData) IsWithin(bag own.Bag) bool {
 			return true
 		}
 	}
+	for _, o := range d.inferredOwners {
+		if bag.Contains(inferredReference(o)) {
+			return true
+		}
+	}
 	return false
 }
 
 func (d fileOwnershipData) String() string {
 	var references []string
-	for _, o := range d.rule.GetOwner() {
-		if h := o.GetHandle(); h != "" {
-			references = append(references, h)
-		}
-		if e := o.GetEmail(); e != "" {
-			references = append(references, e)
+	for _, r := range d.rules {
+		for _, o := range r.GetOwner() {
+			if h := o.GetHandle(); h != "" {
+				references = append(references, h)
+			}
+			if e := o.GetEmail(); e != "" {
+				references = append(references, e)
+			}
 		}
 	}
 	for _, o := range d.assignedOwners {
@@ -223,5 +290,21 @@ func (d fileOwnershipData) String() string {
 	for _, o := range d.assignedTeams {
 		references = append(references, fmt.Sprintf("#%d", o.OwnerTeamID))
 	}
+	for _, o := range d.inferredOwners {
+		if o.TeamID != 0 {
+			references = append(references, fmt.Sprintf("#%d", o.TeamID))
+		} else {
+			references = append(references, o.AuthorEmail)
+		}
+	}
 	return fmt.Sprintf("[%s]", strings.Join(references, ", "))
 }
+
+// inferredReference references an inferred owner, which is either a team or a
+// commit author identified by email.
+func inferredReference(o database.InferredOwner) own.Reference {
+	if o.TeamID != 0 {
+		return own.Reference{TeamID: o.TeamID}
+	}
+	return own.Reference{Email: o.AuthorEmail}
+}
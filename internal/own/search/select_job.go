@@ -2,6 +2,7 @@ package search
 
 import (
 	"context"
+	"slices"
 	"sync"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -55,16 +56,19 @@ func (s *selectOwnersJob) Run(ctx context.Context, clients job.RuntimeClients, s
 			defer bagMu.Unlock()
 			for _, m := range matches {
 				for _, r := range m.references {
-					bag.Add(r)
+					bag.Add(r.Reference)
 				}
 			}
 			bag.Resolve(ctx, clients.DB)
 		}()
 		var results result.Matches
+		// An owner can be found in several CODEOWNERS sections. Results of this
+		// event list all of them.
+		resultsByKey := map[result.Key]*result.OwnerMatch{}
 		for _, m := range matches {
 		nextReference:
 			for _, r := range m.references {
-				ro, found := bag.FindResolved(r)
+				ro, found := bag.FindResolved(r.Reference)
 				if !found {
 					guess := r.ResolutionGuess()
 					// No text references found to make a guess, something is wrong.
@@ -81,9 +85,15 @@ func (s *selectOwnersJob) Run(ctx context.Context, clients job.RuntimeClients, s
 						Repo:          m.fileMatch.Repo,
 						CommitID:      m.fileMatch.CommitID,
 					}
+					if r.section != "" {
+						om.Sections = []string{r.section}
+					}
 					if !dedup.Seen(om) {
 						dedup.Add(om)
 						results = append(results, om)
+						resultsByKey[om.Key()] = om
+					} else if seen, ok := resultsByKey[om.Key()]; ok && r.section != "" && !slices.Contains(seen.Sections, r.section) {
+						seen.Sections = append(seen.Sections, r.section)
 					}
 				}
 			}
@@ -121,7 +131,7 @@ func (s *selectOwnersJob) MapChildren(fn job.MapFunc) job.Job {
 
 type ownerFileMatch struct {
 	fileMatch  *result.FileMatch
-	references []own.Reference
+	references []sectionReference
 }
 
 func getCodeOwnersFromMatches(
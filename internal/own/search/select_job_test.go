@@ -16,6 +16,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/own"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/job"
 	"github.com/sourcegraph/sourcegraph/internal/search/job/mockjob"
@@ -35,6 +36,7 @@ func TestGetCodeOwnersFromMatches(t *testing.T) {
 		db.AssignedOwnersFunc.SetDefaultReturn(dbmocks.NewMockAssignedOwnersStore())
 		db.AssignedTeamsFunc.SetDefaultReturn(dbmocks.NewMockAssignedTeamsStore())
 		db.ReposFunc.SetDefaultReturn(repoStore)
+		db.OwnSignalConfigurationsFunc.SetDefaultReturn(dbmocks.NewMockSignalConfigurationStore())
 		return db
 	}
 
@@ -214,6 +216,115 @@ func TestGetCodeOwnersFromMatches(t *testing.T) {
 		autogold.Expect(want).Equal(t, matches)
 		// TODO: What about hasnoresults?
 	})
+
+	t.Run("returns codeowners sections of owners", func(t *testing.T) {
+		ctx := context.Background()
+
+		gitserverClient := gitserver.NewMockClient()
+		gitserverClient.NewFileReaderFunc.SetDefaultHook(func(ctx context.Context, rn api.RepoName, ci api.CommitID, s string) (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader([]byte(`*.md @docs-owner
+
+[Frontend] @frontend-team
+/client/
+
+^[Docs]
+/client/README.md @docs-owner
+`))), nil
+		})
+		mockUserStore := dbmocks.NewMockUserStore()
+		mockUserStore.GetByUsernameFunc.SetDefaultReturn(nil, database.MockUserNotFoundErr)
+		mockUserStore.GetByVerifiedEmailFunc.SetDefaultReturn(nil, database.MockUserNotFoundErr)
+		mockTeamStore := dbmocks.NewMockTeamStore()
+		mockTeamStore.GetTeamByNameFunc.SetDefaultReturn(nil, database.TeamNotFoundError{})
+		db := setupDB()
+		db.UsersFunc.SetDefaultReturn(mockUserStore)
+		db.UserEmailsFunc.SetDefaultReturn(dbmocks.NewMockUserEmailsStore())
+		db.TeamsFunc.SetDefaultReturn(mockTeamStore)
+		db.UserExternalAccountsFunc.SetDefaultReturn(dbmocks.NewMockUserExternalAccountsStore())
+
+		mockJob := mockjob.NewMockJob()
+		mockJob.RunFunc.SetDefaultHook(func(ctx context.Context, _ job.RuntimeClients, s streaming.Sender) (*search.Alert, error) {
+			s.Send(streaming.SearchEvent{
+				Results: []result.Match{
+					&result.FileMatch{File: result.File{Path: "client/README.md"}},
+				},
+			})
+			return nil, nil
+		})
+		j := &selectOwnersJob{child: mockJob}
+		s := streaming.NewAggregatingStream()
+		_, err := j.Run(ctx, job.RuntimeClients{Gitserver: gitserverClient, DB: db}, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sections := map[string][]string{}
+		for _, m := range s.Results {
+			om := m.(*result.OwnerMatch)
+			sections[om.ResolvedOwner.Identifier()] = om.Sections
+		}
+		// The owner of the rule outside of any section has no section, but
+		// is owner in the docs section as well.
+		assert.Equal(t, map[string][]string{
+			"Person:docs-owner":    {"docs"},
+			"Person:frontend-team": {"frontend"},
+		}, sections)
+	})
+
+	t.Run("returns inferred owners of files without codeowners", func(t *testing.T) {
+		ctx := context.Background()
+
+		gitserverClient := gitserver.NewMockClient()
+		gitserverClient.NewFileReaderFunc.SetDefaultReturn(nil, fs.ErrNotExist)
+		mockUserStore := dbmocks.NewMockUserStore()
+		mockUserStore.GetByVerifiedEmailFunc.SetDefaultReturn(nil, database.MockUserNotFoundErr)
+		team := newTestTeam("backend")
+		mockTeamStore := dbmocks.NewMockTeamStore()
+		mockTeamStore.GetTeamByIDFunc.SetDefaultHook(func(_ context.Context, id int32) (*types.Team, error) {
+			if id == team.ID {
+				return team, nil
+			}
+			return nil, database.TeamNotFoundError{}
+		})
+		signals := dbmocks.NewMockSignalConfigurationStore()
+		signals.IsEnabledFunc.SetDefaultHook(func(_ context.Context, name string) (bool, error) {
+			return name == "inferred-ownership", nil
+		})
+		inferredStore := dbmocks.NewMockInferredOwnershipStore()
+		inferredStore.ListForRepoFunc.SetDefaultReturn([]*database.InferredOwner{
+			{FilePath: "", AuthorEmail: "bob@example.com", Confidence: 0.5},
+			{FilePath: "src", AuthorEmail: "alice@example.com", Confidence: 0.8},
+			{FilePath: "src", TeamID: team.ID, Confidence: 0.8},
+		}, nil)
+		db := setupDB()
+		db.UsersFunc.SetDefaultReturn(mockUserStore)
+		db.UserEmailsFunc.SetDefaultReturn(dbmocks.NewMockUserEmailsStore())
+		db.TeamsFunc.SetDefaultReturn(mockTeamStore)
+		db.UserExternalAccountsFunc.SetDefaultReturn(dbmocks.NewMockUserExternalAccountsStore())
+		db.OwnSignalConfigurationsFunc.SetDefaultReturn(signals)
+		db.InferredOwnershipFunc.SetDefaultReturn(inferredStore)
+		rules := NewRulesCache(gitserverClient, db)
+
+		matches, hasNoResults, err := getCodeOwnersFromMatches(ctx, &rules, []result.Match{
+			&result.FileMatch{File: result.File{Path: "src/lib/main.go"}},
+			&result.FileMatch{File: result.File{Path: "README.md"}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.False(t, hasNoResults)
+
+		owners := map[string][]own.Reference{}
+		for _, m := range matches {
+			for _, r := range m.references {
+				owners[m.fileMatch.Path] = append(owners[m.fileMatch.Path], own.Reference{Email: r.Email, TeamID: r.TeamID})
+			}
+		}
+		assert.Equal(t, map[string][]own.Reference{
+			"src/lib/main.go": {{Email: "alice@example.com"}, {TeamID: team.ID}},
+			"README.md":       {{Email: "bob@example.com"}},
+		}, owners)
+	})
 }
 
 func newTestUser(username string) *types.User {
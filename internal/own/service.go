@@ -10,6 +10,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
+	"github.com/sourcegraph/sourcegraph/internal/own/types"
 )
 
 // Service gives access to code ownership data.
@@ -35,6 +36,11 @@ type Service interface {
 	// team of 'src/test' in a given repo transitively owns all files within the
 	// directory tree at that root like 'src/test/com/sourcegraph/Test.java'.
 	AssignedTeams(context.Context, api.RepoID, api.CommitID) (AssignedTeams, error)
+
+	// InferredOwnership returns the owners of directories of given repo which
+	// were inferred from its commit history by the inferred-ownership signal.
+	// Nothing is returned if the signal is disabled.
+	InferredOwnership(context.Context, api.RepoID) (InferredOwners, error)
 }
 
 type AssignedOwners map[string][]database.AssignedOwnerSummary
@@ -57,6 +63,22 @@ func (at AssignedTeams) Match(path string) []database.AssignedTeamSummary {
 	return match(at, path)
 }
 
+type InferredOwners map[string][]database.InferredOwner
+
+// Match returns the inferred owners of the closest directory containing the
+// given path which has inferred owners. Unlike assigned ownership, inferred
+// owners of parent directories are not inherited when a directory has inferred
+// owners of its own, since they were inferred from the same history.
+func (io InferredOwners) Match(path string) []database.InferredOwner {
+	for lastSlash := len(path); lastSlash != -1; lastSlash = strings.LastIndex(path, "/") {
+		path = path[:lastSlash]
+		if owners, ok := io[path]; ok {
+			return owners
+		}
+	}
+	return io[""]
+}
+
 func match[T any](assigned map[string][]T, path string) []T {
 	var summaries []T
 	for lastSlash := len(path); lastSlash != -1; lastSlash = strings.LastIndex(path, "/") {
@@ -171,3 +193,19 @@ func (s *service) AssignedTeams(ctx context.Context, repoID api.RepoID, _ api.Co
 	}
 	return assignedTeams, nil
 }
+
+func (s *service) InferredOwnership(ctx context.Context, repoID api.RepoID) (InferredOwners, error) {
+	enabled, err := s.db.OwnSignalConfigurations().IsEnabled(ctx, types.SignalInferredOwnership)
+	if err != nil || !enabled {
+		return nil, err
+	}
+	summaries, err := s.db.InferredOwnership().ListForRepo(ctx, repoID)
+	if err != nil {
+		return nil, err
+	}
+	inferredOwners := InferredOwners{}
+	for _, summary := range summaries {
+		inferredOwners[summary.FilePath] = append(inferredOwners[summary.FilePath], *summary)
+	}
+	return inferredOwners, nil
+}
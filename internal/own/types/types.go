@@ -20,5 +20,7 @@ type CodeownersFile struct {
 const (
 	SignalRecentContributors = "recent-contributors"
 	SignalRecentViews        = "recent-views"
+	SignalInferredOwnership  = "inferred-ownership"
+	SignalCodeownersLint     = "codeowners-lint"
 	Analytics                = "analytics"
 )
@@ -0,0 +1,254 @@
+package repos
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// A GiteaSource yields repositories from a single Gitea (or Forgejo)
+// connection configured in Sourcegraph via the external services
+// configuration.
+type GiteaSource struct {
+	svc       *types.ExternalService
+	config    *schema.GiteaConnection
+	cli       gitea.Client
+	serviceID string
+	excluder  repoExcluder
+	logger    log.Logger
+}
+
+// NewGiteaSource returns a new GiteaSource from the given external service.
+func NewGiteaSource(ctx context.Context, logger log.Logger, svc *types.ExternalService, cf *httpcli.Factory) (*GiteaSource, error) {
+	rawConfig, err := svc.Config.Decrypt(ctx)
+	if err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	var c schema.GiteaConnection
+	if err := jsonc.Unmarshal(rawConfig, &c); err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d config error", svc.ID)
+	}
+
+	if cf == nil {
+		cf = httpcli.ExternalClientFactory
+	}
+
+	httpCli, err := cf.Doer()
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(c.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	var ex repoExcluder
+	excludeArchived := func(repo any) bool {
+		if r, ok := repo.(gitea.Repository); ok {
+			return r.Archived
+		}
+		return false
+	}
+	excludeFork := func(repo any) bool {
+		if r, ok := repo.(gitea.Repository); ok {
+			return r.Fork
+		}
+		return false
+	}
+	for _, r := range c.Exclude {
+		rule := NewRule().
+			Exact(r.Name).
+			Pattern(r.Pattern)
+		if r.Archived {
+			rule.Generic(excludeArchived)
+		}
+		if r.Forks {
+			rule.Generic(excludeFork)
+		}
+		ex.AddRule(rule)
+	}
+	if err := ex.RuleErrors(); err != nil {
+		return nil, err
+	}
+
+	cli, err := gitea.NewClient(svc.URN(), u, &auth.OAuthBearerToken{Token: c.Token}, httpCli)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GiteaSource{
+		svc:       svc,
+		config:    &c,
+		cli:       cli,
+		serviceID: extsvc.NormalizeBaseURL(cli.GetURL()).String(),
+		excluder:  ex,
+		logger:    logger,
+	}, nil
+}
+
+// CheckConnection checks that the configured token can be used to talk to the
+// Gitea API.
+func (s *GiteaSource) CheckConnection(ctx context.Context) error {
+	if _, err := s.cli.GetAuthenticatedUser(ctx); err != nil {
+		return errors.Wrap(err, "connection check failed. could not fetch authenticated user")
+	}
+	return nil
+}
+
+// ListRepos returns all Gitea repositories configured with this GiteaSource's
+// config. If no orgs, users or repos are configured, all repositories the
+// token has access to are listed.
+func (s *GiteaSource) ListRepos(ctx context.Context, results chan SourceResult) {
+	seen := make(map[int64]struct{})
+	emit := func(r *gitea.Repository) {
+		if _, ok := seen[r.ID]; ok {
+			return
+		}
+		seen[r.ID] = struct{}{}
+		if s.excludes(r) {
+			return
+		}
+		results <- SourceResult{Source: s, Repo: s.makeRepo(r)}
+	}
+
+	listAll := func(list func(page int) ([]*gitea.Repository, bool, error)) error {
+		for page := 1; ; page++ {
+			repos, hasNext, err := list(page)
+			if err != nil {
+				return err
+			}
+			for _, r := range repos {
+				emit(r)
+			}
+			if !hasNext {
+				return nil
+			}
+		}
+	}
+
+	if len(s.config.Orgs) == 0 && len(s.config.Users) == 0 && len(s.config.Repos) == 0 {
+		if err := listAll(func(page int) ([]*gitea.Repository, bool, error) {
+			return s.cli.ListAuthenticatedUserRepos(ctx, page)
+		}); err != nil {
+			results <- SourceResult{Source: s, Err: errors.Wrap(err, "listing repositories of authenticated user")}
+		}
+		return
+	}
+
+	for _, org := range s.config.Orgs {
+		if err := listAll(func(page int) ([]*gitea.Repository, bool, error) {
+			return s.cli.ListOrgRepos(ctx, org, page)
+		}); err != nil {
+			results <- SourceResult{Source: s, Err: errors.Wrapf(err, "listing repositories of organization %q", org)}
+		}
+	}
+
+	for _, user := range s.config.Users {
+		if err := listAll(func(page int) ([]*gitea.Repository, bool, error) {
+			return s.cli.ListUserRepos(ctx, user, page)
+		}); err != nil {
+			results <- SourceResult{Source: s, Err: errors.Wrapf(err, "listing repositories of user %q", user)}
+		}
+	}
+
+	for _, nameWithOwner := range s.config.Repos {
+		owner, name, ok := strings.Cut(nameWithOwner, "/")
+		if !ok {
+			results <- SourceResult{Source: s, Err: errors.Errorf("invalid repository name %q", nameWithOwner)}
+			continue
+		}
+		r, err := s.cli.GetRepo(ctx, owner, name)
+		if err != nil {
+			if gitea.IsNotFound(err) {
+				s.logger.Warn("skipping missing gitea repository", log.String("repo", nameWithOwner))
+				continue
+			}
+			results <- SourceResult{Source: s, Err: errors.Wrapf(err, "getting repository %q", nameWithOwner)}
+			continue
+		}
+		emit(r)
+	}
+}
+
+// ExternalServices returns a singleton slice containing the external service.
+func (s *GiteaSource) ExternalServices() types.ExternalServices {
+	return types.ExternalServices{s.svc}
+}
+
+// WithAuthenticator returns a copy of the original Source configured to use the
+// given authenticator, provided that authenticator type is supported by the
+// code host.
+func (s *GiteaSource) WithAuthenticator(a auth.Authenticator) (Source, error) {
+	sc := *s
+	cli, err := sc.cli.WithAuthenticator(a)
+	if err != nil {
+		return nil, err
+	}
+	sc.cli = cli
+
+	return &sc, nil
+}
+
+func (s *GiteaSource) excludes(r *gitea.Repository) bool {
+	return s.excluder.ShouldExclude(r.FullName) || s.excluder.ShouldExclude(*r)
+}
+
+func (s *GiteaSource) makeRepo(r *gitea.Repository) *types.Repo {
+	host := s.cli.GetURL().Host
+	urn := s.svc.URN()
+
+	cloneURL := r.CloneURL
+	if s.config.GitURLType == "ssh" {
+		cloneURL = r.SSHURL
+	}
+
+	private := !r.IsPublic()
+	if r.IsInternal() && s.config.MarkInternalReposAsPublic {
+		private = false
+	}
+
+	return &types.Repo{
+		Name: reposource.GiteaRepoName(
+			s.config.RepositoryPathPattern,
+			host,
+			r.FullName,
+		),
+		URI: string(reposource.GiteaRepoName(
+			"",
+			host,
+			r.FullName,
+		)),
+		Description: r.Description,
+		Fork:        r.Fork,
+		Archived:    r.Archived,
+		Private:     private,
+		Topics:      r.Topics,
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          strconv.FormatInt(r.ID, 10),
+			ServiceType: extsvc.TypeGitea,
+			ServiceID:   s.serviceID,
+		},
+		Sources: map[string]*types.SourceInfo{
+			urn: {
+				ID:       urn,
+				CloneURL: cloneURL,
+			},
+		},
+		Metadata: r,
+	}
+}
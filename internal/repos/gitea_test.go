@@ -0,0 +1,108 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
+	"github.com/sourcegraph/sourcegraph/internal/testutil"
+	"github.com/sourcegraph/sourcegraph/internal/types/typestest"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestGiteaSource_ListRepos(t *testing.T) {
+	conf := &schema.GiteaConnection{
+		Url:   "https://gitea.sgdev.org",
+		Token: "secret-token",
+		Orgs:  []string{"sourcegraph"},
+		Repos: []string{"alice/dotfiles", "sourcegraph/src-cli", "alice/missing"},
+		Exclude: []*schema.ExcludedGiteaRepo{
+			{Archived: true},
+			{Pattern: "^sourcegraph/tmp-"},
+		},
+	}
+	cf, save := NewClientFactory(t, t.Name())
+	defer save(t)
+
+	svc := typestest.MakeExternalService(t, extsvc.VariantGitea, conf)
+
+	ctx := context.Background()
+	src, err := NewGiteaSource(ctx, logtest.Scoped(t), svc, cf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repos, err := ListAll(ctx, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertGolden(t, "testdata/sources/"+t.Name(), Update(t.Name()), repos)
+}
+
+func TestGiteaSource_makeRepo_Private(t *testing.T) {
+	repos := map[string]*gitea.Repository{
+		"public":                 {FullName: "alice/public", Owner: &gitea.User{Visibility: gitea.VisibilityPublic}},
+		"private":                {FullName: "alice/private", Private: true},
+		"internal":               {FullName: "alice/internal", Internal: true},
+		"limited owner":          {FullName: "limited/repo", Owner: &gitea.User{Visibility: gitea.VisibilityLimited}},
+		"private owner":          {FullName: "hidden/repo", Owner: &gitea.User{Visibility: gitea.VisibilityPrivate}},
+		"private in public org":  {FullName: "sourcegraph/private", Private: true, Owner: &gitea.User{Visibility: gitea.VisibilityPublic}},
+		"visibility unsupported": {FullName: "old/repo", Owner: &gitea.User{}},
+	}
+
+	for _, tc := range []struct {
+		markInternalReposAsPublic bool
+		want                      map[string]bool
+	}{
+		{
+			markInternalReposAsPublic: false,
+			want: map[string]bool{
+				"public":                 false,
+				"private":                true,
+				"internal":               true,
+				"limited owner":          true,
+				"private owner":          true,
+				"private in public org":  true,
+				"visibility unsupported": false,
+			},
+		},
+		{
+			markInternalReposAsPublic: true,
+			want: map[string]bool{
+				"public":                 false,
+				"private":                true,
+				"internal":               false,
+				"limited owner":          false,
+				"private owner":          true,
+				"private in public org":  true,
+				"visibility unsupported": false,
+			},
+		},
+	} {
+		t.Run(fmt.Sprintf("markInternalReposAsPublic=%t", tc.markInternalReposAsPublic), func(t *testing.T) {
+			svc := typestest.MakeExternalService(t, extsvc.VariantGitea, &schema.GiteaConnection{
+				Url:                       "https://gitea.sgdev.org",
+				Token:                     "secret-token",
+				MarkInternalReposAsPublic: tc.markInternalReposAsPublic,
+			})
+			src, err := NewGiteaSource(context.Background(), logtest.Scoped(t), svc, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := make(map[string]bool, len(repos))
+			for name, r := range repos {
+				got[name] = src.makeRepo(r).Private
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Fatalf("unexpected private flags (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
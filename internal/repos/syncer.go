@@ -22,6 +22,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/licensing"
 	"github.com/sourcegraph/sourcegraph/internal/metrics"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/repos/webhooks"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -179,13 +180,17 @@ func (e ErrAccountSuspended) AccountSuspended() bool {
 	return true
 }
 
-func (s *Syncer) notifyDeleted(ctx context.Context, deleted ...api.RepoID) {
+func (s *Syncer) notifyDeleted(ctx context.Context, hooks *webhooks.Enqueuer, deleted ...api.RepoID) {
 	var d types.RepoSyncDiff
 	for _, id := range deleted {
 		d.Deleted = append(d.Deleted, &types.Repo{ID: id})
 	}
 	observeDiff(d)
 
+	for _, id := range deleted {
+		hooks.RepoDeleted(ctx, id)
+	}
+
 	if s.Synced != nil && d.Len() > 0 {
 		select {
 		case <-ctx.Done():
@@ -279,6 +284,10 @@ func (s *Syncer) SyncExternalService(
 		logger.Warn("connection check failed. syncing repositories might still succeed.", log.Error(err))
 	}
 
+	// Look up the subscribed outbound webhooks once for the whole sync, rather
+	// than for every synced repo.
+	hooks := webhooks.NewEnqueuer(ctx, logger, s.Store)
+
 	results := make(chan SourceResult)
 	go func() {
 		src.ListRepos(ctx, results)
@@ -345,7 +354,7 @@ func (s *Syncer) SyncExternalService(
 		}
 
 		var diff types.RepoSyncDiff
-		if diff, err = s.sync(ctx, svc, sourced); err != nil {
+		if diff, err = s.sync(ctx, svc, sourced, hooks); err != nil {
 			syncProgress.Errors++
 			logger.Error("failed to sync, skipping", log.String("repo", string(sourced.Name)), log.Error(err))
 			errs = errors.Append(errs, err)
@@ -410,7 +419,7 @@ func (s *Syncer) SyncExternalService(
 		// or all of our errors are warnings and either Forbidden or Unauthorized,
 		// or we had one of the fatal errors and the service is not site owned.
 		var deletedErr error
-		deleted, deletedErr = s.delete(ctx, svc, seen)
+		deleted, deletedErr = s.delete(ctx, svc, seen, hooks)
 		if deletedErr != nil {
 			logger.Warn("failed to delete some repos",
 				log.Int("seen", len(seen)),
@@ -437,12 +446,19 @@ func (s *Syncer) SyncExternalService(
 }
 
 // syncs a sourced repo of a given external service, returning a diff with a single repo.
-func (s *Syncer) sync(ctx context.Context, svc *types.ExternalService, sourced *types.Repo) (d types.RepoSyncDiff, err error) {
+func (s *Syncer) sync(ctx context.Context, svc *types.ExternalService, sourced *types.Repo, hooks *webhooks.Enqueuer) (d types.RepoSyncDiff, err error) {
 	tx, err := s.Store.Transact(ctx)
 	if err != nil {
 		return types.RepoSyncDiff{}, errors.Wrap(err, "syncer: opening transaction")
 	}
 
+	// previousName is the name of an existing repo before it was updated, so
+	// that we can tell whether it was renamed.
+	var previousName api.RepoName
+	// conflicting is the ID of a repo that was deleted because sourced took
+	// over its name, if any.
+	var conflicting api.RepoID
+
 	defer func() {
 		observeDiff(d)
 		// We must commit the transaction before publishing to s.Synced
@@ -455,6 +471,11 @@ func (s *Syncer) sync(ctx context.Context, svc *types.ExternalService, sourced *
 			return
 		}
 
+		if conflicting != 0 {
+			hooks.RepoDeleted(ctx, conflicting)
+		}
+		s.enqueueWebhooks(ctx, hooks, d, previousName)
+
 		if s.Synced != nil && d.Len() > 0 {
 			select {
 			case <-ctx.Done():
@@ -490,19 +511,20 @@ func (s *Syncer) sync(ctx context.Context, svc *types.ExternalService, sourced *
 
 		// Pick this sourced repo to own the name by deleting the other repo. If it still exists, it'll have a different
 		// name when we source it from the same code host, and it will be re-created.
-		var conflicting, existing *types.Repo
+		var conflictingRepo, existing *types.Repo
 		for _, r := range stored {
 			if r.ExternalRepo.Equal(&sourced.ExternalRepo) {
 				existing = r
 			} else {
-				conflicting = r
+				conflictingRepo = r
 			}
 		}
 
-		// invariant: conflicting can't be nil due to our database constraints
-		if err = tx.RepoStore().Delete(ctx, conflicting.ID); err != nil {
+		// invariant: conflictingRepo can't be nil due to our database constraints
+		if err = tx.RepoStore().Delete(ctx, conflictingRepo.ID); err != nil {
 			return types.RepoSyncDiff{}, errors.Wrap(err, "syncer: failed to delete conflicting repo")
 		}
+		conflicting = conflictingRepo.ID
 
 		// We fallthrough to the next case after removing the conflicting repo in order to update
 		// the winner (i.e. existing). This works because we mutate stored to contain it, which the case expects.
@@ -515,6 +537,7 @@ func (s *Syncer) sync(ctx context.Context, svc *types.ExternalService, sourced *
 		if err := UpdateRepoLicenseHook(ctx, tx, stored[0], sourced); err != nil {
 			return types.RepoSyncDiff{}, LicenseError{errors.Wrapf(err, "syncer: failed to update repo %s", sourced.Name)}
 		}
+		previousName = stored[0].Name
 		modified := stored[0].Update(sourced)
 		if modified == types.RepoUnmodified {
 			d.Unmodified = append(d.Unmodified, stored[0])
@@ -617,15 +640,28 @@ func UpdateRepoLicenseHook(ctx context.Context, s Store, existingRepo *types.Rep
 	return licensing.NewFeatureNotActivatedError("The private repositories feature is not activated for this license. Please upgrade your license to use this feature.")
 }
 
-func (s *Syncer) delete(ctx context.Context, svc *types.ExternalService, seen map[api.RepoID]struct{}) (int, error) {
+func (s *Syncer) delete(ctx context.Context, svc *types.ExternalService, seen map[api.RepoID]struct{}, hooks *webhooks.Enqueuer) (int, error) {
 	// We do deletion in a best effort manner, returning any errors for individual repos that failed to be deleted.
 	deleted, err := s.Store.DeleteExternalServiceReposNotIn(ctx, svc, seen)
 
-	s.notifyDeleted(ctx, deleted...)
+	s.notifyDeleted(ctx, hooks, deleted...)
 
 	return len(deleted), err
 }
 
+// enqueueWebhooks enqueues outbound webhooks for the repos that were added or
+// renamed by a committed sync.
+func (s *Syncer) enqueueWebhooks(ctx context.Context, hooks *webhooks.Enqueuer, d types.RepoSyncDiff, previousName api.RepoName) {
+	for _, r := range d.Added {
+		hooks.RepoAdded(ctx, r)
+	}
+	for _, m := range d.Modified {
+		if m.Modified&types.RepoModifiedName == types.RepoModifiedName {
+			hooks.RepoRenamed(ctx, m.Repo, previousName)
+		}
+	}
+}
+
 func observeDiff(diff types.RepoSyncDiff) {
 	for state, repos := range map[string]types.Repos{
 		"added":      diff.Added,
@@ -0,0 +1,38 @@
+package webhooks
+
+import "github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
+
+const (
+	RepoAdded          = "repo:added"
+	RepoDeleted        = "repo:deleted"
+	RepoRenamed        = "repo:renamed"
+	RepoCloneCompleted = "repo:clone_completed"
+	RepoCloneFailed    = "repo:clone_failed"
+)
+
+func init() {
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         RepoAdded,
+		Description: "sent when a repository is added by a code host connection sync",
+	})
+
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         RepoDeleted,
+		Description: "sent when a repository is removed because no code host connection yields it anymore",
+	})
+
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         RepoRenamed,
+		Description: "sent when a repository is renamed on its code host",
+	})
+
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         RepoCloneCompleted,
+		Description: "sent when a repository has been cloned for the first time",
+	})
+
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         RepoCloneFailed,
+		Description: "sent when a repository fails to clone; failed retries are not sent again until a fetch of the repository has succeeded",
+	})
+}
@@ -0,0 +1,244 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/encryption"
+	"github.com/sourcegraph/sourcegraph/internal/encryption/keyring"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
+)
+
+var service struct {
+	once sync.Once
+	key  encryption.Key
+}
+
+func getKey() encryption.Key {
+	service.once.Do(func() {
+		service.key = keyring.Default().OutboundWebhookKey
+	})
+	return service.key
+}
+
+// repo represents a repository in a webhook payload.
+type repo struct {
+	ID           *graphql.ID  `json:"id,omitempty"`
+	Name         api.RepoName `json:"name,omitempty"`
+	PreviousName api.RepoName `json:"previous_name,omitempty"`
+	URL          string       `json:"url,omitempty"`
+	Error        string       `json:"error,omitempty"`
+}
+
+func marshalRepoID(id api.RepoID) *graphql.ID {
+	gqlID := relay.MarshalID("Repository", id)
+	return &gqlID
+}
+
+func newRepo(r *types.Repo) repo {
+	return repo{
+		ID:   marshalRepoID(r.ID),
+		Name: r.Name,
+		URL:  r.URI,
+	}
+}
+
+// Enqueue creates an outbound webhook job that will dispatch a webhook of the
+// given type with the given payload marshalled as JSON.
+//
+// No job is created if no outbound webhook is subscribed to the event type.
+// This is checked on every call, so callers emitting many events should use an
+// Enqueuer instead.
+func Enqueue(
+	ctx context.Context, logger log.Logger, db basestore.ShareableStore,
+	eventType string, payload any,
+) {
+	// Webhooks are generally intended to be fire and forget from the point of
+	// view of calling code, so we'll simply log on error and carry on.
+	logger = logger.With(log.String("event_type", eventType))
+
+	key := getKey()
+	subscribed, err := database.OutboundWebhooksWith(db, key).Count(ctx, database.OutboundWebhookCountOpts{
+		EventTypes: []database.FilterEventType{{EventType: eventType}},
+	})
+	if err != nil {
+		logger.Error("error counting subscribed outbound webhooks", log.Error(err))
+		return
+	}
+	if subscribed == 0 {
+		return
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		logger.Error("error marshalling webhook payload", log.Error(err))
+		return
+	}
+
+	if err := outbound.NewOutboundWebhookService(db, key).Enqueue(ctx, eventType, nil, data); err != nil {
+		logger.Error("error enqueuing webhook job", log.Error(err))
+		return
+	}
+}
+
+// Enqueuer enqueues repository webhooks. It checks which repository event
+// types have a subscribed outbound webhook once, when it is created, or
+// periodically for long-lived Enqueuers, so that callers emitting many events,
+// such as a code host connection sync, don't have to query the subscriptions
+// for every event.
+type Enqueuer struct {
+	logger log.Logger
+	db     basestore.ShareableStore
+
+	// maxAge is how long the subscribed event types are used before they are
+	// loaded again. Zero means they are only loaded once.
+	maxAge time.Duration
+
+	mu         sync.Mutex
+	subscribed map[string]bool
+	loadedAt   time.Time
+}
+
+// NewEnqueuer returns an Enqueuer for the repository event types that
+// currently have at least one outbound webhook subscribed.
+func NewEnqueuer(ctx context.Context, logger log.Logger, db basestore.ShareableStore) *Enqueuer {
+	e := &Enqueuer{logger: logger, db: db, subscribed: map[string]bool{}}
+	e.load(ctx)
+	return e
+}
+
+// NewLongLivedEnqueuer returns an Enqueuer meant to be kept for the lifetime
+// of a service, such as gitserver. The subscribed event types are loaded when
+// the first event is enqueued and loaded again once they are older than
+// maxAge, so that webhooks created or deleted in the meantime are picked up.
+func NewLongLivedEnqueuer(logger log.Logger, db basestore.ShareableStore, maxAge time.Duration) *Enqueuer {
+	return &Enqueuer{logger: logger, db: db, maxAge: maxAge, subscribed: map[string]bool{}}
+}
+
+// load loads the event types that have a subscribed outbound webhook. It must
+// be called with e.mu held, or before e is shared.
+func (e *Enqueuer) load(ctx context.Context) {
+	// Set loadedAt even if listing fails, so that we don't query the database
+	// for every event while it is unavailable.
+	e.loadedAt = time.Now()
+
+	eventTypes := []string{RepoAdded, RepoDeleted, RepoRenamed, RepoCloneCompleted, RepoCloneFailed}
+	filters := make([]database.FilterEventType, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		filters = append(filters, database.FilterEventType{EventType: eventType})
+	}
+
+	hooks, err := database.OutboundWebhooksWith(e.db, getKey()).List(ctx, database.OutboundWebhookListOpts{
+		OutboundWebhookCountOpts: database.OutboundWebhookCountOpts{EventTypes: filters},
+	})
+	if err != nil {
+		// As in Enqueue, we only log the error: no webhooks will be enqueued.
+		e.logger.Error("error listing subscribed outbound webhooks", log.Error(err))
+		e.subscribed = map[string]bool{}
+		return
+	}
+
+	subscribed := map[string]bool{}
+	for _, webhook := range hooks {
+		for _, et := range webhook.EventTypes {
+			subscribed[et.EventType] = true
+		}
+	}
+	e.subscribed = subscribed
+}
+
+func (e *Enqueuer) isSubscribed(ctx context.Context, eventType string) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.maxAge > 0 && time.Since(e.loadedAt) > e.maxAge {
+		e.load(ctx)
+	}
+	return e.subscribed[eventType]
+}
+
+func (e *Enqueuer) enqueue(ctx context.Context, eventType string, payload any) {
+	if !e.isSubscribed(ctx, eventType) {
+		return
+	}
+
+	logger := e.logger.With(log.String("event_type", eventType))
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		logger.Error("error marshalling webhook payload", log.Error(err))
+		return
+	}
+
+	if err := outbound.NewOutboundWebhookService(e.db, getKey()).Enqueue(ctx, eventType, nil, data); err != nil {
+		logger.Error("error enqueuing webhook job", log.Error(err))
+	}
+}
+
+// RepoAdded enqueues a RepoAdded webhook for the given repository.
+func (e *Enqueuer) RepoAdded(ctx context.Context, r *types.Repo) {
+	e.enqueue(ctx, RepoAdded, newRepo(r))
+}
+
+// RepoRenamed enqueues a RepoRenamed webhook for the given repository, which
+// was previously known as previousName.
+func (e *Enqueuer) RepoRenamed(ctx context.Context, r *types.Repo, previousName api.RepoName) {
+	payload := newRepo(r)
+	payload.PreviousName = previousName
+	e.enqueue(ctx, RepoRenamed, payload)
+}
+
+// RepoDeleted enqueues a RepoDeleted webhook for the repository with the given
+// ID. Deleted repositories have their name mangled, so only the ID is included
+// in the payload.
+func (e *Enqueuer) RepoDeleted(ctx context.Context, id api.RepoID) {
+	e.enqueue(ctx, RepoDeleted, repo{ID: marshalRepoID(id)})
+}
+
+// CloneCompleted enqueues a RepoCloneCompleted webhook for the repository with
+// the given name.
+func (e *Enqueuer) CloneCompleted(ctx context.Context, name api.RepoName) {
+	if !e.isSubscribed(ctx, RepoCloneCompleted) {
+		return
+	}
+	e.enqueue(ctx, RepoCloneCompleted, e.cloneRepo(ctx, name))
+}
+
+// cloneFailedMessage is the error included in RepoCloneFailed payloads. The
+// actual clone error contains the output of the clone command, which can be
+// large and can contain details about the code host that must not leave the
+// instance, so site admins have to look at the repository's status for it.
+const cloneFailedMessage = "failed to clone repository, see its mirroring status for details"
+
+// CloneFailed enqueues a RepoCloneFailed webhook for the repository with the
+// given name.
+func (e *Enqueuer) CloneFailed(ctx context.Context, name api.RepoName) {
+	if !e.isSubscribed(ctx, RepoCloneFailed) {
+		return
+	}
+	payload := e.cloneRepo(ctx, name)
+	payload.Error = cloneFailedMessage
+	e.enqueue(ctx, RepoCloneFailed, payload)
+}
+
+// cloneRepo returns the payload for a clone event of the repository with the
+// given name. Gitserver only knows repositories by name, so the repository is
+// looked up to include its ID like the other repository events do.
+func (e *Enqueuer) cloneRepo(ctx context.Context, name api.RepoName) repo {
+	r, err := database.ReposWith(e.logger, e.db).GetByName(ctx, name)
+	if err != nil {
+		e.logger.Warn("error getting cloned repo, sending webhook without its ID", log.String("repo", string(name)), log.Error(err))
+		return repo{Name: name}
+	}
+	return newRepo(r)
+}
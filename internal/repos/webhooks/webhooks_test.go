@@ -0,0 +1,90 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
+)
+
+func TestEventTypesRegistered(t *testing.T) {
+	registered := map[string]bool{}
+	for _, et := range outbound.GetRegisteredEventTypes() {
+		registered[et.Key] = true
+	}
+
+	for _, key := range []string{RepoAdded, RepoDeleted, RepoRenamed, RepoCloneCompleted, RepoCloneFailed} {
+		assert.True(t, registered[key], "event type %q is not registered", key)
+	}
+}
+
+func TestRepoPayload(t *testing.T) {
+	r := &types.Repo{ID: 42, Name: "github.com/sourcegraph/sourcegraph", URI: "github.com/sourcegraph/sourcegraph"}
+
+	for name, tc := range map[string]struct {
+		payload repo
+		want    string
+	}{
+		"added": {
+			payload: newRepo(r),
+			want:    `{"id":"UmVwb3NpdG9yeTo0Mg==","name":"github.com/sourcegraph/sourcegraph","url":"github.com/sourcegraph/sourcegraph"}`,
+		},
+		"renamed": {
+			payload: func() repo {
+				p := newRepo(r)
+				p.PreviousName = "github.com/sourcegraph/old"
+				return p
+			}(),
+			want: `{"id":"UmVwb3NpdG9yeTo0Mg==","name":"github.com/sourcegraph/sourcegraph","previous_name":"github.com/sourcegraph/old","url":"github.com/sourcegraph/sourcegraph"}`,
+		},
+		"deleted": {
+			payload: repo{ID: marshalRepoID(api.RepoID(42))},
+			want:    `{"id":"UmVwb3NpdG9yeTo0Mg=="}`,
+		},
+		"clone failed": {
+			payload: func() repo {
+				p := newRepo(r)
+				p.Error = cloneFailedMessage
+				return p
+			}(),
+			want: `{"id":"UmVwb3NpdG9yeTo0Mg==","name":"github.com/sourcegraph/sourcegraph","url":"github.com/sourcegraph/sourcegraph","error":"failed to clone repository, see its mirroring status for details"}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			have, err := json.Marshal(tc.payload)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.want, string(have))
+		})
+	}
+}
+
+func TestEnqueuerSkipsUnsubscribedEventTypes(t *testing.T) {
+	for name, e := range map[string]*Enqueuer{
+		"loaded once": {subscribed: map[string]bool{}},
+		// The subscriptions of a long-lived Enqueuer must not be loaded again
+		// before they are older than maxAge.
+		"long-lived": {maxAge: time.Hour, loadedAt: time.Now(), subscribed: map[string]bool{}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// The Enqueuer has no database, so this would panic if it tried to
+			// load the subscriptions, enqueue a job or look up a repo for an
+			// event type nobody is subscribed to.
+			e.logger = logtest.Scoped(t)
+
+			ctx := context.Background()
+			e.RepoAdded(ctx, &types.Repo{ID: 42})
+			e.RepoRenamed(ctx, &types.Repo{ID: 42}, "github.com/sourcegraph/old")
+			e.RepoDeleted(ctx, 42)
+			e.CloneCompleted(ctx, "github.com/sourcegraph/sourcegraph")
+			e.CloneFailed(ctx, "github.com/sourcegraph/sourcegraph")
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"hash/maphash"
+	"io"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/object"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// RevisionDiff describes how the matches of a revision changed between two
+// runs of a search job.
+type RevisionDiff struct {
+	MatchCount int32
+	Added      int32
+	Removed    int32
+}
+
+// revisionKeys returns the keys of the blobs written by a MatchJSONWriter with
+// the given prefix. Listing by prefix alone is not enough, since the prefix
+// "1-2" would also match the blobs of "1-23".
+func revisionKeys(ctx context.Context, store object.Storage, prefix string) ([]string, error) {
+	iter, err := store.List(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for iter.Next() {
+		key := iter.Current()
+		if key == prefix || strings.HasPrefix(key, prefix+"-") {
+			keys = append(keys, key)
+		}
+	}
+	return keys, iter.Err()
+}
+
+// CopyRevisionResults copies the blobs holding the matches of a revision from
+// one prefix to another, keeping the shard numbers.
+func CopyRevisionResults(ctx context.Context, store object.Storage, fromPrefix, toPrefix string) error {
+	keys, err := revisionKeys(ctx, store, fromPrefix)
+	if err != nil {
+		return err
+	}
+
+	copyKey := func(key string) error {
+		rc, err := store.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		defer rc.Close()
+
+		_, err = store.Upload(ctx, toPrefix+strings.TrimPrefix(key, fromPrefix), rc)
+		return err
+	}
+
+	for _, key := range keys {
+		if err := copyKey(key); err != nil {
+			return errors.Wrapf(err, "copying key %q", key)
+		}
+	}
+	return nil
+}
+
+// maxDiffKeys bounds the number of distinct matches a RevisionMatchWriter
+// keeps in memory to diff them. The matches of revisions with more matches are
+// read back from the object store and diffed in several passes, each of which
+// only considers a share of the matches.
+var maxDiffKeys = 1 << 20
+
+// RevisionMatchWriter is a MatchWriter which keeps track of the matches
+// written for a revision, so that they can be counted and compared with the
+// matches of a previous run. Matches are counted like rows of an exported
+// table, so a content match counts once per chunk.
+//
+// A match is identified across runs by its path and preview. The line is left
+// out, so that a match which only moved because of changes elsewhere in the
+// file is not reported as added and removed.
+type RevisionMatchWriter struct {
+	w     MatchWriter
+	count int32
+
+	diff bool
+	seed maphash.Seed
+	// counts is nil unless the matches are diffed and there are at most
+	// maxDiffKeys distinct ones.
+	counts map[uint64]int
+}
+
+// NewRevisionMatchWriter returns a RevisionMatchWriter which writes to w. The
+// matches are only remembered for Diff if diff is true.
+func NewRevisionMatchWriter(w MatchWriter, diff bool) *RevisionMatchWriter {
+	r := &RevisionMatchWriter{w: w, diff: diff, seed: maphash.MakeSeed()}
+	if diff {
+		r.counts = map[uint64]int{}
+	}
+	return r
+}
+
+func (r *RevisionMatchWriter) Write(match result.Match) error {
+	if err := r.w.Write(match); err != nil {
+		return err
+	}
+	for _, row := range matchRows(toEventMatch(match)) {
+		r.count++
+		if r.counts != nil {
+			r.counts[r.key(row)]++
+			if len(r.counts) > maxDiffKeys {
+				// Too many to keep in memory, Diff reads them back instead.
+				r.counts = nil
+			}
+		}
+	}
+	return nil
+}
+
+// key returns the hash identifying a match row across runs.
+func (r *RevisionMatchWriter) key(row matchRow) uint64 {
+	var h maphash.Hash
+	h.SetSeed(r.seed)
+	h.WriteString(row.Path)
+	h.WriteByte(0)
+	h.WriteString(row.Preview)
+	return h.Sum64()
+}
+
+// MatchCount returns the number of matches written so far.
+func (r *RevisionMatchWriter) MatchCount() int32 {
+	return r.count
+}
+
+// Diff compares the matches written under prefix with the matches stored under
+// previousPrefix. If previousPrefix is empty every match counts as added.
+func (r *RevisionMatchWriter) Diff(ctx context.Context, store object.Storage, prefix, previousPrefix string) (RevisionDiff, error) {
+	diff := RevisionDiff{MatchCount: r.count}
+	if previousPrefix == "" {
+		diff.Added = diff.MatchCount
+		return diff, nil
+	}
+	if !r.diff {
+		return RevisionDiff{}, errors.New("matches were not recorded for diffing")
+	}
+
+	if r.counts != nil {
+		added, removed, err := r.diffPartition(ctx, store, previousPrefix, r.counts, func(uint64) bool { return true })
+		diff.Added, diff.Removed = added, removed
+		return diff, err
+	}
+
+	// Each pass reads back the matches whose key falls into its partition, so
+	// that at most about maxDiffKeys matches are kept in memory at once.
+	partitions := uint64(r.count)/uint64(maxDiffKeys) + 1
+	for partition := uint64(0); partition < partitions; partition++ {
+		inPartition := func(key uint64) bool { return key%partitions == partition }
+
+		counts := map[uint64]int{}
+		err := forEachMatchRow(ctx, store, prefix, func(row matchRow) {
+			if key := r.key(row); inPartition(key) {
+				counts[key]++
+			}
+		})
+		if err != nil {
+			return RevisionDiff{}, err
+		}
+
+		added, removed, err := r.diffPartition(ctx, store, previousPrefix, counts, inPartition)
+		if err != nil {
+			return RevisionDiff{}, err
+		}
+		diff.Added += added
+		diff.Removed += removed
+	}
+	return diff, nil
+}
+
+// diffPartition compares counts with the matches stored under previousPrefix
+// whose key is in the partition. counts is left untouched.
+func (r *RevisionMatchWriter) diffPartition(ctx context.Context, store object.Storage, previousPrefix string, counts map[uint64]int, inPartition func(uint64) bool) (added, removed int32, err error) {
+	remaining := make(map[uint64]int, len(counts))
+	for key, n := range counts {
+		remaining[key] = n
+	}
+	err = forEachMatchRow(ctx, store, previousPrefix, func(row matchRow) {
+		key := r.key(row)
+		if !inPartition(key) {
+			return
+		}
+		if remaining[key] > 0 {
+			remaining[key]--
+		} else {
+			removed++
+		}
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, n := range remaining {
+		added += int32(n)
+	}
+	return added, removed, nil
+}
+
+func forEachMatchRow(ctx context.Context, store object.Storage, prefix string, f func(matchRow)) error {
+	keys, err := revisionKeys(ctx, store, prefix)
+	if err != nil {
+		return err
+	}
+
+	return forEachMatch(ctx, store, keys, func(m streamhttp.EventMatch) error {
+		for _, row := range matchRows(m) {
+			f(row)
+		}
+		return nil
+	})
+}
+
+// forEachMatch calls f for every match stored in the blobs with the given
+// keys.
+func forEachMatch(ctx context.Context, store object.Storage, keys []string, f func(streamhttp.EventMatch) error) error {
+	readKey := func(key string) error {
+		rc, err := store.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		defer rc.Close()
+
+		dec := json.NewDecoder(rc)
+		for {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+
+			m, err := streamhttp.UnmarshalEventMatch(raw)
+			if err != nil {
+				return err
+			}
+			if err := f(m); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, key := range keys {
+		if err := readKey(key); err != nil {
+			return errors.Wrapf(err, "reading key %q", key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/object/mocks"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func writeRevisionResults(t *testing.T, store *mocks.MockStorage, prefix string, matches ...result.Match) *RevisionMatchWriter {
+	t.Helper()
+
+	w, err := NewJSONWriter(context.Background(), store, prefix)
+	require.NoError(t, err)
+	rw := NewRevisionMatchWriter(w, true)
+	for _, m := range matches {
+		require.NoError(t, rw.Write(m))
+	}
+	require.NoError(t, w.Flush())
+	return rw
+}
+
+func mkContentMatch(path string, lines map[int]string) *result.FileMatch {
+	var line []int
+	for n := range lines {
+		line = append(line, n)
+	}
+	sort.Ints(line)
+
+	var cms result.ChunkMatches
+	for _, n := range line {
+		cms = append(cms, result.ChunkMatch{
+			Content:      lines[n],
+			ContentStart: result.Location{Line: n},
+			Ranges: []result.Range{{
+				Start: result.Location{Line: n},
+				End:   result.Location{Line: n, Column: len(lines[n])},
+			}},
+		})
+	}
+
+	return &result.FileMatch{
+		File: result.File{
+			Path: path,
+			Repo: types.MinimalRepo{ID: 1, Name: "repo"},
+		},
+		ChunkMatches: cms,
+	}
+}
+
+func TestRevisionMatchWriter(t *testing.T) {
+	ctx := context.Background()
+	store := setupMockStore(t)
+
+	writeRevisionResults(t, store, "1-1",
+		mkContentMatch("a.go", map[int]string{1: "foo()", 5: "foo(bar)"}),
+		mkContentMatch("b.go", map[int]string{3: "foo()"}),
+	)
+	// In the new run a line was inserted at the top of a.go, foo(bar) was
+	// removed and b.go got a second match.
+	w := writeRevisionResults(t, store, "2-1",
+		mkContentMatch("a.go", map[int]string{2: "foo()"}),
+		mkContentMatch("b.go", map[int]string{3: "foo()", 9: "foo(baz)"}),
+	)
+	// Shares a prefix with 1-1, but belongs to another revision.
+	writeRevisionResults(t, store, "1-10",
+		mkContentMatch("c.go", map[int]string{1: "foo()"}),
+	)
+	require.Equal(t, int32(3), w.MatchCount())
+
+	diff, err := w.Diff(ctx, store, "2-1", "1-1")
+	require.NoError(t, err)
+	require.Equal(t, RevisionDiff{MatchCount: 3, Added: 1, Removed: 1}, diff)
+
+	// Diff can be called more than once.
+	diff, err = w.Diff(ctx, store, "2-1", "1-1")
+	require.NoError(t, err)
+	require.Equal(t, RevisionDiff{MatchCount: 3, Added: 1, Removed: 1}, diff)
+
+	diff, err = w.Diff(ctx, store, "2-1", "")
+	require.NoError(t, err)
+	require.Equal(t, RevisionDiff{MatchCount: 3, Added: 3}, diff)
+
+	empty := writeRevisionResults(t, store, "3-1")
+	diff, err = empty.Diff(ctx, store, "3-1", "1-1")
+	require.NoError(t, err)
+	require.Equal(t, RevisionDiff{Removed: 3}, diff)
+
+	// Without diffing only the matches are counted.
+	counting := NewRevisionMatchWriter(MatchJSONWriter{newBufferedWriter(1024, func([]byte) error { return nil })}, false)
+	require.NoError(t, counting.Write(mkContentMatch("a.go", map[int]string{1: "foo()", 2: "bar()"})))
+	require.Equal(t, int32(2), counting.MatchCount())
+	_, err = counting.Diff(ctx, store, "4-1", "1-1")
+	require.Error(t, err)
+
+	// Matches which don't fit into memory are read back to diff them.
+	defer func(n int) { maxDiffKeys = n }(maxDiffKeys)
+	maxDiffKeys = 1
+	w = writeRevisionResults(t, store, "5-1",
+		mkContentMatch("a.go", map[int]string{2: "foo()"}),
+		mkContentMatch("b.go", map[int]string{3: "foo()", 9: "foo(baz)"}),
+	)
+	diff, err = w.Diff(ctx, store, "5-1", "1-1")
+	require.NoError(t, err)
+	require.Equal(t, RevisionDiff{MatchCount: 3, Added: 1, Removed: 1}, diff)
+}
+
+func TestCopyRevisionResults(t *testing.T) {
+	ctx := context.Background()
+	store := setupMockStore(t)
+
+	for key, blob := range map[string]string{
+		"1-1":   "a\n",
+		"1-1-2": "b\n",
+		"1-10":  "c\n",
+	} {
+		_, err := store.Upload(ctx, key, strings.NewReader(blob))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, CopyRevisionResults(ctx, store, "1-1", "2-5"))
+
+	keys, err := revisionKeys(ctx, store, "2-5")
+	require.NoError(t, err)
+	sort.Strings(keys)
+	require.Equal(t, []string{"2-5", "2-5-2"}, keys)
+
+	for key, want := range map[string]string{"2-5": "a\n", "2-5-2": "b\n"} {
+		rc, err := store.Get(ctx, key)
+		require.NoError(t, err)
+		got, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.Equal(t, want, string(got))
+	}
+}
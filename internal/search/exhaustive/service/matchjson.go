@@ -9,6 +9,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/object"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
 )
 
 // NewJSONWriter creates a MatchJSONWriter which appends matches to a JSON array
@@ -37,12 +38,15 @@ func (m MatchJSONWriter) Flush() error {
 }
 
 func (m MatchJSONWriter) Write(match result.Match) error {
-	eventMatch := search.FromMatch(match, nil, search.FromMatchOptions{
+	return m.w.Append(toEventMatch(match))
+}
+
+// toEventMatch converts match to the form it is stored in.
+func toEventMatch(match result.Match) streamhttp.EventMatch {
+	return search.FromMatch(match, nil, search.FromMatchOptions{
 		ChunkMatches:         true,
 		MaxContentLineLength: -1, // do not truncate content
 	})
-
-	return m.w.Append(eventMatch)
 }
 
 type blobUploader struct {
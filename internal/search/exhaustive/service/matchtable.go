@@ -0,0 +1,372 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/v14/arrow"
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/memory"
+	"github.com/apache/arrow/go/v14/parquet"
+	"github.com/apache/arrow/go/v14/parquet/compress"
+	"github.com/apache/arrow/go/v14/parquet/pqarrow"
+
+	"github.com/sourcegraph/sourcegraph/internal/object"
+	"github.com/sourcegraph/sourcegraph/internal/search/filter"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/iterator"
+)
+
+// ResultFormat is a format in which the results of a search job can be
+// downloaded.
+type ResultFormat string
+
+const (
+	// ResultFormatJSONLines streams the stored blobs as-is, one JSON encoded
+	// match per line.
+	ResultFormatJSONLines ResultFormat = "jsonl"
+	// ResultFormatCSV converts the stored matches to CSV with one row per match.
+	ResultFormatCSV ResultFormat = "csv"
+	// ResultFormatParquet converts the stored matches to a Parquet file with one
+	// row per match.
+	ResultFormatParquet ResultFormat = "parquet"
+)
+
+// ParseResultFormat parses s into a ResultFormat. The empty string is
+// interpreted as ResultFormatJSONLines.
+func ParseResultFormat(s string) (ResultFormat, error) {
+	switch f := ResultFormat(strings.ToLower(s)); f {
+	case "", "json", ResultFormatJSONLines:
+		return ResultFormatJSONLines, nil
+	case ResultFormatCSV, ResultFormatParquet:
+		return f, nil
+	default:
+		return "", errors.Newf("unsupported search job result format %q", s)
+	}
+}
+
+// Extension returns the file extension, without a leading dot, for files in
+// this format.
+func (f ResultFormat) Extension() string {
+	return string(f)
+}
+
+// ContentType returns the MIME type of files in this format.
+func (f ResultFormat) ContentType() string {
+	switch f {
+	case ResultFormatCSV:
+		return "text/csv"
+	case ResultFormatParquet:
+		return "application/vnd.apache.parquet"
+	default:
+		return "application/jsonlines"
+	}
+}
+
+const (
+	columnRepository = "repository"
+	columnRevision   = "revision"
+	columnPath       = "path"
+	columnLine       = "line"
+	columnPreview    = "preview"
+)
+
+var (
+	repoColumns  = []string{columnRepository, columnRevision}
+	fileColumns  = []string{columnRepository, columnRevision, columnPath}
+	matchColumns = []string{columnRepository, columnRevision, columnPath, columnLine, columnPreview}
+)
+
+// resultColumns returns the columns to export for the results of a search
+// job with the given query. Queries which select repositories or files only
+// produce one row per repository or file, so we leave out the columns which
+// would always be empty.
+func resultColumns(q string) []string {
+	plan, err := query.ParseStandard(q)
+	if err != nil {
+		return matchColumns
+	}
+
+	value, _ := plan.StringValue(query.FieldSelect)
+	if value == "" {
+		return matchColumns
+	}
+
+	sp, err := filter.SelectPathFromString(value)
+	if err != nil {
+		return matchColumns
+	}
+
+	switch sp.Root() {
+	case filter.Repository:
+		return repoColumns
+	case filter.File:
+		return fileColumns
+	default:
+		return matchColumns
+	}
+}
+
+// matchRow is a single row of an exported search job result. Line is 1-based,
+// and 0 if the row does not refer to a line.
+type matchRow struct {
+	Repository string
+	Revision   string
+	Path       string
+	Line       int32
+	Preview    string
+}
+
+// matchRows returns the rows for a single match. Content matches produce a
+// row per chunk and symbol matches a row per symbol.
+func matchRows(m streamhttp.EventMatch) []matchRow {
+	switch v := m.(type) {
+	case *streamhttp.EventContentMatch:
+		base := matchRow{Repository: v.Repository, Revision: revision(v.Branches, v.Commit), Path: v.Path}
+		if len(v.ChunkMatches) == 0 {
+			return []matchRow{base}
+		}
+		rows := make([]matchRow, 0, len(v.ChunkMatches))
+		for _, cm := range v.ChunkMatches {
+			row := base
+			row.Line = int32(cm.ContentStart.Line) + 1
+			row.Preview = cm.Content
+			rows = append(rows, row)
+		}
+		return rows
+	case *streamhttp.EventPathMatch:
+		return []matchRow{{Repository: v.Repository, Revision: revision(v.Branches, v.Commit), Path: v.Path}}
+	case *streamhttp.EventRepoMatch:
+		return []matchRow{{Repository: v.Repository, Revision: revision(v.Branches, "")}}
+	case *streamhttp.EventSymbolMatch:
+		base := matchRow{Repository: v.Repository, Revision: revision(v.Branches, v.Commit), Path: v.Path}
+		if len(v.Symbols) == 0 {
+			return []matchRow{base}
+		}
+		rows := make([]matchRow, 0, len(v.Symbols))
+		for _, sym := range v.Symbols {
+			row := base
+			row.Line = sym.Line
+			row.Preview = sym.Name
+			rows = append(rows, row)
+		}
+		return rows
+	case *streamhttp.EventCommitMatch:
+		preview, _, _ := strings.Cut(v.Message, "\n")
+		return []matchRow{{Repository: v.Repository, Revision: v.OID, Preview: preview}}
+	default:
+		return nil
+	}
+}
+
+// revision prefers the revision the user searched over the resolved commit.
+func revision(branches []string, commit string) string {
+	if len(branches) > 0 && branches[0] != "" {
+		return branches[0]
+	}
+	return commit
+}
+
+// matchTableWriter writes matchRows in a tabular file format.
+type matchTableWriter interface {
+	WriteRow(matchRow) error
+	// Close flushes any buffered rows and writes the footer of the file, if
+	// the format has one. It does not close the underlying io.Writer.
+	Close() error
+}
+
+func newMatchTableWriter(format ResultFormat, w io.Writer, columns []string) (matchTableWriter, error) {
+	switch format {
+	case ResultFormatCSV:
+		return newCSVMatchWriter(w, columns)
+	case ResultFormatParquet:
+		return newParquetMatchWriter(w, columns)
+	default:
+		return nil, errors.Newf("%q is not a tabular search job result format", format)
+	}
+}
+
+type csvMatchWriter struct {
+	cw      *csv.Writer
+	columns []string
+	record  []string
+}
+
+func newCSVMatchWriter(w io.Writer, columns []string) (*csvMatchWriter, error) {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(columns); err != nil {
+		return nil, err
+	}
+	return &csvMatchWriter{cw: cw, columns: columns, record: make([]string, len(columns))}, nil
+}
+
+func (c *csvMatchWriter) WriteRow(row matchRow) error {
+	for i, col := range c.columns {
+		switch col {
+		case columnRepository:
+			c.record[i] = row.Repository
+		case columnRevision:
+			c.record[i] = row.Revision
+		case columnPath:
+			c.record[i] = row.Path
+		case columnLine:
+			c.record[i] = ""
+			if row.Line > 0 {
+				c.record[i] = strconv.Itoa(int(row.Line))
+			}
+		case columnPreview:
+			c.record[i] = row.Preview
+		}
+	}
+	return c.cw.Write(c.record)
+}
+
+func (c *csvMatchWriter) Close() error {
+	c.cw.Flush()
+	return c.cw.Error()
+}
+
+// parquetRowGroupSize is the number of rows we buffer before writing them out
+// as a row group.
+const parquetRowGroupSize = 64 * 1024
+
+type parquetMatchWriter struct {
+	fw      *pqarrow.FileWriter
+	b       *array.RecordBuilder
+	columns []string
+}
+
+func newParquetMatchWriter(w io.Writer, columns []string) (*parquetMatchWriter, error) {
+	fields := make([]arrow.Field, 0, len(columns))
+	for _, col := range columns {
+		if col == columnLine {
+			fields = append(fields, arrow.Field{Name: col, Type: arrow.PrimitiveTypes.Int32, Nullable: true})
+		} else {
+			fields = append(fields, arrow.Field{Name: col, Type: arrow.BinaryTypes.String})
+		}
+	}
+	schema := arrow.NewSchema(fields, nil)
+
+	props := parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Snappy))
+	fw, err := pqarrow.NewFileWriter(schema, w, props, pqarrow.DefaultWriterProps())
+	if err != nil {
+		return nil, err
+	}
+
+	return &parquetMatchWriter{
+		fw:      fw,
+		b:       array.NewRecordBuilder(memory.DefaultAllocator, schema),
+		columns: columns,
+	}, nil
+}
+
+func (p *parquetMatchWriter) WriteRow(row matchRow) error {
+	for i, col := range p.columns {
+		switch col {
+		case columnRepository:
+			p.b.Field(i).(*array.StringBuilder).Append(row.Repository)
+		case columnRevision:
+			p.b.Field(i).(*array.StringBuilder).Append(row.Revision)
+		case columnPath:
+			p.b.Field(i).(*array.StringBuilder).Append(row.Path)
+		case columnLine:
+			if row.Line > 0 {
+				p.b.Field(i).(*array.Int32Builder).Append(row.Line)
+			} else {
+				p.b.Field(i).(*array.Int32Builder).AppendNull()
+			}
+		case columnPreview:
+			p.b.Field(i).(*array.StringBuilder).Append(row.Preview)
+		}
+	}
+
+	if p.b.Field(0).Len() >= parquetRowGroupSize {
+		return p.flush()
+	}
+	return nil
+}
+
+func (p *parquetMatchWriter) flush() error {
+	rec := p.b.NewRecord()
+	defer rec.Release()
+	if rec.NumRows() == 0 {
+		return nil
+	}
+	return p.fw.Write(rec)
+}
+
+func (p *parquetMatchWriter) Close() error {
+	defer p.b.Release()
+	if err := p.flush(); err != nil {
+		return err
+	}
+	return p.fw.Close()
+}
+
+// writeSearchJobTable converts the JSON lines stored under the keys of iter to
+// format, keeping only the given columns.
+func writeSearchJobTable(ctx context.Context, iter *iterator.Iterator[string], uploadStore object.Storage, format ResultFormat, columns []string, w io.Writer) (int64, error) {
+	// The table writers buffer internally and don't report the bytes they
+	// write, so we wrap w to find out.
+	writeCounter := &writeCounter{w: w}
+	tw, err := newMatchTableWriter(format, writeCounter, columns)
+	if err != nil {
+		return writeCounter.n, err
+	}
+
+	// Rows which don't include a line don't differ between the chunks of a
+	// match, so we only keep the first one.
+	perLine := len(columns) == len(matchColumns)
+
+	writeKey := func(key string) error {
+		rc, err := uploadStore.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		defer rc.Close()
+
+		dec := json.NewDecoder(rc)
+		for {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+
+			m, err := streamhttp.UnmarshalEventMatch(raw)
+			if err != nil {
+				return err
+			}
+
+			rows := matchRows(m)
+			if !perLine && len(rows) > 1 {
+				rows = rows[:1]
+			}
+			for _, row := range rows {
+				if err := tw.WriteRow(row); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for iter.Next() {
+		key := iter.Current()
+		if err := writeKey(key); err != nil {
+			return writeCounter.n, errors.Wrapf(err, "writing %s for key %q", format, key)
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return writeCounter.n, err
+	}
+
+	err = tw.Close()
+	return writeCounter.n, err
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/memory"
+	"github.com/apache/arrow/go/v14/parquet/file"
+	"github.com/apache/arrow/go/v14/parquet/pqarrow"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/object/mocks"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+	"github.com/sourcegraph/sourcegraph/lib/iterator"
+)
+
+func TestResultColumns(t *testing.T) {
+	for q, want := range map[string][]string{
+		"foo":                     matchColumns,
+		"foo select:content":      matchColumns,
+		"foo select:symbol.class": matchColumns,
+		"foo select:repo":         repoColumns,
+		"foo select:file":         fileColumns,
+		"foo select:file.path":    fileColumns,
+		"foo select:bogus":        matchColumns,
+		"(foo":                    matchColumns,
+	} {
+		t.Run(q, func(t *testing.T) {
+			require.Equal(t, want, resultColumns(q))
+		})
+	}
+}
+
+func testMatchBlobstore(t *testing.T) *mocks.MockStorage {
+	t.Helper()
+
+	var blob bytes.Buffer
+	enc := json.NewEncoder(&blob)
+	for _, m := range []streamhttp.EventMatch{
+		&streamhttp.EventContentMatch{
+			Type:       streamhttp.ContentMatchType,
+			Repository: "github.com/sourcegraph/a",
+			Branches:   []string{"main"},
+			Commit:     "deadbeef",
+			Path:       "README.md",
+			ChunkMatches: []streamhttp.ChunkMatch{
+				{Content: "hello world", ContentStart: streamhttp.Location{Line: 0}},
+				{Content: "hello, \"again\"", ContentStart: streamhttp.Location{Line: 41}},
+			},
+		},
+		&streamhttp.EventPathMatch{
+			Type:       streamhttp.PathMatchType,
+			Repository: "github.com/sourcegraph/b",
+			Commit:     "cafebabe",
+			Path:       "hello.go",
+		},
+	} {
+		require.NoError(t, enc.Encode(m))
+	}
+
+	blobstore := mocks.NewMockStorage()
+	blobstore.GetFunc.SetDefaultHook(func(ctx context.Context, key string) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(blob.Bytes())), nil
+	})
+	return blobstore
+}
+
+func TestWriteSearchJobTable_CSV(t *testing.T) {
+	for name, tc := range map[string]struct {
+		columns []string
+		want    string
+	}{
+		"matches": {
+			columns: matchColumns,
+			want: "repository,revision,path,line,preview\n" +
+				"github.com/sourcegraph/a,main,README.md,1,hello world\n" +
+				"github.com/sourcegraph/a,main,README.md,42,\"hello, \"\"again\"\"\"\n" +
+				"github.com/sourcegraph/b,cafebabe,hello.go,,\n",
+		},
+		"files": {
+			columns: fileColumns,
+			want: "repository,revision,path\n" +
+				"github.com/sourcegraph/a,main,README.md\n" +
+				"github.com/sourcegraph/b,cafebabe,hello.go\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			n, err := writeSearchJobTable(context.Background(), iterator.From([]string{"a"}), testMatchBlobstore(t), ResultFormatCSV, tc.columns, w)
+			require.NoError(t, err)
+			require.Equal(t, int64(w.Len()), n)
+			require.Equal(t, tc.want, w.String())
+		})
+	}
+}
+
+func TestWriteSearchJobTable_Parquet(t *testing.T) {
+	w := &bytes.Buffer{}
+	n, err := writeSearchJobTable(context.Background(), iterator.From([]string{"a"}), testMatchBlobstore(t), ResultFormatParquet, matchColumns, w)
+	require.NoError(t, err)
+	require.Equal(t, int64(w.Len()), n)
+
+	pf, err := file.NewParquetReader(bytes.NewReader(w.Bytes()))
+	require.NoError(t, err)
+	defer pf.Close()
+
+	fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	tbl, err := fr.ReadTable(context.Background())
+	require.NoError(t, err)
+	defer tbl.Release()
+
+	require.Equal(t, int64(3), tbl.NumRows())
+	require.Equal(t, int64(len(matchColumns)), tbl.NumCols())
+
+	repos := tbl.Column(0).Data().Chunk(0).(*array.String)
+	require.Equal(t, "github.com/sourcegraph/b", repos.Value(2))
+
+	lines := tbl.Column(3).Data().Chunk(0).(*array.Int32)
+	require.Equal(t, int32(42), lines.Value(1))
+	require.True(t, lines.IsNull(2))
+}
+
+func TestParseResultFormat(t *testing.T) {
+	for in, want := range map[string]ResultFormat{
+		"":        ResultFormatJSONLines,
+		"jsonl":   ResultFormatJSONLines,
+		"CSV":     ResultFormatCSV,
+		"parquet": ResultFormatParquet,
+	} {
+		got, err := ParseResultFormat(in)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+
+	_, err := ParseResultFormat("xlsx")
+	require.Error(t, err)
+}
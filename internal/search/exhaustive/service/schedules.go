@@ -0,0 +1,354 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/cronexpr"
+	"github.com/sourcegraph/log"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// nextRunAt returns the first time after now at which the cron expression
+// schedule fires. Schedules are evaluated in UTC.
+func nextRunAt(schedule string, now time.Time) (time.Time, error) {
+	expr, err := cronexpr.Parse(schedule)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "invalid schedule %q", schedule)
+	}
+	next := expr.Next(now.UTC())
+	if next.IsZero() {
+		return time.Time{}, errors.Newf("schedule %q never fires", schedule)
+	}
+	return next, nil
+}
+
+func (s *Service) validateSchedule(ctx context.Context, schedule *types.ExhaustiveSearchSchedule) (err error) {
+	if err := s.ValidateSearchJob(ctx, schedule.Query); err != nil {
+		return err
+	}
+	if schedule.RetainRuns < 0 || schedule.RetainDays < 0 {
+		return errors.New("retention must not be negative")
+	}
+
+	schedule.NextRunAt = time.Time{}
+	if schedule.Enabled {
+		schedule.NextRunAt, err = nextRunAt(schedule.Schedule, time.Now())
+	} else {
+		_, err = nextRunAt(schedule.Schedule, time.Now())
+	}
+	return err
+}
+
+// CreateSearchJobSchedule creates a schedule which periodically runs a search
+// job on behalf of the current user.
+func (s *Service) CreateSearchJobSchedule(ctx context.Context, schedule types.ExhaustiveSearchSchedule) (_ *types.ExhaustiveSearchSchedule, err error) {
+	ctx, _, endObservation := s.operations.createSearchJobSchedule.With(ctx, &err, opAttrs(
+		attribute.String("query", schedule.Query),
+		attribute.String("schedule", schedule.Schedule),
+	))
+	defer endObservation(1, observation.Args{})
+
+	actor := actor.FromContext(ctx)
+	if !actor.IsAuthenticated() {
+		return nil, errors.New("search job schedules can only be created by an authenticated user")
+	}
+	schedule.CreatorID = actor.UID
+
+	if err := s.validateSchedule(ctx, &schedule); err != nil {
+		return nil, err
+	}
+
+	id, err := s.store.CreateSchedule(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+	return s.store.GetSchedule(ctx, id)
+}
+
+// UpdateSearchJobSchedule updates the query, cron expression, retention policy
+// and flags of a schedule. The next run is computed from the current time.
+func (s *Service) UpdateSearchJobSchedule(ctx context.Context, schedule types.ExhaustiveSearchSchedule) (_ *types.ExhaustiveSearchSchedule, err error) {
+	ctx, _, endObservation := s.operations.updateSearchJobSchedule.With(ctx, &err, opAttrs(
+		attribute.Int64("id", schedule.ID),
+	))
+	defer endObservation(1, observation.Args{})
+
+	if err := s.validateSchedule(ctx, &schedule); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.UpdateSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+	return s.store.GetSchedule(ctx, schedule.ID)
+}
+
+// DeleteSearchJobSchedule deletes a schedule. Past runs are kept, but are not
+// subject to the retention policy of the schedule anymore.
+func (s *Service) DeleteSearchJobSchedule(ctx context.Context, id int64) (err error) {
+	ctx, _, endObservation := s.operations.deleteSearchJobSchedule.With(ctx, &err, opAttrs(
+		attribute.Int64("id", id),
+	))
+	defer endObservation(1, observation.Args{})
+
+	return s.store.DeleteSchedule(ctx, id)
+}
+
+func (s *Service) GetSearchJobSchedule(ctx context.Context, id int64) (_ *types.ExhaustiveSearchSchedule, err error) {
+	ctx, _, endObservation := s.operations.getSearchJobSchedule.With(ctx, &err, opAttrs(
+		attribute.Int64("id", id),
+	))
+	defer endObservation(1, observation.Args{})
+
+	return s.store.GetSchedule(ctx, id)
+}
+
+func (s *Service) ListSearchJobSchedules(ctx context.Context, args store.ListSchedulesArgs) (schedules []*types.ExhaustiveSearchSchedule, err error) {
+	ctx, _, endObservation := s.operations.listSearchJobSchedules.With(ctx, &err, observation.Args{})
+	defer func() {
+		endObservation(1, opAttrs(
+			attribute.Int("len", len(schedules)),
+		))
+	}()
+
+	return s.store.ListSchedules(ctx, args)
+}
+
+// maxSchedulesPerRun bounds the number of schedules RunDueSchedules runs at
+// once, so that a backlog of due schedules doesn't block the scheduler.
+const maxSchedulesPerRun = 100
+
+// RunDueSchedules creates a search job for every schedule which is due at now.
+// A schedule is skipped if its previous run hasn't finished yet. It returns
+// the number of search jobs created.
+//
+// ctx must belong to an internal actor, runs are created on behalf of the
+// creator of the schedule.
+func (s *Service) RunDueSchedules(ctx context.Context, now time.Time) (created int, err error) {
+	for range maxSchedulesPerRun {
+		ran, found, err := s.runNextDueSchedule(ctx, now)
+		if err != nil {
+			return created, err
+		}
+		if !found {
+			break
+		}
+		if ran {
+			created++
+		}
+	}
+	return created, nil
+}
+
+// runNextDueSchedule runs the schedule which is due next, if any. Each
+// schedule is handled in its own transaction which holds a lock on the
+// schedule, so that concurrent schedulers don't run it twice.
+func (s *Service) runNextDueSchedule(ctx context.Context, now time.Time) (ran, found bool, err error) {
+	tx, err := s.store.Transact(ctx)
+	if err != nil {
+		return false, false, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	due, err := tx.ListDueSchedules(ctx, now, 1)
+	if err != nil || len(due) == 0 {
+		return false, false, err
+	}
+	schedule := due[0]
+
+	logger := s.logger.With(log.Int64("scheduleID", schedule.ID))
+
+	// An invalid schedule can only be fixed by the user, so we disable it by
+	// not setting a next run.
+	next, err := nextRunAt(schedule.Schedule, now)
+	if err != nil {
+		logger.Warn("disabling search job schedule", log.Error(err))
+	}
+	if err := tx.SetScheduleRun(ctx, schedule.ID, now, next); err != nil {
+		return false, true, err
+	}
+
+	runCtx := actor.WithActor(ctx, actor.FromUser(schedule.CreatorID))
+	ran, err = s.runSchedule(runCtx, tx, schedule)
+	if err != nil && !isStoreError(err) {
+		// Errors like an invalid query or a creator who left the org
+		// shouldn't stop other schedules from running.
+		logger.Warn("skipping run of search job schedule", log.Error(err))
+		return false, true, nil
+	}
+	return ran, true, err
+}
+
+type storeError struct{ error }
+
+func (e storeError) Unwrap() error { return e.error }
+
+func isStoreError(err error) bool {
+	return errors.HasType[storeError](err)
+}
+
+// runSchedule creates a search job for the schedule. ctx must belong to the
+// creator of the schedule.
+func (s *Service) runSchedule(ctx context.Context, tx *store.Store, schedule *types.ExhaustiveSearchSchedule) (bool, error) {
+	// 🚨 SECURITY: the creator could have left the org which owns the schedule
+	// since they created it, so we check the namespace again on their behalf.
+	if _, err := tx.GetSchedule(ctx, schedule.ID); err != nil {
+		return false, err
+	}
+
+	runs, err := tx.ListScheduleRuns(ctx, schedule.ID)
+	if err != nil {
+		return false, storeError{err}
+	}
+	if len(runs) > 0 && !runs[0].AggState.IsTerminal() {
+		return false, nil
+	}
+
+	if err := s.ValidateSearchJob(ctx, schedule.Query); err != nil {
+		return false, err
+	}
+
+	job := types.ExhaustiveSearchJob{
+		InitiatorID: schedule.CreatorID,
+		Query:       schedule.Query,
+		ScheduleID:  schedule.ID,
+	}
+	if schedule.Incremental {
+		for _, run := range runs {
+			if run.AggState == types.JobStateCompleted && run.Query == schedule.Query {
+				job.PreviousJobID = run.ID
+				break
+			}
+		}
+	}
+
+	if _, err := tx.CreateExhaustiveSearchJob(ctx, job); err != nil {
+		return false, storeError{err}
+	}
+	return true, nil
+}
+
+// ScheduleRunNotifier is called by FinalizeScheduleRuns for every finished run
+// of a schedule which has notifications enabled.
+type ScheduleRunNotifier func(ctx context.Context, schedule *types.ExhaustiveSearchSchedule, run *types.ExhaustiveSearchJob) error
+
+// FinalizeScheduleRuns notifies about finished runs of schedules and applies
+// the retention policy of their schedules. Failing to notify is logged, but
+// doesn't prevent the run from being finalized, so that we don't notify
+// about the same run repeatedly.
+//
+// ctx must belong to an internal actor.
+func (s *Service) FinalizeScheduleRuns(ctx context.Context, now time.Time, notify ScheduleRunNotifier) error {
+	runs, err := s.store.ListUnfinalizedScheduleRuns(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	for _, run := range runs {
+		if !run.AggState.IsTerminal() {
+			continue
+		}
+
+		if err := s.finalizeScheduleRun(ctx, now, run, notify); err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "finalizing run %d", run.ID))
+		}
+	}
+	return errs
+}
+
+func (s *Service) finalizeScheduleRun(ctx context.Context, now time.Time, run *types.ExhaustiveSearchJob, notify ScheduleRunNotifier) error {
+	schedule, err := s.store.GetSchedule(ctx, run.ScheduleID)
+	if err != nil {
+		return err
+	}
+
+	if schedule.Notify && notify != nil {
+		if err := notify(ctx, schedule, run); err != nil {
+			s.logger.Warn("failed to notify about search job schedule run",
+				log.Int64("scheduleID", schedule.ID),
+				log.Int64("jobID", run.ID),
+				log.Error(err))
+		}
+	}
+
+	if err := s.applyRetention(ctx, now, schedule); err != nil {
+		return err
+	}
+
+	return s.store.SetScheduleRunFinalized(ctx, run.ID)
+}
+
+// applyRetention deletes the finished runs of the schedule which exceed its
+// retention policy. Runs which incremental runs diff against are always kept.
+func (s *Service) applyRetention(ctx context.Context, now time.Time, schedule *types.ExhaustiveSearchSchedule) error {
+	if schedule.RetainRuns == 0 && schedule.RetainDays == 0 {
+		return nil
+	}
+
+	runs, err := s.store.ListScheduleRuns(ctx, schedule.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, run := range expiredRuns(now, schedule, runs) {
+		// DeleteSearchJob checks access, so we delete on behalf of the
+		// initiator of the run.
+		runCtx := actor.WithActor(ctx, actor.FromUser(run.InitiatorID))
+		if err := s.DeleteSearchJob(runCtx, run.ID); err != nil {
+			return errors.Wrapf(err, "deleting run %d", run.ID)
+		}
+	}
+	return nil
+}
+
+// expiredRuns returns the runs which exceed the retention policy of the
+// schedule. runs must be ordered newest first. Runs which haven't finished
+// are never expired, and neither is the most recent finished run.
+//
+// Runs which an incremental run diffs against are kept as well: the previous
+// run of every run which hasn't finished yet, and the most recent completed
+// run, which the next incremental run will diff against.
+func expiredRuns(now time.Time, schedule *types.ExhaustiveSearchSchedule, runs []*types.ExhaustiveSearchJob) []*types.ExhaustiveSearchJob {
+	var cutoff time.Time
+	if schedule.RetainDays > 0 {
+		cutoff = now.AddDate(0, 0, -int(schedule.RetainDays))
+	}
+
+	diffedAgainst := make(map[int64]bool)
+	latestCompleted := false
+	for _, run := range runs {
+		if !run.AggState.IsTerminal() && run.PreviousJobID != 0 {
+			diffedAgainst[run.PreviousJobID] = true
+		}
+		if run.AggState == types.JobStateCompleted && !latestCompleted {
+			diffedAgainst[run.ID] = true
+			latestCompleted = true
+		}
+	}
+
+	var expired []*types.ExhaustiveSearchJob
+	finished := 0
+	for _, run := range runs {
+		if !run.AggState.IsTerminal() {
+			continue
+		}
+		finished++
+		if finished == 1 || diffedAgainst[run.ID] {
+			continue
+		}
+
+		if (schedule.RetainRuns > 0 && finished > int(schedule.RetainRuns)) ||
+			(!cutoff.IsZero() && run.CreatedAt.Before(cutoff)) {
+			expired = append(expired, run)
+		}
+	}
+	return expired
+}
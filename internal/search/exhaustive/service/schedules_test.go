@@ -0,0 +1,103 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+)
+
+func TestNextRunAt(t *testing.T) {
+	now := time.Date(2024, 8, 9, 10, 30, 0, 0, time.FixedZone("CEST", 2*60*60))
+
+	next, err := nextRunAt("0 * * * *", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 8, 9, 9, 0, 0, 0, time.UTC), next)
+
+	// Daily schedules are evaluated in UTC.
+	next, err = nextRunAt("0 6 * * *", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 8, 10, 6, 0, 0, 0, time.UTC), next)
+
+	_, err = nextRunAt("not a schedule", now)
+	require.Error(t, err)
+}
+
+func TestExpiredRuns(t *testing.T) {
+	now := time.Date(2024, 8, 9, 0, 0, 0, 0, time.UTC)
+	run := func(id int64, daysAgo int, state types.JobState) *types.ExhaustiveSearchJob {
+		return &types.ExhaustiveSearchJob{
+			ID:        id,
+			CreatedAt: now.AddDate(0, 0, -daysAgo),
+			AggState:  state,
+		}
+	}
+
+	// The in-progress run diffs against an older run, for example because the
+	// query of the schedule changed in between.
+	inProgress := run(6, 0, types.JobStateProcessing)
+	inProgress.PreviousJobID = 3
+
+	// Newest first, like ListScheduleRuns returns them.
+	runs := []*types.ExhaustiveSearchJob{
+		inProgress,
+		run(5, 1, types.JobStateCompleted),
+		run(4, 2, types.JobStateFailed),
+		run(3, 3, types.JobStateCompleted),
+		run(2, 10, types.JobStateCompleted),
+		run(1, 20, types.JobStateCanceled),
+	}
+
+	ids := func(jobs []*types.ExhaustiveSearchJob) []int64 {
+		var ids []int64
+		for _, j := range jobs {
+			ids = append(ids, j.ID)
+		}
+		return ids
+	}
+
+	cases := []struct {
+		name     string
+		schedule types.ExhaustiveSearchSchedule
+		want     []int64
+	}{{
+		name: "keep all",
+		want: nil,
+	}, {
+		name:     "retain runs",
+		schedule: types.ExhaustiveSearchSchedule{RetainRuns: 2},
+		want:     []int64{2, 1},
+	}, {
+		name:     "retain days",
+		schedule: types.ExhaustiveSearchSchedule{RetainDays: 5},
+		want:     []int64{2, 1},
+	}, {
+		name:     "retain runs and days",
+		schedule: types.ExhaustiveSearchSchedule{RetainRuns: 3, RetainDays: 15},
+		want:     []int64{2, 1},
+	}, {
+		name:     "most recent finished run is kept",
+		schedule: types.ExhaustiveSearchSchedule{RetainDays: 1},
+		want:     []int64{4, 2, 1},
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, ids(expiredRuns(now, &tc.schedule, runs)))
+		})
+	}
+
+	t.Run("most recent completed run is kept", func(t *testing.T) {
+		// The next incremental run diffs against run 2, even though a run
+		// failed since.
+		runs := []*types.ExhaustiveSearchJob{
+			run(3, 0, types.JobStateFailed),
+			run(2, 1, types.JobStateCompleted),
+			run(1, 2, types.JobStateCompleted),
+		}
+		schedule := types.ExhaustiveSearchSchedule{RetainRuns: 1}
+		require.Equal(t, []int64{1}, ids(expiredRuns(now, &schedule, runs)))
+	})
+}
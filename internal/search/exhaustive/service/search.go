@@ -43,6 +43,10 @@ type NewSearcher interface {
 //  2. ResolveRepositoryRevSpec -> speak to gitserver to find out which commits to search.
 //  3. Search -> actually do a search.
 //
+// ResolveCommit is used in between 2 and 3 to pin the commit which is searched
+// and recorded, so that incremental re-runs of a search job can find out if a
+// revision moved since the previous run.
+//
 // This does mean that things like searching a commit in a monorepo are
 // expected to run over a reasonable time frame (eg within a minute?).
 //
@@ -74,6 +78,10 @@ type SearchQuery interface {
 	ResolveRepositoryRevSpec(context.Context, types.RepositoryRevSpecs) ([]types.RepositoryRevision, error)
 
 	Search(context.Context, types.RepositoryRevision, MatchWriter) error
+
+	// ResolveCommit returns the commit the revision currently points to. An
+	// empty commit is returned for HEAD of an empty repository.
+	ResolveCommit(context.Context, types.RepositoryRevision) (api.CommitID, error)
 }
 
 type MatchWriter interface {
@@ -190,6 +198,14 @@ func (s searcherFake) Search(ctx context.Context, r types.RepositoryRevision, w
 	})
 }
 
+func (s searcherFake) ResolveCommit(ctx context.Context, r types.RepositoryRevision) (api.CommitID, error) {
+	if err := isSameUser(ctx, s.userID); err != nil {
+		return "", err
+	}
+
+	return api.CommitID(r.Revision), nil
+}
+
 func isSameUser(ctx context.Context, userID int32) error {
 	if userID == 0 {
 		return errors.New("exhaustive search must be done on behalf of an authenticated user")
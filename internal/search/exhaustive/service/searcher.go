@@ -7,6 +7,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/client"
@@ -217,6 +218,26 @@ func (s searchQuery) Search(ctx context.Context, repoRev types.RepositoryRevisio
 	return err
 }
 
+func (s searchQuery) ResolveCommit(ctx context.Context, repoRev types.RepositoryRevision) (api.CommitID, error) {
+	if err := isSameUser(ctx, s.userID); err != nil {
+		return "", err
+	}
+
+	repo, err := s.minimalRepo(ctx, repoRev.Repository)
+	if err != nil {
+		return "", err
+	}
+
+	commit, err := s.clients.Gitserver.ResolveRevision(ctx, repo.Name, repoRev.Revision, gitserver.ResolveRevisionOptions{EnsureRevision: false})
+
+	// Same as in Search, HEAD of an empty repository is not an error.
+	if repoRev.Revision == "HEAD" && errors.HasType[*gitdomain.RevisionNotFoundError](err) {
+		return "", nil
+	}
+
+	return commit, err
+}
+
 func (s searchQuery) minimalRepo(ctx context.Context, repoID api.RepoID) (sgtypes.MinimalRepo, error) {
 	minimalRepos, err := s.clients.DB.Repos().ListMinimalRepos(ctx, database.ReposListOptions{
 		IDs: []api.RepoID{repoID},
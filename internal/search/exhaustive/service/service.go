@@ -18,6 +18,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/sourcegraph/sourcegraph/lib/iterator"
 )
@@ -55,12 +56,22 @@ func opAttrs(attrs ...attribute.KeyValue) observation.Args {
 }
 
 type operations struct {
-	createSearchJob          *observation.Operation
-	getSearchJob             *observation.Operation
-	deleteSearchJob          *observation.Operation
-	listSearchJobs           *observation.Operation
-	cancelSearchJob          *observation.Operation
-	getAggregateRepoRevState *observation.Operation
+	createSearchJob           *observation.Operation
+	getSearchJob              *observation.Operation
+	deleteSearchJob           *observation.Operation
+	listSearchJobs            *observation.Operation
+	cancelSearchJob           *observation.Operation
+	getAggregateRepoRevState  *observation.Operation
+	aggregateSearchJobResults *observation.Operation
+
+	rerunSearchJobIncrementally *observation.Operation
+	getIncrementalSummary       *observation.Operation
+
+	createSearchJobSchedule *observation.Operation
+	updateSearchJobSchedule *observation.Operation
+	deleteSearchJobSchedule *observation.Operation
+	getSearchJobSchedule    *observation.Operation
+	listSearchJobSchedules  *observation.Operation
 
 	getSearchJobResultsWriterTo operationWithWriterTo
 	getSearchJobLogsWriterTo    operationWithWriterTo
@@ -101,12 +112,22 @@ func newOperations(observationCtx *observation.Context) *operations {
 		}
 
 		singletonOperations = &operations{
-			createSearchJob:          op("CreateSearchJob"),
-			getSearchJob:             op("GetSearchJob"),
-			deleteSearchJob:          op("DeleteSearchJob"),
-			listSearchJobs:           op("ListSearchJobs"),
-			cancelSearchJob:          op("CancelSearchJob"),
-			getAggregateRepoRevState: op("GetAggregateRepoRevState"),
+			createSearchJob:           op("CreateSearchJob"),
+			getSearchJob:              op("GetSearchJob"),
+			deleteSearchJob:           op("DeleteSearchJob"),
+			listSearchJobs:            op("ListSearchJobs"),
+			cancelSearchJob:           op("CancelSearchJob"),
+			getAggregateRepoRevState:  op("GetAggregateRepoRevState"),
+			aggregateSearchJobResults: op("AggregateSearchJobResults"),
+
+			rerunSearchJobIncrementally: op("RerunSearchJobIncrementally"),
+			getIncrementalSummary:       op("GetIncrementalSummary"),
+
+			createSearchJobSchedule: op("CreateSearchJobSchedule"),
+			updateSearchJobSchedule: op("UpdateSearchJobSchedule"),
+			deleteSearchJobSchedule: op("DeleteSearchJobSchedule"),
+			getSearchJobSchedule:    op("GetSearchJobSchedule"),
+			listSearchJobSchedules:  op("ListSearchJobSchedules"),
 
 			getSearchJobResultsWriterTo: operationWithWriterTo{
 				get:      op("GetSearchJobResultsWriterTo"),
@@ -155,7 +176,7 @@ func (s *Service) CreateSearchJob(ctx context.Context, query string) (_ *types.E
 
 	// XXX(keegancsmith) this API for creating seems easy to mess up since the
 	// ExhaustiveSearchJob type has lots of fields, but reading the store
-	// implementation only two fields are read.
+	// implementation only three fields are read.
 	jobID, err := tx.CreateExhaustiveSearchJob(ctx, types.ExhaustiveSearchJob{
 		InitiatorID: actor.UID,
 		Query:       query,
@@ -167,6 +188,66 @@ func (s *Service) CreateSearchJob(ctx context.Context, query string) (_ *types.E
 	return tx.GetExhaustiveSearchJob(ctx, jobID)
 }
 
+// RerunSearchJobIncrementally creates a new search job with the same query as
+// the finished job with the given ID. Revisions which still resolve to the
+// commit they resolved to in the previous job are not searched again, instead
+// their results are copied over.
+func (s *Service) RerunSearchJobIncrementally(ctx context.Context, previousID int64) (_ *types.ExhaustiveSearchJob, err error) {
+	ctx, _, endObservation := s.operations.rerunSearchJobIncrementally.With(ctx, &err, opAttrs(
+		attribute.Int64("previousID", previousID),
+	))
+	defer endObservation(1, observation.Args{})
+
+	actor := actor.FromContext(ctx)
+	if !actor.IsAuthenticated() {
+		return nil, errors.New("search jobs can only be created by an authenticated user")
+	}
+
+	// 🚨 SECURITY: GetExhaustiveSearchJob checks that the actor has access to
+	// the previous job.
+	previous, err := s.store.GetExhaustiveSearchJob(ctx, previousID)
+	if err != nil {
+		return nil, err
+	}
+	if !previous.AggState.IsTerminal() {
+		return nil, errors.Newf("search job %d has not finished yet", previousID)
+	}
+
+	// The query is validated again, since search may have changed since the
+	// previous job was created.
+	err = s.ValidateSearchJob(ctx, previous.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.store.Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	jobID, err := tx.CreateExhaustiveSearchJob(ctx, types.ExhaustiveSearchJob{
+		InitiatorID:   actor.UID,
+		Query:         previous.Query,
+		PreviousJobID: previous.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.GetExhaustiveSearchJob(ctx, jobID)
+}
+
+// GetIncrementalSummary returns how the results of the search job with the
+// given ID differ from the results of the job it incrementally re-runs.
+func (s *Service) GetIncrementalSummary(ctx context.Context, id int64) (_ *types.IncrementalSummary, err error) {
+	ctx, _, endObservation := s.operations.getIncrementalSummary.With(ctx, &err, opAttrs(
+		attribute.Int64("id", id)))
+	defer endObservation(1, observation.Args{})
+
+	return s.store.GetIncrementalSummary(ctx, id)
+}
+
 func (s *Service) CancelSearchJob(ctx context.Context, id int64) (err error) {
 	ctx, _, endObservation := s.operations.cancelSearchJob.With(ctx, &err, opAttrs(
 		attribute.Int64("id", id),
@@ -348,15 +429,16 @@ func (s *Service) DeleteSearchJob(ctx context.Context, id int64) (err error) {
 }
 
 // GetSearchJobResultsWriterTo returns a WriterTo which can be called once to
-// write all CSVs associated with a search job to the given writer for job id.
-// Note: ctx is used by WriterTo.
+// write all results associated with a search job to the given writer for job
+// id, converted to format. Note: ctx is used by WriterTo.
 //
 // io.WriterTo is a specialization of an io.Reader. We expect callers of this
 // function to want to write a http response, so we avoid an io.Pipe and
 // instead pass a more direct use.
-func (s *Service) GetSearchJobResultsWriterTo(parentCtx context.Context, id int64) (_ io.WriterTo, err error) {
+func (s *Service) GetSearchJobResultsWriterTo(parentCtx context.Context, id int64, format ResultFormat) (_ io.WriterTo, err error) {
 	ctx, _, endObservation := s.operations.getSearchJobResultsWriterTo.get.With(parentCtx, &err, opAttrs(
-		attribute.Int64("id", id)))
+		attribute.Int64("id", id),
+		attribute.String("format", string(format))))
 	defer endObservation(1, observation.Args{})
 
 	// 🚨 SECURITY: only someone with access to the job may copy the blobs
@@ -364,6 +446,15 @@ func (s *Service) GetSearchJobResultsWriterTo(parentCtx context.Context, id int6
 		return nil, err
 	}
 
+	var columns []string
+	if format != ResultFormatJSONLines {
+		job, err := s.store.GetExhaustiveSearchJob(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		columns = resultColumns(job.Query)
+	}
+
 	iter, err := s.uploadStore.List(ctx, getPrefix(id))
 	if err != nil {
 		return nil, err
@@ -371,15 +462,56 @@ func (s *Service) GetSearchJobResultsWriterTo(parentCtx context.Context, id int6
 
 	return writerToFunc(func(w io.Writer) (n int64, err error) {
 		ctx, _, endObservation := s.operations.getSearchJobResultsWriterTo.writerTo.With(parentCtx, &err, opAttrs(
-			attribute.Int64("id", id)))
+			attribute.Int64("id", id),
+			attribute.String("format", string(format))))
 		defer func() {
 			endObservation(1, opAttrs(attribute.Int64("bytesWritten", n)))
 		}()
 
-		return writeSearchJobJSON(ctx, iter, s.uploadStore, w)
+		if format == ResultFormatJSONLines {
+			return writeSearchJobJSON(ctx, iter, s.uploadStore, w)
+		}
+		return writeSearchJobTable(ctx, iter, s.uploadStore, format, columns, w)
 	}), nil
 }
 
+// MatchAggregator accumulates the matches of a search job, for example to
+// count them by group.
+type MatchAggregator interface {
+	Add(ctx context.Context, m streamhttp.EventMatch) error
+}
+
+// AggregateSearchJobResults passes every match stored for job id to agg.
+// Results are stored as soon as a repository revision has been searched, so
+// calling this while the job is still running aggregates the results found
+// so far.
+func (s *Service) AggregateSearchJobResults(ctx context.Context, id int64, agg MatchAggregator) (err error) {
+	ctx, _, endObservation := s.operations.aggregateSearchJobResults.With(ctx, &err, opAttrs(
+		attribute.Int64("id", id)))
+	defer endObservation(1, observation.Args{})
+
+	// 🚨 SECURITY: only someone with access to the job may read the blobs
+	if err := s.store.UserHasAccess(ctx, id); err != nil {
+		return err
+	}
+
+	iter, err := s.uploadStore.List(ctx, getPrefix(id))
+	if err != nil {
+		return err
+	}
+	var keys []string
+	for iter.Next() {
+		keys = append(keys, iter.Current())
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+
+	return forEachMatch(ctx, s.uploadStore, keys, func(m streamhttp.EventMatch) error {
+		return agg.Add(ctx, m)
+	})
+}
+
 // GetAggregateRepoRevState returns the map of state -> count for all repo
 // revision jobs for the given job.
 func (s *Service) GetAggregateRepoRevState(ctx context.Context, id int64) (_ *types.RepoRevJobStats, err error) {
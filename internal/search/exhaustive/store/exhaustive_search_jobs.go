@@ -61,6 +61,8 @@ var exhaustiveSearchJobColumns = []*sqlf.Query{
 	sqlf.Sprintf("created_at"),
 	sqlf.Sprintf("updated_at"),
 	sqlf.Sprintf("is_aggregated"),
+	sqlf.Sprintf("previous_job_id"),
+	sqlf.Sprintf("schedule_id"),
 }
 
 func (s *Store) CreateExhaustiveSearchJob(ctx context.Context, job types.ExhaustiveSearchJob) (_ int64, err error) {
@@ -84,7 +86,7 @@ func (s *Store) CreateExhaustiveSearchJob(ctx context.Context, job types.Exhaust
 
 	return basestore.ScanAny[int64](s.Store.QueryRow(
 		ctx,
-		sqlf.Sprintf(createExhaustiveSearchJobQueryFmtr, job.Query, job.InitiatorID),
+		sqlf.Sprintf(createExhaustiveSearchJobQueryFmtr, job.Query, job.InitiatorID, dbutil.NullInt64Column(job.PreviousJobID), dbutil.NullInt64Column(job.ScheduleID)),
 	))
 }
 
@@ -95,8 +97,8 @@ var MissingQueryErr = errors.New("missing query")
 var MissingInitiatorIDErr = errors.New("missing initiator ID")
 
 const createExhaustiveSearchJobQueryFmtr = `
-INSERT INTO exhaustive_search_jobs (query, initiator_id)
-VALUES (%s, %s)
+INSERT INTO exhaustive_search_jobs (query, initiator_id, previous_job_id, schedule_id)
+VALUES (%s, %s, %s, %s)
 RETURNING id
 `
 
@@ -297,6 +299,9 @@ type ListArgs struct {
 	Query   string
 	States  []string
 	UserIDs []int32
+
+	// If set, only runs of this schedule are listed.
+	ScheduleID int64
 }
 
 func (s *Store) ListExhaustiveSearchJobs(ctx context.Context, args ListArgs) (jobs []*types.ExhaustiveSearchJob, err error) {
@@ -319,6 +324,11 @@ func (s *Store) ListExhaustiveSearchJobs(ctx context.Context, args ListArgs) (jo
 		conds = append(conds, sqlf.Sprintf("query LIKE %s", "%"+args.Query+"%"))
 	}
 
+	// Filter by schedule.
+	if args.ScheduleID != 0 {
+		conds = append(conds, sqlf.Sprintf("schedule_id = %d", args.ScheduleID))
+	}
+
 	// Filter by state.
 	if len(args.States) > 0 {
 		states := make([]*sqlf.Query, len(args.States))
@@ -551,6 +561,8 @@ func defaultScanTargets(job *types.ExhaustiveSearchJob) []any {
 		&job.CreatedAt,
 		&job.UpdatedAt,
 		&job.IsAggregated,
+		&dbutil.NullInt64{N: &job.PreviousJobID},
+		&dbutil.NullInt64{N: &job.ScheduleID},
 	}
 }
 
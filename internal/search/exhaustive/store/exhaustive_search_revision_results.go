@@ -0,0 +1,191 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var revisionResultColumns = []*sqlf.Query{
+	sqlf.Sprintf("id"),
+	sqlf.Sprintf("search_job_id"),
+	sqlf.Sprintf("repo_id"),
+	sqlf.Sprintf("revision"),
+	sqlf.Sprintf("commit_id"),
+	sqlf.Sprintf("blob_prefix"),
+	sqlf.Sprintf("match_count"),
+	sqlf.Sprintf("matches_added"),
+	sqlf.Sprintf("matches_removed"),
+	sqlf.Sprintf("reused"),
+	sqlf.Sprintf("created_at"),
+}
+
+// UpsertRevisionResult records the result of searching a revision of a
+// repository. Workers may retry a revision, so an existing result for the same
+// job, repository and revision is overwritten.
+func (s *Store) UpsertRevisionResult(ctx context.Context, r types.ExhaustiveSearchRevisionResult) (err error) {
+	ctx, _, endObservation := s.operations.upsertRevisionResult.With(ctx, &err, opAttrs(
+		attribute.Int64("searchJobID", r.SearchJobID),
+		attribute.Int("repoID", int(r.RepoID)),
+		attribute.String("revision", r.Revision),
+	))
+	defer endObservation(1, observation.Args{})
+
+	if r.SearchJobID <= 0 {
+		return MissingSearchJobIDErr
+	}
+	if r.RepoID <= 0 {
+		return MissingRepoIDErr
+	}
+	if r.Revision == "" {
+		return MissingRevisionErr
+	}
+
+	return s.Exec(ctx, sqlf.Sprintf(
+		upsertRevisionResultQueryFmtr,
+		r.SearchJobID,
+		r.RepoID,
+		r.Revision,
+		r.CommitID,
+		r.BlobPrefix,
+		r.MatchCount,
+		r.MatchesAdded,
+		r.MatchesRemoved,
+		r.Reused,
+	))
+}
+
+const upsertRevisionResultQueryFmtr = `
+INSERT INTO exhaustive_search_revision_results (search_job_id, repo_id, revision, commit_id, blob_prefix, match_count, matches_added, matches_removed, reused)
+VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s)
+ON CONFLICT (search_job_id, repo_id, revision) DO UPDATE SET
+	commit_id = EXCLUDED.commit_id,
+	blob_prefix = EXCLUDED.blob_prefix,
+	match_count = EXCLUDED.match_count,
+	matches_added = EXCLUDED.matches_added,
+	matches_removed = EXCLUDED.matches_removed,
+	reused = EXCLUDED.reused,
+	created_at = NOW()
+`
+
+// GetRevisionResult returns the result of searching revision of the given
+// repository as part of the search job with the given ID. ErrNoResults is
+// returned if the revision has not been searched by the job.
+func (s *Store) GetRevisionResult(ctx context.Context, searchJobID int64, repoID api.RepoID, revision string) (_ *types.ExhaustiveSearchRevisionResult, err error) {
+	ctx, _, endObservation := s.operations.getRevisionResult.With(ctx, &err, opAttrs(
+		attribute.Int64("searchJobID", searchJobID),
+		attribute.Int("repoID", int(repoID)),
+		attribute.String("revision", revision),
+	))
+	defer endObservation(1, observation.Args{})
+
+	r, err := scanRevisionResult(s.QueryRow(ctx, sqlf.Sprintf(
+		getRevisionResultQueryFmtr,
+		sqlf.Join(revisionResultColumns, ", "),
+		searchJobID,
+		repoID,
+		revision,
+	)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNoResults
+		}
+		return nil, err
+	}
+	return r, nil
+}
+
+const getRevisionResultQueryFmtr = `
+SELECT %s
+FROM exhaustive_search_revision_results
+WHERE search_job_id = %s AND repo_id = %s AND revision = %s
+`
+
+// GetPreviousJobID returns the ID of the search job which the search job with
+// the given ID incrementally re-runs, or 0 if it isn't a re-run.
+func (s *Store) GetPreviousJobID(ctx context.Context, id int64) (int64, error) {
+	previousID, _, err := basestore.ScanFirstNullInt64(s.Query(ctx, sqlf.Sprintf(
+		"SELECT previous_job_id FROM exhaustive_search_jobs WHERE id = %s",
+		id,
+	)))
+	return previousID, err
+}
+
+// GetIncrementalSummary returns how the results of the search job with the
+// given ID differ from the results of the job it incrementally re-runs. The
+// summary is only complete once all revision jobs have finished.
+func (s *Store) GetIncrementalSummary(ctx context.Context, id int64) (_ *types.IncrementalSummary, err error) {
+	ctx, _, endObservation := s.operations.getIncrementalSummary.With(ctx, &err, opAttrs(
+		attribute.Int64("ID", id),
+	))
+	defer endObservation(1, observation.Args{})
+
+	// 🚨 SECURITY: only someone with access to the job may view its results
+	if err := s.UserHasAccess(ctx, id); err != nil {
+		return nil, err
+	}
+
+	var summary types.IncrementalSummary
+	err = s.QueryRow(ctx, sqlf.Sprintf(getIncrementalSummaryQueryFmtr, id, id)).Scan(
+		&summary.RevisionsReused,
+		&summary.RevisionsSearched,
+		&summary.RevisionsRemoved,
+		&summary.MatchesAdded,
+		&summary.MatchesRemoved,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+// getIncrementalSummaryQueryFmtr sums up the per revision diffs of a job. The
+// matches of revisions which were part of the previous job, but aren't part of
+// this job anymore, count as removed.
+const getIncrementalSummaryQueryFmtr = `
+WITH removed AS (
+	SELECT COUNT(*) AS revisions, COALESCE(SUM(prev.match_count), 0) AS matches
+	FROM exhaustive_search_jobs sj
+	JOIN exhaustive_search_revision_results prev ON prev.search_job_id = sj.previous_job_id
+	WHERE sj.id = %s
+	AND NOT EXISTS (
+		SELECT 1
+		FROM exhaustive_search_revision_results cur
+		WHERE cur.search_job_id = sj.id AND cur.repo_id = prev.repo_id AND cur.revision = prev.revision
+	)
+)
+SELECT
+	COUNT(*) FILTER (WHERE r.reused),
+	COUNT(*) FILTER (WHERE NOT r.reused),
+	(SELECT revisions FROM removed),
+	COALESCE(SUM(r.matches_added), 0),
+	COALESCE(SUM(r.matches_removed), 0) + (SELECT matches FROM removed)
+FROM exhaustive_search_revision_results r
+WHERE r.search_job_id = %s
+`
+
+func scanRevisionResult(sc dbutil.Scanner) (*types.ExhaustiveSearchRevisionResult, error) {
+	var r types.ExhaustiveSearchRevisionResult
+	return &r, sc.Scan(
+		&r.ID,
+		&r.SearchJobID,
+		&r.RepoID,
+		&r.Revision,
+		&r.CommitID,
+		&r.BlobPrefix,
+		&r.MatchCount,
+		&r.MatchesAdded,
+		&r.MatchesRemoved,
+		&r.Reused,
+		&r.CreatedAt,
+	)
+}
@@ -0,0 +1,90 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store/storetest"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+)
+
+func TestStore_RevisionResults(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(t))
+
+	bs := basestore.NewWithHandle(db.Handle())
+
+	userID, err := storetest.CreateUser(bs, "alice")
+	require.NoError(t, err)
+	repoA, err := storetest.CreateRepo(db, "repo-a")
+	require.NoError(t, err)
+	repoB, err := storetest.CreateRepo(db, "repo-b")
+	require.NoError(t, err)
+
+	ctx := actor.WithActor(context.Background(), &actor.Actor{
+		UID: userID,
+	})
+
+	s := store.New(db, observation.TestContextTB(t))
+
+	previousJobID, err := s.CreateExhaustiveSearchJob(ctx, types.ExhaustiveSearchJob{InitiatorID: userID, Query: "foo"})
+	require.NoError(t, err)
+	jobID, err := s.CreateExhaustiveSearchJob(ctx, types.ExhaustiveSearchJob{InitiatorID: userID, Query: "foo", PreviousJobID: previousJobID})
+	require.NoError(t, err)
+
+	gotPreviousJobID, err := s.GetPreviousJobID(ctx, jobID)
+	require.NoError(t, err)
+	require.Equal(t, previousJobID, gotPreviousJobID)
+
+	gotPreviousJobID, err = s.GetPreviousJobID(ctx, previousJobID)
+	require.NoError(t, err)
+	require.Zero(t, gotPreviousJobID)
+
+	job, err := s.GetExhaustiveSearchJob(ctx, jobID)
+	require.NoError(t, err)
+	require.Equal(t, previousJobID, job.PreviousJobID)
+
+	for _, r := range []types.ExhaustiveSearchRevisionResult{
+		{SearchJobID: previousJobID, RepoID: repoA, Revision: "main", CommitID: "a1", BlobPrefix: "1-1", MatchCount: 5, MatchesAdded: 5},
+		{SearchJobID: previousJobID, RepoID: repoA, Revision: "dev", CommitID: "d1", BlobPrefix: "1-2", MatchCount: 2, MatchesAdded: 2},
+		{SearchJobID: previousJobID, RepoID: repoB, Revision: "main", CommitID: "b1", BlobPrefix: "1-3", MatchCount: 4, MatchesAdded: 4},
+		// repoA@main didn't move, repoB@main did and repoA@dev is gone.
+		{SearchJobID: jobID, RepoID: repoA, Revision: "main", CommitID: "a1", BlobPrefix: "2-1", MatchCount: 5, Reused: true},
+		{SearchJobID: jobID, RepoID: repoB, Revision: "main", CommitID: "b1", BlobPrefix: "2-2", MatchCount: 1, MatchesAdded: 1},
+		// Retrying a revision overwrites the result.
+		{SearchJobID: jobID, RepoID: repoB, Revision: "main", CommitID: "b2", BlobPrefix: "2-2", MatchCount: 6, MatchesAdded: 3, MatchesRemoved: 1},
+	} {
+		require.NoError(t, s.UpsertRevisionResult(ctx, r))
+	}
+
+	got, err := s.GetRevisionResult(ctx, jobID, repoB, "main")
+	require.NoError(t, err)
+	require.Equal(t, "b2", string(got.CommitID))
+	require.Equal(t, int32(6), got.MatchCount)
+
+	_, err = s.GetRevisionResult(ctx, jobID, repoA, "dev")
+	require.ErrorIs(t, err, store.ErrNoResults)
+
+	summary, err := s.GetIncrementalSummary(ctx, jobID)
+	require.NoError(t, err)
+	require.Equal(t, &types.IncrementalSummary{
+		RevisionsReused:   1,
+		RevisionsSearched: 1,
+		RevisionsRemoved:  1,
+		MatchesAdded:      3,
+		MatchesRemoved:    3,
+	}, summary)
+}
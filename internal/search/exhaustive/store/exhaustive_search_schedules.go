@@ -0,0 +1,343 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var scheduleColumns = []*sqlf.Query{
+	sqlf.Sprintf("id"),
+	sqlf.Sprintf("query"),
+	sqlf.Sprintf("schedule"),
+	sqlf.Sprintf("namespace_user_id"),
+	sqlf.Sprintf("namespace_org_id"),
+	sqlf.Sprintf("creator_id"),
+	sqlf.Sprintf("retain_runs"),
+	sqlf.Sprintf("retain_days"),
+	sqlf.Sprintf("incremental"),
+	sqlf.Sprintf("notify"),
+	sqlf.Sprintf("enabled"),
+	sqlf.Sprintf("next_run_at"),
+	sqlf.Sprintf("last_run_at"),
+	sqlf.Sprintf("created_at"),
+	sqlf.Sprintf("updated_at"),
+}
+
+// MissingNamespaceErr is returned when a schedule is owned by neither a user
+// nor an org, or by both.
+var MissingNamespaceErr = errors.New("schedule must be owned by either a user or an org")
+
+// MissingScheduleErr is returned when the cron expression of a schedule is
+// missing.
+var MissingScheduleErr = errors.New("missing schedule")
+
+// checkNamespaceAccess checks that the actor may manage schedules owned by
+// the given user or org.
+func (s *Store) checkNamespaceAccess(ctx context.Context, userID, orgID int32) error {
+	switch {
+	case userID != 0 && orgID == 0:
+		return auth.CheckSiteAdminOrSameUser(ctx, s.db, userID)
+	case orgID != 0 && userID == 0:
+		return auth.CheckOrgAccessOrSiteAdmin(ctx, s.db, orgID)
+	default:
+		return MissingNamespaceErr
+	}
+}
+
+func (s *Store) CreateSchedule(ctx context.Context, schedule types.ExhaustiveSearchSchedule) (_ int64, err error) {
+	ctx, _, endObservation := s.operations.createSchedule.With(ctx, &err, opAttrs(
+		attribute.String("query", schedule.Query),
+		attribute.String("schedule", schedule.Schedule),
+	))
+	defer endObservation(1, observation.Args{})
+
+	if schedule.Query == "" {
+		return 0, MissingQueryErr
+	}
+	if schedule.Schedule == "" {
+		return 0, MissingScheduleErr
+	}
+	if schedule.CreatorID <= 0 {
+		return 0, MissingInitiatorIDErr
+	}
+
+	// 🚨 SECURITY: runs are initiated on behalf of the creator, so the creator
+	// has to match the actor.
+	if err := auth.CheckSameUser(ctx, schedule.CreatorID); err != nil {
+		return 0, err
+	}
+	// 🚨 SECURITY: only members of the namespace may create schedules in it.
+	if err := s.checkNamespaceAccess(ctx, schedule.NamespaceUserID, schedule.NamespaceOrgID); err != nil {
+		return 0, err
+	}
+
+	return basestore.ScanAny[int64](s.QueryRow(ctx, sqlf.Sprintf(
+		createScheduleQueryFmtr,
+		schedule.Query,
+		schedule.Schedule,
+		dbutil.NullInt32Column(schedule.NamespaceUserID),
+		dbutil.NullInt32Column(schedule.NamespaceOrgID),
+		schedule.CreatorID,
+		schedule.RetainRuns,
+		schedule.RetainDays,
+		schedule.Incremental,
+		schedule.Notify,
+		schedule.Enabled,
+		dbutil.NullTimeColumn(schedule.NextRunAt),
+	)))
+}
+
+const createScheduleQueryFmtr = `
+INSERT INTO exhaustive_search_schedules (query, schedule, namespace_user_id, namespace_org_id, creator_id, retain_runs, retain_days, incremental, notify, enabled, next_run_at)
+VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+RETURNING id
+`
+
+// UpdateSchedule updates the mutable fields of a schedule. The namespace and
+// the creator of a schedule cannot be changed, and only the creator or a site
+// admin may update it.
+func (s *Store) UpdateSchedule(ctx context.Context, schedule types.ExhaustiveSearchSchedule) (err error) {
+	ctx, _, endObservation := s.operations.updateSchedule.With(ctx, &err, opAttrs(
+		attribute.Int64("ID", schedule.ID),
+	))
+	defer endObservation(1, observation.Args{})
+
+	if schedule.Query == "" {
+		return MissingQueryErr
+	}
+	if schedule.Schedule == "" {
+		return MissingScheduleErr
+	}
+
+	// 🚨 SECURITY: only members of the namespace may update the schedule.
+	stored, err := s.GetSchedule(ctx, schedule.ID)
+	if err != nil {
+		return err
+	}
+	// 🚨 SECURITY: runs are initiated on behalf of the creator, so other
+	// members of the namespace must not change what the runs search for.
+	if err := auth.CheckSiteAdminOrSameUser(ctx, s.db, stored.CreatorID); err != nil {
+		return err
+	}
+
+	return s.Exec(ctx, sqlf.Sprintf(
+		updateScheduleQueryFmtr,
+		schedule.Query,
+		schedule.Schedule,
+		schedule.RetainRuns,
+		schedule.RetainDays,
+		schedule.Incremental,
+		schedule.Notify,
+		schedule.Enabled,
+		dbutil.NullTimeColumn(schedule.NextRunAt),
+		schedule.ID,
+	))
+}
+
+const updateScheduleQueryFmtr = `
+UPDATE exhaustive_search_schedules
+SET
+	query = %s,
+	schedule = %s,
+	retain_runs = %s,
+	retain_days = %s,
+	incremental = %s,
+	notify = %s,
+	enabled = %s,
+	next_run_at = %s,
+	updated_at = NOW()
+WHERE id = %s
+`
+
+func (s *Store) DeleteSchedule(ctx context.Context, id int64) (err error) {
+	ctx, _, endObservation := s.operations.deleteSchedule.With(ctx, &err, opAttrs(
+		attribute.Int64("ID", id),
+	))
+	defer endObservation(1, observation.Args{})
+
+	// 🚨 SECURITY: only members of the namespace may delete the schedule.
+	if _, err := s.GetSchedule(ctx, id); err != nil {
+		return err
+	}
+
+	return s.Exec(ctx, sqlf.Sprintf("DELETE FROM exhaustive_search_schedules WHERE id = %s", id))
+}
+
+func (s *Store) GetSchedule(ctx context.Context, id int64) (_ *types.ExhaustiveSearchSchedule, err error) {
+	ctx, _, endObservation := s.operations.getSchedule.With(ctx, &err, opAttrs(
+		attribute.Int64("ID", id),
+	))
+	defer endObservation(1, observation.Args{})
+
+	schedule, err := scanSchedule(s.QueryRow(ctx, sqlf.Sprintf(
+		"SELECT %s FROM exhaustive_search_schedules WHERE id = %s",
+		sqlf.Join(scheduleColumns, ", "),
+		id,
+	)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrapf(ErrNoResults, "failed to scan schedule with id %d", id)
+		}
+		return nil, err
+	}
+
+	// 🚨 SECURITY: only members of the namespace may view the schedule.
+	if err := s.checkNamespaceAccess(ctx, schedule.NamespaceUserID, schedule.NamespaceOrgID); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+type ListSchedulesArgs struct {
+	// If set, only schedules owned by this user or org are listed. Otherwise
+	// the schedules of the actor and of all orgs the actor is a member of are
+	// listed.
+	NamespaceUserID int32
+	NamespaceOrgID  int32
+}
+
+func (s *Store) ListSchedules(ctx context.Context, args ListSchedulesArgs) (schedules []*types.ExhaustiveSearchSchedule, err error) {
+	ctx, _, endObservation := s.operations.listSchedules.With(ctx, &err, observation.Args{})
+	defer func() {
+		endObservation(1, opAttrs(attribute.Int("length", len(schedules))))
+	}()
+
+	a := actor.FromContext(ctx)
+
+	// 🚨 SECURITY: Only authenticated users can list schedules.
+	if !a.IsAuthenticated() {
+		return nil, errors.New("can only list schedules for an authenticated user")
+	}
+
+	var where *sqlf.Query
+	switch {
+	case args.NamespaceUserID != 0 || args.NamespaceOrgID != 0:
+		// 🚨 SECURITY: only members of the namespace may list its schedules.
+		if err := s.checkNamespaceAccess(ctx, args.NamespaceUserID, args.NamespaceOrgID); err != nil {
+			return nil, err
+		}
+		if args.NamespaceUserID != 0 {
+			where = sqlf.Sprintf("namespace_user_id = %s", args.NamespaceUserID)
+		} else {
+			where = sqlf.Sprintf("namespace_org_id = %s", args.NamespaceOrgID)
+		}
+	default:
+		where = sqlf.Sprintf(
+			"namespace_user_id = %s OR namespace_org_id IN (SELECT org_id FROM org_members WHERE user_id = %s)",
+			a.UID,
+			a.UID,
+		)
+	}
+
+	return scanSchedules(s.Query(ctx, sqlf.Sprintf(
+		"SELECT %s FROM exhaustive_search_schedules WHERE %s ORDER BY id",
+		sqlf.Join(scheduleColumns, ", "),
+		where,
+	)))
+}
+
+// ListDueSchedules returns the enabled schedules whose next run is due at now
+// and locks them until the end of the transaction. Schedules locked by another
+// transaction are skipped.
+//
+// It does not check permissions and must only be called by the scheduler.
+func (s *Store) ListDueSchedules(ctx context.Context, now time.Time, limit int) (schedules []*types.ExhaustiveSearchSchedule, err error) {
+	ctx, _, endObservation := s.operations.listDueSchedules.With(ctx, &err, observation.Args{})
+	defer func() {
+		endObservation(1, opAttrs(attribute.Int("length", len(schedules))))
+	}()
+
+	return scanSchedules(s.Query(ctx, sqlf.Sprintf(
+		listDueSchedulesQueryFmtr,
+		sqlf.Join(scheduleColumns, ", "),
+		now,
+		limit,
+	)))
+}
+
+const listDueSchedulesQueryFmtr = `
+SELECT %s
+FROM exhaustive_search_schedules
+WHERE enabled AND next_run_at <= %s
+ORDER BY next_run_at
+LIMIT %s
+FOR UPDATE SKIP LOCKED
+`
+
+// SetScheduleRun records that the schedule was considered for a run at
+// lastRunAt and is due again at nextRunAt.
+//
+// It does not check permissions and must only be called by the scheduler.
+func (s *Store) SetScheduleRun(ctx context.Context, id int64, lastRunAt, nextRunAt time.Time) error {
+	return s.Exec(ctx, sqlf.Sprintf(
+		"UPDATE exhaustive_search_schedules SET last_run_at = %s, next_run_at = %s WHERE id = %s",
+		lastRunAt,
+		dbutil.NullTimeColumn(nextRunAt),
+		id,
+	))
+}
+
+// ListScheduleRuns returns all runs of the schedule, newest first.
+//
+// It does not check permissions and must only be called by the scheduler.
+func (s *Store) ListScheduleRuns(ctx context.Context, scheduleID int64) ([]*types.ExhaustiveSearchJob, error) {
+	return scanExhaustiveSearchJobsList(s.Query(ctx, listSearchJobQuery(
+		sqlf.Sprintf("WHERE schedule_id = %s ORDER BY created_at DESC, id DESC", scheduleID),
+	)))
+}
+
+// ListUnfinalizedScheduleRuns returns the runs of schedules which have not
+// been finalized yet, oldest first.
+//
+// It does not check permissions and must only be called by the scheduler.
+func (s *Store) ListUnfinalizedScheduleRuns(ctx context.Context) ([]*types.ExhaustiveSearchJob, error) {
+	return scanExhaustiveSearchJobsList(s.Query(ctx, listSearchJobQuery(
+		// schedule_finalized is not selected by listSearchJobQuery, so we can't
+		// filter on it directly.
+		sqlf.Sprintf("WHERE id IN (SELECT id FROM exhaustive_search_jobs WHERE schedule_id IS NOT NULL AND NOT schedule_finalized) ORDER BY id"),
+	)))
+}
+
+// SetScheduleRunFinalized marks the run as finalized, so that the scheduler
+// doesn't notify about it again.
+//
+// It does not check permissions and must only be called by the scheduler.
+func (s *Store) SetScheduleRunFinalized(ctx context.Context, id int64) error {
+	return s.Exec(ctx, sqlf.Sprintf("UPDATE exhaustive_search_jobs SET schedule_finalized = true WHERE id = %s", id))
+}
+
+func scanSchedule(sc dbutil.Scanner) (*types.ExhaustiveSearchSchedule, error) {
+	var schedule types.ExhaustiveSearchSchedule
+	return &schedule, sc.Scan(
+		&schedule.ID,
+		&schedule.Query,
+		&schedule.Schedule,
+		&dbutil.NullInt32{N: &schedule.NamespaceUserID},
+		&dbutil.NullInt32{N: &schedule.NamespaceOrgID},
+		&schedule.CreatorID,
+		&schedule.RetainRuns,
+		&schedule.RetainDays,
+		&schedule.Incremental,
+		&schedule.Notify,
+		&schedule.Enabled,
+		&dbutil.NullTime{Time: &schedule.NextRunAt},
+		&dbutil.NullTime{Time: &schedule.LastRunAt},
+		&schedule.CreatedAt,
+		&schedule.UpdatedAt,
+	)
+}
+
+var scanSchedules = basestore.NewSliceScanner(scanSchedule)
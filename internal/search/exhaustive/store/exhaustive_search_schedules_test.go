@@ -0,0 +1,164 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store/storetest"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+)
+
+func TestStore_Schedules(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(t))
+
+	bs := basestore.NewWithHandle(db.Handle())
+
+	alice, err := storetest.CreateUser(bs, "alice")
+	require.NoError(t, err)
+	bob, err := storetest.CreateUser(bs, "bob")
+	require.NoError(t, err)
+
+	aliceCtx := actor.WithActor(context.Background(), actor.FromUser(alice))
+	bobCtx := actor.WithActor(context.Background(), actor.FromUser(bob))
+	internalCtx := actor.WithInternalActor(context.Background())
+
+	s := store.New(db, observation.TestContextTB(t))
+
+	now := time.Now().UTC().Truncate(time.Second)
+	schedule := types.ExhaustiveSearchSchedule{
+		Query:           "foo",
+		Schedule:        "0 * * * *",
+		NamespaceUserID: alice,
+		CreatorID:       alice,
+		RetainRuns:      3,
+		Notify:          true,
+		Enabled:         true,
+		NextRunAt:       now.Add(-time.Minute),
+	}
+
+	t.Run("namespace is required", func(t *testing.T) {
+		s := schedule
+		s.NamespaceUserID = 0
+		_, err := store.New(db, observation.TestContextTB(t)).CreateSchedule(aliceCtx, s)
+		require.ErrorIs(t, err, store.MissingNamespaceErr)
+	})
+
+	t.Run("cannot create schedules for other users", func(t *testing.T) {
+		_, err := s.CreateSchedule(bobCtx, schedule)
+		require.Error(t, err)
+	})
+
+	id, err := s.CreateSchedule(aliceCtx, schedule)
+	require.NoError(t, err)
+
+	got, err := s.GetSchedule(aliceCtx, id)
+	require.NoError(t, err)
+	require.Equal(t, "foo", got.Query)
+	require.Equal(t, int32(3), got.RetainRuns)
+	require.True(t, got.Enabled)
+
+	_, err = s.GetSchedule(bobCtx, id)
+	require.Error(t, err)
+
+	schedules, err := s.ListSchedules(aliceCtx, store.ListSchedulesArgs{})
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+
+	schedules, err = s.ListSchedules(bobCtx, store.ListSchedulesArgs{})
+	require.NoError(t, err)
+	require.Empty(t, schedules)
+
+	// The schedule is due.
+	due, err := s.ListDueSchedules(internalCtx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	require.Equal(t, id, due[0].ID)
+
+	require.NoError(t, s.SetScheduleRun(internalCtx, id, now, now.Add(time.Hour)))
+
+	due, err = s.ListDueSchedules(internalCtx, now, 10)
+	require.NoError(t, err)
+	require.Empty(t, due)
+
+	got, err = s.GetSchedule(aliceCtx, id)
+	require.NoError(t, err)
+	require.Equal(t, now, got.LastRunAt.UTC())
+
+	// Runs of the schedule are finalized once.
+	runID, err := s.CreateExhaustiveSearchJob(aliceCtx, types.ExhaustiveSearchJob{InitiatorID: alice, Query: "foo", ScheduleID: id})
+	require.NoError(t, err)
+	_, err = s.CreateExhaustiveSearchJob(aliceCtx, types.ExhaustiveSearchJob{InitiatorID: alice, Query: "bar"})
+	require.NoError(t, err)
+
+	runs, err := s.ListScheduleRuns(internalCtx, id)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	require.Equal(t, runID, runs[0].ID)
+	require.Equal(t, id, runs[0].ScheduleID)
+
+	runs, err = s.ListUnfinalizedScheduleRuns(internalCtx)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+
+	require.NoError(t, s.SetScheduleRunFinalized(internalCtx, runID))
+
+	runs, err = s.ListUnfinalizedScheduleRuns(internalCtx)
+	require.NoError(t, err)
+	require.Empty(t, runs)
+
+	// Disabled schedules are never due.
+	got.Enabled = false
+	got.NextRunAt = time.Time{}
+	require.NoError(t, s.UpdateSchedule(aliceCtx, *got))
+
+	due, err = s.ListDueSchedules(internalCtx, now.Add(24*time.Hour), 10)
+	require.NoError(t, err)
+	require.Empty(t, due)
+
+	require.Error(t, s.DeleteSchedule(bobCtx, id))
+	require.NoError(t, s.DeleteSchedule(aliceCtx, id))
+
+	t.Run("only the creator may update org schedules", func(t *testing.T) {
+		ctx := context.Background()
+		org, err := db.Orgs().Create(ctx, "org", nil)
+		require.NoError(t, err)
+		for _, user := range []int32{alice, bob} {
+			_, err := db.OrgMembers().Create(ctx, org.ID, user)
+			require.NoError(t, err)
+		}
+
+		orgSchedule := schedule
+		orgSchedule.NamespaceUserID = 0
+		orgSchedule.NamespaceOrgID = org.ID
+		id, err := s.CreateSchedule(aliceCtx, orgSchedule)
+		require.NoError(t, err)
+
+		// Bob can see the schedule, but runs are initiated on behalf of
+		// Alice, so he must not change the query.
+		got, err := s.GetSchedule(bobCtx, id)
+		require.NoError(t, err)
+		got.Query = "secret"
+		require.Error(t, s.UpdateSchedule(bobCtx, *got))
+		require.NoError(t, s.UpdateSchedule(aliceCtx, *got))
+	})
+
+	// Runs outlive their schedule.
+	job, err := s.GetExhaustiveSearchJob(aliceCtx, runID)
+	require.NoError(t, err)
+	require.Zero(t, job.ScheduleID)
+}
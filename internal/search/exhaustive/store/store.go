@@ -69,6 +69,17 @@ type operations struct {
 	createExhaustiveSearchRepoJob         *observation.Operation
 	createExhaustiveSearchRepoRevisionJob *observation.Operation
 	getAggregateRepoRevState              *observation.Operation
+
+	upsertRevisionResult  *observation.Operation
+	getRevisionResult     *observation.Operation
+	getIncrementalSummary *observation.Operation
+
+	createSchedule   *observation.Operation
+	updateSchedule   *observation.Operation
+	deleteSchedule   *observation.Operation
+	getSchedule      *observation.Operation
+	listSchedules    *observation.Operation
+	listDueSchedules *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -102,5 +113,16 @@ func newOperations(observationCtx *observation.Context) *operations {
 		createExhaustiveSearchRepoJob:         op("CreateExhaustiveSearchRepoJob"),
 		createExhaustiveSearchRepoRevisionJob: op("CreateExhaustiveSearchRepoRevisionJob"),
 		getAggregateRepoRevState:              op("GetAggregateRepoRevState"),
+
+		upsertRevisionResult:  op("UpsertRevisionResult"),
+		getRevisionResult:     op("GetRevisionResult"),
+		getIncrementalSummary: op("GetIncrementalSummary"),
+
+		createSchedule:   op("CreateSchedule"),
+		updateSchedule:   op("UpdateSchedule"),
+		deleteSchedule:   op("DeleteSchedule"),
+		getSchedule:      op("GetSchedule"),
+		listSchedules:    op("ListSchedules"),
+		listDueSchedules: op("ListDueSchedules"),
 	}
 }
@@ -18,6 +18,14 @@ type ExhaustiveSearchJob struct {
 
 	Query string
 
+	// PreviousJobID is the ID of the search job this job incrementally re-runs,
+	// or 0 if the job searches every revision from scratch.
+	PreviousJobID int64
+
+	// ScheduleID is the ID of the schedule which created this job, or 0 if the
+	// job was created by a user.
+	ScheduleID int64
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
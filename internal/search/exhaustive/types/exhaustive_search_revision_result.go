@@ -0,0 +1,55 @@
+package types
+
+import (
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+// ExhaustiveSearchRevisionResult records the outcome of searching a single
+// revision of a repository as part of a search job. Unlike the worker jobs it
+// is kept until the search job is deleted, so that an incremental re-run can
+// reuse it.
+// Maps to the `exhaustive_search_revision_results` database table.
+type ExhaustiveSearchRevisionResult struct {
+	ID int64
+
+	SearchJobID int64
+	RepoID      api.RepoID
+	Revision    string
+
+	// CommitID is the commit Revision resolved to when it was searched. It is
+	// empty if the repository was empty.
+	CommitID api.CommitID
+
+	// BlobPrefix is the key prefix of the blobs in the upload store which hold
+	// the matches.
+	BlobPrefix string
+
+	MatchCount     int32
+	MatchesAdded   int32
+	MatchesRemoved int32
+
+	// Reused is true if the matches were copied from the previous job because
+	// the revision still resolved to the same commit.
+	Reused bool
+
+	CreatedAt time.Time
+}
+
+// IncrementalSummary describes how the results of an incrementally re-run
+// search job differ from the results of the job it re-ran.
+type IncrementalSummary struct {
+	// RevisionsReused is the number of revisions whose results were copied
+	// from the previous job.
+	RevisionsReused int32
+	// RevisionsSearched is the number of revisions which were searched again,
+	// because they moved or were not part of the previous job.
+	RevisionsSearched int32
+	// RevisionsRemoved is the number of revisions of the previous job which
+	// are not part of this job anymore.
+	RevisionsRemoved int32
+
+	MatchesAdded   int64
+	MatchesRemoved int64
+}
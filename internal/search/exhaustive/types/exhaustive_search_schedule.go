@@ -0,0 +1,43 @@
+package types
+
+import (
+	"time"
+)
+
+// ExhaustiveSearchSchedule periodically creates a search job for a query.
+// Maps to the `exhaustive_search_schedules` database table.
+type ExhaustiveSearchSchedule struct {
+	ID int64
+
+	Query string
+
+	// Schedule is a cron expression, evaluated in UTC.
+	Schedule string
+
+	// The schedule is owned by either a user or an org.
+	NamespaceUserID int32
+	NamespaceOrgID  int32
+
+	// CreatorID is the user who created the schedule. Runs are initiated on
+	// behalf of the creator.
+	CreatorID int32
+
+	// RetainRuns is the number of finished runs to keep, and RetainDays the
+	// number of days to keep finished runs for. 0 means no limit.
+	RetainRuns int32
+	RetainDays int32
+
+	// Incremental runs re-run the previous run incrementally.
+	Incremental bool
+
+	// Notify is true if the creator should be emailed when a run finishes.
+	Notify bool
+
+	Enabled bool
+
+	NextRunAt time.Time
+	LastRunAt time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
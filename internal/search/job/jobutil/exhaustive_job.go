@@ -2,6 +2,7 @@ package jobutil
 
 import (
 	"context"
+	"slices"
 
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/search"
@@ -20,6 +21,10 @@ import (
 // differentiate ourselves from the infrastructure.
 type Exhaustive struct {
 	repoPagerJob *repoPagerJob
+
+	// basic is the query Exhaustive was constructed from. It is used to apply
+	// post-search filters to the jobs returned by Job.
+	basic query.Basic
 }
 
 const (
@@ -45,11 +50,12 @@ func NewExhaustive(inputs *search.Inputs) (Exhaustive, error) {
 		return Exhaustive{}, errors.Errorf("select: is not supported in Search Jobs")
 	}
 
-	// We don't support file predicates, such as file:has.content(), because the
-	// search breaks in unexpected ways. For example, for interactive search
-	// file:has.content() is translated to an AND query which we don't support in
-	// Search Jobs yet.
-	if pred, ok := hasPredicates(query.FieldFile, inputs.Query); ok {
+	// We don't support most file predicates, such as file:has.content(),
+	// because the search breaks in unexpected ways. For example, for
+	// interactive search file:has.content() is translated to an AND query which
+	// we don't support in Search Jobs yet. Predicates which are implemented as
+	// post-search filters on file matches are fine.
+	if pred, ok := hasPredicates(query.FieldFile, inputs.Query, exhaustiveSupportedFilePredicates...); ok {
 		return Exhaustive{}, errors.Errorf("file predicates are not supported. Got %v", pred)
 	}
 
@@ -70,6 +76,10 @@ func NewExhaustive(inputs *search.Inputs) (Exhaustive, error) {
 	var planJob job.Job
 
 	if resultTypes.Has(result.TypeCommit | result.TypeDiff) {
+		if isFileMetadataSearch(b) {
+			return Exhaustive{}, errors.New("file:has.commit.after() and file:has.size() are only supported when searching files and paths")
+		}
+
 		_, _, own := isOwnershipSearch(b)
 		diff := resultTypes.Has(result.TypeDiff)
 		// Follows the logic of interactive search, see
@@ -110,12 +120,23 @@ func NewExhaustive(inputs *search.Inputs) (Exhaustive, error) {
 
 	return Exhaustive{
 		repoPagerJob: repoPagerJob,
+		basic:        b,
 	}, nil
 }
 
-func hasPredicates(field string, q query.Q) (pred string, ok bool) {
+// exhaustiveSupportedFilePredicates are the file predicates Exhaustive
+// supports.
+var exhaustiveSupportedFilePredicates = []string{"has.commit.after", "has.size"}
+
+// hasPredicates returns the first predicate on field which is not one of the
+// allowed predicates.
+func hasPredicates(field string, q query.Q, allowed ...string) (pred string, ok bool) {
 	values, negated := q.StringValues(field)
 	for _, v := range append(values, negated...) {
+		name, _, isPredicate := query.ScanPredicateName([]byte(v), query.DefaultPredicateRegistry[field])
+		if isPredicate && slices.Contains(allowed, name) {
+			continue
+		}
 		pred, _, ok = query.ScanPredicate(field, []byte(v), query.DefaultPredicateRegistry)
 		if ok {
 			break
@@ -127,9 +148,9 @@ func hasPredicates(field string, q query.Q) (pred string, ok bool) {
 func (e Exhaustive) Job(repoRevs *search.RepositoryRevisions) job.Job {
 	// TODO should we add in a timeout and limit here?
 	// TODO should we support indexed search and run through zoekt.PartitionRepos?
-	return e.repoPagerJob.child.Resolve(resolvedRepos{
+	return newFileMetadataFilterJob(e.basic, e.repoPagerJob.child.Resolve(resolvedRepos{
 		unindexed: []*search.RepositoryRevisions{repoRevs},
-	})
+	}))
 }
 
 // RepositoryRevSpecs is a wrapper around repos.Resolver.IterateRepoRevs.
@@ -357,6 +357,31 @@ func sPrintSexpMax(j job.Describer) string {
 	return "\n" + printer.SexpVerbose(j, job.VerbosityMax, true) + "\n"
 }
 
+func TestNewExhaustive_fileMetadataPredicates(t *testing.T) {
+	plan, err := query.Pipeline(query.Init(`index:no foo file:has.commit.after(1 month ago) -file:has.size(>500)`, query.SearchTypeStandard))
+	require.NoError(t, err)
+
+	inputs := &search.Inputs{
+		Plan:         plan,
+		Query:        plan.ToQ(),
+		UserSettings: &schema.Settings{},
+		PatternType:  query.SearchTypeStandard,
+		Protocol:     search.Exhaustive,
+		Features:     &search.Features{},
+	}
+
+	exhaustive, err := NewExhaustive(inputs)
+	require.NoError(t, err)
+
+	j := exhaustive.Job(&search.RepositoryRevisions{
+		Repo: types.MinimalRepo{ID: 1, Name: "repo"},
+		Revs: []string{"main"},
+	})
+	sexp := sPrintSexpMax(j)
+	require.Contains(t, sexp, "FILEHASSIZEFILTER")
+	require.Contains(t, sexp, "FILEHASCOMMITAFTERFILTER")
+}
+
 // The queries are validated before they reach exhaustive search, hence we only
 // have to worry about valid queries we don't want to process for now.
 func TestNewExhaustive_negative(t *testing.T) {
@@ -374,6 +399,8 @@ func TestNewExhaustive_negative(t *testing.T) {
 		{query: `type:file index:no file:has.owner(owner)`},
 		{query: `type:file index:no file:contains.content(content)`},
 		{query: `type:file index:no file:has.contributor(contributor)`},
+		{query: `type:diff index:no foo file:has.size(>10)`},
+		{query: `type:commit index:no foo file:has.commit.after(1 month ago)`},
 		// unsupported types
 		{query: `index:no type:repo`},
 		{query: `index:no type:symbol`},
@@ -0,0 +1,176 @@
+package jobutil
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// NewFileHasCommitAfterJob creates a filter job to post-filter results for the
+// file:has.commit.after() predicate.
+//
+// include and exclude are time references as accepted by git, for example "1
+// month ago". They are resolved when the job runs. A file passes the filter if
+// it was modified after every include time and was not modified after any
+// exclude time.
+func NewFileHasCommitAfterJob(child job.Job, include, exclude []string) job.Job {
+	return &fileHasCommitAfterJob{
+		child:   child,
+		include: include,
+		exclude: exclude,
+	}
+}
+
+type fileHasCommitAfterJob struct {
+	child job.Job
+
+	include []string
+	exclude []string
+}
+
+func (j *fileHasCommitAfterJob) Run(ctx context.Context, clients job.RuntimeClients, stream streaming.Sender) (alert *search.Alert, err error) {
+	_, ctx, stream, finish := job.StartSpan(ctx, stream, j)
+	defer finish(alert, err)
+
+	// All include times have to hold, so only the latest one matters. All
+	// exclude times have to hold as well, so only the earliest one matters.
+	now := time.Now
+	var after, notAfter time.Time
+	for _, ref := range j.include {
+		t, err := gitdomain.ParseGitDate(ref, now)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid time ref %q", ref)
+		}
+		if t.After(after) {
+			after = t
+		}
+	}
+	for _, ref := range j.exclude {
+		t, err := gitdomain.ParseGitDate(ref, now)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid time ref %q", ref)
+		}
+		if notAfter.IsZero() || t.Before(notAfter) {
+			notAfter = t
+		}
+	}
+
+	var (
+		mu   sync.Mutex
+		errs error
+	)
+
+	filteredStream := streaming.StreamFunc(func(event streaming.SearchEvent) {
+		filtered := event.Results[:0]
+		for _, res := range event.Results {
+			// Filter out any result that is not a file
+			fm, ok := res.(*result.FileMatch)
+			if !ok {
+				continue
+			}
+
+			// We send up to two commit log requests per file path. We
+			// should quit early on context deadline exceeded.
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				mu.Lock()
+				errs = errors.Append(errs, ctx.Err())
+				mu.Unlock()
+				break
+			}
+
+			keep, err := j.matches(ctx, clients.Gitserver, fm, after, notAfter)
+			if err != nil {
+				mu.Lock()
+				errs = errors.Append(errs, err)
+				mu.Unlock()
+				continue
+			}
+			if keep {
+				filtered = append(filtered, fm)
+			}
+		}
+
+		event.Results = filtered
+
+		stream.Send(event)
+	})
+
+	alert, err = j.child.Run(ctx, clients, filteredStream)
+	if err != nil {
+		errs = errors.Append(errs, err)
+	}
+	return alert, errs
+}
+
+func (j *fileHasCommitAfterJob) matches(ctx context.Context, client gitserver.Client, fm *result.FileMatch, after, notAfter time.Time) (bool, error) {
+	if !after.IsZero() {
+		ok, err := fileHasCommitAfter(ctx, client, fm, after)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	if !notAfter.IsZero() {
+		ok, err := fileHasCommitAfter(ctx, client, fm, notAfter)
+		if err != nil || ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func fileHasCommitAfter(ctx context.Context, client gitserver.Client, fm *result.FileMatch, after time.Time) (bool, error) {
+	// N: 1 has a special meaning for gitserver, so we ask for two commits
+	// even though we only need one. See hasCommitAfter in the repos package.
+	commits, err := client.Commits(ctx, fm.Repo.Name, gitserver.CommitsOptions{
+		N:      2,
+		After:  after,
+		Ranges: []string{string(fm.CommitID)},
+		Path:   fm.Path,
+	})
+	if err != nil {
+		if errors.HasType[*gitdomain.RevisionNotFoundError](err) || gitdomain.IsRepoNotExist(err) {
+			// The revision is gone, so it certainly has no commits after
+			// some time.
+			return false, nil
+		}
+		return false, err
+	}
+	return len(commits) > 0, nil
+}
+
+func (j *fileHasCommitAfterJob) MapChildren(fn job.MapFunc) job.Job {
+	cp := *j
+	cp.child = job.Map(j.child, fn)
+	return &cp
+}
+
+func (j *fileHasCommitAfterJob) Name() string {
+	return "FileHasCommitAfterFilterJob"
+}
+
+func (j *fileHasCommitAfterJob) Children() []job.Describer {
+	return []job.Describer{j.child}
+}
+
+func (j *fileHasCommitAfterJob) Attributes(v job.Verbosity) (res []attribute.KeyValue) {
+	switch v {
+	case job.VerbosityMax:
+		fallthrough
+	case job.VerbosityBasic:
+		res = append(res,
+			attribute.StringSlice("includeCommitAfter", j.include),
+			attribute.StringSlice("excludeCommitAfter", j.exclude),
+		)
+	}
+	return res
+}
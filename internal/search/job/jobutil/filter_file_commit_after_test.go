@@ -0,0 +1,93 @@
+package jobutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/mockjob"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+)
+
+func TestFileHasCommitAfterJob(t *testing.T) {
+	fm := func(path string) *result.FileMatch {
+		return &result.FileMatch{
+			File: result.File{
+				Path:     path,
+				CommitID: "commitID",
+			},
+		}
+	}
+
+	// lastModified is when each file was last modified.
+	now := time.Now()
+	lastModified := map[string]time.Time{
+		"new":     now.Add(-time.Hour),
+		"month":   now.AddDate(0, 0, -20),
+		"ancient": now.AddDate(-2, 0, 0),
+	}
+
+	tests := []struct {
+		name    string
+		include []string
+		exclude []string
+		want    []string
+	}{{
+		name:    "include",
+		include: []string{"1 month ago"},
+		want:    []string{"new", "month"},
+	}, {
+		name:    "all includes must match",
+		include: []string{"1 month ago", "1 day ago"},
+		want:    []string{"new"},
+	}, {
+		name:    "exclude",
+		exclude: []string{"1 year ago"},
+		want:    []string{"ancient"},
+	}, {
+		name:    "include and exclude",
+		include: []string{"1 month ago"},
+		exclude: []string{"1 day ago"},
+		want:    []string{"month"},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			childJob := mockjob.NewMockJob()
+			childJob.RunFunc.SetDefaultHook(func(_ context.Context, _ job.RuntimeClients, s streaming.Sender) (*search.Alert, error) {
+				s.Send(streaming.SearchEvent{Results: result.Matches{fm("new"), fm("month"), fm("ancient"), &result.CommitMatch{}}})
+				return nil, nil
+			})
+
+			gitServerClient := gitserver.NewMockClient()
+			gitServerClient.CommitsFunc.SetDefaultHook(func(_ context.Context, _ api.RepoName, opts gitserver.CommitsOptions) ([]*gitdomain.Commit, error) {
+				require.Equal(t, []string{"commitID"}, opts.Ranges)
+				if lastModified[opts.Path].After(opts.After) {
+					return []*gitdomain.Commit{{ID: "c"}}, nil
+				}
+				return nil, nil
+			})
+
+			var got []string
+			streamCollector := streaming.StreamFunc(func(ev streaming.SearchEvent) {
+				for _, m := range ev.Results {
+					got = append(got, m.(*result.FileMatch).Path)
+				}
+			})
+
+			j := NewFileHasCommitAfterJob(childJob, tc.include, tc.exclude)
+			alert, err := j.Run(context.Background(), job.RuntimeClients{Gitserver: gitServerClient}, streamCollector)
+			require.Nil(t, alert)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package jobutil
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// NewFileHasSizeJob creates a filter job to post-filter results for the
+// file:has.size() predicate. A file passes the filter if its number of lines
+// satisfies all predicates.
+func NewFileHasSizeJob(child job.Job, sizes []query.FileHasSizePredicate) job.Job {
+	return &fileHasSizeJob{
+		child: child,
+		sizes: sizes,
+	}
+}
+
+type fileHasSizeJob struct {
+	child job.Job
+
+	sizes []query.FileHasSizePredicate
+}
+
+func (j *fileHasSizeJob) Run(ctx context.Context, clients job.RuntimeClients, stream streaming.Sender) (alert *search.Alert, err error) {
+	_, ctx, stream, finish := job.StartSpan(ctx, stream, j)
+	defer finish(alert, err)
+
+	var (
+		mu   sync.Mutex
+		errs error
+	)
+
+	filteredStream := streaming.StreamFunc(func(event streaming.SearchEvent) {
+		filtered := event.Results[:0]
+		for _, res := range event.Results {
+			// Filter out any result that is not a file
+			fm, ok := res.(*result.FileMatch)
+			if !ok {
+				continue
+			}
+
+			// We read every file. We should quit early on context deadline
+			// exceeded.
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				mu.Lock()
+				errs = errors.Append(errs, ctx.Err())
+				mu.Unlock()
+				break
+			}
+
+			lines, err := countFileLines(ctx, clients.Gitserver, fm)
+			if err != nil {
+				// The file or revision may be gone by the time we read it.
+				if os.IsNotExist(err) || errors.HasType[*gitdomain.RevisionNotFoundError](err) {
+					continue
+				}
+				mu.Lock()
+				errs = errors.Append(errs, err)
+				mu.Unlock()
+				continue
+			}
+
+			if j.Matches(lines) {
+				filtered = append(filtered, fm)
+			}
+		}
+
+		event.Results = filtered
+
+		stream.Send(event)
+	})
+
+	alert, err = j.child.Run(ctx, clients, filteredStream)
+	if err != nil {
+		errs = errors.Append(errs, err)
+	}
+	return alert, errs
+}
+
+// Matches returns true if a file with the given number of lines passes all
+// size predicates.
+func (j *fileHasSizeJob) Matches(lines int) bool {
+	for _, size := range j.sizes {
+		if size.Matches(lines) == size.Negated {
+			return false
+		}
+	}
+	return true
+}
+
+// countFileLines returns the number of lines of the file. A last line without
+// a trailing newline is counted as well.
+func countFileLines(ctx context.Context, client gitserver.Client, fm *result.FileMatch) (int, error) {
+	r, err := client.NewFileReader(ctx, fm.Repo.Name, fm.CommitID, fm.Path)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+
+	var (
+		lines int
+		last  byte
+		empty = true
+		buf   = make([]byte, 32*1024)
+	)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			lines += bytes.Count(buf[:n], []byte{'\n'})
+			last = buf[n-1]
+			empty = false
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	if !empty && last != '\n' {
+		lines++
+	}
+	return lines, nil
+}
+
+func (j *fileHasSizeJob) MapChildren(fn job.MapFunc) job.Job {
+	cp := *j
+	cp.child = job.Map(j.child, fn)
+	return &cp
+}
+
+func (j *fileHasSizeJob) Name() string {
+	return "FileHasSizeFilterJob"
+}
+
+func (j *fileHasSizeJob) Children() []job.Describer {
+	return []job.Describer{j.child}
+}
+
+func (j *fileHasSizeJob) Attributes(v job.Verbosity) (res []attribute.KeyValue) {
+	switch v {
+	case job.VerbosityMax:
+		fallthrough
+	case job.VerbosityBasic:
+		sizes := make([]string, 0, len(j.sizes))
+		for _, size := range j.sizes {
+			s := size.Comparator + strconv.Itoa(size.Lines)
+			if size.Negated {
+				s = "-" + s
+			}
+			sizes = append(sizes, s)
+		}
+		res = append(res, attribute.StringSlice("sizes", sizes))
+	}
+	return res
+}
@@ -0,0 +1,99 @@
+package jobutil
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/mockjob"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+)
+
+func TestFileHasSizeJob(t *testing.T) {
+	fm := func(path string) *result.FileMatch {
+		return &result.FileMatch{
+			File: result.File{
+				Path:     path,
+				CommitID: "commitID",
+			},
+		}
+	}
+
+	files := map[string]string{
+		"empty":   "",
+		"one":     "a",
+		"two":     "a\nb\n",
+		"three":   "a\nb\nc",
+		"hundred": strings.Repeat("line\n", 100),
+	}
+
+	size := func(comparator string, lines int, negated bool) query.FileHasSizePredicate {
+		return query.FileHasSizePredicate{Comparator: comparator, Lines: lines, Negated: negated}
+	}
+
+	tests := []struct {
+		name  string
+		sizes []query.FileHasSizePredicate
+		want  []string
+	}{{
+		name:  "greater than",
+		sizes: []query.FileHasSizePredicate{size(">", 2, false)},
+		want:  []string{"three", "hundred"},
+	}, {
+		name:  "exactly",
+		sizes: []query.FileHasSizePredicate{size("=", 0, false)},
+		want:  []string{"empty"},
+	}, {
+		name:  "range",
+		sizes: []query.FileHasSizePredicate{size(">=", 1, false), size("<=", 3, false)},
+		want:  []string{"one", "two", "three"},
+	}, {
+		name:  "negated",
+		sizes: []query.FileHasSizePredicate{size("<", 100, true)},
+		want:  []string{"hundred"},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			childJob := mockjob.NewMockJob()
+			childJob.RunFunc.SetDefaultHook(func(_ context.Context, _ job.RuntimeClients, s streaming.Sender) (*search.Alert, error) {
+				s.Send(streaming.SearchEvent{Results: result.Matches{
+					fm("empty"), fm("one"), fm("two"), fm("three"), fm("hundred"), fm("deleted"), &result.CommitMatch{},
+				}})
+				return nil, nil
+			})
+
+			gitServerClient := gitserver.NewMockClient()
+			gitServerClient.NewFileReaderFunc.SetDefaultHook(func(_ context.Context, _ api.RepoName, _ api.CommitID, name string) (io.ReadCloser, error) {
+				content, ok := files[name]
+				if !ok {
+					return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+				}
+				return io.NopCloser(strings.NewReader(content)), nil
+			})
+
+			var got []string
+			streamCollector := streaming.StreamFunc(func(ev streaming.SearchEvent) {
+				for _, m := range ev.Results {
+					got = append(got, m.(*result.FileMatch).Path)
+				}
+			})
+
+			j := NewFileHasSizeJob(childJob, tc.sizes)
+			alert, err := j.Run(context.Background(), job.RuntimeClients{Gitserver: gitServerClient}, streamCollector)
+			require.Nil(t, alert)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -225,6 +225,10 @@ func NewBasicJob(inputs *search.Inputs, b query.Basic) (job.Job, error) {
 		}
 	}
 
+	{ // Apply file:has.commit.after() and file:has.size() post-search filters
+		basicJob = newFileMetadataFilterJob(b, basicJob)
+	}
+
 	{ // Apply subrepo permissions checks
 		checker := authz.DefaultSubRepoPermsChecker
 		if authz.SubRepoEnabled(checker) {
@@ -566,6 +570,10 @@ func computeFileMatchLimit(b query.Basic, defaultLimit int) int {
 		// This is the int equivalent of count:all.
 		return query.CountAllLimit
 	}
+	if isFileMetadataSearch(b) {
+		// This is the int equivalent of count:all.
+		return query.CountAllLimit
+	}
 	if v, _ := b.ToParseTree().StringValue(query.FieldSelect); v != "" {
 		sp, _ := filter.SelectPathFromString(v) // Invariant: select already validated
 		if isSelectOwnersSearch(sp) {
@@ -596,6 +604,23 @@ func isContributorSearch(b query.Basic) (include, exclude []string, ok bool) {
 	return nil, nil, false
 }
 
+func isFileMetadataSearch(b query.Basic) bool {
+	include, exclude := b.FileHasCommitAfter()
+	return len(include) > 0 || len(exclude) > 0 || len(b.FileHasSize()) > 0
+}
+
+// newFileMetadataFilterJob wraps child with the post-search filters for the
+// file:has.commit.after() and file:has.size() predicates of b, if any.
+func newFileMetadataFilterJob(b query.Basic, child job.Job) job.Job {
+	if include, exclude := b.FileHasCommitAfter(); len(include) > 0 || len(exclude) > 0 {
+		child = NewFileHasCommitAfterJob(child, include, exclude)
+	}
+	if sizes := b.FileHasSize(); len(sizes) > 0 {
+		child = NewFileHasSizeJob(child, sizes)
+	}
+	return child
+}
+
 func contributorsAsRegexp(contributors []string, isCaseSensitive bool) (res []*regexp.Regexp) {
 	for _, pattern := range contributors {
 		if isCaseSensitive {
@@ -2,6 +2,7 @@ package query
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -53,6 +54,8 @@ var DefaultPredicateRegistry = PredicateRegistry{
 		"has.content":      func() Predicate { return &FileContainsContentPredicate{} },
 		"has.owner":        func() Predicate { return &FileHasOwnerPredicate{} },
 		"has.contributor":  func() Predicate { return &FileHasContributorPredicate{} },
+		"has.commit.after": func() Predicate { return &FileHasCommitAfterPredicate{} },
+		"has.size":         func() Predicate { return &FileHasSizePredicate{} },
 	},
 	FieldRev: {
 		"at.time": func() Predicate { return &RevAtTimePredicate{} },
@@ -126,6 +129,11 @@ type RepoContainsFilePredicate struct {
 	Path    string
 	Content string
 	Negated bool
+
+	// Clauses is set instead of Path and Content when the parameters form a
+	// boolean expression, for example `path:a or path:b` or `path:a -path:b`.
+	// See parseFileContentClauses for its interpretation.
+	Clauses [][]RepoHasFileContentArgs
 }
 
 func (f *RepoContainsFilePredicate) Unmarshal(params string, negated bool) error {
@@ -134,7 +142,13 @@ func (f *RepoContainsFilePredicate) Unmarshal(params string, negated bool) error
 		return err
 	}
 
-	if err := f.parseNodes(nodes); err != nil {
+	clauses, err := parseFileContentClauses(nodes, "path", negated, func(field, value string) error {
+		if _, err := syntax.Parse(value, syntax.Perl); err != nil {
+			return errors.Errorf("`contains.file` predicate has invalid `%s` argument: %w", field, err)
+		}
+		return nil
+	})
+	if err != nil {
 		// If there's a parsing error, try falling back to the deprecated syntax `repo:contains.file(name.go)`.
 		// Only attempt to fall back if there is a single pattern node, to avoid being too lenient.
 		if len(nodes) != 1 {
@@ -149,71 +163,180 @@ func (f *RepoContainsFilePredicate) Unmarshal(params string, negated bool) error
 		if _, err := syntax.Parse(pattern.Value, syntax.Perl); err != nil {
 			return err
 		}
-		f.Path = pattern.Value
+		clauses = [][]RepoHasFileContentArgs{{{Path: pattern.Value, Negated: negated}}}
 	}
 
-	if f.Path == "" && f.Content == "" {
-		return errors.New("one of path or content must be set")
+	if leaf, ok := singleFileContentLeaf(clauses, negated); ok {
+		f.Path, f.Content = leaf.Path, leaf.Content
+	} else {
+		f.Clauses = clauses
 	}
-
 	f.Negated = negated
 	return nil
 }
 
-func (f *RepoContainsFilePredicate) parseNodes(nodes []Node) error {
-	for _, node := range nodes {
-		if err := f.parseNode(node); err != nil {
-			return err
-		}
+func (f *RepoContainsFilePredicate) Field() string { return FieldRepo }
+func (f *RepoContainsFilePredicate) Name() string  { return "contains.file" }
+
+// maxFileContentClauses bounds the size of the conjunctive normal form of a
+// repo:contains.file() or repo:contains() expression. Each clause costs a
+// search per repository, and distributing ORs over ANDs can grow
+// exponentially.
+const maxFileContentClauses = 64
+
+// parseFileContentClauses converts the parameters of a repo:contains.file() or
+// repo:contains() predicate into conjunctive normal form: a repository matches
+// if, for every clause, it matches at least one of the clause's alternatives.
+//
+// Within a group of AND'ed parameters, a single non-negated path and a single
+// non-negated content parameter refer to the same file, so
+// `path:a content:b` means "a file matching a that contains b". Every other
+// parameter is an independent condition on the repository, so
+// `path:a -path:b` means "contains a file matching a and no file matching b".
+// If negated is true, the predicate negation is pushed down into the
+// alternatives.
+func parseFileContentClauses(nodes []Node, pathField string, negated bool, validate func(field, value string) error) ([][]RepoHasFileContentArgs, error) {
+	expr, err := parseFileContentExpr(nodes, And, pathField, validate)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	if negated {
+		expr = expr.negate()
+	}
+	return expr.clauses()
 }
 
-func (f *RepoContainsFilePredicate) parseNode(n Node) error {
-	switch v := n.(type) {
-	case Parameter:
-		if v.Negated {
-			return errors.New("predicates do not currently support negated values")
-		}
-		switch strings.ToLower(v.Field) {
-		case "path":
-			if f.Path != "" {
-				return errors.New("cannot specify path multiple times")
+// singleFileContentLeaf returns the only alternative of clauses if the
+// predicate is a plain path and/or content check that can be represented
+// without clauses.
+func singleFileContentLeaf(clauses [][]RepoHasFileContentArgs, negated bool) (RepoHasFileContentArgs, bool) {
+	if len(clauses) != 1 || len(clauses[0]) != 1 {
+		return RepoHasFileContentArgs{}, false
+	}
+	leaf := clauses[0][0]
+	// The predicate negation has already been applied to the leaf.
+	return leaf, leaf.Negated == negated
+}
+
+// fileContentExpr is a boolean expression over path and content checks. It is
+// either a leaf or an operator with operands.
+type fileContentExpr struct {
+	leaf     *RepoHasFileContentArgs
+	kind     OperatorKind
+	operands []fileContentExpr
+}
+
+func parseFileContentExpr(nodes []Node, kind OperatorKind, pathField string, validate func(field, value string) error) (fileContentExpr, error) {
+	expr := fileContentExpr{kind: kind}
+
+	// Indexes of the path and content leaves in expr.operands.
+	var path, content []int
+	for _, node := range nodes {
+		switch v := node.(type) {
+		case Parameter:
+			field := strings.ToLower(v.Field)
+			if field != pathField && field != "content" {
+				return expr, errors.Errorf("unsupported option %q", v.Field)
 			}
-			if _, err := syntax.Parse(v.Value, syntax.Perl); err != nil {
-				return errors.Errorf("`contains.file` predicate has invalid `path` argument: %w", err)
+			if err := validate(field, v.Value); err != nil {
+				return expr, err
 			}
-			f.Path = v.Value
-		case "content":
-			if f.Content != "" {
-				return errors.New("cannot specify content multiple times")
+			leaf := &RepoHasFileContentArgs{Negated: v.Negated}
+			if field == pathField {
+				leaf.Path = v.Value
+				path = append(path, len(expr.operands))
+			} else {
+				leaf.Content = v.Value
+				content = append(content, len(expr.operands))
 			}
-			if _, err := syntax.Parse(v.Value, syntax.Perl); err != nil {
-				return errors.Errorf("`contains.file` predicate has invalid `content` argument: %w", err)
+			expr.operands = append(expr.operands, fileContentExpr{leaf: leaf})
+		case Pattern:
+			return expr, errors.Errorf(`prepend '%s:' or 'content:' to "%s" to search repositories containing files or content respectively.`, pathField, v.Value)
+		case Operator:
+			operand, err := parseFileContentExpr(v.Operands, v.Kind, pathField, validate)
+			if err != nil {
+				return expr, err
 			}
-			f.Content = v.Value
+			expr.operands = append(expr.operands, operand)
 		default:
-			return errors.Errorf("unsupported option %q", v.Field)
+			return expr, errors.Errorf("unsupported node type %T", node)
 		}
-	case Pattern:
-		return errors.Errorf(`prepend 'file:' or 'content:' to "%s" to search repositories containing files or content respectively.`, v.Value)
-	case Operator:
-		if v.Kind == Or {
-			return errors.New("predicates do not currently support 'or' queries")
+	}
+
+	if kind == And && len(path) == 1 && len(content) == 1 {
+		p, c := expr.operands[path[0]].leaf, expr.operands[content[0]].leaf
+		if !p.Negated && !c.Negated {
+			p.Content = c.Content
+			expr.operands = append(expr.operands[:content[0]], expr.operands[content[0]+1:]...)
 		}
-		for _, operand := range v.Operands {
-			if err := f.parseNode(operand); err != nil {
-				return err
+	}
+
+	if len(expr.operands) == 0 {
+		return expr, errors.Errorf("one of %s or content must be set", pathField)
+	}
+	return expr, nil
+}
+
+func (e fileContentExpr) negate() fileContentExpr {
+	if e.leaf != nil {
+		leaf := *e.leaf
+		leaf.Negated = !leaf.Negated
+		return fileContentExpr{leaf: &leaf}
+	}
+
+	negated := fileContentExpr{kind: And}
+	if e.kind == And {
+		negated.kind = Or
+	}
+	for _, operand := range e.operands {
+		negated.operands = append(negated.operands, operand.negate())
+	}
+	return negated
+}
+
+func (e fileContentExpr) clauses() ([][]RepoHasFileContentArgs, error) {
+	if e.leaf != nil {
+		return [][]RepoHasFileContentArgs{{*e.leaf}}, nil
+	}
+
+	if e.kind == And {
+		var res [][]RepoHasFileContentArgs
+		for _, operand := range e.operands {
+			clauses, err := operand.clauses()
+			if err != nil {
+				return nil, err
 			}
+			res = append(res, clauses...)
 		}
-	default:
-		return errors.Errorf("unsupported node type %T", n)
+		if len(res) > maxFileContentClauses {
+			return nil, errors.New("repository file predicate expression is too complex")
+		}
+		return res, nil
 	}
-	return nil
-}
 
-func (f *RepoContainsFilePredicate) Field() string { return FieldRepo }
-func (f *RepoContainsFilePredicate) Name() string  { return "contains.file" }
+	// Distribute the OR over the clauses of its operands.
+	res := [][]RepoHasFileContentArgs{nil}
+	for _, operand := range e.operands {
+		clauses, err := operand.clauses()
+		if err != nil {
+			return nil, err
+		}
+		if len(res)*len(clauses) > maxFileContentClauses {
+			return nil, errors.New("repository file predicate expression is too complex")
+		}
+		next := make([][]RepoHasFileContentArgs, 0, len(res)*len(clauses))
+		for _, prefix := range res {
+			for _, clause := range clauses {
+				merged := make([]RepoHasFileContentArgs, 0, len(prefix)+len(clause))
+				merged = append(merged, prefix...)
+				merged = append(merged, clause...)
+				next = append(next, merged)
+			}
+		}
+		res = next
+	}
+	return res, nil
+}
 
 /* repo:contains.content(pattern) */
 
@@ -514,6 +637,10 @@ type RepoContainsPredicate struct {
 	File    string
 	Content string
 	Negated bool
+
+	// Clauses is set instead of File and Content when the parameters form a
+	// boolean expression. See RepoContainsFilePredicate.Clauses.
+	Clauses [][]RepoHasFileContentArgs
 }
 
 func (f *RepoContainsPredicate) Unmarshal(params string, negated bool) error {
@@ -521,62 +648,26 @@ func (f *RepoContainsPredicate) Unmarshal(params string, negated bool) error {
 	if err != nil {
 		return err
 	}
-	for _, node := range nodes {
-		if err := f.parseNode(node); err != nil {
-			return err
+
+	clauses, err := parseFileContentClauses(nodes, "file", negated, func(field, value string) error {
+		if _, err := regexp.Compile(value); err != nil {
+			return errors.Errorf("the repo:contains() predicate has invalid `%s` argument: %w", field, err)
 		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
-	if f.File == "" && f.Content == "" {
-		return errors.New("one of file or content must be set")
+	if leaf, ok := singleFileContentLeaf(clauses, negated); ok {
+		f.File, f.Content = leaf.Path, leaf.Content
+	} else {
+		f.Clauses = clauses
 	}
 	f.Negated = negated
 	return nil
 }
 
-func (f *RepoContainsPredicate) parseNode(n Node) error {
-	switch v := n.(type) {
-	case Parameter:
-		if v.Negated {
-			return errors.New("the repo:contains() predicate does not currently support negated values")
-		}
-		switch strings.ToLower(v.Field) {
-		case "file":
-			if f.File != "" {
-				return errors.New("cannot specify file multiple times")
-			}
-			if _, err := regexp.Compile(v.Value); err != nil {
-				return errors.Errorf("the repo:contains() predicate has invalid `file` argument: %w", err)
-			}
-			f.File = v.Value
-		case "content":
-			if f.Content != "" {
-				return errors.New("cannot specify content multiple times")
-			}
-			if _, err := regexp.Compile(v.Value); err != nil {
-				return errors.Errorf("the repo:contains() predicate has invalid `content` argument: %w", err)
-			}
-			f.Content = v.Value
-		default:
-			return errors.Errorf("unsupported option %q", v.Field)
-		}
-	case Pattern:
-		return errors.Errorf(`prepend 'file:' or 'content:' to "%s" to search repositories containing files or content respectively.`, v.Value)
-	case Operator:
-		if v.Kind == Or {
-			return errors.New("predicates do not currently support 'or' queries")
-		}
-		for _, operand := range v.Operands {
-			if err := f.parseNode(operand); err != nil {
-				return err
-			}
-		}
-	default:
-		return errors.Errorf("unsupported node type %T", n)
-	}
-	return nil
-}
-
 func (f *RepoContainsPredicate) Field() string { return FieldRepo }
 func (f *RepoContainsPredicate) Name() string  { return "contains" }
 
@@ -640,6 +731,84 @@ func (f *FileHasContributorPredicate) Unmarshal(params string, negated bool) err
 func (f FileHasContributorPredicate) Field() string { return FieldFile }
 func (f FileHasContributorPredicate) Name() string  { return "has.contributor" }
 
+/* file:has.commit.after(time) */
+
+type FileHasCommitAfterPredicate struct {
+	TimeRef string
+	Negated bool
+}
+
+func (f *FileHasCommitAfterPredicate) Unmarshal(params string, negated bool) error {
+	params = strings.TrimSpace(params)
+	if params == "" {
+		return errors.New("the file:has.commit.after() predicate requires a date, for example file:has.commit.after(1 month ago)")
+	}
+	if _, err := gitdomain.ParseGitDate(params, time.Now); err != nil {
+		return errors.Errorf("the file:has.commit.after() predicate has invalid argument: %w", err)
+	}
+
+	f.TimeRef = params
+	f.Negated = negated
+	return nil
+}
+
+func (f FileHasCommitAfterPredicate) Field() string { return FieldFile }
+func (f FileHasCommitAfterPredicate) Name() string  { return "has.commit.after" }
+
+/* file:has.size(>500) */
+
+var fileSizeRegexp = regexp.MustCompile(`^(<=|>=|<|>|=)?\s*([0-9]+)$`)
+
+// FileHasSizePredicate matches files by their number of lines. The argument
+// is a number of lines optionally prefixed by a comparison operator, for
+// example >500. A number without an operator matches files with exactly that
+// many lines.
+type FileHasSizePredicate struct {
+	Comparator string
+	Lines      int
+	Negated    bool
+}
+
+func (f *FileHasSizePredicate) Unmarshal(params string, negated bool) error {
+	match := fileSizeRegexp.FindStringSubmatch(strings.TrimSpace(params))
+	if match == nil {
+		return errors.Errorf("the file:has.size() predicate expects a number of lines optionally prefixed by <, <=, >, >= or =, got %q", params)
+	}
+
+	lines, err := strconv.Atoi(match[2])
+	if err != nil {
+		return errors.Errorf("the file:has.size() predicate has invalid argument: %w", err)
+	}
+
+	f.Comparator = match[1]
+	if f.Comparator == "" {
+		f.Comparator = "="
+	}
+	f.Lines = lines
+	f.Negated = negated
+	return nil
+}
+
+// Matches returns true if a file with the given number of lines satisfies the
+// comparison. It does not take negation into account.
+func (f FileHasSizePredicate) Matches(lines int) bool {
+	switch f.Comparator {
+	case "<":
+		return lines < f.Lines
+	case "<=":
+		return lines <= f.Lines
+	case ">":
+		return lines > f.Lines
+	case ">=":
+		return lines >= f.Lines
+	default:
+		return lines == f.Lines
+	}
+}
+
+func (f FileHasSizePredicate) Field() string { return FieldFile }
+func (f FileHasSizePredicate) Name() string  { return "has.size" }
+
 type RevAtTimePredicate struct {
 	RevAtTime
 }
@@ -1,7 +1,9 @@
 package query
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -27,6 +29,22 @@ func TestRepoContainsFilePredicate(t *testing.T) {
 			{`content and path`, `content:abc path:test.go`, &RepoContainsFilePredicate{Path: "test.go", Content: "abc"}},
 			{`unnamed path`, `test.go`, &RepoContainsFilePredicate{Path: "test.go"}},
 			{`unnamed path regex`, `test(a|b)*.go`, &RepoContainsFilePredicate{Path: "test(a|b)*.go"}},
+			{`negated path`, `-path:test`, &RepoContainsFilePredicate{Clauses: [][]RepoHasFileContentArgs{{{Path: "test", Negated: true}}}}},
+			{`negated content`, `-content:test`, &RepoContainsFilePredicate{Clauses: [][]RepoHasFileContentArgs{{{Content: "test", Negated: true}}}}},
+			{`path and negated path`, `path:go.mod -path:.golangci.yml`, &RepoContainsFilePredicate{Clauses: [][]RepoHasFileContentArgs{
+				{{Path: "go.mod"}},
+				{{Path: ".golangci.yml", Negated: true}},
+			}}},
+			{`or paths`, `path:package.json or path:pnpm-lock.yaml`, &RepoContainsFilePredicate{Clauses: [][]RepoHasFileContentArgs{
+				{{Path: "package.json"}, {Path: "pnpm-lock.yaml"}},
+			}}},
+			{`or path and content`, `(path:a.go content:x) or path:b.go`, &RepoContainsFilePredicate{Clauses: [][]RepoHasFileContentArgs{
+				{{Path: "a.go", Content: "x"}, {Path: "b.go"}},
+			}}},
+			{`or distributes over and`, `path:a or (path:b -content:c)`, &RepoContainsFilePredicate{Clauses: [][]RepoHasFileContentArgs{
+				{{Path: "a"}, {Path: "b"}},
+				{{Path: "a"}, {Content: "c", Negated: true}},
+			}}},
 		}
 
 		for _, tc := range valid {
@@ -45,11 +63,11 @@ func TestRepoContainsFilePredicate(t *testing.T) {
 
 		invalid := []test{
 			{`empty`, ``, nil},
-			{`negated path`, `-path:test`, nil},
-			{`negated content`, `-content:test`, nil},
 			{`catch invalid content regexp`, `path:foo content:([)`, nil},
+			{`catch invalid regexp in or`, `path:foo or content:([)`, nil},
 			{`unsupported syntax`, `content1 content2`, nil},
 			{`invalid unnamed path`, `([)`, nil},
+			{`unsupported field in or`, `path:foo or repo:bar`, nil},
 		}
 
 		for _, tc := range invalid {
@@ -62,6 +80,57 @@ func TestRepoContainsFilePredicate(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("Unmarshal negated", func(t *testing.T) {
+		type test struct {
+			name     string
+			params   string
+			expected *RepoContainsFilePredicate
+		}
+
+		valid := []test{
+			{`path`, `path:test`, &RepoContainsFilePredicate{Path: "test", Negated: true}},
+			{`path and content`, `path:test.go content:abc`, &RepoContainsFilePredicate{Path: "test.go", Content: "abc", Negated: true}},
+			{`unnamed path`, `go.mod`, &RepoContainsFilePredicate{Path: "go.mod", Negated: true}},
+			{`negated path`, `-path:test`, &RepoContainsFilePredicate{
+				Clauses: [][]RepoHasFileContentArgs{{{Path: "test"}}},
+				Negated: true,
+			}},
+			{`or paths`, `path:a or path:b`, &RepoContainsFilePredicate{
+				Clauses: [][]RepoHasFileContentArgs{{{Path: "a", Negated: true}}, {{Path: "b", Negated: true}}},
+				Negated: true,
+			}},
+			{`and paths`, `path:a -path:b`, &RepoContainsFilePredicate{
+				Clauses: [][]RepoHasFileContentArgs{{{Path: "a", Negated: true}, {Path: "b"}}},
+				Negated: true,
+			}},
+		}
+
+		for _, tc := range valid {
+			t.Run(tc.name, func(t *testing.T) {
+				p := &RepoContainsFilePredicate{}
+				err := p.Unmarshal(tc.params, true)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !reflect.DeepEqual(tc.expected, p) {
+					t.Fatalf("expected %#v, got %#v", tc.expected, p)
+				}
+			})
+		}
+	})
+
+	t.Run("too complex", func(t *testing.T) {
+		// Each OR of ANDs doubles the number of clauses.
+		var params []string
+		for i := range 7 {
+			params = append(params, fmt.Sprintf("(path:a%d path:b%d)", i, i))
+		}
+		p := &RepoContainsFilePredicate{}
+		err := p.Unmarshal(strings.Join(params, " or "), false)
+		require.ErrorContains(t, err, "too complex")
+	})
 }
 
 func TestRevAtTimePredicate(t *testing.T) {
@@ -314,6 +383,10 @@ func TestRepoContainsPredicate(t *testing.T) {
 			{`content`, `content:test`, &RepoContainsPredicate{Content: "test"}},
 			{`path and content`, `file:test.go content:abc`, &RepoContainsPredicate{File: "test.go", Content: "abc"}},
 			{`content and path`, `content:abc file:test.go`, &RepoContainsPredicate{File: "test.go", Content: "abc"}},
+			{`negated path`, `-file:test`, &RepoContainsPredicate{Clauses: [][]RepoHasFileContentArgs{{{Path: "test", Negated: true}}}}},
+			{`or paths`, `file:package.json or file:pnpm-lock.yaml`, &RepoContainsPredicate{Clauses: [][]RepoHasFileContentArgs{
+				{{Path: "package.json"}, {Path: "pnpm-lock.yaml"}},
+			}}},
 		}
 
 		for _, tc := range valid {
@@ -332,9 +405,8 @@ func TestRepoContainsPredicate(t *testing.T) {
 
 		invalid := []test{
 			{`empty`, ``, nil},
-			{`negated path`, `-file:test`, nil},
-			{`negated content`, `-content:test`, nil},
 			{`catch invalid content regexp`, `file:foo content:([)`, nil},
+			{`path field`, `path:foo`, nil},
 		}
 
 		for _, tc := range invalid {
@@ -380,6 +452,101 @@ func TestFileHasOwnerPredicate(t *testing.T) {
 	})
 }
 
+func TestFileHasCommitAfterPredicate(t *testing.T) {
+	t.Run("Unmarshal", func(t *testing.T) {
+		valid := []struct {
+			params   string
+			expected *FileHasCommitAfterPredicate
+		}{
+			{`1 month ago`, &FileHasCommitAfterPredicate{TimeRef: "1 month ago"}},
+			{` 2024-01-01 `, &FileHasCommitAfterPredicate{TimeRef: "2024-01-01"}},
+		}
+
+		for _, tc := range valid {
+			t.Run(tc.params, func(t *testing.T) {
+				p := &FileHasCommitAfterPredicate{}
+				if err := p.Unmarshal(tc.params, false); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !reflect.DeepEqual(tc.expected, p) {
+					t.Fatalf("expected %#v, got %#v", tc.expected, p)
+				}
+			})
+		}
+
+		for _, params := range []string{``, `not a date`} {
+			t.Run(params, func(t *testing.T) {
+				p := &FileHasCommitAfterPredicate{}
+				if err := p.Unmarshal(params, false); err == nil {
+					t.Fatal("expected error but got none")
+				}
+			})
+		}
+	})
+}
+
+func TestFileHasSizePredicate(t *testing.T) {
+	t.Run("Unmarshal", func(t *testing.T) {
+		valid := []struct {
+			params   string
+			expected *FileHasSizePredicate
+		}{
+			{`>500`, &FileHasSizePredicate{Comparator: ">", Lines: 500}},
+			{`>= 10`, &FileHasSizePredicate{Comparator: ">=", Lines: 10}},
+			{`<100`, &FileHasSizePredicate{Comparator: "<", Lines: 100}},
+			{`<=0`, &FileHasSizePredicate{Comparator: "<=", Lines: 0}},
+			{`=42`, &FileHasSizePredicate{Comparator: "=", Lines: 42}},
+			{`42`, &FileHasSizePredicate{Comparator: "=", Lines: 42}},
+		}
+
+		for _, tc := range valid {
+			t.Run(tc.params, func(t *testing.T) {
+				p := &FileHasSizePredicate{}
+				if err := p.Unmarshal(tc.params, false); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !reflect.DeepEqual(tc.expected, p) {
+					t.Fatalf("expected %#v, got %#v", tc.expected, p)
+				}
+			})
+		}
+
+		for _, params := range []string{``, `>`, `>-1`, `=>5`, `5 lines`, `>>5`} {
+			t.Run(params, func(t *testing.T) {
+				p := &FileHasSizePredicate{}
+				if err := p.Unmarshal(params, false); err == nil {
+					t.Fatal("expected error but got none")
+				}
+			})
+		}
+	})
+
+	t.Run("Matches", func(t *testing.T) {
+		cases := []struct {
+			comparator string
+			lines      int
+			want       []bool // for 9, 10 and 11 lines
+		}{
+			{"<", 10, []bool{true, false, false}},
+			{"<=", 10, []bool{true, true, false}},
+			{">", 10, []bool{false, false, true}},
+			{">=", 10, []bool{false, true, true}},
+			{"=", 10, []bool{false, true, false}},
+		}
+
+		for _, tc := range cases {
+			p := FileHasSizePredicate{Comparator: tc.comparator, Lines: tc.lines}
+			for i, lines := range []int{9, 10, 11} {
+				if got := p.Matches(lines); got != tc.want[i] {
+					t.Errorf("%s%d: Matches(%d) = %v, want %v", tc.comparator, tc.lines, lines, got, tc.want[i])
+				}
+			}
+		}
+	})
+}
+
 func TestFileHasContributorPredicate(t *testing.T) {
 	t.Run("Unmarshal", func(t *testing.T) {
 		type test struct {
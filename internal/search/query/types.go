@@ -362,6 +362,11 @@ type RepoHasFileContentArgs struct {
 	Path    string // optional
 	Content string // optional
 	Negated bool
+
+	// Or holds alternatives for repo:contains.file() and repo:contains()
+	// expressions like `path:a or path:b`. If set, a repository matches if it
+	// matches any of the alternatives, and the other fields are empty.
+	Or []RepoHasFileContentArgs
 }
 
 func (p Parameters) RepoHasFileContent() (res []RepoHasFileContentArgs) {
@@ -388,6 +393,10 @@ func (p Parameters) RepoHasFileContent() (res []RepoHasFileContentArgs) {
 	})
 
 	VisitTypedPredicate(nodes, func(pred *RepoContainsFilePredicate) {
+		if pred.Clauses != nil {
+			res = append(res, fileContentClausesToArgs(pred.Clauses)...)
+			return
+		}
 		res = append(res, RepoHasFileContentArgs{
 			Path:    pred.Path,
 			Content: pred.Content,
@@ -396,6 +405,10 @@ func (p Parameters) RepoHasFileContent() (res []RepoHasFileContentArgs) {
 	})
 
 	VisitTypedPredicate(nodes, func(pred *RepoContainsPredicate) {
+		if pred.Clauses != nil {
+			res = append(res, fileContentClausesToArgs(pred.Clauses)...)
+			return
+		}
 		res = append(res, RepoHasFileContentArgs{
 			Path:    pred.File,
 			Content: pred.Content,
@@ -406,6 +419,18 @@ func (p Parameters) RepoHasFileContent() (res []RepoHasFileContentArgs) {
 	return res
 }
 
+func fileContentClausesToArgs(clauses [][]RepoHasFileContentArgs) []RepoHasFileContentArgs {
+	res := make([]RepoHasFileContentArgs, 0, len(clauses))
+	for _, clause := range clauses {
+		if len(clause) == 1 {
+			res = append(res, clause[0])
+		} else {
+			res = append(res, RepoHasFileContentArgs{Or: clause})
+		}
+	}
+	return res
+}
+
 func (p Parameters) FileContainsContent() (include []string) {
 	VisitTypedPredicate(toNodes(p), func(pred *FileContainsContentPredicate) {
 		include = append(include, pred.Pattern)
@@ -511,6 +536,24 @@ func (p Parameters) FileHasContributor() (include []string, exclude []string) {
 	return include, exclude
 }
 
+func (p Parameters) FileHasCommitAfter() (include []string, exclude []string) {
+	VisitTypedPredicate(toNodes(p), func(pred *FileHasCommitAfterPredicate) {
+		if pred.Negated {
+			exclude = append(exclude, pred.TimeRef)
+		} else {
+			include = append(include, pred.TimeRef)
+		}
+	})
+	return include, exclude
+}
+
+func (p Parameters) FileHasSize() (res []FileHasSizePredicate) {
+	VisitTypedPredicate(toNodes(p), func(pred *FileHasSizePredicate) {
+		res = append(res, *pred)
+	})
+	return res
+}
+
 // Exists returns whether a parameter exists in the query (whether negated or not).
 func (p Parameters) Exists(field string) bool {
 	found := false
@@ -764,6 +764,7 @@ func hasCommitAfter(ctx context.Context, gitserverClient gitserver.Client, repoN
 // 1) We partition the set of repos into indexed and unindexed
 // 2) We kick off a single zoekt search that handles all the indexed revs
 // 3) We kick off a searcher job for the product of every rev * every contains predicate
+// (or alternative of one)
 // 4) We collect the set of revisions that matched all contains predicates and return them.
 func (r *Resolver) filterRepoHasFileContent(
 	ctx context.Context,
@@ -915,19 +916,39 @@ func (r *Resolver) filterRepoHasFileContent(
 			return r.repoHasFileContentAtCommit(ctx, searcherGRPCConnectionCache, repo, commitID, arg)
 		}
 
+		// matchesArg reports whether the repo satisfies arg, which is the
+		// case if any of its alternatives matches.
+		var matchesArg func(ctx context.Context, arg query.RepoHasFileContentArgs, repo types.MinimalRepo, rev string) (bool, error)
+		matchesArg = func(ctx context.Context, arg query.RepoHasFileContentArgs, repo types.MinimalRepo, rev string) (bool, error) {
+			if len(arg.Or) > 0 {
+				for _, alt := range arg.Or {
+					matches, err := matchesArg(ctx, alt, repo, rev)
+					if err != nil || matches {
+						return matches, err
+					}
+				}
+				return false, nil
+			}
+
+			hasMatches, err := checkHasMatches(ctx, arg, repo, rev)
+			if err != nil {
+				return false, err
+			}
+			return hasMatches != arg.Negated, nil
+		}
+
 		for _, repoRevs := range unindexed {
 			for _, rev := range repoRevs.Revs {
 				repo, rev := repoRevs.Repo, rev
 
 				p.Go(func(ctx context.Context) error {
 					for _, arg := range op.HasFileContent {
-						hasMatches, err := checkHasMatches(ctx, arg, repo, rev)
+						matches, err := matchesArg(ctx, arg, repo, rev)
 						if err != nil {
 							return err
 						}
 
-						wantMatches := !arg.Negated
-						if wantMatches != hasMatches {
+						if !matches {
 							// One of the conditions has failed, so we can return early
 							return nil
 						}
@@ -962,9 +983,8 @@ func (r *Resolver) filterRepoHasFileContent(
 func (r *Resolver) repoHasFileContentAtCommit(ctx context.Context, searcherGRPCConnectionCache *defaults.ConnectionCache, repo types.MinimalRepo, commitID api.CommitID, args query.RepoHasFileContentArgs) (bool, error) {
 	patternInfo := search.TextPatternInfo{
 		Query: &protocol.PatternNode{
-			Value:     args.Content,
-			IsNegated: args.Negated,
-			IsRegExp:  true,
+			Value:    args.Content,
+			IsRegExp: true,
 		},
 		IsCaseSensitive:       false,
 		FileMatchLimit:        1,
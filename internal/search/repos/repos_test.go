@@ -995,6 +995,59 @@ func TestRepoHasFileContent(t *testing.T) {
 		expected: []*search.RepositoryRevisions{
 			mkHead(repoC),
 		},
+	}, {
+		name: "or unindexed paths",
+		filters: []query.RepoHasFileContentArgs{{
+			Or: []query.RepoHasFileContentArgs{{Path: "pathC"}, {Path: "pathD"}},
+		}},
+		matchingRepos: nil,
+		expected: []*search.RepositoryRevisions{
+			mkHead(repoC),
+			mkHead(repoD),
+		},
+	}, {
+		name: "path and negated path",
+		filters: []query.RepoHasFileContentArgs{{
+			Content: "line1",
+		}, {
+			Path:    "pathD",
+			Negated: true,
+		}},
+		matchingRepos: nil,
+		expected: []*search.RepositoryRevisions{
+			mkHead(repoC),
+		},
+	}, {
+		name: "or with negated alternative",
+		filters: []query.RepoHasFileContentArgs{{
+			Or: []query.RepoHasFileContentArgs{{Path: "pathC"}, {Content: "line1", Negated: true}},
+		}},
+		matchingRepos: nil,
+		expected: []*search.RepositoryRevisions{
+			mkHead(repoC),
+			mkHead(repoE),
+		},
+	}, {
+		name: "or indexed paths",
+		filters: []query.RepoHasFileContentArgs{{
+			Or: []query.RepoHasFileContentArgs{{Path: "pathA"}, {Path: "pathB"}},
+		}},
+		matchingRepos: zoekt.ReposMap{
+			1: {
+				Branches: []zoekt.RepositoryBranch{{
+					Name: "HEAD",
+				}},
+			},
+			2: {
+				Branches: []zoekt.RepositoryBranch{{
+					Name: "HEAD",
+				}},
+			},
+		},
+		expected: []*search.RepositoryRevisions{
+			mkHead(repoA),
+			mkHead(repoB),
+		},
 	}}
 
 	for _, tc := range cases {
@@ -1012,7 +1065,8 @@ func TestRepoHasFileContent(t *testing.T) {
 				},
 			}, nil)
 
-			mockZoekt.ListFunc.PushReturn(&zoekt.RepoList{
+			// Every HasFileContent filter issues its own List request.
+			mockZoekt.ListFunc.SetDefaultReturn(&zoekt.RepoList{
 				ReposMap: tc.matchingRepos,
 			}, nil)
 
@@ -42,6 +42,10 @@ func (o OwnerTeam) Type() string {
 type OwnerMatch struct {
 	ResolvedOwner Owner
 
+	// Sections are the names of the CODEOWNERS sections the owner was found
+	// in. It is empty if the owner was not found in a section.
+	Sections []string
+
 	// The following contain information about what search the owner was matched from.
 	InputRev *string           `json:"-"`
 	Repo     types.MinimalRepo `json:"-"`
@@ -125,6 +125,17 @@ func (rr FrontendStreamDecoder) ReadAll(r io.Reader) error {
 	return dec.Err()
 }
 
+// UnmarshalEventMatch unmarshals a single JSON encoded match, as produced by
+// marshalling one of the Event*Match types, into the EventMatch matching its
+// "type" field.
+func UnmarshalEventMatch(b []byte) (EventMatch, error) {
+	var u eventMatchUnmarshaller
+	if err := json.Unmarshal(b, &u); err != nil {
+		return nil, err
+	}
+	return u.EventMatch, nil
+}
+
 type eventMatchUnmarshaller struct {
 	EventMatch
 }
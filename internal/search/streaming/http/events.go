@@ -181,6 +181,9 @@ type EventPersonMatch struct {
 
 	// User will not be set if no user was matched.
 	User *UserMetadata `json:"user,omitempty"`
+
+	// Sections are the names of the CODEOWNERS sections the owner was found in.
+	Sections []string `json:"sections,omitempty"`
 }
 
 type UserMetadata struct {
@@ -201,6 +204,9 @@ type EventTeamMatch struct {
 	// The following are a subset of types.Team fields.
 	Name        string `json:"name"`
 	DisplayName string `json:"displayName"`
+
+	// Sections are the names of the CODEOWNERS sections the owner was found in.
+	Sections []string `json:"sections,omitempty"`
 }
 
 func (e *EventTeamMatch) eventMatch() {}
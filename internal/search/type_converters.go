@@ -266,9 +266,10 @@ func fromOwner(owner *result.OwnerMatch) http.EventMatch {
 	switch v := owner.ResolvedOwner.(type) {
 	case *result.OwnerPerson:
 		person := &http.EventPersonMatch{
-			Type:   http.PersonMatchType,
-			Handle: v.Handle,
-			Email:  v.Email,
+			Type:     http.PersonMatchType,
+			Handle:   v.Handle,
+			Email:    v.Email,
+			Sections: owner.Sections,
 		}
 		if v.User != nil {
 			person.User = &http.UserMetadata{
@@ -285,6 +286,7 @@ func fromOwner(owner *result.OwnerMatch) http.EventMatch {
 			Email:       v.Email,
 			Name:        v.Team.Name,
 			DisplayName: v.Team.DisplayName,
+			Sections:    owner.Sections,
 		}
 	default:
 		panic(fmt.Sprintf("unknown owner match type %T", v))
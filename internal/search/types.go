@@ -150,6 +150,55 @@ type SymbolsResponse struct {
 	LimitHit bool `json:"limitHit,omitempty"`
 }
 
+// SymbolDiffParameters describes a comparison of the symbols of a repository
+// between two commits. The embedded search parameters select the repository, the
+// head commit and the symbols to compare.
+type SymbolDiffParameters struct {
+	SymbolsParameters
+
+	// BaseCommitID is the commit to compare against.
+	BaseCommitID api.CommitID
+}
+
+// SymbolDiff lists the symbols that differ between two commits.
+type SymbolDiff struct {
+	Added    []SymbolLocation `json:"added"`
+	Removed  []SymbolLocation `json:"removed"`
+	Moved    []SymbolMove     `json:"moved"`
+	LimitHit bool             `json:"limitHit"`
+}
+
+type SymbolLocation struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+// SymbolMove is a symbol that was removed from one path and added to another.
+type SymbolMove struct {
+	Name    string `json:"name"`
+	OldPath string `json:"oldPath"`
+	NewPath string `json:"newPath"`
+}
+
+// SymbolIntroductionsParameters selects the symbols whose introduction to look
+// up: the symbols with the given name present at the given commit, optionally
+// restricted to a single path.
+type SymbolIntroductionsParameters struct {
+	Repo     api.RepoName
+	CommitID api.CommitID
+	Name     string
+	Path     string
+}
+
+// SymbolIntroduction is the commit in which a symbol that is present at some
+// commit was added to its path.
+type SymbolIntroduction struct {
+	Name     string       `json:"name"`
+	Path     string       `json:"path"`
+	CommitID api.CommitID `json:"commit"`
+	Height   int          `json:"height"`
+}
+
 type IndexedRequestType string
 
 const (
@@ -507,6 +556,9 @@ func (op *RepoOptions) Attributes() []attribute.KeyValue {
 			if arg.Negated {
 				nondefault = append(nondefault, attribute.Bool("negated", arg.Negated))
 			}
+			for j, alt := range arg.Or {
+				nondefault = append(nondefault, attribute.String(fmt.Sprintf("or[%d]", j), fmt.Sprintf("%+v", alt)))
+			}
 			add(trace.Scoped(fmt.Sprintf("hasFileContent[%d]", i), nondefault...)...)
 		}
 	}
@@ -598,6 +650,9 @@ func (op *RepoOptions) String() string {
 			if arg.Negated {
 				fmt.Fprintf(&b, "HasFileContent[%d].negated: %t\n", i, arg.Negated)
 			}
+			for j, alt := range arg.Or {
+				fmt.Fprintf(&b, "HasFileContent[%d].or[%d]: %+v\n", i, j, alt)
+			}
 		}
 	}
 	if len(op.HasKVPs) > 0 {
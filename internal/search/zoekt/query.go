@@ -107,6 +107,14 @@ func toFileFilters(filesInclude []string, filesExclude []string, isCaseSensitive
 }
 
 func QueryForFileContentArgs(opt query.RepoHasFileContentArgs, caseSensitive bool) zoekt.Q {
+	if len(opt.Or) > 0 {
+		alternatives := make([]zoekt.Q, 0, len(opt.Or))
+		for _, alt := range opt.Or {
+			alternatives = append(alternatives, QueryForFileContentArgs(alt, caseSensitive))
+		}
+		return zoekt.Simplify(zoekt.NewOr(alternatives...))
+	}
+
 	var children []zoekt.Q
 	if opt.Path != "" {
 		re, err := syntax.Parse(opt.Path, syntax.Perl)
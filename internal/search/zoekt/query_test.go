@@ -132,6 +132,18 @@ func TestQueryToZoektQuery(t *testing.T) {
 			},
 			WantZoektOutput: autogold.Expect(`(and lang:Go lang:TypeScript file_regex:"\\.go(?m:$)")`),
 		},
+		{
+			Name:            "repo contains file or",
+			Type:            search.TextRequest,
+			Query:           `foo repo:contains.file(path:package\.json or path:pnpm-lock\.yaml)`,
+			WantZoektOutput: autogold.Expect(`(and substr:"foo" (or (type:repo file_regex:"package\\.json") (type:repo file_regex:"pnpm-lock\\.yaml")))`),
+		},
+		{
+			Name:            "repo contains file with negated path",
+			Type:            search.TextRequest,
+			Query:           `foo repo:contains.file(path:go\.mod -path:\.golangci\.yml)`,
+			WantZoektOutput: autogold.Expect(`(and substr:"foo" (type:repo file_regex:"go\\.mod") (not (type:repo file_regex:"\\.golangci\\.yml")))`),
+		},
 	}
 	for _, tt := range cases {
 		t.Run(tt.Name, func(t *testing.T) {
@@ -121,6 +121,143 @@ func (c *Client) searchGRPC(ctx context.Context, args search.SymbolsParameters)
 	return response, nil
 }
 
+// DiffSymbols returns the symbols added, removed or moved between two commits of
+// a repository. It requires the symbols service to use Rockskip.
+func (c *Client) DiffSymbols(ctx context.Context, args search.SymbolDiffParameters) (diff *search.SymbolDiff, err error) {
+	tr, ctx := trace.New(ctx, "symbols.DiffSymbols",
+		args.Repo.Attr(),
+		args.CommitID.Attr(),
+		attribute.String("baseCommitID", string(args.BaseCommitID)))
+	defer tr.EndWithErr(&err)
+
+	grpcClient, err := c.gRPCClient(string(args.Repo))
+	if err != nil {
+		return nil, errors.Wrap(err, "getting gRPC symbols client")
+	}
+
+	var protoArgs proto.DiffSymbolsRequest
+	protoArgs.FromInternal(&args)
+
+	protoResponse, err := grpcClient.DiffSymbols(ctx, &protoArgs)
+	if err != nil {
+		return nil, errors.Wrap(translateGRPCError(err), "executing symbol diff request")
+	}
+	diff = protoResponse.ToInternal()
+
+	// 🚨 SECURITY: We have a valid result, so we need to apply sub-repo permissions
+	// filtering.
+	canRead := c.pathReadChecker(ctx, args.Repo)
+	if canRead == nil {
+		return diff, nil
+	}
+
+	filtered := &search.SymbolDiff{
+		Added:    []search.SymbolLocation{},
+		Removed:  []search.SymbolLocation{},
+		Moved:    []search.SymbolMove{},
+		LimitHit: diff.LimitHit,
+	}
+	for _, symbol := range diff.Added {
+		if ok, err := canRead(symbol.Path); err != nil {
+			return nil, err
+		} else if ok {
+			filtered.Added = append(filtered.Added, symbol)
+		}
+	}
+	for _, symbol := range diff.Removed {
+		if ok, err := canRead(symbol.Path); err != nil {
+			return nil, err
+		} else if ok {
+			filtered.Removed = append(filtered.Removed, symbol)
+		}
+	}
+	// A move is only reported as such if both paths can be read, so that an
+	// unreadable path is not revealed.
+	for _, move := range diff.Moved {
+		oldOk, err := canRead(move.OldPath)
+		if err != nil {
+			return nil, err
+		}
+		newOk, err := canRead(move.NewPath)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case oldOk && newOk:
+			filtered.Moved = append(filtered.Moved, move)
+		case newOk:
+			filtered.Added = append(filtered.Added, search.SymbolLocation{Name: move.Name, Path: move.NewPath})
+		case oldOk:
+			filtered.Removed = append(filtered.Removed, search.SymbolLocation{Name: move.Name, Path: move.OldPath})
+		}
+	}
+
+	return filtered, nil
+}
+
+// SymbolIntroductions returns the commits in which the symbols with the given
+// name that are present at a commit were added to their paths. It requires the
+// symbols service to use Rockskip.
+func (c *Client) SymbolIntroductions(ctx context.Context, args search.SymbolIntroductionsParameters) (introductions []search.SymbolIntroduction, err error) {
+	tr, ctx := trace.New(ctx, "symbols.SymbolIntroductions",
+		args.Repo.Attr(),
+		args.CommitID.Attr())
+	defer tr.EndWithErr(&err)
+
+	grpcClient, err := c.gRPCClient(string(args.Repo))
+	if err != nil {
+		return nil, errors.Wrap(err, "getting gRPC symbols client")
+	}
+
+	var protoArgs proto.SymbolIntroductionsRequest
+	protoArgs.FromInternal(&args)
+
+	protoResponse, err := grpcClient.SymbolIntroductions(ctx, &protoArgs)
+	if err != nil {
+		return nil, errors.Wrap(translateGRPCError(err), "executing symbol introductions request")
+	}
+	introductions = protoResponse.ToInternal()
+
+	// 🚨 SECURITY: We have valid results, so we need to apply sub-repo permissions
+	// filtering.
+	canRead := c.pathReadChecker(ctx, args.Repo)
+	if canRead == nil {
+		return introductions, nil
+	}
+
+	filtered := introductions[:0]
+	for _, introduction := range introductions {
+		if ok, err := canRead(introduction.Path); err != nil {
+			return nil, err
+		} else if ok {
+			filtered = append(filtered, introduction)
+		}
+	}
+	return filtered, nil
+}
+
+// pathReadChecker returns a function that reports whether the actor of ctx can
+// read a path of repo. It returns nil if sub-repo permissions are disabled.
+func (c *Client) pathReadChecker(ctx context.Context, repo api.RepoName) func(path string) (bool, error) {
+	if c.SubRepoPermsChecker == nil {
+		return nil
+	}
+
+	checker := c.SubRepoPermsChecker()
+	if !authz.SubRepoEnabled(checker) {
+		return nil
+	}
+
+	a := actor.FromContext(ctx)
+	return func(path string) (bool, error) {
+		perm, err := authz.ActorPermissions(ctx, checker, a, authz.RepoContent{Repo: repo, Path: path})
+		if err != nil {
+			return false, errors.Wrap(err, "checking sub-repo permissions")
+		}
+		return perm.Include(authz.Read), nil
+	}
+}
+
 func (c *Client) LocalCodeIntel(ctx context.Context, path types.RepoCommitPath) (result *types.LocalCodeIntelPayload, err error) {
 	tr, ctx := trace.New(ctx, "symbols.LocalCodeIntel",
 		attribute.String("repo", path.Repo),
@@ -9,6 +9,7 @@ import (
 
 	"github.com/sourcegraph/log"
 	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
@@ -202,12 +203,108 @@ func TestDefinitionWithFiltering(t *testing.T) {
 	}
 }
 
+func TestSymbolHistoryWithFiltering(t *testing.T) {
+	ctx := context.Background()
+	diffFixture := search.SymbolDiff{
+		Added:   []search.SymbolLocation{{Name: "foo1", Path: "file1"}, {Name: "foo2", Path: "file2"}},
+		Removed: []search.SymbolLocation{{Name: "bar", Path: "file2"}},
+		Moved: []search.SymbolMove{
+			{Name: "baz1", OldPath: "file1", NewPath: "file2"},
+			{Name: "baz2", OldPath: "file2", NewPath: "file1"},
+			{Name: "baz3", OldPath: "file1", NewPath: "file1"},
+		},
+		LimitHit: true,
+	}
+	introductionsFixture := []search.SymbolIntroduction{
+		{Name: "foo", Path: "file1", CommitID: "a", Height: 1},
+		{Name: "foo", Path: "file2", CommitID: "b", Height: 2},
+	}
+
+	mockServer := &mockSymbolsServer{
+		mockDiffSymbolsGRPC: func(_ context.Context, r *proto.DiffSymbolsRequest) (*proto.DiffSymbolsResponse, error) {
+			if r.GetBaseCommitId() != "base" || r.GetSearch().GetCommitId() != "head" {
+				return nil, errors.Newf("unexpected request %v", r)
+			}
+			var response proto.DiffSymbolsResponse
+			response.FromInternal(&diffFixture)
+			return &response, nil
+		},
+		mockSymbolIntroductionsGRPC: func(_ context.Context, r *proto.SymbolIntroductionsRequest) (*proto.SymbolIntroductionsResponse, error) {
+			if r.GetName() != "foo" {
+				return nil, errors.Newf("unexpected request %v", r)
+			}
+			var response proto.SymbolIntroductionsResponse
+			response.FromInternal(introductionsFixture)
+			return &response, nil
+		},
+	}
+
+	handler, cleanup := mockServer.NewHandler(logtest.Scoped(t))
+	srv := httptest.NewServer(handler)
+	t.Cleanup(func() {
+		srv.Close()
+		cleanup()
+	})
+
+	DefaultClient.Endpoints = endpoint.Static(srv.URL)
+
+	diffArgs := search.SymbolDiffParameters{
+		SymbolsParameters: search.SymbolsParameters{Repo: "foo", CommitID: "head"},
+		BaseCommitID:      "base",
+	}
+	introductionsArgs := search.SymbolIntroductionsParameters{Repo: "foo", CommitID: "head", Name: "foo"}
+
+	oldChecker := authz.DefaultSubRepoPermsChecker
+	t.Cleanup(func() { authz.DefaultSubRepoPermsChecker = oldChecker })
+	authz.DefaultSubRepoPermsChecker = authz.NewMockSubRepoPermissionChecker()
+
+	diff, err := DefaultClient.DiffSymbols(ctx, diffArgs)
+	require.NoError(t, err)
+	require.Equal(t, &diffFixture, diff)
+
+	introductions, err := DefaultClient.SymbolIntroductions(ctx, introductionsArgs)
+	require.NoError(t, err)
+	require.Equal(t, introductionsFixture, introductions)
+
+	// With filtering
+	ctx = actor.WithActor(ctx, &actor.Actor{
+		UID: 1,
+	})
+	checker := authz.NewMockSubRepoPermissionChecker()
+	checker.EnabledFunc.SetDefaultHook(func() bool {
+		return true
+	})
+	checker.PermissionsFunc.SetDefaultHook(func(ctx context.Context, i int32, content authz.RepoContent) (authz.Perms, error) {
+		if content.Path == "file1" {
+			return authz.Read, nil
+		}
+		return authz.None, nil
+	})
+	authz.DefaultSubRepoPermsChecker = checker
+
+	diff, err = DefaultClient.DiffSymbols(ctx, diffArgs)
+	require.NoError(t, err)
+	require.Equal(t, &search.SymbolDiff{
+		Added:    []search.SymbolLocation{{Name: "foo1", Path: "file1"}, {Name: "baz2", Path: "file1"}},
+		Removed:  []search.SymbolLocation{{Name: "baz1", Path: "file1"}},
+		Moved:    []search.SymbolMove{{Name: "baz3", OldPath: "file1", NewPath: "file1"}},
+		LimitHit: true,
+	}, diff)
+
+	introductions, err = DefaultClient.SymbolIntroductions(ctx, introductionsArgs)
+	require.NoError(t, err)
+	require.Equal(t, introductionsFixture[:1], introductions)
+}
+
 type mockSymbolsServer struct {
 	mockSearchGRPC         func(ctx context.Context, request *proto.SearchRequest) (*proto.SearchResponse, error)
 	mockLocalCodeIntelGRPC func(request *proto.LocalCodeIntelRequest, ss proto.SymbolsService_LocalCodeIntelServer) error
 	mockSymbolInfoGRPC     func(ctx context.Context, request *proto.SymbolInfoRequest) (*proto.SymbolInfoResponse, error)
 	mockHealthzGRPC        func(ctx context.Context, request *proto.HealthzRequest) (*proto.HealthzResponse, error)
 
+	mockDiffSymbolsGRPC         func(ctx context.Context, request *proto.DiffSymbolsRequest) (*proto.DiffSymbolsResponse, error)
+	mockSymbolIntroductionsGRPC func(ctx context.Context, request *proto.SymbolIntroductionsRequest) (*proto.SymbolIntroductionsResponse, error)
+
 	restHandler http.Handler
 
 	proto.UnimplementedSymbolsServiceServer
@@ -258,6 +355,22 @@ func (m *mockSymbolsServer) SymbolInfo(ctx context.Context, r *proto.SymbolInfoR
 	return nil, errors.Newf("grpc: method %q not implemented", "SymbolInfo")
 }
 
+func (m *mockSymbolsServer) DiffSymbols(ctx context.Context, r *proto.DiffSymbolsRequest) (*proto.DiffSymbolsResponse, error) {
+	if m.mockDiffSymbolsGRPC != nil {
+		return m.mockDiffSymbolsGRPC(ctx, r)
+	}
+
+	return nil, errors.Newf("grpc: method %q not implemented", "DiffSymbols")
+}
+
+func (m *mockSymbolsServer) SymbolIntroductions(ctx context.Context, r *proto.SymbolIntroductionsRequest) (*proto.SymbolIntroductionsResponse, error) {
+	if m.mockSymbolIntroductionsGRPC != nil {
+		return m.mockSymbolIntroductionsGRPC(ctx, r)
+	}
+
+	return nil, errors.Newf("grpc: method %q not implemented", "SymbolIntroductions")
+}
+
 func (m *mockSymbolsServer) Healthz(ctx context.Context, r *proto.HealthzRequest) (*proto.HealthzResponse, error) {
 	if m.mockHealthzGRPC != nil {
 		return m.mockHealthzGRPC(ctx, r)
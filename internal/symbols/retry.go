@@ -34,6 +34,16 @@ func (a *automaticRetryClient) SymbolInfo(ctx context.Context, in *proto.SymbolI
 	return a.base.SymbolInfo(ctx, in, opts...)
 }
 
+func (a *automaticRetryClient) DiffSymbols(ctx context.Context, in *proto.DiffSymbolsRequest, opts ...grpc.CallOption) (*proto.DiffSymbolsResponse, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return a.base.DiffSymbols(ctx, in, opts...)
+}
+
+func (a *automaticRetryClient) SymbolIntroductions(ctx context.Context, in *proto.SymbolIntroductionsRequest, opts ...grpc.CallOption) (*proto.SymbolIntroductionsResponse, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return a.base.SymbolIntroductions(ctx, in, opts...)
+}
+
 func (a *automaticRetryClient) Healthz(ctx context.Context, in *proto.HealthzRequest, opts ...grpc.CallOption) (*proto.HealthzResponse, error) {
 	opts = append(defaults.RetryPolicy, opts...)
 	return a.base.Healthz(ctx, in, opts...)
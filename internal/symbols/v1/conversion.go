@@ -117,6 +117,114 @@ func (x *SearchResponse_Symbol) ToInternal() result.Symbol {
 	}
 }
 
+func (x *DiffSymbolsRequest) FromInternal(p *search.SymbolDiffParameters) {
+	var searchRequest SearchRequest
+	searchRequest.FromInternal(&p.SymbolsParameters)
+
+	*x = DiffSymbolsRequest{
+		Search:       &searchRequest,
+		BaseCommitId: string(p.BaseCommitID),
+	}
+}
+
+func (x *DiffSymbolsRequest) ToInternal() search.SymbolDiffParameters {
+	return search.SymbolDiffParameters{
+		SymbolsParameters: x.GetSearch().ToInternal(),
+		BaseCommitID:      api.CommitID(x.GetBaseCommitId()),
+	}
+}
+
+func (x *DiffSymbolsResponse) FromInternal(d *search.SymbolDiff) {
+	fromLocations := func(locations []search.SymbolLocation) []*DiffSymbolsResponse_SymbolLocation {
+		out := make([]*DiffSymbolsResponse_SymbolLocation, 0, len(locations))
+		for _, l := range locations {
+			out = append(out, &DiffSymbolsResponse_SymbolLocation{Name: l.Name, Path: l.Path})
+		}
+		return out
+	}
+
+	moved := make([]*DiffSymbolsResponse_SymbolMove, 0, len(d.Moved))
+	for _, m := range d.Moved {
+		moved = append(moved, &DiffSymbolsResponse_SymbolMove{Name: m.Name, OldPath: m.OldPath, NewPath: m.NewPath})
+	}
+
+	*x = DiffSymbolsResponse{
+		Added:    fromLocations(d.Added),
+		Removed:  fromLocations(d.Removed),
+		Moved:    moved,
+		LimitHit: d.LimitHit,
+	}
+}
+
+func (x *DiffSymbolsResponse) ToInternal() *search.SymbolDiff {
+	toLocations := func(locations []*DiffSymbolsResponse_SymbolLocation) []search.SymbolLocation {
+		out := make([]search.SymbolLocation, 0, len(locations))
+		for _, l := range locations {
+			out = append(out, search.SymbolLocation{Name: l.GetName(), Path: l.GetPath()})
+		}
+		return out
+	}
+
+	moved := make([]search.SymbolMove, 0, len(x.GetMoved()))
+	for _, m := range x.GetMoved() {
+		moved = append(moved, search.SymbolMove{Name: m.GetName(), OldPath: m.GetOldPath(), NewPath: m.GetNewPath()})
+	}
+
+	return &search.SymbolDiff{
+		Added:    toLocations(x.GetAdded()),
+		Removed:  toLocations(x.GetRemoved()),
+		Moved:    moved,
+		LimitHit: x.GetLimitHit(),
+	}
+}
+
+func (x *SymbolIntroductionsRequest) FromInternal(p *search.SymbolIntroductionsParameters) {
+	*x = SymbolIntroductionsRequest{
+		Repo:     string(p.Repo),
+		CommitId: string(p.CommitID),
+		Name:     p.Name,
+		Path:     p.Path,
+	}
+}
+
+func (x *SymbolIntroductionsRequest) ToInternal() search.SymbolIntroductionsParameters {
+	return search.SymbolIntroductionsParameters{
+		Repo:     api.RepoName(x.GetRepo()),
+		CommitID: api.CommitID(x.GetCommitId()),
+		Name:     x.GetName(),
+		Path:     x.GetPath(),
+	}
+}
+
+func (x *SymbolIntroductionsResponse) FromInternal(introductions []search.SymbolIntroduction) {
+	protoIntroductions := make([]*SymbolIntroductionsResponse_SymbolIntroduction, 0, len(introductions))
+	for _, i := range introductions {
+		protoIntroductions = append(protoIntroductions, &SymbolIntroductionsResponse_SymbolIntroduction{
+			Name:     i.Name,
+			Path:     i.Path,
+			CommitId: string(i.CommitID),
+			Height:   int32(i.Height),
+		})
+	}
+
+	*x = SymbolIntroductionsResponse{
+		Introductions: protoIntroductions,
+	}
+}
+
+func (x *SymbolIntroductionsResponse) ToInternal() []search.SymbolIntroduction {
+	introductions := make([]search.SymbolIntroduction, 0, len(x.GetIntroductions()))
+	for _, i := range x.GetIntroductions() {
+		introductions = append(introductions, search.SymbolIntroduction{
+			Name:     i.GetName(),
+			Path:     i.GetPath(),
+			CommitID: api.CommitID(i.GetCommitId()),
+			Height:   int(i.GetHeight()),
+		})
+	}
+	return introductions
+}
+
 func (x *SymbolInfoResponse) FromInternal(s *types.SymbolInfo) {
 	if s == nil {
 		*x = SymbolInfoResponse{}
@@ -63,6 +63,100 @@ func Test_Search_SymbolsParameters_ProtoRoundTrip(t *testing.T) {
 	}
 }
 
+func Test_Search_SymbolDiffParameters_ProtoRoundTrip(t *testing.T) {
+	var diff string
+
+	f := func(original search.SymbolDiffParameters) bool {
+		if !symbolsParametersWithinInt32(original.SymbolsParameters) {
+			return true // skip
+		}
+
+		var originalProto DiffSymbolsRequest
+		originalProto.FromInternal(&original)
+
+		converted := originalProto.ToInternal()
+
+		if diff = cmp.Diff(original, converted); diff != "" {
+			return false
+		}
+
+		return true
+	}
+
+	if err := quick.Check(f, nil); err != nil {
+		t.Errorf("SymbolDiffParameters diff (-want +got):\n%s", diff)
+	}
+}
+
+func Test_Search_SymbolDiff_ProtoRoundTrip(t *testing.T) {
+	var diff string
+
+	f := func(original search.SymbolDiff) bool {
+		var originalProto DiffSymbolsResponse
+		originalProto.FromInternal(&original)
+
+		converted := originalProto.ToInternal()
+
+		if diff = cmp.Diff(&original, converted, cmpopts.EquateEmpty()); diff != "" {
+			return false
+		}
+
+		return true
+	}
+
+	if err := quick.Check(f, nil); err != nil {
+		t.Errorf("SymbolDiff diff (-want +got):\n%s", diff)
+	}
+}
+
+func Test_Search_SymbolIntroductionsParameters_ProtoRoundTrip(t *testing.T) {
+	var diff string
+
+	f := func(original search.SymbolIntroductionsParameters) bool {
+		var originalProto SymbolIntroductionsRequest
+		originalProto.FromInternal(&original)
+
+		converted := originalProto.ToInternal()
+
+		if diff = cmp.Diff(original, converted); diff != "" {
+			return false
+		}
+
+		return true
+	}
+
+	if err := quick.Check(f, nil); err != nil {
+		t.Errorf("SymbolIntroductionsParameters diff (-want +got):\n%s", diff)
+	}
+}
+
+func Test_Search_SymbolIntroductions_ProtoRoundTrip(t *testing.T) {
+	var diff string
+
+	f := func(original []search.SymbolIntroduction) bool {
+		for _, i := range original {
+			if !withinInt32(i.Height) {
+				return true // skip
+			}
+		}
+
+		var originalProto SymbolIntroductionsResponse
+		originalProto.FromInternal(original)
+
+		converted := originalProto.ToInternal()
+
+		if diff = cmp.Diff(original, converted, cmpopts.EquateEmpty()); diff != "" {
+			return false
+		}
+
+		return true
+	}
+
+	if err := quick.Check(f, nil); err != nil {
+		t.Errorf("SymbolIntroductions diff (-want +got):\n%s", diff)
+	}
+}
+
 func Test_Result_Symbol_ProtoRoundTrip(t *testing.T) {
 	var diff string
 
@@ -234,6 +234,267 @@ func (x *SearchResponse) GetLimitHit() bool {
 	return false
 }
 
+// DiffSymbolsRequest is the request to the DiffSymbols method.
+type DiffSymbolsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// search selects the repository, the head commit and the symbols to
+	// compare.
+	Search *SearchRequest `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	// base_commit_id is the commit to compare against
+	BaseCommitId string `protobuf:"bytes,2,opt,name=base_commit_id,json=baseCommitId,proto3" json:"base_commit_id,omitempty"`
+}
+
+func (x *DiffSymbolsRequest) Reset() {
+	*x = DiffSymbolsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_symbols_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffSymbolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffSymbolsRequest) ProtoMessage() {}
+
+func (x *DiffSymbolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_symbols_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffSymbolsRequest.ProtoReflect.Descriptor instead.
+func (*DiffSymbolsRequest) Descriptor() ([]byte, []int) {
+	return file_symbols_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DiffSymbolsRequest) GetSearch() *SearchRequest {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *DiffSymbolsRequest) GetBaseCommitId() string {
+	if x != nil {
+		return x.BaseCommitId
+	}
+	return ""
+}
+
+// DiffSymbolsResponse is the response from the DiffSymbols method.
+type DiffSymbolsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// added is the list of symbols that are only present at the head commit
+	Added []*DiffSymbolsResponse_SymbolLocation `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`
+	// removed is the list of symbols that are only present at the base commit
+	Removed []*DiffSymbolsResponse_SymbolLocation `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
+	// moved is the list of symbols that moved to another path
+	Moved []*DiffSymbolsResponse_SymbolMove `protobuf:"bytes,3,rep,name=moved,proto3" json:"moved,omitempty"`
+	// limit_hit is true if the diff ran into the limit set by "first" in the
+	// request
+	LimitHit bool `protobuf:"varint,4,opt,name=limit_hit,json=limitHit,proto3" json:"limit_hit,omitempty"`
+}
+
+func (x *DiffSymbolsResponse) Reset() {
+	*x = DiffSymbolsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_symbols_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffSymbolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffSymbolsResponse) ProtoMessage() {}
+
+func (x *DiffSymbolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_symbols_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffSymbolsResponse.ProtoReflect.Descriptor instead.
+func (*DiffSymbolsResponse) Descriptor() ([]byte, []int) {
+	return file_symbols_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DiffSymbolsResponse) GetAdded() []*DiffSymbolsResponse_SymbolLocation {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *DiffSymbolsResponse) GetRemoved() []*DiffSymbolsResponse_SymbolLocation {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *DiffSymbolsResponse) GetMoved() []*DiffSymbolsResponse_SymbolMove {
+	if x != nil {
+		return x.Moved
+	}
+	return nil
+}
+
+func (x *DiffSymbolsResponse) GetLimitHit() bool {
+	if x != nil {
+		return x.LimitHit
+	}
+	return false
+}
+
+// SymbolIntroductionsRequest is the request to the SymbolIntroductions method.
+type SymbolIntroductionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo is the name of the repository
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// commit_id is the commit at which the symbols are present
+	CommitId string `protobuf:"bytes,2,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
+	// name is the name of the symbols
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// path, if set, restricts the lookup to the symbols in this file path
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *SymbolIntroductionsRequest) Reset() {
+	*x = SymbolIntroductionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_symbols_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SymbolIntroductionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolIntroductionsRequest) ProtoMessage() {}
+
+func (x *SymbolIntroductionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_symbols_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymbolIntroductionsRequest.ProtoReflect.Descriptor instead.
+func (*SymbolIntroductionsRequest) Descriptor() ([]byte, []int) {
+	return file_symbols_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SymbolIntroductionsRequest) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *SymbolIntroductionsRequest) GetCommitId() string {
+	if x != nil {
+		return x.CommitId
+	}
+	return ""
+}
+
+func (x *SymbolIntroductionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SymbolIntroductionsRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// SymbolIntroductionsResponse is the response from the SymbolIntroductions
+// method.
+type SymbolIntroductionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Introductions []*SymbolIntroductionsResponse_SymbolIntroduction `protobuf:"bytes,1,rep,name=introductions,proto3" json:"introductions,omitempty"`
+}
+
+func (x *SymbolIntroductionsResponse) Reset() {
+	*x = SymbolIntroductionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_symbols_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SymbolIntroductionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolIntroductionsResponse) ProtoMessage() {}
+
+func (x *SymbolIntroductionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_symbols_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymbolIntroductionsResponse.ProtoReflect.Descriptor instead.
+func (*SymbolIntroductionsResponse) Descriptor() ([]byte, []int) {
+	return file_symbols_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SymbolIntroductionsResponse) GetIntroductions() []*SymbolIntroductionsResponse_SymbolIntroduction {
+	if x != nil {
+		return x.Introductions
+	}
+	return nil
+}
+
 // LocalCodeIntelRequest is the request to the LocalCodeIntel method.
 type LocalCodeIntelRequest struct {
 	state         protoimpl.MessageState
@@ -247,7 +508,7 @@ type LocalCodeIntelRequest struct {
 func (x *LocalCodeIntelRequest) Reset() {
 	*x = LocalCodeIntelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_symbols_proto_msgTypes[2]
+		mi := &file_symbols_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -260,7 +521,7 @@ func (x *LocalCodeIntelRequest) String() string {
 func (*LocalCodeIntelRequest) ProtoMessage() {}
 
 func (x *LocalCodeIntelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_symbols_proto_msgTypes[2]
+	mi := &file_symbols_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -273,7 +534,7 @@ func (x *LocalCodeIntelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocalCodeIntelRequest.ProtoReflect.Descriptor instead.
 func (*LocalCodeIntelRequest) Descriptor() ([]byte, []int) {
-	return file_symbols_proto_rawDescGZIP(), []int{2}
+	return file_symbols_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *LocalCodeIntelRequest) GetRepoCommitPath() *RepoCommitPath {
@@ -294,7 +555,7 @@ type LocalCodeIntelResponse struct {
 func (x *LocalCodeIntelResponse) Reset() {
 	*x = LocalCodeIntelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_symbols_proto_msgTypes[3]
+		mi := &file_symbols_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -307,7 +568,7 @@ func (x *LocalCodeIntelResponse) String() string {
 func (*LocalCodeIntelResponse) ProtoMessage() {}
 
 func (x *LocalCodeIntelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_symbols_proto_msgTypes[3]
+	mi := &file_symbols_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -320,7 +581,7 @@ func (x *LocalCodeIntelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocalCodeIntelResponse.ProtoReflect.Descriptor instead.
 func (*LocalCodeIntelResponse) Descriptor() ([]byte, []int) {
-	return file_symbols_proto_rawDescGZIP(), []int{3}
+	return file_symbols_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *LocalCodeIntelResponse) GetSymbols() []*LocalCodeIntelResponse_Symbol {
@@ -345,7 +606,7 @@ type SymbolInfoRequest struct {
 func (x *SymbolInfoRequest) Reset() {
 	*x = SymbolInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_symbols_proto_msgTypes[4]
+		mi := &file_symbols_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -358,7 +619,7 @@ func (x *SymbolInfoRequest) String() string {
 func (*SymbolInfoRequest) ProtoMessage() {}
 
 func (x *SymbolInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_symbols_proto_msgTypes[4]
+	mi := &file_symbols_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -371,7 +632,7 @@ func (x *SymbolInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SymbolInfoRequest.ProtoReflect.Descriptor instead.
 func (*SymbolInfoRequest) Descriptor() ([]byte, []int) {
-	return file_symbols_proto_rawDescGZIP(), []int{4}
+	return file_symbols_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SymbolInfoRequest) GetRepoCommitPath() *RepoCommitPath {
@@ -402,7 +663,7 @@ type SymbolInfoResponse struct {
 func (x *SymbolInfoResponse) Reset() {
 	*x = SymbolInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_symbols_proto_msgTypes[5]
+		mi := &file_symbols_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -415,7 +676,7 @@ func (x *SymbolInfoResponse) String() string {
 func (*SymbolInfoResponse) ProtoMessage() {}
 
 func (x *SymbolInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_symbols_proto_msgTypes[5]
+	mi := &file_symbols_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -428,7 +689,7 @@ func (x *SymbolInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SymbolInfoResponse.ProtoReflect.Descriptor instead.
 func (*SymbolInfoResponse) Descriptor() ([]byte, []int) {
-	return file_symbols_proto_rawDescGZIP(), []int{5}
+	return file_symbols_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SymbolInfoResponse) GetResult() *SymbolInfoResponse_DefinitionResult {
@@ -456,7 +717,7 @@ type RepoCommitPath struct {
 func (x *RepoCommitPath) Reset() {
 	*x = RepoCommitPath{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_symbols_proto_msgTypes[6]
+		mi := &file_symbols_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -469,7 +730,7 @@ func (x *RepoCommitPath) String() string {
 func (*RepoCommitPath) ProtoMessage() {}
 
 func (x *RepoCommitPath) ProtoReflect() protoreflect.Message {
-	mi := &file_symbols_proto_msgTypes[6]
+	mi := &file_symbols_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -482,7 +743,7 @@ func (x *RepoCommitPath) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoCommitPath.ProtoReflect.Descriptor instead.
 func (*RepoCommitPath) Descriptor() ([]byte, []int) {
-	return file_symbols_proto_rawDescGZIP(), []int{6}
+	return file_symbols_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RepoCommitPath) GetRepo() string {
@@ -523,7 +784,7 @@ type Range struct {
 func (x *Range) Reset() {
 	*x = Range{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_symbols_proto_msgTypes[7]
+		mi := &file_symbols_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -536,7 +797,7 @@ func (x *Range) String() string {
 func (*Range) ProtoMessage() {}
 
 func (x *Range) ProtoReflect() protoreflect.Message {
-	mi := &file_symbols_proto_msgTypes[7]
+	mi := &file_symbols_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -549,7 +810,7 @@ func (x *Range) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Range.ProtoReflect.Descriptor instead.
 func (*Range) Descriptor() ([]byte, []int) {
-	return file_symbols_proto_rawDescGZIP(), []int{7}
+	return file_symbols_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Range) GetRow() int32 {
@@ -588,7 +849,7 @@ type Point struct {
 func (x *Point) Reset() {
 	*x = Point{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_symbols_proto_msgTypes[8]
+		mi := &file_symbols_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -601,7 +862,7 @@ func (x *Point) String() string {
 func (*Point) ProtoMessage() {}
 
 func (x *Point) ProtoReflect() protoreflect.Message {
-	mi := &file_symbols_proto_msgTypes[8]
+	mi := &file_symbols_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -614,7 +875,7 @@ func (x *Point) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Point.ProtoReflect.Descriptor instead.
 func (*Point) Descriptor() ([]byte, []int) {
-	return file_symbols_proto_rawDescGZIP(), []int{8}
+	return file_symbols_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Point) GetRow() int32 {
@@ -644,7 +905,7 @@ type HealthzRequest struct {
 func (x *HealthzRequest) Reset() {
 	*x = HealthzRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_symbols_proto_msgTypes[9]
+		mi := &file_symbols_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -657,7 +918,7 @@ func (x *HealthzRequest) String() string {
 func (*HealthzRequest) ProtoMessage() {}
 
 func (x *HealthzRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_symbols_proto_msgTypes[9]
+	mi := &file_symbols_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,7 +931,7 @@ func (x *HealthzRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthzRequest.ProtoReflect.Descriptor instead.
 func (*HealthzRequest) Descriptor() ([]byte, []int) {
-	return file_symbols_proto_rawDescGZIP(), []int{9}
+	return file_symbols_proto_rawDescGZIP(), []int{13}
 }
 
 // TODO@ggilmore: Note - GRPC has its own healthchecking protocol that we should
@@ -686,7 +947,7 @@ type HealthzResponse struct {
 func (x *HealthzResponse) Reset() {
 	*x = HealthzResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_symbols_proto_msgTypes[10]
+		mi := &file_symbols_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -699,7 +960,7 @@ func (x *HealthzResponse) String() string {
 func (*HealthzResponse) ProtoMessage() {}
 
 func (x *HealthzResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_symbols_proto_msgTypes[10]
+	mi := &file_symbols_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,7 +973,7 @@ func (x *HealthzResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthzResponse.ProtoReflect.Descriptor instead.
 func (*HealthzResponse) Descriptor() ([]byte, []int) {
-	return file_symbols_proto_rawDescGZIP(), []int{10}
+	return file_symbols_proto_rawDescGZIP(), []int{14}
 }
 
 // Symbol is a code symbol
@@ -747,7 +1008,7 @@ type SearchResponse_Symbol struct {
 func (x *SearchResponse_Symbol) Reset() {
 	*x = SearchResponse_Symbol{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_symbols_proto_msgTypes[11]
+		mi := &file_symbols_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -760,7 +1021,7 @@ func (x *SearchResponse_Symbol) String() string {
 func (*SearchResponse_Symbol) ProtoMessage() {}
 
 func (x *SearchResponse_Symbol) ProtoReflect() protoreflect.Message {
-	mi := &file_symbols_proto_msgTypes[11]
+	mi := &file_symbols_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,6 +1107,206 @@ func (x *SearchResponse_Symbol) GetFileLimited() bool {
 	return false
 }
 
+type DiffSymbolsResponse_SymbolLocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the symbol
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// path is the file path that the symbol occurs in
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *DiffSymbolsResponse_SymbolLocation) Reset() {
+	*x = DiffSymbolsResponse_SymbolLocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_symbols_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffSymbolsResponse_SymbolLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffSymbolsResponse_SymbolLocation) ProtoMessage() {}
+
+func (x *DiffSymbolsResponse_SymbolLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_symbols_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffSymbolsResponse_SymbolLocation.ProtoReflect.Descriptor instead.
+func (*DiffSymbolsResponse_SymbolLocation) Descriptor() ([]byte, []int) {
+	return file_symbols_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *DiffSymbolsResponse_SymbolLocation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiffSymbolsResponse_SymbolLocation) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// SymbolMove is a symbol that was removed from one path and added to
+// another.
+type DiffSymbolsResponse_SymbolMove struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the symbol
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// old_path is the file path that the symbol was removed from
+	OldPath string `protobuf:"bytes,2,opt,name=old_path,json=oldPath,proto3" json:"old_path,omitempty"`
+	// new_path is the file path that the symbol was added to
+	NewPath string `protobuf:"bytes,3,opt,name=new_path,json=newPath,proto3" json:"new_path,omitempty"`
+}
+
+func (x *DiffSymbolsResponse_SymbolMove) Reset() {
+	*x = DiffSymbolsResponse_SymbolMove{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_symbols_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffSymbolsResponse_SymbolMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffSymbolsResponse_SymbolMove) ProtoMessage() {}
+
+func (x *DiffSymbolsResponse_SymbolMove) ProtoReflect() protoreflect.Message {
+	mi := &file_symbols_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffSymbolsResponse_SymbolMove.ProtoReflect.Descriptor instead.
+func (*DiffSymbolsResponse_SymbolMove) Descriptor() ([]byte, []int) {
+	return file_symbols_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *DiffSymbolsResponse_SymbolMove) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiffSymbolsResponse_SymbolMove) GetOldPath() string {
+	if x != nil {
+		return x.OldPath
+	}
+	return ""
+}
+
+func (x *DiffSymbolsResponse_SymbolMove) GetNewPath() string {
+	if x != nil {
+		return x.NewPath
+	}
+	return ""
+}
+
+type SymbolIntroductionsResponse_SymbolIntroduction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the symbol
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// path is the file path that the symbol occurs in
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// commit_id is the commit in which the symbol was added to the path
+	CommitId string `protobuf:"bytes,3,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
+	// height is the distance of the commit from the root of the history
+	Height int32 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *SymbolIntroductionsResponse_SymbolIntroduction) Reset() {
+	*x = SymbolIntroductionsResponse_SymbolIntroduction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_symbols_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SymbolIntroductionsResponse_SymbolIntroduction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolIntroductionsResponse_SymbolIntroduction) ProtoMessage() {}
+
+func (x *SymbolIntroductionsResponse_SymbolIntroduction) ProtoReflect() protoreflect.Message {
+	mi := &file_symbols_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymbolIntroductionsResponse_SymbolIntroduction.ProtoReflect.Descriptor instead.
+func (*SymbolIntroductionsResponse_SymbolIntroduction) Descriptor() ([]byte, []int) {
+	return file_symbols_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *SymbolIntroductionsResponse_SymbolIntroduction) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SymbolIntroductionsResponse_SymbolIntroduction) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SymbolIntroductionsResponse_SymbolIntroduction) GetCommitId() string {
+	if x != nil {
+		return x.CommitId
+	}
+	return ""
+}
+
+func (x *SymbolIntroductionsResponse_SymbolIntroduction) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
 type LocalCodeIntelResponse_Symbol struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -864,7 +1325,7 @@ type LocalCodeIntelResponse_Symbol struct {
 func (x *LocalCodeIntelResponse_Symbol) Reset() {
 	*x = LocalCodeIntelResponse_Symbol{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_symbols_proto_msgTypes[12]
+		mi := &file_symbols_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -877,7 +1338,7 @@ func (x *LocalCodeIntelResponse_Symbol) String() string {
 func (*LocalCodeIntelResponse_Symbol) ProtoMessage() {}
 
 func (x *LocalCodeIntelResponse_Symbol) ProtoReflect() protoreflect.Message {
-	mi := &file_symbols_proto_msgTypes[12]
+	mi := &file_symbols_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,7 +1351,7 @@ func (x *LocalCodeIntelResponse_Symbol) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocalCodeIntelResponse_Symbol.ProtoReflect.Descriptor instead.
 func (*LocalCodeIntelResponse_Symbol) Descriptor() ([]byte, []int) {
-	return file_symbols_proto_rawDescGZIP(), []int{3, 0}
+	return file_symbols_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (x *LocalCodeIntelResponse_Symbol) GetName() string {
@@ -936,7 +1397,7 @@ type SymbolInfoResponse_Definition struct {
 func (x *SymbolInfoResponse_Definition) Reset() {
 	*x = SymbolInfoResponse_Definition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_symbols_proto_msgTypes[13]
+		mi := &file_symbols_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -949,7 +1410,7 @@ func (x *SymbolInfoResponse_Definition) String() string {
 func (*SymbolInfoResponse_Definition) ProtoMessage() {}
 
 func (x *SymbolInfoResponse_Definition) ProtoReflect() protoreflect.Message {
-	mi := &file_symbols_proto_msgTypes[13]
+	mi := &file_symbols_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -962,7 +1423,7 @@ func (x *SymbolInfoResponse_Definition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SymbolInfoResponse_Definition.ProtoReflect.Descriptor instead.
 func (*SymbolInfoResponse_Definition) Descriptor() ([]byte, []int) {
-	return file_symbols_proto_rawDescGZIP(), []int{5, 0}
+	return file_symbols_proto_rawDescGZIP(), []int{9, 0}
 }
 
 func (x *SymbolInfoResponse_Definition) GetRepoCommitPath() *RepoCommitPath {
@@ -994,7 +1455,7 @@ type SymbolInfoResponse_DefinitionResult struct {
 func (x *SymbolInfoResponse_DefinitionResult) Reset() {
 	*x = SymbolInfoResponse_DefinitionResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_symbols_proto_msgTypes[14]
+		mi := &file_symbols_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1007,7 +1468,7 @@ func (x *SymbolInfoResponse_DefinitionResult) String() string {
 func (*SymbolInfoResponse_DefinitionResult) ProtoMessage() {}
 
 func (x *SymbolInfoResponse_DefinitionResult) ProtoReflect() protoreflect.Message {
-	mi := &file_symbols_proto_msgTypes[14]
+	mi := &file_symbols_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1020,7 +1481,7 @@ func (x *SymbolInfoResponse_DefinitionResult) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use SymbolInfoResponse_DefinitionResult.ProtoReflect.Descriptor instead.
 func (*SymbolInfoResponse_DefinitionResult) Descriptor() ([]byte, []int) {
-	return file_symbols_proto_rawDescGZIP(), []int{5, 1}
+	return file_symbols_proto_rawDescGZIP(), []int{9, 1}
 }
 
 func (x *SymbolInfoResponse_DefinitionResult) GetDefinition() *SymbolInfoResponse_Definition {
@@ -1094,100 +1555,167 @@ var file_symbols_proto_rawDesc = []byte{
 	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66,
 	0x69, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
 	0x08, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x42, 0x08,
-	0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5d, 0x0a, 0x15, 0x4c, 0x6f, 0x63, 0x61,
-	0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x6d, 0x0a, 0x12, 0x44, 0x69, 0x66, 0x66,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31,
+	0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x22, 0x96, 0x03, 0x0a, 0x13, 0x44, 0x69, 0x66, 0x66,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x44, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e,
+	0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12,
+	0x40, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x69, 0x74, 0x1a, 0x38,
+	0x0a, 0x0e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x1a, 0x56, 0x0a, 0x0a, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6c,
+	0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x6c,
+	0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x74, 0x68,
+	0x22, 0x75, 0x0a, 0x1a, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65,
+	0x70, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0xf2, 0x01, 0x0a, 0x1b, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a,
+	0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x6e,
+	0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x71, 0x0a, 0x12, 0x53, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x5d, 0x0a, 0x15,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x0e, 0x72, 0x65, 0x70,
+	0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0xdd, 0x01, 0x0a, 0x16,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e,
+	0x74, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x1a, 0x7e, 0x0a, 0x06, 0x53,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x76,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x76, 0x65, 0x72, 0x12,
+	0x23, 0x0a, 0x03, 0x64, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x03, 0x64, 0x65, 0x66, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x11,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
 	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x79,
 	0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6f, 0x6d,
 	0x6d, 0x69, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0xdd, 0x01, 0x0a, 0x16, 0x4c, 0x6f, 0x63, 0x61,
-	0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x43, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x07,
-	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x1a, 0x7e, 0x0a, 0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x03, 0x64,
-	0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x03, 0x64, 0x65, 0x66,
-	0x12, 0x25, 0x0a, 0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x11, 0x53, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a,
-	0x10, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50,
-	0x61, 0x74, 0x68, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50,
-	0x61, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0xff, 0x02, 0x0a,
-	0x12, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x88, 0x01,
-	0x01, 0x1a, 0x8a, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f,
-	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x79, 0x6d,
-	0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67,
-	0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x82,
-	0x01, 0x0a, 0x10, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x49, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x6d, 0x69, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x22, 0xff, 0x02, 0x0a, 0x12, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
 	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19,
-	0x0a, 0x05, 0x68, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x05, 0x68, 0x6f, 0x76, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x68, 0x6f,
-	0x76, 0x65, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x50,
-	0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x72, 0x65, 0x70, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x22, 0x49, 0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x63,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x31, 0x0a, 0x05, 0x50,
-	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0x10,
-	0x0a, 0x0e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x11, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x32, 0xd1, 0x02, 0x0a, 0x0e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x12, 0x19, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x5e, 0x0a, 0x0e,
-	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x6c, 0x12, 0x21,
-	0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
-	0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x22, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x6f, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x0a,
-	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x2e, 0x73, 0x79, 0x6d,
-	0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x47,
-	0x0a, 0x07, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x12, 0x1a, 0x2e, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x88, 0x01, 0x01, 0x1a, 0x8a, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x1a, 0x82, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x49, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x05, 0x68, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x05, 0x68, 0x6f, 0x76, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x68, 0x6f, 0x76, 0x65, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x50, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x22, 0x49, 0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22,
+	0x31, 0x0a, 0x05, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x22, 0x10, 0x0a, 0x0e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x93, 0x04, 0x0a, 0x0e, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x12, 0x19, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01,
+	0x12, 0x5e, 0x0a, 0x0e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x74,
+	0x65, 0x6c, 0x12, 0x21, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x74, 0x65,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01,
+	0x12, 0x50, 0x0a, 0x0a, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d,
+	0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90,
+	0x02, 0x01, 0x12, 0x47, 0x0a, 0x07, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x12, 0x1a, 0x2e,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x79, 0x6d, 0x62,
 	0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x67, 0x72, 0x61, 0x70,
-	0x68, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2f, 0x76,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x53, 0x0a, 0x0b, 0x44,
+	0x69, 0x66, 0x66, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01,
+	0x12, 0x6b, 0x0a, 0x13, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x49, 0x6e, 0x74, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x42, 0x38, 0x5a,
+	0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1202,51 +1730,67 @@ func file_symbols_proto_rawDescGZIP() []byte {
 	return file_symbols_proto_rawDescData
 }
 
-var file_symbols_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_symbols_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_symbols_proto_goTypes = []interface{}{
-	(*SearchRequest)(nil),                       // 0: symbols.v1.SearchRequest
-	(*SearchResponse)(nil),                      // 1: symbols.v1.SearchResponse
-	(*LocalCodeIntelRequest)(nil),               // 2: symbols.v1.LocalCodeIntelRequest
-	(*LocalCodeIntelResponse)(nil),              // 3: symbols.v1.LocalCodeIntelResponse
-	(*SymbolInfoRequest)(nil),                   // 4: symbols.v1.SymbolInfoRequest
-	(*SymbolInfoResponse)(nil),                  // 5: symbols.v1.SymbolInfoResponse
-	(*RepoCommitPath)(nil),                      // 6: symbols.v1.RepoCommitPath
-	(*Range)(nil),                               // 7: symbols.v1.Range
-	(*Point)(nil),                               // 8: symbols.v1.Point
-	(*HealthzRequest)(nil),                      // 9: symbols.v1.HealthzRequest
-	(*HealthzResponse)(nil),                     // 10: symbols.v1.HealthzResponse
-	(*SearchResponse_Symbol)(nil),               // 11: symbols.v1.SearchResponse.Symbol
-	(*LocalCodeIntelResponse_Symbol)(nil),       // 12: symbols.v1.LocalCodeIntelResponse.Symbol
-	(*SymbolInfoResponse_Definition)(nil),       // 13: symbols.v1.SymbolInfoResponse.Definition
-	(*SymbolInfoResponse_DefinitionResult)(nil), // 14: symbols.v1.SymbolInfoResponse.DefinitionResult
-	(*durationpb.Duration)(nil),                 // 15: google.protobuf.Duration
+	(*SearchRequest)(nil),                                  // 0: symbols.v1.SearchRequest
+	(*SearchResponse)(nil),                                 // 1: symbols.v1.SearchResponse
+	(*DiffSymbolsRequest)(nil),                             // 2: symbols.v1.DiffSymbolsRequest
+	(*DiffSymbolsResponse)(nil),                            // 3: symbols.v1.DiffSymbolsResponse
+	(*SymbolIntroductionsRequest)(nil),                     // 4: symbols.v1.SymbolIntroductionsRequest
+	(*SymbolIntroductionsResponse)(nil),                    // 5: symbols.v1.SymbolIntroductionsResponse
+	(*LocalCodeIntelRequest)(nil),                          // 6: symbols.v1.LocalCodeIntelRequest
+	(*LocalCodeIntelResponse)(nil),                         // 7: symbols.v1.LocalCodeIntelResponse
+	(*SymbolInfoRequest)(nil),                              // 8: symbols.v1.SymbolInfoRequest
+	(*SymbolInfoResponse)(nil),                             // 9: symbols.v1.SymbolInfoResponse
+	(*RepoCommitPath)(nil),                                 // 10: symbols.v1.RepoCommitPath
+	(*Range)(nil),                                          // 11: symbols.v1.Range
+	(*Point)(nil),                                          // 12: symbols.v1.Point
+	(*HealthzRequest)(nil),                                 // 13: symbols.v1.HealthzRequest
+	(*HealthzResponse)(nil),                                // 14: symbols.v1.HealthzResponse
+	(*SearchResponse_Symbol)(nil),                          // 15: symbols.v1.SearchResponse.Symbol
+	(*DiffSymbolsResponse_SymbolLocation)(nil),             // 16: symbols.v1.DiffSymbolsResponse.SymbolLocation
+	(*DiffSymbolsResponse_SymbolMove)(nil),                 // 17: symbols.v1.DiffSymbolsResponse.SymbolMove
+	(*SymbolIntroductionsResponse_SymbolIntroduction)(nil), // 18: symbols.v1.SymbolIntroductionsResponse.SymbolIntroduction
+	(*LocalCodeIntelResponse_Symbol)(nil),                  // 19: symbols.v1.LocalCodeIntelResponse.Symbol
+	(*SymbolInfoResponse_Definition)(nil),                  // 20: symbols.v1.SymbolInfoResponse.Definition
+	(*SymbolInfoResponse_DefinitionResult)(nil),            // 21: symbols.v1.SymbolInfoResponse.DefinitionResult
+	(*durationpb.Duration)(nil),                            // 22: google.protobuf.Duration
 }
 var file_symbols_proto_depIdxs = []int32{
-	15, // 0: symbols.v1.SearchRequest.timeout:type_name -> google.protobuf.Duration
-	11, // 1: symbols.v1.SearchResponse.symbols:type_name -> symbols.v1.SearchResponse.Symbol
-	6,  // 2: symbols.v1.LocalCodeIntelRequest.repo_commit_path:type_name -> symbols.v1.RepoCommitPath
-	12, // 3: symbols.v1.LocalCodeIntelResponse.symbols:type_name -> symbols.v1.LocalCodeIntelResponse.Symbol
-	6,  // 4: symbols.v1.SymbolInfoRequest.repo_commit_path:type_name -> symbols.v1.RepoCommitPath
-	8,  // 5: symbols.v1.SymbolInfoRequest.point:type_name -> symbols.v1.Point
-	14, // 6: symbols.v1.SymbolInfoResponse.result:type_name -> symbols.v1.SymbolInfoResponse.DefinitionResult
-	7,  // 7: symbols.v1.LocalCodeIntelResponse.Symbol.def:type_name -> symbols.v1.Range
-	7,  // 8: symbols.v1.LocalCodeIntelResponse.Symbol.refs:type_name -> symbols.v1.Range
-	6,  // 9: symbols.v1.SymbolInfoResponse.Definition.repo_commit_path:type_name -> symbols.v1.RepoCommitPath
-	7,  // 10: symbols.v1.SymbolInfoResponse.Definition.range:type_name -> symbols.v1.Range
-	13, // 11: symbols.v1.SymbolInfoResponse.DefinitionResult.definition:type_name -> symbols.v1.SymbolInfoResponse.Definition
-	0,  // 12: symbols.v1.SymbolsService.Search:input_type -> symbols.v1.SearchRequest
-	2,  // 13: symbols.v1.SymbolsService.LocalCodeIntel:input_type -> symbols.v1.LocalCodeIntelRequest
-	4,  // 14: symbols.v1.SymbolsService.SymbolInfo:input_type -> symbols.v1.SymbolInfoRequest
-	9,  // 15: symbols.v1.SymbolsService.Healthz:input_type -> symbols.v1.HealthzRequest
-	1,  // 16: symbols.v1.SymbolsService.Search:output_type -> symbols.v1.SearchResponse
-	3,  // 17: symbols.v1.SymbolsService.LocalCodeIntel:output_type -> symbols.v1.LocalCodeIntelResponse
-	5,  // 18: symbols.v1.SymbolsService.SymbolInfo:output_type -> symbols.v1.SymbolInfoResponse
-	10, // 19: symbols.v1.SymbolsService.Healthz:output_type -> symbols.v1.HealthzResponse
-	16, // [16:20] is the sub-list for method output_type
-	12, // [12:16] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	22, // 0: symbols.v1.SearchRequest.timeout:type_name -> google.protobuf.Duration
+	15, // 1: symbols.v1.SearchResponse.symbols:type_name -> symbols.v1.SearchResponse.Symbol
+	0,  // 2: symbols.v1.DiffSymbolsRequest.search:type_name -> symbols.v1.SearchRequest
+	16, // 3: symbols.v1.DiffSymbolsResponse.added:type_name -> symbols.v1.DiffSymbolsResponse.SymbolLocation
+	16, // 4: symbols.v1.DiffSymbolsResponse.removed:type_name -> symbols.v1.DiffSymbolsResponse.SymbolLocation
+	17, // 5: symbols.v1.DiffSymbolsResponse.moved:type_name -> symbols.v1.DiffSymbolsResponse.SymbolMove
+	18, // 6: symbols.v1.SymbolIntroductionsResponse.introductions:type_name -> symbols.v1.SymbolIntroductionsResponse.SymbolIntroduction
+	10, // 7: symbols.v1.LocalCodeIntelRequest.repo_commit_path:type_name -> symbols.v1.RepoCommitPath
+	19, // 8: symbols.v1.LocalCodeIntelResponse.symbols:type_name -> symbols.v1.LocalCodeIntelResponse.Symbol
+	10, // 9: symbols.v1.SymbolInfoRequest.repo_commit_path:type_name -> symbols.v1.RepoCommitPath
+	12, // 10: symbols.v1.SymbolInfoRequest.point:type_name -> symbols.v1.Point
+	21, // 11: symbols.v1.SymbolInfoResponse.result:type_name -> symbols.v1.SymbolInfoResponse.DefinitionResult
+	11, // 12: symbols.v1.LocalCodeIntelResponse.Symbol.def:type_name -> symbols.v1.Range
+	11, // 13: symbols.v1.LocalCodeIntelResponse.Symbol.refs:type_name -> symbols.v1.Range
+	10, // 14: symbols.v1.SymbolInfoResponse.Definition.repo_commit_path:type_name -> symbols.v1.RepoCommitPath
+	11, // 15: symbols.v1.SymbolInfoResponse.Definition.range:type_name -> symbols.v1.Range
+	20, // 16: symbols.v1.SymbolInfoResponse.DefinitionResult.definition:type_name -> symbols.v1.SymbolInfoResponse.Definition
+	0,  // 17: symbols.v1.SymbolsService.Search:input_type -> symbols.v1.SearchRequest
+	6,  // 18: symbols.v1.SymbolsService.LocalCodeIntel:input_type -> symbols.v1.LocalCodeIntelRequest
+	8,  // 19: symbols.v1.SymbolsService.SymbolInfo:input_type -> symbols.v1.SymbolInfoRequest
+	13, // 20: symbols.v1.SymbolsService.Healthz:input_type -> symbols.v1.HealthzRequest
+	2,  // 21: symbols.v1.SymbolsService.DiffSymbols:input_type -> symbols.v1.DiffSymbolsRequest
+	4,  // 22: symbols.v1.SymbolsService.SymbolIntroductions:input_type -> symbols.v1.SymbolIntroductionsRequest
+	1,  // 23: symbols.v1.SymbolsService.Search:output_type -> symbols.v1.SearchResponse
+	7,  // 24: symbols.v1.SymbolsService.LocalCodeIntel:output_type -> symbols.v1.LocalCodeIntelResponse
+	9,  // 25: symbols.v1.SymbolsService.SymbolInfo:output_type -> symbols.v1.SymbolInfoResponse
+	14, // 26: symbols.v1.SymbolsService.Healthz:output_type -> symbols.v1.HealthzResponse
+	3,  // 27: symbols.v1.SymbolsService.DiffSymbols:output_type -> symbols.v1.DiffSymbolsResponse
+	5,  // 28: symbols.v1.SymbolsService.SymbolIntroductions:output_type -> symbols.v1.SymbolIntroductionsResponse
+	23, // [23:29] is the sub-list for method output_type
+	17, // [17:23] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_symbols_proto_init() }
@@ -1280,7 +1824,7 @@ func file_symbols_proto_init() {
 			}
 		}
 		file_symbols_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocalCodeIntelRequest); i {
+			switch v := v.(*DiffSymbolsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1292,7 +1836,7 @@ func file_symbols_proto_init() {
 			}
 		}
 		file_symbols_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocalCodeIntelResponse); i {
+			switch v := v.(*DiffSymbolsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1304,7 +1848,7 @@ func file_symbols_proto_init() {
 			}
 		}
 		file_symbols_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SymbolInfoRequest); i {
+			switch v := v.(*SymbolIntroductionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1316,7 +1860,7 @@ func file_symbols_proto_init() {
 			}
 		}
 		file_symbols_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SymbolInfoResponse); i {
+			switch v := v.(*SymbolIntroductionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1328,7 +1872,7 @@ func file_symbols_proto_init() {
 			}
 		}
 		file_symbols_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoCommitPath); i {
+			switch v := v.(*LocalCodeIntelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1340,7 +1884,7 @@ func file_symbols_proto_init() {
 			}
 		}
 		file_symbols_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Range); i {
+			switch v := v.(*LocalCodeIntelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1352,7 +1896,7 @@ func file_symbols_proto_init() {
 			}
 		}
 		file_symbols_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Point); i {
+			switch v := v.(*SymbolInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1364,7 +1908,7 @@ func file_symbols_proto_init() {
 			}
 		}
 		file_symbols_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HealthzRequest); i {
+			switch v := v.(*SymbolInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1376,7 +1920,7 @@ func file_symbols_proto_init() {
 			}
 		}
 		file_symbols_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HealthzResponse); i {
+			switch v := v.(*RepoCommitPath); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1388,7 +1932,7 @@ func file_symbols_proto_init() {
 			}
 		}
 		file_symbols_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchResponse_Symbol); i {
+			switch v := v.(*Range); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1400,7 +1944,7 @@ func file_symbols_proto_init() {
 			}
 		}
 		file_symbols_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocalCodeIntelResponse_Symbol); i {
+			switch v := v.(*Point); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1412,7 +1956,7 @@ func file_symbols_proto_init() {
 			}
 		}
 		file_symbols_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SymbolInfoResponse_Definition); i {
+			switch v := v.(*HealthzRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1424,6 +1968,90 @@ func file_symbols_proto_init() {
 			}
 		}
 		file_symbols_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthzResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_symbols_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse_Symbol); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_symbols_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffSymbolsResponse_SymbolLocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_symbols_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffSymbolsResponse_SymbolMove); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_symbols_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SymbolIntroductionsResponse_SymbolIntroduction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_symbols_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalCodeIntelResponse_Symbol); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_symbols_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SymbolInfoResponse_Definition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_symbols_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SymbolInfoResponse_DefinitionResult); i {
 			case 0:
 				return &v.state
@@ -1437,16 +2065,16 @@ func file_symbols_proto_init() {
 		}
 	}
 	file_symbols_proto_msgTypes[1].OneofWrappers = []interface{}{}
-	file_symbols_proto_msgTypes[5].OneofWrappers = []interface{}{}
-	file_symbols_proto_msgTypes[13].OneofWrappers = []interface{}{}
-	file_symbols_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_symbols_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_symbols_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_symbols_proto_msgTypes[21].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_symbols_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,10 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	SymbolsService_Search_FullMethodName         = "/symbols.v1.SymbolsService/Search"
-	SymbolsService_LocalCodeIntel_FullMethodName = "/symbols.v1.SymbolsService/LocalCodeIntel"
-	SymbolsService_SymbolInfo_FullMethodName     = "/symbols.v1.SymbolsService/SymbolInfo"
-	SymbolsService_Healthz_FullMethodName        = "/symbols.v1.SymbolsService/Healthz"
+	SymbolsService_Search_FullMethodName              = "/symbols.v1.SymbolsService/Search"
+	SymbolsService_LocalCodeIntel_FullMethodName      = "/symbols.v1.SymbolsService/LocalCodeIntel"
+	SymbolsService_SymbolInfo_FullMethodName          = "/symbols.v1.SymbolsService/SymbolInfo"
+	SymbolsService_Healthz_FullMethodName             = "/symbols.v1.SymbolsService/Healthz"
+	SymbolsService_DiffSymbols_FullMethodName         = "/symbols.v1.SymbolsService/DiffSymbols"
+	SymbolsService_SymbolIntroductions_FullMethodName = "/symbols.v1.SymbolsService/SymbolIntroductions"
 )
 
 // SymbolsServiceClient is the client API for SymbolsService service.
@@ -33,6 +35,12 @@ type SymbolsServiceClient interface {
 	LocalCodeIntel(ctx context.Context, in *LocalCodeIntelRequest, opts ...grpc.CallOption) (SymbolsService_LocalCodeIntelClient, error)
 	SymbolInfo(ctx context.Context, in *SymbolInfoRequest, opts ...grpc.CallOption) (*SymbolInfoResponse, error)
 	Healthz(ctx context.Context, in *HealthzRequest, opts ...grpc.CallOption) (*HealthzResponse, error)
+	// DiffSymbols returns the symbols added, removed or moved between two
+	// commits. It is only implemented by the Rockskip backend.
+	DiffSymbols(ctx context.Context, in *DiffSymbolsRequest, opts ...grpc.CallOption) (*DiffSymbolsResponse, error)
+	// SymbolIntroductions returns the commits in which symbols were added to
+	// their paths. It is only implemented by the Rockskip backend.
+	SymbolIntroductions(ctx context.Context, in *SymbolIntroductionsRequest, opts ...grpc.CallOption) (*SymbolIntroductionsResponse, error)
 }
 
 type symbolsServiceClient struct {
@@ -102,6 +110,24 @@ func (c *symbolsServiceClient) Healthz(ctx context.Context, in *HealthzRequest,
 	return out, nil
 }
 
+func (c *symbolsServiceClient) DiffSymbols(ctx context.Context, in *DiffSymbolsRequest, opts ...grpc.CallOption) (*DiffSymbolsResponse, error) {
+	out := new(DiffSymbolsResponse)
+	err := c.cc.Invoke(ctx, SymbolsService_DiffSymbols_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *symbolsServiceClient) SymbolIntroductions(ctx context.Context, in *SymbolIntroductionsRequest, opts ...grpc.CallOption) (*SymbolIntroductionsResponse, error) {
+	out := new(SymbolIntroductionsResponse)
+	err := c.cc.Invoke(ctx, SymbolsService_SymbolIntroductions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SymbolsServiceServer is the server API for SymbolsService service.
 // All implementations must embed UnimplementedSymbolsServiceServer
 // for forward compatibility
@@ -110,6 +136,12 @@ type SymbolsServiceServer interface {
 	LocalCodeIntel(*LocalCodeIntelRequest, SymbolsService_LocalCodeIntelServer) error
 	SymbolInfo(context.Context, *SymbolInfoRequest) (*SymbolInfoResponse, error)
 	Healthz(context.Context, *HealthzRequest) (*HealthzResponse, error)
+	// DiffSymbols returns the symbols added, removed or moved between two
+	// commits. It is only implemented by the Rockskip backend.
+	DiffSymbols(context.Context, *DiffSymbolsRequest) (*DiffSymbolsResponse, error)
+	// SymbolIntroductions returns the commits in which symbols were added to
+	// their paths. It is only implemented by the Rockskip backend.
+	SymbolIntroductions(context.Context, *SymbolIntroductionsRequest) (*SymbolIntroductionsResponse, error)
 	mustEmbedUnimplementedSymbolsServiceServer()
 }
 
@@ -129,6 +161,12 @@ func (UnimplementedSymbolsServiceServer) SymbolInfo(context.Context, *SymbolInfo
 func (UnimplementedSymbolsServiceServer) Healthz(context.Context, *HealthzRequest) (*HealthzResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Healthz not implemented")
 }
+func (UnimplementedSymbolsServiceServer) DiffSymbols(context.Context, *DiffSymbolsRequest) (*DiffSymbolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffSymbols not implemented")
+}
+func (UnimplementedSymbolsServiceServer) SymbolIntroductions(context.Context, *SymbolIntroductionsRequest) (*SymbolIntroductionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SymbolIntroductions not implemented")
+}
 func (UnimplementedSymbolsServiceServer) mustEmbedUnimplementedSymbolsServiceServer() {}
 
 // UnsafeSymbolsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -217,6 +255,42 @@ func _SymbolsService_Healthz_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SymbolsService_DiffSymbols_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffSymbolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbolsServiceServer).DiffSymbols(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SymbolsService_DiffSymbols_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbolsServiceServer).DiffSymbols(ctx, req.(*DiffSymbolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SymbolsService_SymbolIntroductions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SymbolIntroductionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbolsServiceServer).SymbolIntroductions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SymbolsService_SymbolIntroductions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbolsServiceServer).SymbolIntroductions(ctx, req.(*SymbolIntroductionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SymbolsService_ServiceDesc is the grpc.ServiceDesc for SymbolsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -236,6 +310,14 @@ var SymbolsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Healthz",
 			Handler:    _SymbolsService_Healthz_Handler,
 		},
+		{
+			MethodName: "DiffSymbols",
+			Handler:    _SymbolsService_DiffSymbols_Handler,
+		},
+		{
+			MethodName: "SymbolIntroductions",
+			Handler:    _SymbolsService_SymbolIntroductions_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
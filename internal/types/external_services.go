@@ -27,6 +27,12 @@ type GerritConnection struct {
 	*schema.GerritConnection
 }
 
+type GiteaConnection struct {
+	// The unique resource identifier of the external service.
+	URN string
+	*schema.GiteaConnection
+}
+
 type GitHubConnection struct {
 	// The unique resource identifier of the external service.
 	URN string
@@ -80,6 +80,11 @@ func TestExternalService_RedactedConfig(t *testing.T) {
 			in:   schema.PerforceConnection{P4User: "foo", P4Passwd: "bar"},
 			out:  schema.PerforceConnection{P4User: "foo", P4Passwd: RedactedSecret},
 		},
+		{
+			kind: extsvc.KindGitea,
+			in:   schema.GiteaConnection{Url: "https://gitea.com", Token: "bar"},
+			out:  schema.GiteaConnection{Url: "https://gitea.com", Token: RedactedSecret},
+		},
 		{
 			kind: extsvc.KindPagure,
 			in:   schema.PagureConnection{Url: "https://src.fedoraproject.org", Token: "bar"},
@@ -288,6 +293,19 @@ func TestExternalService_UnredactConfig(t *testing.T) {
 			in:   schema.PagureConnection{Url: "https://src.fedoraproject.org"},
 			out:  schema.PagureConnection{Url: "https://src.fedoraproject.org"},
 		},
+		{
+			kind: extsvc.KindGitea,
+			old:  schema.GiteaConnection{Url: "https://gitea.com", Token: "bar"},
+			in:   schema.GiteaConnection{Url: "https://gitea.com", Token: RedactedSecret},
+			out:  schema.GiteaConnection{Url: "https://gitea.com", Token: "bar"},
+		},
+		{
+			kind:    extsvc.KindGitea,
+			old:     schema.GiteaConnection{Url: "https://gitea.com", Token: "bar"},
+			in:      schema.GiteaConnection{Url: "https://gitea.example.org", Token: RedactedSecret},
+			out:     schema.GiteaConnection{Url: "https://gitea.example.org", Token: "bar"},
+			wantErr: errCodeHostIdentityChanged{"url", "token"},
+		},
 		{
 			kind: extsvc.KindPagure,
 			old:  schema.PagureConnection{Url: "https://src.fedoraproject.org", Token: "bar"},
@@ -84,7 +84,7 @@ type Repo struct {
 	Blocked *RepoBlock `json:",omitempty"`
 	// KeyValuePairs is the set of key-value pairs associated with the repo
 	KeyValuePairs map[string]*string `json:",omitempty"`
-	// Topics synced from GitHub or GitLab
+	// Topics synced from GitHub, GitLab or Gitea
 	Topics []string `json:",omitempty"`
 }
 
@@ -135,7 +135,7 @@ type SearchedRepo struct {
 	LastFetched *time.Time
 	// A set of key-value pairs associated with the repo
 	KeyValuePairs map[string]*string
-	// Topics synced from GitHub or GitLab
+	// Topics synced from GitHub, GitLab or Gitea
 	Topics []string
 }
 
@@ -38,6 +38,71 @@ type BatchSpec struct {
 	TransformChanges  *TransformChanges        `json:"transformChanges,omitempty" yaml:"transformChanges,omitempty"`
 	ImportChangesets  []ImportChangeset        `json:"importChangesets,omitempty" yaml:"importChangesets"`
 	ChangesetTemplate *ChangesetTemplate       `json:"changesetTemplate,omitempty" yaml:"changesetTemplate"`
+	AutoMerge         *AutoMergePolicy         `json:"autoMerge,omitempty" yaml:"autoMerge"`
+	Rollout           *RolloutPolicy           `json:"rollout,omitempty" yaml:"rollout"`
+}
+
+// RolloutPolicy describes how the changesets of a batch change are published
+// in consecutive waves. Changesets that aren't part of any of the waves are
+// published in a final wave after all others.
+type RolloutPolicy struct {
+	Waves []RolloutWave `json:"waves,omitempty" yaml:"waves"`
+	Gate  *RolloutGate  `json:"gate,omitempty" yaml:"gate"`
+}
+
+// RolloutWave selects the changesets that are published in a wave. Exactly
+// one of the fields is set.
+type RolloutWave struct {
+	Count                     int    `json:"count,omitempty" yaml:"count"`
+	Percentage                int    `json:"percentage,omitempty" yaml:"percentage"`
+	RepositoriesMatchingQuery string `json:"repositoriesMatchingQuery,omitempty" yaml:"repositoriesMatchingQuery"`
+}
+
+// RolloutGate is the condition the changesets of a wave need to meet before
+// the next wave is published.
+type RolloutGate struct {
+	MergedPercentage int  `json:"mergedPercentage,omitempty" yaml:"mergedPercentage"`
+	NoFailingChecks  bool `json:"noFailingChecks,omitempty" yaml:"noFailingChecks"`
+}
+
+// AutoMergePolicy describes when the changesets of a batch change are merged
+// automatically.
+type AutoMergePolicy struct {
+	RequirePassingChecks *bool             `json:"requirePassingChecks,omitempty" yaml:"requirePassingChecks"`
+	RequiredApprovals    int               `json:"requiredApprovals,omitempty" yaml:"requiredApprovals"`
+	MergeMethod          AutoMergeMethod   `json:"mergeMethod,omitempty" yaml:"mergeMethod"`
+	Windows              []AutoMergeWindow `json:"windows,omitempty" yaml:"windows"`
+}
+
+// ChecksRequired returns true if changesets must have passing checks before
+// they are merged. This is the default.
+func (p *AutoMergePolicy) ChecksRequired() bool {
+	return p.RequirePassingChecks == nil || *p.RequirePassingChecks
+}
+
+// Method returns the merge method of the policy, defaulting to
+// AutoMergeMethodMerge.
+func (p *AutoMergePolicy) Method() AutoMergeMethod {
+	if p.MergeMethod == "" {
+		return AutoMergeMethodMerge
+	}
+	return p.MergeMethod
+}
+
+type AutoMergeMethod string
+
+const (
+	AutoMergeMethodMerge  AutoMergeMethod = "merge"
+	AutoMergeMethodSquash AutoMergeMethod = "squash"
+	AutoMergeMethodRebase AutoMergeMethod = "rebase"
+)
+
+// AutoMergeWindow is a window during which changesets may be merged. All days
+// and times are in UTC.
+type AutoMergeWindow struct {
+	Days  []string `json:"days,omitempty" yaml:"days"`
+	Start string   `json:"start,omitempty" yaml:"start"`
+	End   string   `json:"end,omitempty" yaml:"end"`
 }
 
 type ChangesetTemplate struct {
@@ -47,6 +112,14 @@ type ChangesetTemplate struct {
 	Fork      *bool                        `json:"fork,omitempty" yaml:"fork"`
 	Commit    ExpandedGitCommitDescription `json:"commit,omitempty" yaml:"commit"`
 	Published *overridable.BoolOrString    `json:"published" yaml:"published"`
+	Labels    []string                     `json:"labels,omitempty" yaml:"labels"`
+	Reviewers *ChangesetTemplateReviewers  `json:"reviewers,omitempty" yaml:"reviewers"`
+	Assignees []string                     `json:"assignees,omitempty" yaml:"assignees"`
+}
+
+type ChangesetTemplateReviewers struct {
+	Users []string `json:"users,omitempty" yaml:"users"`
+	Teams []string `json:"teams,omitempty" yaml:"teams"`
 }
 
 type GitCommitAuthor struct {
@@ -92,6 +165,7 @@ func (oqor *OnQueryOrRepository) GetBranches() ([]string, error) {
 type Step struct {
 	Run       string            `json:"run,omitempty" yaml:"run"`
 	Container string            `json:"container,omitempty" yaml:"container"`
+	Builtin   *BuiltinStep      `json:"builtin,omitempty" yaml:"builtin,omitempty"`
 	Env       env.Environment   `json:"env,omitempty" yaml:"env"`
 	Files     map[string]string `json:"files,omitempty" yaml:"files,omitempty"`
 	Outputs   Outputs           `json:"outputs,omitempty" yaml:"outputs,omitempty"`
@@ -99,6 +173,69 @@ type Step struct {
 	If        any               `json:"if,omitempty" yaml:"if,omitempty"`
 }
 
+// BuiltinStep is a transformation that is applied to the repository without
+// running a container. Exactly one of the fields is set.
+type BuiltinStep struct {
+	RegexReplace      *BuiltinRegexReplace      `json:"regexReplace,omitempty" yaml:"regexReplace"`
+	StructuralReplace *BuiltinStructuralReplace `json:"structuralReplace,omitempty" yaml:"structuralReplace"`
+	AddFile           *BuiltinAddFile           `json:"addFile,omitempty" yaml:"addFile"`
+	DeleteFile        *BuiltinDeleteFile        `json:"deleteFile,omitempty" yaml:"deleteFile"`
+	JSONEdit          *BuiltinPathEdit          `json:"jsonEdit,omitempty" yaml:"jsonEdit"`
+	YAMLEdit          *BuiltinPathEdit          `json:"yamlEdit,omitempty" yaml:"yamlEdit"`
+}
+
+// Name returns the name of the transformation of the step, as it is spelled in
+// the batch spec.
+func (s *BuiltinStep) Name() string {
+	switch {
+	case s.RegexReplace != nil:
+		return "regexReplace"
+	case s.StructuralReplace != nil:
+		return "structuralReplace"
+	case s.AddFile != nil:
+		return "addFile"
+	case s.DeleteFile != nil:
+		return "deleteFile"
+	case s.JSONEdit != nil:
+		return "jsonEdit"
+	case s.YAMLEdit != nil:
+		return "yamlEdit"
+	default:
+		return ""
+	}
+}
+
+type BuiltinRegexReplace struct {
+	Paths       []string `json:"paths,omitempty" yaml:"paths"`
+	Pattern     string   `json:"pattern,omitempty" yaml:"pattern"`
+	Replacement string   `json:"replacement" yaml:"replacement"`
+}
+
+type BuiltinStructuralReplace struct {
+	Paths       []string `json:"paths,omitempty" yaml:"paths"`
+	Pattern     string   `json:"pattern,omitempty" yaml:"pattern"`
+	Replacement string   `json:"replacement" yaml:"replacement"`
+	Matcher     string   `json:"matcher,omitempty" yaml:"matcher"`
+}
+
+type BuiltinAddFile struct {
+	Path    string `json:"path,omitempty" yaml:"path"`
+	Content string `json:"content" yaml:"content"`
+}
+
+type BuiltinDeleteFile struct {
+	Paths []string `json:"paths,omitempty" yaml:"paths"`
+}
+
+// BuiltinPathEdit sets or deletes the value at the dot-separated Key in the
+// JSON or YAML file at Path.
+type BuiltinPathEdit struct {
+	Path   string `json:"path,omitempty" yaml:"path"`
+	Key    string `json:"key,omitempty" yaml:"key"`
+	Value  any    `json:"value,omitempty" yaml:"value"`
+	Delete bool   `json:"delete,omitempty" yaml:"delete"`
+}
+
 func (s *Step) IfCondition() string {
 	switch v := s.If.(type) {
 	case bool:
@@ -168,6 +305,9 @@ func parseBatchSpec(schema string, data []byte) (*BatchSpec, error) {
 	}
 
 	for i, step := range spec.Steps {
+		if step.Builtin != nil && (len(step.Files) > 0 || len(step.Mount) > 0) {
+			errs = errors.Append(errs, NewValidationError(errors.Newf("step %d is a builtin step and can't have files or mounts", i+1)))
+		}
 		for _, mount := range step.Mount {
 			if strings.Contains(mount.Path, invalidMountCharacters) {
 				errs = errors.Append(errs, NewValidationError(errors.Newf("step %d mount path contains invalid characters", i+1)))
@@ -286,6 +286,203 @@ changesetTemplate:
 		_, err := ParseBatchSpec([]byte(spec))
 		assert.Equal(t, "step 1 mount mountpoint contains invalid characters", err.Error())
 	})
+
+	t.Run("auto-merge policy", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - run: echo Hello World | tee -a $(find -name README.md)
+    container: alpine:3
+changesetTemplate:
+  title: Hello World
+  body: My first batch change!
+  branch: hello-world
+  commit:
+    message: Append Hello World to all README.md files
+autoMerge:
+  requirePassingChecks: false
+  requiredApprovals: 2
+  mergeMethod: squash
+  windows:
+    - days: [saturday, sunday]
+      start: "06:00"
+      end: "20:00"
+`
+		parsed, err := ParseBatchSpec([]byte(spec))
+		if err != nil {
+			t.Fatalf("parsing valid spec returned error: %s", err)
+		}
+
+		policy := parsed.AutoMerge
+		assert.False(t, policy.ChecksRequired())
+		assert.Equal(t, 2, policy.RequiredApprovals)
+		assert.Equal(t, AutoMergeMethodSquash, policy.Method())
+		assert.Equal(t, []AutoMergeWindow{{Days: []string{"saturday", "sunday"}, Start: "06:00", End: "20:00"}}, policy.Windows)
+	})
+
+	t.Run("invalid auto-merge method", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - run: echo Hello World | tee -a $(find -name README.md)
+    container: alpine:3
+changesetTemplate:
+  title: Hello World
+  body: My first batch change!
+  branch: hello-world
+  commit:
+    message: Append Hello World to all README.md files
+autoMerge:
+  mergeMethod: fast-forward
+`
+		_, err := ParseBatchSpec([]byte(spec))
+		assert.Error(t, err)
+	})
+
+	t.Run("rollout policy", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - run: echo Hello World | tee -a $(find -name README.md)
+    container: alpine:3
+changesetTemplate:
+  title: Hello World
+  body: My first batch change!
+  branch: hello-world
+  commit:
+    message: Append Hello World to all README.md files
+rollout:
+  waves:
+    - repositoriesMatchingQuery: repo:^github.com/sourcegraph/
+    - count: 10
+    - percentage: 50
+  gate:
+    mergedPercentage: 80
+    noFailingChecks: true
+`
+		parsed, err := ParseBatchSpec([]byte(spec))
+		if err != nil {
+			t.Fatalf("parsing valid spec returned error: %s", err)
+		}
+
+		assert.Equal(t, &RolloutPolicy{
+			Waves: []RolloutWave{
+				{RepositoriesMatchingQuery: "repo:^github.com/sourcegraph/"},
+				{Count: 10},
+				{Percentage: 50},
+			},
+			Gate: &RolloutGate{MergedPercentage: 80, NoFailingChecks: true},
+		}, parsed.Rollout)
+	})
+
+	t.Run("rollout wave with multiple selectors", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - run: echo Hello World | tee -a $(find -name README.md)
+    container: alpine:3
+changesetTemplate:
+  title: Hello World
+  body: My first batch change!
+  branch: hello-world
+  commit:
+    message: Append Hello World to all README.md files
+rollout:
+  waves:
+    - count: 10
+      percentage: 50
+`
+		_, err := ParseBatchSpec([]byte(spec))
+		assert.Error(t, err)
+	})
+
+	t.Run("builtin steps", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - builtin:
+      regexReplace:
+        paths: ["**/*.go"]
+        pattern: ioutil\.ReadFile
+        replacement: os.ReadFile
+  - builtin:
+      jsonEdit:
+        path: package.json
+        key: scripts.lint
+        value: eslint .
+  - builtin:
+      deleteFile:
+        paths: [.travis.yml]
+changesetTemplate:
+  title: Hello World
+  body: My first batch change!
+  branch: hello-world
+  commit:
+    message: Replace ioutil
+`
+		parsed, err := ParseBatchSpec([]byte(spec))
+		if err != nil {
+			t.Fatalf("parsing valid spec returned error: %s", err)
+		}
+
+		assert.Equal(t, &BuiltinStep{RegexReplace: &BuiltinRegexReplace{
+			Paths:       []string{"**/*.go"},
+			Pattern:     `ioutil\.ReadFile`,
+			Replacement: "os.ReadFile",
+		}}, parsed.Steps[0].Builtin)
+		assert.Equal(t, "jsonEdit", parsed.Steps[1].Builtin.Name())
+		assert.Equal(t, "eslint .", parsed.Steps[1].Builtin.JSONEdit.Value)
+		assert.Equal(t, []string{".travis.yml"}, parsed.Steps[2].Builtin.DeleteFile.Paths)
+	})
+
+	t.Run("builtin step with container", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - run: echo Hello World
+    container: alpine:3
+    builtin:
+      addFile:
+        path: README.md
+        content: Hello World
+changesetTemplate:
+  title: Hello World
+  body: My first batch change!
+  branch: hello-world
+  commit:
+    message: Add README
+`
+		_, err := ParseBatchSpec([]byte(spec))
+		assert.Error(t, err)
+	})
+
+	t.Run("builtin step with multiple transformations", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - builtin:
+      addFile:
+        path: README.md
+        content: Hello World
+      deleteFile:
+        paths: [README.txt]
+changesetTemplate:
+  title: Hello World
+  body: My first batch change!
+  branch: hello-world
+  commit:
+    message: Add README
+`
+		_, err := ParseBatchSpec([]byte(spec))
+		assert.Error(t, err)
+	})
 }
 
 func TestOnQueryOrRepository_Branches(t *testing.T) {
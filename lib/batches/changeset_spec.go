@@ -78,6 +78,11 @@ type ChangesetSpec struct {
 	Body  string `json:"body,omitempty"`
 	Fork  *bool  `json:"fork,omitempty"`
 
+	Labels        []string `json:"labels,omitempty"`
+	Reviewers     []string `json:"reviewers,omitempty"`
+	TeamReviewers []string `json:"teamReviewers,omitempty"`
+	Assignees     []string `json:"assignees,omitempty"`
+
 	Commits []GitCommitDescription `json:"commits,omitempty"`
 
 	Published PublishedValue `json:"published,omitempty"`
@@ -104,6 +109,10 @@ func (c *ChangesetSpec) MarshalJSON() ([]byte, error) {
 		Commits        []GitCommitDescription `json:"commits,omitempty"`
 		Published      *PublishedValue        `json:"published,omitempty"`
 		Fork           *bool                  `json:"fork,omitempty"`
+		Labels         []string               `json:"labels,omitempty"`
+		Reviewers      []string               `json:"reviewers,omitempty"`
+		TeamReviewers  []string               `json:"teamReviewers,omitempty"`
+		Assignees      []string               `json:"assignees,omitempty"`
 	}{
 		BaseRepository: c.BaseRepository,
 		ExternalID:     c.ExternalID,
@@ -115,6 +124,10 @@ func (c *ChangesetSpec) MarshalJSON() ([]byte, error) {
 		Body:           c.Body,
 		Commits:        c.Commits,
 		Fork:           c.Fork,
+		Labels:         c.Labels,
+		Reviewers:      c.Reviewers,
+		TeamReviewers:  c.TeamReviewers,
+		Assignees:      c.Assignees,
 	}
 	if !c.Published.Nil() {
 		v.Published = &c.Published
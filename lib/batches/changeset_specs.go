@@ -2,6 +2,7 @@ package batches
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	godiff "github.com/sourcegraph/go-diff/diff"
@@ -94,6 +95,28 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, binaryDiffs bool, fallbackAu
 		return nil, err
 	}
 
+	labels, err := renderChangesetTemplateList("labels", input.Template.Labels, tmplCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	var reviewers, teamReviewers []string
+	if input.Template.Reviewers != nil {
+		reviewers, err = renderChangesetTemplateList("reviewers.users", input.Template.Reviewers.Users, tmplCtx)
+		if err != nil {
+			return nil, err
+		}
+		teamReviewers, err = renderChangesetTemplateList("reviewers.teams", input.Template.Reviewers.Teams, tmplCtx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	assignees, err := renderChangesetTemplateList("assignees", input.Template.Assignees, tmplCtx)
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO: As a next step, we should extend the ChangesetTemplateContext to also include
 	// TransformChanges.Group and then change validateGroups and groupFileDiffs to, for each group,
 	// render the branch name *before* grouping the diffs.
@@ -135,6 +158,11 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, binaryDiffs bool, fallbackAu
 				},
 			},
 			Published: PublishedValue{Val: published},
+
+			Labels:        labels,
+			Reviewers:     reviewers,
+			TeamReviewers: teamReviewers,
+			Assignees:     assignees,
 		}
 	}
 
@@ -165,6 +193,29 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, binaryDiffs bool, fallbackAu
 	return specs, nil
 }
 
+// renderChangesetTemplateList renders each of the given templates. Values that
+// render to an empty string are dropped, so that templates can conditionally
+// produce a value, and duplicates are removed.
+func renderChangesetTemplateList(name string, tmpls []string, tmplCtx *template.ChangesetTemplateContext) ([]string, error) {
+	var values []string
+	seen := make(map[string]struct{}, len(tmpls))
+	for i, tmpl := range tmpls {
+		value, err := template.RenderChangesetTemplateField(fmt.Sprintf("%s[%d]", name, i), tmpl, tmplCtx)
+		if err != nil {
+			return nil, err
+		}
+		if value == "" {
+			continue
+		}
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
 type RepoFetcher func(context.Context, []string) (map[string]string, error)
 
 func BuildImportChangesetSpecs(ctx context.Context, importChangesets []ImportChangeset, repoFetcher RepoFetcher) (specs []*ChangesetSpec, errs error) {
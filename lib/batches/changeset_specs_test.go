@@ -158,6 +158,26 @@ func TestCreateChangesetSpecs(t *testing.T) {
 			},
 			wantErr: "",
 		},
+		{
+			name: "labels, reviewers and assignees",
+			input: inputWith(defaultInput, func(input *ChangesetSpecInput) {
+				input.Template.Labels = []string{"batch-change", "${{ repository.name }}", "", "batch-change"}
+				input.Template.Reviewers = &ChangesetTemplateReviewers{
+					Users: []string{"alice", `${{ if eq repository.name "github.com/sourcegraph/sourcegraph" }}bob${{ end }}`},
+					Teams: []string{"sourcegraph/batch-changes"},
+				}
+				input.Template.Assignees = []string{"${{ batch_change.name }}-owner"}
+			}),
+			want: []*ChangesetSpec{
+				specWith(defaultChangesetSpec, func(s *ChangesetSpec) {
+					s.Labels = []string{"batch-change", "github.com/sourcegraph/src-cli"}
+					s.Reviewers = []string{"alice"}
+					s.TeamReviewers = []string{"sourcegraph/batch-changes"}
+					s.Assignees = []string{"the name-owner"}
+				}),
+			},
+			wantErr: "",
+		},
 	}
 
 	for _, tt := range tests {
@@ -128,7 +128,7 @@ const BatchSpecJSON = `{
         "type": "object",
         "description": "A command to run (as part of a sequence) in a repository branch to produce the required changes.",
         "additionalProperties": false,
-        "required": ["run", "container"],
+        "oneOf": [{ "required": ["run", "container"] }, { "required": ["builtin"] }],
         "properties": {
           "run": {
             "type": "string",
@@ -139,6 +139,117 @@ const BatchSpecJSON = `{
             "description": "The Docker image used to launch the Docker container in which the shell command is run.",
             "examples": ["alpine:3"]
           },
+          "builtin": {
+            "type": "object",
+            "title": "BuiltinStep",
+            "description": "A transformation that is applied to the repository without running a container. Builtin steps are only supported in server-side batch changes, where the executor applies them directly to the workspace. Exactly one transformation must be set.",
+            "additionalProperties": false,
+            "oneOf": [
+              { "required": ["regexReplace"] },
+              { "required": ["structuralReplace"] },
+              { "required": ["addFile"] },
+              { "required": ["deleteFile"] },
+              { "required": ["jsonEdit"] },
+              { "required": ["yamlEdit"] }
+            ],
+            "properties": {
+              "regexReplace": {
+                "type": "object",
+                "title": "BuiltinRegexReplace",
+                "description": "Replaces all matches of a regular expression in the files matching the paths.",
+                "additionalProperties": false,
+                "required": ["pattern", "replacement"],
+                "properties": {
+                  "paths": {
+                    "type": "array",
+                    "description": "Glob patterns of the files to change, relative to the workspace. ` + "`" + `**` + "`" + ` matches any number of directories. If omitted, all files are changed.",
+                    "items": { "type": "string" },
+                    "examples": [["**/*.go"], ["src/**/*.ts", "README.md"]]
+                  },
+                  "pattern": {
+                    "type": "string",
+                    "description": "The regular expression to match, in the syntax of the Go regexp package.",
+                    "examples": ["ioutil\\.ReadFile\\("]
+                  },
+                  "replacement": {
+                    "type": "string",
+                    "description": "The replacement for each match. Submatches can be referenced with $1 or ${name}. Supports templating.",
+                    "examples": ["os.ReadFile("]
+                  }
+                }
+              },
+              "structuralReplace": {
+                "type": "object",
+                "title": "BuiltinStructuralReplace",
+                "description": "Replaces all matches of a structural search pattern in the files matching the paths, like comby does.",
+                "additionalProperties": false,
+                "required": ["pattern", "replacement"],
+                "properties": {
+                  "paths": {
+                    "type": "array",
+                    "description": "Glob patterns of the files to change, relative to the workspace. ` + "`" + `**` + "`" + ` matches any number of directories. If omitted, all files are changed.",
+                    "items": { "type": "string" },
+                    "examples": [["**/*.go"]]
+                  },
+                  "pattern": {
+                    "type": "string",
+                    "description": "The structural match template. Holes such as :[x] match balanced text.",
+                    "examples": ["fmt.Sprintf(:[args])"]
+                  },
+                  "replacement": {
+                    "type": "string",
+                    "description": "The rewrite template, in which the holes of the pattern are substituted with the text they matched. Supports templating.",
+                    "examples": ["fmt.Errorf(:[args])"]
+                  },
+                  "matcher": {
+                    "type": "string",
+                    "description": "The language whose syntax is used to match, as a file extension. If omitted, it is derived from the extension of each file.",
+                    "examples": [".go", ".generic"]
+                  }
+                }
+              },
+              "addFile": {
+                "type": "object",
+                "title": "BuiltinAddFile",
+                "description": "Creates a file, or overwrites it if it exists.",
+                "additionalProperties": false,
+                "required": ["path", "content"],
+                "properties": {
+                  "path": {
+                    "type": "string",
+                    "description": "The path of the file, relative to the workspace.",
+                    "examples": ["CODEOWNERS", ".github/dependabot.yml"]
+                  },
+                  "content": {
+                    "type": "string",
+                    "description": "The content of the file. Supports templating."
+                  }
+                }
+              },
+              "deleteFile": {
+                "type": "object",
+                "title": "BuiltinDeleteFile",
+                "description": "Deletes the files matching the paths.",
+                "additionalProperties": false,
+                "required": ["paths"],
+                "properties": {
+                  "paths": {
+                    "type": "array",
+                    "description": "Glob patterns of the files to delete, relative to the workspace. ` + "`" + `**` + "`" + ` matches any number of directories.",
+                    "minItems": 1,
+                    "items": { "type": "string" },
+                    "examples": [[".travis.yml"], ["**/*.orig"]]
+                  }
+                }
+              },
+              "jsonEdit": {
+                "$ref": "#/definitions/BuiltinPathEdit"
+              },
+              "yamlEdit": {
+                "$ref": "#/definitions/BuiltinPathEdit"
+              }
+            }
+          },
           "outputs": {
             "type": ["object", "null"],
             "description": "Output variables of this step that can be referenced in the changesetTemplate or other steps via outputs.<name-of-output>",
@@ -333,6 +444,35 @@ const BatchSpecJSON = `{
           "type": "boolean",
           "description": "Whether to publish the changeset to a fork of the target repository. If omitted, the changeset will be published to a branch directly on the target repository, unless the global ` + "`" + `batches.enforceFork` + "`" + ` setting is enabled. If set, this property will override any global setting."
         },
+        "labels": {
+          "type": "array",
+          "description": "The labels to add to the changeset on the code host. Each label is a template; labels that render to an empty string are skipped. Labels are only supported on code hosts with label support.",
+          "items": { "type": "string" },
+          "examples": [["batch-change", "team/${{ repository.name }}"]]
+        },
+        "reviewers": {
+          "title": "ChangesetTemplateReviewers",
+          "type": "object",
+          "description": "The reviewers to request on the changeset. Each entry is a template; entries that render to an empty string are skipped.",
+          "additionalProperties": false,
+          "properties": {
+            "users": {
+              "type": "array",
+              "description": "The usernames of the users on the code host to request a review from.",
+              "items": { "type": "string" }
+            },
+            "teams": {
+              "type": "array",
+              "description": "The slugs of the teams on the code host to request a review from. Only supported on GitHub.",
+              "items": { "type": "string" }
+            }
+          }
+        },
+        "assignees": {
+          "type": "array",
+          "description": "The usernames of the users on the code host to assign the changeset to. Each entry is a template; entries that render to an empty string are skipped.",
+          "items": { "type": "string" }
+        },
         "commit": {
           "title": "ExpandedGitCommitDescription",
           "type": "object",
@@ -406,6 +546,155 @@ const BatchSpecJSON = `{
           ]
         }
       }
+    },
+    "autoMerge": {
+      "type": "object",
+      "title": "AutoMergePolicy",
+      "description": "A policy describing when changesets of the batch change are merged automatically. If omitted, changesets are never merged automatically.",
+      "additionalProperties": false,
+      "properties": {
+        "requirePassingChecks": {
+          "type": "boolean",
+          "description": "Only merge changesets once all of their checks have passed.",
+          "default": true
+        },
+        "requiredApprovals": {
+          "type": "integer",
+          "description": "The number of approving reviews a changeset needs before it is merged.",
+          "minimum": 0,
+          "default": 0
+        },
+        "mergeMethod": {
+          "type": "string",
+          "description": "The method used to merge changesets. The rebase method is only supported on code hosts that support native auto-merge.",
+          "enum": ["merge", "squash", "rebase"],
+          "default": "merge"
+        },
+        "windows": {
+          "type": "array",
+          "description": "Windows during which changesets may be merged. If omitted, changesets may be merged at any time. All days and times are handled in UTC.",
+          "items": {
+            "title": "AutoMergeWindow",
+            "type": "object",
+            "additionalProperties": false,
+            "properties": {
+              "days": {
+                "type": "array",
+                "description": "Day(s) the window applies to. If omitted, this rule applies to all days of the week.",
+                "items": {
+                  "type": "string",
+                  "pattern": "^([mM]on(day)?|[tT]ue(s|sday)?|[wW]ed(nesday)?|[tT]hu(r|rs|rsday)?|[fF]ri(day)?|[sS]at(urday)?|[sS]un(day)?)$"
+                }
+              },
+              "start": {
+                "type": "string",
+                "description": "Window start time. If omitted, no time window is applied to the day(s) that match this rule.",
+                "pattern": "^[0-9]?[0-9]:[0-9]{2}$"
+              },
+              "end": {
+                "type": "string",
+                "description": "Window end time. If omitted, no time window is applied to the day(s) that match this rule.",
+                "pattern": "^[0-9]?[0-9]:[0-9]{2}$"
+              }
+            },
+            "dependencies": {
+              "start": ["end"],
+              "end": ["start"]
+            }
+          }
+        }
+      }
+    },
+    "rollout": {
+      "type": "object",
+      "title": "RolloutPolicy",
+      "description": "A policy describing how the changesets of the batch change are published in consecutive waves. Changesets that aren't part of any of the waves are published in a final wave after all others. If omitted, all changesets are published at once.",
+      "additionalProperties": false,
+      "required": ["waves"],
+      "properties": {
+        "waves": {
+          "type": "array",
+          "description": "The waves in which changesets are published, in order. Changesets are assigned to the first wave that selects them.",
+          "minItems": 1,
+          "items": {
+            "title": "RolloutWave",
+            "type": "object",
+            "additionalProperties": false,
+            "oneOf": [
+              { "required": ["count"] },
+              { "required": ["percentage"] },
+              { "required": ["repositoriesMatchingQuery"] }
+            ],
+            "properties": {
+              "count": {
+                "type": "integer",
+                "description": "The number of changesets published in this wave.",
+                "minimum": 1
+              },
+              "percentage": {
+                "type": "integer",
+                "description": "The percentage of all changesets of the batch change published in this wave.",
+                "minimum": 1,
+                "maximum": 100
+              },
+              "repositoriesMatchingQuery": {
+                "type": "string",
+                "description": "A Sourcegraph search query. The changesets in the repositories matching the query are published in this wave.",
+                "examples": ["repo:^github\\.com/sourcegraph/"]
+              }
+            }
+          }
+        },
+        "gate": {
+          "type": "object",
+          "title": "RolloutGate",
+          "description": "The condition the changesets of a wave need to meet before the next wave is published. The next wave is always only published once all changesets of the current wave have been processed.",
+          "additionalProperties": false,
+          "properties": {
+            "mergedPercentage": {
+              "type": "integer",
+              "description": "The percentage of published changesets of the current wave that need to be merged.",
+              "minimum": 0,
+              "maximum": 100,
+              "default": 0
+            },
+            "noFailingChecks": {
+              "type": "boolean",
+              "description": "Require that none of the open changesets of the current wave have failing checks.",
+              "default": false
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "BuiltinPathEdit": {
+      "type": "object",
+      "title": "BuiltinPathEdit",
+      "description": "Sets or deletes the value at a key path in a file.",
+      "additionalProperties": false,
+      "required": ["path", "key"],
+      "properties": {
+        "path": {
+          "type": "string",
+          "description": "The path of the file, relative to the workspace. The file is created if it doesn't exist.",
+          "examples": ["package.json", ".github/workflows/ci.yml"]
+        },
+        "key": {
+          "type": "string",
+          "description": "The dot-separated key path of the value. Array elements are addressed by their index. Missing objects along the path are created.",
+          "examples": ["scripts.lint", "jobs.build.steps.0.uses"]
+        },
+        "value": {
+          "description": "The value to set. String values support templating."
+        },
+        "delete": {
+          "type": "boolean",
+          "description": "Delete the key instead of setting its value.",
+          "default": false
+        }
+      }
     }
   }
 }
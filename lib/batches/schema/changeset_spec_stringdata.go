@@ -72,6 +72,26 @@ const ChangesetSpecJSON = `{
         },
         "title": { "type": "string", "description": "The title of the changeset on the code host." },
         "body": { "type": "string", "description": "The body (description) of the changeset on the code host." },
+        "labels": {
+          "type": "array",
+          "description": "The labels to add to the changeset on the code host.",
+          "items": { "type": "string" }
+        },
+        "reviewers": {
+          "type": "array",
+          "description": "The usernames of the users to request a review of the changeset from.",
+          "items": { "type": "string" }
+        },
+        "teamReviewers": {
+          "type": "array",
+          "description": "The slugs of the teams to request a review of the changeset from.",
+          "items": { "type": "string" }
+        },
+        "assignees": {
+          "type": "array",
+          "description": "The usernames of the users to assign the changeset to.",
+          "items": { "type": "string" }
+        },
         "commits": {
           "type": "array",
           "description": "The Git commits with the proposed changes. These commits are pushed to the head ref.",
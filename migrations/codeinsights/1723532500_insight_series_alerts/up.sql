@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS insight_series_alerts (
+    id SERIAL PRIMARY KEY,
+    series_id text NOT NULL REFERENCES insight_series(series_id) ON DELETE CASCADE,
+    kind text NOT NULL,
+    threshold double precision NOT NULL,
+    action_type text NOT NULL,
+    action_url text,
+    user_id integer NOT NULL,
+    last_evaluated_at timestamp with time zone,
+    last_fired_at timestamp with time zone,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    CONSTRAINT insight_series_alerts_action_url CHECK ((action_type = 'EMAIL') = (action_url IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS insight_series_alerts_series_id ON insight_series_alerts USING btree (series_id);
+
+COMMENT ON TABLE insight_series_alerts IS 'Rules notifying users when the recorded values of an insight series meet a condition.';
+COMMENT ON COLUMN insight_series_alerts.kind IS 'The condition of the alert: ABSOLUTE_THRESHOLD, PERCENT_CHANGE or REPO_THRESHOLD.';
+COMMENT ON COLUMN insight_series_alerts.action_type IS 'How the alert is delivered: EMAIL, SLACK_WEBHOOK or WEBHOOK, like the actions of code monitors.';
+COMMENT ON COLUMN insight_series_alerts.action_url IS 'The URL of Slack webhook and webhook actions.';
+COMMENT ON COLUMN insight_series_alerts.user_id IS 'The user who created the alert. Alerts are evaluated with the repository permissions of this user, and emails are sent to them.';
+COMMENT ON COLUMN insight_series_alerts.last_evaluated_at IS 'The time of the latest recorded point the alert was evaluated against.';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS exhaustive_search_revision_results;
+
+ALTER TABLE exhaustive_search_jobs DROP COLUMN IF EXISTS previous_job_id;
@@ -0,0 +1,24 @@
+ALTER TABLE exhaustive_search_jobs ADD COLUMN IF NOT EXISTS previous_job_id integer REFERENCES exhaustive_search_jobs(id) ON DELETE SET NULL;
+
+COMMENT ON COLUMN exhaustive_search_jobs.previous_job_id IS 'The search job this job incrementally re-runs. Results of revisions which still resolve to the same commit are copied from this job instead of being searched again.';
+
+CREATE TABLE IF NOT EXISTS exhaustive_search_revision_results (
+    id SERIAL PRIMARY KEY,
+    search_job_id integer NOT NULL REFERENCES exhaustive_search_jobs(id) ON DELETE CASCADE,
+    repo_id integer NOT NULL REFERENCES repo(id) ON DELETE CASCADE,
+    revision text NOT NULL,
+    commit_id text NOT NULL,
+    blob_prefix text NOT NULL,
+    match_count integer NOT NULL DEFAULT 0,
+    matches_added integer NOT NULL DEFAULT 0,
+    matches_removed integer NOT NULL DEFAULT 0,
+    reused boolean NOT NULL DEFAULT false,
+    created_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS exhaustive_search_revision_results_job_repo_revision ON exhaustive_search_revision_results USING btree (search_job_id, repo_id, revision);
+
+COMMENT ON TABLE exhaustive_search_revision_results IS 'The results of searching a single revision of a repository as part of a search job. Unlike the worker tables, rows are kept until the search job is deleted, so that later runs of the same query can reuse them.';
+COMMENT ON COLUMN exhaustive_search_revision_results.commit_id IS 'The commit the revision resolved to when it was searched. Empty if the repository was empty.';
+COMMENT ON COLUMN exhaustive_search_revision_results.blob_prefix IS 'The key prefix of the blobs holding the matches in the upload store.';
+COMMENT ON COLUMN exhaustive_search_revision_results.reused IS 'Whether the matches were copied from the previous job instead of being searched.';
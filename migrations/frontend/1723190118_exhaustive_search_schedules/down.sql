@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS exhaustive_search_jobs_schedule_id;
+
+ALTER TABLE exhaustive_search_jobs DROP COLUMN IF EXISTS schedule_finalized;
+ALTER TABLE exhaustive_search_jobs DROP COLUMN IF EXISTS schedule_id;
+
+DROP TABLE IF EXISTS exhaustive_search_schedules;
@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS exhaustive_search_schedules (
+    id SERIAL PRIMARY KEY,
+    query text NOT NULL,
+    schedule text NOT NULL,
+    namespace_user_id integer REFERENCES users(id) ON DELETE CASCADE DEFERRABLE,
+    namespace_org_id integer REFERENCES orgs(id) ON DELETE CASCADE DEFERRABLE,
+    creator_id integer NOT NULL REFERENCES users(id) ON DELETE CASCADE DEFERRABLE,
+    retain_runs integer NOT NULL DEFAULT 0,
+    retain_days integer NOT NULL DEFAULT 0,
+    incremental boolean NOT NULL DEFAULT false,
+    notify boolean NOT NULL DEFAULT true,
+    enabled boolean NOT NULL DEFAULT true,
+    next_run_at timestamp with time zone,
+    last_run_at timestamp with time zone,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now(),
+    CONSTRAINT exhaustive_search_schedules_has_namespace CHECK ((namespace_user_id IS NULL) <> (namespace_org_id IS NULL)),
+    CONSTRAINT exhaustive_search_schedules_retention_not_negative CHECK (retain_runs >= 0 AND retain_days >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS exhaustive_search_schedules_next_run_at ON exhaustive_search_schedules USING btree (next_run_at) WHERE enabled;
+
+COMMENT ON TABLE exhaustive_search_schedules IS 'Search jobs which are re-run on a cron schedule. Each run is a row in exhaustive_search_jobs which references the schedule.';
+COMMENT ON COLUMN exhaustive_search_schedules.schedule IS 'The cron expression the schedule runs on, evaluated in UTC.';
+COMMENT ON COLUMN exhaustive_search_schedules.creator_id IS 'The user who created the schedule. Runs are executed on behalf of this user, so results respect their repository permissions.';
+COMMENT ON COLUMN exhaustive_search_schedules.retain_runs IS 'The number of finished runs to keep. Older runs and their results are deleted. 0 keeps all runs.';
+COMMENT ON COLUMN exhaustive_search_schedules.retain_days IS 'The number of days to keep finished runs for. Older runs and their results are deleted. 0 keeps all runs.';
+COMMENT ON COLUMN exhaustive_search_schedules.incremental IS 'Whether runs incrementally re-run the previous run, only searching revisions which moved.';
+COMMENT ON COLUMN exhaustive_search_schedules.notify IS 'Whether the creator is emailed when a run finishes.';
+
+ALTER TABLE exhaustive_search_jobs ADD COLUMN IF NOT EXISTS schedule_id integer REFERENCES exhaustive_search_schedules(id) ON DELETE SET NULL;
+ALTER TABLE exhaustive_search_jobs ADD COLUMN IF NOT EXISTS schedule_finalized boolean NOT NULL DEFAULT false;
+
+CREATE INDEX IF NOT EXISTS exhaustive_search_jobs_schedule_id ON exhaustive_search_jobs USING btree (schedule_id) WHERE schedule_id IS NOT NULL;
+
+COMMENT ON COLUMN exhaustive_search_jobs.schedule_id IS 'The schedule which created this search job, if any.';
+COMMENT ON COLUMN exhaustive_search_jobs.schedule_finalized IS 'Set once the scheduler has sent the notification for a finished run and applied the retention policy of its schedule.';
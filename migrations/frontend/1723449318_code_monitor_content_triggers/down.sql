@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS cm_last_content_matches;
+
+ALTER TABLE cm_trigger_jobs DROP COLUMN IF EXISTS content_changes;
+ALTER TABLE cm_queries DROP COLUMN IF EXISTS kind;
@@ -0,0 +1,14 @@
+ALTER TABLE cm_queries ADD COLUMN IF NOT EXISTS kind text NOT NULL DEFAULT 'COMMIT';
+ALTER TABLE cm_trigger_jobs ADD COLUMN IF NOT EXISTS content_changes jsonb;
+
+COMMENT ON COLUMN cm_queries.kind IS 'The kind of search the trigger runs. COMMIT triggers run commit and diff searches and fire on new matching commits. CONTENT triggers run content searches and fire when the set of matching files changes.';
+COMMENT ON COLUMN cm_trigger_jobs.content_changes IS 'The files which started or stopped matching the query of a CONTENT trigger in this run. Null for COMMIT triggers and runs without changes.';
+
+CREATE TABLE IF NOT EXISTS cm_last_content_matches (
+    monitor_id bigint PRIMARY KEY REFERENCES cm_monitors(id) ON DELETE CASCADE,
+    matches jsonb NOT NULL DEFAULT '[]'::jsonb,
+    updated_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE cm_last_content_matches IS 'The set of files matched by the query of a CONTENT code monitor trigger on its last run';
+COMMENT ON COLUMN cm_last_content_matches.matches IS 'The repository IDs, repository names and paths of the files matched on the last run';
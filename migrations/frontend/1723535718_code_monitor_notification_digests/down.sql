@@ -0,0 +1,8 @@
+ALTER TABLE cm_action_jobs DROP COLUMN IF EXISTS sent_at;
+
+ALTER TABLE cm_monitors DROP COLUMN IF EXISTS notification_limit_window;
+ALTER TABLE cm_monitors DROP COLUMN IF EXISTS notification_limit;
+
+ALTER TABLE cm_slack_webhooks DROP COLUMN IF EXISTS delivery;
+ALTER TABLE cm_webhooks DROP COLUMN IF EXISTS delivery;
+ALTER TABLE cm_emails DROP COLUMN IF EXISTS delivery;
@@ -0,0 +1,17 @@
+ALTER TABLE cm_emails ADD COLUMN IF NOT EXISTS delivery text NOT NULL DEFAULT 'IMMEDIATE';
+ALTER TABLE cm_webhooks ADD COLUMN IF NOT EXISTS delivery text NOT NULL DEFAULT 'IMMEDIATE';
+ALTER TABLE cm_slack_webhooks ADD COLUMN IF NOT EXISTS delivery text NOT NULL DEFAULT 'IMMEDIATE';
+
+COMMENT ON COLUMN cm_emails.delivery IS 'When notifications are sent. IMMEDIATE sends one notification per trigger event. HOURLY_DIGEST and DAILY_DIGEST send one notification for all trigger events at the end of each hour or day (UTC).';
+COMMENT ON COLUMN cm_webhooks.delivery IS 'When notifications are sent. IMMEDIATE sends one notification per trigger event. HOURLY_DIGEST and DAILY_DIGEST send one notification for all trigger events at the end of each hour or day (UTC).';
+COMMENT ON COLUMN cm_slack_webhooks.delivery IS 'When notifications are sent. IMMEDIATE sends one notification per trigger event. HOURLY_DIGEST and DAILY_DIGEST send one notification for all trigger events at the end of each hour or day (UTC).';
+
+ALTER TABLE cm_monitors ADD COLUMN IF NOT EXISTS notification_limit integer;
+ALTER TABLE cm_monitors ADD COLUMN IF NOT EXISTS notification_limit_window text NOT NULL DEFAULT 'HOUR';
+
+COMMENT ON COLUMN cm_monitors.notification_limit IS 'The maximum number of trigger events the actions of the monitor send notifications for within notification_limit_window. Null if unlimited.';
+COMMENT ON COLUMN cm_monitors.notification_limit_window IS 'The rolling window notification_limit applies to. One of HOUR or DAY.';
+
+ALTER TABLE cm_action_jobs ADD COLUMN IF NOT EXISTS sent_at timestamp with time zone;
+
+COMMENT ON COLUMN cm_action_jobs.sent_at IS 'The time at which this job sent a notification. Null for jobs which were merged into a digest or suppressed by the notification limit of the monitor.';
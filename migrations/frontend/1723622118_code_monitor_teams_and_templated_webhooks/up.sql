@@ -0,0 +1,62 @@
+CREATE TABLE IF NOT EXISTS cm_teams_webhooks (
+    id bigserial PRIMARY KEY,
+    monitor bigint NOT NULL REFERENCES cm_monitors(id) ON DELETE CASCADE,
+    url text NOT NULL,
+    enabled boolean NOT NULL,
+    include_results boolean NOT NULL DEFAULT false,
+    delivery text NOT NULL DEFAULT 'IMMEDIATE',
+    created_by integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    changed_by integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    changed_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS cm_teams_webhooks_monitor ON cm_teams_webhooks USING btree (monitor);
+
+COMMENT ON TABLE cm_teams_webhooks IS 'Microsoft Teams webhook actions configured on code monitors';
+COMMENT ON COLUMN cm_teams_webhooks.monitor IS 'The code monitor that the action is defined on';
+COMMENT ON COLUMN cm_teams_webhooks.url IS 'The Microsoft Teams webhook URL we send adaptive cards for code monitor events to';
+COMMENT ON COLUMN cm_teams_webhooks.delivery IS 'When notifications are sent. IMMEDIATE sends one notification per trigger event. HOURLY_DIGEST and DAILY_DIGEST send one notification for all trigger events at the end of each hour or day (UTC).';
+
+CREATE TABLE IF NOT EXISTS cm_templated_webhooks (
+    id bigserial PRIMARY KEY,
+    monitor bigint NOT NULL REFERENCES cm_monitors(id) ON DELETE CASCADE,
+    url text NOT NULL,
+    payload_template text NOT NULL,
+    headers bytea NOT NULL,
+    encryption_key_id text,
+    enabled boolean NOT NULL,
+    include_results boolean NOT NULL DEFAULT false,
+    delivery text NOT NULL DEFAULT 'IMMEDIATE',
+    created_by integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    changed_by integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    changed_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS cm_templated_webhooks_monitor ON cm_templated_webhooks USING btree (monitor);
+
+COMMENT ON TABLE cm_templated_webhooks IS 'Webhook actions with a user-defined payload configured on code monitors';
+COMMENT ON COLUMN cm_templated_webhooks.monitor IS 'The code monitor that the action is defined on';
+COMMENT ON COLUMN cm_templated_webhooks.url IS 'The webhook URL we send the code monitor event to';
+COMMENT ON COLUMN cm_templated_webhooks.payload_template IS 'The Go template which renders the request body of the webhook';
+COMMENT ON COLUMN cm_templated_webhooks.headers IS 'The additional HTTP headers of the webhook request, as a JSON array of objects with a name and a value. Encrypted if encryption_key_id is set, since header values are often credentials';
+COMMENT ON COLUMN cm_templated_webhooks.encryption_key_id IS 'The identifier of the key the headers are encrypted with, if any';
+COMMENT ON COLUMN cm_templated_webhooks.delivery IS 'When notifications are sent. IMMEDIATE sends one notification per trigger event. HOURLY_DIGEST and DAILY_DIGEST send one notification for all trigger events at the end of each hour or day (UTC).';
+
+ALTER TABLE cm_action_jobs ADD COLUMN IF NOT EXISTS teams_webhook bigint REFERENCES cm_teams_webhooks(id) ON DELETE CASCADE;
+ALTER TABLE cm_action_jobs ADD COLUMN IF NOT EXISTS templated_webhook bigint REFERENCES cm_templated_webhooks(id) ON DELETE CASCADE;
+
+COMMENT ON COLUMN cm_action_jobs.teams_webhook IS 'The ID of the cm_teams_webhooks action to execute if this is a Microsoft Teams webhook job. Mutually exclusive with the other action types';
+COMMENT ON COLUMN cm_action_jobs.templated_webhook IS 'The ID of the cm_templated_webhooks action to execute if this is a templated webhook job. Mutually exclusive with the other action types';
+
+ALTER TABLE cm_action_jobs DROP CONSTRAINT IF EXISTS cm_action_jobs_only_one_action_type;
+ALTER TABLE cm_action_jobs ADD CONSTRAINT cm_action_jobs_only_one_action_type CHECK ((
+    CASE WHEN email IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN webhook IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN slack_webhook IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN teams_webhook IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN templated_webhook IS NULL THEN 0 ELSE 1 END
+) = 1);
+
+COMMENT ON CONSTRAINT cm_action_jobs_only_one_action_type ON cm_action_jobs IS 'Constrains that each queued code monitor action has exactly one action type';
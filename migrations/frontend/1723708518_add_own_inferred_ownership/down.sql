@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS own_inferred_ownership;
+
+DELETE FROM own_signal_configurations
+WHERE name = 'inferred-ownership';
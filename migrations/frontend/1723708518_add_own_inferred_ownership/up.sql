@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS own_inferred_ownership (
+    id SERIAL PRIMARY KEY,
+    file_path_id INTEGER NOT NULL REFERENCES repo_paths(id) ON DELETE CASCADE,
+    commit_author_id INTEGER REFERENCES commit_authors(id) ON DELETE CASCADE,
+    team_id INTEGER REFERENCES teams(id) ON DELETE CASCADE DEFERRABLE,
+    score DOUBLE PRECISION NOT NULL,
+    confidence DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT own_inferred_ownership_one_owner CHECK ((commit_author_id IS NULL) <> (team_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS own_inferred_ownership_file_path_id ON own_inferred_ownership USING btree (file_path_id);
+
+COMMENT ON TABLE own_inferred_ownership IS 'Owners of directories inferred from the commit history and blame of repositories. Each row is either a commit author or a team.';
+COMMENT ON COLUMN own_inferred_ownership.file_path_id IS 'The directory the owner was inferred for. The empty path is the repository root.';
+COMMENT ON COLUMN own_inferred_ownership.score IS 'The sum of the time-decayed contributions of the owner to files within the directory.';
+COMMENT ON COLUMN own_inferred_ownership.confidence IS 'A value between 0 and 1: the share of the owner in all contributions to the directory, discounted when there are few contributions.';
+
+INSERT INTO own_signal_configurations (name, enabled, description)
+VALUES (
+        'inferred-ownership',
+        FALSE,
+        'Indexes owners of each directory inferred from repository history and blame, used by ownership search when files have no other owners.'
+    ) ON CONFLICT DO NOTHING;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS codeowners_lint_reports;
+
+DELETE FROM own_signal_configurations
+WHERE name = 'codeowners-lint';
@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS codeowners_lint_reports (
+    id SERIAL PRIMARY KEY,
+    repo_id INTEGER NOT NULL UNIQUE REFERENCES repo(id) ON DELETE CASCADE,
+    commit_id TEXT NOT NULL,
+    has_codeowners BOOLEAN NOT NULL,
+    total_files INTEGER NOT NULL,
+    owned_files INTEGER NOT NULL,
+    coverage DOUBLE PRECISION NOT NULL,
+    dead_rules JSONB NOT NULL DEFAULT '[]'::jsonb,
+    unknown_owners JSONB NOT NULL DEFAULT '[]'::jsonb,
+    unowned_paths JSONB NOT NULL DEFAULT '[]'::jsonb,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS codeowners_lint_reports_coverage ON codeowners_lint_reports USING btree (coverage);
+
+COMMENT ON TABLE codeowners_lint_reports IS 'The latest CODEOWNERS lint report of each repository.';
+COMMENT ON COLUMN codeowners_lint_reports.coverage IS 'The percentage of files of the repository owned by a CODEOWNERS rule.';
+COMMENT ON COLUMN codeowners_lint_reports.dead_rules IS 'The CODEOWNERS rules which patterns do not match any file.';
+COMMENT ON COLUMN codeowners_lint_reports.unknown_owners IS 'The owners referenced by the CODEOWNERS file which cannot be resolved to a user or team.';
+COMMENT ON COLUMN codeowners_lint_reports.unowned_paths IS 'The topmost directories and files which contain no owned files.';
+
+INSERT INTO own_signal_configurations (name, enabled, description)
+VALUES (
+        'codeowners-lint',
+        FALSE,
+        'Lints the CODEOWNERS file of each repository and reports its coverage, dead rules, unknown owners and unowned paths.'
+    ) ON CONFLICT DO NOTHING;
@@ -0,0 +1,5 @@
+ALTER TABLE changeset_specs
+    ADD COLUMN IF NOT EXISTS labels text[] DEFAULT '{}'::text[] NOT NULL,
+    ADD COLUMN IF NOT EXISTS reviewers text[] DEFAULT '{}'::text[] NOT NULL,
+    ADD COLUMN IF NOT EXISTS team_reviewers text[] DEFAULT '{}'::text[] NOT NULL,
+    ADD COLUMN IF NOT EXISTS assignees text[] DEFAULT '{}'::text[] NOT NULL;
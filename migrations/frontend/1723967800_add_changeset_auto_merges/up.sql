@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS changeset_auto_merges (
+    changeset_id bigint NOT NULL PRIMARY KEY REFERENCES changesets(id) ON DELETE CASCADE DEFERRABLE,
+    batch_change_id bigint NOT NULL REFERENCES batch_changes(id) ON DELETE CASCADE DEFERRABLE,
+    merge_method text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE changeset_auto_merges IS 'Changesets for which auto-merge has been enabled on the code host by the auto-merge policy of their batch change.';
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS changeset_rebases (
+    id bigserial PRIMARY KEY,
+    bulk_group text NOT NULL,
+    changeset_id bigint NOT NULL REFERENCES changesets(id) ON DELETE CASCADE DEFERRABLE,
+    batch_spec_workspace_id bigint NOT NULL REFERENCES batch_spec_workspaces(id) ON DELETE CASCADE DEFERRABLE,
+    base_rev text NOT NULL,
+    state text DEFAULT 'EXECUTING'::text NOT NULL,
+    failure_message text,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS changeset_rebases_bulk_group ON changeset_rebases USING btree (bulk_group);
+
+CREATE INDEX IF NOT EXISTS changeset_rebases_batch_spec_workspace_id ON changeset_rebases USING btree (batch_spec_workspace_id);
+
+COMMENT ON TABLE changeset_rebases IS 'Changesets that are re-executed on the current head of their base branch by a rebase bulk operation.';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS changeset_rollout_waves;
+DROP TABLE IF EXISTS batch_change_rollouts;
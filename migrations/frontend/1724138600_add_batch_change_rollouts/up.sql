@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS batch_change_rollouts (
+    batch_change_id bigint NOT NULL PRIMARY KEY REFERENCES batch_changes(id) ON DELETE CASCADE DEFERRABLE,
+    wave_count integer NOT NULL,
+    current_wave integer DEFAULT 0 NOT NULL,
+    paused boolean DEFAULT false NOT NULL,
+    gate_message text,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE batch_change_rollouts IS 'The state of the rollout of batch changes whose changesets are published in waves.';
+
+COMMENT ON COLUMN batch_change_rollouts.current_wave IS 'The zero-based index of the wave whose changesets may currently be published.';
+
+COMMENT ON COLUMN batch_change_rollouts.gate_message IS 'Why the next wave has not been published yet, if its gate has been evaluated and did not pass.';
+
+CREATE TABLE IF NOT EXISTS changeset_rollout_waves (
+    changeset_id bigint NOT NULL PRIMARY KEY REFERENCES changesets(id) ON DELETE CASCADE DEFERRABLE,
+    batch_change_id bigint NOT NULL REFERENCES batch_change_rollouts(batch_change_id) ON DELETE CASCADE DEFERRABLE,
+    wave integer NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS changeset_rollout_waves_batch_change_id_wave ON changeset_rollout_waves USING btree (batch_change_id, wave);
+
+COMMENT ON TABLE changeset_rollout_waves IS 'The rollout wave each changeset of a batch change with a rollout policy is published in.';
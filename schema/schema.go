@@ -229,6 +229,26 @@ func (v *AuthProviders) UnmarshalJSON(data []byte) error {
 	return fmt.Errorf("tagged union type must have a %q property whose value is one of %s", "type", []string{"azureDevOps", "bitbucketcloud", "builtin", "gerrit", "github", "gitlab", "http-header", "openidconnect", "saml"})
 }
 
+// AutoMergePolicy description: A policy describing when changesets of the batch change are merged automatically. If omitted, changesets are never merged automatically.
+type AutoMergePolicy struct {
+	// MergeMethod description: The method used to merge changesets. The rebase method is only supported on code hosts that support native auto-merge.
+	MergeMethod string `json:"mergeMethod,omitempty"`
+	// RequirePassingChecks description: Only merge changesets once all of their checks have passed.
+	RequirePassingChecks bool `json:"requirePassingChecks,omitempty"`
+	// RequiredApprovals description: The number of approving reviews a changeset needs before it is merged.
+	RequiredApprovals int `json:"requiredApprovals,omitempty"`
+	// Windows description: Windows during which changesets may be merged. If omitted, changesets may be merged at any time. All days and times are handled in UTC.
+	Windows []*AutoMergeWindow `json:"windows,omitempty"`
+}
+type AutoMergeWindow struct {
+	// Days description: Day(s) the window applies to. If omitted, this rule applies to all days of the week.
+	Days []string `json:"days,omitempty"`
+	// End description: Window end time. If omitted, no time window is applied to the day(s) that match this rule.
+	End string `json:"end,omitempty"`
+	// Start description: Window start time. If omitted, no time window is applied to the day(s) that match this rule.
+	Start string `json:"start,omitempty"`
+}
+
 // AzureDevOpsAuthProvider description: Azure auth provider for dev.azure.com
 type AzureDevOpsAuthProvider struct {
 	// AllowOrgs description: Restricts new logins and signups (if allowSignup is true) to members of these Azure DevOps organizations only. Existing sessions won't be invalidated. Leave empty or unset for no org restrictions.
@@ -301,6 +321,8 @@ type BatchChangeRolloutWindow struct {
 
 // BatchSpec description: A batch specification, which describes the batch change and what kinds of changes to make (or what existing changesets to track).
 type BatchSpec struct {
+	// AutoMerge description: A policy describing when changesets of the batch change are merged automatically. If omitted, changesets are never merged automatically.
+	AutoMerge *AutoMergePolicy `json:"autoMerge,omitempty"`
 	// ChangesetTemplate description: A template describing how to create (and update) changesets with the file changes produced by the command steps.
 	ChangesetTemplate *ChangesetTemplate `json:"changesetTemplate,omitempty"`
 	// Description description: The description of the batch change.
@@ -311,6 +333,8 @@ type BatchSpec struct {
 	Name string `json:"name"`
 	// On description: The set of repositories (and branches) to run the batch change on, specified as a list of search queries (that match repositories) and/or specific repositories.
 	On []any `json:"on,omitempty"`
+	// Rollout description: A policy describing how the changesets of the batch change are published in consecutive waves. Changesets that aren't part of any of the waves are published in a final wave after all others. If omitted, all changesets are published at once.
+	Rollout *RolloutPolicy `json:"rollout,omitempty"`
 	// Steps description: The sequence of commands to run (for each repository branch matched in the `on` property) to produce the workspace changes that will be included in the batch change.
 	Steps []*Step `json:"steps,omitempty"`
 	// TransformChanges description: Optional transformations to apply to the changes produced in each repository.
@@ -528,6 +552,8 @@ type BitbucketServerUsernameIdentity struct {
 	Type string `json:"type"`
 }
 type BranchChangesetSpec struct {
+	// Assignees description: The usernames of the users to assign the changeset to.
+	Assignees []string `json:"assignees,omitempty"`
 	// BaseRef description: The full name of the Git ref in the base repository that this changeset is based on (and is proposing to be merged into). This ref must exist on the base repository.
 	BaseRef string `json:"baseRef"`
 	// BaseRepository description: The GraphQL ID of the repository that this changeset spec is proposing to change.
@@ -544,8 +570,14 @@ type BranchChangesetSpec struct {
 	HeadRef string `json:"headRef"`
 	// HeadRepository description: The GraphQL ID of the repository that contains the branch with this changeset's changes. Fork repositories and cross-repository changesets are not yet supported. Therefore, headRepository must be equal to baseRepository.
 	HeadRepository string `json:"headRepository"`
+	// Labels description: The labels to add to the changeset on the code host.
+	Labels []string `json:"labels,omitempty"`
 	// Published description: Whether to publish the changeset. An unpublished changeset can be previewed on Sourcegraph by any person who can view the batch change, but its commit, branch, and pull request aren't created on the code host. A published changeset results in a commit, branch, and pull request being created on the code host.
 	Published any `json:"published,omitempty"`
+	// Reviewers description: The usernames of the users to request a review of the changeset from.
+	Reviewers []string `json:"reviewers,omitempty"`
+	// TeamReviewers description: The slugs of the teams to request a review of the changeset from.
+	TeamReviewers []string `json:"teamReviewers,omitempty"`
 	// Title description: The title of the changeset on the code host.
 	Title string `json:"title"`
 	// Version description: A field for versioning the payload.
@@ -570,6 +602,14 @@ type Branding struct {
 	Light   *BrandAssets `json:"light,omitempty"`
 }
 
+// BuiltinAddFile description: Creates a file, or overwrites it if it exists.
+type BuiltinAddFile struct {
+	// Content description: The content of the file. Supports templating.
+	Content string `json:"content"`
+	// Path description: The path of the file, relative to the workspace.
+	Path string `json:"path"`
+}
+
 // BuiltinAuthProvider description: Configures the builtin username-password authentication provider.
 type BuiltinAuthProvider struct {
 	// AllowSignup description: Allows new visitors to sign up for accounts. The sign-up page will be enabled and accessible to all visitors.
@@ -578,6 +618,56 @@ type BuiltinAuthProvider struct {
 	AllowSignup bool   `json:"allowSignup,omitempty"`
 	Type        string `json:"type"`
 }
+
+// BuiltinDeleteFile description: Deletes the files matching the paths.
+type BuiltinDeleteFile struct {
+	// Paths description: Glob patterns of the files to delete, relative to the workspace. `**` matches any number of directories.
+	Paths []string `json:"paths"`
+}
+
+// BuiltinPathEdit description: Sets or deletes the value at a key path in a file.
+type BuiltinPathEdit struct {
+	// Delete description: Delete the key instead of setting its value.
+	Delete bool `json:"delete,omitempty"`
+	// Key description: The dot-separated key path of the value. Array elements are addressed by their index. Missing objects along the path are created.
+	Key string `json:"key"`
+	// Path description: The path of the file, relative to the workspace. The file is created if it doesn't exist.
+	Path string `json:"path"`
+	// Value description: The value to set. String values support templating.
+	Value any `json:"value,omitempty"`
+}
+
+// BuiltinRegexReplace description: Replaces all matches of a regular expression in the files matching the paths.
+type BuiltinRegexReplace struct {
+	// Paths description: Glob patterns of the files to change, relative to the workspace. `**` matches any number of directories. If omitted, all files are changed.
+	Paths []string `json:"paths,omitempty"`
+	// Pattern description: The regular expression to match, in the syntax of the Go regexp package.
+	Pattern string `json:"pattern"`
+	// Replacement description: The replacement for each match. Submatches can be referenced with $1 or ${name}. Supports templating.
+	Replacement string `json:"replacement"`
+}
+
+// BuiltinStep description: A transformation that is applied to the repository without running a container. Builtin steps are only supported in server-side batch changes, where the executor applies them directly to the workspace. Exactly one transformation must be set.
+type BuiltinStep struct {
+	AddFile           *BuiltinAddFile           `json:"addFile,omitempty"`
+	DeleteFile        *BuiltinDeleteFile        `json:"deleteFile,omitempty"`
+	JsonEdit          *BuiltinPathEdit          `json:"jsonEdit,omitempty"`
+	RegexReplace      *BuiltinRegexReplace      `json:"regexReplace,omitempty"`
+	StructuralReplace *BuiltinStructuralReplace `json:"structuralReplace,omitempty"`
+	YamlEdit          *BuiltinPathEdit          `json:"yamlEdit,omitempty"`
+}
+
+// BuiltinStructuralReplace description: Replaces all matches of a structural search pattern in the files matching the paths, like comby does.
+type BuiltinStructuralReplace struct {
+	// Matcher description: The language whose syntax is used to match, as a file extension. If omitted, it is derived from the extension of each file.
+	Matcher string `json:"matcher,omitempty"`
+	// Paths description: Glob patterns of the files to change, relative to the workspace. `**` matches any number of directories. If omitted, all files are changed.
+	Paths []string `json:"paths,omitempty"`
+	// Pattern description: The structural match template. Holes such as :[x] match balanced text.
+	Pattern string `json:"pattern"`
+	// Replacement description: The rewrite template, in which the holes of the pattern are substituted with the text they matched. Supports templating.
+	Replacement string `json:"replacement"`
+}
 type CapabilitiesParams struct {
 }
 type CapabilitiesResult struct {
@@ -589,6 +679,8 @@ type CapabilitiesResult struct {
 
 // ChangesetTemplate description: A template describing how to create (and update) changesets with the file changes produced by the command steps.
 type ChangesetTemplate struct {
+	// Assignees description: The usernames of the users on the code host to assign the changeset to. Each entry is a template; entries that render to an empty string are skipped.
+	Assignees []string `json:"assignees,omitempty"`
 	// Body description: The body (description) of the changeset.
 	Body string `json:"body,omitempty"`
 	// Branch description: The name of the Git branch to create or update on each repository with the changes.
@@ -597,12 +689,24 @@ type ChangesetTemplate struct {
 	Commit ExpandedGitCommitDescription `json:"commit"`
 	// Fork description: Whether to publish the changeset to a fork of the target repository. If omitted, the changeset will be published to a branch directly on the target repository, unless the global `batches.enforceFork` setting is enabled. If set, this property will override any global setting.
 	Fork bool `json:"fork,omitempty"`
+	// Labels description: The labels to add to the changeset on the code host. Each label is a template; labels that render to an empty string are skipped. Labels are only supported on code hosts with label support.
+	Labels []string `json:"labels,omitempty"`
 	// Published description: Whether to publish the changeset. An unpublished changeset can be previewed on Sourcegraph by any person who can view the batch change, but its commit, branch, and pull request aren't created on the code host. A published changeset results in a commit, branch, and pull request being created on the code host. If omitted, the publication state is controlled from the Batch Changes UI.
 	Published any `json:"published,omitempty"`
+	// Reviewers description: The reviewers to request on the changeset. Each entry is a template; entries that render to an empty string are skipped.
+	Reviewers *ChangesetTemplateReviewers `json:"reviewers,omitempty"`
 	// Title description: The title of the changeset.
 	Title string `json:"title"`
 }
 
+// ChangesetTemplateReviewers description: The reviewers to request on the changeset. Each entry is a template; entries that render to an empty string are skipped.
+type ChangesetTemplateReviewers struct {
+	// Teams description: The slugs of the teams on the code host to request a review from. Only supported on GitHub.
+	Teams []string `json:"teams,omitempty"`
+	// Users description: The usernames of the users on the code host to request a review from.
+	Users []string `json:"users,omitempty"`
+}
+
 // ClientSideModelConfig description: No client-side model configuration is currently available.
 type ClientSideModelConfig struct {
 	Openaicompatible *ClientSideModelConfigOpenAICompatible `json:"openaicompatible,omitempty"`
@@ -1080,6 +1184,16 @@ type ExcludedGitLabProject struct {
 	// Pattern description: Regular expression which matches against the name of a GitLab project ("group/name").
 	Pattern string `json:"pattern,omitempty"`
 }
+type ExcludedGiteaRepo struct {
+	// Archived description: If set to true, archived repositories will be excluded.
+	Archived bool `json:"archived,omitempty"`
+	// Forks description: If set to true, forks will be excluded.
+	Forks bool `json:"forks,omitempty"`
+	// Name description: The name of a Gitea repository ("owner/name") to exclude from mirroring.
+	Name string `json:"name,omitempty"`
+	// Pattern description: Regular expression which matches against the name of a Gitea repository ("owner/name").
+	Pattern string `json:"pattern,omitempty"`
+}
 type ExcludedGitoliteRepo struct {
 	// Name description: The name of a Gitolite repo ("my-repo") to exclude from mirroring.
 	Name string `json:"name,omitempty"`
@@ -1640,6 +1754,84 @@ type GitRecorder struct {
 	Size int `json:"size,omitempty"`
 }
 
+// GiteaAuthorization description: If non-null, enforces Gitea repository permissions. The configured token is used to list the repositories each user can access on their behalf, so it must belong to a Gitea site admin.
+type GiteaAuthorization struct {
+	// IdentityProvider description: The source of identity to use when computing permissions. This defines how to compute the Gitea identity to use for a given Sourcegraph user. If unset, only Sourcegraph users with a linked Gitea account have access to restricted repositories. When 'username' is used, Sourcegraph assumes usernames are identical in Sourcegraph and Gitea accounts and `auth.enableUsernameChanges` must be set to false for security reasons.
+	IdentityProvider *GiteaIdentityProvider `json:"identityProvider,omitempty"`
+}
+
+// GiteaConnection description: Configuration for a connection to Gitea or Forgejo.
+type GiteaConnection struct {
+	// Authorization description: If non-null, enforces Gitea repository permissions. The configured token is used to list the repositories each user can access on their behalf, so it must belong to a Gitea site admin.
+	Authorization *GiteaAuthorization `json:"authorization,omitempty"`
+	// Exclude description: A list of repositories to never mirror from this Gitea instance. Takes precedence over "orgs", "users" and "repos" configuration.
+	//
+	// Supports excluding by name ({"name": "owner/name"}), by regular expression ({"pattern": "^owner/.*"}), archived repositories ({"archived": true}) and forks ({"forks": true}).
+	Exclude []*ExcludedGiteaRepo `json:"exclude,omitempty"`
+	// GitURLType description: The type of Git URLs to use for cloning and fetching Git repositories on this Gitea instance.
+	//
+	// If "http", Sourcegraph will access Gitea repositories using Git URLs of the form http(s)://gitea.example.com/myteam/myproject.git (using https: if the Gitea instance uses HTTPS).
+	//
+	// If "ssh", Sourcegraph will access Gitea repositories using Git URLs of the form git@gitea.example.com:myteam/myproject.git. See the documentation for how to provide SSH private keys and known_hosts: https://sourcegraph.com/docs/admin/repo/auth.
+	GitURLType string `json:"gitURLType,omitempty"`
+	// MarkInternalReposAsPublic description: If true, internal repositories (repositories that are only visible to signed-in users because their owner's visibility is limited) will be accessible to all users on Sourcegraph as if they were public. This overrides repository permissions but allows easier discovery and access to internal repositories, and may be desirable if all users on the Sourcegraph instance should have access to all internal repositories anyways. Defaults to false.
+	MarkInternalReposAsPublic bool `json:"markInternalReposAsPublic,omitempty"`
+	// Orgs description: An array of organization names identifying Gitea organizations whose repositories should be mirrored on Sourcegraph.
+	Orgs []string `json:"orgs,omitempty"`
+	// RateLimit description: Rate limit applied when making background API requests to Gitea.
+	RateLimit *GiteaRateLimit `json:"rateLimit,omitempty"`
+	// Repos description: An array of repository "owner/name" strings specifying which Gitea repositories to mirror on Sourcegraph.
+	Repos []string `json:"repos,omitempty"`
+	// RepositoryPathPattern description: The pattern used to generate the corresponding Sourcegraph repository name for a Gitea repository. In the pattern, the variable "{host}" is replaced with the Gitea host (such as gitea.example.com), and "{nameWithOwner}" is replaced with the Gitea repository's "owner/name" path (such as "myteam/myrepo").
+	//
+	// For example, if your Gitea URL is https://gitea.example.com and your Sourcegraph URL is https://src.example.com, then a repositoryPathPattern of "{host}/{nameWithOwner}" would mean that a Gitea repository at https://gitea.example.com/myteam/myrepo is available on Sourcegraph at https://src.example.com/gitea.example.com/myteam/myrepo.
+	//
+	// It is important that the Sourcegraph repository name generated with this pattern be unique to this code host. If different code hosts generate repository names that collide, Sourcegraph's behavior is undefined.
+	RepositoryPathPattern string `json:"repositoryPathPattern,omitempty"`
+	// Token description: An access token for the Gitea instance. The token needs the read:repository, read:organization and read:user scopes to sync repositories, and write:repository to create changesets with Batch Changes. If authorization is enabled, the token must belong to a site admin.
+	Token string `json:"token"`
+	// Url description: URL of a Gitea or Forgejo instance, such as https://gitea.example.com or https://codeberg.org.
+	Url string `json:"url"`
+	// Users description: An array of user names identifying Gitea users whose repositories should be mirrored on Sourcegraph.
+	Users []string `json:"users,omitempty"`
+}
+
+// GiteaIdentityProvider description: The source of identity to use when computing permissions. This defines how to compute the Gitea identity to use for a given Sourcegraph user. If unset, only Sourcegraph users with a linked Gitea account have access to restricted repositories. When 'username' is used, Sourcegraph assumes usernames are identical in Sourcegraph and Gitea accounts and `auth.enableUsernameChanges` must be set to false for security reasons.
+type GiteaIdentityProvider struct {
+	Username *GiteaUsernameIdentity
+}
+
+func (v GiteaIdentityProvider) MarshalJSON() ([]byte, error) {
+	if v.Username != nil {
+		return json.Marshal(v.Username)
+	}
+	return nil, errors.New("tagged union type must have exactly 1 non-nil field value")
+}
+func (v *GiteaIdentityProvider) UnmarshalJSON(data []byte) error {
+	var d struct {
+		DiscriminantProperty string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return err
+	}
+	switch d.DiscriminantProperty {
+	case "username":
+		return json.Unmarshal(data, &v.Username)
+	}
+	return fmt.Errorf("tagged union type must have a %q property whose value is one of %s", "type", []string{"username"})
+}
+
+// GiteaRateLimit description: Rate limit applied when making background API requests to Gitea.
+type GiteaRateLimit struct {
+	// Enabled description: true if rate limiting is enabled.
+	Enabled bool `json:"enabled"`
+	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 500, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 500 requests immediately, provided that the complexity cost of each request is 1.
+	RequestsPerHour float64 `json:"requestsPerHour"`
+}
+type GiteaUsernameIdentity struct {
+	Type string `json:"type"`
+}
+
 // Github description: GitHub configuration, both for queries and receiving release webhooks.
 type Github struct {
 	// Repository description: The repository to get the latest version of.
@@ -2174,6 +2366,20 @@ type OutputVariable struct {
 	Value string `json:"value"`
 }
 
+// OwnInferredOwnership description: Configures how owners are inferred from commit history and blame when the inferred-ownership signal is enabled on the Ownership signals site admin page. Changes take effect the next time a repository is indexed.
+type OwnInferredOwnership struct {
+	// HalfLifeDays description: The number of days after which a contribution counts half as much towards ownership.
+	HalfLifeDays int `json:"halfLifeDays,omitempty"`
+	// LookbackDays description: The number of days of commit history which are taken into account.
+	LookbackDays int `json:"lookbackDays,omitempty"`
+	// MaxBlameFiles description: The maximum number of recently changed files per repository whose blame is taken into account. Set to 0 to only use commit history.
+	MaxBlameFiles *int `json:"maxBlameFiles,omitempty"`
+	// MaxOwnersPerDirectory description: The maximum number of people, and separately of teams, inferred as owners of a directory.
+	MaxOwnersPerDirectory int `json:"maxOwnersPerDirectory,omitempty"`
+	// MinConfidence description: Inferred owners with a lower confidence, between 0 and 1, are discarded.
+	MinConfidence *float64 `json:"minConfidence,omitempty"`
+}
+
 // PagureConnection description: Configuration for a connection to Pagure.
 type PagureConnection struct {
 	// Forks description: If true, it includes forks in the returned projects.
@@ -2424,6 +2630,30 @@ type RestartStep struct {
 	Value string `json:"value"`
 }
 
+// RolloutGate description: The condition the changesets of a wave need to meet before the next wave is published. The next wave is always only published once all changesets of the current wave have been processed.
+type RolloutGate struct {
+	// MergedPercentage description: The percentage of published changesets of the current wave that need to be merged.
+	MergedPercentage int `json:"mergedPercentage,omitempty"`
+	// NoFailingChecks description: Require that none of the open changesets of the current wave have failing checks.
+	NoFailingChecks bool `json:"noFailingChecks,omitempty"`
+}
+
+// RolloutPolicy description: A policy describing how the changesets of the batch change are published in consecutive waves. Changesets that aren't part of any of the waves are published in a final wave after all others. If omitted, all changesets are published at once.
+type RolloutPolicy struct {
+	// Gate description: The condition the changesets of a wave need to meet before the next wave is published. The next wave is always only published once all changesets of the current wave have been processed.
+	Gate *RolloutGate `json:"gate,omitempty"`
+	// Waves description: The waves in which changesets are published, in order. Changesets are assigned to the first wave that selects them.
+	Waves []*RolloutWave `json:"waves"`
+}
+type RolloutWave struct {
+	// Count description: The number of changesets published in this wave.
+	Count int `json:"count,omitempty"`
+	// Percentage description: The percentage of all changesets of the batch change published in this wave.
+	Percentage int `json:"percentage,omitempty"`
+	// RepositoriesMatchingQuery description: A Sourcegraph search query. The changesets in the repositories matching the query are published in this wave.
+	RepositoriesMatchingQuery string `json:"repositoriesMatchingQuery,omitempty"`
+}
+
 // RubyPackagesConnection description: Configuration for a connection to Ruby packages
 type RubyPackagesConnection struct {
 	// Dependencies description: An array of strings specifying Ruby packages to mirror in Sourcegraph.
@@ -3330,6 +3560,8 @@ type SiteConfiguration struct {
 	OwnBackgroundRepoIndexRateLimit int `json:"own.background.repoIndexRateLimit,omitempty"`
 	// OwnBestEffortTeamMatching description: The Own service will attempt to match a Team by the last part of its handle if it contains a slash and no match is found for its full handle.
 	OwnBestEffortTeamMatching *bool `json:"own.bestEffortTeamMatching,omitempty"`
+	// OwnInferredOwnership description: Configures how owners are inferred from commit history and blame when the inferred-ownership signal is enabled on the Ownership signals site admin page. Changes take effect the next time a repository is indexed.
+	OwnInferredOwnership *OwnInferredOwnership `json:"own.inferredOwnership,omitempty"`
 	// ParentSourcegraph description: URL to fetch unreachable repository details from. Defaults to "https://sourcegraph.com"
 	ParentSourcegraph *ParentSourcegraph `json:"parentSourcegraph,omitempty"`
 	// PermissionsSyncJobCleanupInterval description: Time interval (in seconds) of how often cleanup worker should remove old jobs from permissions sync jobs table.
@@ -3529,6 +3761,7 @@ func (v *SiteConfiguration) UnmarshalJSON(data []byte) error {
 	delete(m, "own.background.repoIndexRateBurstLimit")
 	delete(m, "own.background.repoIndexRateLimit")
 	delete(m, "own.bestEffortTeamMatching")
+	delete(m, "own.inferredOwnership")
 	delete(m, "parentSourcegraph")
 	delete(m, "permissions.syncJobCleanupInterval")
 	delete(m, "permissions.syncJobsHistorySize")
@@ -3604,8 +3837,10 @@ type SrcCliVersionCache struct {
 
 // Step description: A command to run (as part of a sequence) in a repository branch to produce the required changes.
 type Step struct {
+	// Builtin description: A transformation that is applied to the repository without running a container. Builtin steps are only supported in server-side batch changes, where the executor applies them directly to the workspace. Exactly one transformation must be set.
+	Builtin *BuiltinStep `json:"builtin,omitempty"`
 	// Container description: The Docker image used to launch the Docker container in which the shell command is run.
-	Container string `json:"container"`
+	Container string `json:"container,omitempty"`
 	// Env description: Environment variables to set in the step environment.
 	Env any `json:"env,omitempty"`
 	// Files description: Files that should be mounted into or be created inside the Docker container.
@@ -3617,7 +3852,7 @@ type Step struct {
 	// Outputs description: Output variables of this step that can be referenced in the changesetTemplate or other steps via outputs.<name-of-output>
 	Outputs map[string]OutputVariable `json:"outputs,omitempty"`
 	// Run description: The shell command to run in the container. It can also be a multi-line shell script. The working directory is the root directory of the repository checkout.
-	Run string `json:"run"`
+	Run string `json:"run,omitempty"`
 }
 
 // StyleOverrides description: Overrides for the notice's default style. You probably want to use notice 'variant' setting instead.